@@ -112,6 +112,7 @@ func (app *App) initMiddlewares() error {
 		app.config,
 	))
 	app.app.Use(middlewares.InjectManager(app.moduleProvider.authModule.GetAccessManager()))
+	app.app.Use(middlewares.InjectRegionCache())
 
 	return nil
 }
@@ -319,6 +320,32 @@ func (app *App) registerBackgroundProcesses() error {
 		}
 	}
 
+	if app.moduleProvider != nil && app.moduleProvider.parserModule != nil {
+		parser := app.moduleProvider.parserModule.GetParser()
+		if parser != nil {
+			app.processManager.Register(parser)
+			app.logger.Info("✅ Catalogue parser registered in process manager")
+		}
+	}
+
+	if app.moduleProvider != nil && app.moduleProvider.cartModule != nil {
+		app.processManager.Register(app.moduleProvider.cartModule.GetCartCleaner())
+		app.logger.Info("✅ Cart cleaner registered in process manager")
+	}
+
+	if app.moduleProvider != nil && app.moduleProvider.orderModule != nil {
+		app.processManager.Register(app.moduleProvider.orderModule.GetReservationReleaser())
+		app.logger.Info("✅ Stock reservation releaser registered in process manager")
+	}
+
+	if app.moduleProvider != nil && app.moduleProvider.receiptModule != nil {
+		receiptSender := app.moduleProvider.receiptModule.GetReceiptSender()
+		if receiptSender != nil {
+			app.processManager.Register(receiptSender)
+			app.logger.Info("✅ Receipt sender registered in process manager")
+		}
+	}
+
 	return nil
 }
 
@@ -346,6 +373,19 @@ func (app *App) initRouter() error {
 	app.moduleProvider.authModule.InitDelivery(api)
 	app.moduleProvider.fileModule.InitDelivery(api)
 	app.moduleProvider.productModule.InitDelivery(api)
+	app.moduleProvider.truModule.InitDelivery(api)
+	app.moduleProvider.regionModule.InitDelivery(api)
+	app.moduleProvider.parserModule.InitDelivery(api)
+	app.moduleProvider.matcherModule.InitDelivery(api)
+	app.moduleProvider.cartModule.InitDelivery(api)
+	app.moduleProvider.addressModule.InitDelivery(api)
+	app.moduleProvider.discountModule.InitDelivery(api)
+	app.moduleProvider.deliveryModule.InitDelivery(api)
+	app.moduleProvider.stockModule.InitDelivery(api)
+	app.moduleProvider.orderModule.InitDelivery(api)
+	app.moduleProvider.receiptModule.InitDelivery(api)
+	app.moduleProvider.paymentModule.InitDelivery(api)
+	app.moduleProvider.returnsModule.InitDelivery(api)
 
 	return nil
 }
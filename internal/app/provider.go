@@ -1,10 +1,23 @@
 package app
 
 import (
+	address_module "github.com/Fi44er/sdmed/internal/module/address"
 	auth_module "github.com/Fi44er/sdmed/internal/module/auth"
+	cart_module "github.com/Fi44er/sdmed/internal/module/cart"
+	delivery_module "github.com/Fi44er/sdmed/internal/module/delivery"
+	discount_module "github.com/Fi44er/sdmed/internal/module/discount"
 	file_module "github.com/Fi44er/sdmed/internal/module/file"
+	matcher_module "github.com/Fi44er/sdmed/internal/module/matcher"
 	notification_module "github.com/Fi44er/sdmed/internal/module/notification"
+	order_module "github.com/Fi44er/sdmed/internal/module/order"
+	parser_module "github.com/Fi44er/sdmed/internal/module/parser"
+	payment_module "github.com/Fi44er/sdmed/internal/module/payment"
 	product_module "github.com/Fi44er/sdmed/internal/module/product"
+	receipt_module "github.com/Fi44er/sdmed/internal/module/receipt"
+	region_module "github.com/Fi44er/sdmed/internal/module/region"
+	returns_module "github.com/Fi44er/sdmed/internal/module/returns"
+	stock_module "github.com/Fi44er/sdmed/internal/module/stock"
+	tru_module "github.com/Fi44er/sdmed/internal/module/tru"
 	user_module "github.com/Fi44er/sdmed/internal/module/user"
 )
 
@@ -15,7 +28,20 @@ type moduleProvider struct {
 	notificationModule *notification_module.NotificationModule
 	authModule         *auth_module.AuthModule
 	fileModule         *file_module.FileModule
+	truModule          *tru_module.TRUModule
+	regionModule       *region_module.RegionModule
 	productModule      *product_module.ProductModule
+	parserModule       *parser_module.ParserModule
+	matcherModule      *matcher_module.MatcherModule
+	cartModule         *cart_module.CartModule
+	addressModule      *address_module.AddressModule
+	discountModule     *discount_module.DiscountModule
+	deliveryModule     *delivery_module.DeliveryModule
+	stockModule        *stock_module.StockModule
+	orderModule        *order_module.OrderModule
+	receiptModule      *receipt_module.ReceiptModule
+	paymentModule      *payment_module.PaymentModule
+	returnsModule      *returns_module.ReturnsModule
 }
 
 func NewModuleProvider(app *App) (*moduleProvider, error) {
@@ -36,7 +62,20 @@ func (p *moduleProvider) initDeps() error {
 		p.NotificationModule,
 		p.AuthModule,
 		p.FileModule,
+		p.RegionModule,
+		p.TRUModule,
 		p.ProductModule,
+		p.ParserModule,
+		p.MatcherModule,
+		p.CartModule,
+		p.AddressModule,
+		p.DiscountModule,
+		p.DeliveryModule,
+		p.StockModule,
+		p.OrderModule,
+		p.ReceiptModule,
+		p.PaymentModule,
+		p.ReturnsModule,
 	}
 	for _, init := range inits {
 		err := init()
@@ -87,6 +126,31 @@ func (p *moduleProvider) FileModule() error {
 	return nil
 }
 
+func (p *moduleProvider) TRUModule() error {
+	p.truModule = tru_module.NewTRUModule(
+		p.app.logger,
+		p.app.validator,
+		p.app.db,
+		p.app.uow,
+		p.regionModule.GetRegionUsecase(),
+	)
+	p.truModule.Init()
+	return nil
+}
+
+func (p *moduleProvider) RegionModule() error {
+	p.regionModule = region_module.NewRegionModule(
+		p.app.logger,
+		p.app.validator,
+		p.app.db,
+		p.app.config,
+		p.userModule.GetUserUsecase(),
+		p.authModule.GetSessionRepository(),
+	)
+	p.regionModule.Init()
+	return nil
+}
+
 func (p *moduleProvider) ProductModule() error {
 	p.productModule = product_module.NewProductModule(
 		p.app.logger,
@@ -96,7 +160,175 @@ func (p *moduleProvider) ProductModule() error {
 		p.fileModule.GetFileService(),
 		p.app.config,
 		p.app.redisManager,
+		p.truModule.GetTRUUsecase(),
+		p.regionModule.GetRegionUsecase(),
 	)
 	p.productModule.Init()
+	p.truModule.SetProductUsecase(p.productModule.GetProductUsecase())
+	return nil
+}
+
+func (p *moduleProvider) ParserModule() error {
+	p.parserModule = parser_module.NewParserModule(
+		p.app.logger,
+		p.app.db,
+		p.app.config,
+		p.truModule.GetTRUUsecase(),
+		p.regionModule.GetRegionUsecase(),
+		p.productModule.GetProductUsecase(),
+	)
+	p.parserModule.Init()
+	return nil
+}
+
+func (p *moduleProvider) MatcherModule() error {
+	p.matcherModule = matcher_module.NewMatcherModule(
+		p.app.logger,
+		p.app.validator,
+		p.app.db,
+		p.app.uow,
+		p.truModule.GetTRUUsecase(),
+		p.productModule.GetProductUsecase(),
+		p.productModule.GetCategoryUsecase(),
+	)
+	p.matcherModule.Init()
+	p.truModule.SetMatcherUsecase(p.matcherModule.GetMatcherUsecase())
+	return nil
+}
+
+func (p *moduleProvider) CartModule() error {
+	p.cartModule = cart_module.NewCartModule(
+		p.app.logger,
+		p.app.validator,
+		p.app.db,
+		p.app.uow,
+		p.app.config,
+		p.authModule.GetSessionRepository(),
+		p.productModule.GetProductUsecase(),
+	)
+	p.cartModule.Init()
+	p.authModule.GetAuthUsecase().SetCartMerger(p.cartModule.GetCartUsecase())
+	return nil
+}
+
+func (p *moduleProvider) AddressModule() error {
+	p.addressModule = address_module.NewAddressModule(
+		p.app.logger,
+		p.app.validator,
+		p.app.db,
+		p.app.uow,
+		p.authModule.GetSessionRepository(),
+		p.regionModule.GetRegionUsecase(),
+	)
+	p.addressModule.Init()
+	p.regionModule.GetRegionUsecase().SetAddressRegionProvider(p.addressModule.GetAddressUsecase())
+	p.authModule.GetAuthUsecase().SetAddressMerger(p.addressModule.GetAddressUsecase())
+	return nil
+}
+
+func (p *moduleProvider) DiscountModule() error {
+	p.discountModule = discount_module.NewDiscountModule(
+		p.app.logger,
+		p.app.validator,
+		p.app.db,
+		p.app.uow,
+		p.authModule.GetSessionRepository(),
+		p.cartModule.GetCartUsecase(),
+		p.productModule.GetProductUsecase(),
+	)
+	p.discountModule.Init()
+	return nil
+}
+
+func (p *moduleProvider) DeliveryModule() error {
+	p.deliveryModule = delivery_module.NewDeliveryModule(
+		p.app.logger,
+		p.app.validator,
+		p.app.db,
+		p.app.config,
+		p.productModule.GetProductUsecase(),
+		p.regionModule.GetRegionUsecase(),
+	)
+	p.deliveryModule.Init()
+	return nil
+}
+
+func (p *moduleProvider) StockModule() error {
+	p.stockModule = stock_module.NewStockModule(
+		p.app.logger,
+		p.app.validator,
+		p.app.db,
+		p.app.uow,
+		p.productModule.GetProductUsecase(),
+	)
+	p.stockModule.Init()
+	return nil
+}
+
+func (p *moduleProvider) OrderModule() error {
+	p.orderModule = order_module.NewOrderModule(
+		p.app.logger,
+		p.app.validator,
+		p.app.db,
+		p.app.uow,
+		p.app.config,
+		p.authModule.GetSessionRepository(),
+		p.cartModule.GetCartUsecase(),
+		p.deliveryModule.GetDeliveryUsecase(),
+		p.addressModule.GetAddressUsecase(),
+		p.discountModule.GetDiscountUsecase(),
+		p.regionModule.GetRegionUsecase(),
+		p.truModule.GetTRUUsecase(),
+		p.fileModule.GetFileService(),
+		p.userModule.GetUserUsecase(),
+		p.stockModule.GetStockUsecase(),
+		p.notificationModule.GetNotificationService(),
+	)
+	p.orderModule.Init()
+	return nil
+}
+
+func (p *moduleProvider) ReceiptModule() error {
+	p.receiptModule = receipt_module.NewReceiptModule(
+		p.app.logger,
+		p.app.validator,
+		p.app.db,
+		p.app.uow,
+		p.app.config,
+		p.orderModule.GetOrderUsecase(),
+	)
+	p.receiptModule.Init()
+	return nil
+}
+
+func (p *moduleProvider) PaymentModule() error {
+	p.paymentModule = payment_module.NewPaymentModule(
+		p.app.logger,
+		p.app.validator,
+		p.app.db,
+		p.app.uow,
+		p.app.config,
+		p.orderModule.GetOrderUsecase(),
+		p.receiptModule.GetReceiptUsecase(),
+	)
+	p.paymentModule.Init()
+	p.orderModule.SetPaymentUsecase(p.paymentModule.GetPaymentUsecase())
+	return nil
+}
+
+func (p *moduleProvider) ReturnsModule() error {
+	p.returnsModule = returns_module.NewReturnsModule(
+		p.app.logger,
+		p.app.validator,
+		p.app.db,
+		p.app.uow,
+		p.app.config,
+		p.authModule.GetSessionRepository(),
+		p.orderModule.GetOrderUsecase(),
+		p.paymentModule.GetPaymentUsecase(),
+		p.stockModule.GetStockUsecase(),
+		p.fileModule.GetFileService(),
+	)
+	p.returnsModule.Init()
 	return nil
 }
@@ -24,6 +24,7 @@ type Config struct {
 	PayKeeperUser   string `mapstructure:"PAY_KEEPER_USER"`
 	PayKeeperPass   string `mapstructure:"PAY_KEEPER_PASS"`
 	PayKeeperServer string `mapstructure:"PAY_KEEPER_SERVER"`
+	PayKeeperSecret string `mapstructure:"PAY_KEEPER_SECRET"`
 
 	SMTPTemplatePath string `mapstructure:"SMTP_TEMPLATE_PATH"`
 	SMTPHost         string `mapstructure:"SMTP_HOST"`
@@ -44,6 +45,48 @@ type Config struct {
 	ResetPassTokenExpiredIn time.Duration `mapstructure:"RESET_PASS_TOKEN_EXPIRED_IN"`
 	ResetPassURL            string        `mapstructure:"RESET_PASS_URL"`
 	ClienUrl                string        `mapstructure:"CLIENT_URL"`
+
+	DefaultRegionCode string `mapstructure:"DEFAULT_REGION_CODE"`
+
+	ParserEnabled         bool          `mapstructure:"PARSER_ENABLED"`
+	ParserBaseURL         string        `mapstructure:"PARSER_BASE_URL"`
+	ParserInterval        time.Duration `mapstructure:"PARSER_INTERVAL"`
+	ParserRequestInterval time.Duration `mapstructure:"PARSER_REQUEST_INTERVAL"`
+	ParserMaxRetries      int           `mapstructure:"PARSER_MAX_RETRIES"`
+	ParserPageSize        int           `mapstructure:"PARSER_PAGE_SIZE"`
+
+	CartCleanupInterval time.Duration `mapstructure:"CART_CLEANUP_INTERVAL"`
+	CartStaleAfter      time.Duration `mapstructure:"CART_STALE_AFTER"`
+
+	PDFFontDir        string `mapstructure:"PDF_FONT_DIR"`
+	SellerName        string `mapstructure:"SELLER_NAME"`
+	SellerINN         string `mapstructure:"SELLER_INN"`
+	SellerKPP         string `mapstructure:"SELLER_KPP"`
+	SellerAddress     string `mapstructure:"SELLER_ADDRESS"`
+	SellerBank        string `mapstructure:"SELLER_BANK"`
+	SellerBIK         string `mapstructure:"SELLER_BIK"`
+	SellerAccount     string `mapstructure:"SELLER_ACCOUNT"`
+	SellerCorrAccount string `mapstructure:"SELLER_CORR_ACCOUNT"`
+
+	StockReservationTTL  time.Duration `mapstructure:"STOCK_RESERVATION_TTL"`
+	StockReleaseInterval time.Duration `mapstructure:"STOCK_RELEASE_INTERVAL"`
+
+	OrderEmailTemplateDir     string `mapstructure:"ORDER_EMAIL_TEMPLATE_DIR"`
+	OrderEmailTemplateVersion string `mapstructure:"ORDER_EMAIL_TEMPLATE_VERSION"`
+
+	DeliveryWeightCharacteristic string  `mapstructure:"DELIVERY_WEIGHT_CHARACTERISTIC"`
+	DeliveryDefaultWeight        float64 `mapstructure:"DELIVERY_DEFAULT_WEIGHT"`
+
+	ReceiptProvider       string        `mapstructure:"RECEIPT_PROVIDER"`
+	ReceiptCompanyEmail   string        `mapstructure:"RECEIPT_COMPANY_EMAIL"`
+	ReceiptPaymentAddress string        `mapstructure:"RECEIPT_PAYMENT_ADDRESS"`
+	ReceiptSNO            string        `mapstructure:"RECEIPT_SNO"`
+	ReceiptVAT            string        `mapstructure:"RECEIPT_VAT"`
+	ReceiptSendInterval   time.Duration `mapstructure:"RECEIPT_SEND_INTERVAL"`
+	ATOLURL               string        `mapstructure:"ATOL_URL"`
+	ATOLLogin             string        `mapstructure:"ATOL_LOGIN"`
+	ATOLPassword          string        `mapstructure:"ATOL_PASSWORD"`
+	ATOLGroupCode         string        `mapstructure:"ATOL_GROUP_CODE"`
 }
 
 func validateConfig(config *Config) error {
@@ -97,6 +140,27 @@ func LoadConfig(path string) (config *Config, err error) {
 	// Automatically map environment variables
 	viper.AutomaticEnv()
 
+	viper.SetDefault("DEFAULT_REGION_CODE", "77")
+	viper.SetDefault("PARSER_ENABLED", false)
+	viper.SetDefault("PARSER_BASE_URL", "https://ktsr.sfr.gov.ru")
+	viper.SetDefault("PARSER_INTERVAL", "24h")
+	viper.SetDefault("PARSER_REQUEST_INTERVAL", "1s")
+	viper.SetDefault("PARSER_MAX_RETRIES", 3)
+	viper.SetDefault("PARSER_PAGE_SIZE", 100)
+	viper.SetDefault("CART_CLEANUP_INTERVAL", "1h")
+	viper.SetDefault("CART_STALE_AFTER", "168h")
+	viper.SetDefault("PDF_FONT_DIR", "/usr/share/fonts/truetype/dejavu")
+	viper.SetDefault("STOCK_RESERVATION_TTL", "30m")
+	viper.SetDefault("STOCK_RELEASE_INTERVAL", "1m")
+	viper.SetDefault("ORDER_EMAIL_TEMPLATE_DIR", "./internal/module/order/pkg/template/email")
+	viper.SetDefault("ORDER_EMAIL_TEMPLATE_VERSION", "v1")
+	viper.SetDefault("DELIVERY_WEIGHT_CHARACTERISTIC", "Вес")
+	viper.SetDefault("DELIVERY_DEFAULT_WEIGHT", 1)
+	viper.SetDefault("RECEIPT_SNO", "osn")
+	viper.SetDefault("RECEIPT_VAT", "vat20")
+	viper.SetDefault("RECEIPT_SEND_INTERVAL", "30s")
+	viper.SetDefault("ATOL_URL", "https://online.atol.ru/possystem/v4")
+
 	err = viper.ReadInConfig()
 	if err != nil {
 		return nil, err
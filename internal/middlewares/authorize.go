@@ -8,6 +8,7 @@ import (
 	"github.com/Fi44er/sdmed/internal/config"
 	auth_entity "github.com/Fi44er/sdmed/internal/module/auth/entity"
 	accessmanager_service "github.com/Fi44er/sdmed/internal/module/auth/usecase/access_manager"
+	region_usecase "github.com/Fi44er/sdmed/internal/module/region/usecase/region"
 	"github.com/Fi44er/sdmed/pkg/logger"
 	"github.com/Fi44er/sdmed/pkg/session"
 	"github.com/go-viper/mapstructure/v2"
@@ -39,6 +40,15 @@ func InjectManager(am *accessmanager_service.Manager) fiber.Handler {
 
 const ManagerKey = "accessManager"
 
+// InjectRegionCache запоминает регион покупателя на время запроса, чтобы товары,
+// корзина и оформление заказа не определяли его заново при каждом обращении
+func InjectRegionCache() fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		c.Locals(region_usecase.CurrentRegionKey, &region_usecase.CurrentRegionCache{})
+		return c.Next()
+	}
+}
+
 func ShadowSessionMiddleware(
 	shadowUserService IShadowUserService,
 	sessionRepository ISessionRepository,
@@ -0,0 +1,50 @@
+package address_http
+
+import (
+	address_dto "github.com/Fi44er/sdmed/internal/module/address/dto"
+	address_entity "github.com/Fi44er/sdmed/internal/module/address/entity"
+)
+
+type Converter struct{}
+
+func (c *Converter) ToEntity(dto *address_dto.AddressRequest) *address_entity.Address {
+	return &address_entity.Address{
+		Label:          dto.Label,
+		RegionID:       dto.RegionID,
+		City:           dto.City,
+		Street:         dto.Street,
+		House:          dto.House,
+		Apartment:      dto.Apartment,
+		PostalCode:     dto.PostalCode,
+		RecipientName:  dto.RecipientName,
+		RecipientPhone: dto.RecipientPhone,
+		IsDefault:      dto.IsDefault,
+	}
+}
+
+func (c *Converter) ToResponse(entity *address_entity.Address) *address_dto.AddressResponse {
+	return &address_dto.AddressResponse{
+		ID:             entity.ID,
+		Label:          entity.Label,
+		RegionID:       entity.RegionID,
+		City:           entity.City,
+		Street:         entity.Street,
+		House:          entity.House,
+		Apartment:      entity.Apartment,
+		PostalCode:     entity.PostalCode,
+		Formatted:      entity.Format(),
+		RecipientName:  entity.RecipientName,
+		RecipientPhone: entity.RecipientPhone,
+		IsDefault:      entity.IsDefault,
+		CreatedAt:      entity.CreatedAt,
+		UpdatedAt:      entity.UpdatedAt,
+	}
+}
+
+func (c *Converter) ToResponses(entities []address_entity.Address) []address_dto.AddressResponse {
+	responses := make([]address_dto.AddressResponse, len(entities))
+	for i := range entities {
+		responses[i] = *c.ToResponse(&entities[i])
+	}
+	return responses
+}
@@ -0,0 +1,208 @@
+package address_http
+
+import (
+	"context"
+
+	address_dto "github.com/Fi44er/sdmed/internal/module/address/dto"
+	address_entity "github.com/Fi44er/sdmed/internal/module/address/entity"
+	"github.com/Fi44er/sdmed/pkg/logger"
+	_ "github.com/Fi44er/sdmed/pkg/response"
+	"github.com/Fi44er/sdmed/pkg/session"
+	"github.com/Fi44er/sdmed/pkg/utils"
+	"github.com/go-playground/validator/v10"
+	"github.com/gofiber/fiber/v2"
+)
+
+type IAddressUsecase interface {
+	GetOwn(ctx context.Context) ([]address_entity.Address, error)
+	GetOwnByID(ctx context.Context, id string) (*address_entity.Address, error)
+	Create(ctx context.Context, address *address_entity.Address) (*address_entity.Address, error)
+	Update(ctx context.Context, address *address_entity.Address) (*address_entity.Address, error)
+	Delete(ctx context.Context, id string) error
+	SetDefault(ctx context.Context, id string) (*address_entity.Address, error)
+}
+
+type AddressHandler struct {
+	usecase IAddressUsecase
+
+	validator *validator.Validate
+	logger    *logger.Logger
+	converter *Converter
+}
+
+func NewAddressHandler(
+	usecase IAddressUsecase,
+	validator *validator.Validate,
+	logger *logger.Logger,
+) *AddressHandler {
+	return &AddressHandler{
+		usecase:   usecase,
+		validator: validator,
+		logger:    logger,
+		converter: &Converter{},
+	}
+}
+
+// GetOwn godoc
+// @Summary Get saved addresses
+// @Description Address book of the signed in user, the default address first
+// @Tags addresses
+// @Produce json
+// @Success 200 {object} response.ResponseData{data=[]address_dto.AddressResponse} "OK"
+// @Failure 401 {object} response.Response "Authentication required"
+// @Failure 500 {object} response.Response "Error"
+// @Router /addresses [get]
+func (h *AddressHandler) GetOwn(ctx *fiber.Ctx) error {
+	addresses, err := h.usecase.GetOwn(h.getCtxWithSession(ctx))
+	if err != nil {
+		return err
+	}
+
+	return ctx.Status(200).JSON(fiber.Map{
+		"status": "success",
+		"data":   h.converter.ToResponses(addresses),
+	})
+}
+
+// GetOwnByID godoc
+// @Summary Get saved address
+// @Tags addresses
+// @Produce json
+// @Param id path string true "Address ID"
+// @Success 200 {object} response.ResponseData{data=address_dto.AddressResponse} "OK"
+// @Failure 401 {object} response.Response "Authentication required"
+// @Failure 404 {object} response.Response "Address not found"
+// @Router /addresses/{id} [get]
+func (h *AddressHandler) GetOwnByID(ctx *fiber.Ctx) error {
+	address, err := h.usecase.GetOwnByID(h.getCtxWithSession(ctx), ctx.Params("id"))
+	if err != nil {
+		return err
+	}
+
+	return ctx.Status(200).JSON(fiber.Map{
+		"status": "success",
+		"data":   h.converter.ToResponse(address),
+	})
+}
+
+// Create godoc
+// @Summary Save address
+// @Description Add an address to the address book. The recipient phone is normalised to 11 digits starting with 7.
+// @Description The first address becomes the default one
+// @Tags addresses
+// @Accept json
+// @Produce json
+// @Param address body address_dto.AddressRequest true "Address"
+// @Success 201 {object} response.ResponseData{data=address_dto.AddressResponse} "Created"
+// @Failure 400 {object} response.Response "Invalid address, phone or region"
+// @Failure 401 {object} response.Response "Authentication required"
+// @Failure 409 {object} response.Response "Address book is full"
+// @Router /addresses [post]
+func (h *AddressHandler) Create(ctx *fiber.Ctx) error {
+	dto := new(address_dto.AddressRequest)
+
+	entity, err := utils.ParseAndValidate(ctx, dto, h.validator, h.converter.ToEntity, h.logger)
+	if err != nil {
+		return ctx.Status(400).JSON(fiber.Map{
+			"status":  "fail",
+			"message": err.Error(),
+		})
+	}
+
+	address, err := h.usecase.Create(h.getCtxWithSession(ctx), entity)
+	if err != nil {
+		return err
+	}
+
+	return ctx.Status(201).JSON(fiber.Map{
+		"status": "success",
+		"data":   h.converter.ToResponse(address),
+	})
+}
+
+// Update godoc
+// @Summary Update saved address
+// @Description Update an address. is_default=true makes it the default one, false keeps the current default
+// @Tags addresses
+// @Accept json
+// @Produce json
+// @Param id path string true "Address ID"
+// @Param address body address_dto.AddressRequest true "Address"
+// @Success 200 {object} response.ResponseData{data=address_dto.AddressResponse} "OK"
+// @Failure 400 {object} response.Response "Invalid address, phone or region"
+// @Failure 401 {object} response.Response "Authentication required"
+// @Failure 404 {object} response.Response "Address not found"
+// @Router /addresses/{id} [put]
+func (h *AddressHandler) Update(ctx *fiber.Ctx) error {
+	dto := new(address_dto.AddressRequest)
+
+	entity, err := utils.ParseAndValidate(ctx, dto, h.validator, h.converter.ToEntity, h.logger)
+	if err != nil {
+		return ctx.Status(400).JSON(fiber.Map{
+			"status":  "fail",
+			"message": err.Error(),
+		})
+	}
+	entity.ID = ctx.Params("id")
+
+	address, err := h.usecase.Update(h.getCtxWithSession(ctx), entity)
+	if err != nil {
+		return err
+	}
+
+	return ctx.Status(200).JSON(fiber.Map{
+		"status": "success",
+		"data":   h.converter.ToResponse(address),
+	})
+}
+
+// Delete godoc
+// @Summary Delete saved address
+// @Description Delete an address. If it was the default one, the newest remaining address becomes the default
+// @Tags addresses
+// @Produce json
+// @Param id path string true "Address ID"
+// @Success 200 {object} response.Response "OK"
+// @Failure 401 {object} response.Response "Authentication required"
+// @Failure 404 {object} response.Response "Address not found"
+// @Router /addresses/{id} [delete]
+func (h *AddressHandler) Delete(ctx *fiber.Ctx) error {
+	if err := h.usecase.Delete(h.getCtxWithSession(ctx), ctx.Params("id")); err != nil {
+		return err
+	}
+
+	return ctx.Status(200).JSON(fiber.Map{
+		"status":  "success",
+		"message": "address deleted successfully",
+	})
+}
+
+// SetDefault godoc
+// @Summary Make address default
+// @Tags addresses
+// @Produce json
+// @Param id path string true "Address ID"
+// @Success 200 {object} response.ResponseData{data=address_dto.AddressResponse} "OK"
+// @Failure 401 {object} response.Response "Authentication required"
+// @Failure 404 {object} response.Response "Address not found"
+// @Router /addresses/{id}/default [post]
+func (h *AddressHandler) SetDefault(ctx *fiber.Ctx) error {
+	address, err := h.usecase.SetDefault(h.getCtxWithSession(ctx), ctx.Params("id"))
+	if err != nil {
+		return err
+	}
+
+	return ctx.Status(200).JSON(fiber.Map{
+		"status": "success",
+		"data":   h.converter.ToResponse(address),
+	})
+}
+
+func (h *AddressHandler) getCtxWithSession(ctx *fiber.Ctx) context.Context {
+	sess := session.FromFiberContext(ctx)
+	if sess == nil {
+		return ctx.Context()
+	}
+
+	return context.WithValue(ctx.Context(), "session", *sess)
+}
@@ -0,0 +1,16 @@
+package address_http
+
+import (
+	"github.com/Fi44er/sdmed/internal/middlewares"
+	"github.com/gofiber/fiber/v2"
+)
+
+func (h *AddressHandler) RegisterRoutes(router fiber.Router) {
+	addresses := router.Group("/addresses", middlewares.RequireAuth())
+	addresses.Get("/", h.GetOwn)
+	addresses.Post("/", h.Create)
+	addresses.Get("/:id", h.GetOwnByID)
+	addresses.Put("/:id", h.Update)
+	addresses.Delete("/:id", h.Delete)
+	addresses.Post("/:id/default", h.SetDefault)
+}
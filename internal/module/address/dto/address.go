@@ -0,0 +1,37 @@
+package address_dto
+
+import "time"
+
+type AddressRequest struct {
+	Label      string `json:"label" validate:"max=100"`
+	RegionID   string `json:"region_id" validate:"omitempty,uuid"`
+	City       string `json:"city" validate:"required,max=255"`
+	Street     string `json:"street" validate:"required,max=255"`
+	House      string `json:"house" validate:"required,max=50"`
+	Apartment  string `json:"apartment" validate:"max=50"`
+	PostalCode string `json:"postal_code" validate:"omitempty,numeric,len=6"`
+
+	RecipientName  string `json:"recipient_name" validate:"required,max=255"`
+	RecipientPhone string `json:"recipient_phone" validate:"required,max=20"`
+
+	IsDefault bool `json:"is_default"`
+}
+
+type AddressResponse struct {
+	ID         string `json:"id"`
+	Label      string `json:"label,omitempty"`
+	RegionID   string `json:"region_id,omitempty"`
+	City       string `json:"city"`
+	Street     string `json:"street"`
+	House      string `json:"house"`
+	Apartment  string `json:"apartment,omitempty"`
+	PostalCode string `json:"postal_code,omitempty"`
+	Formatted  string `json:"formatted"`
+
+	RecipientName  string `json:"recipient_name"`
+	RecipientPhone string `json:"recipient_phone"`
+
+	IsDefault bool      `json:"is_default"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
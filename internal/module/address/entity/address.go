@@ -0,0 +1,87 @@
+package address_entity
+
+import (
+	"strings"
+	"time"
+	"unicode"
+)
+
+// Address - сохраненный адрес доставки покупателя
+type Address struct {
+	ID         string
+	UserID     string
+	Label      string
+	RegionID   string
+	City       string
+	Street     string
+	House      string
+	Apartment  string
+	PostalCode string
+
+	RecipientName  string
+	RecipientPhone string
+
+	IsDefault bool
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+// NormalizePhone приводит телефон к 11 цифрам с кодом страны 7, как его хранит
+// профиль пользователя: +7 (999) 123-45-67, 8 999 123 45 67 и 9991234567 дают 79991234567
+func NormalizePhone(phone string) (string, bool) {
+	digits := strings.Map(func(r rune) rune {
+		if unicode.IsDigit(r) {
+			return r
+		}
+		return -1
+	}, phone)
+
+	switch {
+	case len(digits) == 10:
+		digits = "7" + digits
+	case len(digits) == 11 && digits[0] == '8':
+		digits = "7" + digits[1:]
+	}
+	if len(digits) != 11 || digits[0] != '7' {
+		return "", false
+	}
+	return digits, true
+}
+
+// Normalize убирает лишние пробелы и приводит телефон получателя к формату профиля
+func (a *Address) Normalize() bool {
+	for _, field := range []*string{&a.Label, &a.City, &a.Street, &a.House, &a.Apartment, &a.PostalCode, &a.RecipientName} {
+		*field = strings.Join(strings.Fields(*field), " ")
+	}
+
+	phone, ok := NormalizePhone(a.RecipientPhone)
+	if !ok {
+		return false
+	}
+	a.RecipientPhone = phone
+	return true
+}
+
+// Format - адрес одной строкой для заказа и печатных форм
+func (a *Address) Format() string {
+	parts := make([]string, 0, 5)
+	if a.PostalCode != "" {
+		parts = append(parts, a.PostalCode)
+	}
+	parts = append(parts, a.City, a.Street, "д. "+a.House)
+	if a.Apartment != "" {
+		parts = append(parts, "кв. "+a.Apartment)
+	}
+	return strings.Join(parts, ", ")
+}
+
+// SameAs - адреса совпадают по месту доставки и получателю, метка не учитывается
+func (a *Address) SameAs(other *Address) bool {
+	return strings.EqualFold(a.City, other.City) &&
+		strings.EqualFold(a.Street, other.Street) &&
+		strings.EqualFold(a.House, other.House) &&
+		strings.EqualFold(a.Apartment, other.Apartment) &&
+		a.PostalCode == other.PostalCode &&
+		a.RecipientPhone == other.RecipientPhone &&
+		strings.EqualFold(a.RecipientName, other.RecipientName)
+}
@@ -0,0 +1,37 @@
+package address_entity
+
+import "testing"
+
+func TestNormalizePhone(t *testing.T) {
+	tests := []struct {
+		phone string
+		want  string
+		ok    bool
+	}{
+		{"+7 (999) 123-45-67", "79991234567", true},
+		{"8 999 123 45 67", "79991234567", true},
+		{"9991234567", "79991234567", true},
+		{"79991234567", "79991234567", true},
+		{"+1 999 123 45 67", "", false},
+		{"123-45-67", "", false},
+	}
+
+	for _, tt := range tests {
+		got, ok := NormalizePhone(tt.phone)
+		if got != tt.want || ok != tt.ok {
+			t.Errorf("NormalizePhone(%q) = %q, %v, want %q, %v", tt.phone, got, ok, tt.want, tt.ok)
+		}
+	}
+}
+
+func TestFormat(t *testing.T) {
+	address := &Address{PostalCode: "620014", City: "Екатеринбург", Street: "ул. Ленина", House: "5", Apartment: "12"}
+	if got := address.Format(); got != "620014, Екатеринбург, ул. Ленина, д. 5, кв. 12" {
+		t.Errorf("Format() = %q", got)
+	}
+
+	address.PostalCode, address.Apartment = "", ""
+	if got := address.Format(); got != "Екатеринбург, ул. Ленина, д. 5" {
+		t.Errorf("Format() without postal code and apartment = %q", got)
+	}
+}
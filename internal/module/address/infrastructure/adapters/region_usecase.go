@@ -0,0 +1,33 @@
+package address_adapters
+
+import (
+	"context"
+	"errors"
+
+	region_constant "github.com/Fi44er/sdmed/internal/module/region/pkg"
+	region_usecase "github.com/Fi44er/sdmed/internal/module/region/usecase/region"
+)
+
+type IRegionUsecaseAdapter interface {
+	Exists(ctx context.Context, regionID string) (bool, error)
+}
+
+type RegionUsecaseAdapter struct {
+	regionUsecase region_usecase.IRegionUsecase
+}
+
+func NewRegionUsecaseAdapter(regionUsecase region_usecase.IRegionUsecase) IRegionUsecaseAdapter {
+	return &RegionUsecaseAdapter{
+		regionUsecase: regionUsecase,
+	}
+}
+
+func (a *RegionUsecaseAdapter) Exists(ctx context.Context, regionID string) (bool, error) {
+	if _, err := a.regionUsecase.GetByID(ctx, regionID); err != nil {
+		if errors.Is(err, region_constant.ErrRegionNotFound) {
+			return false, nil
+		}
+		return false, err
+	}
+	return true, nil
+}
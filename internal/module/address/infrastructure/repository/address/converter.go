@@ -0,0 +1,58 @@
+package address_repository
+
+import (
+	address_entity "github.com/Fi44er/sdmed/internal/module/address/entity"
+	address_model "github.com/Fi44er/sdmed/internal/module/address/infrastructure/repository/model"
+)
+
+type Converter struct{}
+
+func (c *Converter) ToModel(entity *address_entity.Address) *address_model.Address {
+	return &address_model.Address{
+		ID:             entity.ID,
+		UserID:         entity.UserID,
+		Label:          entity.Label,
+		RegionID:       optional(entity.RegionID),
+		City:           entity.City,
+		Street:         entity.Street,
+		House:          entity.House,
+		Apartment:      entity.Apartment,
+		PostalCode:     entity.PostalCode,
+		RecipientName:  entity.RecipientName,
+		RecipientPhone: entity.RecipientPhone,
+		IsDefault:      entity.IsDefault,
+	}
+}
+
+func (c *Converter) ToEntity(model *address_model.Address) *address_entity.Address {
+	return &address_entity.Address{
+		ID:             model.ID,
+		UserID:         model.UserID,
+		Label:          model.Label,
+		RegionID:       value(model.RegionID),
+		City:           model.City,
+		Street:         model.Street,
+		House:          model.House,
+		Apartment:      model.Apartment,
+		PostalCode:     model.PostalCode,
+		RecipientName:  model.RecipientName,
+		RecipientPhone: model.RecipientPhone,
+		IsDefault:      model.IsDefault,
+		CreatedAt:      model.CreatedAt,
+		UpdatedAt:      model.UpdatedAt,
+	}
+}
+
+func optional(value string) *string {
+	if value == "" {
+		return nil
+	}
+	return &value
+}
+
+func value(ptr *string) string {
+	if ptr == nil {
+		return ""
+	}
+	return *ptr
+}
@@ -0,0 +1,167 @@
+package address_repository
+
+import (
+	"context"
+
+	address_entity "github.com/Fi44er/sdmed/internal/module/address/entity"
+	address_model "github.com/Fi44er/sdmed/internal/module/address/infrastructure/repository/model"
+	"github.com/Fi44er/sdmed/pkg/logger"
+	"gorm.io/gorm"
+)
+
+type IAddressRepository interface {
+	GetByUserID(ctx context.Context, userID string) ([]address_entity.Address, error)
+	GetByID(ctx context.Context, id string) (*address_entity.Address, error)
+	Count(ctx context.Context, userID string) (int64, error)
+	Create(ctx context.Context, address *address_entity.Address) error
+	Update(ctx context.Context, address *address_entity.Address) error
+	Delete(ctx context.Context, id string) error
+	SetDefault(ctx context.Context, userID, id string) error
+	MoveToUser(ctx context.Context, fromUserID, toUserID string) error
+}
+
+type AddressRepository struct {
+	logger    *logger.Logger
+	db        *gorm.DB
+	converter *Converter
+}
+
+func NewAddressRepository(logger *logger.Logger, db *gorm.DB) IAddressRepository {
+	return &AddressRepository{
+		logger:    logger,
+		db:        db,
+		converter: &Converter{},
+	}
+}
+
+// GetByUserID возвращает адреса пользователя: сначала адрес по умолчанию, затем новые
+func (r *AddressRepository) GetByUserID(ctx context.Context, userID string) ([]address_entity.Address, error) {
+	var addressModels []address_model.Address
+	err := r.db.WithContext(ctx).
+		Where("user_id = ?", userID).
+		Order("is_default DESC, created_at DESC").
+		Find(&addressModels).Error
+	if err != nil {
+		r.logger.Errorf("Failed to get addresses of user %s: %v", userID, err)
+		return nil, err
+	}
+
+	addresses := make([]address_entity.Address, len(addressModels))
+	for i := range addressModels {
+		addresses[i] = *r.converter.ToEntity(&addressModels[i])
+	}
+
+	return addresses, nil
+}
+
+func (r *AddressRepository) GetByID(ctx context.Context, id string) (*address_entity.Address, error) {
+	var addressModel address_model.Address
+	if err := r.db.WithContext(ctx).First(&addressModel, "id = ?", id).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, nil
+		}
+		r.logger.Errorf("Failed to get address %s: %v", id, err)
+		return nil, err
+	}
+
+	return r.converter.ToEntity(&addressModel), nil
+}
+
+func (r *AddressRepository) Count(ctx context.Context, userID string) (int64, error) {
+	var count int64
+	err := r.db.WithContext(ctx).
+		Model(&address_model.Address{}).
+		Where("user_id = ?", userID).
+		Count(&count).Error
+	if err != nil {
+		r.logger.Errorf("Failed to count addresses of user %s: %v", userID, err)
+		return 0, err
+	}
+
+	return count, nil
+}
+
+func (r *AddressRepository) Create(ctx context.Context, address *address_entity.Address) error {
+	r.logger.Infof("Creating address for user: %s", address.UserID)
+
+	addressModel := r.converter.ToModel(address)
+	if err := r.db.WithContext(ctx).Create(addressModel).Error; err != nil {
+		r.logger.Errorf("Failed to create address for user %s: %v", address.UserID, err)
+		return err
+	}
+	address.ID = addressModel.ID
+	address.CreatedAt = addressModel.CreatedAt
+	address.UpdatedAt = addressModel.UpdatedAt
+
+	return nil
+}
+
+// Update меняет поля адреса. Признак адреса по умолчанию меняется только через SetDefault
+func (r *AddressRepository) Update(ctx context.Context, address *address_entity.Address) error {
+	r.logger.Infof("Updating address: %s", address.ID)
+
+	err := r.db.WithContext(ctx).
+		Model(&address_model.Address{}).
+		Where("id = ?", address.ID).
+		Updates(map[string]any{
+			"label":           address.Label,
+			"region_id":       optional(address.RegionID),
+			"city":            address.City,
+			"street":          address.Street,
+			"house":           address.House,
+			"apartment":       address.Apartment,
+			"postal_code":     address.PostalCode,
+			"recipient_name":  address.RecipientName,
+			"recipient_phone": address.RecipientPhone,
+			"updated_at":      gorm.Expr("now()"),
+		}).Error
+	if err != nil {
+		r.logger.Errorf("Failed to update address %s: %v", address.ID, err)
+		return err
+	}
+
+	return nil
+}
+
+func (r *AddressRepository) Delete(ctx context.Context, id string) error {
+	r.logger.Infof("Deleting address: %s", id)
+
+	if err := r.db.WithContext(ctx).Delete(&address_model.Address{}, "id = ?", id).Error; err != nil {
+		r.logger.Errorf("Failed to delete address %s: %v", id, err)
+		return err
+	}
+
+	return nil
+}
+
+// SetDefault делает адрес id единственным адресом пользователя по умолчанию
+func (r *AddressRepository) SetDefault(ctx context.Context, userID, id string) error {
+	err := r.db.WithContext(ctx).
+		Model(&address_model.Address{}).
+		Where("user_id = ?", userID).
+		Update("is_default", gorm.Expr("id = ?", id)).Error
+	if err != nil {
+		r.logger.Errorf("Failed to set default address %s of user %s: %v", id, userID, err)
+		return err
+	}
+
+	return nil
+}
+
+// MoveToUser переносит адреса гостя пользователю. Перенесенные адреса не становятся адресами по умолчанию
+func (r *AddressRepository) MoveToUser(ctx context.Context, fromUserID, toUserID string) error {
+	err := r.db.WithContext(ctx).
+		Model(&address_model.Address{}).
+		Where("user_id = ?", fromUserID).
+		Updates(map[string]any{
+			"user_id":    toUserID,
+			"is_default": false,
+			"updated_at": gorm.Expr("now()"),
+		}).Error
+	if err != nil {
+		r.logger.Errorf("Failed to move addresses of user %s to user %s: %v", fromUserID, toUserID, err)
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,26 @@
+package address_model
+
+import "time"
+
+type Address struct {
+	ID         string  `gorm:"primaryKey;type:uuid;default:uuid_generate_v4();"`
+	UserID     string  `gorm:"type:uuid;not null;index"`
+	Label      string  `gorm:"type:varchar(100);not null;default:''"`
+	RegionID   *string `gorm:"type:uuid"`
+	City       string  `gorm:"type:varchar(255);not null"`
+	Street     string  `gorm:"type:varchar(255);not null"`
+	House      string  `gorm:"type:varchar(50);not null"`
+	Apartment  string  `gorm:"type:varchar(50);not null;default:''"`
+	PostalCode string  `gorm:"type:varchar(6);not null;default:''"`
+
+	RecipientName  string `gorm:"type:varchar(255);not null"`
+	RecipientPhone string `gorm:"type:varchar(11);not null"`
+
+	IsDefault bool      `gorm:"not null;default:false"`
+	CreatedAt time.Time `gorm:"not null;default:now()"`
+	UpdatedAt time.Time `gorm:"not null;default:now()"`
+}
+
+func (Address) TableName() string {
+	return "address_module.addresses"
+}
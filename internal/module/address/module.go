@@ -0,0 +1,71 @@
+package address_module
+
+import (
+	address_http "github.com/Fi44er/sdmed/internal/module/address/delivery/http"
+	address_adapters "github.com/Fi44er/sdmed/internal/module/address/infrastructure/adapters"
+	address_repository "github.com/Fi44er/sdmed/internal/module/address/infrastructure/repository/address"
+	address_usecase "github.com/Fi44er/sdmed/internal/module/address/usecase/address"
+	address_usecase_contracts "github.com/Fi44er/sdmed/internal/module/address/usecase/address/contracts"
+	region_usecase "github.com/Fi44er/sdmed/internal/module/region/usecase/region"
+	"github.com/Fi44er/sdmed/pkg/logger"
+	"github.com/Fi44er/sdmed/pkg/postgres/uow"
+	"github.com/go-playground/validator/v10"
+	"github.com/gofiber/fiber/v2"
+	"gorm.io/gorm"
+)
+
+type AddressModule struct {
+	addressRepository address_repository.IAddressRepository
+	addressUsecase    address_usecase.IAddressUsecase
+	addressHandler    *address_http.AddressHandler
+
+	sessionRepository address_usecase_contracts.ISessionRepository
+	regionUsecase     region_usecase.IRegionUsecase
+
+	logger    *logger.Logger
+	validator *validator.Validate
+	db        *gorm.DB
+	uow       uow.Uow
+}
+
+func NewAddressModule(
+	logger *logger.Logger,
+	validator *validator.Validate,
+	db *gorm.DB,
+	uow uow.Uow,
+	sessionRepository address_usecase_contracts.ISessionRepository,
+	regionUsecase region_usecase.IRegionUsecase,
+) *AddressModule {
+	return &AddressModule{
+		logger:            logger,
+		validator:         validator,
+		db:                db,
+		uow:               uow,
+		sessionRepository: sessionRepository,
+		regionUsecase:     regionUsecase,
+	}
+}
+
+func (m *AddressModule) Init() {
+	m.uow.RegisterRepository("address", func(tx *gorm.DB) (any, error) {
+		return address_repository.NewAddressRepository(m.logger, tx), nil
+	})
+
+	m.addressRepository = address_repository.NewAddressRepository(m.logger, m.db)
+	m.addressUsecase = address_usecase.NewAddressUsecase(
+		m.addressRepository,
+		m.sessionRepository,
+		address_adapters.NewRegionUsecaseAdapter(m.regionUsecase),
+		m.uow,
+		m.logger,
+	)
+	m.addressHandler = address_http.NewAddressHandler(m.addressUsecase, m.validator, m.logger)
+}
+
+func (m *AddressModule) InitDelivery(router fiber.Router) {
+	m.addressHandler.RegisterRoutes(router)
+}
+
+func (m *AddressModule) GetAddressUsecase() address_usecase.IAddressUsecase {
+	return m.addressUsecase
+}
@@ -0,0 +1,14 @@
+package address_constant
+
+import "github.com/Fi44er/sdmed/pkg/customerr"
+
+// MaxAddresses - ограничение размера адресной книги одного пользователя
+const MaxAddresses = 20
+
+var (
+	ErrAddressNotFound     = customerr.NewError(404, "address not found")
+	ErrInvalidPhone        = customerr.NewError(400, "invalid recipient phone number")
+	ErrRegionNotFound      = customerr.NewError(400, "region not found")
+	ErrTooManyAddresses    = customerr.NewError(409, "address book is full")
+	ErrSessionUserNotFound = customerr.NewError(401, "session user not found")
+)
@@ -0,0 +1,27 @@
+package address_usecase_contracts
+
+import (
+	"context"
+
+	address_entity "github.com/Fi44er/sdmed/internal/module/address/entity"
+	auth_entity "github.com/Fi44er/sdmed/internal/module/auth/entity"
+)
+
+type IAddressRepository interface {
+	GetByUserID(ctx context.Context, userID string) ([]address_entity.Address, error)
+	GetByID(ctx context.Context, id string) (*address_entity.Address, error)
+	Count(ctx context.Context, userID string) (int64, error)
+	Create(ctx context.Context, address *address_entity.Address) error
+	Update(ctx context.Context, address *address_entity.Address) error
+	Delete(ctx context.Context, id string) error
+	SetDefault(ctx context.Context, userID, id string) error
+	MoveToUser(ctx context.Context, fromUserID, toUserID string) error
+}
+
+type ISessionRepository interface {
+	GetSessionInfo(ctx context.Context) (*auth_entity.ActiveSession, error)
+}
+
+type IRegionUsecaseAdapter interface {
+	Exists(ctx context.Context, regionID string) (bool, error)
+}
@@ -0,0 +1,228 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./address/contracts/contracts.go
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	context "context"
+	reflect "reflect"
+
+	address_entity "github.com/Fi44er/sdmed/internal/module/address/entity"
+	auth_entity "github.com/Fi44er/sdmed/internal/module/auth/entity"
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockIAddressRepository is a mock of IAddressRepository interface.
+type MockIAddressRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockIAddressRepositoryMockRecorder
+}
+
+// MockIAddressRepositoryMockRecorder is the mock recorder for MockIAddressRepository.
+type MockIAddressRepositoryMockRecorder struct {
+	mock *MockIAddressRepository
+}
+
+// NewMockIAddressRepository creates a new mock instance.
+func NewMockIAddressRepository(ctrl *gomock.Controller) *MockIAddressRepository {
+	mock := &MockIAddressRepository{ctrl: ctrl}
+	mock.recorder = &MockIAddressRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockIAddressRepository) EXPECT() *MockIAddressRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Count mocks base method.
+func (m *MockIAddressRepository) Count(ctx context.Context, userID string) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Count", ctx, userID)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Count indicates an expected call of Count.
+func (mr *MockIAddressRepositoryMockRecorder) Count(ctx, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Count", reflect.TypeOf((*MockIAddressRepository)(nil).Count), ctx, userID)
+}
+
+// Create mocks base method.
+func (m *MockIAddressRepository) Create(ctx context.Context, address *address_entity.Address) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, address)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockIAddressRepositoryMockRecorder) Create(ctx, address interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockIAddressRepository)(nil).Create), ctx, address)
+}
+
+// Delete mocks base method.
+func (m *MockIAddressRepository) Delete(ctx context.Context, id string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockIAddressRepositoryMockRecorder) Delete(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockIAddressRepository)(nil).Delete), ctx, id)
+}
+
+// GetByID mocks base method.
+func (m *MockIAddressRepository) GetByID(ctx context.Context, id string) (*address_entity.Address, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByID", ctx, id)
+	ret0, _ := ret[0].(*address_entity.Address)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByID indicates an expected call of GetByID.
+func (mr *MockIAddressRepositoryMockRecorder) GetByID(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByID", reflect.TypeOf((*MockIAddressRepository)(nil).GetByID), ctx, id)
+}
+
+// GetByUserID mocks base method.
+func (m *MockIAddressRepository) GetByUserID(ctx context.Context, userID string) ([]address_entity.Address, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByUserID", ctx, userID)
+	ret0, _ := ret[0].([]address_entity.Address)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByUserID indicates an expected call of GetByUserID.
+func (mr *MockIAddressRepositoryMockRecorder) GetByUserID(ctx, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByUserID", reflect.TypeOf((*MockIAddressRepository)(nil).GetByUserID), ctx, userID)
+}
+
+// MoveToUser mocks base method.
+func (m *MockIAddressRepository) MoveToUser(ctx context.Context, fromUserID, toUserID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MoveToUser", ctx, fromUserID, toUserID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// MoveToUser indicates an expected call of MoveToUser.
+func (mr *MockIAddressRepositoryMockRecorder) MoveToUser(ctx, fromUserID, toUserID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MoveToUser", reflect.TypeOf((*MockIAddressRepository)(nil).MoveToUser), ctx, fromUserID, toUserID)
+}
+
+// SetDefault mocks base method.
+func (m *MockIAddressRepository) SetDefault(ctx context.Context, userID, id string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetDefault", ctx, userID, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetDefault indicates an expected call of SetDefault.
+func (mr *MockIAddressRepositoryMockRecorder) SetDefault(ctx, userID, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetDefault", reflect.TypeOf((*MockIAddressRepository)(nil).SetDefault), ctx, userID, id)
+}
+
+// Update mocks base method.
+func (m *MockIAddressRepository) Update(ctx context.Context, address *address_entity.Address) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", ctx, address)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockIAddressRepositoryMockRecorder) Update(ctx, address interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockIAddressRepository)(nil).Update), ctx, address)
+}
+
+// MockISessionRepository is a mock of ISessionRepository interface.
+type MockISessionRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockISessionRepositoryMockRecorder
+}
+
+// MockISessionRepositoryMockRecorder is the mock recorder for MockISessionRepository.
+type MockISessionRepositoryMockRecorder struct {
+	mock *MockISessionRepository
+}
+
+// NewMockISessionRepository creates a new mock instance.
+func NewMockISessionRepository(ctrl *gomock.Controller) *MockISessionRepository {
+	mock := &MockISessionRepository{ctrl: ctrl}
+	mock.recorder = &MockISessionRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockISessionRepository) EXPECT() *MockISessionRepositoryMockRecorder {
+	return m.recorder
+}
+
+// GetSessionInfo mocks base method.
+func (m *MockISessionRepository) GetSessionInfo(ctx context.Context) (*auth_entity.ActiveSession, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSessionInfo", ctx)
+	ret0, _ := ret[0].(*auth_entity.ActiveSession)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSessionInfo indicates an expected call of GetSessionInfo.
+func (mr *MockISessionRepositoryMockRecorder) GetSessionInfo(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSessionInfo", reflect.TypeOf((*MockISessionRepository)(nil).GetSessionInfo), ctx)
+}
+
+// MockIRegionUsecaseAdapter is a mock of IRegionUsecaseAdapter interface.
+type MockIRegionUsecaseAdapter struct {
+	ctrl     *gomock.Controller
+	recorder *MockIRegionUsecaseAdapterMockRecorder
+}
+
+// MockIRegionUsecaseAdapterMockRecorder is the mock recorder for MockIRegionUsecaseAdapter.
+type MockIRegionUsecaseAdapterMockRecorder struct {
+	mock *MockIRegionUsecaseAdapter
+}
+
+// NewMockIRegionUsecaseAdapter creates a new mock instance.
+func NewMockIRegionUsecaseAdapter(ctrl *gomock.Controller) *MockIRegionUsecaseAdapter {
+	mock := &MockIRegionUsecaseAdapter{ctrl: ctrl}
+	mock.recorder = &MockIRegionUsecaseAdapterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockIRegionUsecaseAdapter) EXPECT() *MockIRegionUsecaseAdapterMockRecorder {
+	return m.recorder
+}
+
+// Exists mocks base method.
+func (m *MockIRegionUsecaseAdapter) Exists(ctx context.Context, regionID string) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Exists", ctx, regionID)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Exists indicates an expected call of Exists.
+func (mr *MockIRegionUsecaseAdapterMockRecorder) Exists(ctx, regionID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Exists", reflect.TypeOf((*MockIRegionUsecaseAdapter)(nil).Exists), ctx, regionID)
+}
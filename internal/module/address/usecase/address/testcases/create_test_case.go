@@ -0,0 +1,175 @@
+package address_testcases
+
+import (
+	"context"
+	"errors"
+
+	address_entity "github.com/Fi44er/sdmed/internal/module/address/entity"
+	address_constant "github.com/Fi44er/sdmed/internal/module/address/pkg"
+	"github.com/Fi44er/sdmed/internal/module/address/usecase/address/mock"
+	auth_entity "github.com/Fi44er/sdmed/internal/module/auth/entity"
+	uow_mock "github.com/Fi44er/sdmed/pkg/postgres/uow/mock"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+type MockCreate struct {
+	Ctrl            *gomock.Controller
+	Ctx             context.Context
+	RepoMock        *mock.MockIAddressRepository
+	SessionRepoMock *mock.MockISessionRepository
+	RegionMock      *mock.MockIRegionUsecaseAdapter
+	UowMock         *uow_mock.MockUow
+	T               assert.TestingT
+}
+
+type CreateTestCase struct {
+	Name            string
+	Address         *address_entity.Address
+	SetupMocks      func(m *MockCreate)
+	ExpectedAddress *address_entity.Address
+	ExpectedError   error
+}
+
+const (
+	UserID    = "user"
+	GuestID   = "guest"
+	AddressID = "00000000-0000-0000-0000-000000000001"
+	RegionID  = "sverdlovsk"
+)
+
+// NewAddress - адрес в том виде, в котором его вводит покупатель
+func NewAddress(street string) *address_entity.Address {
+	return &address_entity.Address{
+		City:           "Екатеринбург",
+		Street:         street,
+		House:          "5",
+		RecipientName:  "Иванов Иван",
+		RecipientPhone: "8 (912) 345-67-89",
+	}
+}
+
+// stored - сохраненный адрес с нормализованным телефоном
+func stored(id, userID, street string, isDefault bool) address_entity.Address {
+	address := NewAddress(street)
+	address.ID, address.UserID, address.IsDefault, address.RecipientPhone = id, userID, isDefault, "79123456789"
+	return *address
+}
+
+func expectSession(ctx context.Context, sessionRepoMock *mock.MockISessionRepository) {
+	sessionRepoMock.EXPECT().GetSessionInfo(ctx).Return(&auth_entity.ActiveSession{UserID: UserID}, nil)
+}
+
+func expectTx(ctx context.Context, uowMock *uow_mock.MockUow, repo any) {
+	uowMock.EXPECT().
+		Do(ctx, gomock.Any()).
+		DoAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+			return fn(ctx)
+		})
+	uowMock.EXPECT().GetRepository(ctx, "address").Return(repo, nil)
+}
+
+func expectCreate(m *MockCreate) {
+	m.RepoMock.EXPECT().
+		Create(m.Ctx, gomock.Any()).
+		DoAndReturn(func(ctx context.Context, address *address_entity.Address) error {
+			address.ID = AddressID
+			return nil
+		})
+}
+
+func GetCreateTestCases() []CreateTestCase {
+	withDefault := func() *address_entity.Address {
+		address := NewAddress("ул. Гагарина")
+		address.IsDefault = true
+		return address
+	}
+	withPhone := func(phone string) *address_entity.Address {
+		address := NewAddress("ул. Мира")
+		address.RecipientPhone = phone
+		return address
+	}
+	withRegion := func(regionID string) *address_entity.Address {
+		address := NewAddress("ул. Мира")
+		address.RegionID = regionID
+		return address
+	}
+	created := func(street string, isDefault bool, regionID string) *address_entity.Address {
+		address := stored(AddressID, UserID, street, isDefault)
+		address.RegionID = regionID
+		return &address
+	}
+
+	return []CreateTestCase{
+		{
+			Name:    "first_address_becomes_default",
+			Address: NewAddress("ул.  Ленина "),
+			SetupMocks: func(m *MockCreate) {
+				expectSession(m.Ctx, m.SessionRepoMock)
+				expectTx(m.Ctx, m.UowMock, m.RepoMock)
+				m.RepoMock.EXPECT().Count(m.Ctx, UserID).Return(int64(0), nil)
+				expectCreate(m)
+			},
+			ExpectedAddress: created("ул. Ленина", true, ""),
+		},
+		{
+			Name:    "next_address_is_not_default",
+			Address: withRegion(RegionID),
+			SetupMocks: func(m *MockCreate) {
+				expectSession(m.Ctx, m.SessionRepoMock)
+				m.RegionMock.EXPECT().Exists(m.Ctx, RegionID).Return(true, nil)
+				expectTx(m.Ctx, m.UowMock, m.RepoMock)
+				m.RepoMock.EXPECT().Count(m.Ctx, UserID).Return(int64(1), nil)
+				expectCreate(m)
+			},
+			ExpectedAddress: created("ул. Мира", false, RegionID),
+		},
+		{
+			Name:    "requested_default_replaces_current",
+			Address: withDefault(),
+			SetupMocks: func(m *MockCreate) {
+				expectSession(m.Ctx, m.SessionRepoMock)
+				expectTx(m.Ctx, m.UowMock, m.RepoMock)
+				m.RepoMock.EXPECT().Count(m.Ctx, UserID).Return(int64(2), nil)
+				expectCreate(m)
+				m.RepoMock.EXPECT().SetDefault(m.Ctx, UserID, AddressID).Return(nil)
+			},
+			ExpectedAddress: created("ул. Гагарина", true, ""),
+		},
+		{
+			Name:    "address_book_full",
+			Address: NewAddress("ул. Мира"),
+			SetupMocks: func(m *MockCreate) {
+				expectSession(m.Ctx, m.SessionRepoMock)
+				expectTx(m.Ctx, m.UowMock, m.RepoMock)
+				m.RepoMock.EXPECT().Count(m.Ctx, UserID).Return(int64(address_constant.MaxAddresses), nil)
+			},
+			ExpectedError: address_constant.ErrTooManyAddresses,
+		},
+		{
+			Name:    "invalid_phone",
+			Address: withPhone("12345"),
+			SetupMocks: func(m *MockCreate) {
+				expectSession(m.Ctx, m.SessionRepoMock)
+			},
+			ExpectedError: address_constant.ErrInvalidPhone,
+		},
+		{
+			Name:    "unknown_region",
+			Address: withRegion("unknown"),
+			SetupMocks: func(m *MockCreate) {
+				expectSession(m.Ctx, m.SessionRepoMock)
+				m.RegionMock.EXPECT().Exists(m.Ctx, "unknown").Return(false, nil)
+			},
+			ExpectedError: address_constant.ErrRegionNotFound,
+		},
+		{
+			Name:    "no_session_user",
+			Address: NewAddress("ул. Мира"),
+			SetupMocks: func(m *MockCreate) {
+				m.SessionRepoMock.EXPECT().GetSessionInfo(m.Ctx).Return(nil, errors.New("session not found"))
+			},
+			ExpectedError: address_constant.ErrSessionUserNotFound,
+		},
+	}
+}
@@ -0,0 +1,92 @@
+package address_testcases
+
+import (
+	"context"
+
+	address_entity "github.com/Fi44er/sdmed/internal/module/address/entity"
+	address_constant "github.com/Fi44er/sdmed/internal/module/address/pkg"
+	"github.com/Fi44er/sdmed/internal/module/address/usecase/address/mock"
+	uow_mock "github.com/Fi44er/sdmed/pkg/postgres/uow/mock"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+type MockDelete struct {
+	Ctrl            *gomock.Controller
+	Ctx             context.Context
+	RepoMock        *mock.MockIAddressRepository
+	SessionRepoMock *mock.MockISessionRepository
+	UowMock         *uow_mock.MockUow
+	T               assert.TestingT
+}
+
+type DeleteTestCase struct {
+	Name          string
+	ID            string
+	SetupMocks    func(m *MockDelete)
+	ExpectedError error
+}
+
+func GetDeleteTestCases() []DeleteTestCase {
+	const newestID = "00000000-0000-0000-0000-000000000003"
+
+	return []DeleteTestCase{
+		{
+			Name: "newest_remaining_becomes_default",
+			ID:   AddressID,
+			SetupMocks: func(m *MockDelete) {
+				address := stored(AddressID, UserID, "ул. Ленина", true)
+				expectSession(m.Ctx, m.SessionRepoMock)
+				m.RepoMock.EXPECT().GetByID(m.Ctx, AddressID).Return(&address, nil)
+				expectTx(m.Ctx, m.UowMock, m.RepoMock)
+				m.RepoMock.EXPECT().Delete(m.Ctx, AddressID).Return(nil)
+				m.RepoMock.EXPECT().GetByUserID(m.Ctx, UserID).Return([]address_entity.Address{
+					stored(newestID, UserID, "ул. Гагарина", false),
+					stored("00000000-0000-0000-0000-000000000002", UserID, "ул. Мира", false),
+				}, nil)
+				m.RepoMock.EXPECT().SetDefault(m.Ctx, UserID, newestID).Return(nil)
+			},
+		},
+		{
+			Name: "deleting_last_address",
+			ID:   AddressID,
+			SetupMocks: func(m *MockDelete) {
+				address := stored(AddressID, UserID, "ул. Ленина", true)
+				expectSession(m.Ctx, m.SessionRepoMock)
+				m.RepoMock.EXPECT().GetByID(m.Ctx, AddressID).Return(&address, nil)
+				expectTx(m.Ctx, m.UowMock, m.RepoMock)
+				m.RepoMock.EXPECT().Delete(m.Ctx, AddressID).Return(nil)
+				m.RepoMock.EXPECT().GetByUserID(m.Ctx, UserID).Return(nil, nil)
+			},
+		},
+		{
+			Name: "non_default_address_keeps_default",
+			ID:   AddressID,
+			SetupMocks: func(m *MockDelete) {
+				address := stored(AddressID, UserID, "ул. Мира", false)
+				expectSession(m.Ctx, m.SessionRepoMock)
+				m.RepoMock.EXPECT().GetByID(m.Ctx, AddressID).Return(&address, nil)
+				expectTx(m.Ctx, m.UowMock, m.RepoMock)
+				m.RepoMock.EXPECT().Delete(m.Ctx, AddressID).Return(nil)
+			},
+		},
+		{
+			Name: "foreign_address",
+			ID:   AddressID,
+			SetupMocks: func(m *MockDelete) {
+				address := stored(AddressID, GuestID, "ул. Мира", true)
+				expectSession(m.Ctx, m.SessionRepoMock)
+				m.RepoMock.EXPECT().GetByID(m.Ctx, AddressID).Return(&address, nil)
+			},
+			ExpectedError: address_constant.ErrAddressNotFound,
+		},
+		{
+			Name: "invalid_id",
+			ID:   "not-a-uuid",
+			SetupMocks: func(m *MockDelete) {
+				expectSession(m.Ctx, m.SessionRepoMock)
+			},
+			ExpectedError: address_constant.ErrAddressNotFound,
+		},
+	}
+}
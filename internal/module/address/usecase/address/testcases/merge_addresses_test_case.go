@@ -0,0 +1,95 @@
+package address_testcases
+
+import (
+	"context"
+
+	address_entity "github.com/Fi44er/sdmed/internal/module/address/entity"
+	"github.com/Fi44er/sdmed/internal/module/address/usecase/address/mock"
+	uow_mock "github.com/Fi44er/sdmed/pkg/postgres/uow/mock"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+type MockMergeAddresses struct {
+	Ctrl     *gomock.Controller
+	Ctx      context.Context
+	RepoMock *mock.MockIAddressRepository
+	UowMock  *uow_mock.MockUow
+	T        assert.TestingT
+}
+
+type MergeAddressesTestCase struct {
+	Name          string
+	FromUserID    string
+	ToUserID      string
+	SetupMocks    func(m *MockMergeAddresses)
+	ExpectedError error
+}
+
+func GetMergeAddressesTestCases() []MergeAddressesTestCase {
+	const (
+		guestLeninaID = "00000000-0000-0000-0000-000000000011"
+		guestMiraID   = "00000000-0000-0000-0000-000000000012"
+		userLeninaID  = "00000000-0000-0000-0000-000000000021"
+	)
+
+	return []MergeAddressesTestCase{
+		{
+			Name:       "duplicates_dropped_and_user_default_kept",
+			FromUserID: GuestID,
+			ToUserID:   UserID,
+			SetupMocks: func(m *MockMergeAddresses) {
+				expectTx(m.Ctx, m.UowMock, m.RepoMock)
+				m.RepoMock.EXPECT().GetByUserID(m.Ctx, GuestID).Return([]address_entity.Address{
+					stored(guestLeninaID, GuestID, "ул. Ленина", true),
+					stored(guestMiraID, GuestID, "ул. Мира", false),
+				}, nil)
+				gomock.InOrder(
+					m.RepoMock.EXPECT().GetByUserID(m.Ctx, UserID).Return([]address_entity.Address{
+						stored(userLeninaID, UserID, "ул. Ленина", true),
+					}, nil),
+					m.RepoMock.EXPECT().Delete(m.Ctx, guestLeninaID).Return(nil),
+					m.RepoMock.EXPECT().MoveToUser(m.Ctx, GuestID, UserID).Return(nil),
+					m.RepoMock.EXPECT().GetByUserID(m.Ctx, UserID).Return([]address_entity.Address{
+						stored(userLeninaID, UserID, "ул. Ленина", true),
+						stored(guestMiraID, UserID, "ул. Мира", false),
+					}, nil),
+				)
+			},
+		},
+		{
+			Name:       "user_without_addresses_gets_default",
+			FromUserID: GuestID,
+			ToUserID:   UserID,
+			SetupMocks: func(m *MockMergeAddresses) {
+				expectTx(m.Ctx, m.UowMock, m.RepoMock)
+				m.RepoMock.EXPECT().GetByUserID(m.Ctx, GuestID).Return([]address_entity.Address{
+					stored(guestMiraID, GuestID, "ул. Мира", true),
+				}, nil)
+				gomock.InOrder(
+					m.RepoMock.EXPECT().GetByUserID(m.Ctx, UserID).Return(nil, nil),
+					m.RepoMock.EXPECT().MoveToUser(m.Ctx, GuestID, UserID).Return(nil),
+					m.RepoMock.EXPECT().GetByUserID(m.Ctx, UserID).Return([]address_entity.Address{
+						stored(guestMiraID, UserID, "ул. Мира", false),
+					}, nil),
+					m.RepoMock.EXPECT().SetDefault(m.Ctx, UserID, guestMiraID).Return(nil),
+				)
+			},
+		},
+		{
+			Name:       "empty_guest_address_book",
+			FromUserID: GuestID,
+			ToUserID:   UserID,
+			SetupMocks: func(m *MockMergeAddresses) {
+				expectTx(m.Ctx, m.UowMock, m.RepoMock)
+				m.RepoMock.EXPECT().GetByUserID(m.Ctx, GuestID).Return(nil, nil)
+			},
+		},
+		{
+			Name:       "same_user",
+			FromUserID: UserID,
+			ToUserID:   UserID,
+			SetupMocks: func(m *MockMergeAddresses) {},
+		},
+	}
+}
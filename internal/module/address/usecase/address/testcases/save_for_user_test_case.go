@@ -0,0 +1,90 @@
+package address_testcases
+
+import (
+	"context"
+	"fmt"
+
+	address_entity "github.com/Fi44er/sdmed/internal/module/address/entity"
+	address_constant "github.com/Fi44er/sdmed/internal/module/address/pkg"
+	"github.com/Fi44er/sdmed/internal/module/address/usecase/address/mock"
+	uow_mock "github.com/Fi44er/sdmed/pkg/postgres/uow/mock"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+type MockSaveForUser struct {
+	Ctrl       *gomock.Controller
+	Ctx        context.Context
+	RepoMock   *mock.MockIAddressRepository
+	RegionMock *mock.MockIRegionUsecaseAdapter
+	UowMock    *uow_mock.MockUow
+	T          assert.TestingT
+}
+
+type SaveForUserTestCase struct {
+	Name          string
+	UserID        string
+	Address       *address_entity.Address
+	SetupMocks    func(m *MockSaveForUser)
+	ExpectedID    string
+	ExpectedError error
+}
+
+func GetSaveForUserTestCases() []SaveForUserTestCase {
+	const existingID = "00000000-0000-0000-0000-000000000007"
+
+	return []SaveForUserTestCase{
+		{
+			Name:    "new_guest_address_saved",
+			UserID:  GuestID,
+			Address: NewAddress("ул. Ленина"),
+			SetupMocks: func(m *MockSaveForUser) {
+				m.RepoMock.EXPECT().GetByUserID(m.Ctx, GuestID).Return(nil, nil)
+				expectTx(m.Ctx, m.UowMock, m.RepoMock)
+				m.RepoMock.EXPECT().Count(m.Ctx, GuestID).Return(int64(0), nil)
+				m.RepoMock.EXPECT().
+					Create(m.Ctx, gomock.Any()).
+					DoAndReturn(func(ctx context.Context, address *address_entity.Address) error {
+						assert.Equal(m.T, GuestID, address.UserID)
+						assert.True(m.T, address.IsDefault)
+						address.ID = AddressID
+						return nil
+					})
+			},
+			ExpectedID: AddressID,
+		},
+		{
+			Name:    "same_address_reused",
+			UserID:  GuestID,
+			Address: NewAddress("ул.  Ленина "),
+			SetupMocks: func(m *MockSaveForUser) {
+				m.RepoMock.EXPECT().GetByUserID(m.Ctx, GuestID).Return([]address_entity.Address{
+					stored(existingID, GuestID, "ул. Ленина", true),
+				}, nil)
+			},
+			ExpectedID: existingID,
+		},
+		{
+			Name:    "full_address_book_not_blocking_checkout",
+			UserID:  GuestID,
+			Address: NewAddress("ул. Ленина"),
+			SetupMocks: func(m *MockSaveForUser) {
+				addresses := make([]address_entity.Address, address_constant.MaxAddresses)
+				for i := range addresses {
+					addresses[i] = stored(fmt.Sprintf("00000000-0000-0000-0000-%012d", i+10), GuestID, fmt.Sprintf("ул. Мира, %d", i), i == 0)
+				}
+				m.RepoMock.EXPECT().GetByUserID(m.Ctx, GuestID).Return(addresses, nil)
+			},
+			ExpectedID: "",
+		},
+		{
+			Name:   "invalid_phone",
+			UserID: GuestID,
+			Address: &address_entity.Address{
+				City: "Екатеринбург", Street: "ул. Ленина", House: "5", RecipientPhone: "12345",
+			},
+			SetupMocks:    func(m *MockSaveForUser) {},
+			ExpectedError: address_constant.ErrInvalidPhone,
+		},
+	}
+}
@@ -0,0 +1,346 @@
+package address_usecase
+
+import (
+	"context"
+
+	address_entity "github.com/Fi44er/sdmed/internal/module/address/entity"
+	address_constant "github.com/Fi44er/sdmed/internal/module/address/pkg"
+	address_usecase_contracts "github.com/Fi44er/sdmed/internal/module/address/usecase/address/contracts"
+	"github.com/Fi44er/sdmed/pkg/logger"
+	"github.com/Fi44er/sdmed/pkg/postgres/uow"
+	"github.com/google/uuid"
+)
+
+type IAddressUsecase interface {
+	GetOwn(ctx context.Context) ([]address_entity.Address, error)
+	GetOwnByID(ctx context.Context, id string) (*address_entity.Address, error)
+	Create(ctx context.Context, address *address_entity.Address) (*address_entity.Address, error)
+	Update(ctx context.Context, address *address_entity.Address) (*address_entity.Address, error)
+	Delete(ctx context.Context, id string) error
+	SetDefault(ctx context.Context, id string) (*address_entity.Address, error)
+
+	GetByUserID(ctx context.Context, userID, id string) (*address_entity.Address, error)
+	SaveForUser(ctx context.Context, userID string, address *address_entity.Address) (*address_entity.Address, error)
+	GetDefaultRegionID(ctx context.Context, userID string) (string, error)
+	MergeAddresses(ctx context.Context, fromUserID, toUserID string) error
+}
+
+type AddressUsecase struct {
+	repository        address_usecase_contracts.IAddressRepository
+	sessionRepository address_usecase_contracts.ISessionRepository
+	regionUsecase     address_usecase_contracts.IRegionUsecaseAdapter
+	uow               uow.Uow
+	logger            *logger.Logger
+}
+
+func NewAddressUsecase(
+	repository address_usecase_contracts.IAddressRepository,
+	sessionRepository address_usecase_contracts.ISessionRepository,
+	regionUsecase address_usecase_contracts.IRegionUsecaseAdapter,
+	uow uow.Uow,
+	logger *logger.Logger,
+) IAddressUsecase {
+	return &AddressUsecase{
+		repository:        repository,
+		sessionRepository: sessionRepository,
+		regionUsecase:     regionUsecase,
+		uow:               uow,
+		logger:            logger,
+	}
+}
+
+func (u *AddressUsecase) GetOwn(ctx context.Context) ([]address_entity.Address, error) {
+	userID, err := u.getSessionUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return u.repository.GetByUserID(ctx, userID)
+}
+
+func (u *AddressUsecase) GetOwnByID(ctx context.Context, id string) (*address_entity.Address, error) {
+	userID, err := u.getSessionUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return u.GetByUserID(ctx, userID, id)
+}
+
+// Create добавляет адрес в книгу пользователя сессии. Первый адрес
+// становится адресом по умолчанию
+func (u *AddressUsecase) Create(ctx context.Context, address *address_entity.Address) (*address_entity.Address, error) {
+	userID, err := u.getSessionUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	address.UserID = userID
+	if err := u.validate(ctx, address); err != nil {
+		return nil, err
+	}
+
+	if err := u.create(ctx, address); err != nil {
+		return nil, err
+	}
+
+	return address, nil
+}
+
+func (u *AddressUsecase) Update(ctx context.Context, address *address_entity.Address) (*address_entity.Address, error) {
+	existing, err := u.GetOwnByID(ctx, address.ID)
+	if err != nil {
+		return nil, err
+	}
+	address.UserID = existing.UserID
+	if err := u.validate(ctx, address); err != nil {
+		return nil, err
+	}
+
+	err = u.uow.Do(ctx, func(ctx context.Context) error {
+		repo, err := u.getRepository(ctx)
+		if err != nil {
+			return err
+		}
+
+		if err := repo.Update(ctx, address); err != nil {
+			return err
+		}
+		if address.IsDefault && !existing.IsDefault {
+			return repo.SetDefault(ctx, address.UserID, address.ID)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return u.GetByUserID(ctx, address.UserID, address.ID)
+}
+
+// Delete удаляет адрес. Если удален адрес по умолчанию, им становится самый новый из оставшихся
+func (u *AddressUsecase) Delete(ctx context.Context, id string) error {
+	address, err := u.GetOwnByID(ctx, id)
+	if err != nil {
+		return err
+	}
+	u.logger.Infof("Deleting address %s of user %s", id, address.UserID)
+
+	return u.uow.Do(ctx, func(ctx context.Context) error {
+		repo, err := u.getRepository(ctx)
+		if err != nil {
+			return err
+		}
+
+		if err := repo.Delete(ctx, id); err != nil {
+			return err
+		}
+		if !address.IsDefault {
+			return nil
+		}
+
+		return u.ensureDefault(ctx, repo, address.UserID)
+	})
+}
+
+func (u *AddressUsecase) SetDefault(ctx context.Context, id string) (*address_entity.Address, error) {
+	address, err := u.GetOwnByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := u.repository.SetDefault(ctx, address.UserID, address.ID); err != nil {
+		return nil, err
+	}
+	address.IsDefault = true
+
+	return address, nil
+}
+
+// GetByUserID возвращает адрес, только если он принадлежит пользователю userID
+func (u *AddressUsecase) GetByUserID(ctx context.Context, userID, id string) (*address_entity.Address, error) {
+	if _, err := uuid.Parse(id); err != nil {
+		return nil, address_constant.ErrAddressNotFound
+	}
+
+	address, err := u.repository.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if address == nil || address.UserID != userID {
+		return nil, address_constant.ErrAddressNotFound
+	}
+
+	return address, nil
+}
+
+// SaveForUser сохраняет адрес, введенный при оформлении заказа, в том числе для гостя.
+// Уже сохраненный такой же адрес переиспользуется. Если книга заполнена, адрес
+// возвращается без сохранения, чтобы не мешать оформлению
+func (u *AddressUsecase) SaveForUser(ctx context.Context, userID string, address *address_entity.Address) (*address_entity.Address, error) {
+	address.UserID = userID
+	if err := u.validate(ctx, address); err != nil {
+		return nil, err
+	}
+
+	existing, err := u.repository.GetByUserID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	for i := range existing {
+		if existing[i].SameAs(address) {
+			return &existing[i], nil
+		}
+	}
+	if len(existing) >= address_constant.MaxAddresses {
+		u.logger.Warnf("Address book of user %s is full, checkout address is not saved", userID)
+		return address, nil
+	}
+
+	if err := u.create(ctx, address); err != nil {
+		return nil, err
+	}
+
+	return address, nil
+}
+
+// GetDefaultRegionID - регион адреса по умолчанию для определения региона покупателя
+func (u *AddressUsecase) GetDefaultRegionID(ctx context.Context, userID string) (string, error) {
+	addresses, err := u.repository.GetByUserID(ctx, userID)
+	if err != nil {
+		return "", err
+	}
+	for _, address := range addresses {
+		if address.IsDefault {
+			return address.RegionID, nil
+		}
+	}
+
+	return "", nil
+}
+
+// MergeAddresses переносит адреса гостя пользователю при входе. Адреса,
+// которые у пользователя уже есть, не дублируются
+func (u *AddressUsecase) MergeAddresses(ctx context.Context, fromUserID, toUserID string) error {
+	if fromUserID == toUserID {
+		return nil
+	}
+	u.logger.Infof("Merging addresses of user %s into addresses of user %s", fromUserID, toUserID)
+
+	return u.uow.Do(ctx, func(ctx context.Context) error {
+		repo, err := u.getRepository(ctx)
+		if err != nil {
+			return err
+		}
+
+		from, err := repo.GetByUserID(ctx, fromUserID)
+		if err != nil {
+			return err
+		}
+		if len(from) == 0 {
+			return nil
+		}
+		to, err := repo.GetByUserID(ctx, toUserID)
+		if err != nil {
+			return err
+		}
+
+		for i := range from {
+			for j := range to {
+				if from[i].SameAs(&to[j]) {
+					if err := repo.Delete(ctx, from[i].ID); err != nil {
+						return err
+					}
+					break
+				}
+			}
+		}
+
+		if err := repo.MoveToUser(ctx, fromUserID, toUserID); err != nil {
+			return err
+		}
+
+		return u.ensureDefault(ctx, repo, toUserID)
+	})
+}
+
+func (u *AddressUsecase) create(ctx context.Context, address *address_entity.Address) error {
+	return u.uow.Do(ctx, func(ctx context.Context) error {
+		repo, err := u.getRepository(ctx)
+		if err != nil {
+			return err
+		}
+
+		count, err := repo.Count(ctx, address.UserID)
+		if err != nil {
+			return err
+		}
+		if count >= address_constant.MaxAddresses {
+			return address_constant.ErrTooManyAddresses
+		}
+
+		requestedDefault := address.IsDefault
+		address.IsDefault = count == 0
+		if err := repo.Create(ctx, address); err != nil {
+			return err
+		}
+		if requestedDefault && !address.IsDefault {
+			if err := repo.SetDefault(ctx, address.UserID, address.ID); err != nil {
+				return err
+			}
+			address.IsDefault = true
+		}
+
+		return nil
+	})
+}
+
+// ensureDefault назначает адресом по умолчанию самый новый адрес, если такого адреса нет
+func (u *AddressUsecase) ensureDefault(ctx context.Context, repo address_usecase_contracts.IAddressRepository, userID string) error {
+	addresses, err := repo.GetByUserID(ctx, userID)
+	if err != nil {
+		return err
+	}
+	if len(addresses) == 0 || addresses[0].IsDefault {
+		return nil
+	}
+
+	return repo.SetDefault(ctx, userID, addresses[0].ID)
+}
+
+func (u *AddressUsecase) validate(ctx context.Context, address *address_entity.Address) error {
+	if !address.Normalize() {
+		return address_constant.ErrInvalidPhone
+	}
+	if address.RegionID == "" {
+		return nil
+	}
+
+	exists, err := u.regionUsecase.Exists(ctx, address.RegionID)
+	if err != nil {
+		return err
+	}
+	if !exists {
+		return address_constant.ErrRegionNotFound
+	}
+
+	return nil
+}
+
+func (u *AddressUsecase) getSessionUserID(ctx context.Context) (string, error) {
+	sessionInfo, err := u.sessionRepository.GetSessionInfo(ctx)
+	if err != nil || sessionInfo.UserID == "" {
+		u.logger.Warnf("Addresses requested without session user: %v", err)
+		return "", address_constant.ErrSessionUserNotFound
+	}
+
+	return sessionInfo.UserID, nil
+}
+
+func (u *AddressUsecase) getRepository(ctx context.Context) (address_usecase_contracts.IAddressRepository, error) {
+	repo, err := u.uow.GetRepository(ctx, "address")
+	if err != nil {
+		u.logger.Errorf("Failed to get repository: %v", err)
+		return nil, err
+	}
+	return repo.(address_usecase_contracts.IAddressRepository), nil
+}
@@ -0,0 +1,158 @@
+package address_usecase_test
+
+import (
+	"context"
+	"testing"
+
+	address_usecase "github.com/Fi44er/sdmed/internal/module/address/usecase/address"
+	"github.com/Fi44er/sdmed/internal/module/address/usecase/address/mock"
+	address_testcases "github.com/Fi44er/sdmed/internal/module/address/usecase/address/testcases"
+	"github.com/Fi44er/sdmed/pkg/logger"
+	uow_mock "github.com/Fi44er/sdmed/pkg/postgres/uow/mock"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+)
+
+type AddressUsecaseTestSuite struct {
+	suite.Suite
+	ctx    context.Context
+	logger *logger.Logger
+}
+
+func (s *AddressUsecaseTestSuite) SetupSuite() {
+	s.ctx = context.Background()
+	s.logger = logger.NewLogger()
+}
+
+func TestAddressUsecase(t *testing.T) {
+	suite.Run(t, new(AddressUsecaseTestSuite))
+}
+
+func (s *AddressUsecaseTestSuite) TestCreate() {
+	tests := address_testcases.GetCreateTestCases()
+
+	for _, tc := range tests {
+		s.T().Run(tc.Name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockStruct := &address_testcases.MockCreate{
+				Ctrl:            ctrl,
+				Ctx:             s.ctx,
+				RepoMock:        mock.NewMockIAddressRepository(ctrl),
+				SessionRepoMock: mock.NewMockISessionRepository(ctrl),
+				RegionMock:      mock.NewMockIRegionUsecaseAdapter(ctrl),
+				UowMock:         uow_mock.NewMockUow(ctrl),
+				T:               t,
+			}
+
+			usecase := address_usecase.NewAddressUsecase(mockStruct.RepoMock, mockStruct.SessionRepoMock, mockStruct.RegionMock, mockStruct.UowMock, s.logger)
+
+			tc.SetupMocks(mockStruct)
+			address, err := usecase.Create(s.ctx, tc.Address)
+
+			if tc.ExpectedError != nil {
+				assert.ErrorIs(t, err, tc.ExpectedError)
+				assert.Nil(t, address)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tc.ExpectedAddress, address)
+			}
+		})
+	}
+}
+
+func (s *AddressUsecaseTestSuite) TestDelete() {
+	tests := address_testcases.GetDeleteTestCases()
+
+	for _, tc := range tests {
+		s.T().Run(tc.Name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockStruct := &address_testcases.MockDelete{
+				Ctrl:            ctrl,
+				Ctx:             s.ctx,
+				RepoMock:        mock.NewMockIAddressRepository(ctrl),
+				SessionRepoMock: mock.NewMockISessionRepository(ctrl),
+				UowMock:         uow_mock.NewMockUow(ctrl),
+				T:               t,
+			}
+
+			usecase := address_usecase.NewAddressUsecase(mockStruct.RepoMock, mockStruct.SessionRepoMock, nil, mockStruct.UowMock, s.logger)
+
+			tc.SetupMocks(mockStruct)
+			err := usecase.Delete(s.ctx, tc.ID)
+
+			if tc.ExpectedError != nil {
+				assert.ErrorIs(t, err, tc.ExpectedError)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func (s *AddressUsecaseTestSuite) TestSaveForUser() {
+	tests := address_testcases.GetSaveForUserTestCases()
+
+	for _, tc := range tests {
+		s.T().Run(tc.Name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockStruct := &address_testcases.MockSaveForUser{
+				Ctrl:       ctrl,
+				Ctx:        s.ctx,
+				RepoMock:   mock.NewMockIAddressRepository(ctrl),
+				RegionMock: mock.NewMockIRegionUsecaseAdapter(ctrl),
+				UowMock:    uow_mock.NewMockUow(ctrl),
+				T:          t,
+			}
+
+			usecase := address_usecase.NewAddressUsecase(mockStruct.RepoMock, nil, mockStruct.RegionMock, mockStruct.UowMock, s.logger)
+
+			tc.SetupMocks(mockStruct)
+			address, err := usecase.SaveForUser(s.ctx, tc.UserID, tc.Address)
+
+			if tc.ExpectedError != nil {
+				assert.ErrorIs(t, err, tc.ExpectedError)
+				assert.Nil(t, address)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tc.ExpectedID, address.ID)
+			}
+		})
+	}
+}
+
+func (s *AddressUsecaseTestSuite) TestMergeAddresses() {
+	tests := address_testcases.GetMergeAddressesTestCases()
+
+	for _, tc := range tests {
+		s.T().Run(tc.Name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockStruct := &address_testcases.MockMergeAddresses{
+				Ctrl:     ctrl,
+				Ctx:      s.ctx,
+				RepoMock: mock.NewMockIAddressRepository(ctrl),
+				UowMock:  uow_mock.NewMockUow(ctrl),
+				T:        t,
+			}
+
+			usecase := address_usecase.NewAddressUsecase(mockStruct.RepoMock, nil, nil, mockStruct.UowMock, s.logger)
+
+			tc.SetupMocks(mockStruct)
+			err := usecase.MergeAddresses(s.ctx, tc.FromUserID, tc.ToUserID)
+
+			if tc.ExpectedError != nil {
+				assert.ErrorIs(t, err, tc.ExpectedError)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
@@ -11,6 +11,7 @@ type ActiveSession struct {
 	ExpiresAt time.Time `json:"expires_at"`
 	IPAddress string    `json:"ip_address,omitempty"`
 	UserAgent string    `json:"user_agent,omitempty"`
+	RegionID  string    `json:"region_id,omitempty"`
 }
 
 type UserSession struct {
@@ -107,3 +107,7 @@ func (m *AuthModule) GetUserSessionRepository() user_session_repository.IUserSes
 func (m *AuthModule) GetSessionRepository() *repository.SessionRepository {
 	return m.sessionRepository
 }
+
+func (m *AuthModule) GetAuthUsecase() *auth_usecase.AuthUsecase {
+	return m.authUsecase
+}
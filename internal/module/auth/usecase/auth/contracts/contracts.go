@@ -53,3 +53,13 @@ type IShadowUserService interface {
 	PromoteToRealUser(ctx context.Context, shadowUserID string, user *auth_entity.User) error
 	CleanupExpiredShadows(ctx context.Context) error
 }
+
+// ICartMerger переносит гостевую корзину shadow-пользователя в корзину вошедшего пользователя
+type ICartMerger interface {
+	MergeCarts(ctx context.Context, fromUserID, toUserID string) error
+}
+
+// IAddressMerger переносит адреса, введенные shadow-пользователем при оформлении, в адресную книгу вошедшего пользователя
+type IAddressMerger interface {
+	MergeAddresses(ctx context.Context, fromUserID, toUserID string) error
+}
@@ -39,6 +39,8 @@ type AuthUsecase struct {
 	sessionRepository     contracts.ISessionRepository
 	userSessionRepository contracts.IUserSessionRepository
 	shadowUserService     contracts.IShadowUserService
+	cartMerger            contracts.ICartMerger
+	addressMerger         contracts.IAddressMerger
 }
 
 func NewAuthUsecase(
@@ -63,6 +65,16 @@ func NewAuthUsecase(
 	}
 }
 
+// SetCartMerger подключает слияние корзин при входе. Модуль корзины создается позже модуля авторизации
+func (u *AuthUsecase) SetCartMerger(cartMerger contracts.ICartMerger) {
+	u.cartMerger = cartMerger
+}
+
+// SetAddressMerger подключает перенос адресов shadow-пользователя при входе
+func (u *AuthUsecase) SetAddressMerger(addressMerger contracts.IAddressMerger) {
+	u.addressMerger = addressMerger
+}
+
 const (
 	CodeRedisPrefix           = "verification_codes_"
 	UserRedisPrefix           = "temp_user_"
@@ -117,9 +129,13 @@ func (u *AuthUsecase) SignIn(ctx context.Context, user *auth_entity.User) error
 
 	currentSession, err := u.sessionRepository.GetSessionInfo(ctx)
 	var deviceID string
+	var regionID string
+	var shadowUserID string
 
 	if err == nil && currentSession != nil && currentSession.IsShadow {
 		deviceID = currentSession.DeviceID
+		regionID = currentSession.RegionID
+		shadowUserID = currentSession.UserID
 
 		dbSession, err := u.userSessionRepository.Get(ctx, deviceID)
 		if err == nil && dbSession != nil {
@@ -158,12 +174,26 @@ func (u *AuthUsecase) SignIn(ctx context.Context, user *auth_entity.User) error
 		IsShadow:  false,
 		CreatedAt: now,
 		ExpiresAt: now.Add(u.config.RefreshTokenExpiresIn),
+		RegionID:  regionID,
 	}
 
 	if err := u.sessionRepository.PutSessionInfo(ctx, userSession); err != nil {
 		return err
 	}
 
+	if shadowUserID != "" && u.cartMerger != nil {
+		// Ошибка слияния не должна мешать входу: гостевая корзина останется до очистки
+		if err := u.cartMerger.MergeCarts(ctx, shadowUserID, existingUser.ID); err != nil {
+			u.logger.Errorf("failed to merge cart of shadow user %s: %v", shadowUserID, err)
+		}
+	}
+
+	if shadowUserID != "" && u.addressMerger != nil {
+		if err := u.addressMerger.MergeAddresses(ctx, shadowUserID, existingUser.ID); err != nil {
+			u.logger.Errorf("failed to merge addresses of shadow user %s: %v", shadowUserID, err)
+		}
+	}
+
 	return nil
 }
 
@@ -236,7 +266,8 @@ func (u *AuthUsecase) VerifyCode(ctx context.Context, verifyCode *auth_entity.Co
 	sessionInfo, err := u.sessionRepository.GetSessionInfo(ctx)
 	u.logger.Debugf("session info in VerifyCode: %+v \n %v", sessionInfo, err)
 	if err == nil && sessionInfo.IsShadow {
-		// Конвертируем shadow user в реального
+		// Конвертируем shadow user в реального. ID пользователя сохраняется,
+		// поэтому гостевая корзина остается у него без слияния
 		if err := u.shadowUserService.PromoteToRealUser(ctx, sessionInfo.UserID, &user); err != nil {
 			return err
 		}
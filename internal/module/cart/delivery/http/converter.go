@@ -0,0 +1,42 @@
+package cart_http
+
+import (
+	cart_dto "github.com/Fi44er/sdmed/internal/module/cart/dto"
+	cart_entity "github.com/Fi44er/sdmed/internal/module/cart/entity"
+)
+
+type Converter struct{}
+
+func (c *Converter) ToItemEntity(dto *cart_dto.AddCartItemRequest) *cart_entity.CartItem {
+	return &cart_entity.CartItem{
+		ProductID: dto.ProductID,
+		Quantity:  dto.Quantity,
+	}
+}
+
+func (c *Converter) ToItemEntityFromUpdate(dto *cart_dto.UpdateCartItemRequest) *cart_entity.CartItem {
+	return &cart_entity.CartItem{
+		ProductID: dto.ProductID,
+		Quantity:  dto.Quantity,
+	}
+}
+
+func (c *Converter) ToCartResponse(cart *cart_entity.Cart) *cart_dto.CartResponse {
+	items := make([]cart_dto.CartItemResponse, len(cart.Items))
+	for i := range cart.Items {
+		items[i] = cart_dto.CartItemResponse{
+			ProductID: cart.Items[i].ProductID,
+			Name:      cart.Items[i].Name,
+			Article:   cart.Items[i].Article,
+			Quantity:  cart.Items[i].Quantity,
+			Price:     cart.Items[i].Price,
+			Sum:       cart.Items[i].Sum(),
+		}
+	}
+
+	return &cart_dto.CartResponse{
+		Items: items,
+		Count: cart.Count(),
+		Total: cart.Total(),
+	}
+}
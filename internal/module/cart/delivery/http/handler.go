@@ -0,0 +1,181 @@
+package cart_http
+
+import (
+	"context"
+
+	cart_dto "github.com/Fi44er/sdmed/internal/module/cart/dto"
+	cart_entity "github.com/Fi44er/sdmed/internal/module/cart/entity"
+	"github.com/Fi44er/sdmed/pkg/logger"
+	_ "github.com/Fi44er/sdmed/pkg/response"
+	"github.com/Fi44er/sdmed/pkg/session"
+	"github.com/Fi44er/sdmed/pkg/utils"
+	"github.com/go-playground/validator/v10"
+	"github.com/gofiber/fiber/v2"
+)
+
+type ICartUsecase interface {
+	Get(ctx context.Context) (*cart_entity.Cart, error)
+	AddItem(ctx context.Context, productID string, quantity int) (*cart_entity.Cart, error)
+	UpdateQuantity(ctx context.Context, productID string, quantity int) (*cart_entity.Cart, error)
+	RemoveItem(ctx context.Context, productID string) (*cart_entity.Cart, error)
+	Clear(ctx context.Context) error
+}
+
+type CartHandler struct {
+	usecase ICartUsecase
+
+	validator *validator.Validate
+	logger    *logger.Logger
+	converter *Converter
+}
+
+func NewCartHandler(
+	usecase ICartUsecase,
+	validator *validator.Validate,
+	logger *logger.Logger,
+) *CartHandler {
+	return &CartHandler{
+		usecase:   usecase,
+		validator: validator,
+		logger:    logger,
+		converter: &Converter{},
+	}
+}
+
+// Get godoc
+// @Summary Get cart
+// @Description Get the cart of the current session. Guests get the cart of their shadow user
+// @Tags cart
+// @Produce json
+// @Success 200 {object} response.ResponseData{data=cart_dto.CartResponse} "OK"
+// @Failure 401 {object} response.Response "No session"
+// @Failure 500 {object} response.Response "Error"
+// @Router /cart [get]
+func (h *CartHandler) Get(ctx *fiber.Ctx) error {
+	cart, err := h.usecase.Get(h.getCtxWithSession(ctx))
+	if err != nil {
+		return err
+	}
+
+	return ctx.Status(200).JSON(fiber.Map{
+		"status": "success",
+		"data":   h.converter.ToCartResponse(cart),
+	})
+}
+
+// AddItem godoc
+// @Summary Add product to cart
+// @Description Add a product to the cart with its current price. Adding a product already in the cart increases its quantity
+// @Tags cart
+// @Accept json
+// @Produce json
+// @Param item body cart_dto.AddCartItemRequest true "Product and quantity"
+// @Success 200 {object} response.ResponseData{data=cart_dto.CartResponse} "OK"
+// @Failure 400 {object} response.Response "Invalid request or product unavailable"
+// @Failure 404 {object} response.Response "Product not found"
+// @Failure 500 {object} response.Response "Error"
+// @Router /cart/items [post]
+func (h *CartHandler) AddItem(ctx *fiber.Ctx) error {
+	dto := new(cart_dto.AddCartItemRequest)
+
+	item, err := utils.ParseAndValidate(ctx, dto, h.validator, h.converter.ToItemEntity, h.logger)
+	if err != nil {
+		return ctx.Status(400).JSON(fiber.Map{
+			"status":  "fail",
+			"message": err.Error(),
+		})
+	}
+
+	cart, err := h.usecase.AddItem(h.getCtxWithSession(ctx), item.ProductID, item.Quantity)
+	if err != nil {
+		return err
+	}
+
+	return ctx.Status(200).JSON(fiber.Map{
+		"status": "success",
+		"data":   h.converter.ToCartResponse(cart),
+	})
+}
+
+// UpdateQuantity godoc
+// @Summary Update cart item quantity
+// @Tags cart
+// @Accept json
+// @Produce json
+// @Param product_id path string true "Product ID"
+// @Param item body cart_dto.UpdateCartItemRequest true "Quantity"
+// @Success 200 {object} response.ResponseData{data=cart_dto.CartResponse} "OK"
+// @Failure 400 {object} response.Response "Invalid quantity"
+// @Failure 404 {object} response.Response "Item not found"
+// @Failure 500 {object} response.Response "Error"
+// @Router /cart/items/{product_id} [put]
+func (h *CartHandler) UpdateQuantity(ctx *fiber.Ctx) error {
+	dto := new(cart_dto.UpdateCartItemRequest)
+	dto.ProductID = ctx.Params("product_id")
+
+	item, err := utils.ParseAndValidate(ctx, dto, h.validator, h.converter.ToItemEntityFromUpdate, h.logger)
+	if err != nil {
+		return ctx.Status(400).JSON(fiber.Map{
+			"status":  "fail",
+			"message": err.Error(),
+		})
+	}
+
+	cart, err := h.usecase.UpdateQuantity(h.getCtxWithSession(ctx), item.ProductID, item.Quantity)
+	if err != nil {
+		return err
+	}
+
+	return ctx.Status(200).JSON(fiber.Map{
+		"status": "success",
+		"data":   h.converter.ToCartResponse(cart),
+	})
+}
+
+// RemoveItem godoc
+// @Summary Remove product from cart
+// @Tags cart
+// @Produce json
+// @Param product_id path string true "Product ID"
+// @Success 200 {object} response.ResponseData{data=cart_dto.CartResponse} "OK"
+// @Failure 404 {object} response.Response "Item not found"
+// @Failure 500 {object} response.Response "Error"
+// @Router /cart/items/{product_id} [delete]
+func (h *CartHandler) RemoveItem(ctx *fiber.Ctx) error {
+	cart, err := h.usecase.RemoveItem(h.getCtxWithSession(ctx), ctx.Params("product_id"))
+	if err != nil {
+		return err
+	}
+
+	return ctx.Status(200).JSON(fiber.Map{
+		"status": "success",
+		"data":   h.converter.ToCartResponse(cart),
+	})
+}
+
+// Clear godoc
+// @Summary Clear cart
+// @Tags cart
+// @Produce json
+// @Success 200 {object} response.Response "OK"
+// @Failure 500 {object} response.Response "Error"
+// @Router /cart [delete]
+func (h *CartHandler) Clear(ctx *fiber.Ctx) error {
+	if err := h.usecase.Clear(h.getCtxWithSession(ctx)); err != nil {
+		return err
+	}
+
+	return ctx.Status(200).JSON(fiber.Map{
+		"status":  "success",
+		"message": "cart cleared successfully",
+	})
+}
+
+func (h *CartHandler) getCtxWithSession(ctx *fiber.Ctx) context.Context {
+	sess := session.FromFiberContext(ctx)
+	if sess == nil {
+		return ctx.Context()
+	}
+
+	return context.WithValue(ctx.Context(), "session", *sess)
+}
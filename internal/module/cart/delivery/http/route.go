@@ -0,0 +1,12 @@
+package cart_http
+
+import "github.com/gofiber/fiber/v2"
+
+func (h *CartHandler) RegisterRoutes(router fiber.Router) {
+	cart := router.Group("/cart")
+	cart.Get("/", h.Get)
+	cart.Delete("/", h.Clear)
+	cart.Post("/items", h.AddItem)
+	cart.Put("/items/:product_id", h.UpdateQuantity)
+	cart.Delete("/items/:product_id", h.RemoveItem)
+}
@@ -0,0 +1,26 @@
+package cart_dto
+
+type AddCartItemRequest struct {
+	ProductID string `json:"product_id" validate:"required,uuid"`
+	Quantity  int    `json:"quantity" validate:"required,min=1,max=999"`
+}
+
+type UpdateCartItemRequest struct {
+	ProductID string `json:"-" validate:"required"`
+	Quantity  int    `json:"quantity" validate:"required,min=1,max=999"`
+}
+
+type CartItemResponse struct {
+	ProductID string  `json:"product_id"`
+	Name      string  `json:"name"`
+	Article   string  `json:"article"`
+	Quantity  int     `json:"quantity"`
+	Price     float64 `json:"price"`
+	Sum       float64 `json:"sum"`
+}
+
+type CartResponse struct {
+	Items []CartItemResponse `json:"items"`
+	Count int                `json:"count"`
+	Total float64            `json:"total"`
+}
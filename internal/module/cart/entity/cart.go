@@ -0,0 +1,67 @@
+package cart_entity
+
+import (
+	"math"
+	"time"
+)
+
+// Cart - корзина пользователя, в том числе shadow-пользователя гостевой сессии
+type Cart struct {
+	ID        string
+	UserID    string
+	Items     []CartItem
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+// CartItem - позиция корзины. Цена фиксируется в момент добавления товара
+type CartItem struct {
+	ID        string
+	CartID    string
+	ProductID string
+	Name      string
+	Article   string
+	Quantity  int
+	Price     float64
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+// CartProduct - данные товара, нужные корзине
+type CartProduct struct {
+	ID       string
+	Name     string
+	Article  string
+	Price    *float64
+	IsActive bool
+}
+
+func (i *CartItem) Sum() float64 {
+	return math.Round(i.Price*float64(i.Quantity)*100) / 100
+}
+
+func (c *Cart) Total() float64 {
+	total := 0.0
+	for i := range c.Items {
+		total += c.Items[i].Sum()
+	}
+	return math.Round(total*100) / 100
+}
+
+func (c *Cart) Count() int {
+	count := 0
+	for i := range c.Items {
+		count += c.Items[i].Quantity
+	}
+	return count
+}
+
+// FindItem возвращает позицию с товаром или nil
+func (c *Cart) FindItem(productID string) *CartItem {
+	for i := range c.Items {
+		if c.Items[i].ProductID == productID {
+			return &c.Items[i]
+		}
+	}
+	return nil
+}
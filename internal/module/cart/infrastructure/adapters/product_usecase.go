@@ -0,0 +1,42 @@
+package cart_adapters
+
+import (
+	"context"
+
+	cart_entity "github.com/Fi44er/sdmed/internal/module/cart/entity"
+	product_usecase "github.com/Fi44er/sdmed/internal/module/product/usecase/product"
+)
+
+type IProductUsecaseAdapter interface {
+	GetByID(ctx context.Context, id string) (*cart_entity.CartProduct, error)
+}
+
+type ProductUsecaseAdapter struct {
+	productUsecase product_usecase.IProductUsecase
+}
+
+func NewProductUsecaseAdapter(productUsecase product_usecase.IProductUsecase) IProductUsecaseAdapter {
+	return &ProductUsecaseAdapter{
+		productUsecase: productUsecase,
+	}
+}
+
+// GetByID возвращает товар или nil, если товара нет
+func (a *ProductUsecaseAdapter) GetByID(ctx context.Context, id string) (*cart_entity.CartProduct, error) {
+	products, err := a.productUsecase.GetByIDs(ctx, []string{id})
+	if err != nil {
+		return nil, err
+	}
+	if len(products) == 0 {
+		return nil, nil
+	}
+
+	product := products[0]
+	return &cart_entity.CartProduct{
+		ID:       product.ID,
+		Name:     product.Name,
+		Article:  product.Article,
+		Price:    product.ManualPrice,
+		IsActive: product.IsActive,
+	}, nil
+}
@@ -0,0 +1,49 @@
+package cart_repository
+
+import (
+	cart_entity "github.com/Fi44er/sdmed/internal/module/cart/entity"
+	cart_model "github.com/Fi44er/sdmed/internal/module/cart/infrastructure/repository/model"
+)
+
+type Converter struct{}
+
+func (c *Converter) ToItemModel(entity *cart_entity.CartItem) *cart_model.CartItem {
+	return &cart_model.CartItem{
+		ID:        entity.ID,
+		CartID:    entity.CartID,
+		ProductID: entity.ProductID,
+		Name:      entity.Name,
+		Article:   entity.Article,
+		Quantity:  entity.Quantity,
+		Price:     entity.Price,
+	}
+}
+
+func (c *Converter) ToItemEntity(model *cart_model.CartItem) *cart_entity.CartItem {
+	return &cart_entity.CartItem{
+		ID:        model.ID,
+		CartID:    model.CartID,
+		ProductID: model.ProductID,
+		Name:      model.Name,
+		Article:   model.Article,
+		Quantity:  model.Quantity,
+		Price:     model.Price,
+		CreatedAt: model.CreatedAt,
+		UpdatedAt: model.UpdatedAt,
+	}
+}
+
+func (c *Converter) ToEntity(model *cart_model.Cart) *cart_entity.Cart {
+	items := make([]cart_entity.CartItem, len(model.Items))
+	for i := range model.Items {
+		items[i] = *c.ToItemEntity(&model.Items[i])
+	}
+
+	return &cart_entity.Cart{
+		ID:        model.ID,
+		UserID:    model.UserID,
+		Items:     items,
+		CreatedAt: model.CreatedAt,
+		UpdatedAt: model.UpdatedAt,
+	}
+}
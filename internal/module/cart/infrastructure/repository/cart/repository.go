@@ -0,0 +1,166 @@
+package cart_repository
+
+import (
+	"context"
+	"time"
+
+	cart_entity "github.com/Fi44er/sdmed/internal/module/cart/entity"
+	cart_model "github.com/Fi44er/sdmed/internal/module/cart/infrastructure/repository/model"
+	"github.com/Fi44er/sdmed/pkg/logger"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type ICartRepository interface {
+	GetByUserID(ctx context.Context, userID string) (*cart_entity.Cart, error)
+	Create(ctx context.Context, cart *cart_entity.Cart) error
+	Delete(ctx context.Context, id string) error
+	Touch(ctx context.Context, id string) error
+
+	SaveItem(ctx context.Context, item *cart_entity.CartItem) error
+	DeleteItem(ctx context.Context, cartID, productID string) (bool, error)
+	ClearItems(ctx context.Context, cartID string) error
+
+	DeleteStaleShadowCarts(ctx context.Context, staleBefore time.Time) (int64, error)
+}
+
+type CartRepository struct {
+	logger    *logger.Logger
+	db        *gorm.DB
+	converter *Converter
+}
+
+func NewCartRepository(logger *logger.Logger, db *gorm.DB) ICartRepository {
+	return &CartRepository{
+		logger:    logger,
+		db:        db,
+		converter: &Converter{},
+	}
+}
+
+func (r *CartRepository) GetByUserID(ctx context.Context, userID string) (*cart_entity.Cart, error) {
+	r.logger.Debugf("Getting cart of user: %s", userID)
+
+	var cartModel cart_model.Cart
+	err := r.db.WithContext(ctx).
+		Preload("Items", func(db *gorm.DB) *gorm.DB {
+			return db.Order("created_at ASC")
+		}).
+		First(&cartModel, "user_id = ?", userID).Error
+	if err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, nil
+		}
+		r.logger.Errorf("Failed to get cart of user %s: %v", userID, err)
+		return nil, err
+	}
+
+	return r.converter.ToEntity(&cartModel), nil
+}
+
+func (r *CartRepository) Create(ctx context.Context, cart *cart_entity.Cart) error {
+	r.logger.Infof("Creating cart for user: %s", cart.UserID)
+
+	cartModel := &cart_model.Cart{UserID: cart.UserID}
+	if err := r.db.WithContext(ctx).Create(cartModel).Error; err != nil {
+		r.logger.Errorf("Failed to create cart for user %s: %v", cart.UserID, err)
+		return err
+	}
+	cart.ID = cartModel.ID
+	cart.CreatedAt = cartModel.CreatedAt
+	cart.UpdatedAt = cartModel.UpdatedAt
+
+	return nil
+}
+
+func (r *CartRepository) Delete(ctx context.Context, id string) error {
+	r.logger.Infof("Deleting cart: %s", id)
+
+	if err := r.db.WithContext(ctx).Delete(&cart_model.Cart{}, "id = ?", id).Error; err != nil {
+		r.logger.Errorf("Failed to delete cart %s: %v", id, err)
+		return err
+	}
+
+	return nil
+}
+
+// Touch обновляет время изменения корзины, по нему определяются устаревшие корзины
+func (r *CartRepository) Touch(ctx context.Context, id string) error {
+	err := r.db.WithContext(ctx).
+		Model(&cart_model.Cart{}).
+		Where("id = ?", id).
+		Update("updated_at", gorm.Expr("now()")).Error
+	if err != nil {
+		r.logger.Errorf("Failed to touch cart %s: %v", id, err)
+		return err
+	}
+
+	return nil
+}
+
+// SaveItem создает позицию или обновляет количество и цену уже добавленного товара
+func (r *CartRepository) SaveItem(ctx context.Context, item *cart_entity.CartItem) error {
+	r.logger.Debugf("Saving cart item: product %s x%d in cart %s", item.ProductID, item.Quantity, item.CartID)
+
+	itemModel := r.converter.ToItemModel(item)
+	err := r.db.WithContext(ctx).Clauses(clause.OnConflict{
+		Columns: []clause.Column{{Name: "cart_id"}, {Name: "product_id"}},
+		DoUpdates: clause.Assignments(map[string]any{
+			"name":       item.Name,
+			"article":    item.Article,
+			"quantity":   item.Quantity,
+			"price":      item.Price,
+			"updated_at": gorm.Expr("now()"),
+		}),
+	}).Create(itemModel).Error
+	if err != nil {
+		r.logger.Errorf("Failed to save cart item: %v", err)
+		return err
+	}
+	item.ID = itemModel.ID
+
+	return nil
+}
+
+// DeleteItem удаляет позицию, возвращает false если товара в корзине не было
+func (r *CartRepository) DeleteItem(ctx context.Context, cartID, productID string) (bool, error) {
+	r.logger.Debugf("Deleting product %s from cart %s", productID, cartID)
+
+	result := r.db.WithContext(ctx).Delete(&cart_model.CartItem{}, "cart_id = ? AND product_id = ?", cartID, productID)
+	if result.Error != nil {
+		r.logger.Errorf("Failed to delete cart item: %v", result.Error)
+		return false, result.Error
+	}
+
+	return result.RowsAffected > 0, nil
+}
+
+func (r *CartRepository) ClearItems(ctx context.Context, cartID string) error {
+	r.logger.Infof("Clearing cart: %s", cartID)
+
+	if err := r.db.WithContext(ctx).Delete(&cart_model.CartItem{}, "cart_id = ?", cartID).Error; err != nil {
+		r.logger.Errorf("Failed to clear cart %s: %v", cartID, err)
+		return err
+	}
+
+	return nil
+}
+
+// DeleteStaleShadowCarts удаляет корзины shadow-пользователей с истекшим сроком жизни,
+// которые не менялись с момента staleBefore, а также корзины удаленных пользователей
+func (r *CartRepository) DeleteStaleShadowCarts(ctx context.Context, staleBefore time.Time) (int64, error) {
+	expiredShadows := r.db.Table("users").
+		Select("id").
+		Where("is_shadow = ? AND shadow_expires_at < now()", true)
+	existingUsers := r.db.Table("users").Select("id")
+
+	result := r.db.WithContext(ctx).
+		Where("(user_id IN (?) AND updated_at < ?) OR user_id NOT IN (?)", expiredShadows, staleBefore, existingUsers).
+		Delete(&cart_model.Cart{})
+	if result.Error != nil {
+		r.logger.Errorf("Failed to delete stale shadow carts: %v", result.Error)
+		return 0, result.Error
+	}
+
+	return result.RowsAffected, nil
+}
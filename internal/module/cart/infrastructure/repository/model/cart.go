@@ -0,0 +1,31 @@
+package cart_model
+
+import "time"
+
+type Cart struct {
+	ID        string     `gorm:"primaryKey;type:uuid;default:uuid_generate_v4();"`
+	UserID    string     `gorm:"type:uuid;not null;uniqueIndex"`
+	Items     []CartItem `gorm:"foreignKey:CartID;constraint:OnDelete:CASCADE"`
+	CreatedAt time.Time  `gorm:"not null;default:now()"`
+	UpdatedAt time.Time  `gorm:"not null;default:now();index"`
+}
+
+func (Cart) TableName() string {
+	return "cart_module.carts"
+}
+
+type CartItem struct {
+	ID        string    `gorm:"primaryKey;type:uuid;default:uuid_generate_v4();"`
+	CartID    string    `gorm:"type:uuid;not null;uniqueIndex:idx_cart_item_product"`
+	ProductID string    `gorm:"type:uuid;not null;uniqueIndex:idx_cart_item_product"`
+	Name      string    `gorm:"type:varchar(255);not null"`
+	Article   string    `gorm:"type:varchar(255);not null;default:''"`
+	Quantity  int       `gorm:"not null"`
+	Price     float64   `gorm:"type:float;not null"`
+	CreatedAt time.Time `gorm:"not null;default:now()"`
+	UpdatedAt time.Time `gorm:"not null;default:now()"`
+}
+
+func (CartItem) TableName() string {
+	return "cart_module.cart_items"
+}
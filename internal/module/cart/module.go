@@ -0,0 +1,81 @@
+package cart_module
+
+import (
+	"github.com/Fi44er/sdmed/internal/config"
+	cart_http "github.com/Fi44er/sdmed/internal/module/cart/delivery/http"
+	cart_adapters "github.com/Fi44er/sdmed/internal/module/cart/infrastructure/adapters"
+	cart_repository "github.com/Fi44er/sdmed/internal/module/cart/infrastructure/repository/cart"
+	cart_usecase "github.com/Fi44er/sdmed/internal/module/cart/usecase/cart"
+	cart_usecase_contracts "github.com/Fi44er/sdmed/internal/module/cart/usecase/cart/contracts"
+	product_usecase "github.com/Fi44er/sdmed/internal/module/product/usecase/product"
+	"github.com/Fi44er/sdmed/pkg/logger"
+	"github.com/Fi44er/sdmed/pkg/postgres/uow"
+	"github.com/go-playground/validator/v10"
+	"github.com/gofiber/fiber/v2"
+	"gorm.io/gorm"
+)
+
+type CartModule struct {
+	cartRepository cart_repository.ICartRepository
+	cartUsecase    cart_usecase.ICartUsecase
+	cartHandler    *cart_http.CartHandler
+	cartCleaner    *cart_usecase.CartCleaner
+
+	sessionRepository cart_usecase_contracts.ISessionRepository
+	productUsecase    product_usecase.IProductUsecase
+
+	logger    *logger.Logger
+	validator *validator.Validate
+	db        *gorm.DB
+	uow       uow.Uow
+	config    *config.Config
+}
+
+func NewCartModule(
+	logger *logger.Logger,
+	validator *validator.Validate,
+	db *gorm.DB,
+	uow uow.Uow,
+	config *config.Config,
+	sessionRepository cart_usecase_contracts.ISessionRepository,
+	productUsecase product_usecase.IProductUsecase,
+) *CartModule {
+	return &CartModule{
+		logger:            logger,
+		validator:         validator,
+		db:                db,
+		uow:               uow,
+		config:            config,
+		sessionRepository: sessionRepository,
+		productUsecase:    productUsecase,
+	}
+}
+
+func (m *CartModule) Init() {
+	m.uow.RegisterRepository("cart", func(tx *gorm.DB) (any, error) {
+		return cart_repository.NewCartRepository(m.logger, tx), nil
+	})
+
+	m.cartRepository = cart_repository.NewCartRepository(m.logger, m.db)
+	m.cartUsecase = cart_usecase.NewCartUsecase(
+		m.cartRepository,
+		m.sessionRepository,
+		cart_adapters.NewProductUsecaseAdapter(m.productUsecase),
+		m.uow,
+		m.logger,
+	)
+	m.cartHandler = cart_http.NewCartHandler(m.cartUsecase, m.validator, m.logger)
+	m.cartCleaner = cart_usecase.NewCartCleaner(m.cartRepository, m.logger, m.config.CartCleanupInterval, m.config.CartStaleAfter)
+}
+
+func (m *CartModule) InitDelivery(router fiber.Router) {
+	m.cartHandler.RegisterRoutes(router)
+}
+
+func (m *CartModule) GetCartUsecase() cart_usecase.ICartUsecase {
+	return m.cartUsecase
+}
+
+func (m *CartModule) GetCartCleaner() *cart_usecase.CartCleaner {
+	return m.cartCleaner
+}
@@ -0,0 +1,26 @@
+package cart_constant
+
+import (
+	"time"
+
+	"github.com/Fi44er/sdmed/pkg/customerr"
+)
+
+const (
+	ProcessName = "cart_cleaner"
+
+	// MaxItemQuantity - ограничение количества одной позиции, в том числе после слияния корзин
+	MaxItemQuantity = 999
+
+	DefaultCleanupInterval = time.Hour
+	DefaultStaleAfter      = 7 * 24 * time.Hour
+)
+
+var (
+	ErrCartItemNotFound    = customerr.NewError(404, "cart item not found")
+	ErrProductNotFound     = customerr.NewError(404, "product not found")
+	ErrProductUnavailable  = customerr.NewError(400, "product is not available for order")
+	ErrProductPriceNotSet  = customerr.NewError(400, "product has no price")
+	ErrQuantityOutOfRange  = customerr.NewError(400, "quantity must be between 1 and 999")
+	ErrSessionUserNotFound = customerr.NewError(401, "session user not found")
+)
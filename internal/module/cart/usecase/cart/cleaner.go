@@ -0,0 +1,111 @@
+package cart_usecase
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	cart_constant "github.com/Fi44er/sdmed/internal/module/cart/pkg"
+	cart_usecase_contracts "github.com/Fi44er/sdmed/internal/module/cart/usecase/cart/contracts"
+	"github.com/Fi44er/sdmed/pkg/logger"
+)
+
+// CartCleaner периодически удаляет корзины истекших shadow-пользователей
+type CartCleaner struct {
+	repository cart_usecase_contracts.ICartRepository
+	logger     *logger.Logger
+	interval   time.Duration
+	staleAfter time.Duration
+	stopCh     chan struct{}
+	running    bool
+	mutex      sync.RWMutex
+}
+
+func (cc *CartCleaner) Name() string {
+	return cart_constant.ProcessName
+}
+
+func NewCartCleaner(
+	repository cart_usecase_contracts.ICartRepository,
+	logger *logger.Logger,
+	interval time.Duration,
+	staleAfter time.Duration,
+) *CartCleaner {
+	return &CartCleaner{
+		repository: repository,
+		logger:     logger,
+		interval:   interval,
+		staleAfter: staleAfter,
+		stopCh:     make(chan struct{}),
+	}
+}
+
+func (cc *CartCleaner) Start() {
+	cc.mutex.Lock()
+	defer cc.mutex.Unlock()
+
+	if cc.running {
+		cc.logger.Warn("Cart cleaner is already running")
+		return
+	}
+
+	cc.stopCh = make(chan struct{})
+	cc.running = true
+
+	ticker := time.NewTicker(cc.interval)
+
+	go func() {
+		cc.logger.Infof("Cart cleaner started with interval: %v", cc.interval)
+
+		cc.cleanupStaleCarts()
+		for {
+			select {
+			case <-ticker.C:
+				cc.cleanupStaleCarts()
+			case <-cc.stopCh:
+				ticker.Stop()
+				cc.mutex.Lock()
+				cc.running = false
+				cc.mutex.Unlock()
+				cc.logger.Info("Cart cleaner stopped")
+				return
+			}
+		}
+	}()
+}
+
+func (cc *CartCleaner) Stop(ctx context.Context) error {
+	cc.mutex.Lock()
+	defer cc.mutex.Unlock()
+
+	if !cc.running {
+		return nil
+	}
+
+	close(cc.stopCh)
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-time.After(100 * time.Millisecond):
+		return nil
+	}
+}
+
+func (cc *CartCleaner) IsRunning() bool {
+	cc.mutex.RLock()
+	defer cc.mutex.RUnlock()
+	return cc.running
+}
+
+func (cc *CartCleaner) cleanupStaleCarts() {
+	deleted, err := cc.repository.DeleteStaleShadowCarts(context.Background(), time.Now().Add(-cc.staleAfter))
+	if err != nil {
+		cc.logger.Errorf("Failed to delete stale carts: %v", err)
+		return
+	}
+
+	if deleted > 0 {
+		cc.logger.Infof("Cleaned up %d stale shadow carts", deleted)
+	}
+}
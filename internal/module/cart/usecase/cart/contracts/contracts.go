@@ -0,0 +1,30 @@
+package cart_usecase_contracts
+
+import (
+	"context"
+	"time"
+
+	auth_entity "github.com/Fi44er/sdmed/internal/module/auth/entity"
+	cart_entity "github.com/Fi44er/sdmed/internal/module/cart/entity"
+)
+
+type ICartRepository interface {
+	GetByUserID(ctx context.Context, userID string) (*cart_entity.Cart, error)
+	Create(ctx context.Context, cart *cart_entity.Cart) error
+	Delete(ctx context.Context, id string) error
+	Touch(ctx context.Context, id string) error
+
+	SaveItem(ctx context.Context, item *cart_entity.CartItem) error
+	DeleteItem(ctx context.Context, cartID, productID string) (bool, error)
+	ClearItems(ctx context.Context, cartID string) error
+
+	DeleteStaleShadowCarts(ctx context.Context, staleBefore time.Time) (int64, error)
+}
+
+type ISessionRepository interface {
+	GetSessionInfo(ctx context.Context) (*auth_entity.ActiveSession, error)
+}
+
+type IProductUsecaseAdapter interface {
+	GetByID(ctx context.Context, id string) (*cart_entity.CartProduct, error)
+}
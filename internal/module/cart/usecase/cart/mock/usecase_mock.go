@@ -0,0 +1,229 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./cart/contracts/contracts.go
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	auth_entity "github.com/Fi44er/sdmed/internal/module/auth/entity"
+	cart_entity "github.com/Fi44er/sdmed/internal/module/cart/entity"
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockICartRepository is a mock of ICartRepository interface.
+type MockICartRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockICartRepositoryMockRecorder
+}
+
+// MockICartRepositoryMockRecorder is the mock recorder for MockICartRepository.
+type MockICartRepositoryMockRecorder struct {
+	mock *MockICartRepository
+}
+
+// NewMockICartRepository creates a new mock instance.
+func NewMockICartRepository(ctrl *gomock.Controller) *MockICartRepository {
+	mock := &MockICartRepository{ctrl: ctrl}
+	mock.recorder = &MockICartRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockICartRepository) EXPECT() *MockICartRepositoryMockRecorder {
+	return m.recorder
+}
+
+// ClearItems mocks base method.
+func (m *MockICartRepository) ClearItems(ctx context.Context, cartID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ClearItems", ctx, cartID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ClearItems indicates an expected call of ClearItems.
+func (mr *MockICartRepositoryMockRecorder) ClearItems(ctx, cartID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ClearItems", reflect.TypeOf((*MockICartRepository)(nil).ClearItems), ctx, cartID)
+}
+
+// Create mocks base method.
+func (m *MockICartRepository) Create(ctx context.Context, cart *cart_entity.Cart) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, cart)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockICartRepositoryMockRecorder) Create(ctx, cart interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockICartRepository)(nil).Create), ctx, cart)
+}
+
+// Delete mocks base method.
+func (m *MockICartRepository) Delete(ctx context.Context, id string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockICartRepositoryMockRecorder) Delete(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockICartRepository)(nil).Delete), ctx, id)
+}
+
+// DeleteItem mocks base method.
+func (m *MockICartRepository) DeleteItem(ctx context.Context, cartID, productID string) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteItem", ctx, cartID, productID)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteItem indicates an expected call of DeleteItem.
+func (mr *MockICartRepositoryMockRecorder) DeleteItem(ctx, cartID, productID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteItem", reflect.TypeOf((*MockICartRepository)(nil).DeleteItem), ctx, cartID, productID)
+}
+
+// DeleteStaleShadowCarts mocks base method.
+func (m *MockICartRepository) DeleteStaleShadowCarts(ctx context.Context, staleBefore time.Time) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteStaleShadowCarts", ctx, staleBefore)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteStaleShadowCarts indicates an expected call of DeleteStaleShadowCarts.
+func (mr *MockICartRepositoryMockRecorder) DeleteStaleShadowCarts(ctx, staleBefore interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteStaleShadowCarts", reflect.TypeOf((*MockICartRepository)(nil).DeleteStaleShadowCarts), ctx, staleBefore)
+}
+
+// GetByUserID mocks base method.
+func (m *MockICartRepository) GetByUserID(ctx context.Context, userID string) (*cart_entity.Cart, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByUserID", ctx, userID)
+	ret0, _ := ret[0].(*cart_entity.Cart)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByUserID indicates an expected call of GetByUserID.
+func (mr *MockICartRepositoryMockRecorder) GetByUserID(ctx, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByUserID", reflect.TypeOf((*MockICartRepository)(nil).GetByUserID), ctx, userID)
+}
+
+// SaveItem mocks base method.
+func (m *MockICartRepository) SaveItem(ctx context.Context, item *cart_entity.CartItem) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SaveItem", ctx, item)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SaveItem indicates an expected call of SaveItem.
+func (mr *MockICartRepositoryMockRecorder) SaveItem(ctx, item interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveItem", reflect.TypeOf((*MockICartRepository)(nil).SaveItem), ctx, item)
+}
+
+// Touch mocks base method.
+func (m *MockICartRepository) Touch(ctx context.Context, id string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Touch", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Touch indicates an expected call of Touch.
+func (mr *MockICartRepositoryMockRecorder) Touch(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Touch", reflect.TypeOf((*MockICartRepository)(nil).Touch), ctx, id)
+}
+
+// MockISessionRepository is a mock of ISessionRepository interface.
+type MockISessionRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockISessionRepositoryMockRecorder
+}
+
+// MockISessionRepositoryMockRecorder is the mock recorder for MockISessionRepository.
+type MockISessionRepositoryMockRecorder struct {
+	mock *MockISessionRepository
+}
+
+// NewMockISessionRepository creates a new mock instance.
+func NewMockISessionRepository(ctrl *gomock.Controller) *MockISessionRepository {
+	mock := &MockISessionRepository{ctrl: ctrl}
+	mock.recorder = &MockISessionRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockISessionRepository) EXPECT() *MockISessionRepositoryMockRecorder {
+	return m.recorder
+}
+
+// GetSessionInfo mocks base method.
+func (m *MockISessionRepository) GetSessionInfo(ctx context.Context) (*auth_entity.ActiveSession, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSessionInfo", ctx)
+	ret0, _ := ret[0].(*auth_entity.ActiveSession)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSessionInfo indicates an expected call of GetSessionInfo.
+func (mr *MockISessionRepositoryMockRecorder) GetSessionInfo(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSessionInfo", reflect.TypeOf((*MockISessionRepository)(nil).GetSessionInfo), ctx)
+}
+
+// MockIProductUsecaseAdapter is a mock of IProductUsecaseAdapter interface.
+type MockIProductUsecaseAdapter struct {
+	ctrl     *gomock.Controller
+	recorder *MockIProductUsecaseAdapterMockRecorder
+}
+
+// MockIProductUsecaseAdapterMockRecorder is the mock recorder for MockIProductUsecaseAdapter.
+type MockIProductUsecaseAdapterMockRecorder struct {
+	mock *MockIProductUsecaseAdapter
+}
+
+// NewMockIProductUsecaseAdapter creates a new mock instance.
+func NewMockIProductUsecaseAdapter(ctrl *gomock.Controller) *MockIProductUsecaseAdapter {
+	mock := &MockIProductUsecaseAdapter{ctrl: ctrl}
+	mock.recorder = &MockIProductUsecaseAdapterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockIProductUsecaseAdapter) EXPECT() *MockIProductUsecaseAdapterMockRecorder {
+	return m.recorder
+}
+
+// GetByID mocks base method.
+func (m *MockIProductUsecaseAdapter) GetByID(ctx context.Context, id string) (*cart_entity.CartProduct, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByID", ctx, id)
+	ret0, _ := ret[0].(*cart_entity.CartProduct)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByID indicates an expected call of GetByID.
+func (mr *MockIProductUsecaseAdapterMockRecorder) GetByID(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByID", reflect.TypeOf((*MockIProductUsecaseAdapter)(nil).GetByID), ctx, id)
+}
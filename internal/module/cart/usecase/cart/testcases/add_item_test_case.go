@@ -0,0 +1,164 @@
+package cart_testcases
+
+import (
+	"context"
+
+	auth_entity "github.com/Fi44er/sdmed/internal/module/auth/entity"
+	cart_entity "github.com/Fi44er/sdmed/internal/module/cart/entity"
+	cart_constant "github.com/Fi44er/sdmed/internal/module/cart/pkg"
+	"github.com/Fi44er/sdmed/internal/module/cart/usecase/cart/mock"
+	uow_mock "github.com/Fi44er/sdmed/pkg/postgres/uow/mock"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+type MockAddItem struct {
+	Ctrl            *gomock.Controller
+	Ctx             context.Context
+	RepoMock        *mock.MockICartRepository
+	SessionRepoMock *mock.MockISessionRepository
+	ProductMock     *mock.MockIProductUsecaseAdapter
+	UowMock         *uow_mock.MockUow
+	T               assert.TestingT
+}
+
+type AddItemTestCase struct {
+	Name          string
+	ProductID     string
+	Quantity      int
+	SetupMocks    func(m *MockAddItem)
+	ExpectedCart  *cart_entity.Cart
+	ExpectedError error
+}
+
+var (
+	price    = 1000.0
+	walker   = &cart_entity.CartProduct{ID: "walker", Name: "Ходунки", Article: "W-1", Price: &price, IsActive: true}
+	archived = &cart_entity.CartProduct{ID: "archived", Name: "Снят с продажи", Price: &price}
+	noPrice  = &cart_entity.CartProduct{ID: "no-price", Name: "Без цены", IsActive: true}
+)
+
+func (m *MockAddItem) expectTx() {
+	m.UowMock.EXPECT().
+		Do(m.Ctx, gomock.Any()).
+		DoAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+			return fn(ctx)
+		})
+	m.UowMock.EXPECT().GetRepository(m.Ctx, "cart").Return(m.RepoMock, nil)
+}
+
+func GetAddItemTestCases() []AddItemTestCase {
+	added := &cart_entity.Cart{ID: "cart-1", UserID: "guest", Items: []cart_entity.CartItem{
+		{CartID: "cart-1", ProductID: "walker", Name: "Ходунки", Article: "W-1", Quantity: 3, Price: 1000},
+	}}
+
+	return []AddItemTestCase{
+		{
+			Name:      "cart_created_for_first_item",
+			ProductID: "walker",
+			Quantity:  2,
+			SetupMocks: func(m *MockAddItem) {
+				m.SessionRepoMock.EXPECT().GetSessionInfo(m.Ctx).Return(&auth_entity.ActiveSession{UserID: "guest"}, nil)
+				m.ProductMock.EXPECT().GetByID(m.Ctx, "walker").Return(walker, nil)
+				m.expectTx()
+				m.RepoMock.EXPECT().GetByUserID(m.Ctx, "guest").Return(nil, nil)
+				m.RepoMock.EXPECT().
+					Create(m.Ctx, gomock.Any()).
+					DoAndReturn(func(ctx context.Context, cart *cart_entity.Cart) error {
+						assert.Equal(m.T, "guest", cart.UserID)
+						cart.ID = "cart-1"
+						return nil
+					})
+				m.RepoMock.EXPECT().
+					SaveItem(m.Ctx, gomock.Any()).
+					DoAndReturn(func(ctx context.Context, item *cart_entity.CartItem) error {
+						assert.Equal(m.T, cart_entity.CartItem{
+							CartID: "cart-1", ProductID: "walker", Name: "Ходунки", Article: "W-1", Quantity: 2, Price: 1000,
+						}, *item)
+						return nil
+					})
+				m.RepoMock.EXPECT().Touch(m.Ctx, "cart-1").Return(nil)
+				m.RepoMock.EXPECT().GetByUserID(m.Ctx, "guest").Return(added, nil)
+			},
+			ExpectedCart: added,
+		},
+		{
+			Name:      "same_product_increases_quantity_with_current_price",
+			ProductID: "walker",
+			Quantity:  1,
+			SetupMocks: func(m *MockAddItem) {
+				m.SessionRepoMock.EXPECT().GetSessionInfo(m.Ctx).Return(&auth_entity.ActiveSession{UserID: "guest"}, nil)
+				m.ProductMock.EXPECT().GetByID(m.Ctx, "walker").Return(walker, nil)
+				m.expectTx()
+				m.RepoMock.EXPECT().GetByUserID(m.Ctx, "guest").Return(&cart_entity.Cart{
+					ID: "cart-1", UserID: "guest", Items: []cart_entity.CartItem{
+						{ID: "item-1", CartID: "cart-1", ProductID: "walker", Quantity: 2, Price: 900},
+					},
+				}, nil)
+				m.RepoMock.EXPECT().
+					SaveItem(m.Ctx, gomock.Any()).
+					DoAndReturn(func(ctx context.Context, item *cart_entity.CartItem) error {
+						assert.Equal(m.T, 3, item.Quantity)
+						assert.Equal(m.T, 1000.0, item.Price)
+						return nil
+					})
+				m.RepoMock.EXPECT().Touch(m.Ctx, "cart-1").Return(nil)
+				m.RepoMock.EXPECT().GetByUserID(m.Ctx, "guest").Return(added, nil)
+			},
+			ExpectedCart: added,
+		},
+		{
+			Name:      "quantity_limit",
+			ProductID: "walker",
+			Quantity:  cart_constant.MaxItemQuantity,
+			SetupMocks: func(m *MockAddItem) {
+				m.SessionRepoMock.EXPECT().GetSessionInfo(m.Ctx).Return(&auth_entity.ActiveSession{UserID: "guest"}, nil)
+				m.ProductMock.EXPECT().GetByID(m.Ctx, "walker").Return(walker, nil)
+				m.expectTx()
+				m.RepoMock.EXPECT().GetByUserID(m.Ctx, "guest").Return(&cart_entity.Cart{
+					ID: "cart-1", UserID: "guest", Items: []cart_entity.CartItem{{CartID: "cart-1", ProductID: "walker", Quantity: 1}},
+				}, nil)
+			},
+			ExpectedError: cart_constant.ErrQuantityOutOfRange,
+		},
+		{
+			Name:      "inactive_product",
+			ProductID: "archived",
+			Quantity:  1,
+			SetupMocks: func(m *MockAddItem) {
+				m.SessionRepoMock.EXPECT().GetSessionInfo(m.Ctx).Return(&auth_entity.ActiveSession{UserID: "guest"}, nil)
+				m.ProductMock.EXPECT().GetByID(m.Ctx, "archived").Return(archived, nil)
+			},
+			ExpectedError: cart_constant.ErrProductUnavailable,
+		},
+		{
+			Name:      "product_without_price",
+			ProductID: "no-price",
+			Quantity:  1,
+			SetupMocks: func(m *MockAddItem) {
+				m.SessionRepoMock.EXPECT().GetSessionInfo(m.Ctx).Return(&auth_entity.ActiveSession{UserID: "guest"}, nil)
+				m.ProductMock.EXPECT().GetByID(m.Ctx, "no-price").Return(noPrice, nil)
+			},
+			ExpectedError: cart_constant.ErrProductPriceNotSet,
+		},
+		{
+			Name:      "product_not_found",
+			ProductID: "missing",
+			Quantity:  1,
+			SetupMocks: func(m *MockAddItem) {
+				m.SessionRepoMock.EXPECT().GetSessionInfo(m.Ctx).Return(&auth_entity.ActiveSession{UserID: "guest"}, nil)
+				m.ProductMock.EXPECT().GetByID(m.Ctx, "missing").Return(nil, nil)
+			},
+			ExpectedError: cart_constant.ErrProductNotFound,
+		},
+		{
+			Name:      "no_session_user",
+			ProductID: "walker",
+			Quantity:  1,
+			SetupMocks: func(m *MockAddItem) {
+				m.SessionRepoMock.EXPECT().GetSessionInfo(m.Ctx).Return(&auth_entity.ActiveSession{}, nil)
+			},
+			ExpectedError: cart_constant.ErrSessionUserNotFound,
+		},
+	}
+}
@@ -0,0 +1,138 @@
+package cart_testcases
+
+import (
+	"context"
+	"time"
+
+	cart_entity "github.com/Fi44er/sdmed/internal/module/cart/entity"
+	"github.com/Fi44er/sdmed/internal/module/cart/usecase/cart/mock"
+	uow_mock "github.com/Fi44er/sdmed/pkg/postgres/uow/mock"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+type MockMergeCarts struct {
+	Ctrl     *gomock.Controller
+	Ctx      context.Context
+	RepoMock *mock.MockICartRepository
+	UowMock  *uow_mock.MockUow
+	T        assert.TestingT
+}
+
+type MergeCartsTestCase struct {
+	Name          string
+	FromUserID    string
+	ToUserID      string
+	SetupMocks    func(m *MockMergeCarts)
+	ExpectedError error
+}
+
+func (m *MockMergeCarts) expectTx() {
+	m.UowMock.EXPECT().
+		Do(m.Ctx, gomock.Any()).
+		DoAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+			return fn(ctx)
+		})
+	m.UowMock.EXPECT().GetRepository(m.Ctx, "cart").Return(m.RepoMock, nil)
+}
+
+func GetMergeCartsTestCases() []MergeCartsTestCase {
+	now := time.Now()
+
+	return []MergeCartsTestCase{
+		{
+			Name:       "quantities_summed_and_newer_price_wins",
+			FromUserID: "guest",
+			ToUserID:   "user",
+			SetupMocks: func(m *MockMergeCarts) {
+				m.expectTx()
+				m.RepoMock.EXPECT().GetByUserID(m.Ctx, "guest").Return(&cart_entity.Cart{
+					ID: "guest-cart", UserID: "guest", Items: []cart_entity.CartItem{
+						{ID: "guest-walker", CartID: "guest-cart", ProductID: "walker", Quantity: 2, Price: 1000, UpdatedAt: now},
+						{ID: "guest-cane", CartID: "guest-cart", ProductID: "cane", Quantity: 1, Price: 500, UpdatedAt: now.Add(-2 * time.Hour)},
+					},
+				}, nil)
+				m.RepoMock.EXPECT().GetByUserID(m.Ctx, "user").Return(&cart_entity.Cart{
+					ID: "user-cart", UserID: "user", Items: []cart_entity.CartItem{
+						{ID: "user-walker", CartID: "user-cart", ProductID: "walker", Quantity: 1, Price: 900, UpdatedAt: now.Add(-time.Hour)},
+						{ID: "user-cane", CartID: "user-cart", ProductID: "cane", Quantity: 1, Price: 450, UpdatedAt: now.Add(-time.Hour)},
+					},
+				}, nil)
+				gomock.InOrder(
+					m.RepoMock.EXPECT().
+						SaveItem(m.Ctx, gomock.Any()).
+						DoAndReturn(func(ctx context.Context, item *cart_entity.CartItem) error {
+							assert.Equal(m.T, "", item.ID)
+							assert.Equal(m.T, "user-cart", item.CartID)
+							assert.Equal(m.T, 3, item.Quantity)
+							assert.Equal(m.T, 1000.0, item.Price, "newer guest price wins")
+							return nil
+						}),
+					m.RepoMock.EXPECT().
+						SaveItem(m.Ctx, gomock.Any()).
+						DoAndReturn(func(ctx context.Context, item *cart_entity.CartItem) error {
+							assert.Equal(m.T, 2, item.Quantity)
+							assert.Equal(m.T, 450.0, item.Price, "newer user price is kept")
+							return nil
+						}),
+				)
+				m.RepoMock.EXPECT().Touch(m.Ctx, "user-cart").Return(nil)
+				m.RepoMock.EXPECT().Delete(m.Ctx, "guest-cart").Return(nil)
+			},
+		},
+		{
+			Name:       "user_cart_created",
+			FromUserID: "guest",
+			ToUserID:   "user",
+			SetupMocks: func(m *MockMergeCarts) {
+				m.expectTx()
+				m.RepoMock.EXPECT().GetByUserID(m.Ctx, "guest").Return(&cart_entity.Cart{
+					ID: "guest-cart", UserID: "guest", Items: []cart_entity.CartItem{
+						{CartID: "guest-cart", ProductID: "walker", Quantity: 2, Price: 1000},
+					},
+				}, nil)
+				m.RepoMock.EXPECT().GetByUserID(m.Ctx, "user").Return(nil, nil)
+				m.RepoMock.EXPECT().
+					Create(m.Ctx, gomock.Any()).
+					DoAndReturn(func(ctx context.Context, cart *cart_entity.Cart) error {
+						cart.ID = "user-cart"
+						return nil
+					})
+				m.RepoMock.EXPECT().
+					SaveItem(m.Ctx, gomock.Any()).
+					DoAndReturn(func(ctx context.Context, item *cart_entity.CartItem) error {
+						assert.Equal(m.T, "user-cart", item.CartID)
+						assert.Equal(m.T, 2, item.Quantity)
+						return nil
+					})
+				m.RepoMock.EXPECT().Touch(m.Ctx, "user-cart").Return(nil)
+				m.RepoMock.EXPECT().Delete(m.Ctx, "guest-cart").Return(nil)
+			},
+		},
+		{
+			Name:       "empty_guest_cart_deleted",
+			FromUserID: "guest",
+			ToUserID:   "user",
+			SetupMocks: func(m *MockMergeCarts) {
+				m.expectTx()
+				m.RepoMock.EXPECT().GetByUserID(m.Ctx, "guest").Return(&cart_entity.Cart{ID: "guest-cart", UserID: "guest"}, nil)
+				m.RepoMock.EXPECT().Delete(m.Ctx, "guest-cart").Return(nil)
+			},
+		},
+		{
+			Name:       "no_guest_cart",
+			FromUserID: "guest",
+			ToUserID:   "user",
+			SetupMocks: func(m *MockMergeCarts) {
+				m.expectTx()
+				m.RepoMock.EXPECT().GetByUserID(m.Ctx, "guest").Return(nil, nil)
+			},
+		},
+		{
+			Name:       "same_user",
+			FromUserID: "user",
+			ToUserID:   "user",
+			SetupMocks: func(m *MockMergeCarts) {},
+		},
+	}
+}
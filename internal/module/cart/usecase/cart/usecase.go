@@ -0,0 +1,345 @@
+package cart_usecase
+
+import (
+	"context"
+
+	cart_entity "github.com/Fi44er/sdmed/internal/module/cart/entity"
+	cart_constant "github.com/Fi44er/sdmed/internal/module/cart/pkg"
+	cart_usecase_contracts "github.com/Fi44er/sdmed/internal/module/cart/usecase/cart/contracts"
+	"github.com/Fi44er/sdmed/pkg/logger"
+	"github.com/Fi44er/sdmed/pkg/postgres/uow"
+)
+
+type ICartUsecase interface {
+	Get(ctx context.Context) (*cart_entity.Cart, error)
+	AddItem(ctx context.Context, productID string, quantity int) (*cart_entity.Cart, error)
+	UpdateQuantity(ctx context.Context, productID string, quantity int) (*cart_entity.Cart, error)
+	RemoveItem(ctx context.Context, productID string) (*cart_entity.Cart, error)
+	Clear(ctx context.Context) error
+
+	GetByUserID(ctx context.Context, userID string) (*cart_entity.Cart, error)
+	ClearByUserID(ctx context.Context, userID string) error
+	MergeCarts(ctx context.Context, fromUserID, toUserID string) error
+}
+
+type CartUsecase struct {
+	repository        cart_usecase_contracts.ICartRepository
+	sessionRepository cart_usecase_contracts.ISessionRepository
+	productUsecase    cart_usecase_contracts.IProductUsecaseAdapter
+	uow               uow.Uow
+	logger            *logger.Logger
+}
+
+func NewCartUsecase(
+	repository cart_usecase_contracts.ICartRepository,
+	sessionRepository cart_usecase_contracts.ISessionRepository,
+	productUsecase cart_usecase_contracts.IProductUsecaseAdapter,
+	uow uow.Uow,
+	logger *logger.Logger,
+) ICartUsecase {
+	return &CartUsecase{
+		repository:        repository,
+		sessionRepository: sessionRepository,
+		productUsecase:    productUsecase,
+		uow:               uow,
+		logger:            logger,
+	}
+}
+
+// Get возвращает корзину текущей сессии. Если корзины еще нет, возвращается пустая
+func (u *CartUsecase) Get(ctx context.Context) (*cart_entity.Cart, error) {
+	userID, err := u.getSessionUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return u.GetByUserID(ctx, userID)
+}
+
+func (u *CartUsecase) GetByUserID(ctx context.Context, userID string) (*cart_entity.Cart, error) {
+	cart, err := u.repository.GetByUserID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if cart == nil {
+		return &cart_entity.Cart{UserID: userID, Items: []cart_entity.CartItem{}}, nil
+	}
+
+	return cart, nil
+}
+
+// AddItem добавляет товар в корзину с текущей ценой. Если товар уже в корзине,
+// количество увеличивается, а цена обновляется до актуальной
+func (u *CartUsecase) AddItem(ctx context.Context, productID string, quantity int) (*cart_entity.Cart, error) {
+	userID, err := u.getSessionUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	u.logger.Infof("Adding product %s x%d to cart of user %s", productID, quantity, userID)
+
+	product, err := u.getOrderableProduct(ctx, productID)
+	if err != nil {
+		return nil, err
+	}
+
+	err = u.uow.Do(ctx, func(ctx context.Context) error {
+		repo, err := u.getRepository(ctx)
+		if err != nil {
+			return err
+		}
+
+		cart, err := u.getOrCreate(ctx, repo, userID)
+		if err != nil {
+			return err
+		}
+
+		item := &cart_entity.CartItem{CartID: cart.ID, ProductID: product.ID, Quantity: quantity}
+		if exist := cart.FindItem(product.ID); exist != nil {
+			item.Quantity += exist.Quantity
+		}
+		if err := validateQuantity(item.Quantity); err != nil {
+			return err
+		}
+
+		item.Name, item.Article, item.Price = product.Name, product.Article, *product.Price
+		if err := repo.SaveItem(ctx, item); err != nil {
+			return err
+		}
+
+		return repo.Touch(ctx, cart.ID)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return u.GetByUserID(ctx, userID)
+}
+
+// UpdateQuantity меняет количество товара, зафиксированная цена позиции не меняется
+func (u *CartUsecase) UpdateQuantity(ctx context.Context, productID string, quantity int) (*cart_entity.Cart, error) {
+	userID, err := u.getSessionUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	u.logger.Infof("Setting quantity of product %s to %d in cart of user %s", productID, quantity, userID)
+
+	if err := validateQuantity(quantity); err != nil {
+		return nil, err
+	}
+
+	err = u.uow.Do(ctx, func(ctx context.Context) error {
+		repo, err := u.getRepository(ctx)
+		if err != nil {
+			return err
+		}
+
+		cart, err := repo.GetByUserID(ctx, userID)
+		if err != nil {
+			return err
+		}
+		if cart == nil {
+			return cart_constant.ErrCartItemNotFound
+		}
+
+		item := cart.FindItem(productID)
+		if item == nil {
+			return cart_constant.ErrCartItemNotFound
+		}
+
+		item.Quantity = quantity
+		if err := repo.SaveItem(ctx, item); err != nil {
+			return err
+		}
+
+		return repo.Touch(ctx, cart.ID)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return u.GetByUserID(ctx, userID)
+}
+
+func (u *CartUsecase) RemoveItem(ctx context.Context, productID string) (*cart_entity.Cart, error) {
+	userID, err := u.getSessionUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	u.logger.Infof("Removing product %s from cart of user %s", productID, userID)
+
+	err = u.uow.Do(ctx, func(ctx context.Context) error {
+		repo, err := u.getRepository(ctx)
+		if err != nil {
+			return err
+		}
+
+		cart, err := repo.GetByUserID(ctx, userID)
+		if err != nil {
+			return err
+		}
+		if cart == nil {
+			return cart_constant.ErrCartItemNotFound
+		}
+
+		deleted, err := repo.DeleteItem(ctx, cart.ID, productID)
+		if err != nil {
+			return err
+		}
+		if !deleted {
+			return cart_constant.ErrCartItemNotFound
+		}
+
+		return repo.Touch(ctx, cart.ID)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return u.GetByUserID(ctx, userID)
+}
+
+func (u *CartUsecase) Clear(ctx context.Context) error {
+	userID, err := u.getSessionUserID(ctx)
+	if err != nil {
+		return err
+	}
+
+	return u.ClearByUserID(ctx, userID)
+}
+
+// ClearByUserID очищает корзину пользователя. Выполняется в транзакции вызывающего,
+// например при оформлении заказа
+func (u *CartUsecase) ClearByUserID(ctx context.Context, userID string) error {
+	u.logger.Infof("Clearing cart of user %s", userID)
+
+	return u.uow.Do(ctx, func(ctx context.Context) error {
+		repo, err := u.getRepository(ctx)
+		if err != nil {
+			return err
+		}
+
+		cart, err := repo.GetByUserID(ctx, userID)
+		if err != nil {
+			return err
+		}
+		if cart == nil {
+			return nil
+		}
+
+		return repo.ClearItems(ctx, cart.ID)
+	})
+}
+
+// MergeCarts переносит гостевую корзину в корзину пользователя при входе.
+// Количество одинаковых товаров складывается, цена берется из более поздней позиции.
+// Гостевая корзина после слияния удаляется.
+func (u *CartUsecase) MergeCarts(ctx context.Context, fromUserID, toUserID string) error {
+	if fromUserID == toUserID {
+		return nil
+	}
+	u.logger.Infof("Merging cart of user %s into cart of user %s", fromUserID, toUserID)
+
+	return u.uow.Do(ctx, func(ctx context.Context) error {
+		repo, err := u.getRepository(ctx)
+		if err != nil {
+			return err
+		}
+
+		from, err := repo.GetByUserID(ctx, fromUserID)
+		if err != nil {
+			return err
+		}
+		if from == nil {
+			return nil
+		}
+
+		if len(from.Items) > 0 {
+			to, err := u.getOrCreate(ctx, repo, toUserID)
+			if err != nil {
+				return err
+			}
+
+			for _, item := range from.Items {
+				merged := item
+				merged.ID, merged.CartID = "", to.ID
+				if exist := to.FindItem(item.ProductID); exist != nil {
+					merged.Quantity = min(exist.Quantity+item.Quantity, cart_constant.MaxItemQuantity)
+					if exist.UpdatedAt.After(item.UpdatedAt) {
+						merged.Price = exist.Price
+					}
+				}
+
+				if err := repo.SaveItem(ctx, &merged); err != nil {
+					return err
+				}
+			}
+
+			if err := repo.Touch(ctx, to.ID); err != nil {
+				return err
+			}
+		}
+
+		return repo.Delete(ctx, from.ID)
+	})
+}
+
+func (u *CartUsecase) getOrderableProduct(ctx context.Context, productID string) (*cart_entity.CartProduct, error) {
+	product, err := u.productUsecase.GetByID(ctx, productID)
+	if err != nil {
+		return nil, err
+	}
+	if product == nil {
+		return nil, cart_constant.ErrProductNotFound
+	}
+	if !product.IsActive {
+		return nil, cart_constant.ErrProductUnavailable
+	}
+	if product.Price == nil || *product.Price <= 0 {
+		return nil, cart_constant.ErrProductPriceNotSet
+	}
+
+	return product, nil
+}
+
+func (u *CartUsecase) getOrCreate(ctx context.Context, repo cart_usecase_contracts.ICartRepository, userID string) (*cart_entity.Cart, error) {
+	cart, err := repo.GetByUserID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if cart != nil {
+		return cart, nil
+	}
+
+	cart = &cart_entity.Cart{UserID: userID}
+	if err := repo.Create(ctx, cart); err != nil {
+		return nil, err
+	}
+
+	return cart, nil
+}
+
+func (u *CartUsecase) getSessionUserID(ctx context.Context) (string, error) {
+	sessionInfo, err := u.sessionRepository.GetSessionInfo(ctx)
+	if err != nil || sessionInfo.UserID == "" {
+		u.logger.Warnf("Cart requested without session user: %v", err)
+		return "", cart_constant.ErrSessionUserNotFound
+	}
+
+	return sessionInfo.UserID, nil
+}
+
+func (u *CartUsecase) getRepository(ctx context.Context) (cart_usecase_contracts.ICartRepository, error) {
+	repo, err := u.uow.GetRepository(ctx, "cart")
+	if err != nil {
+		u.logger.Errorf("Failed to get repository: %v", err)
+		return nil, err
+	}
+	return repo.(cart_usecase_contracts.ICartRepository), nil
+}
+
+func validateQuantity(quantity int) error {
+	if quantity < 1 || quantity > cart_constant.MaxItemQuantity {
+		return cart_constant.ErrQuantityOutOfRange
+	}
+	return nil
+}
@@ -0,0 +1,94 @@
+package cart_usecase_test
+
+import (
+	"context"
+	"testing"
+
+	cart_usecase "github.com/Fi44er/sdmed/internal/module/cart/usecase/cart"
+	"github.com/Fi44er/sdmed/internal/module/cart/usecase/cart/mock"
+	cart_testcases "github.com/Fi44er/sdmed/internal/module/cart/usecase/cart/testcases"
+	"github.com/Fi44er/sdmed/pkg/logger"
+	uow_mock "github.com/Fi44er/sdmed/pkg/postgres/uow/mock"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+)
+
+type CartUsecaseTestSuite struct {
+	suite.Suite
+	ctx    context.Context
+	logger *logger.Logger
+}
+
+func (s *CartUsecaseTestSuite) SetupSuite() {
+	s.ctx = context.Background()
+	s.logger = logger.NewLogger()
+}
+
+func TestCartUsecase(t *testing.T) {
+	suite.Run(t, new(CartUsecaseTestSuite))
+}
+
+func (s *CartUsecaseTestSuite) TestAddItem() {
+	tests := cart_testcases.GetAddItemTestCases()
+
+	for _, tc := range tests {
+		s.T().Run(tc.Name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockStruct := &cart_testcases.MockAddItem{
+				Ctrl:            ctrl,
+				Ctx:             s.ctx,
+				RepoMock:        mock.NewMockICartRepository(ctrl),
+				SessionRepoMock: mock.NewMockISessionRepository(ctrl),
+				ProductMock:     mock.NewMockIProductUsecaseAdapter(ctrl),
+				UowMock:         uow_mock.NewMockUow(ctrl),
+				T:               t,
+			}
+
+			usecase := cart_usecase.NewCartUsecase(mockStruct.RepoMock, mockStruct.SessionRepoMock, mockStruct.ProductMock, mockStruct.UowMock, s.logger)
+
+			tc.SetupMocks(mockStruct)
+			cart, err := usecase.AddItem(s.ctx, tc.ProductID, tc.Quantity)
+
+			if tc.ExpectedError != nil {
+				assert.ErrorIs(t, err, tc.ExpectedError)
+				assert.Nil(t, cart)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tc.ExpectedCart, cart)
+			}
+		})
+	}
+}
+
+func (s *CartUsecaseTestSuite) TestMergeCarts() {
+	tests := cart_testcases.GetMergeCartsTestCases()
+
+	for _, tc := range tests {
+		s.T().Run(tc.Name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockStruct := &cart_testcases.MockMergeCarts{
+				Ctrl:     ctrl,
+				Ctx:      s.ctx,
+				RepoMock: mock.NewMockICartRepository(ctrl),
+				UowMock:  uow_mock.NewMockUow(ctrl),
+				T:        t,
+			}
+
+			usecase := cart_usecase.NewCartUsecase(mockStruct.RepoMock, mock.NewMockISessionRepository(ctrl), mock.NewMockIProductUsecaseAdapter(ctrl), mockStruct.UowMock, s.logger)
+
+			tc.SetupMocks(mockStruct)
+			err := usecase.MergeCarts(s.ctx, tc.FromUserID, tc.ToUserID)
+
+			if tc.ExpectedError != nil {
+				assert.ErrorIs(t, err, tc.ExpectedError)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,103 @@
+package delivery_http
+
+import (
+	delivery_dto "github.com/Fi44er/sdmed/internal/module/delivery/dto"
+	delivery_entity "github.com/Fi44er/sdmed/internal/module/delivery/entity"
+)
+
+type Converter struct{}
+
+func (c *Converter) ToMethod(dto *delivery_dto.MethodRequest) *delivery_entity.Method {
+	return &delivery_entity.Method{
+		Code:        dto.Code,
+		Name:        dto.Name,
+		Type:        delivery_entity.MethodType(dto.Type),
+		Description: dto.Description,
+		IsActive:    dto.IsActive,
+		SortOrder:   dto.SortOrder,
+	}
+}
+
+func (c *Converter) ToZone(dto *delivery_dto.ZoneRequest) *delivery_entity.Zone {
+	return &delivery_entity.Zone{
+		Name:           dto.Name,
+		RegionCodes:    dto.RegionCodes,
+		PostalCodes:    dto.PostalCodes,
+		CostType:       delivery_entity.CostType(dto.CostType),
+		Price:          dto.Price,
+		IncludedWeight: dto.IncludedWeight,
+		PricePerKg:     dto.PricePerKg,
+		FreeFrom:       dto.FreeFrom,
+		MinDays:        dto.MinDays,
+		MaxDays:        dto.MaxDays,
+		IsActive:       dto.IsActive,
+	}
+}
+
+func (c *Converter) ToCreateZone(dto *delivery_dto.CreateZoneRequest) *delivery_entity.Zone {
+	zone := c.ToZone(&dto.ZoneRequest)
+	zone.MethodID = dto.MethodID
+	return zone
+}
+
+func (c *Converter) ToZoneResponse(entity *delivery_entity.Zone) *delivery_dto.ZoneResponse {
+	return &delivery_dto.ZoneResponse{
+		ID:             entity.ID,
+		MethodID:       entity.MethodID,
+		Name:           entity.Name,
+		RegionCodes:    entity.RegionCodes,
+		PostalCodes:    entity.PostalCodes,
+		CostType:       string(entity.CostType),
+		Price:          entity.Price,
+		IncludedWeight: entity.IncludedWeight,
+		PricePerKg:     entity.PricePerKg,
+		FreeFrom:       entity.FreeFrom,
+		MinDays:        entity.MinDays,
+		MaxDays:        entity.MaxDays,
+		IsActive:       entity.IsActive,
+		CreatedAt:      entity.CreatedAt,
+		UpdatedAt:      entity.UpdatedAt,
+	}
+}
+
+func (c *Converter) ToMethodResponse(entity *delivery_entity.Method) *delivery_dto.MethodResponse {
+	response := &delivery_dto.MethodResponse{
+		ID:          entity.ID,
+		Code:        entity.Code,
+		Name:        entity.Name,
+		Type:        string(entity.Type),
+		Description: entity.Description,
+		IsActive:    entity.IsActive,
+		SortOrder:   entity.SortOrder,
+		Zones:       make([]delivery_dto.ZoneResponse, len(entity.Zones)),
+		CreatedAt:   entity.CreatedAt,
+		UpdatedAt:   entity.UpdatedAt,
+	}
+	for i := range entity.Zones {
+		response.Zones[i] = *c.ToZoneResponse(&entity.Zones[i])
+	}
+
+	return response
+}
+
+func (c *Converter) ToMethodResponses(entities []delivery_entity.Method) []delivery_dto.MethodResponse {
+	responses := make([]delivery_dto.MethodResponse, len(entities))
+	for i := range entities {
+		responses[i] = *c.ToMethodResponse(&entities[i])
+	}
+	return responses
+}
+
+func (c *Converter) ToPublicMethodResponses(entities []delivery_entity.Method) []delivery_dto.PublicMethodResponse {
+	responses := make([]delivery_dto.PublicMethodResponse, len(entities))
+	for i, entity := range entities {
+		responses[i] = delivery_dto.PublicMethodResponse{
+			ID:          entity.ID,
+			Code:        entity.Code,
+			Name:        entity.Name,
+			Type:        string(entity.Type),
+			Description: entity.Description,
+		}
+	}
+	return responses
+}
@@ -0,0 +1,281 @@
+package delivery_http
+
+import (
+	"context"
+
+	delivery_dto "github.com/Fi44er/sdmed/internal/module/delivery/dto"
+	delivery_entity "github.com/Fi44er/sdmed/internal/module/delivery/entity"
+	"github.com/Fi44er/sdmed/pkg/logger"
+	_ "github.com/Fi44er/sdmed/pkg/response"
+	"github.com/Fi44er/sdmed/pkg/utils"
+	"github.com/go-playground/validator/v10"
+	"github.com/gofiber/fiber/v2"
+)
+
+type IDeliveryUsecase interface {
+	GetMethods(ctx context.Context, onlyActive bool) ([]delivery_entity.Method, error)
+	GetMethod(ctx context.Context, id string) (*delivery_entity.Method, error)
+	CreateMethod(ctx context.Context, method *delivery_entity.Method) (*delivery_entity.Method, error)
+	UpdateMethod(ctx context.Context, method *delivery_entity.Method) (*delivery_entity.Method, error)
+	DeleteMethod(ctx context.Context, id string) error
+
+	CreateZone(ctx context.Context, zone *delivery_entity.Zone) (*delivery_entity.Zone, error)
+	UpdateZone(ctx context.Context, zone *delivery_entity.Zone) (*delivery_entity.Zone, error)
+	DeleteZone(ctx context.Context, id string) error
+}
+
+type DeliveryHandler struct {
+	usecase IDeliveryUsecase
+
+	validator *validator.Validate
+	logger    *logger.Logger
+	converter *Converter
+}
+
+func NewDeliveryHandler(
+	usecase IDeliveryUsecase,
+	validator *validator.Validate,
+	logger *logger.Logger,
+) *DeliveryHandler {
+	return &DeliveryHandler{
+		usecase:   usecase,
+		validator: validator,
+		logger:    logger,
+		converter: &Converter{},
+	}
+}
+
+// GetActiveMethods godoc
+// @Summary Get delivery methods
+// @Description Enabled delivery methods. Cost and dates depend on the address and are returned by the checkout quotes
+// @Tags delivery
+// @Produce json
+// @Success 200 {object} response.ResponseData{data=[]delivery_dto.PublicMethodResponse} "OK"
+// @Failure 500 {object} response.Response "Error"
+// @Router /delivery/methods [get]
+func (h *DeliveryHandler) GetActiveMethods(ctx *fiber.Ctx) error {
+	methods, err := h.usecase.GetMethods(ctx.Context(), true)
+	if err != nil {
+		return err
+	}
+
+	return ctx.Status(200).JSON(fiber.Map{
+		"status": "success",
+		"data":   h.converter.ToPublicMethodResponses(methods),
+	})
+}
+
+// GetMethods godoc
+// @Summary Get all delivery methods
+// @Description Delivery methods with their zones, including disabled ones
+// @Tags delivery-admin
+// @Produce json
+// @Success 200 {object} response.ResponseData{data=[]delivery_dto.MethodResponse} "OK"
+// @Failure 500 {object} response.Response "Error"
+// @Router /admin/delivery/methods [get]
+func (h *DeliveryHandler) GetMethods(ctx *fiber.Ctx) error {
+	methods, err := h.usecase.GetMethods(ctx.Context(), false)
+	if err != nil {
+		return err
+	}
+
+	return ctx.Status(200).JSON(fiber.Map{
+		"status": "success",
+		"data":   h.converter.ToMethodResponses(methods),
+	})
+}
+
+// GetMethod godoc
+// @Summary Get delivery method
+// @Description Delivery method with its zones
+// @Tags delivery-admin
+// @Produce json
+// @Param id path string true "Method ID"
+// @Success 200 {object} response.ResponseData{data=delivery_dto.MethodResponse} "OK"
+// @Failure 404 {object} response.Response "Method not found"
+// @Router /admin/delivery/methods/{id} [get]
+func (h *DeliveryHandler) GetMethod(ctx *fiber.Ctx) error {
+	method, err := h.usecase.GetMethod(ctx.Context(), ctx.Params("id"))
+	if err != nil {
+		return err
+	}
+
+	return ctx.Status(200).JSON(fiber.Map{
+		"status": "success",
+		"data":   h.converter.ToMethodResponse(method),
+	})
+}
+
+// CreateMethod godoc
+// @Summary Create delivery method
+// @Description Create a courier, pickup or transport company delivery method. Zones are added separately
+// @Tags delivery-admin
+// @Accept json
+// @Produce json
+// @Param method body delivery_dto.MethodRequest true "Method"
+// @Success 201 {object} response.ResponseData{data=delivery_dto.MethodResponse} "Created"
+// @Failure 400 {object} response.Response "Invalid request"
+// @Failure 409 {object} response.Response "Code already exists"
+// @Router /admin/delivery/methods [post]
+func (h *DeliveryHandler) CreateMethod(ctx *fiber.Ctx) error {
+	dto := new(delivery_dto.MethodRequest)
+
+	entity, err := utils.ParseAndValidate(ctx, dto, h.validator, h.converter.ToMethod, h.logger)
+	if err != nil {
+		return ctx.Status(400).JSON(fiber.Map{
+			"status":  "fail",
+			"message": err.Error(),
+		})
+	}
+
+	method, err := h.usecase.CreateMethod(ctx.Context(), entity)
+	if err != nil {
+		return err
+	}
+
+	return ctx.Status(201).JSON(fiber.Map{
+		"status": "success",
+		"data":   h.converter.ToMethodResponse(method),
+	})
+}
+
+// UpdateMethod godoc
+// @Summary Update delivery method
+// @Tags delivery-admin
+// @Accept json
+// @Produce json
+// @Param id path string true "Method ID"
+// @Param method body delivery_dto.MethodRequest true "Method"
+// @Success 200 {object} response.ResponseData{data=delivery_dto.MethodResponse} "OK"
+// @Failure 400 {object} response.Response "Invalid request"
+// @Failure 404 {object} response.Response "Method not found"
+// @Failure 409 {object} response.Response "Code already exists"
+// @Router /admin/delivery/methods/{id} [put]
+func (h *DeliveryHandler) UpdateMethod(ctx *fiber.Ctx) error {
+	dto := new(delivery_dto.MethodRequest)
+
+	entity, err := utils.ParseAndValidate(ctx, dto, h.validator, h.converter.ToMethod, h.logger)
+	if err != nil {
+		return ctx.Status(400).JSON(fiber.Map{
+			"status":  "fail",
+			"message": err.Error(),
+		})
+	}
+	entity.ID = ctx.Params("id")
+
+	method, err := h.usecase.UpdateMethod(ctx.Context(), entity)
+	if err != nil {
+		return err
+	}
+
+	return ctx.Status(200).JSON(fiber.Map{
+		"status": "success",
+		"data":   h.converter.ToMethodResponse(method),
+	})
+}
+
+// DeleteMethod godoc
+// @Summary Delete delivery method
+// @Description Delete a method with its zones. Placed orders keep their delivery name and cost
+// @Tags delivery-admin
+// @Produce json
+// @Param id path string true "Method ID"
+// @Success 200 {object} response.Response "OK"
+// @Failure 404 {object} response.Response "Method not found"
+// @Router /admin/delivery/methods/{id} [delete]
+func (h *DeliveryHandler) DeleteMethod(ctx *fiber.Ctx) error {
+	if err := h.usecase.DeleteMethod(ctx.Context(), ctx.Params("id")); err != nil {
+		return err
+	}
+
+	return ctx.Status(200).JSON(fiber.Map{
+		"status":  "success",
+		"message": "delivery method deleted successfully",
+	})
+}
+
+// CreateZone godoc
+// @Summary Create delivery zone
+// @Description Add a zone to a method. A zone matches by region codes and/or postal code prefixes; a zone without both matches any address
+// @Tags delivery-admin
+// @Accept json
+// @Produce json
+// @Param zone body delivery_dto.CreateZoneRequest true "Zone"
+// @Success 201 {object} response.ResponseData{data=delivery_dto.ZoneResponse} "Created"
+// @Failure 400 {object} response.Response "Invalid request"
+// @Failure 404 {object} response.Response "Method not found"
+// @Router /admin/delivery/zones [post]
+func (h *DeliveryHandler) CreateZone(ctx *fiber.Ctx) error {
+	dto := new(delivery_dto.CreateZoneRequest)
+
+	entity, err := utils.ParseAndValidate(ctx, dto, h.validator, h.converter.ToCreateZone, h.logger)
+	if err != nil {
+		return ctx.Status(400).JSON(fiber.Map{
+			"status":  "fail",
+			"message": err.Error(),
+		})
+	}
+
+	zone, err := h.usecase.CreateZone(ctx.Context(), entity)
+	if err != nil {
+		return err
+	}
+
+	return ctx.Status(201).JSON(fiber.Map{
+		"status": "success",
+		"data":   h.converter.ToZoneResponse(zone),
+	})
+}
+
+// UpdateZone godoc
+// @Summary Update delivery zone
+// @Tags delivery-admin
+// @Accept json
+// @Produce json
+// @Param id path string true "Zone ID"
+// @Param zone body delivery_dto.ZoneRequest true "Zone"
+// @Success 200 {object} response.ResponseData{data=delivery_dto.ZoneResponse} "OK"
+// @Failure 400 {object} response.Response "Invalid request"
+// @Failure 404 {object} response.Response "Zone not found"
+// @Router /admin/delivery/zones/{id} [put]
+func (h *DeliveryHandler) UpdateZone(ctx *fiber.Ctx) error {
+	dto := new(delivery_dto.ZoneRequest)
+
+	entity, err := utils.ParseAndValidate(ctx, dto, h.validator, h.converter.ToZone, h.logger)
+	if err != nil {
+		return ctx.Status(400).JSON(fiber.Map{
+			"status":  "fail",
+			"message": err.Error(),
+		})
+	}
+	entity.ID = ctx.Params("id")
+
+	zone, err := h.usecase.UpdateZone(ctx.Context(), entity)
+	if err != nil {
+		return err
+	}
+
+	return ctx.Status(200).JSON(fiber.Map{
+		"status": "success",
+		"data":   h.converter.ToZoneResponse(zone),
+	})
+}
+
+// DeleteZone godoc
+// @Summary Delete delivery zone
+// @Tags delivery-admin
+// @Produce json
+// @Param id path string true "Zone ID"
+// @Success 200 {object} response.Response "OK"
+// @Failure 404 {object} response.Response "Zone not found"
+// @Router /admin/delivery/zones/{id} [delete]
+func (h *DeliveryHandler) DeleteZone(ctx *fiber.Ctx) error {
+	if err := h.usecase.DeleteZone(ctx.Context(), ctx.Params("id")); err != nil {
+		return err
+	}
+
+	return ctx.Status(200).JSON(fiber.Map{
+		"status":  "success",
+		"message": "delivery zone deleted successfully",
+	})
+}
@@ -0,0 +1,22 @@
+package delivery_http
+
+import (
+	"github.com/Fi44er/sdmed/internal/middlewares"
+	"github.com/gofiber/fiber/v2"
+)
+
+func (h *DeliveryHandler) RegisterRoutes(router fiber.Router) {
+	router.Get("/delivery/methods", h.GetActiveMethods)
+
+	methods := router.Group("/admin/delivery/methods")
+	methods.Get("/", middlewares.Authorize("delivery", "read"), h.GetMethods)
+	methods.Get("/:id", middlewares.Authorize("delivery", "read"), h.GetMethod)
+	methods.Post("/", middlewares.Authorize("delivery", "create"), h.CreateMethod)
+	methods.Put("/:id", middlewares.Authorize("delivery", "update"), h.UpdateMethod)
+	methods.Delete("/:id", middlewares.Authorize("delivery", "delete"), h.DeleteMethod)
+
+	zones := router.Group("/admin/delivery/zones")
+	zones.Post("/", middlewares.Authorize("delivery", "create"), h.CreateZone)
+	zones.Put("/:id", middlewares.Authorize("delivery", "update"), h.UpdateZone)
+	zones.Delete("/:id", middlewares.Authorize("delivery", "delete"), h.DeleteZone)
+}
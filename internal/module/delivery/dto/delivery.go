@@ -0,0 +1,73 @@
+package delivery_dto
+
+import "time"
+
+type MethodRequest struct {
+	Code        string `json:"code" validate:"required,max=50"`
+	Name        string `json:"name" validate:"required,max=255"`
+	Type        string `json:"type" validate:"required,oneof=courier pickup transport"`
+	Description string `json:"description"`
+	IsActive    bool   `json:"is_active"`
+	SortOrder   int    `json:"sort_order"`
+}
+
+type ZoneRequest struct {
+	Name        string   `json:"name" validate:"required,max=255"`
+	RegionCodes []string `json:"region_codes"`
+	PostalCodes []string `json:"postal_codes" validate:"dive,numeric,max=6"`
+
+	CostType       string  `json:"cost_type" validate:"required,oneof=flat weight"`
+	Price          float64 `json:"price" validate:"gte=0"`
+	IncludedWeight float64 `json:"included_weight" validate:"gte=0"`
+	PricePerKg     float64 `json:"price_per_kg" validate:"gte=0"`
+	FreeFrom       float64 `json:"free_from" validate:"gte=0"`
+
+	MinDays  int  `json:"min_days" validate:"gte=0"`
+	MaxDays  int  `json:"max_days" validate:"gte=0"`
+	IsActive bool `json:"is_active"`
+}
+
+type CreateZoneRequest struct {
+	MethodID string `json:"method_id" validate:"required,uuid"`
+	ZoneRequest
+}
+
+type ZoneResponse struct {
+	ID             string    `json:"id"`
+	MethodID       string    `json:"method_id"`
+	Name           string    `json:"name"`
+	RegionCodes    []string  `json:"region_codes"`
+	PostalCodes    []string  `json:"postal_codes"`
+	CostType       string    `json:"cost_type"`
+	Price          float64   `json:"price"`
+	IncludedWeight float64   `json:"included_weight"`
+	PricePerKg     float64   `json:"price_per_kg"`
+	FreeFrom       float64   `json:"free_from"`
+	MinDays        int       `json:"min_days"`
+	MaxDays        int       `json:"max_days"`
+	IsActive       bool      `json:"is_active"`
+	CreatedAt      time.Time `json:"created_at"`
+	UpdatedAt      time.Time `json:"updated_at"`
+}
+
+type MethodResponse struct {
+	ID          string         `json:"id"`
+	Code        string         `json:"code"`
+	Name        string         `json:"name"`
+	Type        string         `json:"type"`
+	Description string         `json:"description"`
+	IsActive    bool           `json:"is_active"`
+	SortOrder   int            `json:"sort_order"`
+	Zones       []ZoneResponse `json:"zones,omitempty"`
+	CreatedAt   time.Time      `json:"created_at"`
+	UpdatedAt   time.Time      `json:"updated_at"`
+}
+
+// PublicMethodResponse - способ доставки для витрины, без настроек зон
+type PublicMethodResponse struct {
+	ID          string `json:"id"`
+	Code        string `json:"code"`
+	Name        string `json:"name"`
+	Type        string `json:"type"`
+	Description string `json:"description"`
+}
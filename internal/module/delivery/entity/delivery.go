@@ -0,0 +1,180 @@
+package delivery_entity
+
+import (
+	"math"
+	"strings"
+	"time"
+)
+
+type MethodType string
+
+const (
+	MethodTypeCourier   MethodType = "courier"
+	MethodTypePickup    MethodType = "pickup"
+	MethodTypeTransport MethodType = "transport"
+)
+
+func (t MethodType) IsValid() bool {
+	switch t {
+	case MethodTypeCourier, MethodTypePickup, MethodTypeTransport:
+		return true
+	}
+	return false
+}
+
+// RequiresAddress - для курьера и транспортной компании нужен адрес получателя
+func (t MethodType) RequiresAddress() bool {
+	return t != MethodTypePickup
+}
+
+type CostType string
+
+const (
+	// CostTypeFlat - фиксированная стоимость
+	CostTypeFlat CostType = "flat"
+	// CostTypeWeight - базовая стоимость плюс цена за каждый начатый килограмм сверх включенного веса
+	CostTypeWeight CostType = "weight"
+)
+
+func (t CostType) IsValid() bool {
+	return t == CostTypeFlat || t == CostTypeWeight
+}
+
+// Method - способ доставки. Стоимость и сроки задаются зонами способа
+type Method struct {
+	ID          string
+	Code        string
+	Name        string
+	Type        MethodType
+	Description string
+	IsActive    bool
+	SortOrder   int
+	Zones       []Zone
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+}
+
+// Zone - зона доставки: набор регионов и/или префиксов почтовых индексов
+// с правилом расчета стоимости. Зона без регионов и индексов действует везде
+type Zone struct {
+	ID          string
+	MethodID    string
+	Name        string
+	RegionCodes []string
+	PostalCodes []string
+
+	CostType       CostType
+	Price          float64
+	IncludedWeight float64
+	PricePerKg     float64
+	// FreeFrom - сумма заказа, с которой доставка бесплатна; 0 - без бесплатной доставки
+	FreeFrom float64
+
+	MinDays   int
+	MaxDays   int
+	IsActive  bool
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+// QuoteItem - позиция, для которой считается доставка
+type QuoteItem struct {
+	ProductID string
+	Quantity  int
+	Price     float64
+}
+
+type QuoteRequest struct {
+	RegionID   string
+	PostalCode string
+	Items      []QuoteItem
+}
+
+// Quote - предложение доставки выбранным способом
+type Quote struct {
+	MethodID        string
+	MethodCode      string
+	MethodName      string
+	Type            MethodType
+	ZoneID          string
+	ZoneName        string
+	Cost            float64
+	Weight          float64
+	MinDays         int
+	MaxDays         int
+	EstimatedFrom   time.Time
+	EstimatedTo     time.Time
+	RequiresAddress bool
+}
+
+// Subtotal - стоимость позиций без доставки
+func (r *QuoteRequest) Subtotal() float64 {
+	total := 0.0
+	for _, item := range r.Items {
+		total += item.Price * float64(item.Quantity)
+	}
+	return round(total)
+}
+
+// Match оценивает, насколько точно зона подходит адресу: 0 - не подходит,
+// совпадение по индексу точнее совпадения по региону, зона без ограничений - наименее точная
+func (z *Zone) Match(regionCode, postalCode string) int {
+	if !z.IsActive {
+		return 0
+	}
+	if len(z.RegionCodes) == 0 && len(z.PostalCodes) == 0 {
+		return 1
+	}
+
+	best := 0
+	if postalCode != "" {
+		for _, prefix := range z.PostalCodes {
+			if strings.HasPrefix(postalCode, prefix) {
+				best = max(best, 10+len(prefix))
+			}
+		}
+	}
+	if best == 0 && regionCode != "" {
+		for _, code := range z.RegionCodes {
+			if code == regionCode {
+				best = 2
+			}
+		}
+	}
+	return best
+}
+
+// Cost - стоимость доставки заказа на сумму subtotal весом weight кг
+func (z *Zone) Cost(subtotal, weight float64) float64 {
+	if z.FreeFrom > 0 && subtotal >= z.FreeFrom {
+		return 0
+	}
+
+	cost := z.Price
+	if z.CostType == CostTypeWeight && weight > z.IncludedWeight {
+		cost += math.Ceil(weight-z.IncludedWeight) * z.PricePerKg
+	}
+	return round(cost)
+}
+
+// Estimate - ожидаемые даты доставки при заказе в момент now
+func (z *Zone) Estimate(now time.Time) (time.Time, time.Time) {
+	day := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, now.Location())
+	return day.AddDate(0, 0, z.MinDays), day.AddDate(0, 0, z.MaxDays)
+}
+
+// FindZone возвращает наиболее точно подходящую адресу активную зону или nil
+func (m *Method) FindZone(regionCode, postalCode string) *Zone {
+	var found *Zone
+	best := 0
+	for i := range m.Zones {
+		if score := m.Zones[i].Match(regionCode, postalCode); score > best {
+			found, best = &m.Zones[i], score
+		}
+	}
+	return found
+}
+
+func round(value float64) float64 {
+	return math.Round(value*100) / 100
+}
@@ -0,0 +1,69 @@
+package delivery_entity
+
+import (
+	"testing"
+	"time"
+)
+
+func TestZoneCost(t *testing.T) {
+	tests := []struct {
+		name     string
+		zone     Zone
+		subtotal float64
+		weight   float64
+		want     float64
+	}{
+		{"flat", Zone{CostType: CostTypeFlat, Price: 300}, 1000, 12, 300},
+		{"weight within included", Zone{CostType: CostTypeWeight, Price: 300, IncludedWeight: 5, PricePerKg: 40}, 1000, 4.5, 300},
+		{"weight per started kg", Zone{CostType: CostTypeWeight, Price: 300, IncludedWeight: 5, PricePerKg: 40}, 1000, 7.2, 420},
+		{"free above threshold", Zone{CostType: CostTypeWeight, Price: 300, PricePerKg: 40, FreeFrom: 5000}, 5000, 30, 0},
+		{"below threshold", Zone{CostType: CostTypeFlat, Price: 300, FreeFrom: 5000}, 4999.99, 1, 300},
+	}
+
+	for _, tt := range tests {
+		if got := tt.zone.Cost(tt.subtotal, tt.weight); got != tt.want {
+			t.Errorf("%s: Cost(%.2f, %.1f) = %.2f, want %.2f", tt.name, tt.subtotal, tt.weight, got, tt.want)
+		}
+	}
+}
+
+func TestFindZone(t *testing.T) {
+	method := &Method{Zones: []Zone{
+		{ID: "russia", IsActive: true},
+		{ID: "moscow", RegionCodes: []string{"77", "50"}, IsActive: true},
+		{ID: "center", PostalCodes: []string{"1"}, IsActive: true},
+		{ID: "kremlin", PostalCodes: []string{"1030"}, IsActive: true},
+		{ID: "disabled", PostalCodes: []string{"103012"}},
+	}}
+
+	tests := []struct {
+		regionCode string
+		postalCode string
+		want       string
+	}{
+		{"77", "103012", "kremlin"},
+		{"77", "125009", "center"},
+		{"77", "", "moscow"},
+		{"66", "620000", "russia"},
+		{"", "", "russia"},
+	}
+	for _, tt := range tests {
+		zone := method.FindZone(tt.regionCode, tt.postalCode)
+		if zone == nil || zone.ID != tt.want {
+			t.Errorf("FindZone(%q, %q) = %+v, want %s", tt.regionCode, tt.postalCode, zone, tt.want)
+		}
+	}
+
+	local := &Method{Zones: []Zone{{ID: "moscow", RegionCodes: []string{"77"}, IsActive: true}}}
+	if zone := local.FindZone("66", "620000"); zone != nil {
+		t.Errorf("zone %s matched an address outside of it", zone.ID)
+	}
+}
+
+func TestEstimate(t *testing.T) {
+	zone := Zone{MinDays: 1, MaxDays: 3}
+	from, to := zone.Estimate(time.Date(2026, 3, 31, 18, 30, 0, 0, time.UTC))
+	if !from.Equal(time.Date(2026, 4, 1, 0, 0, 0, 0, time.UTC)) || !to.Equal(time.Date(2026, 4, 3, 0, 0, 0, 0, time.UTC)) {
+		t.Errorf("Estimate = %s - %s", from, to)
+	}
+}
@@ -0,0 +1,55 @@
+package delivery_adapters
+
+import (
+	"context"
+	"strconv"
+	"strings"
+
+	product_usecase "github.com/Fi44er/sdmed/internal/module/product/usecase/product"
+)
+
+type IProductUsecaseAdapter interface {
+	GetWeights(ctx context.Context, productIDs []string) (map[string]float64, error)
+}
+
+type ProductUsecaseAdapter struct {
+	productUsecase product_usecase.IProductUsecase
+	// characteristic - название числовой характеристики с весом товара в кг
+	characteristic string
+}
+
+func NewProductUsecaseAdapter(productUsecase product_usecase.IProductUsecase, characteristic string) IProductUsecaseAdapter {
+	return &ProductUsecaseAdapter{
+		productUsecase: productUsecase,
+		characteristic: characteristic,
+	}
+}
+
+// GetWeights возвращает вес единицы товара по характеристике веса.
+// Товары без заполненного веса в результат не попадают
+func (a *ProductUsecaseAdapter) GetWeights(ctx context.Context, productIDs []string) (map[string]float64, error) {
+	products, err := a.productUsecase.GetByIDs(ctx, productIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	weights := make(map[string]float64, len(products))
+	for i := range products {
+		for _, value := range products[i].CharValues {
+			if !strings.EqualFold(strings.TrimSpace(value.CharacteristicName), a.characteristic) {
+				continue
+			}
+			if value.NumberValue != nil {
+				weights[products[i].ID] = *value.NumberValue
+				break
+			}
+			raw := strings.ReplaceAll(strings.TrimSpace(value.GetStringValue()), ",", ".")
+			if weight, err := strconv.ParseFloat(raw, 64); err == nil {
+				weights[products[i].ID] = weight
+			}
+			break
+		}
+	}
+
+	return weights, nil
+}
@@ -0,0 +1,30 @@
+package delivery_adapters
+
+import (
+	"context"
+
+	region_usecase "github.com/Fi44er/sdmed/internal/module/region/usecase/region"
+)
+
+type IRegionUsecaseAdapter interface {
+	GetCode(ctx context.Context, regionID string) (string, error)
+}
+
+type RegionUsecaseAdapter struct {
+	regionUsecase region_usecase.IRegionUsecase
+}
+
+func NewRegionUsecaseAdapter(regionUsecase region_usecase.IRegionUsecase) IRegionUsecaseAdapter {
+	return &RegionUsecaseAdapter{
+		regionUsecase: regionUsecase,
+	}
+}
+
+// GetCode возвращает код субъекта РФ, по которому подбираются зоны доставки
+func (a *RegionUsecaseAdapter) GetCode(ctx context.Context, regionID string) (string, error) {
+	region, err := a.regionUsecase.GetByID(ctx, regionID)
+	if err != nil {
+		return "", err
+	}
+	return region.Code, nil
+}
@@ -0,0 +1,85 @@
+package delivery_repository
+
+import (
+	delivery_entity "github.com/Fi44er/sdmed/internal/module/delivery/entity"
+	delivery_model "github.com/Fi44er/sdmed/internal/module/delivery/infrastructure/repository/model"
+)
+
+type Converter struct{}
+
+func (c *Converter) ToMethodModel(entity *delivery_entity.Method) *delivery_model.DeliveryMethod {
+	return &delivery_model.DeliveryMethod{
+		ID:          entity.ID,
+		Code:        entity.Code,
+		Name:        entity.Name,
+		Type:        string(entity.Type),
+		Description: entity.Description,
+		IsActive:    entity.IsActive,
+		SortOrder:   entity.SortOrder,
+	}
+}
+
+func (c *Converter) ToMethodEntity(model *delivery_model.DeliveryMethod) *delivery_entity.Method {
+	method := &delivery_entity.Method{
+		ID:          model.ID,
+		Code:        model.Code,
+		Name:        model.Name,
+		Type:        delivery_entity.MethodType(model.Type),
+		Description: model.Description,
+		IsActive:    model.IsActive,
+		SortOrder:   model.SortOrder,
+		Zones:       make([]delivery_entity.Zone, len(model.Zones)),
+		CreatedAt:   model.CreatedAt,
+		UpdatedAt:   model.UpdatedAt,
+	}
+	for i := range model.Zones {
+		method.Zones[i] = *c.ToZoneEntity(&model.Zones[i])
+	}
+
+	return method
+}
+
+func (c *Converter) ToZoneModel(entity *delivery_entity.Zone) *delivery_model.DeliveryZone {
+	return &delivery_model.DeliveryZone{
+		ID:             entity.ID,
+		MethodID:       entity.MethodID,
+		Name:           entity.Name,
+		RegionCodes:    nonNil(entity.RegionCodes),
+		PostalCodes:    nonNil(entity.PostalCodes),
+		CostType:       string(entity.CostType),
+		Price:          entity.Price,
+		IncludedWeight: entity.IncludedWeight,
+		PricePerKg:     entity.PricePerKg,
+		FreeFrom:       entity.FreeFrom,
+		MinDays:        entity.MinDays,
+		MaxDays:        entity.MaxDays,
+		IsActive:       entity.IsActive,
+	}
+}
+
+func (c *Converter) ToZoneEntity(model *delivery_model.DeliveryZone) *delivery_entity.Zone {
+	return &delivery_entity.Zone{
+		ID:             model.ID,
+		MethodID:       model.MethodID,
+		Name:           model.Name,
+		RegionCodes:    model.RegionCodes,
+		PostalCodes:    model.PostalCodes,
+		CostType:       delivery_entity.CostType(model.CostType),
+		Price:          model.Price,
+		IncludedWeight: model.IncludedWeight,
+		PricePerKg:     model.PricePerKg,
+		FreeFrom:       model.FreeFrom,
+		MinDays:        model.MinDays,
+		MaxDays:        model.MaxDays,
+		IsActive:       model.IsActive,
+		CreatedAt:      model.CreatedAt,
+		UpdatedAt:      model.UpdatedAt,
+	}
+}
+
+func nonNil(values []string) []string {
+	if values == nil {
+		return []string{}
+	}
+	return values
+}
@@ -0,0 +1,205 @@
+package delivery_repository
+
+import (
+	"context"
+
+	delivery_entity "github.com/Fi44er/sdmed/internal/module/delivery/entity"
+	delivery_model "github.com/Fi44er/sdmed/internal/module/delivery/infrastructure/repository/model"
+	"github.com/Fi44er/sdmed/pkg/logger"
+	"gorm.io/gorm"
+)
+
+type IDeliveryRepository interface {
+	GetMethods(ctx context.Context, onlyActive bool) ([]delivery_entity.Method, error)
+	GetMethodByID(ctx context.Context, id string) (*delivery_entity.Method, error)
+	GetMethodByCode(ctx context.Context, code string) (*delivery_entity.Method, error)
+	CreateMethod(ctx context.Context, method *delivery_entity.Method) error
+	UpdateMethod(ctx context.Context, method *delivery_entity.Method) error
+	DeleteMethod(ctx context.Context, id string) error
+
+	GetZoneByID(ctx context.Context, id string) (*delivery_entity.Zone, error)
+	CreateZone(ctx context.Context, zone *delivery_entity.Zone) error
+	UpdateZone(ctx context.Context, zone *delivery_entity.Zone) error
+	DeleteZone(ctx context.Context, id string) error
+}
+
+type DeliveryRepository struct {
+	logger    *logger.Logger
+	db        *gorm.DB
+	converter *Converter
+}
+
+func NewDeliveryRepository(logger *logger.Logger, db *gorm.DB) IDeliveryRepository {
+	return &DeliveryRepository{
+		logger:    logger,
+		db:        db,
+		converter: &Converter{},
+	}
+}
+
+// GetMethods возвращает способы доставки с зонами. onlyActive - только включенные
+// способы и зоны, как их видит покупатель
+func (r *DeliveryRepository) GetMethods(ctx context.Context, onlyActive bool) ([]delivery_entity.Method, error) {
+	var methodModels []delivery_model.DeliveryMethod
+	query := r.db.WithContext(ctx)
+	if onlyActive {
+		query = query.
+			Preload("Zones", "is_active = ?", true, func(db *gorm.DB) *gorm.DB { return db.Order("created_at") }).
+			Where("is_active = ?", true)
+	} else {
+		query = query.Preload("Zones", func(db *gorm.DB) *gorm.DB { return db.Order("created_at") })
+	}
+
+	if err := query.Order("sort_order, name").Find(&methodModels).Error; err != nil {
+		r.logger.Errorf("Failed to get delivery methods: %v", err)
+		return nil, err
+	}
+
+	methods := make([]delivery_entity.Method, len(methodModels))
+	for i := range methodModels {
+		methods[i] = *r.converter.ToMethodEntity(&methodModels[i])
+	}
+
+	return methods, nil
+}
+
+func (r *DeliveryRepository) GetMethodByID(ctx context.Context, id string) (*delivery_entity.Method, error) {
+	return r.getMethod(ctx, "id = ?", id)
+}
+
+func (r *DeliveryRepository) GetMethodByCode(ctx context.Context, code string) (*delivery_entity.Method, error) {
+	return r.getMethod(ctx, "code = ?", code)
+}
+
+func (r *DeliveryRepository) CreateMethod(ctx context.Context, method *delivery_entity.Method) error {
+	r.logger.Infof("Creating delivery method: %s", method.Code)
+
+	methodModel := r.converter.ToMethodModel(method)
+	if err := r.db.WithContext(ctx).Create(methodModel).Error; err != nil {
+		r.logger.Errorf("Failed to create delivery method %s: %v", method.Code, err)
+		return err
+	}
+	method.ID = methodModel.ID
+	method.CreatedAt = methodModel.CreatedAt
+	method.UpdatedAt = methodModel.UpdatedAt
+
+	return nil
+}
+
+func (r *DeliveryRepository) UpdateMethod(ctx context.Context, method *delivery_entity.Method) error {
+	r.logger.Infof("Updating delivery method: %s", method.ID)
+
+	err := r.db.WithContext(ctx).
+		Model(&delivery_model.DeliveryMethod{}).
+		Where("id = ?", method.ID).
+		Updates(map[string]any{
+			"code":        method.Code,
+			"name":        method.Name,
+			"type":        string(method.Type),
+			"description": method.Description,
+			"is_active":   method.IsActive,
+			"sort_order":  method.SortOrder,
+			"updated_at":  gorm.Expr("now()"),
+		}).Error
+	if err != nil {
+		r.logger.Errorf("Failed to update delivery method %s: %v", method.ID, err)
+		return err
+	}
+
+	return nil
+}
+
+func (r *DeliveryRepository) DeleteMethod(ctx context.Context, id string) error {
+	r.logger.Infof("Deleting delivery method: %s", id)
+
+	if err := r.db.WithContext(ctx).Delete(&delivery_model.DeliveryMethod{}, "id = ?", id).Error; err != nil {
+		r.logger.Errorf("Failed to delete delivery method %s: %v", id, err)
+		return err
+	}
+
+	return nil
+}
+
+func (r *DeliveryRepository) GetZoneByID(ctx context.Context, id string) (*delivery_entity.Zone, error) {
+	var zoneModel delivery_model.DeliveryZone
+	if err := r.db.WithContext(ctx).First(&zoneModel, "id = ?", id).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, nil
+		}
+		r.logger.Errorf("Failed to get delivery zone %s: %v", id, err)
+		return nil, err
+	}
+
+	return r.converter.ToZoneEntity(&zoneModel), nil
+}
+
+func (r *DeliveryRepository) CreateZone(ctx context.Context, zone *delivery_entity.Zone) error {
+	r.logger.Infof("Creating delivery zone %s for method %s", zone.Name, zone.MethodID)
+
+	zoneModel := r.converter.ToZoneModel(zone)
+	if err := r.db.WithContext(ctx).Create(zoneModel).Error; err != nil {
+		r.logger.Errorf("Failed to create delivery zone %s: %v", zone.Name, err)
+		return err
+	}
+	zone.ID = zoneModel.ID
+	zone.CreatedAt = zoneModel.CreatedAt
+	zone.UpdatedAt = zoneModel.UpdatedAt
+
+	return nil
+}
+
+func (r *DeliveryRepository) UpdateZone(ctx context.Context, zone *delivery_entity.Zone) error {
+	r.logger.Infof("Updating delivery zone: %s", zone.ID)
+
+	zoneModel := r.converter.ToZoneModel(zone)
+	err := r.db.WithContext(ctx).
+		Model(&delivery_model.DeliveryZone{}).
+		Where("id = ?", zone.ID).
+		Updates(map[string]any{
+			"name":            zoneModel.Name,
+			"region_codes":    zoneModel.RegionCodes,
+			"postal_codes":    zoneModel.PostalCodes,
+			"cost_type":       zoneModel.CostType,
+			"price":           zoneModel.Price,
+			"included_weight": zoneModel.IncludedWeight,
+			"price_per_kg":    zoneModel.PricePerKg,
+			"free_from":       zoneModel.FreeFrom,
+			"min_days":        zoneModel.MinDays,
+			"max_days":        zoneModel.MaxDays,
+			"is_active":       zoneModel.IsActive,
+			"updated_at":      gorm.Expr("now()"),
+		}).Error
+	if err != nil {
+		r.logger.Errorf("Failed to update delivery zone %s: %v", zone.ID, err)
+		return err
+	}
+
+	return nil
+}
+
+func (r *DeliveryRepository) DeleteZone(ctx context.Context, id string) error {
+	r.logger.Infof("Deleting delivery zone: %s", id)
+
+	if err := r.db.WithContext(ctx).Delete(&delivery_model.DeliveryZone{}, "id = ?", id).Error; err != nil {
+		r.logger.Errorf("Failed to delete delivery zone %s: %v", id, err)
+		return err
+	}
+
+	return nil
+}
+
+func (r *DeliveryRepository) getMethod(ctx context.Context, query string, value string) (*delivery_entity.Method, error) {
+	var methodModel delivery_model.DeliveryMethod
+	err := r.db.WithContext(ctx).
+		Preload("Zones", func(db *gorm.DB) *gorm.DB { return db.Order("created_at") }).
+		First(&methodModel, query, value).Error
+	if err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, nil
+		}
+		r.logger.Errorf("Failed to get delivery method (%s %s): %v", query, value, err)
+		return nil, err
+	}
+
+	return r.converter.ToMethodEntity(&methodModel), nil
+}
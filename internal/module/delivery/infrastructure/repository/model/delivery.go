@@ -0,0 +1,48 @@
+package delivery_model
+
+import (
+	"time"
+
+	"github.com/lib/pq"
+)
+
+type DeliveryMethod struct {
+	ID          string         `gorm:"primaryKey;type:uuid;default:uuid_generate_v4();"`
+	Code        string         `gorm:"type:varchar(50);not null;uniqueIndex"`
+	Name        string         `gorm:"type:varchar(255);not null"`
+	Type        string         `gorm:"type:varchar(20);not null"`
+	Description string         `gorm:"type:text;not null;default:''"`
+	IsActive    bool           `gorm:"not null;default:true"`
+	SortOrder   int            `gorm:"not null;default:0"`
+	Zones       []DeliveryZone `gorm:"foreignKey:MethodID;constraint:OnDelete:CASCADE"`
+	CreatedAt   time.Time      `gorm:"not null;default:now()"`
+	UpdatedAt   time.Time      `gorm:"not null;default:now()"`
+}
+
+func (DeliveryMethod) TableName() string {
+	return "delivery_module.methods"
+}
+
+type DeliveryZone struct {
+	ID          string         `gorm:"primaryKey;type:uuid;default:uuid_generate_v4();"`
+	MethodID    string         `gorm:"type:uuid;not null;index"`
+	Name        string         `gorm:"type:varchar(255);not null"`
+	RegionCodes pq.StringArray `gorm:"type:text[];not null;default:'{}'"`
+	PostalCodes pq.StringArray `gorm:"type:text[];not null;default:'{}'"`
+
+	CostType       string  `gorm:"type:varchar(20);not null"`
+	Price          float64 `gorm:"type:float;not null;default:0"`
+	IncludedWeight float64 `gorm:"type:float;not null;default:0"`
+	PricePerKg     float64 `gorm:"type:float;not null;default:0"`
+	FreeFrom       float64 `gorm:"type:float;not null;default:0"`
+
+	MinDays   int       `gorm:"not null;default:0"`
+	MaxDays   int       `gorm:"not null;default:0"`
+	IsActive  bool      `gorm:"not null;default:true"`
+	CreatedAt time.Time `gorm:"not null;default:now()"`
+	UpdatedAt time.Time `gorm:"not null;default:now()"`
+}
+
+func (DeliveryZone) TableName() string {
+	return "delivery_module.zones"
+}
@@ -0,0 +1,67 @@
+package delivery_module
+
+import (
+	"github.com/Fi44er/sdmed/internal/config"
+	delivery_http "github.com/Fi44er/sdmed/internal/module/delivery/delivery/http"
+	delivery_adapters "github.com/Fi44er/sdmed/internal/module/delivery/infrastructure/adapters"
+	delivery_repository "github.com/Fi44er/sdmed/internal/module/delivery/infrastructure/repository/delivery"
+	delivery_usecase "github.com/Fi44er/sdmed/internal/module/delivery/usecase/delivery"
+	product_usecase "github.com/Fi44er/sdmed/internal/module/product/usecase/product"
+	region_usecase "github.com/Fi44er/sdmed/internal/module/region/usecase/region"
+	"github.com/Fi44er/sdmed/pkg/logger"
+	"github.com/go-playground/validator/v10"
+	"github.com/gofiber/fiber/v2"
+	"gorm.io/gorm"
+)
+
+type DeliveryModule struct {
+	deliveryRepository delivery_repository.IDeliveryRepository
+	deliveryUsecase    delivery_usecase.IDeliveryUsecase
+	deliveryHandler    *delivery_http.DeliveryHandler
+
+	productUsecase product_usecase.IProductUsecase
+	regionUsecase  region_usecase.IRegionUsecase
+
+	logger    *logger.Logger
+	validator *validator.Validate
+	db        *gorm.DB
+	config    *config.Config
+}
+
+func NewDeliveryModule(
+	logger *logger.Logger,
+	validator *validator.Validate,
+	db *gorm.DB,
+	config *config.Config,
+	productUsecase product_usecase.IProductUsecase,
+	regionUsecase region_usecase.IRegionUsecase,
+) *DeliveryModule {
+	return &DeliveryModule{
+		logger:         logger,
+		validator:      validator,
+		db:             db,
+		config:         config,
+		productUsecase: productUsecase,
+		regionUsecase:  regionUsecase,
+	}
+}
+
+func (m *DeliveryModule) Init() {
+	m.deliveryRepository = delivery_repository.NewDeliveryRepository(m.logger, m.db)
+	m.deliveryUsecase = delivery_usecase.NewDeliveryUsecase(
+		m.deliveryRepository,
+		delivery_adapters.NewProductUsecaseAdapter(m.productUsecase, m.config.DeliveryWeightCharacteristic),
+		delivery_adapters.NewRegionUsecaseAdapter(m.regionUsecase),
+		m.config.DeliveryDefaultWeight,
+		m.logger,
+	)
+	m.deliveryHandler = delivery_http.NewDeliveryHandler(m.deliveryUsecase, m.validator, m.logger)
+}
+
+func (m *DeliveryModule) InitDelivery(router fiber.Router) {
+	m.deliveryHandler.RegisterRoutes(router)
+}
+
+func (m *DeliveryModule) GetDeliveryUsecase() delivery_usecase.IDeliveryUsecase {
+	return m.deliveryUsecase
+}
@@ -0,0 +1,19 @@
+package delivery_constant
+
+import "github.com/Fi44er/sdmed/pkg/customerr"
+
+const (
+	DefaultWeightCharacteristic = "Вес"
+	// DefaultItemWeight - вес единицы товара в кг, если у товара не заполнена характеристика веса
+	DefaultItemWeight = 1.0
+)
+
+var (
+	ErrMethodNotFound     = customerr.NewError(404, "delivery method not found")
+	ErrZoneNotFound       = customerr.NewError(404, "delivery zone not found")
+	ErrMethodCodeExists   = customerr.NewError(409, "delivery method with this code already exists")
+	ErrInvalidMethodType  = customerr.NewError(400, "invalid delivery method type")
+	ErrInvalidCostType    = customerr.NewError(400, "invalid delivery cost type")
+	ErrInvalidDays        = customerr.NewError(400, "min days must not exceed max days")
+	ErrMethodNotAvailable = customerr.NewError(400, "delivery method is not available for this address")
+)
@@ -0,0 +1,29 @@
+package delivery_usecase_contracts
+
+import (
+	"context"
+
+	delivery_entity "github.com/Fi44er/sdmed/internal/module/delivery/entity"
+)
+
+type IDeliveryRepository interface {
+	GetMethods(ctx context.Context, onlyActive bool) ([]delivery_entity.Method, error)
+	GetMethodByID(ctx context.Context, id string) (*delivery_entity.Method, error)
+	GetMethodByCode(ctx context.Context, code string) (*delivery_entity.Method, error)
+	CreateMethod(ctx context.Context, method *delivery_entity.Method) error
+	UpdateMethod(ctx context.Context, method *delivery_entity.Method) error
+	DeleteMethod(ctx context.Context, id string) error
+
+	GetZoneByID(ctx context.Context, id string) (*delivery_entity.Zone, error)
+	CreateZone(ctx context.Context, zone *delivery_entity.Zone) error
+	UpdateZone(ctx context.Context, zone *delivery_entity.Zone) error
+	DeleteZone(ctx context.Context, id string) error
+}
+
+type IProductUsecaseAdapter interface {
+	GetWeights(ctx context.Context, productIDs []string) (map[string]float64, error)
+}
+
+type IRegionUsecaseAdapter interface {
+	GetCode(ctx context.Context, regionID string) (string, error)
+}
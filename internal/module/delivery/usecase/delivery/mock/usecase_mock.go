@@ -0,0 +1,256 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./delivery/contracts/contracts.go
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	context "context"
+	reflect "reflect"
+
+	delivery_entity "github.com/Fi44er/sdmed/internal/module/delivery/entity"
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockIDeliveryRepository is a mock of IDeliveryRepository interface.
+type MockIDeliveryRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockIDeliveryRepositoryMockRecorder
+}
+
+// MockIDeliveryRepositoryMockRecorder is the mock recorder for MockIDeliveryRepository.
+type MockIDeliveryRepositoryMockRecorder struct {
+	mock *MockIDeliveryRepository
+}
+
+// NewMockIDeliveryRepository creates a new mock instance.
+func NewMockIDeliveryRepository(ctrl *gomock.Controller) *MockIDeliveryRepository {
+	mock := &MockIDeliveryRepository{ctrl: ctrl}
+	mock.recorder = &MockIDeliveryRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockIDeliveryRepository) EXPECT() *MockIDeliveryRepositoryMockRecorder {
+	return m.recorder
+}
+
+// CreateMethod mocks base method.
+func (m *MockIDeliveryRepository) CreateMethod(ctx context.Context, method *delivery_entity.Method) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateMethod", ctx, method)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateMethod indicates an expected call of CreateMethod.
+func (mr *MockIDeliveryRepositoryMockRecorder) CreateMethod(ctx, method interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateMethod", reflect.TypeOf((*MockIDeliveryRepository)(nil).CreateMethod), ctx, method)
+}
+
+// CreateZone mocks base method.
+func (m *MockIDeliveryRepository) CreateZone(ctx context.Context, zone *delivery_entity.Zone) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateZone", ctx, zone)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateZone indicates an expected call of CreateZone.
+func (mr *MockIDeliveryRepositoryMockRecorder) CreateZone(ctx, zone interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateZone", reflect.TypeOf((*MockIDeliveryRepository)(nil).CreateZone), ctx, zone)
+}
+
+// DeleteMethod mocks base method.
+func (m *MockIDeliveryRepository) DeleteMethod(ctx context.Context, id string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteMethod", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteMethod indicates an expected call of DeleteMethod.
+func (mr *MockIDeliveryRepositoryMockRecorder) DeleteMethod(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteMethod", reflect.TypeOf((*MockIDeliveryRepository)(nil).DeleteMethod), ctx, id)
+}
+
+// DeleteZone mocks base method.
+func (m *MockIDeliveryRepository) DeleteZone(ctx context.Context, id string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteZone", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteZone indicates an expected call of DeleteZone.
+func (mr *MockIDeliveryRepositoryMockRecorder) DeleteZone(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteZone", reflect.TypeOf((*MockIDeliveryRepository)(nil).DeleteZone), ctx, id)
+}
+
+// GetMethodByCode mocks base method.
+func (m *MockIDeliveryRepository) GetMethodByCode(ctx context.Context, code string) (*delivery_entity.Method, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetMethodByCode", ctx, code)
+	ret0, _ := ret[0].(*delivery_entity.Method)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetMethodByCode indicates an expected call of GetMethodByCode.
+func (mr *MockIDeliveryRepositoryMockRecorder) GetMethodByCode(ctx, code interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMethodByCode", reflect.TypeOf((*MockIDeliveryRepository)(nil).GetMethodByCode), ctx, code)
+}
+
+// GetMethodByID mocks base method.
+func (m *MockIDeliveryRepository) GetMethodByID(ctx context.Context, id string) (*delivery_entity.Method, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetMethodByID", ctx, id)
+	ret0, _ := ret[0].(*delivery_entity.Method)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetMethodByID indicates an expected call of GetMethodByID.
+func (mr *MockIDeliveryRepositoryMockRecorder) GetMethodByID(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMethodByID", reflect.TypeOf((*MockIDeliveryRepository)(nil).GetMethodByID), ctx, id)
+}
+
+// GetMethods mocks base method.
+func (m *MockIDeliveryRepository) GetMethods(ctx context.Context, onlyActive bool) ([]delivery_entity.Method, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetMethods", ctx, onlyActive)
+	ret0, _ := ret[0].([]delivery_entity.Method)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetMethods indicates an expected call of GetMethods.
+func (mr *MockIDeliveryRepositoryMockRecorder) GetMethods(ctx, onlyActive interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMethods", reflect.TypeOf((*MockIDeliveryRepository)(nil).GetMethods), ctx, onlyActive)
+}
+
+// GetZoneByID mocks base method.
+func (m *MockIDeliveryRepository) GetZoneByID(ctx context.Context, id string) (*delivery_entity.Zone, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetZoneByID", ctx, id)
+	ret0, _ := ret[0].(*delivery_entity.Zone)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetZoneByID indicates an expected call of GetZoneByID.
+func (mr *MockIDeliveryRepositoryMockRecorder) GetZoneByID(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetZoneByID", reflect.TypeOf((*MockIDeliveryRepository)(nil).GetZoneByID), ctx, id)
+}
+
+// UpdateMethod mocks base method.
+func (m *MockIDeliveryRepository) UpdateMethod(ctx context.Context, method *delivery_entity.Method) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateMethod", ctx, method)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateMethod indicates an expected call of UpdateMethod.
+func (mr *MockIDeliveryRepositoryMockRecorder) UpdateMethod(ctx, method interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateMethod", reflect.TypeOf((*MockIDeliveryRepository)(nil).UpdateMethod), ctx, method)
+}
+
+// UpdateZone mocks base method.
+func (m *MockIDeliveryRepository) UpdateZone(ctx context.Context, zone *delivery_entity.Zone) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateZone", ctx, zone)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateZone indicates an expected call of UpdateZone.
+func (mr *MockIDeliveryRepositoryMockRecorder) UpdateZone(ctx, zone interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateZone", reflect.TypeOf((*MockIDeliveryRepository)(nil).UpdateZone), ctx, zone)
+}
+
+// MockIProductUsecaseAdapter is a mock of IProductUsecaseAdapter interface.
+type MockIProductUsecaseAdapter struct {
+	ctrl     *gomock.Controller
+	recorder *MockIProductUsecaseAdapterMockRecorder
+}
+
+// MockIProductUsecaseAdapterMockRecorder is the mock recorder for MockIProductUsecaseAdapter.
+type MockIProductUsecaseAdapterMockRecorder struct {
+	mock *MockIProductUsecaseAdapter
+}
+
+// NewMockIProductUsecaseAdapter creates a new mock instance.
+func NewMockIProductUsecaseAdapter(ctrl *gomock.Controller) *MockIProductUsecaseAdapter {
+	mock := &MockIProductUsecaseAdapter{ctrl: ctrl}
+	mock.recorder = &MockIProductUsecaseAdapterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockIProductUsecaseAdapter) EXPECT() *MockIProductUsecaseAdapterMockRecorder {
+	return m.recorder
+}
+
+// GetWeights mocks base method.
+func (m *MockIProductUsecaseAdapter) GetWeights(ctx context.Context, productIDs []string) (map[string]float64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetWeights", ctx, productIDs)
+	ret0, _ := ret[0].(map[string]float64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetWeights indicates an expected call of GetWeights.
+func (mr *MockIProductUsecaseAdapterMockRecorder) GetWeights(ctx, productIDs interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWeights", reflect.TypeOf((*MockIProductUsecaseAdapter)(nil).GetWeights), ctx, productIDs)
+}
+
+// MockIRegionUsecaseAdapter is a mock of IRegionUsecaseAdapter interface.
+type MockIRegionUsecaseAdapter struct {
+	ctrl     *gomock.Controller
+	recorder *MockIRegionUsecaseAdapterMockRecorder
+}
+
+// MockIRegionUsecaseAdapterMockRecorder is the mock recorder for MockIRegionUsecaseAdapter.
+type MockIRegionUsecaseAdapterMockRecorder struct {
+	mock *MockIRegionUsecaseAdapter
+}
+
+// NewMockIRegionUsecaseAdapter creates a new mock instance.
+func NewMockIRegionUsecaseAdapter(ctrl *gomock.Controller) *MockIRegionUsecaseAdapter {
+	mock := &MockIRegionUsecaseAdapter{ctrl: ctrl}
+	mock.recorder = &MockIRegionUsecaseAdapterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockIRegionUsecaseAdapter) EXPECT() *MockIRegionUsecaseAdapterMockRecorder {
+	return m.recorder
+}
+
+// GetCode mocks base method.
+func (m *MockIRegionUsecaseAdapter) GetCode(ctx context.Context, regionID string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCode", ctx, regionID)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCode indicates an expected call of GetCode.
+func (mr *MockIRegionUsecaseAdapterMockRecorder) GetCode(ctx, regionID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCode", reflect.TypeOf((*MockIRegionUsecaseAdapter)(nil).GetCode), ctx, regionID)
+}
@@ -0,0 +1,62 @@
+package delivery_testcases
+
+import (
+	"context"
+
+	delivery_entity "github.com/Fi44er/sdmed/internal/module/delivery/entity"
+	delivery_constant "github.com/Fi44er/sdmed/internal/module/delivery/pkg"
+	"github.com/Fi44er/sdmed/internal/module/delivery/usecase/delivery/mock"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+type MockCreateMethod struct {
+	Ctrl     *gomock.Controller
+	Ctx      context.Context
+	RepoMock *mock.MockIDeliveryRepository
+	T        assert.TestingT
+}
+
+type CreateMethodTestCase struct {
+	Name           string
+	Method         *delivery_entity.Method
+	SetupMocks     func(m *MockCreateMethod)
+	ExpectedMethod *delivery_entity.Method
+	ExpectedError  error
+}
+
+func GetCreateMethodTestCases() []CreateMethodTestCase {
+	created := &delivery_entity.Method{ID: CourierID, Code: "courier", Name: "Курьер", Type: delivery_entity.MethodTypeCourier, IsActive: true}
+
+	return []CreateMethodTestCase{
+		{
+			Name:   "method_created",
+			Method: &delivery_entity.Method{Code: "courier", Name: "Курьер", Type: delivery_entity.MethodTypeCourier, IsActive: true},
+			SetupMocks: func(m *MockCreateMethod) {
+				m.RepoMock.EXPECT().GetMethodByCode(m.Ctx, "courier").Return(nil, nil)
+				m.RepoMock.EXPECT().
+					CreateMethod(m.Ctx, gomock.Any()).
+					DoAndReturn(func(ctx context.Context, method *delivery_entity.Method) error {
+						method.ID = CourierID
+						return nil
+					})
+				m.RepoMock.EXPECT().GetMethodByID(m.Ctx, CourierID).Return(created, nil)
+			},
+			ExpectedMethod: created,
+		},
+		{
+			Name:   "duplicate_code",
+			Method: &delivery_entity.Method{Code: "courier", Name: "Дубль", Type: delivery_entity.MethodTypeCourier},
+			SetupMocks: func(m *MockCreateMethod) {
+				m.RepoMock.EXPECT().GetMethodByCode(m.Ctx, "courier").Return(created, nil)
+			},
+			ExpectedError: delivery_constant.ErrMethodCodeExists,
+		},
+		{
+			Name:          "invalid_type",
+			Method:        &delivery_entity.Method{Code: "drone", Name: "Дрон", Type: "drone"},
+			SetupMocks:    func(m *MockCreateMethod) {},
+			ExpectedError: delivery_constant.ErrInvalidMethodType,
+		},
+	}
+}
@@ -0,0 +1,73 @@
+package delivery_testcases
+
+import (
+	"context"
+
+	delivery_entity "github.com/Fi44er/sdmed/internal/module/delivery/entity"
+	delivery_constant "github.com/Fi44er/sdmed/internal/module/delivery/pkg"
+	"github.com/Fi44er/sdmed/internal/module/delivery/usecase/delivery/mock"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+type MockCreateZone struct {
+	Ctrl     *gomock.Controller
+	Ctx      context.Context
+	RepoMock *mock.MockIDeliveryRepository
+	T        assert.TestingT
+}
+
+type CreateZoneTestCase struct {
+	Name          string
+	Zone          *delivery_entity.Zone
+	SetupMocks    func(m *MockCreateZone)
+	ExpectedZone  *delivery_entity.Zone
+	ExpectedError error
+}
+
+func GetCreateZoneTestCases() []CreateZoneTestCase {
+	return []CreateZoneTestCase{
+		{
+			Name: "codes_normalized",
+			Zone: &delivery_entity.Zone{
+				MethodID: CourierID, Name: "Москва", CostType: delivery_entity.CostTypeFlat,
+				RegionCodes: []string{" 77 ", ""}, PostalCodes: []string{"101", " "},
+			},
+			SetupMocks: func(m *MockCreateZone) {
+				method := courier()
+				m.RepoMock.EXPECT().GetMethodByID(m.Ctx, CourierID).Return(&method, nil)
+				m.RepoMock.EXPECT().CreateZone(m.Ctx, gomock.Any()).Return(nil)
+			},
+			ExpectedZone: &delivery_entity.Zone{
+				MethodID: CourierID, Name: "Москва", CostType: delivery_entity.CostTypeFlat,
+				RegionCodes: []string{"77"}, PostalCodes: []string{"101"},
+			},
+		},
+		{
+			Name: "min_days_above_max",
+			Zone: &delivery_entity.Zone{MethodID: CourierID, CostType: delivery_entity.CostTypeFlat, MinDays: 5, MaxDays: 2},
+			SetupMocks: func(m *MockCreateZone) {
+				method := courier()
+				m.RepoMock.EXPECT().GetMethodByID(m.Ctx, CourierID).Return(&method, nil)
+			},
+			ExpectedError: delivery_constant.ErrInvalidDays,
+		},
+		{
+			Name: "invalid_cost_type",
+			Zone: &delivery_entity.Zone{MethodID: CourierID, CostType: "random"},
+			SetupMocks: func(m *MockCreateZone) {
+				method := courier()
+				m.RepoMock.EXPECT().GetMethodByID(m.Ctx, CourierID).Return(&method, nil)
+			},
+			ExpectedError: delivery_constant.ErrInvalidCostType,
+		},
+		{
+			Name: "method_not_found",
+			Zone: &delivery_entity.Zone{MethodID: PickupID, CostType: delivery_entity.CostTypeFlat},
+			SetupMocks: func(m *MockCreateZone) {
+				m.RepoMock.EXPECT().GetMethodByID(m.Ctx, PickupID).Return(nil, nil)
+			},
+			ExpectedError: delivery_constant.ErrMethodNotFound,
+		},
+	}
+}
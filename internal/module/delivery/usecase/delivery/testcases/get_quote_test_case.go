@@ -0,0 +1,98 @@
+package delivery_testcases
+
+import (
+	"context"
+
+	delivery_entity "github.com/Fi44er/sdmed/internal/module/delivery/entity"
+	delivery_constant "github.com/Fi44er/sdmed/internal/module/delivery/pkg"
+	"github.com/Fi44er/sdmed/internal/module/delivery/usecase/delivery/mock"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+type MockGetQuote struct {
+	Ctrl        *gomock.Controller
+	Ctx         context.Context
+	RepoMock    *mock.MockIDeliveryRepository
+	ProductMock *mock.MockIProductUsecaseAdapter
+	RegionMock  *mock.MockIRegionUsecaseAdapter
+	T           assert.TestingT
+}
+
+type GetQuoteTestCase struct {
+	Name          string
+	MethodID      string
+	Request       *delivery_entity.QuoteRequest
+	SetupMocks    func(m *MockGetQuote)
+	ExpectedQuote *delivery_entity.Quote
+	ExpectedError error
+}
+
+func GetGetQuoteTestCases() []GetQuoteTestCase {
+	return []GetQuoteTestCase{
+		{
+			// 14.5 кг коляски и две трости по 0.5 кг по умолчанию: 15.5 кг, 6 начатых кг сверх 10
+			Name:     "courier_cost_by_weight",
+			MethodID: CourierID,
+			Request:  Request("moscow"),
+			SetupMocks: func(m *MockGetQuote) {
+				method := courier()
+				m.RepoMock.EXPECT().GetMethodByID(m.Ctx, CourierID).Return(&method, nil)
+				m.RegionMock.EXPECT().GetCode(m.Ctx, "moscow").Return("77", nil)
+				expectWeights(m.Ctx, m.ProductMock)
+			},
+			ExpectedQuote: &delivery_entity.Quote{
+				MethodID:        CourierID,
+				MethodCode:      "courier",
+				MethodName:      "Курьер",
+				Type:            delivery_entity.MethodTypeCourier,
+				ZoneID:          ZoneID,
+				ZoneName:        "Москва",
+				Weight:          15.5,
+				Cost:            800,
+				MinDays:         1,
+				MaxDays:         2,
+				RequiresAddress: true,
+			},
+		},
+		{
+			Name:     "no_zone_for_address",
+			MethodID: CourierID,
+			Request:  Request("ekb"),
+			SetupMocks: func(m *MockGetQuote) {
+				method := courier()
+				m.RepoMock.EXPECT().GetMethodByID(m.Ctx, CourierID).Return(&method, nil)
+				m.RegionMock.EXPECT().GetCode(m.Ctx, "ekb").Return("66", nil)
+				expectWeights(m.Ctx, m.ProductMock)
+			},
+			ExpectedError: delivery_constant.ErrMethodNotAvailable,
+		},
+		{
+			Name:     "disabled_method",
+			MethodID: CourierID,
+			Request:  Request("moscow"),
+			SetupMocks: func(m *MockGetQuote) {
+				method := courier()
+				method.IsActive = false
+				m.RepoMock.EXPECT().GetMethodByID(m.Ctx, CourierID).Return(&method, nil)
+			},
+			ExpectedError: delivery_constant.ErrMethodNotAvailable,
+		},
+		{
+			Name:     "method_not_found",
+			MethodID: CourierID,
+			Request:  Request("moscow"),
+			SetupMocks: func(m *MockGetQuote) {
+				m.RepoMock.EXPECT().GetMethodByID(m.Ctx, CourierID).Return(nil, nil)
+			},
+			ExpectedError: delivery_constant.ErrMethodNotFound,
+		},
+		{
+			Name:          "invalid_method_id",
+			MethodID:      "not-a-uuid",
+			Request:       Request("moscow"),
+			SetupMocks:    func(m *MockGetQuote) {},
+			ExpectedError: delivery_constant.ErrMethodNotFound,
+		},
+	}
+}
@@ -0,0 +1,114 @@
+package delivery_testcases
+
+import (
+	"context"
+	"errors"
+
+	delivery_entity "github.com/Fi44er/sdmed/internal/module/delivery/entity"
+	"github.com/Fi44er/sdmed/internal/module/delivery/usecase/delivery/mock"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+type MockGetQuotes struct {
+	Ctrl        *gomock.Controller
+	Ctx         context.Context
+	RepoMock    *mock.MockIDeliveryRepository
+	ProductMock *mock.MockIProductUsecaseAdapter
+	RegionMock  *mock.MockIRegionUsecaseAdapter
+	T           assert.TestingT
+}
+
+type GetQuotesTestCase struct {
+	Name              string
+	Request           *delivery_entity.QuoteRequest
+	SetupMocks        func(m *MockGetQuotes)
+	ExpectedMethodIDs []string
+	ExpectedError     error
+}
+
+const (
+	CourierID = "3f1e2d4c-5b6a-4978-8c1d-2e3f4a5b6c7d"
+	PickupID  = "9a8b7c6d-5e4f-4a3b-9c2d-1e0f2a3b4c5d"
+	ZoneID    = "6c5d4e3f-2a1b-4c0d-9e8f-7a6b5c4d3e2f"
+)
+
+var errWeights = errors.New("catalog is unavailable")
+
+func courier() delivery_entity.Method {
+	return delivery_entity.Method{
+		ID: CourierID, Code: "courier", Name: "Курьер", Type: delivery_entity.MethodTypeCourier, IsActive: true,
+		Zones: []delivery_entity.Zone{{
+			ID: ZoneID, MethodID: CourierID, Name: "Москва", RegionCodes: []string{"77"},
+			CostType: delivery_entity.CostTypeWeight, Price: 500, IncludedWeight: 10, PricePerKg: 50, FreeFrom: 50000,
+			MinDays: 1, MaxDays: 2, IsActive: true,
+		}},
+	}
+}
+
+func pickup() delivery_entity.Method {
+	return delivery_entity.Method{
+		ID: PickupID, Code: "pickup", Name: "Самовывоз", Type: delivery_entity.MethodTypePickup, IsActive: true,
+		Zones: []delivery_entity.Zone{{MethodID: PickupID, Name: "Склад", CostType: delivery_entity.CostTypeFlat, IsActive: true}},
+	}
+}
+
+// Request - коляска 14.5 кг и две трости без характеристики веса
+func Request(regionID string) *delivery_entity.QuoteRequest {
+	return &delivery_entity.QuoteRequest{
+		RegionID: regionID,
+		Items: []delivery_entity.QuoteItem{
+			{ProductID: "wheelchair", Quantity: 1, Price: 20000},
+			{ProductID: "cane", Quantity: 2, Price: 1000},
+		},
+	}
+}
+
+func expectWeights(ctx context.Context, productMock *mock.MockIProductUsecaseAdapter) {
+	productMock.EXPECT().GetWeights(ctx, []string{"wheelchair", "cane"}).Return(map[string]float64{"wheelchair": 14.5}, nil)
+}
+
+func GetGetQuotesTestCases() []GetQuotesTestCase {
+	return []GetQuotesTestCase{
+		{
+			Name:    "all_methods_in_courier_zone",
+			Request: Request("moscow"),
+			SetupMocks: func(m *MockGetQuotes) {
+				m.RepoMock.EXPECT().GetMethods(m.Ctx, true).Return([]delivery_entity.Method{courier(), pickup()}, nil)
+				m.RegionMock.EXPECT().GetCode(m.Ctx, "moscow").Return("77", nil)
+				expectWeights(m.Ctx, m.ProductMock)
+			},
+			ExpectedMethodIDs: []string{CourierID, PickupID},
+		},
+		{
+			Name:    "only_pickup_outside_courier_zone",
+			Request: Request("ekb"),
+			SetupMocks: func(m *MockGetQuotes) {
+				m.RepoMock.EXPECT().GetMethods(m.Ctx, true).Return([]delivery_entity.Method{courier(), pickup()}, nil)
+				m.RegionMock.EXPECT().GetCode(m.Ctx, "ekb").Return("66", nil)
+				expectWeights(m.Ctx, m.ProductMock)
+			},
+			ExpectedMethodIDs: []string{PickupID},
+		},
+		{
+			Name:    "unresolved_region_gets_default_zones",
+			Request: Request("unknown"),
+			SetupMocks: func(m *MockGetQuotes) {
+				m.RepoMock.EXPECT().GetMethods(m.Ctx, true).Return([]delivery_entity.Method{courier(), pickup()}, nil)
+				m.RegionMock.EXPECT().GetCode(m.Ctx, "unknown").Return("", errors.New("region not found"))
+				expectWeights(m.Ctx, m.ProductMock)
+			},
+			ExpectedMethodIDs: []string{PickupID},
+		},
+		{
+			Name:    "weights_error",
+			Request: Request("moscow"),
+			SetupMocks: func(m *MockGetQuotes) {
+				m.RepoMock.EXPECT().GetMethods(m.Ctx, true).Return([]delivery_entity.Method{courier(), pickup()}, nil)
+				m.RegionMock.EXPECT().GetCode(m.Ctx, "moscow").Return("77", nil)
+				m.ProductMock.EXPECT().GetWeights(m.Ctx, gomock.Any()).Return(nil, errWeights)
+			},
+			ExpectedError: errWeights,
+		},
+	}
+}
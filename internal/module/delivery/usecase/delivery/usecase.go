@@ -0,0 +1,342 @@
+package delivery_usecase
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	delivery_entity "github.com/Fi44er/sdmed/internal/module/delivery/entity"
+	delivery_constant "github.com/Fi44er/sdmed/internal/module/delivery/pkg"
+	delivery_usecase_contracts "github.com/Fi44er/sdmed/internal/module/delivery/usecase/delivery/contracts"
+	"github.com/Fi44er/sdmed/pkg/logger"
+	"github.com/google/uuid"
+)
+
+type IDeliveryUsecase interface {
+	GetQuotes(ctx context.Context, request *delivery_entity.QuoteRequest) ([]delivery_entity.Quote, error)
+	GetQuote(ctx context.Context, methodID string, request *delivery_entity.QuoteRequest) (*delivery_entity.Quote, error)
+
+	GetMethods(ctx context.Context, onlyActive bool) ([]delivery_entity.Method, error)
+	GetMethod(ctx context.Context, id string) (*delivery_entity.Method, error)
+	CreateMethod(ctx context.Context, method *delivery_entity.Method) (*delivery_entity.Method, error)
+	UpdateMethod(ctx context.Context, method *delivery_entity.Method) (*delivery_entity.Method, error)
+	DeleteMethod(ctx context.Context, id string) error
+
+	CreateZone(ctx context.Context, zone *delivery_entity.Zone) (*delivery_entity.Zone, error)
+	UpdateZone(ctx context.Context, zone *delivery_entity.Zone) (*delivery_entity.Zone, error)
+	DeleteZone(ctx context.Context, id string) error
+}
+
+type DeliveryUsecase struct {
+	repository     delivery_usecase_contracts.IDeliveryRepository
+	productUsecase delivery_usecase_contracts.IProductUsecaseAdapter
+	regionUsecase  delivery_usecase_contracts.IRegionUsecaseAdapter
+	// defaultItemWeight - вес единицы товара без характеристики веса, кг
+	defaultItemWeight float64
+	logger            *logger.Logger
+}
+
+func NewDeliveryUsecase(
+	repository delivery_usecase_contracts.IDeliveryRepository,
+	productUsecase delivery_usecase_contracts.IProductUsecaseAdapter,
+	regionUsecase delivery_usecase_contracts.IRegionUsecaseAdapter,
+	defaultItemWeight float64,
+	logger *logger.Logger,
+) IDeliveryUsecase {
+	if defaultItemWeight <= 0 {
+		defaultItemWeight = delivery_constant.DefaultItemWeight
+	}
+
+	return &DeliveryUsecase{
+		repository:        repository,
+		productUsecase:    productUsecase,
+		regionUsecase:     regionUsecase,
+		defaultItemWeight: defaultItemWeight,
+		logger:            logger,
+	}
+}
+
+// GetQuotes рассчитывает доставку всеми включенными способами, у которых
+// есть зона для адреса покупателя
+func (u *DeliveryUsecase) GetQuotes(ctx context.Context, request *delivery_entity.QuoteRequest) ([]delivery_entity.Quote, error) {
+	methods, err := u.repository.GetMethods(ctx, true)
+	if err != nil {
+		return nil, err
+	}
+
+	calculation, err := u.newCalculation(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+
+	quotes := make([]delivery_entity.Quote, 0, len(methods))
+	for i := range methods {
+		if quote := calculation.quote(&methods[i]); quote != nil {
+			quotes = append(quotes, *quote)
+		}
+	}
+
+	return quotes, nil
+}
+
+// GetQuote рассчитывает доставку выбранным при оформлении заказа способом
+func (u *DeliveryUsecase) GetQuote(ctx context.Context, methodID string, request *delivery_entity.QuoteRequest) (*delivery_entity.Quote, error) {
+	method, err := u.GetMethod(ctx, methodID)
+	if err != nil {
+		return nil, err
+	}
+	if !method.IsActive {
+		return nil, delivery_constant.ErrMethodNotAvailable
+	}
+
+	calculation, err := u.newCalculation(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+
+	quote := calculation.quote(method)
+	if quote == nil {
+		u.logger.Warnf("Delivery method %s has no zone for region %q, postal code %q", method.Code, calculation.regionCode, request.PostalCode)
+		return nil, delivery_constant.ErrMethodNotAvailable
+	}
+
+	return quote, nil
+}
+
+func (u *DeliveryUsecase) GetMethods(ctx context.Context, onlyActive bool) ([]delivery_entity.Method, error) {
+	return u.repository.GetMethods(ctx, onlyActive)
+}
+
+func (u *DeliveryUsecase) GetMethod(ctx context.Context, id string) (*delivery_entity.Method, error) {
+	if _, err := uuid.Parse(id); err != nil {
+		return nil, delivery_constant.ErrMethodNotFound
+	}
+
+	method, err := u.repository.GetMethodByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if method == nil {
+		return nil, delivery_constant.ErrMethodNotFound
+	}
+
+	return method, nil
+}
+
+func (u *DeliveryUsecase) CreateMethod(ctx context.Context, method *delivery_entity.Method) (*delivery_entity.Method, error) {
+	if err := u.validateMethod(ctx, method); err != nil {
+		return nil, err
+	}
+
+	if err := u.repository.CreateMethod(ctx, method); err != nil {
+		return nil, err
+	}
+
+	return u.GetMethod(ctx, method.ID)
+}
+
+func (u *DeliveryUsecase) UpdateMethod(ctx context.Context, method *delivery_entity.Method) (*delivery_entity.Method, error) {
+	if _, err := u.GetMethod(ctx, method.ID); err != nil {
+		return nil, err
+	}
+	if err := u.validateMethod(ctx, method); err != nil {
+		return nil, err
+	}
+
+	if err := u.repository.UpdateMethod(ctx, method); err != nil {
+		return nil, err
+	}
+
+	return u.GetMethod(ctx, method.ID)
+}
+
+// DeleteMethod удаляет способ вместе с зонами. Оформленные заказы хранят
+// название и стоимость доставки у себя и не затрагиваются
+func (u *DeliveryUsecase) DeleteMethod(ctx context.Context, id string) error {
+	if _, err := u.GetMethod(ctx, id); err != nil {
+		return err
+	}
+
+	return u.repository.DeleteMethod(ctx, id)
+}
+
+func (u *DeliveryUsecase) CreateZone(ctx context.Context, zone *delivery_entity.Zone) (*delivery_entity.Zone, error) {
+	if _, err := u.GetMethod(ctx, zone.MethodID); err != nil {
+		return nil, err
+	}
+	if err := validateZone(zone); err != nil {
+		return nil, err
+	}
+
+	if err := u.repository.CreateZone(ctx, zone); err != nil {
+		return nil, err
+	}
+
+	return zone, nil
+}
+
+func (u *DeliveryUsecase) UpdateZone(ctx context.Context, zone *delivery_entity.Zone) (*delivery_entity.Zone, error) {
+	existing, err := u.getZone(ctx, zone.ID)
+	if err != nil {
+		return nil, err
+	}
+	zone.MethodID = existing.MethodID
+	if err := validateZone(zone); err != nil {
+		return nil, err
+	}
+
+	if err := u.repository.UpdateZone(ctx, zone); err != nil {
+		return nil, err
+	}
+
+	return u.getZone(ctx, zone.ID)
+}
+
+func (u *DeliveryUsecase) DeleteZone(ctx context.Context, id string) error {
+	if _, err := u.getZone(ctx, id); err != nil {
+		return err
+	}
+
+	return u.repository.DeleteZone(ctx, id)
+}
+
+// calculation - общие для всех способов данные расчета: регион, сумма и вес заказа
+type calculation struct {
+	request    *delivery_entity.QuoteRequest
+	regionCode string
+	subtotal   float64
+	weight     float64
+	now        time.Time
+}
+
+func (u *DeliveryUsecase) newCalculation(ctx context.Context, request *delivery_entity.QuoteRequest) (*calculation, error) {
+	request.PostalCode = strings.TrimSpace(request.PostalCode)
+
+	regionCode := ""
+	if request.RegionID != "" {
+		code, err := u.regionUsecase.GetCode(ctx, request.RegionID)
+		if err != nil {
+			u.logger.Warnf("Failed to resolve region %s for delivery: %v", request.RegionID, err)
+		}
+		regionCode = code
+	}
+
+	weight, err := u.weight(ctx, request.Items)
+	if err != nil {
+		return nil, err
+	}
+
+	return &calculation{
+		request:    request,
+		regionCode: regionCode,
+		subtotal:   request.Subtotal(),
+		weight:     weight,
+		now:        time.Now(),
+	}, nil
+}
+
+func (c *calculation) quote(method *delivery_entity.Method) *delivery_entity.Quote {
+	zone := method.FindZone(c.regionCode, c.request.PostalCode)
+	if zone == nil {
+		return nil
+	}
+
+	from, to := zone.Estimate(c.now)
+	return &delivery_entity.Quote{
+		MethodID:        method.ID,
+		MethodCode:      method.Code,
+		MethodName:      method.Name,
+		Type:            method.Type,
+		ZoneID:          zone.ID,
+		ZoneName:        zone.Name,
+		Cost:            zone.Cost(c.subtotal, c.weight),
+		Weight:          c.weight,
+		MinDays:         zone.MinDays,
+		MaxDays:         zone.MaxDays,
+		EstimatedFrom:   from,
+		EstimatedTo:     to,
+		RequiresAddress: method.Type.RequiresAddress(),
+	}
+}
+
+// weight - вес заказа в кг по характеристике веса товаров
+func (u *DeliveryUsecase) weight(ctx context.Context, items []delivery_entity.QuoteItem) (float64, error) {
+	if len(items) == 0 {
+		return 0, nil
+	}
+
+	productIDs := make([]string, len(items))
+	for i := range items {
+		productIDs[i] = items[i].ProductID
+	}
+	weights, err := u.productUsecase.GetWeights(ctx, productIDs)
+	if err != nil {
+		return 0, err
+	}
+
+	total := 0.0
+	for _, item := range items {
+		weight, ok := weights[item.ProductID]
+		if !ok || weight <= 0 {
+			weight = u.defaultItemWeight
+		}
+		total += weight * float64(item.Quantity)
+	}
+
+	return total, nil
+}
+
+func (u *DeliveryUsecase) validateMethod(ctx context.Context, method *delivery_entity.Method) error {
+	if !method.Type.IsValid() {
+		return delivery_constant.ErrInvalidMethodType
+	}
+
+	existing, err := u.repository.GetMethodByCode(ctx, method.Code)
+	if err != nil {
+		return err
+	}
+	if existing != nil && existing.ID != method.ID {
+		return delivery_constant.ErrMethodCodeExists
+	}
+
+	return nil
+}
+
+func (u *DeliveryUsecase) getZone(ctx context.Context, id string) (*delivery_entity.Zone, error) {
+	if _, err := uuid.Parse(id); err != nil {
+		return nil, delivery_constant.ErrZoneNotFound
+	}
+
+	zone, err := u.repository.GetZoneByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if zone == nil {
+		return nil, delivery_constant.ErrZoneNotFound
+	}
+
+	return zone, nil
+}
+
+func validateZone(zone *delivery_entity.Zone) error {
+	if !zone.CostType.IsValid() {
+		return delivery_constant.ErrInvalidCostType
+	}
+	if zone.MinDays > zone.MaxDays {
+		return delivery_constant.ErrInvalidDays
+	}
+
+	zone.RegionCodes = normalizeCodes(zone.RegionCodes)
+	zone.PostalCodes = normalizeCodes(zone.PostalCodes)
+	return nil
+}
+
+func normalizeCodes(codes []string) []string {
+	normalized := make([]string, 0, len(codes))
+	for _, code := range codes {
+		if code = strings.TrimSpace(code); code != "" {
+			normalized = append(normalized, code)
+		}
+	}
+	return normalized
+}
@@ -0,0 +1,166 @@
+package delivery_usecase_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	delivery_usecase "github.com/Fi44er/sdmed/internal/module/delivery/usecase/delivery"
+	"github.com/Fi44er/sdmed/internal/module/delivery/usecase/delivery/mock"
+	delivery_testcases "github.com/Fi44er/sdmed/internal/module/delivery/usecase/delivery/testcases"
+	"github.com/Fi44er/sdmed/pkg/logger"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+)
+
+const defaultItemWeight = 0.5
+
+type DeliveryUsecaseTestSuite struct {
+	suite.Suite
+	ctx    context.Context
+	logger *logger.Logger
+}
+
+func (s *DeliveryUsecaseTestSuite) SetupSuite() {
+	s.ctx = context.Background()
+	s.logger = logger.NewLogger()
+}
+
+func TestDeliveryUsecase(t *testing.T) {
+	suite.Run(t, new(DeliveryUsecaseTestSuite))
+}
+
+func (s *DeliveryUsecaseTestSuite) TestGetQuotes() {
+	tests := delivery_testcases.GetGetQuotesTestCases()
+
+	for _, tc := range tests {
+		s.T().Run(tc.Name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockStruct := &delivery_testcases.MockGetQuotes{
+				Ctrl:        ctrl,
+				Ctx:         s.ctx,
+				RepoMock:    mock.NewMockIDeliveryRepository(ctrl),
+				ProductMock: mock.NewMockIProductUsecaseAdapter(ctrl),
+				RegionMock:  mock.NewMockIRegionUsecaseAdapter(ctrl),
+				T:           t,
+			}
+
+			usecase := delivery_usecase.NewDeliveryUsecase(mockStruct.RepoMock, mockStruct.ProductMock, mockStruct.RegionMock, defaultItemWeight, s.logger)
+
+			tc.SetupMocks(mockStruct)
+			quotes, err := usecase.GetQuotes(s.ctx, tc.Request)
+
+			if tc.ExpectedError != nil {
+				assert.ErrorIs(t, err, tc.ExpectedError)
+				assert.Nil(t, quotes)
+				return
+			}
+			assert.NoError(t, err)
+			methodIDs := make([]string, len(quotes))
+			for i := range quotes {
+				methodIDs[i] = quotes[i].MethodID
+			}
+			assert.Equal(t, tc.ExpectedMethodIDs, methodIDs)
+		})
+	}
+}
+
+func (s *DeliveryUsecaseTestSuite) TestGetQuote() {
+	tests := delivery_testcases.GetGetQuoteTestCases()
+
+	for _, tc := range tests {
+		s.T().Run(tc.Name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockStruct := &delivery_testcases.MockGetQuote{
+				Ctrl:        ctrl,
+				Ctx:         s.ctx,
+				RepoMock:    mock.NewMockIDeliveryRepository(ctrl),
+				ProductMock: mock.NewMockIProductUsecaseAdapter(ctrl),
+				RegionMock:  mock.NewMockIRegionUsecaseAdapter(ctrl),
+				T:           t,
+			}
+
+			usecase := delivery_usecase.NewDeliveryUsecase(mockStruct.RepoMock, mockStruct.ProductMock, mockStruct.RegionMock, defaultItemWeight, s.logger)
+
+			tc.SetupMocks(mockStruct)
+			quote, err := usecase.GetQuote(s.ctx, tc.MethodID, tc.Request)
+
+			if tc.ExpectedError != nil {
+				assert.ErrorIs(t, err, tc.ExpectedError)
+				assert.Nil(t, quote)
+				return
+			}
+			assert.NoError(t, err)
+			assert.False(t, quote.EstimatedTo.Before(quote.EstimatedFrom))
+			quote.EstimatedFrom, quote.EstimatedTo = time.Time{}, time.Time{}
+			assert.Equal(t, tc.ExpectedQuote, quote)
+		})
+	}
+}
+
+func (s *DeliveryUsecaseTestSuite) TestCreateMethod() {
+	tests := delivery_testcases.GetCreateMethodTestCases()
+
+	for _, tc := range tests {
+		s.T().Run(tc.Name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockStruct := &delivery_testcases.MockCreateMethod{
+				Ctrl:     ctrl,
+				Ctx:      s.ctx,
+				RepoMock: mock.NewMockIDeliveryRepository(ctrl),
+				T:        t,
+			}
+
+			usecase := delivery_usecase.NewDeliveryUsecase(mockStruct.RepoMock, nil, nil, defaultItemWeight, s.logger)
+
+			tc.SetupMocks(mockStruct)
+			method, err := usecase.CreateMethod(s.ctx, tc.Method)
+
+			if tc.ExpectedError != nil {
+				assert.ErrorIs(t, err, tc.ExpectedError)
+				assert.Nil(t, method)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tc.ExpectedMethod, method)
+			}
+		})
+	}
+}
+
+func (s *DeliveryUsecaseTestSuite) TestCreateZone() {
+	tests := delivery_testcases.GetCreateZoneTestCases()
+
+	for _, tc := range tests {
+		s.T().Run(tc.Name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockStruct := &delivery_testcases.MockCreateZone{
+				Ctrl:     ctrl,
+				Ctx:      s.ctx,
+				RepoMock: mock.NewMockIDeliveryRepository(ctrl),
+				T:        t,
+			}
+
+			usecase := delivery_usecase.NewDeliveryUsecase(mockStruct.RepoMock, nil, nil, defaultItemWeight, s.logger)
+
+			tc.SetupMocks(mockStruct)
+			zone, err := usecase.CreateZone(s.ctx, tc.Zone)
+
+			if tc.ExpectedError != nil {
+				assert.ErrorIs(t, err, tc.ExpectedError)
+				assert.Nil(t, zone)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tc.ExpectedZone, zone)
+			}
+		})
+	}
+}
@@ -0,0 +1,121 @@
+package discount_http
+
+import (
+	discount_dto "github.com/Fi44er/sdmed/internal/module/discount/dto"
+	discount_entity "github.com/Fi44er/sdmed/internal/module/discount/entity"
+)
+
+type Converter struct{}
+
+func (c *Converter) ToPromoCode(dto *discount_dto.PromoCodeRequest) *discount_entity.PromoCode {
+	return &discount_entity.PromoCode{
+		Code:           dto.Code,
+		Type:           discount_entity.ValueType(dto.Type),
+		Value:          dto.Value,
+		MinOrderAmount: dto.MinOrderAmount,
+		StartsAt:       dto.StartsAt,
+		EndsAt:         dto.EndsAt,
+		UsageLimit:     dto.UsageLimit,
+		PerUserLimit:   dto.PerUserLimit,
+		IsActive:       dto.IsActive,
+	}
+}
+
+func (c *Converter) ToPromoCodeResponse(entity *discount_entity.PromoCode) *discount_dto.PromoCodeResponse {
+	return &discount_dto.PromoCodeResponse{
+		ID:             entity.ID,
+		Code:           entity.Code,
+		Type:           string(entity.Type),
+		Value:          entity.Value,
+		MinOrderAmount: entity.MinOrderAmount,
+		StartsAt:       entity.StartsAt,
+		EndsAt:         entity.EndsAt,
+		UsageLimit:     entity.UsageLimit,
+		PerUserLimit:   entity.PerUserLimit,
+		UsedCount:      entity.UsedCount,
+		IsActive:       entity.IsActive,
+		CreatedAt:      entity.CreatedAt,
+		UpdatedAt:      entity.UpdatedAt,
+	}
+}
+
+func (c *Converter) ToPromoCodeResponses(entities []discount_entity.PromoCode) []discount_dto.PromoCodeResponse {
+	responses := make([]discount_dto.PromoCodeResponse, len(entities))
+	for i := range entities {
+		responses[i] = *c.ToPromoCodeResponse(&entities[i])
+	}
+	return responses
+}
+
+func (c *Converter) ToRule(dto *discount_dto.RuleRequest) *discount_entity.Rule {
+	return &discount_entity.Rule{
+		Name:         dto.Name,
+		Type:         discount_entity.RuleType(dto.Type),
+		CategoryID:   dto.CategoryID,
+		Percent:      dto.Percent,
+		BuyQuantity:  dto.BuyQuantity,
+		FreeQuantity: dto.FreeQuantity,
+		StartsAt:     dto.StartsAt,
+		EndsAt:       dto.EndsAt,
+		IsActive:     dto.IsActive,
+	}
+}
+
+func (c *Converter) ToRuleResponse(entity *discount_entity.Rule) *discount_dto.RuleResponse {
+	return &discount_dto.RuleResponse{
+		ID:           entity.ID,
+		Name:         entity.Name,
+		Type:         string(entity.Type),
+		CategoryID:   entity.CategoryID,
+		Percent:      entity.Percent,
+		BuyQuantity:  entity.BuyQuantity,
+		FreeQuantity: entity.FreeQuantity,
+		StartsAt:     entity.StartsAt,
+		EndsAt:       entity.EndsAt,
+		IsActive:     entity.IsActive,
+		CreatedAt:    entity.CreatedAt,
+		UpdatedAt:    entity.UpdatedAt,
+	}
+}
+
+func (c *Converter) ToRuleResponses(entities []discount_entity.Rule) []discount_dto.RuleResponse {
+	responses := make([]discount_dto.RuleResponse, len(entities))
+	for i := range entities {
+		responses[i] = *c.ToRuleResponse(&entities[i])
+	}
+	return responses
+}
+
+func (c *Converter) ToDiscountResponse(result *discount_entity.Result) *discount_dto.DiscountResponse {
+	response := &discount_dto.DiscountResponse{
+		Lines:    make([]discount_dto.LineDiscountResponse, len(result.Lines)),
+		Subtotal: result.Subtotal,
+		Discount: result.Discount,
+		Total:    result.Total,
+	}
+	if result.PromoCode != nil {
+		response.PromoCode = result.PromoCode.Code
+	}
+	for i, line := range result.Lines {
+		applied := make([]discount_dto.AppliedDiscountResponse, len(line.Applied))
+		for j, discount := range line.Applied {
+			applied[j] = discount_dto.AppliedDiscountResponse{
+				Source:   string(discount.Source),
+				SourceID: discount.SourceID,
+				Name:     discount.Name,
+				Amount:   discount.Amount,
+			}
+		}
+		response.Lines[i] = discount_dto.LineDiscountResponse{
+			ProductID: line.ProductID,
+			Quantity:  line.Quantity,
+			Price:     line.Price,
+			Sum:       line.Sum,
+			Discount:  line.Discount,
+			Total:     line.Total,
+			Applied:   applied,
+		}
+	}
+
+	return response
+}
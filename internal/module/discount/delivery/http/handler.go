@@ -0,0 +1,344 @@
+package discount_http
+
+import (
+	"context"
+
+	discount_dto "github.com/Fi44er/sdmed/internal/module/discount/dto"
+	discount_entity "github.com/Fi44er/sdmed/internal/module/discount/entity"
+	"github.com/Fi44er/sdmed/pkg/logger"
+	_ "github.com/Fi44er/sdmed/pkg/response"
+	"github.com/Fi44er/sdmed/pkg/session"
+	"github.com/Fi44er/sdmed/pkg/utils"
+	"github.com/go-playground/validator/v10"
+	"github.com/gofiber/fiber/v2"
+)
+
+type IDiscountUsecase interface {
+	CalculateCart(ctx context.Context, code string) (*discount_entity.Result, error)
+
+	GetPromoCodes(ctx context.Context) ([]discount_entity.PromoCode, error)
+	GetPromoCode(ctx context.Context, id string) (*discount_entity.PromoCode, error)
+	CreatePromoCode(ctx context.Context, promo *discount_entity.PromoCode) (*discount_entity.PromoCode, error)
+	UpdatePromoCode(ctx context.Context, promo *discount_entity.PromoCode) (*discount_entity.PromoCode, error)
+	DeletePromoCode(ctx context.Context, id string) error
+
+	GetRules(ctx context.Context) ([]discount_entity.Rule, error)
+	GetRule(ctx context.Context, id string) (*discount_entity.Rule, error)
+	CreateRule(ctx context.Context, rule *discount_entity.Rule) (*discount_entity.Rule, error)
+	UpdateRule(ctx context.Context, rule *discount_entity.Rule) (*discount_entity.Rule, error)
+	DeleteRule(ctx context.Context, id string) error
+}
+
+type DiscountHandler struct {
+	usecase IDiscountUsecase
+
+	validator *validator.Validate
+	logger    *logger.Logger
+	converter *Converter
+}
+
+func NewDiscountHandler(
+	usecase IDiscountUsecase,
+	validator *validator.Validate,
+	logger *logger.Logger,
+) *DiscountHandler {
+	return &DiscountHandler{
+		usecase:   usecase,
+		validator: validator,
+		logger:    logger,
+		converter: &Converter{},
+	}
+}
+
+// CalculateCart godoc
+// @Summary Get cart discounts
+// @Description Automatic discounts and the promo code applied to the cart of the current session, with a breakdown by line.
+// @Description The promo code applies to the amount left after automatic discounts. With certificate payment discounts are recalculated at checkout
+// @Description and never apply to the certificate-covered part
+// @Tags discounts
+// @Produce json
+// @Param promo_code query string false "Promo code"
+// @Success 200 {object} response.ResponseData{data=discount_dto.DiscountResponse} "OK"
+// @Failure 400 {object} response.Response "Empty cart, promo code inactive, used up or order amount below minimum"
+// @Failure 401 {object} response.Response "No session"
+// @Failure 404 {object} response.Response "Promo code not found"
+// @Router /discounts/cart [get]
+func (h *DiscountHandler) CalculateCart(ctx *fiber.Ctx) error {
+	params := new(discount_dto.CartDiscountQueryParams)
+	if err := ctx.QueryParser(params); err != nil {
+		h.logger.Errorf("Failed to parse query params: %v", err)
+		return fiber.NewError(fiber.StatusBadRequest, "Invalid query parameters")
+	}
+
+	result, err := h.usecase.CalculateCart(h.getCtxWithSession(ctx), params.PromoCode)
+	if err != nil {
+		return err
+	}
+
+	return ctx.Status(200).JSON(fiber.Map{
+		"status": "success",
+		"data":   h.converter.ToDiscountResponse(result),
+	})
+}
+
+// GetPromoCodes godoc
+// @Summary Get promo codes
+// @Tags discounts-admin
+// @Produce json
+// @Success 200 {object} response.ResponseData{data=[]discount_dto.PromoCodeResponse} "OK"
+// @Failure 500 {object} response.Response "Error"
+// @Router /admin/discounts/promo-codes [get]
+func (h *DiscountHandler) GetPromoCodes(ctx *fiber.Ctx) error {
+	promos, err := h.usecase.GetPromoCodes(ctx.Context())
+	if err != nil {
+		return err
+	}
+
+	return ctx.Status(200).JSON(fiber.Map{
+		"status": "success",
+		"data":   h.converter.ToPromoCodeResponses(promos),
+	})
+}
+
+// GetPromoCode godoc
+// @Summary Get promo code
+// @Tags discounts-admin
+// @Produce json
+// @Param id path string true "Promo code ID"
+// @Success 200 {object} response.ResponseData{data=discount_dto.PromoCodeResponse} "OK"
+// @Failure 404 {object} response.Response "Promo code not found"
+// @Router /admin/discounts/promo-codes/{id} [get]
+func (h *DiscountHandler) GetPromoCode(ctx *fiber.Ctx) error {
+	promo, err := h.usecase.GetPromoCode(ctx.Context(), ctx.Params("id"))
+	if err != nil {
+		return err
+	}
+
+	return ctx.Status(200).JSON(fiber.Map{
+		"status": "success",
+		"data":   h.converter.ToPromoCodeResponse(promo),
+	})
+}
+
+// CreatePromoCode godoc
+// @Summary Create promo code
+// @Description Code is stored in upper case. Zero usage_limit and per_user_limit mean no limit
+// @Tags discounts-admin
+// @Accept json
+// @Produce json
+// @Param promo body discount_dto.PromoCodeRequest true "Promo code"
+// @Success 201 {object} response.ResponseData{data=discount_dto.PromoCodeResponse} "Created"
+// @Failure 400 {object} response.Response "Invalid request"
+// @Failure 409 {object} response.Response "Code already exists"
+// @Router /admin/discounts/promo-codes [post]
+func (h *DiscountHandler) CreatePromoCode(ctx *fiber.Ctx) error {
+	dto := new(discount_dto.PromoCodeRequest)
+
+	entity, err := utils.ParseAndValidate(ctx, dto, h.validator, h.converter.ToPromoCode, h.logger)
+	if err != nil {
+		return ctx.Status(400).JSON(fiber.Map{
+			"status":  "fail",
+			"message": err.Error(),
+		})
+	}
+
+	promo, err := h.usecase.CreatePromoCode(ctx.Context(), entity)
+	if err != nil {
+		return err
+	}
+
+	return ctx.Status(201).JSON(fiber.Map{
+		"status": "success",
+		"data":   h.converter.ToPromoCodeResponse(promo),
+	})
+}
+
+// UpdatePromoCode godoc
+// @Summary Update promo code
+// @Description Usage counter is kept: it changes only with placed and cancelled orders
+// @Tags discounts-admin
+// @Accept json
+// @Produce json
+// @Param id path string true "Promo code ID"
+// @Param promo body discount_dto.PromoCodeRequest true "Promo code"
+// @Success 200 {object} response.ResponseData{data=discount_dto.PromoCodeResponse} "OK"
+// @Failure 400 {object} response.Response "Invalid request"
+// @Failure 404 {object} response.Response "Promo code not found"
+// @Failure 409 {object} response.Response "Code already exists"
+// @Router /admin/discounts/promo-codes/{id} [put]
+func (h *DiscountHandler) UpdatePromoCode(ctx *fiber.Ctx) error {
+	dto := new(discount_dto.PromoCodeRequest)
+
+	entity, err := utils.ParseAndValidate(ctx, dto, h.validator, h.converter.ToPromoCode, h.logger)
+	if err != nil {
+		return ctx.Status(400).JSON(fiber.Map{
+			"status":  "fail",
+			"message": err.Error(),
+		})
+	}
+	entity.ID = ctx.Params("id")
+
+	promo, err := h.usecase.UpdatePromoCode(ctx.Context(), entity)
+	if err != nil {
+		return err
+	}
+
+	return ctx.Status(200).JSON(fiber.Map{
+		"status": "success",
+		"data":   h.converter.ToPromoCodeResponse(promo),
+	})
+}
+
+// DeletePromoCode godoc
+// @Summary Delete promo code
+// @Description Delete a promo code with its usage history. Placed orders keep the code and discount amount
+// @Tags discounts-admin
+// @Produce json
+// @Param id path string true "Promo code ID"
+// @Success 200 {object} response.Response "OK"
+// @Failure 404 {object} response.Response "Promo code not found"
+// @Router /admin/discounts/promo-codes/{id} [delete]
+func (h *DiscountHandler) DeletePromoCode(ctx *fiber.Ctx) error {
+	if err := h.usecase.DeletePromoCode(ctx.Context(), ctx.Params("id")); err != nil {
+		return err
+	}
+
+	return ctx.Status(200).JSON(fiber.Map{
+		"status":  "success",
+		"message": "promo code deleted successfully",
+	})
+}
+
+// GetRules godoc
+// @Summary Get discount rules
+// @Tags discounts-admin
+// @Produce json
+// @Success 200 {object} response.ResponseData{data=[]discount_dto.RuleResponse} "OK"
+// @Failure 500 {object} response.Response "Error"
+// @Router /admin/discounts/rules [get]
+func (h *DiscountHandler) GetRules(ctx *fiber.Ctx) error {
+	rules, err := h.usecase.GetRules(ctx.Context())
+	if err != nil {
+		return err
+	}
+
+	return ctx.Status(200).JSON(fiber.Map{
+		"status": "success",
+		"data":   h.converter.ToRuleResponses(rules),
+	})
+}
+
+// GetRule godoc
+// @Summary Get discount rule
+// @Tags discounts-admin
+// @Produce json
+// @Param id path string true "Rule ID"
+// @Success 200 {object} response.ResponseData{data=discount_dto.RuleResponse} "OK"
+// @Failure 404 {object} response.Response "Rule not found"
+// @Router /admin/discounts/rules/{id} [get]
+func (h *DiscountHandler) GetRule(ctx *fiber.Ctx) error {
+	rule, err := h.usecase.GetRule(ctx.Context(), ctx.Params("id"))
+	if err != nil {
+		return err
+	}
+
+	return ctx.Status(200).JSON(fiber.Map{
+		"status": "success",
+		"data":   h.converter.ToRuleResponse(rule),
+	})
+}
+
+// CreateRule godoc
+// @Summary Create discount rule
+// @Description category - percent off products of a category; n_plus_one - every buy_quantity+free_quantity units give free_quantity free,
+// @Description for all products or for a category. Each line gets the best automatic rule
+// @Tags discounts-admin
+// @Accept json
+// @Produce json
+// @Param rule body discount_dto.RuleRequest true "Rule"
+// @Success 201 {object} response.ResponseData{data=discount_dto.RuleResponse} "Created"
+// @Failure 400 {object} response.Response "Invalid request"
+// @Router /admin/discounts/rules [post]
+func (h *DiscountHandler) CreateRule(ctx *fiber.Ctx) error {
+	dto := new(discount_dto.RuleRequest)
+
+	entity, err := utils.ParseAndValidate(ctx, dto, h.validator, h.converter.ToRule, h.logger)
+	if err != nil {
+		return ctx.Status(400).JSON(fiber.Map{
+			"status":  "fail",
+			"message": err.Error(),
+		})
+	}
+
+	rule, err := h.usecase.CreateRule(ctx.Context(), entity)
+	if err != nil {
+		return err
+	}
+
+	return ctx.Status(201).JSON(fiber.Map{
+		"status": "success",
+		"data":   h.converter.ToRuleResponse(rule),
+	})
+}
+
+// UpdateRule godoc
+// @Summary Update discount rule
+// @Tags discounts-admin
+// @Accept json
+// @Produce json
+// @Param id path string true "Rule ID"
+// @Param rule body discount_dto.RuleRequest true "Rule"
+// @Success 200 {object} response.ResponseData{data=discount_dto.RuleResponse} "OK"
+// @Failure 400 {object} response.Response "Invalid request"
+// @Failure 404 {object} response.Response "Rule not found"
+// @Router /admin/discounts/rules/{id} [put]
+func (h *DiscountHandler) UpdateRule(ctx *fiber.Ctx) error {
+	dto := new(discount_dto.RuleRequest)
+
+	entity, err := utils.ParseAndValidate(ctx, dto, h.validator, h.converter.ToRule, h.logger)
+	if err != nil {
+		return ctx.Status(400).JSON(fiber.Map{
+			"status":  "fail",
+			"message": err.Error(),
+		})
+	}
+	entity.ID = ctx.Params("id")
+
+	rule, err := h.usecase.UpdateRule(ctx.Context(), entity)
+	if err != nil {
+		return err
+	}
+
+	return ctx.Status(200).JSON(fiber.Map{
+		"status": "success",
+		"data":   h.converter.ToRuleResponse(rule),
+	})
+}
+
+// DeleteRule godoc
+// @Summary Delete discount rule
+// @Tags discounts-admin
+// @Produce json
+// @Param id path string true "Rule ID"
+// @Success 200 {object} response.Response "OK"
+// @Failure 404 {object} response.Response "Rule not found"
+// @Router /admin/discounts/rules/{id} [delete]
+func (h *DiscountHandler) DeleteRule(ctx *fiber.Ctx) error {
+	if err := h.usecase.DeleteRule(ctx.Context(), ctx.Params("id")); err != nil {
+		return err
+	}
+
+	return ctx.Status(200).JSON(fiber.Map{
+		"status":  "success",
+		"message": "discount rule deleted successfully",
+	})
+}
+
+func (h *DiscountHandler) getCtxWithSession(ctx *fiber.Ctx) context.Context {
+	sess := session.FromFiberContext(ctx)
+	if sess == nil {
+		return ctx.Context()
+	}
+
+	return context.WithValue(ctx.Context(), "session", *sess)
+}
@@ -0,0 +1,24 @@
+package discount_http
+
+import (
+	"github.com/Fi44er/sdmed/internal/middlewares"
+	"github.com/gofiber/fiber/v2"
+)
+
+func (h *DiscountHandler) RegisterRoutes(router fiber.Router) {
+	router.Get("/discounts/cart", h.CalculateCart)
+
+	promoCodes := router.Group("/admin/discounts/promo-codes")
+	promoCodes.Get("/", middlewares.Authorize("discount", "read"), h.GetPromoCodes)
+	promoCodes.Get("/:id", middlewares.Authorize("discount", "read"), h.GetPromoCode)
+	promoCodes.Post("/", middlewares.Authorize("discount", "create"), h.CreatePromoCode)
+	promoCodes.Put("/:id", middlewares.Authorize("discount", "update"), h.UpdatePromoCode)
+	promoCodes.Delete("/:id", middlewares.Authorize("discount", "delete"), h.DeletePromoCode)
+
+	rules := router.Group("/admin/discounts/rules")
+	rules.Get("/", middlewares.Authorize("discount", "read"), h.GetRules)
+	rules.Get("/:id", middlewares.Authorize("discount", "read"), h.GetRule)
+	rules.Post("/", middlewares.Authorize("discount", "create"), h.CreateRule)
+	rules.Put("/:id", middlewares.Authorize("discount", "update"), h.UpdateRule)
+	rules.Delete("/:id", middlewares.Authorize("discount", "delete"), h.DeleteRule)
+}
@@ -0,0 +1,87 @@
+package discount_dto
+
+import "time"
+
+type PromoCodeRequest struct {
+	Code           string     `json:"code" validate:"required,max=50"`
+	Type           string     `json:"type" validate:"required,oneof=percent fixed"`
+	Value          float64    `json:"value" validate:"gt=0"`
+	MinOrderAmount float64    `json:"min_order_amount" validate:"gte=0"`
+	StartsAt       *time.Time `json:"starts_at"`
+	EndsAt         *time.Time `json:"ends_at"`
+	UsageLimit     int        `json:"usage_limit" validate:"gte=0"`
+	PerUserLimit   int        `json:"per_user_limit" validate:"gte=0"`
+	IsActive       bool       `json:"is_active"`
+}
+
+type PromoCodeResponse struct {
+	ID             string     `json:"id"`
+	Code           string     `json:"code"`
+	Type           string     `json:"type"`
+	Value          float64    `json:"value"`
+	MinOrderAmount float64    `json:"min_order_amount"`
+	StartsAt       *time.Time `json:"starts_at,omitempty"`
+	EndsAt         *time.Time `json:"ends_at,omitempty"`
+	UsageLimit     int        `json:"usage_limit"`
+	PerUserLimit   int        `json:"per_user_limit"`
+	UsedCount      int        `json:"used_count"`
+	IsActive       bool       `json:"is_active"`
+	CreatedAt      time.Time  `json:"created_at"`
+	UpdatedAt      time.Time  `json:"updated_at"`
+}
+
+type RuleRequest struct {
+	Name         string     `json:"name" validate:"required,max=255"`
+	Type         string     `json:"type" validate:"required,oneof=category n_plus_one"`
+	CategoryID   string     `json:"category_id" validate:"required_if=Type category,omitempty,uuid"`
+	Percent      float64    `json:"percent" validate:"gte=0,lte=100"`
+	BuyQuantity  int        `json:"buy_quantity" validate:"gte=0"`
+	FreeQuantity int        `json:"free_quantity" validate:"gte=0"`
+	StartsAt     *time.Time `json:"starts_at"`
+	EndsAt       *time.Time `json:"ends_at"`
+	IsActive     bool       `json:"is_active"`
+}
+
+type RuleResponse struct {
+	ID           string     `json:"id"`
+	Name         string     `json:"name"`
+	Type         string     `json:"type"`
+	CategoryID   string     `json:"category_id,omitempty"`
+	Percent      float64    `json:"percent,omitempty"`
+	BuyQuantity  int        `json:"buy_quantity,omitempty"`
+	FreeQuantity int        `json:"free_quantity,omitempty"`
+	StartsAt     *time.Time `json:"starts_at,omitempty"`
+	EndsAt       *time.Time `json:"ends_at,omitempty"`
+	IsActive     bool       `json:"is_active"`
+	CreatedAt    time.Time  `json:"created_at"`
+	UpdatedAt    time.Time  `json:"updated_at"`
+}
+
+type CartDiscountQueryParams struct {
+	PromoCode string `query:"promo_code"`
+}
+
+type AppliedDiscountResponse struct {
+	Source   string  `json:"source"`
+	SourceID string  `json:"source_id"`
+	Name     string  `json:"name"`
+	Amount   float64 `json:"amount"`
+}
+
+type LineDiscountResponse struct {
+	ProductID string                    `json:"product_id"`
+	Quantity  int                       `json:"quantity"`
+	Price     float64                   `json:"price"`
+	Sum       float64                   `json:"sum"`
+	Discount  float64                   `json:"discount"`
+	Total     float64                   `json:"total"`
+	Applied   []AppliedDiscountResponse `json:"applied"`
+}
+
+type DiscountResponse struct {
+	Lines     []LineDiscountResponse `json:"lines"`
+	Subtotal  float64                `json:"subtotal"`
+	Discount  float64                `json:"discount"`
+	Total     float64                `json:"total"`
+	PromoCode string                 `json:"promo_code,omitempty"`
+}
@@ -0,0 +1,263 @@
+package discount_entity
+
+import (
+	"math"
+	"strings"
+	"time"
+)
+
+// ValueType - вид скидки промокода: процент или фиксированная сумма
+type ValueType string
+
+const (
+	ValueTypePercent ValueType = "percent"
+	ValueTypeFixed   ValueType = "fixed"
+)
+
+func (t ValueType) IsValid() bool {
+	return t == ValueTypePercent || t == ValueTypeFixed
+}
+
+// RuleType - вид автоматической скидки
+type RuleType string
+
+const (
+	// RuleTypeCategory - процент на все товары категории
+	RuleTypeCategory RuleType = "category"
+	// RuleTypeNPlusOne - каждые BuyQuantity единиц товара дают FreeQuantity бесплатно
+	RuleTypeNPlusOne RuleType = "n_plus_one"
+)
+
+func (t RuleType) IsValid() bool {
+	return t == RuleTypeCategory || t == RuleTypeNPlusOne
+}
+
+// Source - источник скидки в расшифровке по позиции
+type Source string
+
+const (
+	SourceRule      Source = "rule"
+	SourcePromoCode Source = "promo_code"
+)
+
+// PromoCode - промокод. UsageLimit и PerUserLimit равные нулю не ограничивают применение
+type PromoCode struct {
+	ID             string
+	Code           string
+	Type           ValueType
+	Value          float64
+	MinOrderAmount float64
+	StartsAt       *time.Time
+	EndsAt         *time.Time
+	UsageLimit     int
+	PerUserLimit   int
+	UsedCount      int
+	IsActive       bool
+	CreatedAt      time.Time
+	UpdatedAt      time.Time
+}
+
+// Rule - автоматическая скидка. Пустой CategoryID у правила N+1 означает все товары
+type Rule struct {
+	ID           string
+	Name         string
+	Type         RuleType
+	CategoryID   string
+	Percent      float64
+	BuyQuantity  int
+	FreeQuantity int
+	StartsAt     *time.Time
+	EndsAt       *time.Time
+	IsActive     bool
+	CreatedAt    time.Time
+	UpdatedAt    time.Time
+}
+
+// Usage - применение промокода в заказе
+type Usage struct {
+	ID          string
+	PromoCodeID string
+	UserID      string
+	OrderID     string
+	CreatedAt   time.Time
+}
+
+// Line - позиция для расчета скидок. Excluded - часть суммы позиции, на которую
+// скидки не распространяются: покрытие электронным сертификатом
+type Line struct {
+	ProductID  string
+	CategoryID string
+	Quantity   int
+	Price      float64
+	Excluded   float64
+}
+
+// Applied - скидка, примененная к позиции
+type Applied struct {
+	Source   Source
+	SourceID string
+	Name     string
+	Amount   float64
+}
+
+type LineResult struct {
+	Line
+	Sum      float64
+	Discount float64
+	Total    float64
+	Applied  []Applied
+}
+
+// Result - расчет скидок с расшифровкой по позициям
+type Result struct {
+	Lines     []LineResult
+	Subtotal  float64
+	Discount  float64
+	Total     float64
+	PromoCode *PromoCode
+}
+
+func NormalizeCode(code string) string {
+	return strings.ToUpper(strings.TrimSpace(code))
+}
+
+func (l *Line) Sum() float64 {
+	return round(l.Price * float64(l.Quantity))
+}
+
+// Base - сумма позиции, на которую действуют скидки
+func (l *Line) Base() float64 {
+	return math.Max(round(l.Sum()-l.Excluded), 0)
+}
+
+func (p *PromoCode) IsValidAt(now time.Time) bool {
+	return activeAt(p.IsActive, p.StartsAt, p.EndsAt, now)
+}
+
+func (p *PromoCode) IsExhausted() bool {
+	return p.UsageLimit > 0 && p.UsedCount >= p.UsageLimit
+}
+
+// Discount - скидка промокода на сумму base
+func (p *PromoCode) Discount(base float64) float64 {
+	if p.Type == ValueTypePercent {
+		return round(base * math.Min(p.Value, 100) / 100)
+	}
+	return round(math.Min(p.Value, base))
+}
+
+func (r *Rule) IsValidAt(now time.Time) bool {
+	return activeAt(r.IsActive, r.StartsAt, r.EndsAt, now)
+}
+
+func (r *Rule) Matches(line *Line) bool {
+	return r.CategoryID == "" || r.CategoryID == line.CategoryID
+}
+
+// Discount - скидка правила на позицию, не больше суммы, на которую действуют скидки
+func (r *Rule) Discount(line *Line) float64 {
+	if !r.Matches(line) {
+		return 0
+	}
+
+	var amount float64
+	switch r.Type {
+	case RuleTypeCategory:
+		amount = line.Base() * math.Min(r.Percent, 100) / 100
+	case RuleTypeNPlusOne:
+		if r.BuyQuantity <= 0 || r.FreeQuantity <= 0 {
+			return 0
+		}
+		free := line.Quantity / (r.BuyQuantity + r.FreeQuantity) * r.FreeQuantity
+		amount = line.Price * float64(free)
+	}
+	return round(math.Min(amount, line.Base()))
+}
+
+// Calculate применяет к каждой позиции самое выгодное автоматическое правило, затем
+// промокод на оставшуюся сумму. Фиксированная скидка промокода распределяется
+// по позициям пропорционально их сумме, копейки округления относятся на самую дорогую
+func Calculate(lines []Line, rules []Rule, promo *PromoCode) *Result {
+	result := &Result{Lines: make([]LineResult, len(lines)), PromoCode: promo}
+	for i := range lines {
+		line := &result.Lines[i]
+		line.Line = lines[i]
+		line.Sum = lines[i].Sum()
+		result.Subtotal += line.Sum
+
+		var best *Rule
+		var bestAmount float64
+		for j := range rules {
+			if amount := rules[j].Discount(&lines[i]); amount > bestAmount {
+				best, bestAmount = &rules[j], amount
+			}
+		}
+		if best != nil {
+			line.Discount = bestAmount
+			line.Applied = append(line.Applied, Applied{Source: SourceRule, SourceID: best.ID, Name: best.Name, Amount: bestAmount})
+		}
+	}
+	result.Subtotal = round(result.Subtotal)
+
+	if promo != nil {
+		applyPromoCode(result, promo)
+	}
+
+	for i := range result.Lines {
+		line := &result.Lines[i]
+		line.Total = round(line.Sum - line.Discount)
+		result.Discount += line.Discount
+	}
+	result.Discount = round(result.Discount)
+	result.Total = round(result.Subtotal - result.Discount)
+
+	return result
+}
+
+func applyPromoCode(result *Result, promo *PromoCode) {
+	remaining := make([]float64, len(result.Lines))
+	var base float64
+	largest := -1
+	for i := range result.Lines {
+		remaining[i] = math.Max(round(result.Lines[i].Base()-result.Lines[i].Discount), 0)
+		base += remaining[i]
+		if remaining[i] > 0 && (largest < 0 || remaining[i] > remaining[largest]) {
+			largest = i
+		}
+	}
+	amount := promo.Discount(round(base))
+	if amount <= 0 || largest < 0 {
+		return
+	}
+
+	shares := make([]float64, len(result.Lines))
+	var allocated float64
+	for i := range remaining {
+		shares[i] = round(amount * remaining[i] / base)
+		allocated += shares[i]
+	}
+	shares[largest] = round(math.Min(shares[largest]+amount-allocated, remaining[largest]))
+
+	for i := range shares {
+		if shares[i] <= 0 {
+			continue
+		}
+		line := &result.Lines[i]
+		line.Discount = round(line.Discount + shares[i])
+		line.Applied = append(line.Applied, Applied{Source: SourcePromoCode, SourceID: promo.ID, Name: promo.Code, Amount: shares[i]})
+	}
+}
+
+func activeAt(isActive bool, startsAt, endsAt *time.Time, now time.Time) bool {
+	if !isActive {
+		return false
+	}
+	if startsAt != nil && now.Before(*startsAt) {
+		return false
+	}
+	return endsAt == nil || now.Before(*endsAt)
+}
+
+func round(value float64) float64 {
+	return math.Round(value*100) / 100
+}
@@ -0,0 +1,79 @@
+package discount_entity
+
+import (
+	"testing"
+	"time"
+)
+
+func TestRuleDiscount(t *testing.T) {
+	category := Rule{Type: RuleTypeCategory, CategoryID: "diapers", Percent: 10}
+	nPlusOne := Rule{Type: RuleTypeNPlusOne, BuyQuantity: 2, FreeQuantity: 1}
+
+	tests := []struct {
+		name string
+		rule Rule
+		line Line
+		want float64
+	}{
+		{"category", category, Line{CategoryID: "diapers", Quantity: 3, Price: 900}, 270},
+		{"other category", category, Line{CategoryID: "canes", Quantity: 3, Price: 900}, 0},
+		{"certificate part excluded", category, Line{CategoryID: "diapers", Quantity: 3, Price: 900, Excluded: 2400}, 30},
+		{"2+1 for five items", nPlusOne, Line{Quantity: 5, Price: 100}, 100},
+		{"2+1 for six items", nPlusOne, Line{Quantity: 6, Price: 100}, 200},
+		{"2+1 capped by certificate", nPlusOne, Line{Quantity: 3, Price: 100, Excluded: 250}, 50},
+	}
+
+	for _, tt := range tests {
+		if got := tt.rule.Discount(&tt.line); got != tt.want {
+			t.Errorf("%s: discount %.2f, want %.2f", tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestCalculate(t *testing.T) {
+	lines := []Line{
+		{ProductID: "diapers", CategoryID: "hygiene", Quantity: 3, Price: 1000},
+		{ProductID: "cane", CategoryID: "canes", Quantity: 1, Price: 1500},
+		{ProductID: "wheelchair", CategoryID: "chairs", Quantity: 1, Price: 30000, Excluded: 30000},
+	}
+	rules := []Rule{
+		{ID: "r1", Name: "Гигиена -10%", Type: RuleTypeCategory, CategoryID: "hygiene", Percent: 10},
+		{ID: "r2", Name: "2+1", Type: RuleTypeNPlusOne, BuyQuantity: 2, FreeQuantity: 1},
+	}
+	promo := &PromoCode{ID: "p1", Code: "SALE", Type: ValueTypeFixed, Value: 1000}
+
+	result := Calculate(lines, rules, promo)
+
+	diapers := result.Lines[0]
+	if diapers.Applied[0].SourceID != "r2" || diapers.Applied[0].Amount != 1000 {
+		t.Errorf("the best rule must apply, got %+v", diapers.Applied[0])
+	}
+	if result.Lines[2].Discount != 0 {
+		t.Errorf("certificate-covered line got discount %.2f", result.Lines[2].Discount)
+	}
+	// промокод делится между подгузниками (остаток 2000) и тростью (1500)
+	if diapers.Discount != 1571.43 || result.Lines[1].Discount != 428.57 {
+		t.Errorf("promo split %.2f / %.2f", diapers.Discount, result.Lines[1].Discount)
+	}
+	if result.Subtotal != 34500 || result.Discount != 2000 || result.Total != 32500 {
+		t.Errorf("subtotal %.2f, discount %.2f, total %.2f", result.Subtotal, result.Discount, result.Total)
+	}
+}
+
+func TestPromoCodeValidity(t *testing.T) {
+	now := time.Date(2026, 5, 1, 12, 0, 0, 0, time.UTC)
+	past, future := now.Add(-time.Hour), now.Add(time.Hour)
+
+	if !(&PromoCode{IsActive: true, StartsAt: &past, EndsAt: &future}).IsValidAt(now) {
+		t.Error("promo code inside its window must be valid")
+	}
+	if (&PromoCode{IsActive: true, StartsAt: &future}).IsValidAt(now) {
+		t.Error("promo code before its start must be invalid")
+	}
+	if (&PromoCode{IsActive: true, EndsAt: &past}).IsValidAt(now) {
+		t.Error("expired promo code must be invalid")
+	}
+	if !(&PromoCode{UsageLimit: 2, UsedCount: 2}).IsExhausted() {
+		t.Error("promo code with all usages spent must be exhausted")
+	}
+}
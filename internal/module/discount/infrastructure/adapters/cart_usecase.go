@@ -0,0 +1,41 @@
+package discount_adapters
+
+import (
+	"context"
+
+	cart_usecase "github.com/Fi44er/sdmed/internal/module/cart/usecase/cart"
+	discount_entity "github.com/Fi44er/sdmed/internal/module/discount/entity"
+)
+
+type ICartUsecaseAdapter interface {
+	GetLines(ctx context.Context, userID string) ([]discount_entity.Line, error)
+}
+
+type CartUsecaseAdapter struct {
+	cartUsecase cart_usecase.ICartUsecase
+}
+
+func NewCartUsecaseAdapter(cartUsecase cart_usecase.ICartUsecase) ICartUsecaseAdapter {
+	return &CartUsecaseAdapter{
+		cartUsecase: cartUsecase,
+	}
+}
+
+// GetLines возвращает позиции корзины пользователя для расчета скидок
+func (a *CartUsecaseAdapter) GetLines(ctx context.Context, userID string) ([]discount_entity.Line, error) {
+	cart, err := a.cartUsecase.GetByUserID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	lines := make([]discount_entity.Line, len(cart.Items))
+	for i := range cart.Items {
+		lines[i] = discount_entity.Line{
+			ProductID: cart.Items[i].ProductID,
+			Quantity:  cart.Items[i].Quantity,
+			Price:     cart.Items[i].Price,
+		}
+	}
+
+	return lines, nil
+}
@@ -0,0 +1,38 @@
+package discount_adapters
+
+import (
+	"context"
+
+	product_usecase "github.com/Fi44er/sdmed/internal/module/product/usecase/product"
+)
+
+type IProductUsecaseAdapter interface {
+	GetCategories(ctx context.Context, productIDs []string) (map[string]string, error)
+}
+
+type ProductUsecaseAdapter struct {
+	productUsecase product_usecase.IProductUsecase
+}
+
+func NewProductUsecaseAdapter(productUsecase product_usecase.IProductUsecase) IProductUsecaseAdapter {
+	return &ProductUsecaseAdapter{
+		productUsecase: productUsecase,
+	}
+}
+
+// GetCategories возвращает категории товаров. Товары без категории в результат не попадают
+func (a *ProductUsecaseAdapter) GetCategories(ctx context.Context, productIDs []string) (map[string]string, error) {
+	products, err := a.productUsecase.GetByIDs(ctx, productIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	categories := make(map[string]string, len(products))
+	for i := range products {
+		if products[i].CategoryID != nil {
+			categories[products[i].ID] = *products[i].CategoryID
+		}
+	}
+
+	return categories, nil
+}
@@ -0,0 +1,95 @@
+package discount_repository
+
+import (
+	discount_entity "github.com/Fi44er/sdmed/internal/module/discount/entity"
+	discount_model "github.com/Fi44er/sdmed/internal/module/discount/infrastructure/repository/model"
+)
+
+type Converter struct{}
+
+func (c *Converter) ToPromoCodeModel(entity *discount_entity.PromoCode) *discount_model.PromoCode {
+	return &discount_model.PromoCode{
+		ID:             entity.ID,
+		Code:           entity.Code,
+		Type:           string(entity.Type),
+		Value:          entity.Value,
+		MinOrderAmount: entity.MinOrderAmount,
+		StartsAt:       entity.StartsAt,
+		EndsAt:         entity.EndsAt,
+		UsageLimit:     entity.UsageLimit,
+		PerUserLimit:   entity.PerUserLimit,
+		UsedCount:      entity.UsedCount,
+		IsActive:       entity.IsActive,
+	}
+}
+
+func (c *Converter) ToPromoCodeEntity(model *discount_model.PromoCode) *discount_entity.PromoCode {
+	return &discount_entity.PromoCode{
+		ID:             model.ID,
+		Code:           model.Code,
+		Type:           discount_entity.ValueType(model.Type),
+		Value:          model.Value,
+		MinOrderAmount: model.MinOrderAmount,
+		StartsAt:       model.StartsAt,
+		EndsAt:         model.EndsAt,
+		UsageLimit:     model.UsageLimit,
+		PerUserLimit:   model.PerUserLimit,
+		UsedCount:      model.UsedCount,
+		IsActive:       model.IsActive,
+		CreatedAt:      model.CreatedAt,
+		UpdatedAt:      model.UpdatedAt,
+	}
+}
+
+func (c *Converter) ToRuleModel(entity *discount_entity.Rule) *discount_model.Rule {
+	return &discount_model.Rule{
+		ID:           entity.ID,
+		Name:         entity.Name,
+		Type:         string(entity.Type),
+		CategoryID:   optional(entity.CategoryID),
+		Percent:      entity.Percent,
+		BuyQuantity:  entity.BuyQuantity,
+		FreeQuantity: entity.FreeQuantity,
+		StartsAt:     entity.StartsAt,
+		EndsAt:       entity.EndsAt,
+		IsActive:     entity.IsActive,
+	}
+}
+
+func (c *Converter) ToRuleEntity(model *discount_model.Rule) *discount_entity.Rule {
+	rule := &discount_entity.Rule{
+		ID:           model.ID,
+		Name:         model.Name,
+		Type:         discount_entity.RuleType(model.Type),
+		Percent:      model.Percent,
+		BuyQuantity:  model.BuyQuantity,
+		FreeQuantity: model.FreeQuantity,
+		StartsAt:     model.StartsAt,
+		EndsAt:       model.EndsAt,
+		IsActive:     model.IsActive,
+		CreatedAt:    model.CreatedAt,
+		UpdatedAt:    model.UpdatedAt,
+	}
+	if model.CategoryID != nil {
+		rule.CategoryID = *model.CategoryID
+	}
+
+	return rule
+}
+
+func (c *Converter) ToUsageEntity(model *discount_model.PromoCodeUsage) *discount_entity.Usage {
+	return &discount_entity.Usage{
+		ID:          model.ID,
+		PromoCodeID: model.PromoCodeID,
+		UserID:      model.UserID,
+		OrderID:     model.OrderID,
+		CreatedAt:   model.CreatedAt,
+	}
+}
+
+func optional(value string) *string {
+	if value == "" {
+		return nil
+	}
+	return &value
+}
@@ -0,0 +1,302 @@
+package discount_repository
+
+import (
+	"context"
+
+	discount_entity "github.com/Fi44er/sdmed/internal/module/discount/entity"
+	discount_model "github.com/Fi44er/sdmed/internal/module/discount/infrastructure/repository/model"
+	"github.com/Fi44er/sdmed/pkg/logger"
+	"gorm.io/gorm"
+)
+
+type IDiscountRepository interface {
+	GetPromoCodes(ctx context.Context) ([]discount_entity.PromoCode, error)
+	GetPromoCodeByID(ctx context.Context, id string) (*discount_entity.PromoCode, error)
+	GetPromoCodeByCode(ctx context.Context, code string) (*discount_entity.PromoCode, error)
+	CreatePromoCode(ctx context.Context, promo *discount_entity.PromoCode) error
+	UpdatePromoCode(ctx context.Context, promo *discount_entity.PromoCode) error
+	DeletePromoCode(ctx context.Context, id string) error
+
+	GetRules(ctx context.Context, onlyActive bool) ([]discount_entity.Rule, error)
+	GetRuleByID(ctx context.Context, id string) (*discount_entity.Rule, error)
+	CreateRule(ctx context.Context, rule *discount_entity.Rule) error
+	UpdateRule(ctx context.Context, rule *discount_entity.Rule) error
+	DeleteRule(ctx context.Context, id string) error
+
+	CountUsages(ctx context.Context, promoCodeID, userID string) (int64, error)
+	GetUsageByOrderID(ctx context.Context, orderID string) (*discount_entity.Usage, error)
+	AddUsage(ctx context.Context, usage *discount_entity.Usage) error
+	DeleteUsage(ctx context.Context, id string) error
+	IncrementUsedCount(ctx context.Context, promoCodeID string) (bool, error)
+	DecrementUsedCount(ctx context.Context, promoCodeID string) error
+}
+
+type DiscountRepository struct {
+	logger    *logger.Logger
+	db        *gorm.DB
+	converter *Converter
+}
+
+func NewDiscountRepository(logger *logger.Logger, db *gorm.DB) IDiscountRepository {
+	return &DiscountRepository{
+		logger:    logger,
+		db:        db,
+		converter: &Converter{},
+	}
+}
+
+func (r *DiscountRepository) GetPromoCodes(ctx context.Context) ([]discount_entity.PromoCode, error) {
+	var promoModels []discount_model.PromoCode
+	if err := r.db.WithContext(ctx).Order("created_at DESC").Find(&promoModels).Error; err != nil {
+		r.logger.Errorf("Failed to get promo codes: %v", err)
+		return nil, err
+	}
+
+	promos := make([]discount_entity.PromoCode, len(promoModels))
+	for i := range promoModels {
+		promos[i] = *r.converter.ToPromoCodeEntity(&promoModels[i])
+	}
+
+	return promos, nil
+}
+
+func (r *DiscountRepository) GetPromoCodeByID(ctx context.Context, id string) (*discount_entity.PromoCode, error) {
+	return r.getPromoCode(ctx, "id = ?", id)
+}
+
+func (r *DiscountRepository) GetPromoCodeByCode(ctx context.Context, code string) (*discount_entity.PromoCode, error) {
+	return r.getPromoCode(ctx, "code = ?", code)
+}
+
+func (r *DiscountRepository) CreatePromoCode(ctx context.Context, promo *discount_entity.PromoCode) error {
+	r.logger.Infof("Creating promo code: %s", promo.Code)
+
+	promoModel := r.converter.ToPromoCodeModel(promo)
+	if err := r.db.WithContext(ctx).Create(promoModel).Error; err != nil {
+		r.logger.Errorf("Failed to create promo code %s: %v", promo.Code, err)
+		return err
+	}
+	promo.ID = promoModel.ID
+	promo.CreatedAt = promoModel.CreatedAt
+	promo.UpdatedAt = promoModel.UpdatedAt
+
+	return nil
+}
+
+// UpdatePromoCode не меняет счетчик применений: он ведется только через применения в заказах
+func (r *DiscountRepository) UpdatePromoCode(ctx context.Context, promo *discount_entity.PromoCode) error {
+	r.logger.Infof("Updating promo code: %s", promo.ID)
+
+	err := r.db.WithContext(ctx).
+		Model(&discount_model.PromoCode{}).
+		Where("id = ?", promo.ID).
+		Updates(map[string]any{
+			"code":             promo.Code,
+			"type":             string(promo.Type),
+			"value":            promo.Value,
+			"min_order_amount": promo.MinOrderAmount,
+			"starts_at":        promo.StartsAt,
+			"ends_at":          promo.EndsAt,
+			"usage_limit":      promo.UsageLimit,
+			"per_user_limit":   promo.PerUserLimit,
+			"is_active":        promo.IsActive,
+			"updated_at":       gorm.Expr("now()"),
+		}).Error
+	if err != nil {
+		r.logger.Errorf("Failed to update promo code %s: %v", promo.ID, err)
+		return err
+	}
+
+	return nil
+}
+
+func (r *DiscountRepository) DeletePromoCode(ctx context.Context, id string) error {
+	r.logger.Infof("Deleting promo code: %s", id)
+
+	if err := r.db.WithContext(ctx).Delete(&discount_model.PromoCode{}, "id = ?", id).Error; err != nil {
+		r.logger.Errorf("Failed to delete promo code %s: %v", id, err)
+		return err
+	}
+
+	return nil
+}
+
+// GetRules возвращает правила скидок. onlyActive - только включенные, без проверки периода действия
+func (r *DiscountRepository) GetRules(ctx context.Context, onlyActive bool) ([]discount_entity.Rule, error) {
+	var ruleModels []discount_model.Rule
+	query := r.db.WithContext(ctx)
+	if onlyActive {
+		query = query.Where("is_active = ?", true)
+	}
+
+	if err := query.Order("created_at DESC").Find(&ruleModels).Error; err != nil {
+		r.logger.Errorf("Failed to get discount rules: %v", err)
+		return nil, err
+	}
+
+	rules := make([]discount_entity.Rule, len(ruleModels))
+	for i := range ruleModels {
+		rules[i] = *r.converter.ToRuleEntity(&ruleModels[i])
+	}
+
+	return rules, nil
+}
+
+func (r *DiscountRepository) GetRuleByID(ctx context.Context, id string) (*discount_entity.Rule, error) {
+	var ruleModel discount_model.Rule
+	if err := r.db.WithContext(ctx).First(&ruleModel, "id = ?", id).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, nil
+		}
+		r.logger.Errorf("Failed to get discount rule %s: %v", id, err)
+		return nil, err
+	}
+
+	return r.converter.ToRuleEntity(&ruleModel), nil
+}
+
+func (r *DiscountRepository) CreateRule(ctx context.Context, rule *discount_entity.Rule) error {
+	r.logger.Infof("Creating discount rule: %s", rule.Name)
+
+	ruleModel := r.converter.ToRuleModel(rule)
+	if err := r.db.WithContext(ctx).Create(ruleModel).Error; err != nil {
+		r.logger.Errorf("Failed to create discount rule %s: %v", rule.Name, err)
+		return err
+	}
+	rule.ID = ruleModel.ID
+	rule.CreatedAt = ruleModel.CreatedAt
+	rule.UpdatedAt = ruleModel.UpdatedAt
+
+	return nil
+}
+
+func (r *DiscountRepository) UpdateRule(ctx context.Context, rule *discount_entity.Rule) error {
+	r.logger.Infof("Updating discount rule: %s", rule.ID)
+
+	ruleModel := r.converter.ToRuleModel(rule)
+	err := r.db.WithContext(ctx).
+		Model(&discount_model.Rule{}).
+		Where("id = ?", rule.ID).
+		Updates(map[string]any{
+			"name":          ruleModel.Name,
+			"type":          ruleModel.Type,
+			"category_id":   ruleModel.CategoryID,
+			"percent":       ruleModel.Percent,
+			"buy_quantity":  ruleModel.BuyQuantity,
+			"free_quantity": ruleModel.FreeQuantity,
+			"starts_at":     ruleModel.StartsAt,
+			"ends_at":       ruleModel.EndsAt,
+			"is_active":     ruleModel.IsActive,
+			"updated_at":    gorm.Expr("now()"),
+		}).Error
+	if err != nil {
+		r.logger.Errorf("Failed to update discount rule %s: %v", rule.ID, err)
+		return err
+	}
+
+	return nil
+}
+
+func (r *DiscountRepository) DeleteRule(ctx context.Context, id string) error {
+	r.logger.Infof("Deleting discount rule: %s", id)
+
+	if err := r.db.WithContext(ctx).Delete(&discount_model.Rule{}, "id = ?", id).Error; err != nil {
+		r.logger.Errorf("Failed to delete discount rule %s: %v", id, err)
+		return err
+	}
+
+	return nil
+}
+
+func (r *DiscountRepository) CountUsages(ctx context.Context, promoCodeID, userID string) (int64, error) {
+	var count int64
+	err := r.db.WithContext(ctx).
+		Model(&discount_model.PromoCodeUsage{}).
+		Where("promo_code_id = ? AND user_id = ?", promoCodeID, userID).
+		Count(&count).Error
+	if err != nil {
+		r.logger.Errorf("Failed to count usages of promo code %s: %v", promoCodeID, err)
+		return 0, err
+	}
+
+	return count, nil
+}
+
+func (r *DiscountRepository) GetUsageByOrderID(ctx context.Context, orderID string) (*discount_entity.Usage, error) {
+	var usageModel discount_model.PromoCodeUsage
+	if err := r.db.WithContext(ctx).First(&usageModel, "order_id = ?", orderID).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, nil
+		}
+		r.logger.Errorf("Failed to get promo code usage of order %s: %v", orderID, err)
+		return nil, err
+	}
+
+	return r.converter.ToUsageEntity(&usageModel), nil
+}
+
+func (r *DiscountRepository) AddUsage(ctx context.Context, usage *discount_entity.Usage) error {
+	usageModel := &discount_model.PromoCodeUsage{
+		PromoCodeID: usage.PromoCodeID,
+		UserID:      usage.UserID,
+		OrderID:     usage.OrderID,
+	}
+	if err := r.db.WithContext(ctx).Create(usageModel).Error; err != nil {
+		r.logger.Errorf("Failed to add usage of promo code %s: %v", usage.PromoCodeID, err)
+		return err
+	}
+	usage.ID = usageModel.ID
+	usage.CreatedAt = usageModel.CreatedAt
+
+	return nil
+}
+
+func (r *DiscountRepository) DeleteUsage(ctx context.Context, id string) error {
+	if err := r.db.WithContext(ctx).Delete(&discount_model.PromoCodeUsage{}, "id = ?", id).Error; err != nil {
+		r.logger.Errorf("Failed to delete promo code usage %s: %v", id, err)
+		return err
+	}
+
+	return nil
+}
+
+// IncrementUsedCount увеличивает счетчик применений, если общий лимит не исчерпан.
+// Условие в запросе защищает лимит от одновременных заказов
+func (r *DiscountRepository) IncrementUsedCount(ctx context.Context, promoCodeID string) (bool, error) {
+	result := r.db.WithContext(ctx).
+		Model(&discount_model.PromoCode{}).
+		Where("id = ? AND (usage_limit = 0 OR used_count < usage_limit)", promoCodeID).
+		Update("used_count", gorm.Expr("used_count + 1"))
+	if result.Error != nil {
+		r.logger.Errorf("Failed to increment usages of promo code %s: %v", promoCodeID, result.Error)
+		return false, result.Error
+	}
+
+	return result.RowsAffected > 0, nil
+}
+
+func (r *DiscountRepository) DecrementUsedCount(ctx context.Context, promoCodeID string) error {
+	err := r.db.WithContext(ctx).
+		Model(&discount_model.PromoCode{}).
+		Where("id = ? AND used_count > 0", promoCodeID).
+		Update("used_count", gorm.Expr("used_count - 1")).Error
+	if err != nil {
+		r.logger.Errorf("Failed to decrement usages of promo code %s: %v", promoCodeID, err)
+		return err
+	}
+
+	return nil
+}
+
+func (r *DiscountRepository) getPromoCode(ctx context.Context, query string, value string) (*discount_entity.PromoCode, error) {
+	var promoModel discount_model.PromoCode
+	if err := r.db.WithContext(ctx).First(&promoModel, query, value).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, nil
+		}
+		r.logger.Errorf("Failed to get promo code (%s %s): %v", query, value, err)
+		return nil, err
+	}
+
+	return r.converter.ToPromoCodeEntity(&promoModel), nil
+}
@@ -0,0 +1,56 @@
+package discount_model
+
+import "time"
+
+type PromoCode struct {
+	ID             string     `gorm:"primaryKey;type:uuid;default:uuid_generate_v4();"`
+	Code           string     `gorm:"type:varchar(50);not null;uniqueIndex"`
+	Type           string     `gorm:"type:varchar(20);not null"`
+	Value          float64    `gorm:"type:float;not null"`
+	MinOrderAmount float64    `gorm:"type:float;not null;default:0"`
+	StartsAt       *time.Time `gorm:"type:timestamptz"`
+	EndsAt         *time.Time `gorm:"type:timestamptz"`
+	UsageLimit     int        `gorm:"not null;default:0"`
+	PerUserLimit   int        `gorm:"not null;default:0"`
+	UsedCount      int        `gorm:"not null;default:0"`
+	IsActive       bool       `gorm:"not null;default:true"`
+	CreatedAt      time.Time  `gorm:"not null;default:now()"`
+	UpdatedAt      time.Time  `gorm:"not null;default:now()"`
+}
+
+func (PromoCode) TableName() string {
+	return "discount_module.promo_codes"
+}
+
+type Rule struct {
+	ID           string     `gorm:"primaryKey;type:uuid;default:uuid_generate_v4();"`
+	Name         string     `gorm:"type:varchar(255);not null"`
+	Type         string     `gorm:"type:varchar(20);not null"`
+	CategoryID   *string    `gorm:"type:uuid;index"`
+	Percent      float64    `gorm:"type:float;not null;default:0"`
+	BuyQuantity  int        `gorm:"not null;default:0"`
+	FreeQuantity int        `gorm:"not null;default:0"`
+	StartsAt     *time.Time `gorm:"type:timestamptz"`
+	EndsAt       *time.Time `gorm:"type:timestamptz"`
+	IsActive     bool       `gorm:"not null;default:true"`
+	CreatedAt    time.Time  `gorm:"not null;default:now()"`
+	UpdatedAt    time.Time  `gorm:"not null;default:now()"`
+}
+
+func (Rule) TableName() string {
+	return "discount_module.rules"
+}
+
+// PromoCodeUsage - применение промокода. У заказа не больше одного промокода
+type PromoCodeUsage struct {
+	ID          string    `gorm:"primaryKey;type:uuid;default:uuid_generate_v4();"`
+	PromoCodeID string    `gorm:"type:uuid;not null;index:idx_promo_code_usage_user"`
+	PromoCode   PromoCode `gorm:"foreignKey:PromoCodeID;constraint:OnDelete:CASCADE"`
+	UserID      string    `gorm:"type:uuid;not null;index:idx_promo_code_usage_user"`
+	OrderID     string    `gorm:"type:uuid;not null;uniqueIndex"`
+	CreatedAt   time.Time `gorm:"not null;default:now()"`
+}
+
+func (PromoCodeUsage) TableName() string {
+	return "discount_module.promo_code_usages"
+}
@@ -0,0 +1,76 @@
+package discount_module
+
+import (
+	cart_usecase "github.com/Fi44er/sdmed/internal/module/cart/usecase/cart"
+	discount_http "github.com/Fi44er/sdmed/internal/module/discount/delivery/http"
+	discount_adapters "github.com/Fi44er/sdmed/internal/module/discount/infrastructure/adapters"
+	discount_repository "github.com/Fi44er/sdmed/internal/module/discount/infrastructure/repository/discount"
+	discount_usecase "github.com/Fi44er/sdmed/internal/module/discount/usecase/discount"
+	discount_usecase_contracts "github.com/Fi44er/sdmed/internal/module/discount/usecase/discount/contracts"
+	product_usecase "github.com/Fi44er/sdmed/internal/module/product/usecase/product"
+	"github.com/Fi44er/sdmed/pkg/logger"
+	"github.com/Fi44er/sdmed/pkg/postgres/uow"
+	"github.com/go-playground/validator/v10"
+	"github.com/gofiber/fiber/v2"
+	"gorm.io/gorm"
+)
+
+type DiscountModule struct {
+	discountRepository discount_repository.IDiscountRepository
+	discountUsecase    discount_usecase.IDiscountUsecase
+	discountHandler    *discount_http.DiscountHandler
+
+	sessionRepository discount_usecase_contracts.ISessionRepository
+	cartUsecase       cart_usecase.ICartUsecase
+	productUsecase    product_usecase.IProductUsecase
+
+	logger    *logger.Logger
+	validator *validator.Validate
+	db        *gorm.DB
+	uow       uow.Uow
+}
+
+func NewDiscountModule(
+	logger *logger.Logger,
+	validator *validator.Validate,
+	db *gorm.DB,
+	uow uow.Uow,
+	sessionRepository discount_usecase_contracts.ISessionRepository,
+	cartUsecase cart_usecase.ICartUsecase,
+	productUsecase product_usecase.IProductUsecase,
+) *DiscountModule {
+	return &DiscountModule{
+		logger:            logger,
+		validator:         validator,
+		db:                db,
+		uow:               uow,
+		sessionRepository: sessionRepository,
+		cartUsecase:       cartUsecase,
+		productUsecase:    productUsecase,
+	}
+}
+
+func (m *DiscountModule) Init() {
+	m.uow.RegisterRepository("discount", func(tx *gorm.DB) (any, error) {
+		return discount_repository.NewDiscountRepository(m.logger, tx), nil
+	})
+
+	m.discountRepository = discount_repository.NewDiscountRepository(m.logger, m.db)
+	m.discountUsecase = discount_usecase.NewDiscountUsecase(
+		m.discountRepository,
+		m.sessionRepository,
+		discount_adapters.NewCartUsecaseAdapter(m.cartUsecase),
+		discount_adapters.NewProductUsecaseAdapter(m.productUsecase),
+		m.uow,
+		m.logger,
+	)
+	m.discountHandler = discount_http.NewDiscountHandler(m.discountUsecase, m.validator, m.logger)
+}
+
+func (m *DiscountModule) InitDelivery(router fiber.Router) {
+	m.discountHandler.RegisterRoutes(router)
+}
+
+func (m *DiscountModule) GetDiscountUsecase() discount_usecase.IDiscountUsecase {
+	return m.discountUsecase
+}
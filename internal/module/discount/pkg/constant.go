@@ -0,0 +1,21 @@
+package discount_constant
+
+import "github.com/Fi44er/sdmed/pkg/customerr"
+
+var (
+	ErrPromoCodeNotFound   = customerr.NewError(404, "promo code not found")
+	ErrRuleNotFound        = customerr.NewError(404, "discount rule not found")
+	ErrPromoCodeExists     = customerr.NewError(409, "promo code already exists")
+	ErrPromoCodeInactive   = customerr.NewError(400, "promo code is not active")
+	ErrPromoCodeExhausted  = customerr.NewError(400, "promo code usage limit reached")
+	ErrPromoCodeUsedUp     = customerr.NewError(400, "promo code has already been used the maximum number of times")
+	ErrMinOrderAmount      = customerr.NewError(400, "order amount is below the promo code minimum")
+	ErrInvalidValueType    = customerr.NewError(400, "invalid promo code type")
+	ErrInvalidRuleType     = customerr.NewError(400, "invalid discount rule type")
+	ErrInvalidValue        = customerr.NewError(400, "percent discount must be between 0 and 100")
+	ErrInvalidPeriod       = customerr.NewError(400, "discount must start before it ends")
+	ErrInvalidNPlusOne     = customerr.NewError(400, "n+1 rule requires buy and free quantities")
+	ErrCategoryRequired    = customerr.NewError(400, "category rule requires category")
+	ErrCartEmpty           = customerr.NewError(400, "cart is empty")
+	ErrSessionUserNotFound = customerr.NewError(401, "session user not found")
+)
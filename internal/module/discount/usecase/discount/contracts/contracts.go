@@ -0,0 +1,42 @@
+package discount_usecase_contracts
+
+import (
+	"context"
+
+	auth_entity "github.com/Fi44er/sdmed/internal/module/auth/entity"
+	discount_entity "github.com/Fi44er/sdmed/internal/module/discount/entity"
+)
+
+type IDiscountRepository interface {
+	GetPromoCodes(ctx context.Context) ([]discount_entity.PromoCode, error)
+	GetPromoCodeByID(ctx context.Context, id string) (*discount_entity.PromoCode, error)
+	GetPromoCodeByCode(ctx context.Context, code string) (*discount_entity.PromoCode, error)
+	CreatePromoCode(ctx context.Context, promo *discount_entity.PromoCode) error
+	UpdatePromoCode(ctx context.Context, promo *discount_entity.PromoCode) error
+	DeletePromoCode(ctx context.Context, id string) error
+
+	GetRules(ctx context.Context, onlyActive bool) ([]discount_entity.Rule, error)
+	GetRuleByID(ctx context.Context, id string) (*discount_entity.Rule, error)
+	CreateRule(ctx context.Context, rule *discount_entity.Rule) error
+	UpdateRule(ctx context.Context, rule *discount_entity.Rule) error
+	DeleteRule(ctx context.Context, id string) error
+
+	CountUsages(ctx context.Context, promoCodeID, userID string) (int64, error)
+	GetUsageByOrderID(ctx context.Context, orderID string) (*discount_entity.Usage, error)
+	AddUsage(ctx context.Context, usage *discount_entity.Usage) error
+	DeleteUsage(ctx context.Context, id string) error
+	IncrementUsedCount(ctx context.Context, promoCodeID string) (bool, error)
+	DecrementUsedCount(ctx context.Context, promoCodeID string) error
+}
+
+type ISessionRepository interface {
+	GetSessionInfo(ctx context.Context) (*auth_entity.ActiveSession, error)
+}
+
+type ICartUsecaseAdapter interface {
+	GetLines(ctx context.Context, userID string) ([]discount_entity.Line, error)
+}
+
+type IProductUsecaseAdapter interface {
+	GetCategories(ctx context.Context, productIDs []string) (map[string]string, error)
+}
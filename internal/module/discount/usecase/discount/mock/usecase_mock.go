@@ -0,0 +1,397 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./discount/contracts/contracts.go
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	context "context"
+	reflect "reflect"
+
+	auth_entity "github.com/Fi44er/sdmed/internal/module/auth/entity"
+	discount_entity "github.com/Fi44er/sdmed/internal/module/discount/entity"
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockIDiscountRepository is a mock of IDiscountRepository interface.
+type MockIDiscountRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockIDiscountRepositoryMockRecorder
+}
+
+// MockIDiscountRepositoryMockRecorder is the mock recorder for MockIDiscountRepository.
+type MockIDiscountRepositoryMockRecorder struct {
+	mock *MockIDiscountRepository
+}
+
+// NewMockIDiscountRepository creates a new mock instance.
+func NewMockIDiscountRepository(ctrl *gomock.Controller) *MockIDiscountRepository {
+	mock := &MockIDiscountRepository{ctrl: ctrl}
+	mock.recorder = &MockIDiscountRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockIDiscountRepository) EXPECT() *MockIDiscountRepositoryMockRecorder {
+	return m.recorder
+}
+
+// AddUsage mocks base method.
+func (m *MockIDiscountRepository) AddUsage(ctx context.Context, usage *discount_entity.Usage) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddUsage", ctx, usage)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddUsage indicates an expected call of AddUsage.
+func (mr *MockIDiscountRepositoryMockRecorder) AddUsage(ctx, usage interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddUsage", reflect.TypeOf((*MockIDiscountRepository)(nil).AddUsage), ctx, usage)
+}
+
+// CountUsages mocks base method.
+func (m *MockIDiscountRepository) CountUsages(ctx context.Context, promoCodeID, userID string) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountUsages", ctx, promoCodeID, userID)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountUsages indicates an expected call of CountUsages.
+func (mr *MockIDiscountRepositoryMockRecorder) CountUsages(ctx, promoCodeID, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountUsages", reflect.TypeOf((*MockIDiscountRepository)(nil).CountUsages), ctx, promoCodeID, userID)
+}
+
+// CreatePromoCode mocks base method.
+func (m *MockIDiscountRepository) CreatePromoCode(ctx context.Context, promo *discount_entity.PromoCode) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreatePromoCode", ctx, promo)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreatePromoCode indicates an expected call of CreatePromoCode.
+func (mr *MockIDiscountRepositoryMockRecorder) CreatePromoCode(ctx, promo interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreatePromoCode", reflect.TypeOf((*MockIDiscountRepository)(nil).CreatePromoCode), ctx, promo)
+}
+
+// CreateRule mocks base method.
+func (m *MockIDiscountRepository) CreateRule(ctx context.Context, rule *discount_entity.Rule) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateRule", ctx, rule)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateRule indicates an expected call of CreateRule.
+func (mr *MockIDiscountRepositoryMockRecorder) CreateRule(ctx, rule interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateRule", reflect.TypeOf((*MockIDiscountRepository)(nil).CreateRule), ctx, rule)
+}
+
+// DecrementUsedCount mocks base method.
+func (m *MockIDiscountRepository) DecrementUsedCount(ctx context.Context, promoCodeID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DecrementUsedCount", ctx, promoCodeID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DecrementUsedCount indicates an expected call of DecrementUsedCount.
+func (mr *MockIDiscountRepositoryMockRecorder) DecrementUsedCount(ctx, promoCodeID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DecrementUsedCount", reflect.TypeOf((*MockIDiscountRepository)(nil).DecrementUsedCount), ctx, promoCodeID)
+}
+
+// DeletePromoCode mocks base method.
+func (m *MockIDiscountRepository) DeletePromoCode(ctx context.Context, id string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeletePromoCode", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeletePromoCode indicates an expected call of DeletePromoCode.
+func (mr *MockIDiscountRepositoryMockRecorder) DeletePromoCode(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeletePromoCode", reflect.TypeOf((*MockIDiscountRepository)(nil).DeletePromoCode), ctx, id)
+}
+
+// DeleteRule mocks base method.
+func (m *MockIDiscountRepository) DeleteRule(ctx context.Context, id string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteRule", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteRule indicates an expected call of DeleteRule.
+func (mr *MockIDiscountRepositoryMockRecorder) DeleteRule(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteRule", reflect.TypeOf((*MockIDiscountRepository)(nil).DeleteRule), ctx, id)
+}
+
+// DeleteUsage mocks base method.
+func (m *MockIDiscountRepository) DeleteUsage(ctx context.Context, id string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteUsage", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteUsage indicates an expected call of DeleteUsage.
+func (mr *MockIDiscountRepositoryMockRecorder) DeleteUsage(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteUsage", reflect.TypeOf((*MockIDiscountRepository)(nil).DeleteUsage), ctx, id)
+}
+
+// GetPromoCodeByCode mocks base method.
+func (m *MockIDiscountRepository) GetPromoCodeByCode(ctx context.Context, code string) (*discount_entity.PromoCode, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPromoCodeByCode", ctx, code)
+	ret0, _ := ret[0].(*discount_entity.PromoCode)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPromoCodeByCode indicates an expected call of GetPromoCodeByCode.
+func (mr *MockIDiscountRepositoryMockRecorder) GetPromoCodeByCode(ctx, code interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPromoCodeByCode", reflect.TypeOf((*MockIDiscountRepository)(nil).GetPromoCodeByCode), ctx, code)
+}
+
+// GetPromoCodeByID mocks base method.
+func (m *MockIDiscountRepository) GetPromoCodeByID(ctx context.Context, id string) (*discount_entity.PromoCode, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPromoCodeByID", ctx, id)
+	ret0, _ := ret[0].(*discount_entity.PromoCode)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPromoCodeByID indicates an expected call of GetPromoCodeByID.
+func (mr *MockIDiscountRepositoryMockRecorder) GetPromoCodeByID(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPromoCodeByID", reflect.TypeOf((*MockIDiscountRepository)(nil).GetPromoCodeByID), ctx, id)
+}
+
+// GetPromoCodes mocks base method.
+func (m *MockIDiscountRepository) GetPromoCodes(ctx context.Context) ([]discount_entity.PromoCode, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPromoCodes", ctx)
+	ret0, _ := ret[0].([]discount_entity.PromoCode)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPromoCodes indicates an expected call of GetPromoCodes.
+func (mr *MockIDiscountRepositoryMockRecorder) GetPromoCodes(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPromoCodes", reflect.TypeOf((*MockIDiscountRepository)(nil).GetPromoCodes), ctx)
+}
+
+// GetRuleByID mocks base method.
+func (m *MockIDiscountRepository) GetRuleByID(ctx context.Context, id string) (*discount_entity.Rule, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRuleByID", ctx, id)
+	ret0, _ := ret[0].(*discount_entity.Rule)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRuleByID indicates an expected call of GetRuleByID.
+func (mr *MockIDiscountRepositoryMockRecorder) GetRuleByID(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRuleByID", reflect.TypeOf((*MockIDiscountRepository)(nil).GetRuleByID), ctx, id)
+}
+
+// GetRules mocks base method.
+func (m *MockIDiscountRepository) GetRules(ctx context.Context, onlyActive bool) ([]discount_entity.Rule, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRules", ctx, onlyActive)
+	ret0, _ := ret[0].([]discount_entity.Rule)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRules indicates an expected call of GetRules.
+func (mr *MockIDiscountRepositoryMockRecorder) GetRules(ctx, onlyActive interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRules", reflect.TypeOf((*MockIDiscountRepository)(nil).GetRules), ctx, onlyActive)
+}
+
+// GetUsageByOrderID mocks base method.
+func (m *MockIDiscountRepository) GetUsageByOrderID(ctx context.Context, orderID string) (*discount_entity.Usage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUsageByOrderID", ctx, orderID)
+	ret0, _ := ret[0].(*discount_entity.Usage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUsageByOrderID indicates an expected call of GetUsageByOrderID.
+func (mr *MockIDiscountRepositoryMockRecorder) GetUsageByOrderID(ctx, orderID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUsageByOrderID", reflect.TypeOf((*MockIDiscountRepository)(nil).GetUsageByOrderID), ctx, orderID)
+}
+
+// IncrementUsedCount mocks base method.
+func (m *MockIDiscountRepository) IncrementUsedCount(ctx context.Context, promoCodeID string) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IncrementUsedCount", ctx, promoCodeID)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// IncrementUsedCount indicates an expected call of IncrementUsedCount.
+func (mr *MockIDiscountRepositoryMockRecorder) IncrementUsedCount(ctx, promoCodeID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IncrementUsedCount", reflect.TypeOf((*MockIDiscountRepository)(nil).IncrementUsedCount), ctx, promoCodeID)
+}
+
+// UpdatePromoCode mocks base method.
+func (m *MockIDiscountRepository) UpdatePromoCode(ctx context.Context, promo *discount_entity.PromoCode) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdatePromoCode", ctx, promo)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdatePromoCode indicates an expected call of UpdatePromoCode.
+func (mr *MockIDiscountRepositoryMockRecorder) UpdatePromoCode(ctx, promo interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdatePromoCode", reflect.TypeOf((*MockIDiscountRepository)(nil).UpdatePromoCode), ctx, promo)
+}
+
+// UpdateRule mocks base method.
+func (m *MockIDiscountRepository) UpdateRule(ctx context.Context, rule *discount_entity.Rule) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateRule", ctx, rule)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateRule indicates an expected call of UpdateRule.
+func (mr *MockIDiscountRepositoryMockRecorder) UpdateRule(ctx, rule interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateRule", reflect.TypeOf((*MockIDiscountRepository)(nil).UpdateRule), ctx, rule)
+}
+
+// MockISessionRepository is a mock of ISessionRepository interface.
+type MockISessionRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockISessionRepositoryMockRecorder
+}
+
+// MockISessionRepositoryMockRecorder is the mock recorder for MockISessionRepository.
+type MockISessionRepositoryMockRecorder struct {
+	mock *MockISessionRepository
+}
+
+// NewMockISessionRepository creates a new mock instance.
+func NewMockISessionRepository(ctrl *gomock.Controller) *MockISessionRepository {
+	mock := &MockISessionRepository{ctrl: ctrl}
+	mock.recorder = &MockISessionRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockISessionRepository) EXPECT() *MockISessionRepositoryMockRecorder {
+	return m.recorder
+}
+
+// GetSessionInfo mocks base method.
+func (m *MockISessionRepository) GetSessionInfo(ctx context.Context) (*auth_entity.ActiveSession, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSessionInfo", ctx)
+	ret0, _ := ret[0].(*auth_entity.ActiveSession)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSessionInfo indicates an expected call of GetSessionInfo.
+func (mr *MockISessionRepositoryMockRecorder) GetSessionInfo(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSessionInfo", reflect.TypeOf((*MockISessionRepository)(nil).GetSessionInfo), ctx)
+}
+
+// MockICartUsecaseAdapter is a mock of ICartUsecaseAdapter interface.
+type MockICartUsecaseAdapter struct {
+	ctrl     *gomock.Controller
+	recorder *MockICartUsecaseAdapterMockRecorder
+}
+
+// MockICartUsecaseAdapterMockRecorder is the mock recorder for MockICartUsecaseAdapter.
+type MockICartUsecaseAdapterMockRecorder struct {
+	mock *MockICartUsecaseAdapter
+}
+
+// NewMockICartUsecaseAdapter creates a new mock instance.
+func NewMockICartUsecaseAdapter(ctrl *gomock.Controller) *MockICartUsecaseAdapter {
+	mock := &MockICartUsecaseAdapter{ctrl: ctrl}
+	mock.recorder = &MockICartUsecaseAdapterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockICartUsecaseAdapter) EXPECT() *MockICartUsecaseAdapterMockRecorder {
+	return m.recorder
+}
+
+// GetLines mocks base method.
+func (m *MockICartUsecaseAdapter) GetLines(ctx context.Context, userID string) ([]discount_entity.Line, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetLines", ctx, userID)
+	ret0, _ := ret[0].([]discount_entity.Line)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetLines indicates an expected call of GetLines.
+func (mr *MockICartUsecaseAdapterMockRecorder) GetLines(ctx, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLines", reflect.TypeOf((*MockICartUsecaseAdapter)(nil).GetLines), ctx, userID)
+}
+
+// MockIProductUsecaseAdapter is a mock of IProductUsecaseAdapter interface.
+type MockIProductUsecaseAdapter struct {
+	ctrl     *gomock.Controller
+	recorder *MockIProductUsecaseAdapterMockRecorder
+}
+
+// MockIProductUsecaseAdapterMockRecorder is the mock recorder for MockIProductUsecaseAdapter.
+type MockIProductUsecaseAdapterMockRecorder struct {
+	mock *MockIProductUsecaseAdapter
+}
+
+// NewMockIProductUsecaseAdapter creates a new mock instance.
+func NewMockIProductUsecaseAdapter(ctrl *gomock.Controller) *MockIProductUsecaseAdapter {
+	mock := &MockIProductUsecaseAdapter{ctrl: ctrl}
+	mock.recorder = &MockIProductUsecaseAdapterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockIProductUsecaseAdapter) EXPECT() *MockIProductUsecaseAdapterMockRecorder {
+	return m.recorder
+}
+
+// GetCategories mocks base method.
+func (m *MockIProductUsecaseAdapter) GetCategories(ctx context.Context, productIDs []string) (map[string]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCategories", ctx, productIDs)
+	ret0, _ := ret[0].(map[string]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCategories indicates an expected call of GetCategories.
+func (mr *MockIProductUsecaseAdapterMockRecorder) GetCategories(ctx, productIDs interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCategories", reflect.TypeOf((*MockIProductUsecaseAdapter)(nil).GetCategories), ctx, productIDs)
+}
@@ -0,0 +1,167 @@
+package discount_testcases
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	auth_entity "github.com/Fi44er/sdmed/internal/module/auth/entity"
+	discount_entity "github.com/Fi44er/sdmed/internal/module/discount/entity"
+	discount_constant "github.com/Fi44er/sdmed/internal/module/discount/pkg"
+	"github.com/Fi44er/sdmed/internal/module/discount/usecase/discount/mock"
+	uow_mock "github.com/Fi44er/sdmed/pkg/postgres/uow/mock"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+type MockCalculateCart struct {
+	Ctrl            *gomock.Controller
+	Ctx             context.Context
+	RepoMock        *mock.MockIDiscountRepository
+	SessionRepoMock *mock.MockISessionRepository
+	CartMock        *mock.MockICartUsecaseAdapter
+	ProductMock     *mock.MockIProductUsecaseAdapter
+	UowMock         *uow_mock.MockUow
+	T               assert.TestingT
+}
+
+type CalculateCartTestCase struct {
+	Name             string
+	Code             string
+	SetupMocks       func(m *MockCalculateCart)
+	ExpectedSubtotal float64
+	ExpectedDiscount float64
+	ExpectedTotal    float64
+	ExpectedError    error
+}
+
+const UserID = "user"
+
+func salePromo() *discount_entity.PromoCode {
+	return &discount_entity.PromoCode{ID: "sale", Code: "SALE", Type: discount_entity.ValueTypePercent, Value: 10, MinOrderAmount: 2000, PerUserLimit: 1, IsActive: true}
+}
+
+func limitedPromo() *discount_entity.PromoCode {
+	return &discount_entity.PromoCode{ID: "limited", Code: "LIMITED", Type: discount_entity.ValueTypeFixed, Value: 100, UsageLimit: 1, IsActive: true}
+}
+
+func rules() []discount_entity.Rule {
+	yesterday := time.Now().Add(-24 * time.Hour)
+	return []discount_entity.Rule{
+		{ID: "hygiene", Name: "Гигиена -20%", Type: discount_entity.RuleTypeCategory, CategoryID: "hygiene", Percent: 20, IsActive: true},
+		{ID: "expired", Name: "Старая акция", Type: discount_entity.RuleTypeNPlusOne, BuyQuantity: 1, FreeQuantity: 1, EndsAt: &yesterday, IsActive: true},
+	}
+}
+
+func lines() []discount_entity.Line {
+	return []discount_entity.Line{
+		{ProductID: "diapers", Quantity: 2, Price: 1000},
+		{ProductID: "cane", Quantity: 1, Price: 1500},
+	}
+}
+
+func expectCart(m *MockCalculateCart, cartLines []discount_entity.Line) {
+	m.SessionRepoMock.EXPECT().GetSessionInfo(m.Ctx).Return(&auth_entity.ActiveSession{UserID: UserID}, nil)
+	m.CartMock.EXPECT().GetLines(m.Ctx, UserID).Return(cartLines, nil)
+}
+
+func expectRules(m *MockCalculateCart) {
+	m.RepoMock.EXPECT().GetRules(m.Ctx, true).Return(rules(), nil)
+	m.ProductMock.EXPECT().GetCategories(m.Ctx, gomock.Any()).Return(map[string]string{"diapers": "hygiene"}, nil)
+}
+
+func GetCalculateCartTestCases() []CalculateCartTestCase {
+	return []CalculateCartTestCase{
+		{
+			// правило категории: 400 на подгузники, промокод 10% от оставшихся 3100
+			Name: "promo_code_after_category_rule",
+			Code: " sale ",
+			SetupMocks: func(m *MockCalculateCart) {
+				expectCart(m, lines())
+				m.RepoMock.EXPECT().GetPromoCodeByCode(m.Ctx, "SALE").Return(salePromo(), nil)
+				m.RepoMock.EXPECT().CountUsages(m.Ctx, "sale", UserID).Return(int64(0), nil)
+				expectRules(m)
+			},
+			ExpectedSubtotal: 3500,
+			ExpectedDiscount: 710,
+			ExpectedTotal:    2790,
+		},
+		{
+			Name: "expired_rule_skipped",
+			SetupMocks: func(m *MockCalculateCart) {
+				expectCart(m, lines())
+				expectRules(m)
+			},
+			ExpectedSubtotal: 3500,
+			ExpectedDiscount: 400,
+			ExpectedTotal:    3100,
+		},
+		{
+			Name: "promo_code_not_found",
+			Code: "missing",
+			SetupMocks: func(m *MockCalculateCart) {
+				expectCart(m, lines())
+				m.RepoMock.EXPECT().GetPromoCodeByCode(m.Ctx, "MISSING").Return(nil, nil)
+			},
+			ExpectedError: discount_constant.ErrPromoCodeNotFound,
+		},
+		{
+			Name: "expired_promo_code",
+			Code: "OLD",
+			SetupMocks: func(m *MockCalculateCart) {
+				yesterday := time.Now().Add(-24 * time.Hour)
+				expectCart(m, lines())
+				m.RepoMock.EXPECT().GetPromoCodeByCode(m.Ctx, "OLD").Return(&discount_entity.PromoCode{
+					ID: "old", Code: "OLD", Type: discount_entity.ValueTypeFixed, Value: 100, EndsAt: &yesterday, IsActive: true,
+				}, nil)
+			},
+			ExpectedError: discount_constant.ErrPromoCodeInactive,
+		},
+		{
+			Name: "exhausted_promo_code",
+			Code: "LIMITED",
+			SetupMocks: func(m *MockCalculateCart) {
+				promo := limitedPromo()
+				promo.UsedCount = 1
+				expectCart(m, lines())
+				m.RepoMock.EXPECT().GetPromoCodeByCode(m.Ctx, "LIMITED").Return(promo, nil)
+			},
+			ExpectedError: discount_constant.ErrPromoCodeExhausted,
+		},
+		{
+			Name: "per_user_limit_reached",
+			Code: "SALE",
+			SetupMocks: func(m *MockCalculateCart) {
+				expectCart(m, lines())
+				m.RepoMock.EXPECT().GetPromoCodeByCode(m.Ctx, "SALE").Return(salePromo(), nil)
+				m.RepoMock.EXPECT().CountUsages(m.Ctx, "sale", UserID).Return(int64(1), nil)
+			},
+			ExpectedError: discount_constant.ErrPromoCodeUsedUp,
+		},
+		{
+			Name: "below_min_order_amount",
+			Code: "SALE",
+			SetupMocks: func(m *MockCalculateCart) {
+				expectCart(m, lines()[1:])
+				m.RepoMock.EXPECT().GetPromoCodeByCode(m.Ctx, "SALE").Return(salePromo(), nil)
+				m.RepoMock.EXPECT().CountUsages(m.Ctx, "sale", UserID).Return(int64(0), nil)
+				expectRules(m)
+			},
+			ExpectedError: discount_constant.ErrMinOrderAmount,
+		},
+		{
+			Name: "empty_cart",
+			SetupMocks: func(m *MockCalculateCart) {
+				expectCart(m, nil)
+			},
+			ExpectedError: discount_constant.ErrCartEmpty,
+		},
+		{
+			Name: "no_session_user",
+			SetupMocks: func(m *MockCalculateCart) {
+				m.SessionRepoMock.EXPECT().GetSessionInfo(m.Ctx).Return(nil, errors.New("session not found"))
+			},
+			ExpectedError: discount_constant.ErrSessionUserNotFound,
+		},
+	}
+}
@@ -0,0 +1,91 @@
+package discount_testcases
+
+import (
+	"context"
+
+	discount_entity "github.com/Fi44er/sdmed/internal/module/discount/entity"
+	discount_constant "github.com/Fi44er/sdmed/internal/module/discount/pkg"
+	"github.com/Fi44er/sdmed/internal/module/discount/usecase/discount/mock"
+	uow_mock "github.com/Fi44er/sdmed/pkg/postgres/uow/mock"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+type MockRedeem struct {
+	Ctrl     *gomock.Controller
+	Ctx      context.Context
+	RepoMock *mock.MockIDiscountRepository
+	UowMock  *uow_mock.MockUow
+	T        assert.TestingT
+}
+
+type RedeemTestCase struct {
+	Name          string
+	PromoCodeID   string
+	UserID        string
+	OrderID       string
+	SetupMocks    func(m *MockRedeem)
+	ExpectedError error
+}
+
+func expectTx(ctx context.Context, uowMock *uow_mock.MockUow, repo any) {
+	uowMock.EXPECT().
+		Do(ctx, gomock.Any()).
+		DoAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+			return fn(ctx)
+		})
+	uowMock.EXPECT().GetRepository(ctx, "discount").Return(repo, nil)
+}
+
+func GetRedeemTestCases() []RedeemTestCase {
+	return []RedeemTestCase{
+		{
+			Name:        "usage_recorded",
+			PromoCodeID: "sale",
+			UserID:      UserID,
+			OrderID:     "order-1",
+			SetupMocks: func(m *MockRedeem) {
+				expectTx(m.Ctx, m.UowMock, m.RepoMock)
+				m.RepoMock.EXPECT().GetPromoCodeByID(m.Ctx, "sale").Return(salePromo(), nil)
+				m.RepoMock.EXPECT().CountUsages(m.Ctx, "sale", UserID).Return(int64(0), nil)
+				m.RepoMock.EXPECT().IncrementUsedCount(m.Ctx, "sale").Return(true, nil)
+				m.RepoMock.EXPECT().AddUsage(m.Ctx, &discount_entity.Usage{PromoCodeID: "sale", UserID: UserID, OrderID: "order-1"}).Return(nil)
+			},
+		},
+		{
+			Name:        "per_user_limit_reached",
+			PromoCodeID: "sale",
+			UserID:      UserID,
+			OrderID:     "order-2",
+			SetupMocks: func(m *MockRedeem) {
+				expectTx(m.Ctx, m.UowMock, m.RepoMock)
+				m.RepoMock.EXPECT().GetPromoCodeByID(m.Ctx, "sale").Return(salePromo(), nil)
+				m.RepoMock.EXPECT().CountUsages(m.Ctx, "sale", UserID).Return(int64(1), nil)
+			},
+			ExpectedError: discount_constant.ErrPromoCodeUsedUp,
+		},
+		{
+			Name:        "limit_exhausted_during_checkout",
+			PromoCodeID: "limited",
+			UserID:      "other",
+			OrderID:     "order-4",
+			SetupMocks: func(m *MockRedeem) {
+				expectTx(m.Ctx, m.UowMock, m.RepoMock)
+				m.RepoMock.EXPECT().GetPromoCodeByID(m.Ctx, "limited").Return(limitedPromo(), nil)
+				m.RepoMock.EXPECT().IncrementUsedCount(m.Ctx, "limited").Return(false, nil)
+			},
+			ExpectedError: discount_constant.ErrPromoCodeExhausted,
+		},
+		{
+			Name:        "promo_code_deleted",
+			PromoCodeID: "sale",
+			UserID:      UserID,
+			OrderID:     "order-1",
+			SetupMocks: func(m *MockRedeem) {
+				expectTx(m.Ctx, m.UowMock, m.RepoMock)
+				m.RepoMock.EXPECT().GetPromoCodeByID(m.Ctx, "sale").Return(nil, nil)
+			},
+			ExpectedError: discount_constant.ErrPromoCodeNotFound,
+		},
+	}
+}
@@ -0,0 +1,51 @@
+package discount_testcases
+
+import (
+	"context"
+
+	discount_entity "github.com/Fi44er/sdmed/internal/module/discount/entity"
+	"github.com/Fi44er/sdmed/internal/module/discount/usecase/discount/mock"
+	uow_mock "github.com/Fi44er/sdmed/pkg/postgres/uow/mock"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+type MockRelease struct {
+	Ctrl     *gomock.Controller
+	Ctx      context.Context
+	RepoMock *mock.MockIDiscountRepository
+	UowMock  *uow_mock.MockUow
+	T        assert.TestingT
+}
+
+type ReleaseTestCase struct {
+	Name          string
+	OrderID       string
+	SetupMocks    func(m *MockRelease)
+	ExpectedError error
+}
+
+func GetReleaseTestCases() []ReleaseTestCase {
+	return []ReleaseTestCase{
+		{
+			Name:    "usage_returned",
+			OrderID: "order-1",
+			SetupMocks: func(m *MockRelease) {
+				expectTx(m.Ctx, m.UowMock, m.RepoMock)
+				m.RepoMock.EXPECT().GetUsageByOrderID(m.Ctx, "order-1").Return(&discount_entity.Usage{ID: "usage-1", PromoCodeID: "sale", UserID: UserID, OrderID: "order-1"}, nil)
+				gomock.InOrder(
+					m.RepoMock.EXPECT().DeleteUsage(m.Ctx, "usage-1").Return(nil),
+					m.RepoMock.EXPECT().DecrementUsedCount(m.Ctx, "sale").Return(nil),
+				)
+			},
+		},
+		{
+			Name:    "order_without_promo_code",
+			OrderID: "order-2",
+			SetupMocks: func(m *MockRelease) {
+				expectTx(m.Ctx, m.UowMock, m.RepoMock)
+				m.RepoMock.EXPECT().GetUsageByOrderID(m.Ctx, "order-2").Return(nil, nil)
+			},
+		},
+	}
+}
@@ -0,0 +1,418 @@
+package discount_usecase
+
+import (
+	"context"
+	"time"
+
+	discount_entity "github.com/Fi44er/sdmed/internal/module/discount/entity"
+	discount_constant "github.com/Fi44er/sdmed/internal/module/discount/pkg"
+	discount_usecase_contracts "github.com/Fi44er/sdmed/internal/module/discount/usecase/discount/contracts"
+	"github.com/Fi44er/sdmed/pkg/logger"
+	"github.com/Fi44er/sdmed/pkg/postgres/uow"
+	"github.com/google/uuid"
+)
+
+type IDiscountUsecase interface {
+	Calculate(ctx context.Context, userID, code string, lines []discount_entity.Line) (*discount_entity.Result, error)
+	CalculateCart(ctx context.Context, code string) (*discount_entity.Result, error)
+	Redeem(ctx context.Context, promoCodeID, userID, orderID string) error
+	Release(ctx context.Context, orderID string) error
+
+	GetPromoCodes(ctx context.Context) ([]discount_entity.PromoCode, error)
+	GetPromoCode(ctx context.Context, id string) (*discount_entity.PromoCode, error)
+	CreatePromoCode(ctx context.Context, promo *discount_entity.PromoCode) (*discount_entity.PromoCode, error)
+	UpdatePromoCode(ctx context.Context, promo *discount_entity.PromoCode) (*discount_entity.PromoCode, error)
+	DeletePromoCode(ctx context.Context, id string) error
+
+	GetRules(ctx context.Context) ([]discount_entity.Rule, error)
+	GetRule(ctx context.Context, id string) (*discount_entity.Rule, error)
+	CreateRule(ctx context.Context, rule *discount_entity.Rule) (*discount_entity.Rule, error)
+	UpdateRule(ctx context.Context, rule *discount_entity.Rule) (*discount_entity.Rule, error)
+	DeleteRule(ctx context.Context, id string) error
+}
+
+type DiscountUsecase struct {
+	repository        discount_usecase_contracts.IDiscountRepository
+	sessionRepository discount_usecase_contracts.ISessionRepository
+	cartUsecase       discount_usecase_contracts.ICartUsecaseAdapter
+	productUsecase    discount_usecase_contracts.IProductUsecaseAdapter
+	uow               uow.Uow
+	logger            *logger.Logger
+}
+
+func NewDiscountUsecase(
+	repository discount_usecase_contracts.IDiscountRepository,
+	sessionRepository discount_usecase_contracts.ISessionRepository,
+	cartUsecase discount_usecase_contracts.ICartUsecaseAdapter,
+	productUsecase discount_usecase_contracts.IProductUsecaseAdapter,
+	uow uow.Uow,
+	logger *logger.Logger,
+) IDiscountUsecase {
+	return &DiscountUsecase{
+		repository:        repository,
+		sessionRepository: sessionRepository,
+		cartUsecase:       cartUsecase,
+		productUsecase:    productUsecase,
+		uow:               uow,
+		logger:            logger,
+	}
+}
+
+// Calculate рассчитывает автоматические скидки и промокод code для позиций.
+// Пустой userID отключает проверку лимита применений на пользователя
+func (u *DiscountUsecase) Calculate(ctx context.Context, userID, code string, lines []discount_entity.Line) (*discount_entity.Result, error) {
+	now := time.Now()
+
+	promo, err := u.findPromoCode(ctx, userID, code, now)
+	if err != nil {
+		return nil, err
+	}
+	rules, err := u.activeRules(ctx, now)
+	if err != nil {
+		return nil, err
+	}
+	if err := u.fillCategories(ctx, lines); err != nil {
+		return nil, err
+	}
+
+	result := discount_entity.Calculate(lines, rules, promo)
+	if promo != nil && result.Subtotal < promo.MinOrderAmount {
+		return nil, discount_constant.ErrMinOrderAmount
+	}
+
+	return result, nil
+}
+
+// CalculateCart рассчитывает скидки на корзину пользователя сессии. Покрытие
+// сертификатом здесь не учитывается: при оплате сертификатом скидки
+// пересчитываются при оформлении заказа
+func (u *DiscountUsecase) CalculateCart(ctx context.Context, code string) (*discount_entity.Result, error) {
+	userID, err := u.getSessionUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	lines, err := u.cartUsecase.GetLines(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if len(lines) == 0 {
+		return nil, discount_constant.ErrCartEmpty
+	}
+
+	return u.Calculate(ctx, userID, code, lines)
+}
+
+// Redeem записывает применение промокода в заказе. Вызывается в транзакции оформления
+// заказа: при исчерпании лимита за время оформления заказ не создается
+func (u *DiscountUsecase) Redeem(ctx context.Context, promoCodeID, userID, orderID string) error {
+	u.logger.Infof("Redeeming promo code %s in order %s", promoCodeID, orderID)
+
+	return u.uow.Do(ctx, func(ctx context.Context) error {
+		repo, err := u.getRepository(ctx)
+		if err != nil {
+			return err
+		}
+
+		promo, err := repo.GetPromoCodeByID(ctx, promoCodeID)
+		if err != nil {
+			return err
+		}
+		if promo == nil {
+			return discount_constant.ErrPromoCodeNotFound
+		}
+		if err := u.checkUserLimit(ctx, repo, promo, userID); err != nil {
+			return err
+		}
+
+		incremented, err := repo.IncrementUsedCount(ctx, promoCodeID)
+		if err != nil {
+			return err
+		}
+		if !incremented {
+			return discount_constant.ErrPromoCodeExhausted
+		}
+
+		return repo.AddUsage(ctx, &discount_entity.Usage{
+			PromoCodeID: promoCodeID,
+			UserID:      userID,
+			OrderID:     orderID,
+		})
+	})
+}
+
+// Release возвращает применение промокода при отмене заказа
+func (u *DiscountUsecase) Release(ctx context.Context, orderID string) error {
+	return u.uow.Do(ctx, func(ctx context.Context) error {
+		repo, err := u.getRepository(ctx)
+		if err != nil {
+			return err
+		}
+
+		usage, err := repo.GetUsageByOrderID(ctx, orderID)
+		if err != nil {
+			return err
+		}
+		if usage == nil {
+			return nil
+		}
+		u.logger.Infof("Releasing promo code %s of order %s", usage.PromoCodeID, orderID)
+
+		if err := repo.DeleteUsage(ctx, usage.ID); err != nil {
+			return err
+		}
+		return repo.DecrementUsedCount(ctx, usage.PromoCodeID)
+	})
+}
+
+func (u *DiscountUsecase) GetPromoCodes(ctx context.Context) ([]discount_entity.PromoCode, error) {
+	return u.repository.GetPromoCodes(ctx)
+}
+
+func (u *DiscountUsecase) GetPromoCode(ctx context.Context, id string) (*discount_entity.PromoCode, error) {
+	if _, err := uuid.Parse(id); err != nil {
+		return nil, discount_constant.ErrPromoCodeNotFound
+	}
+
+	promo, err := u.repository.GetPromoCodeByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if promo == nil {
+		return nil, discount_constant.ErrPromoCodeNotFound
+	}
+
+	return promo, nil
+}
+
+func (u *DiscountUsecase) CreatePromoCode(ctx context.Context, promo *discount_entity.PromoCode) (*discount_entity.PromoCode, error) {
+	if err := u.validatePromoCode(ctx, promo); err != nil {
+		return nil, err
+	}
+
+	if err := u.repository.CreatePromoCode(ctx, promo); err != nil {
+		return nil, err
+	}
+
+	return promo, nil
+}
+
+func (u *DiscountUsecase) UpdatePromoCode(ctx context.Context, promo *discount_entity.PromoCode) (*discount_entity.PromoCode, error) {
+	if _, err := u.GetPromoCode(ctx, promo.ID); err != nil {
+		return nil, err
+	}
+	if err := u.validatePromoCode(ctx, promo); err != nil {
+		return nil, err
+	}
+
+	if err := u.repository.UpdatePromoCode(ctx, promo); err != nil {
+		return nil, err
+	}
+
+	return u.GetPromoCode(ctx, promo.ID)
+}
+
+// DeletePromoCode удаляет промокод вместе с историей применений. Оформленные
+// заказы хранят код и сумму скидки у себя
+func (u *DiscountUsecase) DeletePromoCode(ctx context.Context, id string) error {
+	if _, err := u.GetPromoCode(ctx, id); err != nil {
+		return err
+	}
+
+	return u.repository.DeletePromoCode(ctx, id)
+}
+
+func (u *DiscountUsecase) GetRules(ctx context.Context) ([]discount_entity.Rule, error) {
+	return u.repository.GetRules(ctx, false)
+}
+
+func (u *DiscountUsecase) GetRule(ctx context.Context, id string) (*discount_entity.Rule, error) {
+	if _, err := uuid.Parse(id); err != nil {
+		return nil, discount_constant.ErrRuleNotFound
+	}
+
+	rule, err := u.repository.GetRuleByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if rule == nil {
+		return nil, discount_constant.ErrRuleNotFound
+	}
+
+	return rule, nil
+}
+
+func (u *DiscountUsecase) CreateRule(ctx context.Context, rule *discount_entity.Rule) (*discount_entity.Rule, error) {
+	if err := validateRule(rule); err != nil {
+		return nil, err
+	}
+
+	if err := u.repository.CreateRule(ctx, rule); err != nil {
+		return nil, err
+	}
+
+	return rule, nil
+}
+
+func (u *DiscountUsecase) UpdateRule(ctx context.Context, rule *discount_entity.Rule) (*discount_entity.Rule, error) {
+	if _, err := u.GetRule(ctx, rule.ID); err != nil {
+		return nil, err
+	}
+	if err := validateRule(rule); err != nil {
+		return nil, err
+	}
+
+	if err := u.repository.UpdateRule(ctx, rule); err != nil {
+		return nil, err
+	}
+
+	return u.GetRule(ctx, rule.ID)
+}
+
+func (u *DiscountUsecase) DeleteRule(ctx context.Context, id string) error {
+	if _, err := u.GetRule(ctx, id); err != nil {
+		return err
+	}
+
+	return u.repository.DeleteRule(ctx, id)
+}
+
+func (u *DiscountUsecase) findPromoCode(ctx context.Context, userID, code string, now time.Time) (*discount_entity.PromoCode, error) {
+	code = discount_entity.NormalizeCode(code)
+	if code == "" {
+		return nil, nil
+	}
+
+	promo, err := u.repository.GetPromoCodeByCode(ctx, code)
+	if err != nil {
+		return nil, err
+	}
+	if promo == nil {
+		return nil, discount_constant.ErrPromoCodeNotFound
+	}
+	if !promo.IsValidAt(now) {
+		return nil, discount_constant.ErrPromoCodeInactive
+	}
+	if promo.IsExhausted() {
+		return nil, discount_constant.ErrPromoCodeExhausted
+	}
+	if err := u.checkUserLimit(ctx, u.repository, promo, userID); err != nil {
+		return nil, err
+	}
+
+	return promo, nil
+}
+
+func (u *DiscountUsecase) checkUserLimit(ctx context.Context, repo discount_usecase_contracts.IDiscountRepository, promo *discount_entity.PromoCode, userID string) error {
+	if userID == "" || promo.PerUserLimit <= 0 {
+		return nil
+	}
+
+	count, err := repo.CountUsages(ctx, promo.ID, userID)
+	if err != nil {
+		return err
+	}
+	if count >= int64(promo.PerUserLimit) {
+		return discount_constant.ErrPromoCodeUsedUp
+	}
+
+	return nil
+}
+
+func (u *DiscountUsecase) activeRules(ctx context.Context, now time.Time) ([]discount_entity.Rule, error) {
+	rules, err := u.repository.GetRules(ctx, true)
+	if err != nil {
+		return nil, err
+	}
+
+	active := rules[:0]
+	for i := range rules {
+		if rules[i].IsValidAt(now) {
+			active = append(active, rules[i])
+		}
+	}
+
+	return active, nil
+}
+
+func (u *DiscountUsecase) fillCategories(ctx context.Context, lines []discount_entity.Line) error {
+	productIDs := make([]string, len(lines))
+	for i := range lines {
+		productIDs[i] = lines[i].ProductID
+	}
+
+	categories, err := u.productUsecase.GetCategories(ctx, productIDs)
+	if err != nil {
+		return err
+	}
+	for i := range lines {
+		lines[i].CategoryID = categories[lines[i].ProductID]
+	}
+
+	return nil
+}
+
+func (u *DiscountUsecase) validatePromoCode(ctx context.Context, promo *discount_entity.PromoCode) error {
+	promo.Code = discount_entity.NormalizeCode(promo.Code)
+	if !promo.Type.IsValid() {
+		return discount_constant.ErrInvalidValueType
+	}
+	if promo.Type == discount_entity.ValueTypePercent && promo.Value > 100 {
+		return discount_constant.ErrInvalidValue
+	}
+	if promo.StartsAt != nil && promo.EndsAt != nil && !promo.StartsAt.Before(*promo.EndsAt) {
+		return discount_constant.ErrInvalidPeriod
+	}
+
+	existing, err := u.repository.GetPromoCodeByCode(ctx, promo.Code)
+	if err != nil {
+		return err
+	}
+	if existing != nil && existing.ID != promo.ID {
+		return discount_constant.ErrPromoCodeExists
+	}
+
+	return nil
+}
+
+func validateRule(rule *discount_entity.Rule) error {
+	switch rule.Type {
+	case discount_entity.RuleTypeCategory:
+		if rule.CategoryID == "" {
+			return discount_constant.ErrCategoryRequired
+		}
+		if rule.Percent <= 0 || rule.Percent > 100 {
+			return discount_constant.ErrInvalidValue
+		}
+	case discount_entity.RuleTypeNPlusOne:
+		if rule.BuyQuantity <= 0 || rule.FreeQuantity <= 0 {
+			return discount_constant.ErrInvalidNPlusOne
+		}
+	default:
+		return discount_constant.ErrInvalidRuleType
+	}
+	if rule.StartsAt != nil && rule.EndsAt != nil && !rule.StartsAt.Before(*rule.EndsAt) {
+		return discount_constant.ErrInvalidPeriod
+	}
+
+	return nil
+}
+
+func (u *DiscountUsecase) getSessionUserID(ctx context.Context) (string, error) {
+	sessionInfo, err := u.sessionRepository.GetSessionInfo(ctx)
+	if err != nil || sessionInfo.UserID == "" {
+		u.logger.Warnf("Discounts requested without session user: %v", err)
+		return "", discount_constant.ErrSessionUserNotFound
+	}
+
+	return sessionInfo.UserID, nil
+}
+
+func (u *DiscountUsecase) getRepository(ctx context.Context) (discount_usecase_contracts.IDiscountRepository, error) {
+	repo, err := u.uow.GetRepository(ctx, "discount")
+	if err != nil {
+		u.logger.Errorf("Failed to get repository: %v", err)
+		return nil, err
+	}
+	return repo.(discount_usecase_contracts.IDiscountRepository), nil
+}
@@ -0,0 +1,127 @@
+package discount_usecase_test
+
+import (
+	"context"
+	"testing"
+
+	discount_usecase "github.com/Fi44er/sdmed/internal/module/discount/usecase/discount"
+	"github.com/Fi44er/sdmed/internal/module/discount/usecase/discount/mock"
+	discount_testcases "github.com/Fi44er/sdmed/internal/module/discount/usecase/discount/testcases"
+	"github.com/Fi44er/sdmed/pkg/logger"
+	uow_mock "github.com/Fi44er/sdmed/pkg/postgres/uow/mock"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+)
+
+type DiscountUsecaseTestSuite struct {
+	suite.Suite
+	ctx    context.Context
+	logger *logger.Logger
+}
+
+func (s *DiscountUsecaseTestSuite) SetupSuite() {
+	s.ctx = context.Background()
+	s.logger = logger.NewLogger()
+}
+
+func TestDiscountUsecase(t *testing.T) {
+	suite.Run(t, new(DiscountUsecaseTestSuite))
+}
+
+func (s *DiscountUsecaseTestSuite) TestCalculateCart() {
+	tests := discount_testcases.GetCalculateCartTestCases()
+
+	for _, tc := range tests {
+		s.T().Run(tc.Name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockStruct := &discount_testcases.MockCalculateCart{
+				Ctrl:            ctrl,
+				Ctx:             s.ctx,
+				RepoMock:        mock.NewMockIDiscountRepository(ctrl),
+				SessionRepoMock: mock.NewMockISessionRepository(ctrl),
+				CartMock:        mock.NewMockICartUsecaseAdapter(ctrl),
+				ProductMock:     mock.NewMockIProductUsecaseAdapter(ctrl),
+				UowMock:         uow_mock.NewMockUow(ctrl),
+				T:               t,
+			}
+
+			usecase := discount_usecase.NewDiscountUsecase(mockStruct.RepoMock, mockStruct.SessionRepoMock, mockStruct.CartMock, mockStruct.ProductMock, mockStruct.UowMock, s.logger)
+
+			tc.SetupMocks(mockStruct)
+			result, err := usecase.CalculateCart(s.ctx, tc.Code)
+
+			if tc.ExpectedError != nil {
+				assert.ErrorIs(t, err, tc.ExpectedError)
+				assert.Nil(t, result)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tc.ExpectedSubtotal, result.Subtotal)
+				assert.Equal(t, tc.ExpectedDiscount, result.Discount)
+				assert.Equal(t, tc.ExpectedTotal, result.Total)
+			}
+		})
+	}
+}
+
+func (s *DiscountUsecaseTestSuite) TestRedeem() {
+	tests := discount_testcases.GetRedeemTestCases()
+
+	for _, tc := range tests {
+		s.T().Run(tc.Name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockStruct := &discount_testcases.MockRedeem{
+				Ctrl:     ctrl,
+				Ctx:      s.ctx,
+				RepoMock: mock.NewMockIDiscountRepository(ctrl),
+				UowMock:  uow_mock.NewMockUow(ctrl),
+				T:        t,
+			}
+
+			usecase := discount_usecase.NewDiscountUsecase(mockStruct.RepoMock, nil, nil, nil, mockStruct.UowMock, s.logger)
+
+			tc.SetupMocks(mockStruct)
+			err := usecase.Redeem(s.ctx, tc.PromoCodeID, tc.UserID, tc.OrderID)
+
+			if tc.ExpectedError != nil {
+				assert.ErrorIs(t, err, tc.ExpectedError)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func (s *DiscountUsecaseTestSuite) TestRelease() {
+	tests := discount_testcases.GetReleaseTestCases()
+
+	for _, tc := range tests {
+		s.T().Run(tc.Name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockStruct := &discount_testcases.MockRelease{
+				Ctrl:     ctrl,
+				Ctx:      s.ctx,
+				RepoMock: mock.NewMockIDiscountRepository(ctrl),
+				UowMock:  uow_mock.NewMockUow(ctrl),
+				T:        t,
+			}
+
+			usecase := discount_usecase.NewDiscountUsecase(mockStruct.RepoMock, nil, nil, nil, mockStruct.UowMock, s.logger)
+
+			tc.SetupMocks(mockStruct)
+			err := usecase.Release(s.ctx, tc.OrderID)
+
+			if tc.ExpectedError != nil {
+				assert.ErrorIs(t, err, tc.ExpectedError)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
@@ -57,7 +57,8 @@ func (s *LocalFileStorage) Upload(name *string, data []byte) error {
 	}
 
 	kind, _ := filetype.Match(data)
-	if os.WriteFile(outputPath+"."+kind.Extension, data, 0644) != nil {
+	*name = *name + "." + kind.Extension
+	if err := os.WriteFile(s.config.FileDir+*name, data, 0644); err != nil {
 		s.logger.Errorf("failed to write file: %s", outputPath)
 		return err
 	}
@@ -0,0 +1,60 @@
+package matcher_http
+
+import (
+	"math"
+
+	matcher_dto "github.com/Fi44er/sdmed/internal/module/matcher/dto"
+	matcher_entity "github.com/Fi44er/sdmed/internal/module/matcher/entity"
+	dto_utils "github.com/Fi44er/sdmed/pkg/utils/dto"
+)
+
+type Converter struct{}
+
+func (c *Converter) ToGenerateParams(dto *matcher_dto.GenerateSuggestionsRequest) *matcher_entity.GenerateParams {
+	return &matcher_entity.GenerateParams{
+		ProductIDs:     dto.ProductIDs,
+		MinScore:       dto.MinScore,
+		MaxSuggestions: dto.MaxSuggestions,
+	}
+}
+
+func (c *Converter) ToFilterEntity(params *matcher_dto.SuggestionQueryParams) *matcher_entity.SuggestionFilter {
+	return &matcher_entity.SuggestionFilter{
+		Status:    matcher_entity.SuggestionStatus(params.Status),
+		ProductID: params.ProductID,
+	}
+}
+
+func (c *Converter) ToSuggestionResponse(entity *matcher_entity.Suggestion) *matcher_dto.SuggestionResponse {
+	return &matcher_dto.SuggestionResponse{
+		ID:         entity.ID,
+		ProductID:  entity.ProductID,
+		TRUCodeID:  entity.TRUCodeID,
+		Code:       entity.Code,
+		CodeName:   entity.CodeName,
+		Score:      entity.Score,
+		Status:     string(entity.Status),
+		CreatedAt:  entity.CreatedAt,
+		ReviewedAt: entity.ReviewedAt,
+	}
+}
+
+func (c *Converter) ToSuggestionResponses(entities []matcher_entity.Suggestion) []matcher_dto.SuggestionResponse {
+	result := make([]matcher_dto.SuggestionResponse, len(entities))
+	for i := range entities {
+		result[i] = *c.ToSuggestionResponse(&entities[i])
+	}
+	return result
+}
+
+func (c *Converter) ToSuggestionListResponse(suggestions []matcher_entity.Suggestion, count int64, page, pageSize int) *dto_utils.ListResponse[matcher_dto.SuggestionResponse] {
+	return &dto_utils.ListResponse[matcher_dto.SuggestionResponse]{
+		Data: c.ToSuggestionResponses(suggestions),
+		Pagination: dto_utils.PaginationInfo{
+			Total:    count,
+			Page:     page,
+			PageSize: pageSize,
+			Pages:    int(math.Ceil(float64(count) / float64(pageSize))),
+		},
+	}
+}
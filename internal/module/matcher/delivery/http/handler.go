@@ -0,0 +1,154 @@
+package matcher_http
+
+import (
+	"context"
+
+	matcher_dto "github.com/Fi44er/sdmed/internal/module/matcher/dto"
+	matcher_entity "github.com/Fi44er/sdmed/internal/module/matcher/entity"
+	"github.com/Fi44er/sdmed/pkg/logger"
+	_ "github.com/Fi44er/sdmed/pkg/response"
+	"github.com/Fi44er/sdmed/pkg/utils"
+	"github.com/go-playground/validator/v10"
+	"github.com/gofiber/fiber/v2"
+)
+
+type IMatcherUsecase interface {
+	Generate(ctx context.Context, params *matcher_entity.GenerateParams) ([]matcher_entity.Suggestion, error)
+	GetAll(ctx context.Context, filter *matcher_entity.SuggestionFilter, page, pageSize int) ([]matcher_entity.Suggestion, int64, error)
+	Accept(ctx context.Context, id string) error
+	Reject(ctx context.Context, id string) error
+}
+
+type MatcherHandler struct {
+	usecase IMatcherUsecase
+
+	validator *validator.Validate
+	logger    *logger.Logger
+	converter *Converter
+}
+
+func NewMatcherHandler(
+	usecase IMatcherUsecase,
+	validator *validator.Validate,
+	logger *logger.Logger,
+) *MatcherHandler {
+	return &MatcherHandler{
+		usecase:   usecase,
+		validator: validator,
+		logger:    logger,
+		converter: &Converter{},
+	}
+}
+
+// Generate godoc
+// @Summary Generate TRU code suggestions
+// @Description Match products against the TRU catalogue by name, category and characteristics and queue the best codes for review
+// @Tags tru-suggestions
+// @Accept json
+// @Produce json
+// @Param request body matcher_dto.GenerateSuggestionsRequest true "Products to match"
+// @Success 201 {object} response.ResponseData{data=[]matcher_dto.SuggestionResponse} "Created"
+// @Failure 400 {object} response.Response "Invalid request"
+// @Failure 500 {object} response.Response "Error"
+// @Router /tru-suggestions/generate [post]
+func (h *MatcherHandler) Generate(ctx *fiber.Ctx) error {
+	dto := new(matcher_dto.GenerateSuggestionsRequest)
+
+	params, err := utils.ParseAndValidate(ctx, dto, h.validator, h.converter.ToGenerateParams, h.logger)
+	if err != nil {
+		return ctx.Status(400).JSON(fiber.Map{
+			"status":  "fail",
+			"message": err.Error(),
+		})
+	}
+
+	suggestions, err := h.usecase.Generate(ctx.Context(), params)
+	if err != nil {
+		return err
+	}
+
+	return ctx.Status(201).JSON(fiber.Map{
+		"status": "success",
+		"data":   h.converter.ToSuggestionResponses(suggestions),
+	})
+}
+
+// GetAll godoc
+// @Summary Get TRU code suggestions
+// @Description Review queue of suggested TRU codes ordered by confidence
+// @Tags tru-suggestions
+// @Accept json
+// @Produce json
+// @Param status query string false "Filter by status (pending, accepted, rejected)"
+// @Param product_id query string false "Filter by product"
+// @Param page query int false "Page number (default 1)"
+// @Param page_size query int false "Items per page (default 20)"
+// @Success 200 {object} response.ResponseData{data=[]matcher_dto.SuggestionResponse} "OK"
+// @Failure 500 {object} response.Response "Error"
+// @Router /tru-suggestions [get]
+func (h *MatcherHandler) GetAll(ctx *fiber.Ctx) error {
+	params := &matcher_dto.SuggestionQueryParams{
+		Status:   string(matcher_entity.SuggestionStatusPending),
+		Page:     1,
+		PageSize: 20,
+	}
+
+	if err := ctx.QueryParser(params); err != nil {
+		h.logger.Errorf("Failed to parse query params: %v", err)
+		return fiber.NewError(fiber.StatusBadRequest, "Invalid query parameters")
+	}
+
+	suggestions, count, err := h.usecase.GetAll(ctx.Context(), h.converter.ToFilterEntity(params), params.Page, params.PageSize)
+	if err != nil {
+		return err
+	}
+
+	return ctx.Status(200).JSON(fiber.Map{
+		"status": "success",
+		"data":   h.converter.ToSuggestionListResponse(suggestions, count, params.Page, params.PageSize),
+	})
+}
+
+// Accept godoc
+// @Summary Accept a TRU code suggestion
+// @Description Accept the suggestion and link the TRU code to the product
+// @Tags tru-suggestions
+// @Produce json
+// @Param id path string true "Suggestion ID"
+// @Success 200 {object} response.Response "OK"
+// @Failure 404 {object} response.Response "Not found"
+// @Failure 409 {object} response.Response "Already reviewed"
+// @Failure 500 {object} response.Response "Error"
+// @Router /tru-suggestions/{id}/accept [post]
+func (h *MatcherHandler) Accept(ctx *fiber.Ctx) error {
+	if err := h.usecase.Accept(ctx.Context(), ctx.Params("id")); err != nil {
+		return err
+	}
+
+	return ctx.Status(200).JSON(fiber.Map{
+		"status":  "success",
+		"message": "suggestion accepted",
+	})
+}
+
+// Reject godoc
+// @Summary Reject a TRU code suggestion
+// @Description Reject the suggestion. Rejected codes are not suggested for the product again
+// @Tags tru-suggestions
+// @Produce json
+// @Param id path string true "Suggestion ID"
+// @Success 200 {object} response.Response "OK"
+// @Failure 404 {object} response.Response "Not found"
+// @Failure 409 {object} response.Response "Already reviewed"
+// @Failure 500 {object} response.Response "Error"
+// @Router /tru-suggestions/{id}/reject [post]
+func (h *MatcherHandler) Reject(ctx *fiber.Ctx) error {
+	if err := h.usecase.Reject(ctx.Context(), ctx.Params("id")); err != nil {
+		return err
+	}
+
+	return ctx.Status(200).JSON(fiber.Map{
+		"status":  "success",
+		"message": "suggestion rejected",
+	})
+}
@@ -0,0 +1,14 @@
+package matcher_http
+
+import (
+	"github.com/Fi44er/sdmed/internal/middlewares"
+	"github.com/gofiber/fiber/v2"
+)
+
+func (h *MatcherHandler) RegisterRoutes(router fiber.Router) {
+	suggestions := router.Group("/tru-suggestions", middlewares.Authorize("tru", "update"))
+	suggestions.Get("/", h.GetAll)
+	suggestions.Post("/generate", h.Generate)
+	suggestions.Post("/:id/accept", h.Accept)
+	suggestions.Post("/:id/reject", h.Reject)
+}
@@ -0,0 +1,28 @@
+package matcher_dto
+
+import "time"
+
+type GenerateSuggestionsRequest struct {
+	ProductIDs     []string `json:"product_ids" validate:"required,min=1,dive,uuid"`
+	MinScore       float64  `json:"min_score" validate:"omitempty,gte=0,lte=1"`
+	MaxSuggestions int      `json:"max_suggestions" validate:"omitempty,gte=1,lte=50"`
+}
+
+type SuggestionQueryParams struct {
+	Status    string `query:"status"`
+	ProductID string `query:"product_id"`
+	Page      int    `query:"page"`
+	PageSize  int    `query:"page_size"`
+}
+
+type SuggestionResponse struct {
+	ID         string     `json:"id"`
+	ProductID  string     `json:"product_id"`
+	TRUCodeID  string     `json:"tru_code_id"`
+	Code       string     `json:"code"`
+	CodeName   string     `json:"code_name"`
+	Score      float64    `json:"score"`
+	Status     string     `json:"status"`
+	CreatedAt  time.Time  `json:"created_at"`
+	ReviewedAt *time.Time `json:"reviewed_at,omitempty"`
+}
@@ -0,0 +1,52 @@
+package matcher_entity
+
+import "time"
+
+type SuggestionStatus string
+
+const (
+	SuggestionStatusPending  SuggestionStatus = "pending"
+	SuggestionStatusAccepted SuggestionStatus = "accepted"
+	SuggestionStatusRejected SuggestionStatus = "rejected"
+)
+
+// Suggestion - предложенная привязка товара к коду КТРУ с оценкой уверенности от 0 до 1
+type Suggestion struct {
+	ID         string
+	ProductID  string
+	TRUCodeID  string
+	Code       string
+	CodeName   string
+	Score      float64
+	Status     SuggestionStatus
+	CreatedAt  time.Time
+	ReviewedAt *time.Time
+}
+
+type SuggestionFilter struct {
+	Status    SuggestionStatus
+	ProductID string
+	Offset    int
+	Limit     int
+}
+
+// MatchProduct - данные товара, по которым подбирается код
+type MatchProduct struct {
+	ID           string
+	Name         string
+	CategoryName string
+	Values       []string
+}
+
+type MatchCode struct {
+	ID   string
+	Code string
+	Name string
+}
+
+// GenerateParams - параметры подбора кодов для товаров
+type GenerateParams struct {
+	ProductIDs     []string
+	MinScore       float64
+	MaxSuggestions int
+}
@@ -0,0 +1,67 @@
+package matcher_adapters
+
+import (
+	"context"
+
+	matcher_entity "github.com/Fi44er/sdmed/internal/module/matcher/entity"
+	category_usecase "github.com/Fi44er/sdmed/internal/module/product/usecase/category"
+	product_usecase "github.com/Fi44er/sdmed/internal/module/product/usecase/product"
+)
+
+type IProductUsecaseAdapter interface {
+	GetByIDs(ctx context.Context, ids []string) ([]matcher_entity.MatchProduct, error)
+}
+
+type ProductUsecaseAdapter struct {
+	productUsecase  product_usecase.IProductUsecase
+	categoryUsecase category_usecase.ICategoryUsecase
+}
+
+func NewProductUsecaseAdapter(
+	productUsecase product_usecase.IProductUsecase,
+	categoryUsecase category_usecase.ICategoryUsecase,
+) IProductUsecaseAdapter {
+	return &ProductUsecaseAdapter{
+		productUsecase:  productUsecase,
+		categoryUsecase: categoryUsecase,
+	}
+}
+
+func (a *ProductUsecaseAdapter) GetByIDs(ctx context.Context, ids []string) ([]matcher_entity.MatchProduct, error) {
+	products, err := a.productUsecase.GetByIDs(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+
+	categoryNames := make(map[string]string)
+	result := make([]matcher_entity.MatchProduct, len(products))
+	for i := range products {
+		matchProduct := matcher_entity.MatchProduct{
+			ID:   products[i].ID,
+			Name: products[i].Name,
+		}
+
+		if categoryID := products[i].CategoryID; categoryID != nil {
+			name, ok := categoryNames[*categoryID]
+			if !ok {
+				category, err := a.categoryUsecase.GetByID(ctx, *categoryID)
+				if err != nil {
+					return nil, err
+				}
+				name = category.Name
+				categoryNames[*categoryID] = name
+			}
+			matchProduct.CategoryName = name
+		}
+
+		for j := range products[i].CharValues {
+			if value := products[i].CharValues[j].GetStringValue(); value != "" {
+				matchProduct.Values = append(matchProduct.Values, value)
+			}
+		}
+
+		result[i] = matchProduct
+	}
+
+	return result, nil
+}
@@ -0,0 +1,79 @@
+package matcher_adapters
+
+import (
+	"context"
+
+	matcher_entity "github.com/Fi44er/sdmed/internal/module/matcher/entity"
+	matcher_constant "github.com/Fi44er/sdmed/internal/module/matcher/pkg"
+	tru_entity "github.com/Fi44er/sdmed/internal/module/tru/entity"
+	tru_usecase "github.com/Fi44er/sdmed/internal/module/tru/usecase/tru"
+)
+
+type ITRUUsecaseAdapter interface {
+	GetCodes(ctx context.Context) ([]matcher_entity.MatchCode, error)
+	GetProductCodeIDs(ctx context.Context, productID string) ([]string, error)
+	LinkCode(ctx context.Context, productID, truCodeID string) error
+}
+
+type TRUUsecaseAdapter struct {
+	truUsecase tru_usecase.ITRUUsecase
+}
+
+func NewTRUUsecaseAdapter(truUsecase tru_usecase.ITRUUsecase) ITRUUsecaseAdapter {
+	return &TRUUsecaseAdapter{
+		truUsecase: truUsecase,
+	}
+}
+
+// GetCodes выгружает весь справочник кодов постранично
+func (a *TRUUsecaseAdapter) GetCodes(ctx context.Context) ([]matcher_entity.MatchCode, error) {
+	var result []matcher_entity.MatchCode
+	for page := 1; ; page++ {
+		codes, total, err := a.truUsecase.GetAll(ctx, &tru_entity.TRUCodeFilter{}, page, matcher_constant.CodesPageSize)
+		if err != nil {
+			return nil, err
+		}
+
+		for i := range codes {
+			result = append(result, matcher_entity.MatchCode{
+				ID:   codes[i].ID,
+				Code: codes[i].Code,
+				Name: codes[i].Name,
+			})
+		}
+
+		if len(codes) == 0 || int64(len(result)) >= total {
+			return result, nil
+		}
+	}
+}
+
+func (a *TRUUsecaseAdapter) GetProductCodeIDs(ctx context.Context, productID string) ([]string, error) {
+	codes, err := a.truUsecase.GetProductCodes(ctx, productID)
+	if err != nil {
+		return nil, err
+	}
+
+	ids := make([]string, len(codes))
+	for i := range codes {
+		ids[i] = codes[i].ID
+	}
+
+	return ids, nil
+}
+
+// LinkCode добавляет код к уже привязанным кодам товара
+func (a *TRUUsecaseAdapter) LinkCode(ctx context.Context, productID, truCodeID string) error {
+	ids, err := a.GetProductCodeIDs(ctx, productID)
+	if err != nil {
+		return err
+	}
+
+	for _, id := range ids {
+		if id == truCodeID {
+			return nil
+		}
+	}
+
+	return a.truUsecase.SetProductCodes(ctx, productID, append(ids, truCodeID))
+}
@@ -0,0 +1,19 @@
+package matcher_model
+
+import "time"
+
+type TRUSuggestion struct {
+	ID         string     `gorm:"primaryKey;type:uuid;default:uuid_generate_v4();"`
+	ProductID  string     `gorm:"type:uuid;not null;uniqueIndex:idx_suggestion_product_code"`
+	TRUCodeID  string     `gorm:"type:uuid;not null;uniqueIndex:idx_suggestion_product_code;index"`
+	Code       string     `gorm:"type:varchar(30);not null"`
+	CodeName   string     `gorm:"type:varchar(1000);not null;default:''"`
+	Score      float64    `gorm:"type:float;not null;index"`
+	Status     string     `gorm:"type:varchar(20);not null;default:'pending';index"`
+	CreatedAt  time.Time  `gorm:"not null;default:now()"`
+	ReviewedAt *time.Time `gorm:""`
+}
+
+func (TRUSuggestion) TableName() string {
+	return "matcher_module.tru_suggestions"
+}
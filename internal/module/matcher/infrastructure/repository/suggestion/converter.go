@@ -0,0 +1,35 @@
+package suggestion_repository
+
+import (
+	matcher_entity "github.com/Fi44er/sdmed/internal/module/matcher/entity"
+	matcher_model "github.com/Fi44er/sdmed/internal/module/matcher/infrastructure/repository/model"
+)
+
+type Converter struct{}
+
+func (c *Converter) ToModel(entity *matcher_entity.Suggestion) *matcher_model.TRUSuggestion {
+	return &matcher_model.TRUSuggestion{
+		ID:         entity.ID,
+		ProductID:  entity.ProductID,
+		TRUCodeID:  entity.TRUCodeID,
+		Code:       entity.Code,
+		CodeName:   entity.CodeName,
+		Score:      entity.Score,
+		Status:     string(entity.Status),
+		ReviewedAt: entity.ReviewedAt,
+	}
+}
+
+func (c *Converter) ToEntity(model *matcher_model.TRUSuggestion) *matcher_entity.Suggestion {
+	return &matcher_entity.Suggestion{
+		ID:         model.ID,
+		ProductID:  model.ProductID,
+		TRUCodeID:  model.TRUCodeID,
+		Code:       model.Code,
+		CodeName:   model.CodeName,
+		Score:      model.Score,
+		Status:     matcher_entity.SuggestionStatus(model.Status),
+		CreatedAt:  model.CreatedAt,
+		ReviewedAt: model.ReviewedAt,
+	}
+}
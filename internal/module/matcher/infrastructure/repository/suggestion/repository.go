@@ -0,0 +1,175 @@
+package suggestion_repository
+
+import (
+	"context"
+	"time"
+
+	matcher_entity "github.com/Fi44er/sdmed/internal/module/matcher/entity"
+	matcher_model "github.com/Fi44er/sdmed/internal/module/matcher/infrastructure/repository/model"
+	"github.com/Fi44er/sdmed/pkg/logger"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type ISuggestionRepository interface {
+	CreateMany(ctx context.Context, suggestions []matcher_entity.Suggestion) error
+	GetByID(ctx context.Context, id string) (*matcher_entity.Suggestion, error)
+	GetAll(ctx context.Context, filter *matcher_entity.SuggestionFilter) ([]matcher_entity.Suggestion, error)
+	Count(ctx context.Context, filter *matcher_entity.SuggestionFilter) (int64, error)
+	GetSuggestedCodeIDs(ctx context.Context, productID string) ([]string, error)
+	UpdateStatus(ctx context.Context, id string, status matcher_entity.SuggestionStatus, reviewedAt time.Time) error
+	MoveCode(ctx context.Context, fromCodeID string, to *matcher_entity.MatchCode) error
+}
+
+type SuggestionRepository struct {
+	logger    *logger.Logger
+	db        *gorm.DB
+	converter *Converter
+}
+
+func NewSuggestionRepository(logger *logger.Logger, db *gorm.DB) ISuggestionRepository {
+	return &SuggestionRepository{
+		logger:    logger,
+		db:        db,
+		converter: &Converter{},
+	}
+}
+
+// CreateMany сохраняет подсказки, пропуская уже предложенные пары товар-код
+func (r *SuggestionRepository) CreateMany(ctx context.Context, suggestions []matcher_entity.Suggestion) error {
+	if len(suggestions) == 0 {
+		return nil
+	}
+	r.logger.Infof("Creating %d tru code suggestions", len(suggestions))
+
+	suggestionModels := make([]matcher_model.TRUSuggestion, len(suggestions))
+	for i := range suggestions {
+		suggestionModels[i] = *r.converter.ToModel(&suggestions[i])
+	}
+
+	err := r.db.WithContext(ctx).Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "product_id"}, {Name: "tru_code_id"}},
+		DoNothing: true,
+	}).Create(&suggestionModels).Error
+	if err != nil {
+		r.logger.Errorf("Failed to create suggestions: %v", err)
+		return err
+	}
+
+	for i := range suggestions {
+		suggestions[i].ID = suggestionModels[i].ID
+		suggestions[i].CreatedAt = suggestionModels[i].CreatedAt
+	}
+
+	return nil
+}
+
+func (r *SuggestionRepository) GetByID(ctx context.Context, id string) (*matcher_entity.Suggestion, error) {
+	r.logger.Debugf("Getting suggestion: %s", id)
+
+	var suggestionModel matcher_model.TRUSuggestion
+	if err := r.db.WithContext(ctx).First(&suggestionModel, "id = ?", id).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, nil
+		}
+		r.logger.Errorf("Failed to get suggestion %s: %v", id, err)
+		return nil, err
+	}
+
+	return r.converter.ToEntity(&suggestionModel), nil
+}
+
+func (r *SuggestionRepository) GetAll(ctx context.Context, filter *matcher_entity.SuggestionFilter) ([]matcher_entity.Suggestion, error) {
+	r.logger.Debugf("Getting suggestions (offset: %d, limit: %d)", filter.Offset, filter.Limit)
+
+	var suggestionModels []matcher_model.TRUSuggestion
+	query := r.applyFilter(r.db.WithContext(ctx).Model(&matcher_model.TRUSuggestion{}), filter)
+	if err := query.Order("score DESC, created_at ASC").Offset(filter.Offset).Limit(filter.Limit).Find(&suggestionModels).Error; err != nil {
+		r.logger.Errorf("Failed to get suggestions: %v", err)
+		return nil, err
+	}
+
+	suggestions := make([]matcher_entity.Suggestion, len(suggestionModels))
+	for i := range suggestionModels {
+		suggestions[i] = *r.converter.ToEntity(&suggestionModels[i])
+	}
+
+	return suggestions, nil
+}
+
+func (r *SuggestionRepository) Count(ctx context.Context, filter *matcher_entity.SuggestionFilter) (int64, error) {
+	var count int64
+	query := r.applyFilter(r.db.WithContext(ctx).Model(&matcher_model.TRUSuggestion{}), filter)
+	if err := query.Count(&count).Error; err != nil {
+		r.logger.Errorf("Failed to count suggestions: %v", err)
+		return 0, err
+	}
+
+	return count, nil
+}
+
+// GetSuggestedCodeIDs возвращает коды, которые уже предлагались товару в любом статусе
+func (r *SuggestionRepository) GetSuggestedCodeIDs(ctx context.Context, productID string) ([]string, error) {
+	var codeIDs []string
+	err := r.db.WithContext(ctx).
+		Model(&matcher_model.TRUSuggestion{}).
+		Where("product_id = ?", productID).
+		Pluck("tru_code_id", &codeIDs).Error
+	if err != nil {
+		r.logger.Errorf("Failed to get suggested codes for product %s: %v", productID, err)
+		return nil, err
+	}
+
+	return codeIDs, nil
+}
+
+func (r *SuggestionRepository) UpdateStatus(ctx context.Context, id string, status matcher_entity.SuggestionStatus, reviewedAt time.Time) error {
+	r.logger.Infof("Setting suggestion %s status to %s", id, status)
+
+	err := r.db.WithContext(ctx).
+		Model(&matcher_model.TRUSuggestion{}).
+		Where("id = ?", id).
+		Updates(map[string]any{"status": string(status), "reviewed_at": reviewedAt}).Error
+	if err != nil {
+		r.logger.Errorf("Failed to update suggestion %s: %v", id, err)
+		return err
+	}
+
+	return nil
+}
+
+// MoveCode переводит подсказки на другой код. Если товару уже предлагался целевой код,
+// подсказка со старым кодом удаляется, чтобы не нарушить уникальность пары товар-код.
+func (r *SuggestionRepository) MoveCode(ctx context.Context, fromCodeID string, to *matcher_entity.MatchCode) error {
+	r.logger.Infof("Moving suggestions from tru code %s to %s", fromCodeID, to.ID)
+
+	err := r.db.WithContext(ctx).
+		Where("tru_code_id = ?", fromCodeID).
+		Where("product_id IN (?)", r.db.Model(&matcher_model.TRUSuggestion{}).Select("product_id").Where("tru_code_id = ?", to.ID)).
+		Delete(&matcher_model.TRUSuggestion{}).Error
+	if err != nil {
+		r.logger.Errorf("Failed to delete duplicate suggestions of tru code %s: %v", fromCodeID, err)
+		return err
+	}
+
+	err = r.db.WithContext(ctx).
+		Model(&matcher_model.TRUSuggestion{}).
+		Where("tru_code_id = ?", fromCodeID).
+		Updates(map[string]any{"tru_code_id": to.ID, "code": to.Code, "code_name": to.Name}).Error
+	if err != nil {
+		r.logger.Errorf("Failed to move suggestions to tru code %s: %v", to.ID, err)
+		return err
+	}
+
+	return nil
+}
+
+func (r *SuggestionRepository) applyFilter(query *gorm.DB, filter *matcher_entity.SuggestionFilter) *gorm.DB {
+	if filter.Status != "" {
+		query = query.Where("status = ?", string(filter.Status))
+	}
+	if filter.ProductID != "" {
+		query = query.Where("product_id = ?", filter.ProductID)
+	}
+	return query
+}
@@ -0,0 +1,75 @@
+package matcher_module
+
+import (
+	matcher_http "github.com/Fi44er/sdmed/internal/module/matcher/delivery/http"
+	matcher_adapters "github.com/Fi44er/sdmed/internal/module/matcher/infrastructure/adapters"
+	suggestion_repository "github.com/Fi44er/sdmed/internal/module/matcher/infrastructure/repository/suggestion"
+	matcher_usecase "github.com/Fi44er/sdmed/internal/module/matcher/usecase/matcher"
+	category_usecase "github.com/Fi44er/sdmed/internal/module/product/usecase/category"
+	product_usecase "github.com/Fi44er/sdmed/internal/module/product/usecase/product"
+	tru_usecase "github.com/Fi44er/sdmed/internal/module/tru/usecase/tru"
+	"github.com/Fi44er/sdmed/pkg/logger"
+	"github.com/Fi44er/sdmed/pkg/postgres/uow"
+	"github.com/go-playground/validator/v10"
+	"github.com/gofiber/fiber/v2"
+	"gorm.io/gorm"
+)
+
+type MatcherModule struct {
+	suggestionRepository suggestion_repository.ISuggestionRepository
+	matcherUsecase       matcher_usecase.IMatcherUsecase
+	matcherHandler       *matcher_http.MatcherHandler
+
+	truUsecase      tru_usecase.ITRUUsecase
+	productUsecase  product_usecase.IProductUsecase
+	categoryUsecase category_usecase.ICategoryUsecase
+
+	logger    *logger.Logger
+	validator *validator.Validate
+	db        *gorm.DB
+	uow       uow.Uow
+}
+
+func NewMatcherModule(
+	logger *logger.Logger,
+	validator *validator.Validate,
+	db *gorm.DB,
+	uow uow.Uow,
+	truUsecase tru_usecase.ITRUUsecase,
+	productUsecase product_usecase.IProductUsecase,
+	categoryUsecase category_usecase.ICategoryUsecase,
+) *MatcherModule {
+	return &MatcherModule{
+		logger:          logger,
+		validator:       validator,
+		db:              db,
+		uow:             uow,
+		truUsecase:      truUsecase,
+		productUsecase:  productUsecase,
+		categoryUsecase: categoryUsecase,
+	}
+}
+
+func (m *MatcherModule) Init() {
+	m.uow.RegisterRepository("tru_suggestion", func(tx *gorm.DB) (any, error) {
+		return suggestion_repository.NewSuggestionRepository(m.logger, tx), nil
+	})
+
+	m.suggestionRepository = suggestion_repository.NewSuggestionRepository(m.logger, m.db)
+	m.matcherUsecase = matcher_usecase.NewMatcherUsecase(
+		m.suggestionRepository,
+		matcher_adapters.NewTRUUsecaseAdapter(m.truUsecase),
+		matcher_adapters.NewProductUsecaseAdapter(m.productUsecase, m.categoryUsecase),
+		m.uow,
+		m.logger,
+	)
+	m.matcherHandler = matcher_http.NewMatcherHandler(m.matcherUsecase, m.validator, m.logger)
+}
+
+func (m *MatcherModule) InitDelivery(router fiber.Router) {
+	m.matcherHandler.RegisterRoutes(router)
+}
+
+func (m *MatcherModule) GetMatcherUsecase() matcher_usecase.IMatcherUsecase {
+	return m.matcherUsecase
+}
@@ -0,0 +1,17 @@
+package matcher_constant
+
+import "github.com/Fi44er/sdmed/pkg/customerr"
+
+const (
+	// DefaultMinScore - подсказки с меньшей уверенностью не сохраняются
+	DefaultMinScore = 0.3
+	// DefaultMaxSuggestions - сколько лучших кодов предлагать на один товар
+	DefaultMaxSuggestions = 5
+	// CodesPageSize - размер страницы при выгрузке кодов КТРУ для сопоставления
+	CodesPageSize = 1000
+)
+
+var (
+	ErrSuggestionNotFound        = customerr.NewError(404, "suggestion not found")
+	ErrSuggestionAlreadyReviewed = customerr.NewError(409, "suggestion already reviewed")
+)
@@ -0,0 +1,28 @@
+package matcher_usecase_contracts
+
+import (
+	"context"
+	"time"
+
+	matcher_entity "github.com/Fi44er/sdmed/internal/module/matcher/entity"
+)
+
+type ISuggestionRepository interface {
+	CreateMany(ctx context.Context, suggestions []matcher_entity.Suggestion) error
+	GetByID(ctx context.Context, id string) (*matcher_entity.Suggestion, error)
+	GetAll(ctx context.Context, filter *matcher_entity.SuggestionFilter) ([]matcher_entity.Suggestion, error)
+	Count(ctx context.Context, filter *matcher_entity.SuggestionFilter) (int64, error)
+	GetSuggestedCodeIDs(ctx context.Context, productID string) ([]string, error)
+	UpdateStatus(ctx context.Context, id string, status matcher_entity.SuggestionStatus, reviewedAt time.Time) error
+	MoveCode(ctx context.Context, fromCodeID string, to *matcher_entity.MatchCode) error
+}
+
+type ITRUUsecaseAdapter interface {
+	GetCodes(ctx context.Context) ([]matcher_entity.MatchCode, error)
+	GetProductCodeIDs(ctx context.Context, productID string) ([]string, error)
+	LinkCode(ctx context.Context, productID, truCodeID string) error
+}
+
+type IProductUsecaseAdapter interface {
+	GetByIDs(ctx context.Context, ids []string) ([]matcher_entity.MatchProduct, error)
+}
@@ -0,0 +1,95 @@
+package matcher_usecase
+
+import (
+	"math"
+	"regexp"
+	"strings"
+
+	matcher_entity "github.com/Fi44er/sdmed/internal/module/matcher/entity"
+)
+
+// stemLength - токены обрезаются до общей основы, чтобы "коляска" и "коляски" совпадали
+const stemLength = 6
+
+const (
+	nameWeight     = 0.6
+	categoryWeight = 0.2
+	valuesWeight   = 0.2
+)
+
+var tokenSplitter = regexp.MustCompile(`[^\p{L}\p{N}]+`)
+
+var stopWords = map[string]struct{}{
+	"и": {}, "в": {}, "во": {}, "с": {}, "со": {}, "на": {}, "по": {}, "для": {}, "из": {},
+	"от": {}, "до": {}, "без": {}, "или": {}, "не": {}, "при": {}, "под": {}, "над": {}, "за": {},
+}
+
+type tokenSet map[string]struct{}
+
+func tokenize(texts ...string) tokenSet {
+	tokens := make(tokenSet)
+	for _, text := range texts {
+		for _, word := range tokenSplitter.Split(strings.ToLower(text), -1) {
+			if _, ok := stopWords[word]; ok || len([]rune(word)) < 2 {
+				continue
+			}
+			tokens[stem(word)] = struct{}{}
+		}
+	}
+	return tokens
+}
+
+func stem(word string) string {
+	runes := []rune(word)
+	if len(runes) <= stemLength {
+		return word
+	}
+	return string(runes[:stemLength])
+}
+
+// dice - коэффициент Сёренсена: 2|A∩B| / (|A|+|B|)
+func dice(a, b tokenSet) float64 {
+	if len(a) == 0 || len(b) == 0 {
+		return 0
+	}
+
+	common := 0
+	for token := range a {
+		if _, ok := b[token]; ok {
+			common++
+		}
+	}
+
+	return 2 * float64(common) / float64(len(a)+len(b))
+}
+
+// score оценивает сходство товара с описанием кода.
+// Название весит больше всего; пустые категория и характеристики не штрафуют товар,
+// их вес перераспределяется на заполненные поля.
+func score(product *matcher_entity.MatchProduct, codeTokens tokenSet) float64 {
+	type part struct {
+		tokens tokenSet
+		weight float64
+	}
+
+	parts := []part{
+		{tokens: tokenize(product.Name), weight: nameWeight},
+		{tokens: tokenize(product.CategoryName), weight: categoryWeight},
+		{tokens: tokenize(product.Values...), weight: valuesWeight},
+	}
+
+	total, weights := 0.0, 0.0
+	for _, p := range parts {
+		if len(p.tokens) == 0 {
+			continue
+		}
+		total += dice(p.tokens, codeTokens) * p.weight
+		weights += p.weight
+	}
+
+	if weights == 0 {
+		return 0
+	}
+
+	return math.Round(total/weights*100) / 100
+}
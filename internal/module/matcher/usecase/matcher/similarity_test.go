@@ -0,0 +1,33 @@
+package matcher_usecase
+
+import (
+	"testing"
+
+	matcher_entity "github.com/Fi44er/sdmed/internal/module/matcher/entity"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestScore(t *testing.T) {
+	wheelchair := tokenize("Кресло-коляска с ручным приводом комнатная")
+	cane := tokenize("Трость опорная, регулируемая по высоте")
+
+	product := &matcher_entity.MatchProduct{
+		Name:         "Кресла-коляски комнатные с ручным приводом Ortonica",
+		CategoryName: "Кресла-коляски",
+		Values:       []string{"Ручной привод", "120 кг"},
+	}
+
+	t.Run("similar code scores higher", func(t *testing.T) {
+		assert.Greater(t, score(product, wheelchair), 0.5)
+		assert.Equal(t, 0.0, score(product, cane))
+	})
+
+	t.Run("empty optional fields do not lower the score", func(t *testing.T) {
+		nameOnly := &matcher_entity.MatchProduct{Name: "Кресло-коляска с ручным приводом комнатная"}
+		assert.Equal(t, 1.0, score(nameOnly, wheelchair))
+	})
+
+	t.Run("empty product", func(t *testing.T) {
+		assert.Equal(t, 0.0, score(&matcher_entity.MatchProduct{}, wheelchair))
+	})
+}
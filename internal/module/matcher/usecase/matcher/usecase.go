@@ -0,0 +1,232 @@
+package matcher_usecase
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	matcher_entity "github.com/Fi44er/sdmed/internal/module/matcher/entity"
+	matcher_constant "github.com/Fi44er/sdmed/internal/module/matcher/pkg"
+	matcher_usecase_contracts "github.com/Fi44er/sdmed/internal/module/matcher/usecase/matcher/contracts"
+	"github.com/Fi44er/sdmed/pkg/logger"
+	"github.com/Fi44er/sdmed/pkg/postgres/uow"
+	"github.com/Fi44er/sdmed/pkg/utils"
+)
+
+type IMatcherUsecase interface {
+	Generate(ctx context.Context, params *matcher_entity.GenerateParams) ([]matcher_entity.Suggestion, error)
+	GetAll(ctx context.Context, filter *matcher_entity.SuggestionFilter, page, pageSize int) ([]matcher_entity.Suggestion, int64, error)
+	Accept(ctx context.Context, id string) error
+	Reject(ctx context.Context, id string) error
+	MoveCode(ctx context.Context, fromCodeID string, to *matcher_entity.MatchCode) error
+}
+
+type MatcherUsecase struct {
+	repository     matcher_usecase_contracts.ISuggestionRepository
+	truUsecase     matcher_usecase_contracts.ITRUUsecaseAdapter
+	productUsecase matcher_usecase_contracts.IProductUsecaseAdapter
+	uow            uow.Uow
+	logger         *logger.Logger
+}
+
+func NewMatcherUsecase(
+	repository matcher_usecase_contracts.ISuggestionRepository,
+	truUsecase matcher_usecase_contracts.ITRUUsecaseAdapter,
+	productUsecase matcher_usecase_contracts.IProductUsecaseAdapter,
+	uow uow.Uow,
+	logger *logger.Logger,
+) IMatcherUsecase {
+	return &MatcherUsecase{
+		repository:     repository,
+		truUsecase:     truUsecase,
+		productUsecase: productUsecase,
+		uow:            uow,
+		logger:         logger,
+	}
+}
+
+// Generate подбирает коды КТРУ для товаров и сохраняет лучшие варианты в очередь на проверку.
+// Уже привязанные и ранее предложенные (в том числе отклонённые) коды повторно не предлагаются.
+func (u *MatcherUsecase) Generate(ctx context.Context, params *matcher_entity.GenerateParams) ([]matcher_entity.Suggestion, error) {
+	u.logger.Infof("Generating tru code suggestions for %d products", len(params.ProductIDs))
+
+	if params.MinScore <= 0 {
+		params.MinScore = matcher_constant.DefaultMinScore
+	}
+	if params.MaxSuggestions <= 0 {
+		params.MaxSuggestions = matcher_constant.DefaultMaxSuggestions
+	}
+
+	products, err := u.productUsecase.GetByIDs(ctx, params.ProductIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	codes, err := u.truUsecase.GetCodes(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	codeTokens := make([]tokenSet, len(codes))
+	for i := range codes {
+		codeTokens[i] = tokenize(codes[i].Name)
+	}
+
+	result := make([]matcher_entity.Suggestion, 0)
+	for i := range products {
+		excluded, err := u.getExcludedCodeIDs(ctx, products[i].ID)
+		if err != nil {
+			return nil, err
+		}
+
+		suggestions := make([]matcher_entity.Suggestion, 0)
+		for j := range codes {
+			if _, ok := excluded[codes[j].ID]; ok {
+				continue
+			}
+
+			codeScore := score(&products[i], codeTokens[j])
+			if codeScore < params.MinScore {
+				continue
+			}
+
+			suggestions = append(suggestions, matcher_entity.Suggestion{
+				ProductID: products[i].ID,
+				TRUCodeID: codes[j].ID,
+				Code:      codes[j].Code,
+				CodeName:  codes[j].Name,
+				Score:     codeScore,
+				Status:    matcher_entity.SuggestionStatusPending,
+			})
+		}
+
+		sort.SliceStable(suggestions, func(a, b int) bool {
+			return suggestions[a].Score > suggestions[b].Score
+		})
+		if len(suggestions) > params.MaxSuggestions {
+			suggestions = suggestions[:params.MaxSuggestions]
+		}
+
+		if err := u.repository.CreateMany(ctx, suggestions); err != nil {
+			return nil, err
+		}
+		result = append(result, suggestions...)
+	}
+
+	u.logger.Infof("Created %d tru code suggestions", len(result))
+	return result, nil
+}
+
+func (u *MatcherUsecase) GetAll(ctx context.Context, filter *matcher_entity.SuggestionFilter, page, pageSize int) ([]matcher_entity.Suggestion, int64, error) {
+	u.logger.Debugf("Getting suggestions (page: %d, pageSize: %d)", page, pageSize)
+
+	filter.Offset, filter.Limit = utils.SafeCalculateForPostgres(page, pageSize)
+	suggestions, err := u.repository.GetAll(ctx, filter)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	count, err := u.repository.Count(ctx, filter)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return suggestions, count, nil
+}
+
+// Accept принимает подсказку и привязывает код к товару в одной транзакции
+func (u *MatcherUsecase) Accept(ctx context.Context, id string) error {
+	u.logger.Infof("Accepting suggestion: %s", id)
+
+	return u.uow.Do(ctx, func(ctx context.Context) error {
+		repo, err := u.getRepository(ctx)
+		if err != nil {
+			return err
+		}
+
+		suggestion, err := u.getPending(ctx, repo, id)
+		if err != nil {
+			return err
+		}
+
+		if err := repo.UpdateStatus(ctx, id, matcher_entity.SuggestionStatusAccepted, time.Now()); err != nil {
+			return err
+		}
+
+		return u.truUsecase.LinkCode(ctx, suggestion.ProductID, suggestion.TRUCodeID)
+	})
+}
+
+func (u *MatcherUsecase) Reject(ctx context.Context, id string) error {
+	u.logger.Infof("Rejecting suggestion: %s", id)
+
+	return u.uow.Do(ctx, func(ctx context.Context) error {
+		repo, err := u.getRepository(ctx)
+		if err != nil {
+			return err
+		}
+
+		if _, err := u.getPending(ctx, repo, id); err != nil {
+			return err
+		}
+
+		return repo.UpdateStatus(ctx, id, matcher_entity.SuggestionStatusRejected, time.Now())
+	})
+}
+
+// MoveCode переводит подсказки удаляемого кода на код, с которым он объединен.
+// Выполняется в транзакции вызывающего модуля.
+func (u *MatcherUsecase) MoveCode(ctx context.Context, fromCodeID string, to *matcher_entity.MatchCode) error {
+	u.logger.Infof("Moving suggestions of tru code %s to %s", fromCodeID, to.ID)
+
+	repo, err := u.getRepository(ctx)
+	if err != nil {
+		return err
+	}
+
+	return repo.MoveCode(ctx, fromCodeID, to)
+}
+
+func (u *MatcherUsecase) getPending(ctx context.Context, repo matcher_usecase_contracts.ISuggestionRepository, id string) (*matcher_entity.Suggestion, error) {
+	suggestion, err := repo.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if suggestion == nil {
+		return nil, matcher_constant.ErrSuggestionNotFound
+	}
+	if suggestion.Status != matcher_entity.SuggestionStatusPending {
+		u.logger.Warnf("Suggestion %s already reviewed: %s", id, suggestion.Status)
+		return nil, matcher_constant.ErrSuggestionAlreadyReviewed
+	}
+
+	return suggestion, nil
+}
+
+func (u *MatcherUsecase) getExcludedCodeIDs(ctx context.Context, productID string) (map[string]struct{}, error) {
+	linked, err := u.truUsecase.GetProductCodeIDs(ctx, productID)
+	if err != nil {
+		return nil, err
+	}
+
+	suggested, err := u.repository.GetSuggestedCodeIDs(ctx, productID)
+	if err != nil {
+		return nil, err
+	}
+
+	excluded := make(map[string]struct{}, len(linked)+len(suggested))
+	for _, id := range append(linked, suggested...) {
+		excluded[id] = struct{}{}
+	}
+
+	return excluded, nil
+}
+
+func (u *MatcherUsecase) getRepository(ctx context.Context) (matcher_usecase_contracts.ISuggestionRepository, error) {
+	repo, err := u.uow.GetRepository(ctx, "tru_suggestion")
+	if err != nil {
+		u.logger.Errorf("Failed to get repository: %v", err)
+		return nil, err
+	}
+	return repo.(matcher_usecase_contracts.ISuggestionRepository), nil
+}
@@ -1,6 +1,7 @@
 package service
 
 import (
+	"fmt"
 	"time"
 
 	"github.com/Fi44er/sdmed/pkg/logger"
@@ -17,6 +18,7 @@ type Message struct {
 
 type Notifier interface {
 	Send(msg *Message)
+	SendSync(msg *Message) error
 }
 
 type NotificationService struct {
@@ -38,3 +40,18 @@ func (ns *NotificationService) Send(msg *Message, selectedNotifiers ...string) {
 		}
 	}
 }
+
+// SendSync отправляет сообщение через выбранные каналы и дожидается результата.
+// Возвращает первую ошибку отправки или ошибку неизвестного канала
+func (ns *NotificationService) SendSync(msg *Message, selectedNotifiers ...string) error {
+	for _, name := range selectedNotifiers {
+		notifier, ok := ns.notifiers[name]
+		if !ok {
+			return fmt.Errorf("notifier %s is not configured", name)
+		}
+		if err := notifier.SendSync(msg); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -44,6 +44,11 @@ func (n *SMTPNotifier) Send(msg *service.Message) {
 	}()
 }
 
+// SendSync отправляет письмо и возвращает результат отправки
+func (n *SMTPNotifier) SendSync(msg *service.Message) error {
+	return n.send(msg)
+}
+
 func (n *SMTPNotifier) send(msg *service.Message) error {
 	e := email.NewEmail()
 	e.From = n.from
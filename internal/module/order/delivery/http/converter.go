@@ -0,0 +1,362 @@
+package order_http
+
+import (
+	"math"
+	"strings"
+	"time"
+
+	order_dto "github.com/Fi44er/sdmed/internal/module/order/dto"
+	order_entity "github.com/Fi44er/sdmed/internal/module/order/entity"
+	dto_utils "github.com/Fi44er/sdmed/pkg/utils/dto"
+)
+
+type Converter struct{}
+
+func (c *Converter) ToEntity(dto *order_dto.CreateOrderRequest) *order_entity.Order {
+	order := &order_entity.Order{
+		Contact: order_entity.Contact{
+			Name:  dto.ContactName,
+			Phone: dto.ContactPhone,
+			Email: dto.ContactEmail,
+		},
+		Delivery: order_entity.Delivery{
+			MethodID:   dto.DeliveryMethodID,
+			AddressID:  dto.AddressID,
+			Address:    dto.DeliveryAddress,
+			PostalCode: dto.PostalCode,
+		},
+		Comment:       dto.Comment,
+		PromoCode:     dto.PromoCode,
+		PaymentMethod: order_entity.PaymentMethod(dto.PaymentMethod),
+	}
+	if dto.Address != nil {
+		order.Delivery.NewAddress = &order_entity.Address{
+			Label:          dto.Address.Label,
+			RegionID:       dto.Address.RegionID,
+			City:           dto.Address.City,
+			Street:         dto.Address.Street,
+			House:          dto.Address.House,
+			Apartment:      dto.Address.Apartment,
+			PostalCode:     dto.Address.PostalCode,
+			RecipientName:  dto.Address.RecipientName,
+			RecipientPhone: dto.Address.RecipientPhone,
+		}
+	}
+	if order.PaymentMethod == order_entity.PaymentMethodCertificate {
+		order.Certificate = &order_entity.Certificate{
+			Number: dto.CertificateNumber,
+			SNILS:  dto.SNILS,
+		}
+	}
+
+	return order
+}
+
+func (c *Converter) ToStatusChange(dto *order_dto.ChangeOrderStatusRequest) *order_entity.StatusChange {
+	return &order_entity.StatusChange{
+		OrderID:  dto.ID,
+		ToStatus: order_entity.OrderStatus(dto.Status),
+		Comment:  dto.Comment,
+	}
+}
+
+func (c *Converter) ToCertificateUpdate(dto *order_dto.UpdateCertificateStatusRequest) *order_entity.Order {
+	items := make([]order_entity.OrderItem, len(dto.ItemIDs))
+	for i, itemID := range dto.ItemIDs {
+		items[i] = order_entity.OrderItem{ID: itemID}
+	}
+
+	return &order_entity.Order{ID: dto.ID, Items: items}
+}
+
+func (c *Converter) ToCancelComment(dto *order_dto.CancelOrderRequest) *string {
+	return &dto.Comment
+}
+
+// ToFilterEntity - даты проверены валидатором, date_to включается в период целиком.
+// Телефон ищется по цифрам без учета форматирования
+func (c *Converter) ToFilterEntity(params *order_dto.OrderQueryParams) *order_entity.OrderFilter {
+	filter := &order_entity.OrderFilter{
+		Status:        order_entity.OrderStatus(params.Status),
+		UserID:        params.UserID,
+		PaymentMethod: order_entity.PaymentMethod(params.PaymentMethod),
+		ManagerID:     params.ManagerID,
+		Number:        order_entity.NormalizeNumber(params.Number),
+		Email:         strings.TrimSpace(params.Email),
+		Phone:         digits(params.Phone),
+		Article:       strings.TrimSpace(params.Article),
+	}
+	if params.DateFrom != "" {
+		filter.CreatedFrom, _ = time.Parse(time.DateOnly, params.DateFrom)
+	}
+	if params.DateTo != "" {
+		dateTo, _ := time.Parse(time.DateOnly, params.DateTo)
+		filter.CreatedTo = dateTo.AddDate(0, 0, 1)
+	}
+	return filter
+}
+
+func (c *Converter) ToItemChanges(dto *order_dto.EditOrderItemsRequest) *[]order_entity.ItemChange {
+	changes := make([]order_entity.ItemChange, len(dto.Items))
+	for i, item := range dto.Items {
+		changes[i] = order_entity.ItemChange{ItemID: item.ItemID, Quantity: item.Quantity}
+	}
+	return &changes
+}
+
+func (c *Converter) ToDeliveryUpdate(dto *order_dto.UpdateOrderDeliveryRequest) *order_entity.Delivery {
+	return &order_entity.Delivery{
+		MethodID:   dto.DeliveryMethodID,
+		Address:    dto.DeliveryAddress,
+		PostalCode: dto.PostalCode,
+	}
+}
+
+func (c *Converter) ToManagerID(dto *order_dto.AssignManagerRequest) *string {
+	return &dto.ManagerID
+}
+
+func (c *Converter) ToCommentText(dto *order_dto.OrderCommentRequest) *string {
+	return &dto.Text
+}
+
+func (c *Converter) ToBulkStatus(dto *order_dto.BulkOrderStatusRequest) *order_entity.OrderStatus {
+	status := order_entity.OrderStatus(dto.Status)
+	return &status
+}
+
+func (c *Converter) ToCommentResponses(entities []order_entity.InternalComment) []order_dto.OrderCommentResponse {
+	responses := make([]order_dto.OrderCommentResponse, len(entities))
+	for i := range entities {
+		responses[i] = *c.ToCommentResponse(&entities[i])
+	}
+	return responses
+}
+
+func (c *Converter) ToCommentResponse(entity *order_entity.InternalComment) *order_dto.OrderCommentResponse {
+	return &order_dto.OrderCommentResponse{
+		ID:        entity.ID,
+		AuthorID:  entity.AuthorID,
+		Text:      entity.Text,
+		CreatedAt: entity.CreatedAt,
+	}
+}
+
+func (c *Converter) ToBulkStatusResponses(results []order_entity.StatusChangeResult) []order_dto.BulkOrderStatusResponse {
+	responses := make([]order_dto.BulkOrderStatusResponse, len(results))
+	for i, result := range results {
+		responses[i] = order_dto.BulkOrderStatusResponse{
+			OrderID: result.OrderID,
+			Status:  string(result.Status),
+		}
+		if result.Err != nil {
+			responses[i].Error = result.Err.Error()
+		}
+	}
+	return responses
+}
+
+func (c *Converter) ToDeliveryQuoteResponses(quotes []order_entity.DeliveryQuote) []order_dto.DeliveryQuoteResponse {
+	responses := make([]order_dto.DeliveryQuoteResponse, len(quotes))
+	for i, quote := range quotes {
+		responses[i] = order_dto.DeliveryQuoteResponse{
+			MethodID:        quote.MethodID,
+			MethodName:      quote.MethodName,
+			Type:            string(quote.Method),
+			Cost:            quote.Cost,
+			MinDays:         quote.MinDays,
+			MaxDays:         quote.MaxDays,
+			EstimatedFrom:   quote.EstimatedFrom,
+			EstimatedTo:     quote.EstimatedTo,
+			RequiresAddress: quote.RequiresAddress,
+		}
+	}
+	return responses
+}
+
+func (c *Converter) ToDeliveryResponse(entity *order_entity.Delivery) order_dto.DeliveryResponse {
+	response := order_dto.DeliveryResponse{
+		Method:     string(entity.Method),
+		MethodID:   entity.MethodID,
+		MethodName: entity.MethodName,
+		AddressID:  entity.AddressID,
+		Address:    entity.Address,
+		PostalCode: entity.PostalCode,
+		Cost:       entity.Cost,
+
+		TrackingNumber: entity.TrackingNumber,
+	}
+	if !entity.EstimatedFrom.IsZero() {
+		response.EstimatedFrom, response.EstimatedTo = &entity.EstimatedFrom, &entity.EstimatedTo
+	}
+	return response
+}
+
+// ToOrderResponse - в next_statuses попадают только переходы, доступные actor
+func (c *Converter) ToOrderResponse(entity *order_entity.Order, actor order_entity.Actor) *order_dto.OrderResponse {
+	items := make([]order_dto.OrderItemResponse, len(entity.Items))
+	for i := range entity.Items {
+		items[i] = order_dto.OrderItemResponse{
+			ID:                entity.Items[i].ID,
+			ProductID:         entity.Items[i].ProductID,
+			Name:              entity.Items[i].Name,
+			Article:           entity.Items[i].Article,
+			Quantity:          entity.Items[i].Quantity,
+			Price:             entity.Items[i].Price,
+			Sum:               entity.Items[i].Sum(),
+			Discount:          entity.Items[i].Discount,
+			Total:             entity.Items[i].Total(),
+			TRUCode:           entity.Items[i].TRUCode,
+			CertificateAmount: entity.Items[i].CertificateAmount,
+			CertificateStatus: string(entity.Items[i].CertificateStatus),
+		}
+	}
+
+	history := make([]order_dto.StatusChangeResponse, len(entity.History))
+	for i, change := range entity.History {
+		history[i] = order_dto.StatusChangeResponse{
+			FromStatus: string(change.FromStatus),
+			ToStatus:   string(change.ToStatus),
+			Actor:      string(change.Actor),
+			ActorID:    change.ActorID,
+			Comment:    change.Comment,
+			CreatedAt:  change.CreatedAt,
+		}
+	}
+
+	kinds := order_entity.AvailableDocuments(entity.Status)
+	documents := make([]string, len(kinds))
+	for i := range kinds {
+		documents[i] = string(kinds[i])
+	}
+
+	next := order_entity.NextStatuses(entity.Status, actor)
+	nextStatuses := make([]string, len(next))
+	for i := range next {
+		nextStatuses[i] = string(next[i])
+	}
+
+	var certificate *order_dto.CertificateResponse
+	if entity.Certificate != nil {
+		certificate = &order_dto.CertificateResponse{
+			Number: entity.Certificate.Number,
+			SNILS:  entity.Certificate.SNILS,
+		}
+	}
+
+	response := &order_dto.OrderResponse{
+		ID:                entity.ID,
+		UserID:            entity.UserID,
+		Status:            string(entity.Status),
+		NextStatuses:      nextStatuses,
+		Items:             items,
+		Discount:          entity.Discount,
+		PromoCode:         entity.PromoCode,
+		Total:             entity.Total,
+		ContactName:       entity.Contact.Name,
+		ContactPhone:      entity.Contact.Phone,
+		ContactEmail:      entity.Contact.Email,
+		DeliveryMethod:    string(entity.Delivery.Method),
+		DeliveryAddress:   entity.Delivery.Address,
+		Delivery:          c.ToDeliveryResponse(&entity.Delivery),
+		Comment:           entity.Comment,
+		PaymentMethod:     string(entity.PaymentMethod),
+		Certificate:       certificate,
+		CertificateAmount: entity.CertificateAmount,
+		Surcharge:         entity.Surcharge(),
+		SurchargePaid:     entity.SurchargePaid,
+		SurchargePaidAt:   entity.SurchargePaidAt,
+		AmountDue:         entity.AmountDue(),
+		Documents:         documents,
+		History:           history,
+		CreatedAt:         entity.CreatedAt,
+		UpdatedAt:         entity.UpdatedAt,
+	}
+	if actor == order_entity.ActorManager {
+		response.ManagerID = entity.ManagerID
+	}
+
+	return response
+}
+
+func (c *Converter) ToOrderListResponse(orders []order_entity.Order, count int64, page, pageSize int, actor order_entity.Actor) *dto_utils.ListResponse[order_dto.OrderResponse] {
+	data := make([]order_dto.OrderResponse, len(orders))
+	for i := range orders {
+		data[i] = *c.ToOrderResponse(&orders[i], actor)
+	}
+
+	return &dto_utils.ListResponse[order_dto.OrderResponse]{
+		Data: data,
+		Pagination: dto_utils.PaginationInfo{
+			Total:    count,
+			Page:     page,
+			PageSize: pageSize,
+			Pages:    int(math.Ceil(float64(count) / float64(pageSize))),
+		},
+	}
+}
+
+func (c *Converter) ToDocumentResponse(entity *order_entity.Document) *order_dto.DocumentResponse {
+	return &order_dto.DocumentResponse{
+		Kind:      string(entity.Kind),
+		Number:    entity.Number,
+		CreatedAt: entity.CreatedAt,
+	}
+}
+
+func (c *Converter) ToNotificationResponse(entity *order_entity.Notification) *order_dto.NotificationResponse {
+	return &order_dto.NotificationResponse{
+		ID:              entity.ID,
+		Event:           string(entity.Event),
+		TemplateVersion: entity.TemplateVersion,
+		Recipient:       entity.Recipient,
+		Subject:         entity.Subject,
+		Status:          string(entity.Status),
+		Error:           entity.Error,
+		ResendOfID:      entity.ResendOfID,
+		CreatedAt:       entity.CreatedAt,
+	}
+}
+
+func (c *Converter) ToNotificationResponses(entities []order_entity.Notification) []order_dto.NotificationResponse {
+	responses := make([]order_dto.NotificationResponse, len(entities))
+	for i := range entities {
+		responses[i] = *c.ToNotificationResponse(&entities[i])
+	}
+	return responses
+}
+
+func (c *Converter) ToRepeatOrderResponse(result *order_entity.RepeatResult) *order_dto.RepeatOrderResponse {
+	return &order_dto.RepeatOrderResponse{
+		OrderID:     result.OrderID,
+		Added:       c.toRepeatItemResponses(result.Added),
+		Unavailable: c.toRepeatItemResponses(result.Unavailable),
+	}
+}
+
+func (c *Converter) toRepeatItemResponses(items []order_entity.RepeatItem) []order_dto.RepeatItemResponse {
+	responses := make([]order_dto.RepeatItemResponse, len(items))
+	for i := range items {
+		responses[i] = order_dto.RepeatItemResponse{
+			ProductID:          items[i].ProductID,
+			Name:               items[i].Name,
+			Quantity:           items[i].Quantity,
+			OrderedQuantity:    items[i].OrderedQuantity,
+			PartiallyAvailable: items[i].PartiallyAvailable(),
+			OrderPrice:         items[i].OrderPrice,
+			Price:              items[i].Price,
+			PriceChanged:       items[i].PriceChanged(),
+			Reason:             string(items[i].Reason),
+		}
+	}
+	return responses
+}
+
+func digits(value string) string {
+	return strings.Map(func(r rune) rune {
+		if r >= '0' && r <= '9' {
+			return r
+		}
+		return -1
+	}, value)
+}
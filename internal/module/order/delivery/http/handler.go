@@ -0,0 +1,749 @@
+package order_http
+
+import (
+	"context"
+	"fmt"
+
+	order_dto "github.com/Fi44er/sdmed/internal/module/order/dto"
+	order_entity "github.com/Fi44er/sdmed/internal/module/order/entity"
+	"github.com/Fi44er/sdmed/pkg/logger"
+	_ "github.com/Fi44er/sdmed/pkg/response"
+	"github.com/Fi44er/sdmed/pkg/session"
+	"github.com/Fi44er/sdmed/pkg/utils"
+	"github.com/go-playground/validator/v10"
+	"github.com/gofiber/fiber/v2"
+)
+
+type IOrderUsecase interface {
+	Create(ctx context.Context, order *order_entity.Order) (*order_entity.Order, error)
+	GetOwn(ctx context.Context, filter *order_entity.OrderFilter, page, pageSize int) ([]order_entity.Order, int64, error)
+	GetOwnByID(ctx context.Context, id string) (*order_entity.Order, error)
+	Cancel(ctx context.Context, id, comment string) (*order_entity.Order, error)
+	GetDeliveryQuotes(ctx context.Context, postalCode string) ([]order_entity.DeliveryQuote, error)
+	Repeat(ctx context.Context, id string) (*order_entity.RepeatResult, error)
+
+	GetByID(ctx context.Context, id string) (*order_entity.Order, error)
+	GetAll(ctx context.Context, filter *order_entity.OrderFilter, page, pageSize int) ([]order_entity.Order, int64, error)
+	ChangeStatus(ctx context.Context, id string, to order_entity.OrderStatus, actor order_entity.Actor, comment string) (*order_entity.Order, error)
+	UpdateCertificateStatus(ctx context.Context, id string, itemIDs []string, to order_entity.CertificateStatus) (*order_entity.Order, error)
+
+	GetOwnDocument(ctx context.Context, id string, kind order_entity.DocumentKind) (*order_entity.Document, error)
+	GetDocument(ctx context.Context, id string, kind order_entity.DocumentKind) (*order_entity.Document, error)
+	RegenerateDocument(ctx context.Context, id string, kind order_entity.DocumentKind) (*order_entity.Document, error)
+
+	Ship(ctx context.Context, id, trackingNumber, comment string) (*order_entity.Order, error)
+	GetNotifications(ctx context.Context, id string) ([]order_entity.Notification, error)
+	ResendNotification(ctx context.Context, id, notificationID string) (*order_entity.Notification, error)
+
+	EditItems(ctx context.Context, id string, changes []order_entity.ItemChange, comment string) (*order_entity.Order, error)
+	UpdateDelivery(ctx context.Context, id string, delivery *order_entity.Delivery, comment string) (*order_entity.Order, error)
+	AssignManager(ctx context.Context, id, managerID string) (*order_entity.Order, error)
+	AddComment(ctx context.Context, id, text string) (*order_entity.InternalComment, error)
+	GetComments(ctx context.Context, id string) ([]order_entity.InternalComment, error)
+	BulkChangeStatus(ctx context.Context, ids []string, to order_entity.OrderStatus, comment string) []order_entity.StatusChangeResult
+}
+
+type OrderHandler struct {
+	usecase IOrderUsecase
+
+	validator *validator.Validate
+	logger    *logger.Logger
+	converter *Converter
+}
+
+func NewOrderHandler(
+	usecase IOrderUsecase,
+	validator *validator.Validate,
+	logger *logger.Logger,
+) *OrderHandler {
+	return &OrderHandler{
+		usecase:   usecase,
+		validator: validator,
+		logger:    logger,
+		converter: &Converter{},
+	}
+}
+
+// Create godoc
+// @Summary Place an order
+// @Description Place an order from the cart of the current session. Item prices are taken from the cart, the cart is cleared afterwards.
+// @Description Delivery cost of the chosen method is recalculated for the cart and included in the total.
+// @Description Automatic discounts and promo_code are applied to the lines; with certificate payment they reduce only the surcharge.
+// @Description address_id takes a saved address of the customer, address saves a new one to the address book (also for guests).
+// @Description With the certificate payment method the order is split into the amount covered by the SFR certificate and a card surcharge
+// @Tags orders
+// @Accept json
+// @Produce json
+// @Param order body order_dto.CreateOrderRequest true "Contact and delivery data"
+// @Success 201 {object} response.ResponseData{data=order_dto.OrderResponse} "Created"
+// @Failure 400 {object} response.Response "Invalid request, empty cart, delivery method not available or promo code not applicable"
+// @Failure 401 {object} response.Response "No session"
+// @Failure 404 {object} response.Response "Delivery method, saved address or promo code not found"
+// @Failure 500 {object} response.Response "Error"
+// @Router /orders [post]
+func (h *OrderHandler) Create(ctx *fiber.Ctx) error {
+	dto := new(order_dto.CreateOrderRequest)
+
+	order, err := utils.ParseAndValidate(ctx, dto, h.validator, h.converter.ToEntity, h.logger)
+	if err != nil {
+		return ctx.Status(400).JSON(fiber.Map{
+			"status":  "fail",
+			"message": err.Error(),
+		})
+	}
+
+	order, err = h.usecase.Create(h.getCtxWithSession(ctx), order)
+	if err != nil {
+		return err
+	}
+
+	return ctx.Status(201).JSON(fiber.Map{
+		"status": "success",
+		"data":   h.converter.ToOrderResponse(order, order_entity.ActorCustomer),
+	})
+}
+
+// GetDeliveryQuotes godoc
+// @Summary Get delivery options
+// @Description Delivery methods available for the cart of the current session with cost and estimated dates.
+// @Description Zones are matched by the customer region and the postal code
+// @Tags orders
+// @Produce json
+// @Param postal_code query string false "Recipient postal code"
+// @Success 200 {object} response.ResponseData{data=[]order_dto.DeliveryQuoteResponse} "OK"
+// @Failure 400 {object} response.Response "Empty cart"
+// @Failure 401 {object} response.Response "No session"
+// @Failure 500 {object} response.Response "Error"
+// @Router /orders/delivery-quotes [get]
+func (h *OrderHandler) GetDeliveryQuotes(ctx *fiber.Ctx) error {
+	params := new(order_dto.DeliveryQuotesQueryParams)
+	if err := ctx.QueryParser(params); err != nil {
+		h.logger.Errorf("Failed to parse query params: %v", err)
+		return fiber.NewError(fiber.StatusBadRequest, "Invalid query parameters")
+	}
+
+	quotes, err := h.usecase.GetDeliveryQuotes(h.getCtxWithSession(ctx), params.PostalCode)
+	if err != nil {
+		return err
+	}
+
+	return ctx.Status(200).JSON(fiber.Map{
+		"status": "success",
+		"data":   h.converter.ToDeliveryQuoteResponses(quotes),
+	})
+}
+
+// GetOwn godoc
+// @Summary Get own orders
+// @Description Orders of the session user, newest first. date_from and date_to are inclusive
+// @Tags orders
+// @Produce json
+// @Param status query string false "Filter by status"
+// @Param date_from query string false "Created on or after the date (YYYY-MM-DD)"
+// @Param date_to query string false "Created on or before the date (YYYY-MM-DD)"
+// @Param page query int false "Page number (default 1)"
+// @Param page_size query int false "Items per page (default 20)"
+// @Success 200 {object} response.ResponseData{data=[]order_dto.OrderResponse} "OK"
+// @Failure 400 {object} response.Response "Invalid status or date"
+// @Failure 401 {object} response.Response "Unauthorized"
+// @Failure 500 {object} response.Response "Error"
+// @Router /orders [get]
+func (h *OrderHandler) GetOwn(ctx *fiber.Ctx) error {
+	params := &order_dto.OrderQueryParams{
+		Page:     1,
+		PageSize: 20,
+	}
+
+	if err := ctx.QueryParser(params); err != nil {
+		h.logger.Errorf("Failed to parse query params: %v", err)
+		return fiber.NewError(fiber.StatusBadRequest, "Invalid query parameters")
+	}
+	if err := h.validator.Struct(params); err != nil {
+		return ctx.Status(400).JSON(fiber.Map{
+			"status":  "fail",
+			"message": err.Error(),
+		})
+	}
+
+	orders, count, err := h.usecase.GetOwn(h.getCtxWithSession(ctx), h.converter.ToFilterEntity(params), params.Page, params.PageSize)
+	if err != nil {
+		return err
+	}
+
+	return ctx.Status(200).JSON(fiber.Map{
+		"status": "success",
+		"data":   h.converter.ToOrderListResponse(orders, count, params.Page, params.PageSize, order_entity.ActorCustomer),
+	})
+}
+
+// GetOwnByID godoc
+// @Summary Get own order
+// @Description Order with its status timeline. documents lists kinds that can be downloaded from /orders/{id}/documents/{kind}
+// @Tags orders
+// @Produce json
+// @Param id path string true "Order ID"
+// @Success 200 {object} response.ResponseData{data=order_dto.OrderResponse} "OK"
+// @Failure 404 {object} response.Response "Order not found"
+// @Failure 500 {object} response.Response "Error"
+// @Router /orders/{id} [get]
+func (h *OrderHandler) GetOwnByID(ctx *fiber.Ctx) error {
+	order, err := h.usecase.GetOwnByID(h.getCtxWithSession(ctx), ctx.Params("id"))
+	if err != nil {
+		return err
+	}
+
+	return ctx.Status(200).JSON(fiber.Map{
+		"status": "success",
+		"data":   h.converter.ToOrderResponse(order, order_entity.ActorCustomer),
+	})
+}
+
+// Cancel godoc
+// @Summary Cancel own order
+// @Description Cancel an order that has not been paid yet
+// @Tags orders
+// @Accept json
+// @Produce json
+// @Param id path string true "Order ID"
+// @Param request body order_dto.CancelOrderRequest false "Reason"
+// @Success 200 {object} response.ResponseData{data=order_dto.OrderResponse} "OK"
+// @Failure 404 {object} response.Response "Order not found"
+// @Failure 409 {object} response.Response "Order can no longer be cancelled"
+// @Failure 500 {object} response.Response "Error"
+// @Router /orders/{id}/cancel [post]
+func (h *OrderHandler) Cancel(ctx *fiber.Ctx) error {
+	comment := new(string)
+	if len(ctx.Body()) > 0 {
+		var err error
+		comment, err = utils.ParseAndValidate(ctx, new(order_dto.CancelOrderRequest), h.validator, h.converter.ToCancelComment, h.logger)
+		if err != nil {
+			return ctx.Status(400).JSON(fiber.Map{
+				"status":  "fail",
+				"message": err.Error(),
+			})
+		}
+	}
+
+	order, err := h.usecase.Cancel(h.getCtxWithSession(ctx), ctx.Params("id"), *comment)
+	if err != nil {
+		return err
+	}
+
+	return ctx.Status(200).JSON(fiber.Map{
+		"status": "success",
+		"data":   h.converter.ToOrderResponse(order, order_entity.ActorCustomer),
+	})
+}
+
+// Repeat godoc
+// @Summary Repeat own order
+// @Description Rebuild the cart from the order lines with current prices. The current cart content is replaced.
+// @Description Lines that can no longer be ordered are returned in unavailable with a reason: not_found, unavailable, no_price, quantity or out_of_stock.
+// @Description A line with less stock than ordered is added with the available quantity
+// @Tags orders
+// @Produce json
+// @Param id path string true "Order ID"
+// @Success 200 {object} response.ResponseData{data=order_dto.RepeatOrderResponse} "OK"
+// @Failure 401 {object} response.Response "Unauthorized"
+// @Failure 404 {object} response.Response "Order not found"
+// @Failure 500 {object} response.Response "Error"
+// @Router /orders/{id}/repeat [post]
+func (h *OrderHandler) Repeat(ctx *fiber.Ctx) error {
+	result, err := h.usecase.Repeat(h.getCtxWithSession(ctx), ctx.Params("id"))
+	if err != nil {
+		return err
+	}
+
+	return ctx.Status(200).JSON(fiber.Map{
+		"status": "success",
+		"data":   h.converter.ToRepeatOrderResponse(result),
+	})
+}
+
+// GetAll godoc
+// @Summary Get orders
+// @Description List of all orders for managers, newest first.
+// @Description number matches the beginning of the order number, email, phone and article match a part of the value
+// @Tags orders-admin
+// @Produce json
+// @Param status query string false "Filter by status"
+// @Param user_id query string false "Filter by customer"
+// @Param manager_id query string false "Filter by responsible manager"
+// @Param payment_method query string false "Filter by payment method (card, certificate)"
+// @Param number query string false "Order number or its beginning"
+// @Param email query string false "Customer email"
+// @Param phone query string false "Customer phone, formatting is ignored"
+// @Param article query string false "Product article in the order lines"
+// @Param date_from query string false "Created on or after the date (YYYY-MM-DD)"
+// @Param date_to query string false "Created on or before the date (YYYY-MM-DD)"
+// @Param page query int false "Page number (default 1)"
+// @Param page_size query int false "Items per page (default 20)"
+// @Success 200 {object} response.ResponseData{data=[]order_dto.OrderResponse} "OK"
+// @Failure 400 {object} response.Response "Invalid status or date"
+// @Failure 500 {object} response.Response "Error"
+// @Router /admin/orders [get]
+func (h *OrderHandler) GetAll(ctx *fiber.Ctx) error {
+	params := &order_dto.OrderQueryParams{
+		Page:     1,
+		PageSize: 20,
+	}
+
+	if err := ctx.QueryParser(params); err != nil {
+		h.logger.Errorf("Failed to parse query params: %v", err)
+		return fiber.NewError(fiber.StatusBadRequest, "Invalid query parameters")
+	}
+	if err := h.validator.Struct(params); err != nil {
+		return ctx.Status(400).JSON(fiber.Map{
+			"status":  "fail",
+			"message": err.Error(),
+		})
+	}
+
+	orders, count, err := h.usecase.GetAll(ctx.Context(), h.converter.ToFilterEntity(params), params.Page, params.PageSize)
+	if err != nil {
+		return err
+	}
+
+	return ctx.Status(200).JSON(fiber.Map{
+		"status": "success",
+		"data":   h.converter.ToOrderListResponse(orders, count, params.Page, params.PageSize, order_entity.ActorManager),
+	})
+}
+
+// GetByID godoc
+// @Summary Get order
+// @Description Order with status history for managers
+// @Tags orders-admin
+// @Produce json
+// @Param id path string true "Order ID"
+// @Success 200 {object} response.ResponseData{data=order_dto.OrderResponse} "OK"
+// @Failure 404 {object} response.Response "Order not found"
+// @Failure 500 {object} response.Response "Error"
+// @Router /admin/orders/{id} [get]
+func (h *OrderHandler) GetByID(ctx *fiber.Ctx) error {
+	order, err := h.usecase.GetByID(ctx.Context(), ctx.Params("id"))
+	if err != nil {
+		return err
+	}
+
+	return ctx.Status(200).JSON(fiber.Map{
+		"status": "success",
+		"data":   h.converter.ToOrderResponse(order, order_entity.ActorManager),
+	})
+}
+
+// ChangeStatus godoc
+// @Summary Change order status
+// @Description Move the order along its lifecycle: new → confirmed → paid → assembling → shipped → delivered, or to cancelled / returned.
+// @Description tracking_number is accepted with the shipped status and is sent to the customer by email
+// @Tags orders-admin
+// @Accept json
+// @Produce json
+// @Param id path string true "Order ID"
+// @Param request body order_dto.ChangeOrderStatusRequest true "Target status"
+// @Success 200 {object} response.ResponseData{data=order_dto.OrderResponse} "OK"
+// @Failure 400 {object} response.Response "Invalid request"
+// @Failure 403 {object} response.Response "Transition is not permitted"
+// @Failure 404 {object} response.Response "Order not found"
+// @Failure 409 {object} response.Response "Transition is not allowed from the current status"
+// @Failure 500 {object} response.Response "Error"
+// @Router /admin/orders/{id}/status [post]
+func (h *OrderHandler) ChangeStatus(ctx *fiber.Ctx) error {
+	dto := new(order_dto.ChangeOrderStatusRequest)
+	dto.ID = ctx.Params("id")
+
+	change, err := utils.ParseAndValidate(ctx, dto, h.validator, h.converter.ToStatusChange, h.logger)
+	if err != nil {
+		return ctx.Status(400).JSON(fiber.Map{
+			"status":  "fail",
+			"message": err.Error(),
+		})
+	}
+
+	var order *order_entity.Order
+	if change.ToStatus == order_entity.OrderStatusShipped {
+		order, err = h.usecase.Ship(h.getCtxWithSession(ctx), change.OrderID, dto.TrackingNumber, change.Comment)
+	} else {
+		order, err = h.usecase.ChangeStatus(h.getCtxWithSession(ctx), change.OrderID, change.ToStatus, order_entity.ActorManager, change.Comment)
+	}
+	if err != nil {
+		return err
+	}
+
+	return ctx.Status(200).JSON(fiber.Map{
+		"status": "success",
+		"data":   h.converter.ToOrderResponse(order, order_entity.ActorManager),
+	})
+}
+
+// UpdateCertificateStatus godoc
+// @Summary Update certificate status
+// @Description Record SFR certificate verification and settlement for order lines: pending → verified → settled, or rejected.
+// @Description Without item_ids all certificate lines are updated. Rejected lines move to the card surcharge
+// @Tags orders-admin
+// @Accept json
+// @Produce json
+// @Param id path string true "Order ID"
+// @Param request body order_dto.UpdateCertificateStatusRequest true "Certificate status"
+// @Success 200 {object} response.ResponseData{data=order_dto.OrderResponse} "OK"
+// @Failure 400 {object} response.Response "Invalid request or not a certificate order"
+// @Failure 404 {object} response.Response "Order or item not found"
+// @Failure 409 {object} response.Response "Transition is not allowed"
+// @Failure 500 {object} response.Response "Error"
+// @Router /admin/orders/{id}/certificate [post]
+func (h *OrderHandler) UpdateCertificateStatus(ctx *fiber.Ctx) error {
+	dto := new(order_dto.UpdateCertificateStatusRequest)
+	dto.ID = ctx.Params("id")
+
+	update, err := utils.ParseAndValidate(ctx, dto, h.validator, h.converter.ToCertificateUpdate, h.logger)
+	if err != nil {
+		return ctx.Status(400).JSON(fiber.Map{
+			"status":  "fail",
+			"message": err.Error(),
+		})
+	}
+
+	itemIDs := make([]string, len(update.Items))
+	for i := range update.Items {
+		itemIDs[i] = update.Items[i].ID
+	}
+
+	order, err := h.usecase.UpdateCertificateStatus(h.getCtxWithSession(ctx), update.ID, itemIDs, order_entity.CertificateStatus(dto.Status))
+	if err != nil {
+		return err
+	}
+
+	return ctx.Status(200).JSON(fiber.Map{
+		"status": "success",
+		"data":   h.converter.ToOrderResponse(order, order_entity.ActorManager),
+	})
+}
+
+// GetOwnDocument godoc
+// @Summary Download order document
+// @Description PDF invoice (счет) or delivery act of an own order. The act is available after shipment
+// @Tags orders
+// @Produce application/pdf
+// @Param id path string true "Order ID"
+// @Param kind path string true "Document kind" Enums(invoice, act)
+// @Success 200 {file} file "PDF"
+// @Failure 400 {object} response.Response "Invalid document kind"
+// @Failure 404 {object} response.Response "Order not found"
+// @Failure 409 {object} response.Response "Document is not available yet"
+// @Router /orders/{id}/documents/{kind} [get]
+func (h *OrderHandler) GetOwnDocument(ctx *fiber.Ctx) error {
+	document, err := h.usecase.GetOwnDocument(h.getCtxWithSession(ctx), ctx.Params("id"), order_entity.DocumentKind(ctx.Params("kind")))
+	if err != nil {
+		return err
+	}
+
+	return h.sendDocument(ctx, document)
+}
+
+// GetDocument godoc
+// @Summary Download order document
+// @Description PDF invoice or delivery act of any order
+// @Tags orders-admin
+// @Produce application/pdf
+// @Param id path string true "Order ID"
+// @Param kind path string true "Document kind" Enums(invoice, act)
+// @Success 200 {file} file "PDF"
+// @Failure 400 {object} response.Response "Invalid document kind"
+// @Failure 404 {object} response.Response "Order not found"
+// @Failure 409 {object} response.Response "Document is not available yet"
+// @Router /admin/orders/{id}/documents/{kind} [get]
+func (h *OrderHandler) GetDocument(ctx *fiber.Ctx) error {
+	document, err := h.usecase.GetDocument(ctx.Context(), ctx.Params("id"), order_entity.DocumentKind(ctx.Params("kind")))
+	if err != nil {
+		return err
+	}
+
+	return h.sendDocument(ctx, document)
+}
+
+// RegenerateDocument godoc
+// @Summary Regenerate order document
+// @Description Generate the document again from current order and seller data, replacing the stored PDF
+// @Tags orders-admin
+// @Produce json
+// @Param id path string true "Order ID"
+// @Param kind path string true "Document kind" Enums(invoice, act)
+// @Success 200 {object} response.ResponseData{data=order_dto.DocumentResponse} "OK"
+// @Failure 400 {object} response.Response "Invalid document kind"
+// @Failure 404 {object} response.Response "Order not found"
+// @Failure 409 {object} response.Response "Document is not available yet"
+// @Router /admin/orders/{id}/documents/{kind} [post]
+func (h *OrderHandler) RegenerateDocument(ctx *fiber.Ctx) error {
+	document, err := h.usecase.RegenerateDocument(ctx.Context(), ctx.Params("id"), order_entity.DocumentKind(ctx.Params("kind")))
+	if err != nil {
+		return err
+	}
+
+	return ctx.Status(200).JSON(fiber.Map{
+		"status": "success",
+		"data":   h.converter.ToDocumentResponse(document),
+	})
+}
+
+// GetNotifications godoc
+// @Summary Get order emails
+// @Description History of emails sent to the customer about the order: event, template version, recipient and status.
+// @Description skipped - the order has no email, failed - the template could not be rendered
+// @Tags orders-admin
+// @Produce json
+// @Param id path string true "Order ID"
+// @Success 200 {object} response.ResponseData{data=[]order_dto.NotificationResponse} "OK"
+// @Failure 404 {object} response.Response "Order not found"
+// @Failure 500 {object} response.Response "Error"
+// @Router /admin/orders/{id}/notifications [get]
+func (h *OrderHandler) GetNotifications(ctx *fiber.Ctx) error {
+	notifications, err := h.usecase.GetNotifications(ctx.Context(), ctx.Params("id"))
+	if err != nil {
+		return err
+	}
+
+	return ctx.Status(200).JSON(fiber.Map{
+		"status": "success",
+		"data":   h.converter.ToNotificationResponses(notifications),
+	})
+}
+
+// ResendNotification godoc
+// @Summary Resend order email
+// @Description Send the email again with the same event and template version using current order data. The resend is recorded separately
+// @Tags orders-admin
+// @Produce json
+// @Param id path string true "Order ID"
+// @Param notification_id path string true "Notification ID"
+// @Success 200 {object} response.ResponseData{data=order_dto.NotificationResponse} "OK"
+// @Failure 404 {object} response.Response "Order or notification not found"
+// @Failure 500 {object} response.Response "Error"
+// @Router /admin/orders/{id}/notifications/{notification_id}/resend [post]
+func (h *OrderHandler) ResendNotification(ctx *fiber.Ctx) error {
+	notification, err := h.usecase.ResendNotification(ctx.Context(), ctx.Params("id"), ctx.Params("notification_id"))
+	if err != nil {
+		return err
+	}
+
+	return ctx.Status(200).JSON(fiber.Map{
+		"status": "success",
+		"data":   h.converter.ToNotificationResponse(notification),
+	})
+}
+
+// EditItems godoc
+// @Summary Edit order lines
+// @Description Change quantities of order lines before payment, quantity 0 removes the line. At least one line must remain.
+// @Description Line discounts and certificate coverage are reduced proportionally, delivery cost is kept.
+// @Description The change is recorded in the order history, an issued invoice is generated again
+// @Tags orders-admin
+// @Accept json
+// @Produce json
+// @Param id path string true "Order ID"
+// @Param request body order_dto.EditOrderItemsRequest true "New quantities"
+// @Success 200 {object} response.ResponseData{data=order_dto.OrderResponse} "OK"
+// @Failure 400 {object} response.Response "Invalid request, duplicate line or no lines left"
+// @Failure 404 {object} response.Response "Order or line not found"
+// @Failure 409 {object} response.Response "Order is already paid, its payment is in progress or surcharge is paid"
+// @Failure 500 {object} response.Response "Error"
+// @Router /admin/orders/{id}/items [put]
+func (h *OrderHandler) EditItems(ctx *fiber.Ctx) error {
+	dto := new(order_dto.EditOrderItemsRequest)
+	dto.ID = ctx.Params("id")
+
+	changes, err := utils.ParseAndValidate(ctx, dto, h.validator, h.converter.ToItemChanges, h.logger)
+	if err != nil {
+		return ctx.Status(400).JSON(fiber.Map{
+			"status":  "fail",
+			"message": err.Error(),
+		})
+	}
+
+	order, err := h.usecase.EditItems(h.getCtxWithSession(ctx), dto.ID, *changes, dto.Comment)
+	if err != nil {
+		return err
+	}
+
+	return ctx.Status(200).JSON(fiber.Map{
+		"status": "success",
+		"data":   h.converter.ToOrderResponse(order, order_entity.ActorManager),
+	})
+}
+
+// UpdateDelivery godoc
+// @Summary Change order delivery
+// @Description Change delivery data before shipment. Before payment the delivery method can be changed, the cost is recalculated and included in the total.
+// @Description After payment only the address and postal code can be changed
+// @Tags orders-admin
+// @Accept json
+// @Produce json
+// @Param id path string true "Order ID"
+// @Param request body order_dto.UpdateOrderDeliveryRequest true "Delivery data"
+// @Success 200 {object} response.ResponseData{data=order_dto.OrderResponse} "OK"
+// @Failure 400 {object} response.Response "Invalid request, delivery method not available or address required"
+// @Failure 404 {object} response.Response "Order or delivery method not found"
+// @Failure 409 {object} response.Response "Order is already shipped, delivery method of a paid order cannot be changed or its payment is in progress"
+// @Failure 500 {object} response.Response "Error"
+// @Router /admin/orders/{id}/delivery [put]
+func (h *OrderHandler) UpdateDelivery(ctx *fiber.Ctx) error {
+	dto := new(order_dto.UpdateOrderDeliveryRequest)
+	dto.ID = ctx.Params("id")
+
+	delivery, err := utils.ParseAndValidate(ctx, dto, h.validator, h.converter.ToDeliveryUpdate, h.logger)
+	if err != nil {
+		return ctx.Status(400).JSON(fiber.Map{
+			"status":  "fail",
+			"message": err.Error(),
+		})
+	}
+
+	order, err := h.usecase.UpdateDelivery(h.getCtxWithSession(ctx), dto.ID, delivery, dto.Comment)
+	if err != nil {
+		return err
+	}
+
+	return ctx.Status(200).JSON(fiber.Map{
+		"status": "success",
+		"data":   h.converter.ToOrderResponse(order, order_entity.ActorManager),
+	})
+}
+
+// AssignManager godoc
+// @Summary Assign responsible manager
+// @Description Set the employee responsible for the order, an empty manager_id removes the assignment
+// @Tags orders-admin
+// @Accept json
+// @Produce json
+// @Param id path string true "Order ID"
+// @Param request body order_dto.AssignManagerRequest true "Manager"
+// @Success 200 {object} response.ResponseData{data=order_dto.OrderResponse} "OK"
+// @Failure 400 {object} response.Response "Invalid request"
+// @Failure 404 {object} response.Response "Order or manager not found"
+// @Failure 500 {object} response.Response "Error"
+// @Router /admin/orders/{id}/manager [put]
+func (h *OrderHandler) AssignManager(ctx *fiber.Ctx) error {
+	dto := new(order_dto.AssignManagerRequest)
+	dto.ID = ctx.Params("id")
+
+	managerID, err := utils.ParseAndValidate(ctx, dto, h.validator, h.converter.ToManagerID, h.logger)
+	if err != nil {
+		return ctx.Status(400).JSON(fiber.Map{
+			"status":  "fail",
+			"message": err.Error(),
+		})
+	}
+
+	order, err := h.usecase.AssignManager(h.getCtxWithSession(ctx), dto.ID, *managerID)
+	if err != nil {
+		return err
+	}
+
+	return ctx.Status(200).JSON(fiber.Map{
+		"status": "success",
+		"data":   h.converter.ToOrderResponse(order, order_entity.ActorManager),
+	})
+}
+
+// GetComments godoc
+// @Summary Get internal comments
+// @Description Internal comments of employees on the order, oldest first. Customers do not see them
+// @Tags orders-admin
+// @Produce json
+// @Param id path string true "Order ID"
+// @Success 200 {object} response.ResponseData{data=[]order_dto.OrderCommentResponse} "OK"
+// @Failure 404 {object} response.Response "Order not found"
+// @Failure 500 {object} response.Response "Error"
+// @Router /admin/orders/{id}/comments [get]
+func (h *OrderHandler) GetComments(ctx *fiber.Ctx) error {
+	comments, err := h.usecase.GetComments(ctx.Context(), ctx.Params("id"))
+	if err != nil {
+		return err
+	}
+
+	return ctx.Status(200).JSON(fiber.Map{
+		"status": "success",
+		"data":   h.converter.ToCommentResponses(comments),
+	})
+}
+
+// AddComment godoc
+// @Summary Add internal comment
+// @Description Add an internal comment to the order on behalf of the session user
+// @Tags orders-admin
+// @Accept json
+// @Produce json
+// @Param id path string true "Order ID"
+// @Param request body order_dto.OrderCommentRequest true "Comment"
+// @Success 201 {object} response.ResponseData{data=order_dto.OrderCommentResponse} "Created"
+// @Failure 400 {object} response.Response "Invalid request"
+// @Failure 404 {object} response.Response "Order not found"
+// @Failure 500 {object} response.Response "Error"
+// @Router /admin/orders/{id}/comments [post]
+func (h *OrderHandler) AddComment(ctx *fiber.Ctx) error {
+	dto := new(order_dto.OrderCommentRequest)
+	dto.ID = ctx.Params("id")
+
+	text, err := utils.ParseAndValidate(ctx, dto, h.validator, h.converter.ToCommentText, h.logger)
+	if err != nil {
+		return ctx.Status(400).JSON(fiber.Map{
+			"status":  "fail",
+			"message": err.Error(),
+		})
+	}
+
+	comment, err := h.usecase.AddComment(h.getCtxWithSession(ctx), dto.ID, *text)
+	if err != nil {
+		return err
+	}
+
+	return ctx.Status(201).JSON(fiber.Map{
+		"status": "success",
+		"data":   h.converter.ToCommentResponse(comment),
+	})
+}
+
+// BulkChangeStatus godoc
+// @Summary Change status of several orders
+// @Description Move up to 100 orders to the status one by one. A failed order does not stop the others,
+// @Description the result lists the new status or the error for every order
+// @Tags orders-admin
+// @Accept json
+// @Produce json
+// @Param request body order_dto.BulkOrderStatusRequest true "Orders and target status"
+// @Success 200 {object} response.ResponseData{data=[]order_dto.BulkOrderStatusResponse} "OK"
+// @Failure 400 {object} response.Response "Invalid request"
+// @Failure 500 {object} response.Response "Error"
+// @Router /admin/orders/status [post]
+func (h *OrderHandler) BulkChangeStatus(ctx *fiber.Ctx) error {
+	dto := new(order_dto.BulkOrderStatusRequest)
+
+	status, err := utils.ParseAndValidate(ctx, dto, h.validator, h.converter.ToBulkStatus, h.logger)
+	if err != nil {
+		return ctx.Status(400).JSON(fiber.Map{
+			"status":  "fail",
+			"message": err.Error(),
+		})
+	}
+
+	results := h.usecase.BulkChangeStatus(h.getCtxWithSession(ctx), dto.IDs, *status, dto.Comment)
+
+	return ctx.Status(200).JSON(fiber.Map{
+		"status": "success",
+		"data":   h.converter.ToBulkStatusResponses(results),
+	})
+}
+
+func (h *OrderHandler) sendDocument(ctx *fiber.Ctx, document *order_entity.Document) error {
+	ctx.Set("Content-Type", "application/pdf")
+	ctx.Set("Content-Disposition", fmt.Sprintf("attachment; filename=%s-%s.pdf", document.Kind, document.Number))
+	return ctx.Status(200).Send(document.Data)
+}
+
+func (h *OrderHandler) getCtxWithSession(ctx *fiber.Ctx) context.Context {
+	sess := session.FromFiberContext(ctx)
+	if sess == nil {
+		return ctx.Context()
+	}
+
+	return context.WithValue(ctx.Context(), "session", *sess)
+}
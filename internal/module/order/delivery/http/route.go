@@ -0,0 +1,33 @@
+package order_http
+
+import (
+	"github.com/Fi44er/sdmed/internal/middlewares"
+	"github.com/gofiber/fiber/v2"
+)
+
+func (h *OrderHandler) RegisterRoutes(router fiber.Router) {
+	orders := router.Group("/orders")
+	orders.Post("/", h.Create)
+	orders.Get("/", middlewares.RequireAuth(), h.GetOwn)
+	orders.Get("/delivery-quotes", h.GetDeliveryQuotes)
+	orders.Get("/:id", h.GetOwnByID)
+	orders.Post("/:id/cancel", h.Cancel)
+	orders.Post("/:id/repeat", middlewares.RequireAuth(), h.Repeat)
+	orders.Get("/:id/documents/:kind", h.GetOwnDocument)
+
+	admin := router.Group("/admin/orders", middlewares.Authorize("order", "read"))
+	admin.Get("/", h.GetAll)
+	admin.Post("/status", middlewares.Authorize("order", "update"), h.BulkChangeStatus)
+	admin.Get("/:id", h.GetByID)
+	admin.Post("/:id/status", middlewares.Authorize("order", "update"), h.ChangeStatus)
+	admin.Post("/:id/certificate", middlewares.Authorize("order", "update"), h.UpdateCertificateStatus)
+	admin.Put("/:id/items", middlewares.Authorize("order", "update"), h.EditItems)
+	admin.Put("/:id/delivery", middlewares.Authorize("order", "update"), h.UpdateDelivery)
+	admin.Put("/:id/manager", middlewares.Authorize("order", "assign"), h.AssignManager)
+	admin.Get("/:id/comments", h.GetComments)
+	admin.Post("/:id/comments", middlewares.Authorize("order", "update"), h.AddComment)
+	admin.Get("/:id/documents/:kind", h.GetDocument)
+	admin.Post("/:id/documents/:kind", middlewares.Authorize("order", "update"), h.RegenerateDocument)
+	admin.Get("/:id/notifications", h.GetNotifications)
+	admin.Post("/:id/notifications/:notification_id/resend", middlewares.Authorize("order", "update"), h.ResendNotification)
+}
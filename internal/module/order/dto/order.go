@@ -0,0 +1,246 @@
+package order_dto
+
+import "time"
+
+type CreateOrderRequest struct {
+	ContactName      string `json:"contact_name" validate:"required,max=255"`
+	ContactPhone     string `json:"contact_phone" validate:"required,max=20"`
+	ContactEmail     string `json:"contact_email" validate:"omitempty,email"`
+	DeliveryMethodID string `json:"delivery_method_id" validate:"required,uuid"`
+	DeliveryAddress  string `json:"delivery_address" validate:"max=1000"`
+	PostalCode       string `json:"postal_code" validate:"omitempty,numeric,len=6"`
+	Comment          string `json:"comment" validate:"max=1000"`
+	PromoCode        string `json:"promo_code" validate:"max=50"`
+
+	// AddressID - сохраненный адрес; Address - новый адрес, который сохранится в адресную книгу
+	AddressID string               `json:"address_id" validate:"omitempty,uuid,excluded_with=Address"`
+	Address   *OrderAddressRequest `json:"address" validate:"omitempty"`
+
+	PaymentMethod     string `json:"payment_method" validate:"omitempty,oneof=card certificate"`
+	CertificateNumber string `json:"certificate_number" validate:"required_if=PaymentMethod certificate,max=40"`
+	SNILS             string `json:"snils" validate:"required_if=PaymentMethod certificate,max=20"`
+}
+
+type OrderAddressRequest struct {
+	Label          string `json:"label" validate:"max=100"`
+	RegionID       string `json:"region_id" validate:"omitempty,uuid"`
+	City           string `json:"city" validate:"required,max=255"`
+	Street         string `json:"street" validate:"required,max=255"`
+	House          string `json:"house" validate:"required,max=50"`
+	Apartment      string `json:"apartment" validate:"max=50"`
+	PostalCode     string `json:"postal_code" validate:"omitempty,numeric,len=6"`
+	RecipientName  string `json:"recipient_name" validate:"required,max=255"`
+	RecipientPhone string `json:"recipient_phone" validate:"required,max=20"`
+}
+
+type CancelOrderRequest struct {
+	Comment string `json:"comment" validate:"max=1000"`
+}
+
+type ChangeOrderStatusRequest struct {
+	ID      string `json:"-" validate:"required"`
+	Status  string `json:"status" validate:"required,oneof=new confirmed paid assembling shipped delivered cancelled returned"`
+	Comment string `json:"comment" validate:"max=1000"`
+	// TrackingNumber - трек-номер отправления, только для статуса shipped
+	TrackingNumber string `json:"tracking_number" validate:"excluded_unless=Status shipped,max=100"`
+}
+
+type UpdateCertificateStatusRequest struct {
+	ID      string   `json:"-" validate:"required"`
+	Status  string   `json:"status" validate:"required,oneof=verified rejected settled"`
+	ItemIDs []string `json:"item_ids" validate:"omitempty,dive,uuid"`
+}
+
+type DeliveryQuotesQueryParams struct {
+	PostalCode string `query:"postal_code"`
+}
+
+type DeliveryQuoteResponse struct {
+	MethodID        string    `json:"method_id"`
+	MethodName      string    `json:"method_name"`
+	Type            string    `json:"type"`
+	Cost            float64   `json:"cost"`
+	MinDays         int       `json:"min_days"`
+	MaxDays         int       `json:"max_days"`
+	EstimatedFrom   time.Time `json:"estimated_from"`
+	EstimatedTo     time.Time `json:"estimated_to"`
+	RequiresAddress bool      `json:"requires_address"`
+}
+
+type DeliveryResponse struct {
+	Method        string     `json:"method"`
+	MethodID      string     `json:"method_id,omitempty"`
+	MethodName    string     `json:"method_name,omitempty"`
+	AddressID     string     `json:"address_id,omitempty"`
+	Address       string     `json:"address,omitempty"`
+	PostalCode    string     `json:"postal_code,omitempty"`
+	Cost          float64    `json:"cost"`
+	EstimatedFrom *time.Time `json:"estimated_from,omitempty"`
+	EstimatedTo   *time.Time `json:"estimated_to,omitempty"`
+
+	TrackingNumber string `json:"tracking_number,omitempty"`
+}
+
+type OrderQueryParams struct {
+	Status        string `query:"status"`
+	UserID        string `query:"user_id"`
+	PaymentMethod string `query:"payment_method"`
+	ManagerID     string `query:"manager_id" validate:"omitempty,uuid"`
+	// Number - начало номера заказа, Email, Phone и Article ищутся по вхождению
+	Number   string `query:"number" validate:"max=40"`
+	Email    string `query:"email" validate:"max=255"`
+	Phone    string `query:"phone" validate:"max=20"`
+	Article  string `query:"article" validate:"max=100"`
+	DateFrom string `query:"date_from" validate:"omitempty,datetime=2006-01-02"`
+	DateTo   string `query:"date_to" validate:"omitempty,datetime=2006-01-02"`
+	Page     int    `query:"page"`
+	PageSize int    `query:"page_size"`
+}
+
+type OrderItemResponse struct {
+	ID        string  `json:"id"`
+	ProductID string  `json:"product_id"`
+	Name      string  `json:"name"`
+	Article   string  `json:"article"`
+	Quantity  int     `json:"quantity"`
+	Price     float64 `json:"price"`
+	Sum       float64 `json:"sum"`
+	Discount  float64 `json:"discount"`
+	Total     float64 `json:"total"`
+
+	TRUCode           string  `json:"tru_code,omitempty"`
+	CertificateAmount float64 `json:"certificate_amount,omitempty"`
+	CertificateStatus string  `json:"certificate_status,omitempty"`
+}
+
+type CertificateResponse struct {
+	Number string `json:"number"`
+	SNILS  string `json:"snils"`
+}
+
+type StatusChangeResponse struct {
+	FromStatus string    `json:"from_status,omitempty"`
+	ToStatus   string    `json:"to_status"`
+	Actor      string    `json:"actor"`
+	ActorID    string    `json:"actor_id,omitempty"`
+	Comment    string    `json:"comment,omitempty"`
+	CreatedAt  time.Time `json:"created_at"`
+}
+
+type OrderResponse struct {
+	ID              string              `json:"id"`
+	UserID          string              `json:"user_id"`
+	Status          string              `json:"status"`
+	NextStatuses    []string            `json:"next_statuses"`
+	Items           []OrderItemResponse `json:"items"`
+	Discount        float64             `json:"discount"`
+	PromoCode       string              `json:"promo_code,omitempty"`
+	Total           float64             `json:"total"`
+	ContactName     string              `json:"contact_name"`
+	ContactPhone    string              `json:"contact_phone"`
+	ContactEmail    string              `json:"contact_email,omitempty"`
+	DeliveryMethod  string              `json:"delivery_method"`
+	DeliveryAddress string              `json:"delivery_address,omitempty"`
+	Delivery        DeliveryResponse    `json:"delivery"`
+	Comment         string              `json:"comment,omitempty"`
+	ManagerID       string              `json:"manager_id,omitempty"`
+
+	PaymentMethod     string               `json:"payment_method"`
+	Certificate       *CertificateResponse `json:"certificate,omitempty"`
+	CertificateAmount float64              `json:"certificate_amount"`
+	Surcharge         float64              `json:"surcharge"`
+	SurchargePaid     float64              `json:"surcharge_paid"`
+	SurchargePaidAt   *time.Time           `json:"surcharge_paid_at,omitempty"`
+	AmountDue         float64              `json:"amount_due"`
+
+	Documents []string               `json:"documents"`
+	History   []StatusChangeResponse `json:"history,omitempty"`
+	CreatedAt time.Time              `json:"created_at"`
+	UpdatedAt time.Time              `json:"updated_at"`
+}
+
+type NotificationResponse struct {
+	ID              string    `json:"id"`
+	Event           string    `json:"event"`
+	TemplateVersion string    `json:"template_version"`
+	Recipient       string    `json:"recipient"`
+	Subject         string    `json:"subject"`
+	Status          string    `json:"status"`
+	Error           string    `json:"error,omitempty"`
+	ResendOfID      string    `json:"resend_of_id,omitempty"`
+	CreatedAt       time.Time `json:"created_at"`
+}
+
+type DocumentResponse struct {
+	Kind      string    `json:"kind"`
+	Number    string    `json:"number"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+type RepeatItemResponse struct {
+	ProductID          string  `json:"product_id"`
+	Name               string  `json:"name"`
+	Quantity           int     `json:"quantity"`
+	OrderedQuantity    int     `json:"ordered_quantity"`
+	PartiallyAvailable bool    `json:"partially_available"`
+	OrderPrice         float64 `json:"order_price"`
+	Price              float64 `json:"price,omitempty"`
+	PriceChanged       bool    `json:"price_changed"`
+	Reason             string  `json:"reason,omitempty"`
+}
+
+type RepeatOrderResponse struct {
+	OrderID     string               `json:"order_id"`
+	Added       []RepeatItemResponse `json:"added"`
+	Unavailable []RepeatItemResponse `json:"unavailable"`
+}
+
+type EditOrderItemsRequest struct {
+	ID      string                 `json:"-" validate:"required"`
+	Items   []EditOrderItemRequest `json:"items" validate:"required,min=1,dive"`
+	Comment string                 `json:"comment" validate:"max=1000"`
+}
+
+// EditOrderItemRequest - новое количество позиции, 0 удаляет позицию из заказа
+type EditOrderItemRequest struct {
+	ItemID   string `json:"item_id" validate:"required,uuid"`
+	Quantity int    `json:"quantity" validate:"min=0,max=10000"`
+}
+
+type UpdateOrderDeliveryRequest struct {
+	ID               string `json:"-" validate:"required"`
+	DeliveryMethodID string `json:"delivery_method_id" validate:"omitempty,uuid"`
+	DeliveryAddress  string `json:"delivery_address" validate:"max=1000"`
+	PostalCode       string `json:"postal_code" validate:"omitempty,numeric,len=6"`
+	Comment          string `json:"comment" validate:"max=1000"`
+}
+
+// AssignManagerRequest - пустой manager_id снимает ответственного
+type AssignManagerRequest struct {
+	ID        string `json:"-" validate:"required"`
+	ManagerID string `json:"manager_id" validate:"omitempty,uuid"`
+}
+
+type OrderCommentRequest struct {
+	ID   string `json:"-" validate:"required"`
+	Text string `json:"text" validate:"required,max=2000"`
+}
+
+type OrderCommentResponse struct {
+	ID        string    `json:"id"`
+	AuthorID  string    `json:"author_id,omitempty"`
+	Text      string    `json:"text"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+type BulkOrderStatusRequest struct {
+	IDs     []string `json:"ids" validate:"required,min=1,max=100,unique,dive,uuid"`
+	Status  string   `json:"status" validate:"required,oneof=new confirmed paid assembling shipped delivered cancelled returned"`
+	Comment string   `json:"comment" validate:"max=1000"`
+}
+
+type BulkOrderStatusResponse struct {
+	OrderID string `json:"order_id"`
+	Status  string `json:"status,omitempty"`
+	Error   string `json:"error,omitempty"`
+}
@@ -0,0 +1,16 @@
+package order_entity
+
+// Address - адрес из адресной книги покупателя. Formatted - строка адреса для заказа и документов
+type Address struct {
+	ID             string
+	Label          string
+	RegionID       string
+	City           string
+	Street         string
+	House          string
+	Apartment      string
+	PostalCode     string
+	RecipientName  string
+	RecipientPhone string
+	Formatted      string
+}
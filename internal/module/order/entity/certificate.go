@@ -0,0 +1,110 @@
+package order_entity
+
+import (
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+type PaymentMethod string
+
+const (
+	PaymentMethodCard PaymentMethod = "card"
+	// PaymentMethodCertificate - электронный сертификат СФР, остаток доплачивается картой
+	PaymentMethodCertificate PaymentMethod = "certificate"
+)
+
+// CertificateStatus - статус проверки и погашения сертификата по позиции заказа
+type CertificateStatus string
+
+const (
+	// CertificateStatusNone - позиция не покрывается сертификатом
+	CertificateStatusNone     CertificateStatus = ""
+	CertificateStatusPending  CertificateStatus = "pending"
+	CertificateStatusVerified CertificateStatus = "verified"
+	CertificateStatusRejected CertificateStatus = "rejected"
+	CertificateStatusSettled  CertificateStatus = "settled"
+)
+
+var certificateTransitions = map[CertificateStatus][]CertificateStatus{
+	CertificateStatusPending:  {CertificateStatusVerified, CertificateStatusRejected},
+	CertificateStatusVerified: {CertificateStatusSettled, CertificateStatusRejected},
+}
+
+// Certificate - электронный сертификат СФР и СНИЛС получателя
+type Certificate struct {
+	Number string
+	SNILS  string
+}
+
+// Reimbursement - цена возмещения по КТРУ для товара в регионе заказа
+type Reimbursement struct {
+	TRUCodeID string
+	Code      string
+	Price     float64
+}
+
+func (s CertificateStatus) IsValid() bool {
+	switch s {
+	case CertificateStatusPending, CertificateStatusVerified, CertificateStatusRejected, CertificateStatusSettled:
+		return true
+	}
+	return false
+}
+
+func CanChangeCertificateStatus(from, to CertificateStatus) bool {
+	for _, allowed := range certificateTransitions[from] {
+		if allowed == to {
+			return true
+		}
+	}
+	return false
+}
+
+// NormalizeCertificateNumber убирает пробелы и дефисы, номер должен состоять из цифр
+func NormalizeCertificateNumber(value string) (string, bool) {
+	number := stripSeparators(value)
+	if number == "" || len(number) > 30 {
+		return "", false
+	}
+	for _, r := range number {
+		if !unicode.IsDigit(r) {
+			return "", false
+		}
+	}
+	return number, true
+}
+
+// NormalizeSNILS приводит СНИЛС к виду XXX-XXX-XXX YY и проверяет контрольное число
+func NormalizeSNILS(value string) (string, bool) {
+	digits := stripSeparators(value)
+	if len(digits) != 11 {
+		return "", false
+	}
+	if _, err := strconv.ParseUint(digits, 10, 64); err != nil {
+		return "", false
+	}
+
+	sum := 0
+	for i := 0; i < 9; i++ {
+		sum += int(digits[i]-'0') * (9 - i)
+	}
+	checksum := sum % 101
+	if checksum == 100 {
+		checksum = 0
+	}
+	if expected, _ := strconv.Atoi(digits[9:]); expected != checksum {
+		return "", false
+	}
+
+	return digits[0:3] + "-" + digits[3:6] + "-" + digits[6:9] + " " + digits[9:], true
+}
+
+func stripSeparators(value string) string {
+	return strings.Map(func(r rune) rune {
+		if r == ' ' || r == '-' || r == '\u00a0' {
+			return -1
+		}
+		return r
+	}, value)
+}
@@ -0,0 +1,112 @@
+package order_entity
+
+import (
+	"testing"
+)
+
+func TestNormalizeSNILS(t *testing.T) {
+	tests := []struct {
+		value string
+		want  string
+		ok    bool
+	}{
+		{"112-233-445 95", "112-233-445 95", true},
+		{"11223344595", "112-233-445 95", true},
+		{"112-233-445 96", "", false},
+		{"112-233-445", "", false},
+		{"abc-def-ghi jk", "", false},
+	}
+
+	for _, tt := range tests {
+		got, ok := NormalizeSNILS(tt.value)
+		if got != tt.want || ok != tt.ok {
+			t.Errorf("NormalizeSNILS(%q) = %q, %v, want %q, %v", tt.value, got, ok, tt.want, tt.ok)
+		}
+	}
+}
+
+func TestApplyCertificate(t *testing.T) {
+	order := &Order{
+		PaymentMethod: PaymentMethodCertificate,
+		Items: []OrderItem{
+			{ID: "wheelchair", ProductID: "p1", Quantity: 1, Price: 30000},
+			{ID: "diapers", ProductID: "p2", Quantity: 3, Price: 900},
+			{ID: "cane", ProductID: "p3", Quantity: 1, Price: 1500},
+		},
+	}
+	order.CalculateTotal()
+
+	order.ApplyCertificate(map[string]Reimbursement{
+		"p1": {TRUCodeID: "c1", Code: "32.50.22.121-00000001", Price: 25000},
+		"p2": {TRUCodeID: "c2", Code: "17.22.12.130-00000002", Price: 1000},
+	})
+
+	if order.Total != 34200 || order.CertificateAmount != 27700 || order.AmountDue() != 6500 {
+		t.Fatalf("total %.2f, certificate %.2f, due %.2f", order.Total, order.CertificateAmount, order.AmountDue())
+	}
+	if status := order.FindItem("cane").CertificateStatus; status != CertificateStatusNone {
+		t.Errorf("item without reimbursement has certificate status %q", status)
+	}
+
+	order.FindItem("diapers").CertificateStatus = CertificateStatusRejected
+	order.CalculateCertificateAmount()
+	if order.AmountDue() != 9200 {
+		t.Errorf("rejected line must move to surcharge, due %.2f", order.AmountDue())
+	}
+
+	order.FindItem("wheelchair").CertificateStatus = CertificateStatusSettled
+	if !order.IsCertificateSettled() {
+		t.Error("order with all remaining lines settled must be settled")
+	}
+	if order.IsFullyPaid() {
+		t.Error("order with unpaid surcharge must not be paid")
+	}
+
+	order.SurchargePaid = 9200
+	if !order.IsFullyPaid() || order.AmountDue() != 0 {
+		t.Errorf("settled order with paid surcharge must be paid, due %.2f", order.AmountDue())
+	}
+}
+
+func TestSurchargeRisesAfterPayment(t *testing.T) {
+	order := &Order{
+		PaymentMethod: PaymentMethodCertificate,
+		SurchargePaid: 5000,
+		Items: []OrderItem{
+			{ID: "wheelchair", Quantity: 1, Price: 30000, CertificateAmount: 25000, CertificateStatus: CertificateStatusSettled},
+			{ID: "diapers", Quantity: 3, Price: 900, CertificateAmount: 2700, CertificateStatus: CertificateStatusVerified},
+		},
+	}
+	order.CalculateTotal()
+	order.CalculateCertificateAmount()
+	if order.AmountDue() != 0 {
+		t.Fatalf("paid surcharge must not be due again, due %.2f", order.AmountDue())
+	}
+
+	order.FindItem("diapers").CertificateStatus = CertificateStatusRejected
+	order.CalculateCertificateAmount()
+	if order.IsFullyPaid() || order.AmountDue() != 2700 {
+		t.Errorf("line rejected after payment must be paid by card, due %.2f", order.AmountDue())
+	}
+
+	order.SurchargePaid += 2700
+	if !order.IsFullyPaid() {
+		t.Errorf("order with the rest of surcharge paid must be paid, due %.2f", order.AmountDue())
+	}
+}
+
+func TestIsFullyPaidWaitsForCertificate(t *testing.T) {
+	order := &Order{
+		PaymentMethod: PaymentMethodCertificate,
+		SurchargePaid: 5000,
+		Items: []OrderItem{
+			{ID: "wheelchair", Quantity: 1, Price: 30000, CertificateAmount: 25000, CertificateStatus: CertificateStatusVerified},
+		},
+	}
+	order.CalculateTotal()
+	order.CalculateCertificateAmount()
+
+	if order.IsFullyPaid() {
+		t.Error("order with paid surcharge and unsettled certificate must not be paid")
+	}
+}
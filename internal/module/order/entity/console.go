@@ -0,0 +1,57 @@
+package order_entity
+
+import (
+	"math"
+	"time"
+)
+
+// InternalComment - комментарий сотрудника к заказу, покупателю не показывается
+type InternalComment struct {
+	ID        string
+	OrderID   string
+	AuthorID  string
+	Text      string
+	CreatedAt time.Time
+}
+
+// ItemChange - новое количество позиции заказа, 0 удаляет позицию
+type ItemChange struct {
+	ItemID   string
+	Quantity int
+}
+
+// StatusChangeResult - результат смены статуса одного заказа при массовой смене
+type StatusChangeResult struct {
+	OrderID string
+	Status  OrderStatus
+	Err     error
+}
+
+// ChangeQuantity меняет количество позиции. Скидка и покрытие сертификатом
+// пересчитываются пропорционально количеству, так что правка не дает новых скидок
+func (i *OrderItem) ChangeQuantity(quantity int) {
+	if i.Quantity == quantity {
+		return
+	}
+	if i.Quantity > 0 {
+		ratio := float64(quantity) / float64(i.Quantity)
+		i.Discount = math.Round(i.Discount*ratio*100) / 100
+		i.CertificateAmount = math.Round(i.CertificateAmount*ratio*100) / 100
+	}
+	i.Quantity = quantity
+}
+
+// RemoveEmptyItems убирает позиции с нулевым количеством и возвращает их ID
+func (o *Order) RemoveEmptyItems() []string {
+	var removed []string
+	items := o.Items[:0]
+	for _, item := range o.Items {
+		if item.Quantity == 0 {
+			removed = append(removed, item.ID)
+			continue
+		}
+		items = append(items, item)
+	}
+	o.Items = items
+	return removed
+}
@@ -0,0 +1,42 @@
+package order_entity
+
+import "testing"
+
+func TestOrderItemChangeQuantity(t *testing.T) {
+	item := &OrderItem{Quantity: 3, Price: 1000, Discount: 100, CertificateAmount: 2400}
+
+	item.ChangeQuantity(2)
+	if item.Quantity != 2 || item.Discount != 66.67 || item.CertificateAmount != 1600 {
+		t.Errorf("ChangeQuantity(2) = %+v, want quantity 2, discount 66.67, certificate amount 1600", item)
+	}
+
+	item.ChangeQuantity(4)
+	if item.Discount != 133.34 || item.CertificateAmount != 3200 {
+		t.Errorf("ChangeQuantity(4) = %+v, want discount 133.34, certificate amount 3200", item)
+	}
+}
+
+func TestOrderRemoveEmptyItems(t *testing.T) {
+	order := &Order{Items: []OrderItem{
+		{ID: "a", Quantity: 1},
+		{ID: "b", Quantity: 0},
+		{ID: "c", Quantity: 2},
+	}}
+
+	removed := order.RemoveEmptyItems()
+	if len(removed) != 1 || removed[0] != "b" {
+		t.Errorf("RemoveEmptyItems() = %v, want [b]", removed)
+	}
+	if len(order.Items) != 2 || order.Items[0].ID != "a" || order.Items[1].ID != "c" {
+		t.Errorf("items left: %+v, want a and c", order.Items)
+	}
+}
+
+func TestOrderStatusEditable(t *testing.T) {
+	if !OrderStatusConfirmed.IsEditable() || OrderStatusPaid.IsEditable() {
+		t.Error("items must be editable only before payment")
+	}
+	if !OrderStatusAssembling.IsDeliveryEditable() || OrderStatusShipped.IsDeliveryEditable() {
+		t.Error("delivery must be editable only before shipment")
+	}
+}
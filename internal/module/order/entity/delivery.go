@@ -0,0 +1,30 @@
+package order_entity
+
+import "time"
+
+// DeliveryQuote - предложение доставки, рассчитанное модулем доставки для корзины
+type DeliveryQuote struct {
+	MethodID        string
+	MethodName      string
+	Method          DeliveryMethod
+	Cost            float64
+	MinDays         int
+	MaxDays         int
+	EstimatedFrom   time.Time
+	EstimatedTo     time.Time
+	RequiresAddress bool
+}
+
+// ApplyDelivery сохраняет в заказе выбранное предложение доставки. Для самовывоза
+// адрес получателя не нужен и не сохраняется
+func (o *Order) ApplyDelivery(quote *DeliveryQuote) {
+	o.Delivery.Method = quote.Method
+	o.Delivery.MethodID = quote.MethodID
+	o.Delivery.MethodName = quote.MethodName
+	o.Delivery.Cost = quote.Cost
+	o.Delivery.EstimatedFrom = quote.EstimatedFrom
+	o.Delivery.EstimatedTo = quote.EstimatedTo
+	if !quote.RequiresAddress {
+		o.Delivery.Address = ""
+	}
+}
@@ -0,0 +1,41 @@
+package order_entity
+
+import "math"
+
+// Discount - расчет скидок по позициям заказа. Lines - скидки позиций в порядке Items
+type Discount struct {
+	PromoCodeID string
+	PromoCode   string
+	Lines       []float64
+	Amount      float64
+}
+
+// DiscountBase - часть суммы позиции, на которую действуют скидки: без покрытия сертификатом
+func (i *OrderItem) DiscountBase() float64 {
+	if i.CertificateStatus == CertificateStatusNone || i.CertificateStatus == CertificateStatusRejected {
+		return i.Sum()
+	}
+	return math.Max(math.Round((i.Sum()-i.CertificateAmount)*100)/100, 0)
+}
+
+// Total - сумма позиции со скидкой
+func (i *OrderItem) Total() float64 {
+	return math.Round((i.Sum()-i.Discount)*100) / 100
+}
+
+// CardAmount - часть суммы позиции, оплачиваемая картой: со скидкой и без покрытия сертификатом
+func (i *OrderItem) CardAmount() float64 {
+	return math.Max(math.Round((i.DiscountBase()-i.Discount)*100)/100, 0)
+}
+
+// ApplyDiscount сохраняет скидки позиций и промокод и пересчитывает сумму заказа
+func (o *Order) ApplyDiscount(discount *Discount) {
+	for i := range o.Items {
+		o.Items[i].Discount = 0
+		if i < len(discount.Lines) {
+			o.Items[i].Discount = discount.Lines[i]
+		}
+	}
+	o.PromoCodeID, o.PromoCode = discount.PromoCodeID, discount.PromoCode
+	o.CalculateTotal()
+}
@@ -0,0 +1,34 @@
+package order_entity
+
+import "testing"
+
+func TestApplyDiscount(t *testing.T) {
+	order := &Order{
+		PaymentMethod: PaymentMethodCertificate,
+		Items: []OrderItem{
+			{ID: "wheelchair", ProductID: "p1", Quantity: 1, Price: 30000},
+			{ID: "cane", ProductID: "p2", Quantity: 1, Price: 1500},
+		},
+		Delivery: Delivery{Cost: 500},
+	}
+	order.ApplyCertificate(map[string]Reimbursement{"p1": {TRUCodeID: "c1", Price: 25000}})
+
+	if base := order.FindItem("wheelchair").DiscountBase(); base != 5000 {
+		t.Fatalf("certificate-covered part must be excluded from discounts, base %.2f", base)
+	}
+
+	order.ApplyDiscount(&Discount{PromoCodeID: "promo", PromoCode: "SALE", Lines: []float64{500, 150}})
+
+	if order.Discount != 650 || order.Total != 31350 {
+		t.Errorf("discount %.2f, total %.2f", order.Discount, order.Total)
+	}
+	if order.CertificateAmount != 25000 || order.Surcharge() != 6350 {
+		t.Errorf("discount must reduce only the surcharge: certificate %.2f, surcharge %.2f", order.CertificateAmount, order.Surcharge())
+	}
+	if total := order.FindItem("cane").Total(); total != 1350 {
+		t.Errorf("line total %.2f", total)
+	}
+	if paid := order.FindItem("wheelchair").CardAmount(); paid != 4500 {
+		t.Errorf("card amount must exclude the certificate part and the discount, got %.2f", paid)
+	}
+}
@@ -0,0 +1,94 @@
+package order_entity
+
+import (
+	"strings"
+	"time"
+)
+
+type DocumentKind string
+
+const (
+	// DocumentKindInvoice - счет на оплату
+	DocumentKindInvoice DocumentKind = "invoice"
+	// DocumentKindAct - товарная накладная / акт приема-передачи
+	DocumentKindAct DocumentKind = "act"
+)
+
+// DocumentOwnerType - тип владельца файлов документов в файловом модуле
+const DocumentOwnerType = "order"
+
+func (k DocumentKind) IsValid() bool {
+	return k == DocumentKindInvoice || k == DocumentKindAct
+}
+
+// IsAvailableFor - счет выставляется на любой неотмененный заказ,
+// акт - только после отгрузки
+func (k DocumentKind) IsAvailableFor(status OrderStatus) bool {
+	switch k {
+	case DocumentKindInvoice:
+		return status != OrderStatusCancelled
+	case DocumentKindAct:
+		return status == OrderStatusShipped || status == OrderStatusDelivered
+	}
+	return false
+}
+
+// AvailableDocuments - документы, которые можно скачать по заказу в статусе status
+func AvailableDocuments(status OrderStatus) []DocumentKind {
+	var kinds []DocumentKind
+	for _, kind := range []DocumentKind{DocumentKindInvoice, DocumentKindAct} {
+		if kind.IsAvailableFor(status) {
+			kinds = append(kinds, kind)
+		}
+	}
+	return kinds
+}
+
+// Document - сформированный по заказу PDF. Сам файл хранится в файловом модуле,
+// Data заполняется только при выдаче
+type Document struct {
+	ID        string
+	OrderID   string
+	Kind      DocumentKind
+	Number    string
+	FileID    string
+	FileName  string
+	Data      []byte
+	CreatedAt time.Time
+}
+
+// Seller - реквизиты продавца для печатных форм
+type Seller struct {
+	Name        string
+	INN         string
+	KPP         string
+	Address     string
+	Bank        string
+	BIK         string
+	Account     string
+	CorrAccount string
+}
+
+// DocumentData - данные для заполнения шаблона документа
+type DocumentData struct {
+	Kind   DocumentKind
+	Number string
+	Date   time.Time
+	Seller Seller
+	Order  *Order
+}
+
+// DocumentNumber - номер документа по заказу: первые символы идентификатора заказа
+func DocumentNumber(orderID string) string {
+	number := strings.ReplaceAll(orderID, "-", "")
+	if len(number) > 8 {
+		number = number[:8]
+	}
+	return strings.ToUpper(number)
+}
+
+// NormalizeNumber приводит введенный номер заказа к виду для поиска по началу ID:
+// без дефисов и пробелов, в нижнем регистре
+func NormalizeNumber(number string) string {
+	return strings.ToLower(stripSeparators(number))
+}
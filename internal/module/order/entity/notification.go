@@ -0,0 +1,78 @@
+package order_entity
+
+import "time"
+
+// NotificationEvent - событие заказа, о котором покупателю отправляется письмо.
+// Имя события совпадает с именем файла шаблона
+type NotificationEvent string
+
+const (
+	NotificationEventCreated       NotificationEvent = "created"
+	NotificationEventPaid          NotificationEvent = "paid"
+	NotificationEventPaymentFailed NotificationEvent = "payment_failed"
+	NotificationEventShipped       NotificationEvent = "shipped"
+	NotificationEventDelivered     NotificationEvent = "delivered"
+	NotificationEventCancelled     NotificationEvent = "cancelled"
+)
+
+type NotificationStatus string
+
+const (
+	// NotificationStatusSent - письмо принято почтовым сервером
+	NotificationStatusSent NotificationStatus = "sent"
+	// NotificationStatusFailed - письмо не удалось сформировать или отправить
+	NotificationStatusFailed NotificationStatus = "failed"
+	// NotificationStatusSkipped - у заказа нет email получателя
+	NotificationStatusSkipped NotificationStatus = "skipped"
+)
+
+// statusEvents - статусы заказа, о переходе в которые покупатель получает письмо
+var statusEvents = map[OrderStatus]NotificationEvent{
+	OrderStatusPaid:      NotificationEventPaid,
+	OrderStatusShipped:   NotificationEventShipped,
+	OrderStatusDelivered: NotificationEventDelivered,
+	OrderStatusCancelled: NotificationEventCancelled,
+}
+
+func (e NotificationEvent) IsValid() bool {
+	switch e {
+	case NotificationEventCreated, NotificationEventPaid, NotificationEventPaymentFailed,
+		NotificationEventShipped, NotificationEventDelivered, NotificationEventCancelled:
+		return true
+	}
+	return false
+}
+
+// NotificationEventFor возвращает событие для перехода заказа в статус status
+func NotificationEventFor(status OrderStatus) (NotificationEvent, bool) {
+	event, ok := statusEvents[status]
+	return event, ok
+}
+
+// Notification - запись об отправке письма по заказу. ResendOfID указывает
+// на исходную запись, если письмо отправлено повторно
+type Notification struct {
+	ID              string
+	OrderID         string
+	Event           NotificationEvent
+	TemplateVersion string
+	Recipient       string
+	Subject         string
+	Status          NotificationStatus
+	Error           string
+	ResendOfID      string
+	CreatedAt       time.Time
+}
+
+// Email - письмо, сформированное по шаблону
+type Email struct {
+	Subject string
+	Body    string
+}
+
+// NotificationData - данные для шаблона письма
+type NotificationData struct {
+	Event  NotificationEvent
+	Number string
+	Order  *Order
+}
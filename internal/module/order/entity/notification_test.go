@@ -0,0 +1,27 @@
+package order_entity
+
+import "testing"
+
+func TestNotificationEventFor(t *testing.T) {
+	tests := []struct {
+		status OrderStatus
+		want   NotificationEvent
+		ok     bool
+	}{
+		{OrderStatusPaid, NotificationEventPaid, true},
+		{OrderStatusShipped, NotificationEventShipped, true},
+		{OrderStatusDelivered, NotificationEventDelivered, true},
+		{OrderStatusCancelled, NotificationEventCancelled, true},
+		{OrderStatusConfirmed, "", false},
+		{OrderStatusAssembling, "", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(string(tt.status), func(t *testing.T) {
+			got, ok := NotificationEventFor(tt.status)
+			if got != tt.want || ok != tt.ok {
+				t.Errorf("NotificationEventFor(%s) = %s, %v, want %s, %v", tt.status, got, ok, tt.want, tt.ok)
+			}
+		})
+	}
+}
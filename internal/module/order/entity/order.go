@@ -0,0 +1,236 @@
+package order_entity
+
+import (
+	"math"
+	"time"
+)
+
+type DeliveryMethod string
+
+const (
+	DeliveryMethodPickup    DeliveryMethod = "pickup"
+	DeliveryMethodCourier   DeliveryMethod = "courier"
+	DeliveryMethodTransport DeliveryMethod = "transport"
+)
+
+// Order - заказ, оформленный из корзины. Позиции хранят цену на момент оформления
+type Order struct {
+	ID     string
+	UserID string
+	Status OrderStatus
+	Items  []OrderItem
+	Total  float64
+	// Discount - сумма скидок по позициям, PromoCode - примененный промокод
+	Discount    float64
+	PromoCode   string
+	PromoCodeID string
+
+	Contact  Contact
+	Delivery Delivery
+	Comment  string
+	RegionID string
+	// ManagerID - ответственный сотрудник
+	ManagerID string
+
+	PaymentMethod PaymentMethod
+	Certificate   *Certificate
+	// CertificateAmount - часть суммы, покрываемая сертификатом, без отклоненных позиций
+	CertificateAmount float64
+	// SurchargePaid - сколько доплаты сверх сертификата покупатель оплатил картой
+	SurchargePaid float64
+	// SurchargePaidAt - когда пришла последняя оплата доплаты
+	SurchargePaidAt *time.Time
+
+	History   []StatusChange
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+type OrderItem struct {
+	ID        string
+	OrderID   string
+	ProductID string
+	Name      string
+	Article   string
+	Quantity  int
+	Price     float64
+	// Discount - скидка на позицию целиком, не затрагивает покрытие сертификатом
+	Discount float64
+
+	TRUCodeID         string
+	TRUCode           string
+	CertificateAmount float64
+	CertificateStatus CertificateStatus
+}
+
+// Contact - контактные данные получателя
+type Contact struct {
+	Name  string
+	Phone string
+	Email string
+}
+
+// Delivery - выбранный при оформлении способ доставки. Название, стоимость
+// и сроки сохраняются на момент оформления и не зависят от настроек модуля доставки
+type Delivery struct {
+	Method     DeliveryMethod
+	MethodID   string
+	MethodName string
+	// AddressID - сохраненный адрес покупателя, Address - его строка на момент оформления
+	AddressID string
+	// NewAddress - адрес, введенный при оформлении; сохраняется в адресную книгу покупателя
+	NewAddress    *Address
+	Address       string
+	PostalCode    string
+	Cost          float64
+	EstimatedFrom time.Time
+	EstimatedTo   time.Time
+	// TrackingNumber - трек-номер отправления, указывается при отгрузке
+	TrackingNumber string
+}
+
+// StatusChange - запись истории статусов заказа
+type StatusChange struct {
+	ID         string
+	OrderID    string
+	FromStatus OrderStatus
+	ToStatus   OrderStatus
+	Actor      Actor
+	ActorID    string
+	Comment    string
+	CreatedAt  time.Time
+}
+
+// OrderFilter - CreatedFrom и CreatedTo ограничивают дату создания, CreatedTo не включается.
+// Number - начало номера заказа, Email, Phone и Article ищутся по вхождению
+type OrderFilter struct {
+	Status        OrderStatus
+	UserID        string
+	ManagerID     string
+	PaymentMethod PaymentMethod
+	Number        string
+	Email         string
+	Phone         string
+	Article       string
+	CreatedFrom   time.Time
+	CreatedTo     time.Time
+	Offset        int
+	Limit         int
+}
+
+func (i *OrderItem) Sum() float64 {
+	return math.Round(i.Price*float64(i.Quantity)*100) / 100
+}
+
+// ItemsTotal - стоимость позиций без доставки
+func (o *Order) ItemsTotal() float64 {
+	total := 0.0
+	for i := range o.Items {
+		total += o.Items[i].Sum()
+	}
+	return math.Round(total*100) / 100
+}
+
+// CalculateTotal пересчитывает сумму заказа по позициям, скидкам и доставке
+func (o *Order) CalculateTotal() float64 {
+	discount := 0.0
+	for i := range o.Items {
+		discount += o.Items[i].Discount
+	}
+	o.Discount = math.Round(discount*100) / 100
+	o.Total = math.Round((o.ItemsTotal()-o.Discount+o.Delivery.Cost)*100) / 100
+	return o.Total
+}
+
+// ApplyCertificate делит позиции на покрытые сертификатом и доплату. Сертификат
+// покрывает не больше цены возмещения по КТРУ, позиции без возмещения оплачиваются полностью
+func (o *Order) ApplyCertificate(reimbursements map[string]Reimbursement) {
+	for i := range o.Items {
+		item := &o.Items[i]
+		reimbursement, ok := reimbursements[item.ProductID]
+		if !ok || reimbursement.Price <= 0 {
+			continue
+		}
+
+		item.TRUCodeID, item.TRUCode = reimbursement.TRUCodeID, reimbursement.Code
+		item.CertificateAmount = math.Round(min(item.Price, reimbursement.Price)*float64(item.Quantity)*100) / 100
+		item.CertificateStatus = CertificateStatusPending
+	}
+	o.CalculateCertificateAmount()
+}
+
+// CalculateCertificateAmount пересчитывает покрытие сертификатом без отклоненных позиций
+func (o *Order) CalculateCertificateAmount() float64 {
+	amount := 0.0
+	for i := range o.Items {
+		if o.Items[i].CertificateStatus != CertificateStatusNone && o.Items[i].CertificateStatus != CertificateStatusRejected {
+			amount += o.Items[i].CertificateAmount
+		}
+	}
+	o.CertificateAmount = math.Round(amount*100) / 100
+	return o.CertificateAmount
+}
+
+// Surcharge - доплата покупателя сверх сертификата
+func (o *Order) Surcharge() float64 {
+	return math.Max(math.Round((o.Total-o.CertificateAmount)*100)/100, 0)
+}
+
+// AmountDue - сумма к оплате картой. Для заказа с сертификатом - остаток доплаты:
+// если после оплаты позицию отклонили, доплата растет и разницу нужно оплатить
+func (o *Order) AmountDue() float64 {
+	if o.PaymentMethod == PaymentMethodCertificate {
+		return math.Max(math.Round((o.Surcharge()-o.SurchargePaid)*100)/100, 0)
+	}
+	return o.Total
+}
+
+// IsFullyPaid - заказ с сертификатом оплачен, когда сертификат погашен по всем позициям
+// и доплата оплачена картой полностью
+func (o *Order) IsFullyPaid() bool {
+	return o.IsCertificateSettled() && o.AmountDue() == 0
+}
+
+// ReservationExpiresAt - до какого момента держится резерв товара неоплаченного заказа.
+// Заказ с оплатой сертификатом резервируется без срока: погашение сертификата занимает дни
+func (o *Order) ReservationExpiresAt(now time.Time, ttl time.Duration) time.Time {
+	if o.PaymentMethod == PaymentMethodCertificate || ttl <= 0 {
+		return time.Time{}
+	}
+	return now.Add(ttl)
+}
+
+// ReservationDeadline - срок резерва товара заказа, считается от оформления.
+// Резерв оплаченного заказа держится без срока
+func (o *Order) ReservationDeadline(ttl time.Duration) time.Time {
+	if !o.Status.IsPayable() {
+		return time.Time{}
+	}
+	return o.ReservationExpiresAt(o.CreatedAt, ttl)
+}
+
+// IsCertificateSettled - все покрытые сертификатом позиции погашены
+func (o *Order) IsCertificateSettled() bool {
+	covered := 0
+	for i := range o.Items {
+		switch o.Items[i].CertificateStatus {
+		case CertificateStatusNone, CertificateStatusRejected:
+			continue
+		case CertificateStatusSettled:
+			covered++
+		default:
+			return false
+		}
+	}
+	return covered > 0
+}
+
+// FindItem возвращает позицию заказа или nil
+func (o *Order) FindItem(id string) *OrderItem {
+	for i := range o.Items {
+		if o.Items[i].ID == id {
+			return &o.Items[i]
+		}
+	}
+	return nil
+}
@@ -0,0 +1,66 @@
+package order_entity
+
+// RepeatReason - почему позицию прошлого заказа не удалось вернуть в корзину
+type RepeatReason string
+
+const (
+	RepeatReasonNotFound    RepeatReason = "not_found"
+	RepeatReasonUnavailable RepeatReason = "unavailable"
+	RepeatReasonNoPrice     RepeatReason = "no_price"
+	// RepeatReasonQuantity - в корзине уже максимальное количество товара
+	RepeatReasonQuantity RepeatReason = "quantity"
+	// RepeatReasonOutOfStock - товара нет в свободном остатке склада
+	RepeatReasonOutOfStock RepeatReason = "out_of_stock"
+)
+
+// RepeatItem - позиция повторяемого заказа. OrderedQuantity - количество в заказе,
+// Quantity - добавленное в корзину, OrderPrice - цена в заказе,
+// Price - актуальная цена в корзине, Reason заполняется, если товар не добавлен
+type RepeatItem struct {
+	ProductID       string
+	Name            string
+	Quantity        int
+	OrderedQuantity int
+	OrderPrice      float64
+	Price           float64
+	Reason          RepeatReason
+}
+
+// RepeatResult - итог повторения заказа: что попало в корзину и что недоступно
+type RepeatResult struct {
+	OrderID     string
+	Added       []RepeatItem
+	Unavailable []RepeatItem
+}
+
+// PriceChanged - цена товара отличается от цены в заказе
+func (i *RepeatItem) PriceChanged() bool {
+	return i.Reason == "" && i.Price != i.OrderPrice
+}
+
+// PartiallyAvailable - товар добавлен в корзину в меньшем количестве, чем был в заказе
+func (i *RepeatItem) PartiallyAvailable() bool {
+	return i.Reason == "" && i.Quantity < i.OrderedQuantity
+}
+
+// RepeatItems - позиции заказа для повторения. Позиции одного товара объединяются
+func (o *Order) RepeatItems() []RepeatItem {
+	items := make([]RepeatItem, 0, len(o.Items))
+	index := make(map[string]int, len(o.Items))
+	for _, item := range o.Items {
+		if i, ok := index[item.ProductID]; ok {
+			items[i].Quantity += item.Quantity
+			items[i].OrderedQuantity += item.Quantity
+			continue
+		}
+		index[item.ProductID] = len(items)
+		items = append(items, RepeatItem{
+			ProductID:       item.ProductID,
+			Name:            item.Name,
+			Quantity:        item.Quantity,
+			OrderedQuantity: item.Quantity,
+			OrderPrice:      item.Price,
+		})
+	}
+	return items
+}
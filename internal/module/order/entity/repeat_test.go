@@ -0,0 +1,35 @@
+package order_entity
+
+import "testing"
+
+func TestOrderRepeatItems(t *testing.T) {
+	order := &Order{Items: []OrderItem{
+		{ProductID: "cane", Name: "Трость опорная", Quantity: 1, Price: 990},
+		{ProductID: "walker", Name: "Ходунки", Quantity: 1, Price: 4500},
+		{ProductID: "cane", Name: "Трость опорная", Quantity: 2, Price: 990},
+	}}
+
+	items := order.RepeatItems()
+	if len(items) != 2 {
+		t.Fatalf("RepeatItems() returned %d items, want 2", len(items))
+	}
+	if items[0].ProductID != "cane" || items[0].Quantity != 3 || items[0].OrderedQuantity != 3 || items[0].OrderPrice != 990 {
+		t.Errorf("lines of one product are not merged: %+v", items[0])
+	}
+	if items[0].PartiallyAvailable() {
+		t.Error("line with ordered quantity is reported as partially available")
+	}
+	items[0].Quantity = 1
+	if !items[0].PartiallyAvailable() {
+		t.Error("line with reduced quantity is not reported as partially available")
+	}
+
+	items[1].Price = 4700
+	if !items[1].PriceChanged() {
+		t.Error("changed price is not reported")
+	}
+	items[1].Reason = RepeatReasonUnavailable
+	if items[1].PriceChanged() {
+		t.Error("price change is reported for an unavailable line")
+	}
+}
@@ -0,0 +1,121 @@
+package order_entity
+
+type OrderStatus string
+
+const (
+	OrderStatusNew        OrderStatus = "new"
+	OrderStatusConfirmed  OrderStatus = "confirmed"
+	OrderStatusPaid       OrderStatus = "paid"
+	OrderStatusAssembling OrderStatus = "assembling"
+	OrderStatusShipped    OrderStatus = "shipped"
+	OrderStatusDelivered  OrderStatus = "delivered"
+	OrderStatusCancelled  OrderStatus = "cancelled"
+	OrderStatusReturned   OrderStatus = "returned"
+)
+
+// Actor - кто инициирует смену статуса заказа
+type Actor string
+
+const (
+	// ActorCustomer - владелец заказа
+	ActorCustomer Actor = "customer"
+	// ActorManager - сотрудник с правом управления заказами
+	ActorManager Actor = "manager"
+	// ActorSystem - внутренние процессы, например подтверждение оплаты
+	ActorSystem Actor = "system"
+)
+
+// transitions - допустимые переходы между статусами и кто может их выполнять
+var transitions = map[OrderStatus]map[OrderStatus][]Actor{
+	OrderStatusNew: {
+		OrderStatusConfirmed: {ActorManager, ActorSystem},
+		OrderStatusCancelled: {ActorCustomer, ActorManager, ActorSystem},
+	},
+	OrderStatusConfirmed: {
+		OrderStatusPaid:      {ActorManager, ActorSystem},
+		OrderStatusCancelled: {ActorCustomer, ActorManager, ActorSystem},
+	},
+	OrderStatusPaid: {
+		OrderStatusAssembling: {ActorManager},
+		OrderStatusCancelled:  {ActorManager},
+	},
+	OrderStatusAssembling: {
+		OrderStatusShipped:   {ActorManager},
+		OrderStatusCancelled: {ActorManager},
+	},
+	OrderStatusShipped: {
+		OrderStatusDelivered: {ActorManager, ActorSystem},
+		OrderStatusReturned:  {ActorManager},
+	},
+	OrderStatusDelivered: {
+		OrderStatusReturned: {ActorManager, ActorSystem},
+	},
+}
+
+func (s OrderStatus) IsValid() bool {
+	switch s {
+	case OrderStatusNew, OrderStatusConfirmed, OrderStatusPaid, OrderStatusAssembling,
+		OrderStatusShipped, OrderStatusDelivered, OrderStatusCancelled, OrderStatusReturned:
+		return true
+	}
+	return false
+}
+
+// IsFinal - из конечного статуса переходов нет
+func (s OrderStatus) IsFinal() bool {
+	return len(transitions[s]) == 0
+}
+
+// IsPayable - оплатить можно новый заказ или заказ, который система может перевести в paid
+func (s OrderStatus) IsPayable() bool {
+	return s == OrderStatusNew || CanPerform(s, OrderStatusPaid, ActorSystem)
+}
+
+// IsEditable - состав заказа можно менять до оплаты
+func (s OrderStatus) IsEditable() bool {
+	return s == OrderStatusNew || s == OrderStatusConfirmed
+}
+
+// IsDeliveryEditable - данные доставки можно менять до отгрузки
+func (s OrderStatus) IsDeliveryEditable() bool {
+	return s.IsEditable() || s == OrderStatusPaid || s == OrderStatusAssembling
+}
+
+// CanTransition проверяет, что переход из статуса from в to существует
+func CanTransition(from, to OrderStatus) bool {
+	_, ok := transitions[from][to]
+	return ok
+}
+
+// CanPerform проверяет, что переход существует и разрешен actor
+func CanPerform(from, to OrderStatus, actor Actor) bool {
+	for _, allowed := range transitions[from][to] {
+		if allowed == actor {
+			return true
+		}
+	}
+	return false
+}
+
+// NextStatuses возвращает статусы, в которые actor может перевести заказ
+func NextStatuses(from OrderStatus, actor Actor) []OrderStatus {
+	result := make([]OrderStatus, 0)
+	for _, to := range statusOrder {
+		if CanPerform(from, to, actor) {
+			result = append(result, to)
+		}
+	}
+	return result
+}
+
+// statusOrder - порядок статусов для стабильного вывода
+var statusOrder = []OrderStatus{
+	OrderStatusNew,
+	OrderStatusConfirmed,
+	OrderStatusPaid,
+	OrderStatusAssembling,
+	OrderStatusShipped,
+	OrderStatusDelivered,
+	OrderStatusCancelled,
+	OrderStatusReturned,
+}
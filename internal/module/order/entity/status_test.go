@@ -0,0 +1,56 @@
+package order_entity
+
+import (
+	"testing"
+	"time"
+)
+
+func TestCanPerform(t *testing.T) {
+	tests := []struct {
+		name  string
+		from  OrderStatus
+		to    OrderStatus
+		actor Actor
+		want  bool
+	}{
+		{"manager confirms new", OrderStatusNew, OrderStatusConfirmed, ActorManager, true},
+		{"customer cannot confirm", OrderStatusNew, OrderStatusConfirmed, ActorCustomer, false},
+		{"customer cancels new", OrderStatusNew, OrderStatusCancelled, ActorCustomer, true},
+		{"customer cannot cancel paid", OrderStatusPaid, OrderStatusCancelled, ActorCustomer, false},
+		{"system marks paid", OrderStatusConfirmed, OrderStatusPaid, ActorSystem, true},
+		{"no skipping assembling", OrderStatusPaid, OrderStatusShipped, ActorManager, false},
+		{"delivered can be returned", OrderStatusDelivered, OrderStatusReturned, ActorManager, true},
+		{"cancelled is final", OrderStatusCancelled, OrderStatusNew, ActorManager, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := CanPerform(tt.from, tt.to, tt.actor); got != tt.want {
+				t.Errorf("CanPerform(%s, %s, %s) = %v, want %v", tt.from, tt.to, tt.actor, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestFinalStatuses(t *testing.T) {
+	for _, status := range statusOrder {
+		final := status == OrderStatusCancelled || status == OrderStatusReturned
+		if status.IsFinal() != final {
+			t.Errorf("%s.IsFinal() = %v, want %v", status, status.IsFinal(), final)
+		}
+	}
+}
+
+func TestReservationDeadline(t *testing.T) {
+	createdAt := time.Date(2026, 3, 1, 10, 0, 0, 0, time.UTC)
+	order := &Order{Status: OrderStatusConfirmed, PaymentMethod: PaymentMethodCard, CreatedAt: createdAt}
+
+	if got := order.ReservationDeadline(time.Hour); !got.Equal(createdAt.Add(time.Hour)) {
+		t.Errorf("ReservationDeadline() = %v, want %v", got, createdAt.Add(time.Hour))
+	}
+
+	order.Status = OrderStatusPaid
+	if got := order.ReservationDeadline(time.Hour); !got.IsZero() {
+		t.Errorf("ReservationDeadline() of paid order = %v, want zero", got)
+	}
+}
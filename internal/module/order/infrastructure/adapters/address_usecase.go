@@ -0,0 +1,66 @@
+package order_adapters
+
+import (
+	"context"
+
+	address_entity "github.com/Fi44er/sdmed/internal/module/address/entity"
+	address_usecase "github.com/Fi44er/sdmed/internal/module/address/usecase/address"
+	order_entity "github.com/Fi44er/sdmed/internal/module/order/entity"
+)
+
+type IAddressUsecaseAdapter interface {
+	GetByUserID(ctx context.Context, userID, id string) (*order_entity.Address, error)
+	SaveForUser(ctx context.Context, userID string, address *order_entity.Address) (*order_entity.Address, error)
+}
+
+type AddressUsecaseAdapter struct {
+	addressUsecase address_usecase.IAddressUsecase
+}
+
+func NewAddressUsecaseAdapter(addressUsecase address_usecase.IAddressUsecase) IAddressUsecaseAdapter {
+	return &AddressUsecaseAdapter{
+		addressUsecase: addressUsecase,
+	}
+}
+
+func (a *AddressUsecaseAdapter) GetByUserID(ctx context.Context, userID, id string) (*order_entity.Address, error) {
+	address, err := a.addressUsecase.GetByUserID(ctx, userID, id)
+	if err != nil {
+		return nil, err
+	}
+	return toOrderAddress(address), nil
+}
+
+func (a *AddressUsecaseAdapter) SaveForUser(ctx context.Context, userID string, address *order_entity.Address) (*order_entity.Address, error) {
+	saved, err := a.addressUsecase.SaveForUser(ctx, userID, &address_entity.Address{
+		Label:          address.Label,
+		RegionID:       address.RegionID,
+		City:           address.City,
+		Street:         address.Street,
+		House:          address.House,
+		Apartment:      address.Apartment,
+		PostalCode:     address.PostalCode,
+		RecipientName:  address.RecipientName,
+		RecipientPhone: address.RecipientPhone,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return toOrderAddress(saved), nil
+}
+
+func toOrderAddress(address *address_entity.Address) *order_entity.Address {
+	return &order_entity.Address{
+		ID:             address.ID,
+		Label:          address.Label,
+		RegionID:       address.RegionID,
+		City:           address.City,
+		Street:         address.Street,
+		House:          address.House,
+		Apartment:      address.Apartment,
+		PostalCode:     address.PostalCode,
+		RecipientName:  address.RecipientName,
+		RecipientPhone: address.RecipientPhone,
+		Formatted:      address.Format(),
+	}
+}
@@ -0,0 +1,75 @@
+package order_adapters
+
+import (
+	"context"
+	"errors"
+
+	cart_constant "github.com/Fi44er/sdmed/internal/module/cart/pkg"
+	cart_usecase "github.com/Fi44er/sdmed/internal/module/cart/usecase/cart"
+	order_entity "github.com/Fi44er/sdmed/internal/module/order/entity"
+)
+
+type ICartUsecaseAdapter interface {
+	GetItems(ctx context.Context, userID string) ([]order_entity.OrderItem, error)
+	Clear(ctx context.Context, userID string) error
+	AddItem(ctx context.Context, item *order_entity.RepeatItem) error
+}
+
+type CartUsecaseAdapter struct {
+	cartUsecase cart_usecase.ICartUsecase
+}
+
+func NewCartUsecaseAdapter(cartUsecase cart_usecase.ICartUsecase) ICartUsecaseAdapter {
+	return &CartUsecaseAdapter{
+		cartUsecase: cartUsecase,
+	}
+}
+
+// GetItems возвращает позиции корзины пользователя с зафиксированными в корзине ценами
+func (a *CartUsecaseAdapter) GetItems(ctx context.Context, userID string) ([]order_entity.OrderItem, error) {
+	cart, err := a.cartUsecase.GetByUserID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	items := make([]order_entity.OrderItem, len(cart.Items))
+	for i := range cart.Items {
+		items[i] = order_entity.OrderItem{
+			ProductID: cart.Items[i].ProductID,
+			Name:      cart.Items[i].Name,
+			Article:   cart.Items[i].Article,
+			Quantity:  cart.Items[i].Quantity,
+			Price:     cart.Items[i].Price,
+		}
+	}
+
+	return items, nil
+}
+
+func (a *CartUsecaseAdapter) Clear(ctx context.Context, userID string) error {
+	return a.cartUsecase.ClearByUserID(ctx, userID)
+}
+
+// AddItem добавляет товар в корзину пользователя сессии по актуальной цене.
+// Если товар недоступен, причина записывается в item.Reason, а ошибка не возвращается
+func (a *CartUsecaseAdapter) AddItem(ctx context.Context, item *order_entity.RepeatItem) error {
+	cart, err := a.cartUsecase.AddItem(ctx, item.ProductID, item.Quantity)
+	switch {
+	case errors.Is(err, cart_constant.ErrProductNotFound):
+		item.Reason = order_entity.RepeatReasonNotFound
+	case errors.Is(err, cart_constant.ErrProductUnavailable):
+		item.Reason = order_entity.RepeatReasonUnavailable
+	case errors.Is(err, cart_constant.ErrProductPriceNotSet):
+		item.Reason = order_entity.RepeatReasonNoPrice
+	case errors.Is(err, cart_constant.ErrQuantityOutOfRange):
+		item.Reason = order_entity.RepeatReasonQuantity
+	case err != nil:
+		return err
+	default:
+		if added := cart.FindItem(item.ProductID); added != nil {
+			item.Price = added.Price
+		}
+	}
+
+	return nil
+}
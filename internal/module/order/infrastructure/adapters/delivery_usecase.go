@@ -0,0 +1,75 @@
+package order_adapters
+
+import (
+	"context"
+
+	delivery_entity "github.com/Fi44er/sdmed/internal/module/delivery/entity"
+	delivery_usecase "github.com/Fi44er/sdmed/internal/module/delivery/usecase/delivery"
+	order_entity "github.com/Fi44er/sdmed/internal/module/order/entity"
+)
+
+type IDeliveryUsecaseAdapter interface {
+	GetQuotes(ctx context.Context, regionID, postalCode string, items []order_entity.OrderItem) ([]order_entity.DeliveryQuote, error)
+	GetQuote(ctx context.Context, methodID, regionID, postalCode string, items []order_entity.OrderItem) (*order_entity.DeliveryQuote, error)
+}
+
+type DeliveryUsecaseAdapter struct {
+	deliveryUsecase delivery_usecase.IDeliveryUsecase
+}
+
+func NewDeliveryUsecaseAdapter(deliveryUsecase delivery_usecase.IDeliveryUsecase) IDeliveryUsecaseAdapter {
+	return &DeliveryUsecaseAdapter{
+		deliveryUsecase: deliveryUsecase,
+	}
+}
+
+func (a *DeliveryUsecaseAdapter) GetQuotes(ctx context.Context, regionID, postalCode string, items []order_entity.OrderItem) ([]order_entity.DeliveryQuote, error) {
+	quotes, err := a.deliveryUsecase.GetQuotes(ctx, toQuoteRequest(regionID, postalCode, items))
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]order_entity.DeliveryQuote, len(quotes))
+	for i := range quotes {
+		result[i] = *toDeliveryQuote(&quotes[i])
+	}
+	return result, nil
+}
+
+func (a *DeliveryUsecaseAdapter) GetQuote(ctx context.Context, methodID, regionID, postalCode string, items []order_entity.OrderItem) (*order_entity.DeliveryQuote, error) {
+	quote, err := a.deliveryUsecase.GetQuote(ctx, methodID, toQuoteRequest(regionID, postalCode, items))
+	if err != nil {
+		return nil, err
+	}
+	return toDeliveryQuote(quote), nil
+}
+
+func toQuoteRequest(regionID, postalCode string, items []order_entity.OrderItem) *delivery_entity.QuoteRequest {
+	request := &delivery_entity.QuoteRequest{
+		RegionID:   regionID,
+		PostalCode: postalCode,
+		Items:      make([]delivery_entity.QuoteItem, len(items)),
+	}
+	for i, item := range items {
+		request.Items[i] = delivery_entity.QuoteItem{
+			ProductID: item.ProductID,
+			Quantity:  item.Quantity,
+			Price:     item.Price,
+		}
+	}
+	return request
+}
+
+func toDeliveryQuote(quote *delivery_entity.Quote) *order_entity.DeliveryQuote {
+	return &order_entity.DeliveryQuote{
+		MethodID:        quote.MethodID,
+		MethodName:      quote.MethodName,
+		Method:          order_entity.DeliveryMethod(quote.Type),
+		Cost:            quote.Cost,
+		MinDays:         quote.MinDays,
+		MaxDays:         quote.MaxDays,
+		EstimatedFrom:   quote.EstimatedFrom,
+		EstimatedTo:     quote.EstimatedTo,
+		RequiresAddress: quote.RequiresAddress,
+	}
+}
@@ -0,0 +1,65 @@
+package order_adapters
+
+import (
+	"context"
+
+	discount_entity "github.com/Fi44er/sdmed/internal/module/discount/entity"
+	discount_usecase "github.com/Fi44er/sdmed/internal/module/discount/usecase/discount"
+	order_entity "github.com/Fi44er/sdmed/internal/module/order/entity"
+)
+
+type IDiscountUsecaseAdapter interface {
+	Calculate(ctx context.Context, userID, code string, items []order_entity.OrderItem) (*order_entity.Discount, error)
+	Redeem(ctx context.Context, promoCodeID, userID, orderID string) error
+	Release(ctx context.Context, orderID string) error
+}
+
+type DiscountUsecaseAdapter struct {
+	discountUsecase discount_usecase.IDiscountUsecase
+}
+
+func NewDiscountUsecaseAdapter(discountUsecase discount_usecase.IDiscountUsecase) IDiscountUsecaseAdapter {
+	return &DiscountUsecaseAdapter{
+		discountUsecase: discountUsecase,
+	}
+}
+
+// Calculate рассчитывает скидки по позициям. Покрытая сертификатом часть позиций
+// передается как исключенная из скидок
+func (a *DiscountUsecaseAdapter) Calculate(ctx context.Context, userID, code string, items []order_entity.OrderItem) (*order_entity.Discount, error) {
+	lines := make([]discount_entity.Line, len(items))
+	for i := range items {
+		lines[i] = discount_entity.Line{
+			ProductID: items[i].ProductID,
+			Quantity:  items[i].Quantity,
+			Price:     items[i].Price,
+			Excluded:  items[i].Sum() - items[i].DiscountBase(),
+		}
+	}
+
+	result, err := a.discountUsecase.Calculate(ctx, userID, code, lines)
+	if err != nil {
+		return nil, err
+	}
+
+	discount := &order_entity.Discount{
+		Lines:  make([]float64, len(result.Lines)),
+		Amount: result.Discount,
+	}
+	for i := range result.Lines {
+		discount.Lines[i] = result.Lines[i].Discount
+	}
+	if result.PromoCode != nil {
+		discount.PromoCodeID, discount.PromoCode = result.PromoCode.ID, result.PromoCode.Code
+	}
+
+	return discount, nil
+}
+
+func (a *DiscountUsecaseAdapter) Redeem(ctx context.Context, promoCodeID, userID, orderID string) error {
+	return a.discountUsecase.Redeem(ctx, promoCodeID, userID, orderID)
+}
+
+func (a *DiscountUsecaseAdapter) Release(ctx context.Context, orderID string) error {
+	return a.discountUsecase.Release(ctx, orderID)
+}
@@ -0,0 +1,52 @@
+package order_adapters
+
+import (
+	"context"
+
+	file_entity "github.com/Fi44er/sdmed/internal/module/file/entity"
+	file_usecase "github.com/Fi44er/sdmed/internal/module/file/usecase/file"
+	order_entity "github.com/Fi44er/sdmed/internal/module/order/entity"
+)
+
+type IFileUsecaseAdapter interface {
+	Upload(ctx context.Context, data []byte, ownerID string) (*order_entity.Document, error)
+	Get(ctx context.Context, name string) ([]byte, error)
+	DeleteByID(ctx context.Context, id string) error
+}
+
+type FileUsecaseAdapter struct {
+	fileUsecase file_usecase.IFileUsecase
+}
+
+func NewFileUsecaseAdapter(fileUsecase file_usecase.IFileUsecase) IFileUsecaseAdapter {
+	return &FileUsecaseAdapter{
+		fileUsecase: fileUsecase,
+	}
+}
+
+// Upload сохраняет файл документа как постоянный файл заказа и возвращает
+// документ с заполненными идентификатором и именем файла
+func (a *FileUsecaseAdapter) Upload(ctx context.Context, data []byte, ownerID string) (*order_entity.Document, error) {
+	file := &file_entity.File{Data: data}
+	if _, err := a.fileUsecase.UploadPermanent(ctx, file, ownerID, order_entity.DocumentOwnerType); err != nil {
+		return nil, err
+	}
+
+	return &order_entity.Document{
+		OrderID:  ownerID,
+		FileID:   file.ID,
+		FileName: file.Name,
+	}, nil
+}
+
+func (a *FileUsecaseAdapter) Get(ctx context.Context, name string) ([]byte, error) {
+	file, err := a.fileUsecase.Get(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+	return file.Data, nil
+}
+
+func (a *FileUsecaseAdapter) DeleteByID(ctx context.Context, id string) error {
+	return a.fileUsecase.DeleteByID(ctx, id)
+}
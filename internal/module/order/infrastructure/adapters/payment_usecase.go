@@ -0,0 +1,45 @@
+package order_adapters
+
+import (
+	"context"
+	"time"
+
+	payment_entity "github.com/Fi44er/sdmed/internal/module/payment/entity"
+	payment_usecase "github.com/Fi44er/sdmed/internal/module/payment/usecase/payment"
+)
+
+type IPaymentUsecaseAdapter interface {
+	HasPaymentInProgress(ctx context.Context, orderID string, since time.Time) (bool, error)
+}
+
+type PaymentUsecaseAdapter struct {
+	paymentUsecase payment_usecase.IPaymentUsecase
+}
+
+func NewPaymentUsecaseAdapter(paymentUsecase payment_usecase.IPaymentUsecase) IPaymentUsecaseAdapter {
+	return &PaymentUsecaseAdapter{
+		paymentUsecase: paymentUsecase,
+	}
+}
+
+// HasPaymentInProgress - средства по заказу заблокированы шлюзом или покупатель начал оплату
+// после since. Брошенная ссылка на оплату старше since заказ не держит
+func (a *PaymentUsecaseAdapter) HasPaymentInProgress(ctx context.Context, orderID string, since time.Time) (bool, error) {
+	payments, err := a.paymentUsecase.GetByOrderID(ctx, orderID)
+	if err != nil {
+		return false, err
+	}
+
+	for i := range payments {
+		switch payments[i].Status {
+		case payment_entity.PaymentStatusAuthorized:
+			return true, nil
+		case payment_entity.PaymentStatusPending:
+			if payments[i].CreatedAt.After(since) {
+				return true, nil
+			}
+		}
+	}
+
+	return false, nil
+}
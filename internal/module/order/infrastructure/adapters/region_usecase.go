@@ -0,0 +1,30 @@
+package order_adapters
+
+import (
+	"context"
+
+	region_usecase "github.com/Fi44er/sdmed/internal/module/region/usecase/region"
+)
+
+type IRegionUsecaseAdapter interface {
+	ResolveRegionID(ctx context.Context) (string, error)
+}
+
+type RegionUsecaseAdapter struct {
+	regionUsecase region_usecase.IRegionUsecase
+}
+
+func NewRegionUsecaseAdapter(regionUsecase region_usecase.IRegionUsecase) IRegionUsecaseAdapter {
+	return &RegionUsecaseAdapter{
+		regionUsecase: regionUsecase,
+	}
+}
+
+// ResolveRegionID возвращает регион покупателя по сессии
+func (a *RegionUsecaseAdapter) ResolveRegionID(ctx context.Context) (string, error) {
+	region, err := a.regionUsecase.GetCurrent(ctx)
+	if err != nil {
+		return "", err
+	}
+	return region.ID, nil
+}
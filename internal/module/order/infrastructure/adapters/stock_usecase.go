@@ -0,0 +1,74 @@
+package order_adapters
+
+import (
+	"context"
+	"time"
+
+	order_entity "github.com/Fi44er/sdmed/internal/module/order/entity"
+	stock_entity "github.com/Fi44er/sdmed/internal/module/stock/entity"
+	stock_usecase "github.com/Fi44er/sdmed/internal/module/stock/usecase/stock"
+)
+
+type IStockUsecaseAdapter interface {
+	Reserve(ctx context.Context, orderID string, items []order_entity.OrderItem, expiresAt time.Time) error
+	Update(ctx context.Context, orderID string, items []order_entity.OrderItem, expiresAt time.Time) error
+	Extend(ctx context.Context, orderID string, expiresAt time.Time) error
+	Confirm(ctx context.Context, orderID string) error
+	Release(ctx context.Context, orderID string) error
+	WriteOff(ctx context.Context, orderID string) error
+	GetExpiredOrderIDs(ctx context.Context, limit int) ([]string, error)
+	GetAvailable(ctx context.Context, productID string) (int, bool, error)
+}
+
+type StockUsecaseAdapter struct {
+	stockUsecase stock_usecase.IStockUsecase
+}
+
+func NewStockUsecaseAdapter(stockUsecase stock_usecase.IStockUsecase) IStockUsecaseAdapter {
+	return &StockUsecaseAdapter{
+		stockUsecase: stockUsecase,
+	}
+}
+
+func (a *StockUsecaseAdapter) Reserve(ctx context.Context, orderID string, items []order_entity.OrderItem, expiresAt time.Time) error {
+	return a.stockUsecase.Reserve(ctx, orderID, toReserveItems(items), expiresAt)
+}
+
+func (a *StockUsecaseAdapter) Update(ctx context.Context, orderID string, items []order_entity.OrderItem, expiresAt time.Time) error {
+	return a.stockUsecase.Update(ctx, orderID, toReserveItems(items), expiresAt)
+}
+
+func (a *StockUsecaseAdapter) Extend(ctx context.Context, orderID string, expiresAt time.Time) error {
+	return a.stockUsecase.Extend(ctx, orderID, expiresAt)
+}
+
+func (a *StockUsecaseAdapter) Confirm(ctx context.Context, orderID string) error {
+	return a.stockUsecase.Confirm(ctx, orderID)
+}
+
+func (a *StockUsecaseAdapter) Release(ctx context.Context, orderID string) error {
+	return a.stockUsecase.Release(ctx, orderID)
+}
+
+func (a *StockUsecaseAdapter) WriteOff(ctx context.Context, orderID string) error {
+	return a.stockUsecase.WriteOff(ctx, orderID)
+}
+
+func (a *StockUsecaseAdapter) GetExpiredOrderIDs(ctx context.Context, limit int) ([]string, error) {
+	return a.stockUsecase.GetExpiredOrderIDs(ctx, limit)
+}
+
+func (a *StockUsecaseAdapter) GetAvailable(ctx context.Context, productID string) (int, bool, error) {
+	return a.stockUsecase.GetAvailable(ctx, productID)
+}
+
+func toReserveItems(items []order_entity.OrderItem) []stock_entity.ReserveItem {
+	result := make([]stock_entity.ReserveItem, len(items))
+	for i := range items {
+		result[i] = stock_entity.ReserveItem{
+			ProductID: items[i].ProductID,
+			Quantity:  items[i].Quantity,
+		}
+	}
+	return result
+}
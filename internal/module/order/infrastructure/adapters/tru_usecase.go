@@ -0,0 +1,40 @@
+package order_adapters
+
+import (
+	"context"
+
+	order_entity "github.com/Fi44er/sdmed/internal/module/order/entity"
+	tru_usecase "github.com/Fi44er/sdmed/internal/module/tru/usecase/tru"
+)
+
+type ITRUUsecaseAdapter interface {
+	GetReimbursements(ctx context.Context, productIDs []string, regionID string) (map[string]order_entity.Reimbursement, error)
+}
+
+type TRUUsecaseAdapter struct {
+	truUsecase tru_usecase.ITRUUsecase
+}
+
+func NewTRUUsecaseAdapter(truUsecase tru_usecase.ITRUUsecase) ITRUUsecaseAdapter {
+	return &TRUUsecaseAdapter{
+		truUsecase: truUsecase,
+	}
+}
+
+func (a *TRUUsecaseAdapter) GetReimbursements(ctx context.Context, productIDs []string, regionID string) (map[string]order_entity.Reimbursement, error) {
+	reimbursements, err := a.truUsecase.GetReimbursements(ctx, productIDs, regionID)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make(map[string]order_entity.Reimbursement, len(reimbursements))
+	for productID, reimbursement := range reimbursements {
+		result[productID] = order_entity.Reimbursement{
+			TRUCodeID: reimbursement.TRUCodeID,
+			Code:      reimbursement.Code,
+			Price:     reimbursement.Price,
+		}
+	}
+
+	return result, nil
+}
@@ -0,0 +1,35 @@
+package order_adapters
+
+import (
+	"context"
+	"errors"
+
+	user_constant "github.com/Fi44er/sdmed/internal/module/user/pkg/constant"
+	user_usecase "github.com/Fi44er/sdmed/internal/module/user/usecase/user"
+)
+
+type IUserUsecaseAdapter interface {
+	IsRegistered(ctx context.Context, userID string) (bool, error)
+}
+
+type UserUsecaseAdapter struct {
+	userUsecase *user_usecase.UserUsecase
+}
+
+func NewUserUsecaseAdapter(userUsecase *user_usecase.UserUsecase) IUserUsecaseAdapter {
+	return &UserUsecaseAdapter{
+		userUsecase: userUsecase,
+	}
+}
+
+// IsRegistered - пользователь существует и не является shadow-пользователем
+func (a *UserUsecaseAdapter) IsRegistered(ctx context.Context, userID string) (bool, error) {
+	user, err := a.userUsecase.GetByID(ctx, userID)
+	if errors.Is(err, user_constant.ErrUserNotFound) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	return !user.IsShadow, nil
+}
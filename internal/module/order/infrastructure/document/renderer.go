@@ -0,0 +1,330 @@
+package order_document
+
+import (
+	"bytes"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"text/template"
+	"time"
+
+	order_entity "github.com/Fi44er/sdmed/internal/module/order/entity"
+	order_constant "github.com/Fi44er/sdmed/internal/module/order/pkg"
+	"github.com/Fi44er/sdmed/pkg/logger"
+	"github.com/go-pdf/fpdf"
+)
+
+const (
+	fontFamily     = "DejaVu"
+	fontRegular    = "DejaVuSans.ttf"
+	fontBold       = "DejaVuSans-Bold.ttf"
+	pageMargin     = 10.0
+	lineHeight     = 5.0
+	signatureWidth = 60.0
+)
+
+// column - колонка таблицы позиций
+type column struct {
+	title string
+	width float64
+	align string
+}
+
+var columns = []column{
+	{title: "№", width: 10, align: "C"},
+	{title: "Товар", width: 78, align: "L"},
+	{title: "Артикул", width: 25, align: "L"},
+	{title: "Кол-во", width: 15, align: "R"},
+	{title: "Ед.", width: 12, align: "C"},
+	{title: "Цена", width: 25, align: "R"},
+	{title: "Сумма", width: 25, align: "R"},
+}
+
+// PDFRenderer формирует печатные формы заказа по шаблонам из templates.
+// Для кириллицы нужны TTF-шрифты DejaVu из fontDir
+type PDFRenderer struct {
+	fontDir   string
+	templates map[order_entity.DocumentKind]*parsedTemplate
+	logger    *logger.Logger
+}
+
+type parsedTemplate struct {
+	title       *template.Template
+	bankDetails bool
+	parties     []parsedParty
+	basis       *template.Template
+	note        *template.Template
+	signatures  []string
+}
+
+type parsedParty struct {
+	label string
+	value *template.Template
+}
+
+// filledTemplate - тексты шаблона, заполненные данными документа
+type filledTemplate struct {
+	title   string
+	parties [][2]string
+	basis   string
+	note    string
+}
+
+func NewPDFRenderer(logger *logger.Logger, fontDir string) *PDFRenderer {
+	parsed := make(map[order_entity.DocumentKind]*parsedTemplate, len(templates))
+	for kind, tmpl := range templates {
+		parsed[kind] = mustParse(kind, tmpl)
+	}
+
+	return &PDFRenderer{
+		fontDir:   fontDir,
+		templates: parsed,
+		logger:    logger,
+	}
+}
+
+func (r *PDFRenderer) Render(data *order_entity.DocumentData) ([]byte, error) {
+	tmpl, ok := r.templates[data.Kind]
+	if !ok {
+		return nil, order_constant.ErrInvalidDocumentKind
+	}
+	text, err := tmpl.fill(data)
+	if err != nil {
+		r.logger.Errorf("Failed to fill %s template: %v", data.Kind, err)
+		return nil, err
+	}
+
+	pdf := fpdf.New("P", "mm", "A4", r.fontDir)
+	pdf.SetMargins(pageMargin, pageMargin, pageMargin)
+	pdf.SetAutoPageBreak(true, pageMargin)
+	pdf.AddUTF8Font(fontFamily, "", fontRegular)
+	pdf.AddUTF8Font(fontFamily, "B", fontBold)
+	if err := pdf.Error(); err != nil {
+		r.logger.Errorf("Failed to load fonts from %s: %v", r.fontDir, err)
+		return nil, fmt.Errorf("load document fonts: %w", err)
+	}
+	pdf.AddPage()
+
+	if tmpl.bankDetails {
+		r.writeBankDetails(pdf, &data.Seller)
+	}
+
+	pdf.SetFont(fontFamily, "B", 14)
+	pdf.MultiCell(0, 8, text.title, "B", "L", false)
+	pdf.Ln(3)
+
+	for _, p := range text.parties {
+		r.writeParty(pdf, p[0], p[1])
+	}
+	r.writeParty(pdf, "Основание:", text.basis)
+	pdf.Ln(2)
+
+	r.writeItems(pdf, data.Order)
+	r.writeTotals(pdf, data.Order)
+
+	pdf.SetFont(fontFamily, "", 9)
+	pdf.MultiCell(0, lineHeight, text.note, "", "L", false)
+	pdf.Ln(10)
+
+	r.writeSignatures(pdf, tmpl.signatures)
+
+	var buf bytes.Buffer
+	if err := pdf.Output(&buf); err != nil {
+		r.logger.Errorf("Failed to render %s document %s: %v", data.Kind, data.Number, err)
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+func (r *PDFRenderer) writeBankDetails(pdf *fpdf.Fpdf, seller *order_entity.Seller) {
+	pdf.SetFont(fontFamily, "", 9)
+	pdf.CellFormat(110, lineHeight, seller.Bank, "LTR", 0, "L", false, 0, "")
+	pdf.CellFormat(20, lineHeight, "БИК", "LTR", 0, "L", false, 0, "")
+	pdf.CellFormat(0, lineHeight, seller.BIK, "LTR", 1, "L", false, 0, "")
+	pdf.CellFormat(110, lineHeight, "Банк получателя", "LBR", 0, "L", false, 0, "")
+	pdf.CellFormat(20, lineHeight, "Сч. №", "LBR", 0, "L", false, 0, "")
+	pdf.CellFormat(0, lineHeight, seller.CorrAccount, "LBR", 1, "L", false, 0, "")
+	pdf.CellFormat(55, lineHeight, "ИНН "+seller.INN, "1", 0, "L", false, 0, "")
+	pdf.CellFormat(55, lineHeight, "КПП "+seller.KPP, "1", 0, "L", false, 0, "")
+	pdf.CellFormat(20, lineHeight, "Сч. №", "LTR", 0, "L", false, 0, "")
+	pdf.CellFormat(0, lineHeight, seller.Account, "LTR", 1, "L", false, 0, "")
+	pdf.CellFormat(110, lineHeight, seller.Name, "LR", 0, "L", false, 0, "")
+	pdf.CellFormat(20, lineHeight, "", "LR", 0, "L", false, 0, "")
+	pdf.CellFormat(0, lineHeight, "", "LR", 1, "L", false, 0, "")
+	pdf.CellFormat(110, lineHeight, "Получатель", "LBR", 0, "L", false, 0, "")
+	pdf.CellFormat(20, lineHeight, "", "LBR", 0, "L", false, 0, "")
+	pdf.CellFormat(0, lineHeight, "", "LBR", 1, "L", false, 0, "")
+	pdf.Ln(4)
+}
+
+func (r *PDFRenderer) writeParty(pdf *fpdf.Fpdf, label, value string) {
+	pdf.SetFont(fontFamily, "", 9)
+	pdf.CellFormat(30, lineHeight, label, "", 0, "L", false, 0, "")
+	pdf.SetFont(fontFamily, "B", 9)
+	pdf.MultiCell(0, lineHeight, value, "", "L", false)
+	pdf.Ln(1)
+}
+
+// writeItems печатает таблицу позиций. Высота строки подбирается по самому длинному
+// наименованию, которое переносится внутри своей ячейки
+func (r *PDFRenderer) writeItems(pdf *fpdf.Fpdf, order *order_entity.Order) {
+	pdf.SetFont(fontFamily, "B", 9)
+	for _, col := range columns {
+		pdf.CellFormat(col.width, 6, col.title, "1", 0, "C", false, 0, "")
+	}
+	pdf.Ln(-1)
+
+	pdf.SetFont(fontFamily, "", 9)
+	_, pageHeight := pdf.GetPageSize()
+	for i := range order.Items {
+		item := &order.Items[i]
+		values := []string{
+			strconv.Itoa(i + 1),
+			item.Name,
+			item.Article,
+			strconv.Itoa(item.Quantity),
+			"шт",
+			money(item.Price),
+			money(item.Sum()),
+		}
+
+		lines := make([][]string, len(columns))
+		rows := 1
+		for j, col := range columns {
+			lines[j] = pdf.SplitText(values[j], col.width-2)
+			rows = max(rows, len(lines[j]))
+		}
+		height := float64(rows) * lineHeight
+
+		if pdf.GetY()+height > pageHeight-pageMargin {
+			pdf.AddPage()
+		}
+
+		x, y := pdf.GetXY()
+		for j, col := range columns {
+			pdf.Rect(x, y, col.width, height, "D")
+			pdf.SetXY(x, y)
+			pdf.MultiCell(col.width, lineHeight, strings.Join(lines[j], "\n"), "", col.align, false)
+			x += col.width
+		}
+		pdf.SetXY(pageMargin, y+height)
+	}
+	pdf.Ln(2)
+}
+
+func (r *PDFRenderer) writeTotals(pdf *fpdf.Fpdf, order *order_entity.Order) {
+	var rows [][2]string
+	if order.Delivery.Cost > 0 || order.Discount > 0 {
+		rows = append(rows, [2]string{"Товары:", money(order.ItemsTotal())})
+	}
+	if order.Discount > 0 {
+		rows = append(rows, [2]string{"Скидка:", "-" + money(order.Discount)})
+	}
+	if order.Delivery.Cost > 0 {
+		rows = append(rows, [2]string{"Доставка:", money(order.Delivery.Cost)})
+	}
+	rows = append(rows,
+		[2]string{"Итого:", money(order.Total)},
+		[2]string{"Без налога (НДС)", "-"},
+	)
+	if order.CertificateAmount > 0 {
+		rows = append(rows,
+			[2]string{"Оплачивается сертификатом:", money(order.CertificateAmount)},
+			[2]string{"Доплата покупателя:", money(order.Surcharge())},
+		)
+	} else {
+		rows = append(rows, [2]string{"Всего к оплате:", money(order.Total)})
+	}
+
+	pdf.SetFont(fontFamily, "B", 9)
+	for _, row := range rows {
+		pdf.CellFormat(140, lineHeight, row[0], "", 0, "R", false, 0, "")
+		pdf.CellFormat(0, lineHeight, row[1], "", 1, "R", false, 0, "")
+	}
+	pdf.Ln(3)
+}
+
+func (r *PDFRenderer) writeSignatures(pdf *fpdf.Fpdf, signatures []string) {
+	pdf.SetFont(fontFamily, "", 9)
+	for i, label := range signatures {
+		pdf.CellFormat(25, lineHeight, label, "", 0, "L", false, 0, "")
+		pdf.CellFormat(signatureWidth, lineHeight, "", "B", 0, "L", false, 0, "")
+		if i%2 == 0 {
+			pdf.CellFormat(10, lineHeight, "", "", 0, "L", false, 0, "")
+		} else {
+			pdf.Ln(12)
+		}
+	}
+}
+
+var funcs = template.FuncMap{
+	"date":   func(t time.Time) string { return t.Format("02.01.2006") },
+	"money":  money,
+	"number": order_entity.DocumentNumber,
+}
+
+func mustParse(kind order_entity.DocumentKind, tmpl documentTemplate) *parsedTemplate {
+	parse := func(name, text string) *template.Template {
+		return template.Must(template.New(string(kind) + "." + name).Funcs(funcs).Parse(text))
+	}
+
+	parsed := &parsedTemplate{
+		title:       parse("title", tmpl.Title),
+		bankDetails: tmpl.BankDetails,
+		basis:       parse("basis", tmpl.Basis),
+		note:        parse("note", tmpl.Note),
+		signatures:  tmpl.Signatures,
+	}
+	for i, p := range tmpl.Parties {
+		parsed.parties = append(parsed.parties, parsedParty{
+			label: p.Label,
+			value: parse("party"+strconv.Itoa(i), p.Value),
+		})
+	}
+
+	return parsed
+}
+
+func (t *parsedTemplate) fill(data *order_entity.DocumentData) (*filledTemplate, error) {
+	var err error
+	execute := func(tmpl *template.Template) string {
+		var buf bytes.Buffer
+		if err == nil {
+			err = tmpl.Execute(&buf, data)
+		}
+		return buf.String()
+	}
+
+	filled := &filledTemplate{
+		title: execute(t.title),
+		basis: execute(t.basis),
+		note:  execute(t.note),
+	}
+	for _, p := range t.parties {
+		filled.parties = append(filled.parties, [2]string{p.label, execute(p.value)})
+	}
+
+	return filled, err
+}
+
+// money форматирует сумму в рублях: 1 500,50
+func money(amount float64) string {
+	kopecks := int64(math.Round(amount * 100))
+	sign := ""
+	if kopecks < 0 {
+		sign, kopecks = "-", -kopecks
+	}
+
+	rubles := strconv.FormatInt(kopecks/100, 10)
+	var grouped strings.Builder
+	for i, digit := range rubles {
+		if i > 0 && (len(rubles)-i)%3 == 0 {
+			grouped.WriteRune(' ')
+		}
+		grouped.WriteRune(digit)
+	}
+
+	return fmt.Sprintf("%s%s,%02d", sign, grouped.String(), kopecks%100)
+}
@@ -0,0 +1,80 @@
+package order_document
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	order_entity "github.com/Fi44er/sdmed/internal/module/order/entity"
+	"github.com/Fi44er/sdmed/pkg/logger"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testFontDir = "/usr/share/fonts/truetype/dejavu"
+
+func testDocumentData(kind order_entity.DocumentKind) *order_entity.DocumentData {
+	order := &order_entity.Order{
+		ID:     "3f2a9c1e-7b4d-4e8a-9c2b-1d5e6f7a8b9c",
+		Status: order_entity.OrderStatusShipped,
+		Contact: order_entity.Contact{
+			Name:  "ГБУ Центр социального обслуживания",
+			Phone: "+79990001122",
+		},
+		Delivery: order_entity.Delivery{Method: order_entity.DeliveryMethodCourier, MethodName: "Курьер", Address: "г. Москва, ул. Ленина, д. 1", Cost: 500},
+		Items: []order_entity.OrderItem{
+			{Name: "Кресло-коляска с ручным приводом прогулочная, складная, с откидной спинкой", Article: "KK-100", Quantity: 2, Price: 15400.5},
+			{Name: "Трость опорная", Article: "TR-1", Quantity: 1, Price: 990},
+		},
+		CreatedAt: time.Date(2026, 3, 1, 10, 0, 0, 0, time.UTC),
+	}
+	order.Total = order.CalculateTotal()
+
+	return &order_entity.DocumentData{
+		Kind:   kind,
+		Number: order_entity.DocumentNumber(order.ID),
+		Date:   time.Date(2026, 3, 2, 0, 0, 0, 0, time.UTC),
+		Seller: order_entity.Seller{Name: "ООО \"СДМЕД\"", INN: "7700000000", KPP: "770001001", Bank: "ПАО Сбербанк", BIK: "044525225"},
+		Order:  order,
+	}
+}
+
+func TestRender(t *testing.T) {
+	if _, err := os.Stat(filepath.Join(testFontDir, fontRegular)); err != nil {
+		t.Skipf("DejaVu fonts are not installed: %v", err)
+	}
+	renderer := NewPDFRenderer(logger.NewLogger(), testFontDir)
+
+	for _, kind := range []order_entity.DocumentKind{order_entity.DocumentKindInvoice, order_entity.DocumentKindAct} {
+		t.Run(string(kind), func(t *testing.T) {
+			data, err := renderer.Render(testDocumentData(kind))
+			require.NoError(t, err)
+			assert.True(t, bytes.HasPrefix(data, []byte("%PDF-")))
+		})
+	}
+
+	t.Run("missing fonts", func(t *testing.T) {
+		_, err := NewPDFRenderer(logger.NewLogger(), t.TempDir()).Render(testDocumentData(order_entity.DocumentKindInvoice))
+		assert.Error(t, err)
+	})
+}
+
+func TestTemplates(t *testing.T) {
+	renderer := NewPDFRenderer(logger.NewLogger(), testFontDir)
+
+	text, err := renderer.templates[order_entity.DocumentKindInvoice].fill(testDocumentData(order_entity.DocumentKindInvoice))
+	require.NoError(t, err)
+	assert.Equal(t, "Счет на оплату № 3F2A9C1E от 02.03.2026", text.title)
+	assert.Equal(t, "Заказ № 3F2A9C1E от 01.03.2026", text.basis)
+	assert.Contains(t, text.note, "Всего наименований 2, на сумму 32 291,00 руб.")
+	assert.Equal(t, "ООО \"СДМЕД\", ИНН 7700000000, КПП 770001001", text.parties[0][1])
+}
+
+func TestMoney(t *testing.T) {
+	assert.Equal(t, "0,00", money(0))
+	assert.Equal(t, "990,00", money(990))
+	assert.Equal(t, "1 500,50", money(1500.5))
+	assert.Equal(t, "1 234 567,89", money(1234567.888))
+}
@@ -0,0 +1,53 @@
+package order_document
+
+import order_entity "github.com/Fi44er/sdmed/internal/module/order/entity"
+
+// documentTemplate - текстовые части печатной формы. Поля - шаблоны text/template,
+// заполняемые из order_entity.DocumentData
+type documentTemplate struct {
+	Title string
+	// BankDetails - печатать ли блок банковских реквизитов продавца над заголовком
+	BankDetails bool
+	Parties     []party
+	Basis       string
+	Note        string
+	Signatures  []string
+}
+
+type party struct {
+	Label string
+	Value string
+}
+
+const (
+	sellerTemplate = `{{.Seller.Name}}{{with .Seller.INN}}, ИНН {{.}}{{end}}{{with .Seller.KPP}}, КПП {{.}}{{end}}{{with .Seller.Address}}, {{.}}{{end}}`
+	buyerTemplate  = `{{.Order.Contact.Name}}{{with .Order.Contact.Phone}}, тел. {{.}}{{end}}{{with .Order.Contact.Email}}, {{.}}{{end}}`
+)
+
+var templates = map[order_entity.DocumentKind]documentTemplate{
+	order_entity.DocumentKindInvoice: {
+		Title:       `Счет на оплату № {{.Number}} от {{date .Date}}`,
+		BankDetails: true,
+		Parties: []party{
+			{Label: "Поставщик:", Value: sellerTemplate},
+			{Label: "Покупатель:", Value: buyerTemplate},
+		},
+		Basis: `Заказ № {{number .Order.ID}} от {{date .Order.CreatedAt}}`,
+		Note: `Всего наименований {{len .Order.Items}}, на сумму {{money .Order.Total}} руб.` +
+			`{{if gt .Order.CertificateAmount 0.0}} Оплачивается электронным сертификатом {{money .Order.CertificateAmount}} руб.{{end}}` +
+			` Оплатить не позднее 5 банковских дней с даты счета.`,
+		Signatures: []string{"Руководитель", "Бухгалтер"},
+	},
+	order_entity.DocumentKindAct: {
+		Title: `Акт приема-передачи товара № {{.Number}} от {{date .Date}}`,
+		Parties: []party{
+			{Label: "Поставщик:", Value: sellerTemplate},
+			{Label: "Получатель:", Value: buyerTemplate},
+			{Label: "Адрес доставки:", Value: `{{if .Order.Delivery.Address}}{{.Order.Delivery.Address}}{{else}}самовывоз{{end}}`},
+		},
+		Basis: `Заказ № {{number .Order.ID}} от {{date .Order.CreatedAt}}`,
+		Note: `Всего наименований {{len .Order.Items}}, на сумму {{money .Order.Total}} руб. ` +
+			`Вышеперечисленные товары переданы полностью. Получатель претензий по количеству, качеству и комплектности не имеет.`,
+		Signatures: []string{"Передал", "Принял"},
+	},
+}
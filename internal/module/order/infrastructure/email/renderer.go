@@ -0,0 +1,69 @@
+package order_email
+
+import (
+	"bytes"
+	"fmt"
+	"html/template"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	order_entity "github.com/Fi44er/sdmed/internal/module/order/entity"
+	"github.com/Fi44er/sdmed/pkg/logger"
+)
+
+const (
+	// layoutFile - общая разметка писем версии
+	layoutFile = "layout.html"
+	// subjectTemplate - блок шаблона с темой письма
+	subjectTemplate = "subject"
+)
+
+// TemplateRenderer формирует письма по шаблонам из каталога dir. Шаблоны версионируются
+// каталогами: <dir>/<version>/<event>.html вместе с общей разметкой layout.html,
+// тема письма задается блоком {{define "subject"}}. Файлы читаются при каждой отправке,
+// поэтому правка шаблона не требует перезапуска
+type TemplateRenderer struct {
+	dir    string
+	logger *logger.Logger
+}
+
+func NewTemplateRenderer(logger *logger.Logger, dir string) *TemplateRenderer {
+	return &TemplateRenderer{
+		dir:    dir,
+		logger: logger,
+	}
+}
+
+func (r *TemplateRenderer) Render(version string, data *order_entity.NotificationData) (*order_entity.Email, error) {
+	dir := filepath.Join(r.dir, filepath.Base(version))
+	path := filepath.Join(dir, string(data.Event)+".html")
+	if _, err := os.Stat(path); err != nil {
+		return nil, fmt.Errorf("email template %s/%s is not found: %w", version, data.Event, err)
+	}
+
+	tmpl, err := template.New(filepath.Base(path)).Funcs(funcs).ParseFiles(filepath.Join(dir, layoutFile), path)
+	if err != nil {
+		r.logger.Errorf("Failed to parse email template %s: %v", path, err)
+		return nil, fmt.Errorf("failed to parse email template: %w", err)
+	}
+
+	var subject, body bytes.Buffer
+	if err := tmpl.ExecuteTemplate(&subject, subjectTemplate, data); err != nil {
+		return nil, fmt.Errorf("failed to execute email subject: %w", err)
+	}
+	if err := tmpl.Execute(&body, data); err != nil {
+		return nil, fmt.Errorf("failed to execute email template: %w", err)
+	}
+
+	return &order_entity.Email{
+		Subject: strings.TrimSpace(subject.String()),
+		Body:    strings.TrimSpace(body.String()),
+	}, nil
+}
+
+var funcs = template.FuncMap{
+	"date":  func(t time.Time) string { return t.Format("02.01.2006") },
+	"money": func(amount float64) string { return fmt.Sprintf("%.2f", amount) },
+}
@@ -0,0 +1,73 @@
+package order_email
+
+import (
+	"testing"
+	"time"
+
+	order_entity "github.com/Fi44er/sdmed/internal/module/order/entity"
+	"github.com/Fi44er/sdmed/pkg/logger"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testTemplateDir = "../../pkg/template/email"
+
+func testNotificationData(event order_entity.NotificationEvent) *order_entity.NotificationData {
+	order := &order_entity.Order{
+		ID:      "3f2a9c1e-7b4d-4e8a-9c2b-1d5e6f7a8b9c",
+		Status:  order_entity.OrderStatusShipped,
+		Contact: order_entity.Contact{Name: "Иван Петров", Phone: "+79990001122", Email: "ivan@example.com"},
+		Delivery: order_entity.Delivery{
+			Method:         order_entity.DeliveryMethodCourier,
+			MethodName:     "Курьер",
+			Address:        "г. Москва, ул. Ленина, д. 1",
+			Cost:           500,
+			TrackingNumber: "RA123456789RU",
+		},
+		Items: []order_entity.OrderItem{
+			{Name: "Трость опорная", Quantity: 2, Price: 990, Discount: 100},
+		},
+		CreatedAt: time.Date(2026, 3, 1, 10, 0, 0, 0, time.UTC),
+	}
+	order.CalculateTotal()
+
+	return &order_entity.NotificationData{
+		Event:  event,
+		Number: order_entity.DocumentNumber(order.ID),
+		Order:  order,
+	}
+}
+
+func TestTemplateRenderer_Render(t *testing.T) {
+	renderer := NewTemplateRenderer(logger.NewLogger(), testTemplateDir)
+
+	events := []order_entity.NotificationEvent{
+		order_entity.NotificationEventCreated,
+		order_entity.NotificationEventPaid,
+		order_entity.NotificationEventPaymentFailed,
+		order_entity.NotificationEventShipped,
+		order_entity.NotificationEventDelivered,
+		order_entity.NotificationEventCancelled,
+	}
+	for _, event := range events {
+		t.Run(string(event), func(t *testing.T) {
+			email, err := renderer.Render("v1", testNotificationData(event))
+			require.NoError(t, err)
+
+			assert.Contains(t, email.Subject, "3F2A9C1E")
+			assert.Contains(t, email.Body, "Иван Петров")
+			assert.Contains(t, email.Body, "2380.00")
+		})
+	}
+
+	t.Run("shipped email contains tracking number", func(t *testing.T) {
+		email, err := renderer.Render("v1", testNotificationData(order_entity.NotificationEventShipped))
+		require.NoError(t, err)
+		assert.Contains(t, email.Body, "RA123456789RU")
+	})
+
+	t.Run("unknown version", func(t *testing.T) {
+		_, err := renderer.Render("v0", testNotificationData(order_entity.NotificationEventCreated))
+		assert.Error(t, err)
+	})
+}
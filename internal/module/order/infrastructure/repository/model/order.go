@@ -0,0 +1,126 @@
+package order_model
+
+import "time"
+
+type Order struct {
+	ID        string  `gorm:"primaryKey;type:uuid;default:uuid_generate_v4();"`
+	UserID    string  `gorm:"type:uuid;not null;index"`
+	Status    string  `gorm:"type:varchar(20);not null;index"`
+	Total     float64 `gorm:"type:float;not null"`
+	Discount  float64 `gorm:"type:float;not null;default:0"`
+	PromoCode string  `gorm:"type:varchar(50);not null;default:''"`
+	// PromoCodeID без внешнего ключа: промокод можно удалить, заказ хранит код и скидку
+	PromoCodeID     *string `gorm:"type:uuid"`
+	ContactName     string  `gorm:"type:varchar(255);not null"`
+	ContactPhone    string  `gorm:"type:varchar(20);not null"`
+	ContactEmail    string  `gorm:"type:varchar(255);not null;default:''"`
+	DeliveryMethod  string  `gorm:"type:varchar(20);not null"`
+	DeliveryAddress string  `gorm:"type:text;not null;default:''"`
+	// DeliveryMethodID без внешнего ключа: способ доставки можно удалить, заказ хранит его название
+	DeliveryMethodID   *string `gorm:"type:uuid"`
+	DeliveryMethodName string  `gorm:"type:varchar(255);not null;default:''"`
+	// DeliveryAddressID без внешнего ключа: адрес можно удалить из адресной книги
+	DeliveryAddressID  *string    `gorm:"type:uuid"`
+	DeliveryPostalCode string     `gorm:"type:varchar(6);not null;default:''"`
+	DeliveryCost       float64    `gorm:"type:float;not null;default:0"`
+	DeliveryFrom       *time.Time `gorm:"type:date"`
+	DeliveryTo         *time.Time `gorm:"type:date"`
+	DeliveryTracking   string     `gorm:"type:varchar(100);not null;default:''"`
+	Comment            string     `gorm:"type:text;not null;default:''"`
+	RegionID           *string    `gorm:"type:uuid"`
+	ManagerID          *string    `gorm:"type:uuid;index"`
+
+	PaymentMethod     string     `gorm:"type:varchar(20);not null;default:'card';index"`
+	CertificateNumber string     `gorm:"type:varchar(30);not null;default:''"`
+	SNILS             string     `gorm:"column:snils;type:varchar(14);not null;default:''"`
+	CertificateAmount float64    `gorm:"type:float;not null;default:0"`
+	SurchargePaid     float64    `gorm:"type:float;not null;default:0"`
+	SurchargePaidAt   *time.Time `gorm:""`
+
+	Items     []OrderItem          `gorm:"foreignKey:OrderID;constraint:OnDelete:CASCADE"`
+	History   []OrderStatusHistory `gorm:"foreignKey:OrderID;constraint:OnDelete:CASCADE"`
+	CreatedAt time.Time            `gorm:"not null;default:now();index"`
+	UpdatedAt time.Time            `gorm:"not null;default:now()"`
+}
+
+func (Order) TableName() string {
+	return "order_module.orders"
+}
+
+type OrderItem struct {
+	ID        string  `gorm:"primaryKey;type:uuid;default:uuid_generate_v4();"`
+	OrderID   string  `gorm:"type:uuid;not null;index"`
+	ProductID string  `gorm:"type:uuid;not null;index"`
+	Name      string  `gorm:"type:varchar(255);not null"`
+	Article   string  `gorm:"type:varchar(255);not null;default:''"`
+	Quantity  int     `gorm:"not null"`
+	Price     float64 `gorm:"type:float;not null"`
+	Discount  float64 `gorm:"type:float;not null;default:0"`
+
+	TRUCodeID         *string `gorm:"type:uuid"`
+	TRUCode           string  `gorm:"type:varchar(50);not null;default:''"`
+	CertificateAmount float64 `gorm:"type:float;not null;default:0"`
+	CertificateStatus string  `gorm:"type:varchar(20);not null;default:'';index"`
+}
+
+func (OrderItem) TableName() string {
+	return "order_module.order_items"
+}
+
+type OrderStatusHistory struct {
+	ID         string    `gorm:"primaryKey;type:uuid;default:uuid_generate_v4();"`
+	OrderID    string    `gorm:"type:uuid;not null;index"`
+	FromStatus string    `gorm:"type:varchar(20);not null;default:''"`
+	ToStatus   string    `gorm:"type:varchar(20);not null"`
+	Actor      string    `gorm:"type:varchar(20);not null"`
+	ActorID    *string   `gorm:"type:uuid"`
+	Comment    string    `gorm:"type:text;not null;default:''"`
+	CreatedAt  time.Time `gorm:"not null;default:now();index"`
+}
+
+func (OrderStatusHistory) TableName() string {
+	return "order_module.order_status_history"
+}
+
+type OrderDocument struct {
+	ID        string    `gorm:"primaryKey;type:uuid;default:uuid_generate_v4();"`
+	OrderID   string    `gorm:"type:uuid;not null;uniqueIndex:idx_order_document_kind"`
+	Kind      string    `gorm:"type:varchar(20);not null;uniqueIndex:idx_order_document_kind"`
+	Number    string    `gorm:"type:varchar(20);not null"`
+	FileID    string    `gorm:"type:uuid;not null"`
+	FileName  string    `gorm:"type:varchar(255);not null"`
+	CreatedAt time.Time `gorm:"not null;default:now()"`
+}
+
+func (OrderDocument) TableName() string {
+	return "order_module.order_documents"
+}
+
+type OrderNotification struct {
+	ID              string    `gorm:"primaryKey;type:uuid;default:uuid_generate_v4();"`
+	OrderID         string    `gorm:"type:uuid;not null;index"`
+	Event           string    `gorm:"type:varchar(30);not null"`
+	TemplateVersion string    `gorm:"type:varchar(20);not null"`
+	Recipient       string    `gorm:"type:varchar(255);not null;default:''"`
+	Subject         string    `gorm:"type:varchar(255);not null;default:''"`
+	Status          string    `gorm:"type:varchar(20);not null"`
+	Error           string    `gorm:"type:text;not null;default:''"`
+	ResendOfID      *string   `gorm:"type:uuid"`
+	CreatedAt       time.Time `gorm:"not null;default:now();index"`
+}
+
+func (OrderNotification) TableName() string {
+	return "order_module.order_notifications"
+}
+
+type OrderComment struct {
+	ID        string    `gorm:"primaryKey;type:uuid;default:uuid_generate_v4();"`
+	OrderID   string    `gorm:"type:uuid;not null;index"`
+	AuthorID  *string   `gorm:"type:uuid"`
+	Text      string    `gorm:"type:text;not null"`
+	CreatedAt time.Time `gorm:"not null;default:now()"`
+}
+
+func (OrderComment) TableName() string {
+	return "order_module.order_comments"
+}
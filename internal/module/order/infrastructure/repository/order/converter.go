@@ -0,0 +1,257 @@
+package order_repository
+
+import (
+	"time"
+
+	order_entity "github.com/Fi44er/sdmed/internal/module/order/entity"
+	order_model "github.com/Fi44er/sdmed/internal/module/order/infrastructure/repository/model"
+)
+
+type Converter struct{}
+
+func (c *Converter) ToModel(entity *order_entity.Order) *order_model.Order {
+	items := make([]order_model.OrderItem, len(entity.Items))
+	for i := range entity.Items {
+		items[i] = order_model.OrderItem{
+			ProductID:         entity.Items[i].ProductID,
+			Name:              entity.Items[i].Name,
+			Article:           entity.Items[i].Article,
+			Quantity:          entity.Items[i].Quantity,
+			Price:             entity.Items[i].Price,
+			Discount:          entity.Items[i].Discount,
+			TRUCodeID:         optional(entity.Items[i].TRUCodeID),
+			TRUCode:           entity.Items[i].TRUCode,
+			CertificateAmount: entity.Items[i].CertificateAmount,
+			CertificateStatus: string(entity.Items[i].CertificateStatus),
+		}
+	}
+
+	orderModel := &order_model.Order{
+		ID:                 entity.ID,
+		UserID:             entity.UserID,
+		Status:             string(entity.Status),
+		Total:              entity.Total,
+		Discount:           entity.Discount,
+		PromoCode:          entity.PromoCode,
+		PromoCodeID:        optional(entity.PromoCodeID),
+		ContactName:        entity.Contact.Name,
+		ContactPhone:       entity.Contact.Phone,
+		ContactEmail:       entity.Contact.Email,
+		DeliveryMethod:     string(entity.Delivery.Method),
+		DeliveryAddress:    entity.Delivery.Address,
+		DeliveryMethodID:   optional(entity.Delivery.MethodID),
+		DeliveryMethodName: entity.Delivery.MethodName,
+		DeliveryAddressID:  optional(entity.Delivery.AddressID),
+		DeliveryPostalCode: entity.Delivery.PostalCode,
+		DeliveryCost:       entity.Delivery.Cost,
+		DeliveryFrom:       optionalTime(entity.Delivery.EstimatedFrom),
+		DeliveryTo:         optionalTime(entity.Delivery.EstimatedTo),
+		DeliveryTracking:   entity.Delivery.TrackingNumber,
+		Comment:            entity.Comment,
+		RegionID:           optional(entity.RegionID),
+		ManagerID:          optional(entity.ManagerID),
+		PaymentMethod:      string(entity.PaymentMethod),
+		Items:              items,
+	}
+	if entity.Certificate != nil {
+		orderModel.CertificateNumber = entity.Certificate.Number
+		orderModel.SNILS = entity.Certificate.SNILS
+		orderModel.CertificateAmount = entity.CertificateAmount
+	}
+
+	return orderModel
+}
+
+func (c *Converter) ToEntity(model *order_model.Order) *order_entity.Order {
+	items := make([]order_entity.OrderItem, len(model.Items))
+	for i := range model.Items {
+		items[i] = order_entity.OrderItem{
+			ID:        model.Items[i].ID,
+			OrderID:   model.Items[i].OrderID,
+			ProductID: model.Items[i].ProductID,
+			Name:      model.Items[i].Name,
+			Article:   model.Items[i].Article,
+			Quantity:  model.Items[i].Quantity,
+			Price:     model.Items[i].Price,
+			Discount:  model.Items[i].Discount,
+
+			TRUCodeID:         value(model.Items[i].TRUCodeID),
+			TRUCode:           model.Items[i].TRUCode,
+			CertificateAmount: model.Items[i].CertificateAmount,
+			CertificateStatus: order_entity.CertificateStatus(model.Items[i].CertificateStatus),
+		}
+	}
+
+	history := make([]order_entity.StatusChange, len(model.History))
+	for i := range model.History {
+		history[i] = *c.ToHistoryEntity(&model.History[i])
+	}
+
+	var certificate *order_entity.Certificate
+	if model.CertificateNumber != "" {
+		certificate = &order_entity.Certificate{Number: model.CertificateNumber, SNILS: model.SNILS}
+	}
+
+	return &order_entity.Order{
+		ID:     model.ID,
+		UserID: model.UserID,
+		Status: order_entity.OrderStatus(model.Status),
+		Items:  items,
+		Total:  model.Total,
+
+		Discount:    model.Discount,
+		PromoCode:   model.PromoCode,
+		PromoCodeID: value(model.PromoCodeID),
+		Contact: order_entity.Contact{
+			Name:  model.ContactName,
+			Phone: model.ContactPhone,
+			Email: model.ContactEmail,
+		},
+		Delivery: order_entity.Delivery{
+			Method:        order_entity.DeliveryMethod(model.DeliveryMethod),
+			MethodID:      value(model.DeliveryMethodID),
+			MethodName:    model.DeliveryMethodName,
+			AddressID:     value(model.DeliveryAddressID),
+			Address:       model.DeliveryAddress,
+			PostalCode:    model.DeliveryPostalCode,
+			Cost:          model.DeliveryCost,
+			EstimatedFrom: timeValue(model.DeliveryFrom),
+			EstimatedTo:   timeValue(model.DeliveryTo),
+
+			TrackingNumber: model.DeliveryTracking,
+		},
+		Comment:           model.Comment,
+		RegionID:          value(model.RegionID),
+		ManagerID:         value(model.ManagerID),
+		PaymentMethod:     order_entity.PaymentMethod(model.PaymentMethod),
+		Certificate:       certificate,
+		CertificateAmount: model.CertificateAmount,
+		SurchargePaid:     model.SurchargePaid,
+		SurchargePaidAt:   model.SurchargePaidAt,
+		History:           history,
+		CreatedAt:         model.CreatedAt,
+		UpdatedAt:         model.UpdatedAt,
+	}
+}
+
+func (c *Converter) ToHistoryModel(entity *order_entity.StatusChange) *order_model.OrderStatusHistory {
+	return &order_model.OrderStatusHistory{
+		OrderID:    entity.OrderID,
+		FromStatus: string(entity.FromStatus),
+		ToStatus:   string(entity.ToStatus),
+		Actor:      string(entity.Actor),
+		ActorID:    optional(entity.ActorID),
+		Comment:    entity.Comment,
+	}
+}
+
+func (c *Converter) ToHistoryEntity(model *order_model.OrderStatusHistory) *order_entity.StatusChange {
+	return &order_entity.StatusChange{
+		ID:         model.ID,
+		OrderID:    model.OrderID,
+		FromStatus: order_entity.OrderStatus(model.FromStatus),
+		ToStatus:   order_entity.OrderStatus(model.ToStatus),
+		Actor:      order_entity.Actor(model.Actor),
+		ActorID:    value(model.ActorID),
+		Comment:    model.Comment,
+		CreatedAt:  model.CreatedAt,
+	}
+}
+
+// optional - пустая строка хранится как NULL
+func (c *Converter) ToDocumentModel(entity *order_entity.Document) *order_model.OrderDocument {
+	return &order_model.OrderDocument{
+		OrderID:   entity.OrderID,
+		Kind:      string(entity.Kind),
+		Number:    entity.Number,
+		FileID:    entity.FileID,
+		FileName:  entity.FileName,
+		CreatedAt: time.Now(),
+	}
+}
+
+func (c *Converter) ToDocumentEntity(model *order_model.OrderDocument) *order_entity.Document {
+	return &order_entity.Document{
+		ID:        model.ID,
+		OrderID:   model.OrderID,
+		Kind:      order_entity.DocumentKind(model.Kind),
+		Number:    model.Number,
+		FileID:    model.FileID,
+		FileName:  model.FileName,
+		CreatedAt: model.CreatedAt,
+	}
+}
+
+func (c *Converter) ToNotificationModel(entity *order_entity.Notification) *order_model.OrderNotification {
+	return &order_model.OrderNotification{
+		OrderID:         entity.OrderID,
+		Event:           string(entity.Event),
+		TemplateVersion: entity.TemplateVersion,
+		Recipient:       entity.Recipient,
+		Subject:         entity.Subject,
+		Status:          string(entity.Status),
+		Error:           entity.Error,
+		ResendOfID:      optional(entity.ResendOfID),
+	}
+}
+
+func (c *Converter) ToNotificationEntity(model *order_model.OrderNotification) *order_entity.Notification {
+	return &order_entity.Notification{
+		ID:              model.ID,
+		OrderID:         model.OrderID,
+		Event:           order_entity.NotificationEvent(model.Event),
+		TemplateVersion: model.TemplateVersion,
+		Recipient:       model.Recipient,
+		Subject:         model.Subject,
+		Status:          order_entity.NotificationStatus(model.Status),
+		Error:           model.Error,
+		ResendOfID:      value(model.ResendOfID),
+		CreatedAt:       model.CreatedAt,
+	}
+}
+
+func (c *Converter) ToCommentModel(entity *order_entity.InternalComment) *order_model.OrderComment {
+	return &order_model.OrderComment{
+		OrderID:  entity.OrderID,
+		AuthorID: optional(entity.AuthorID),
+		Text:     entity.Text,
+	}
+}
+
+func (c *Converter) ToCommentEntity(model *order_model.OrderComment) *order_entity.InternalComment {
+	return &order_entity.InternalComment{
+		ID:        model.ID,
+		OrderID:   model.OrderID,
+		AuthorID:  value(model.AuthorID),
+		Text:      model.Text,
+		CreatedAt: model.CreatedAt,
+	}
+}
+
+func optional(value string) *string {
+	if value == "" {
+		return nil
+	}
+	return &value
+}
+
+func value(ptr *string) string {
+	if ptr == nil {
+		return ""
+	}
+	return *ptr
+}
+
+func optionalTime(value time.Time) *time.Time {
+	if value.IsZero() {
+		return nil
+	}
+	return &value
+}
+
+func timeValue(ptr *time.Time) time.Time {
+	if ptr == nil {
+		return time.Time{}
+	}
+	return *ptr
+}
@@ -0,0 +1,455 @@
+package order_repository
+
+import (
+	"context"
+
+	order_entity "github.com/Fi44er/sdmed/internal/module/order/entity"
+	order_model "github.com/Fi44er/sdmed/internal/module/order/infrastructure/repository/model"
+	"github.com/Fi44er/sdmed/pkg/logger"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type IOrderRepository interface {
+	Create(ctx context.Context, order *order_entity.Order) error
+	GetByID(ctx context.Context, id string) (*order_entity.Order, error)
+	GetAll(ctx context.Context, filter *order_entity.OrderFilter) ([]order_entity.Order, error)
+	Count(ctx context.Context, filter *order_entity.OrderFilter) (int64, error)
+	UpdateStatus(ctx context.Context, id string, from, to order_entity.OrderStatus) (bool, error)
+	AddHistory(ctx context.Context, change *order_entity.StatusChange) error
+	UpdateItemCertificateStatus(ctx context.Context, itemID string, status order_entity.CertificateStatus) error
+	UpdateCertificateAmount(ctx context.Context, id string, amount float64) error
+	AddSurchargePaid(ctx context.Context, id string, amount float64) error
+	GetDocument(ctx context.Context, orderID string, kind order_entity.DocumentKind) (*order_entity.Document, error)
+	SaveDocument(ctx context.Context, document *order_entity.Document) error
+	UpdateTrackingNumber(ctx context.Context, id, trackingNumber string) error
+	AddNotification(ctx context.Context, notification *order_entity.Notification) error
+	GetNotification(ctx context.Context, id string) (*order_entity.Notification, error)
+	GetNotifications(ctx context.Context, orderID string) ([]order_entity.Notification, error)
+	UpdateItems(ctx context.Context, order *order_entity.Order) error
+	UpdateDelivery(ctx context.Context, order *order_entity.Order) error
+	UpdateManager(ctx context.Context, id, managerID string) error
+	AddComment(ctx context.Context, comment *order_entity.InternalComment) error
+	GetComments(ctx context.Context, orderID string) ([]order_entity.InternalComment, error)
+}
+
+type OrderRepository struct {
+	logger    *logger.Logger
+	db        *gorm.DB
+	converter *Converter
+}
+
+func NewOrderRepository(logger *logger.Logger, db *gorm.DB) IOrderRepository {
+	return &OrderRepository{
+		logger:    logger,
+		db:        db,
+		converter: &Converter{},
+	}
+}
+
+func (r *OrderRepository) Create(ctx context.Context, order *order_entity.Order) error {
+	r.logger.Infof("Creating order for user: %s", order.UserID)
+
+	orderModel := r.converter.ToModel(order)
+	if err := r.db.WithContext(ctx).Create(orderModel).Error; err != nil {
+		r.logger.Errorf("Failed to create order for user %s: %v", order.UserID, err)
+		return err
+	}
+
+	created := r.converter.ToEntity(orderModel)
+	order.ID = created.ID
+	order.Items = created.Items
+	order.CreatedAt = created.CreatedAt
+	order.UpdatedAt = created.UpdatedAt
+
+	return nil
+}
+
+func (r *OrderRepository) GetByID(ctx context.Context, id string) (*order_entity.Order, error) {
+	r.logger.Debugf("Getting order: %s", id)
+
+	var orderModel order_model.Order
+	err := r.db.WithContext(ctx).
+		Preload("Items").
+		Preload("History", func(db *gorm.DB) *gorm.DB {
+			return db.Order("created_at ASC")
+		}).
+		First(&orderModel, "id = ?", id).Error
+	if err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, nil
+		}
+		r.logger.Errorf("Failed to get order %s: %v", id, err)
+		return nil, err
+	}
+
+	return r.converter.ToEntity(&orderModel), nil
+}
+
+func (r *OrderRepository) GetAll(ctx context.Context, filter *order_entity.OrderFilter) ([]order_entity.Order, error) {
+	var orderModels []order_model.Order
+	query := r.applyFilter(r.db.WithContext(ctx).Model(&order_model.Order{}), filter)
+	err := query.Preload("Items").
+		Order("created_at DESC").
+		Offset(filter.Offset).
+		Limit(filter.Limit).
+		Find(&orderModels).Error
+	if err != nil {
+		r.logger.Errorf("Failed to get orders: %v", err)
+		return nil, err
+	}
+
+	orders := make([]order_entity.Order, len(orderModels))
+	for i := range orderModels {
+		orders[i] = *r.converter.ToEntity(&orderModels[i])
+	}
+
+	return orders, nil
+}
+
+func (r *OrderRepository) Count(ctx context.Context, filter *order_entity.OrderFilter) (int64, error) {
+	var count int64
+	query := r.applyFilter(r.db.WithContext(ctx).Model(&order_model.Order{}), filter)
+	if err := query.Count(&count).Error; err != nil {
+		r.logger.Errorf("Failed to count orders: %v", err)
+		return 0, err
+	}
+
+	return count, nil
+}
+
+// UpdateStatus меняет статус, только если заказ все еще в статусе from.
+// Возвращает false, если статус успели изменить
+func (r *OrderRepository) UpdateStatus(ctx context.Context, id string, from, to order_entity.OrderStatus) (bool, error) {
+	r.logger.Infof("Updating order %s status: %s -> %s", id, from, to)
+
+	result := r.db.WithContext(ctx).
+		Model(&order_model.Order{}).
+		Where("id = ? AND status = ?", id, string(from)).
+		Updates(map[string]any{
+			"status":     string(to),
+			"updated_at": gorm.Expr("now()"),
+		})
+	if result.Error != nil {
+		r.logger.Errorf("Failed to update order %s status: %v", id, result.Error)
+		return false, result.Error
+	}
+
+	return result.RowsAffected > 0, nil
+}
+
+func (r *OrderRepository) AddHistory(ctx context.Context, change *order_entity.StatusChange) error {
+	historyModel := r.converter.ToHistoryModel(change)
+	if err := r.db.WithContext(ctx).Create(historyModel).Error; err != nil {
+		r.logger.Errorf("Failed to add history for order %s: %v", change.OrderID, err)
+		return err
+	}
+	change.ID = historyModel.ID
+	change.CreatedAt = historyModel.CreatedAt
+
+	return nil
+}
+
+func (r *OrderRepository) UpdateItemCertificateStatus(ctx context.Context, itemID string, status order_entity.CertificateStatus) error {
+	err := r.db.WithContext(ctx).
+		Model(&order_model.OrderItem{}).
+		Where("id = ?", itemID).
+		Update("certificate_status", string(status)).Error
+	if err != nil {
+		r.logger.Errorf("Failed to update certificate status of order item %s: %v", itemID, err)
+		return err
+	}
+
+	return nil
+}
+
+// AddSurchargePaid прибавляет оплату доплаты картой по заказу с сертификатом
+func (r *OrderRepository) AddSurchargePaid(ctx context.Context, id string, amount float64) error {
+	err := r.db.WithContext(ctx).
+		Model(&order_model.Order{}).
+		Where("id = ?", id).
+		Updates(map[string]any{
+			"surcharge_paid":    gorm.Expr("surcharge_paid + ?", amount),
+			"surcharge_paid_at": gorm.Expr("now()"),
+			"updated_at":        gorm.Expr("now()"),
+		}).Error
+	if err != nil {
+		r.logger.Errorf("Failed to mark surcharge of order %s as paid: %v", id, err)
+		return err
+	}
+
+	return nil
+}
+
+func (r *OrderRepository) UpdateCertificateAmount(ctx context.Context, id string, amount float64) error {
+	err := r.db.WithContext(ctx).
+		Model(&order_model.Order{}).
+		Where("id = ?", id).
+		Updates(map[string]any{
+			"certificate_amount": amount,
+			"updated_at":         gorm.Expr("now()"),
+		}).Error
+	if err != nil {
+		r.logger.Errorf("Failed to update certificate amount of order %s: %v", id, err)
+		return err
+	}
+
+	return nil
+}
+
+func (r *OrderRepository) GetDocument(ctx context.Context, orderID string, kind order_entity.DocumentKind) (*order_entity.Document, error) {
+	var documentModel order_model.OrderDocument
+	err := r.db.WithContext(ctx).
+		First(&documentModel, "order_id = ? AND kind = ?", orderID, string(kind)).Error
+	if err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, nil
+		}
+		r.logger.Errorf("Failed to get %s document of order %s: %v", kind, orderID, err)
+		return nil, err
+	}
+
+	return r.converter.ToDocumentEntity(&documentModel), nil
+}
+
+// SaveDocument сохраняет документ заказа, заменяя ранее сформированный документ того же вида
+func (r *OrderRepository) SaveDocument(ctx context.Context, document *order_entity.Document) error {
+	r.logger.Infof("Saving %s document of order %s", document.Kind, document.OrderID)
+
+	documentModel := r.converter.ToDocumentModel(document)
+	err := r.db.WithContext(ctx).
+		Clauses(clause.OnConflict{
+			Columns:   []clause.Column{{Name: "order_id"}, {Name: "kind"}},
+			DoUpdates: clause.AssignmentColumns([]string{"number", "file_id", "file_name", "created_at"}),
+		}).
+		Create(documentModel).Error
+	if err != nil {
+		r.logger.Errorf("Failed to save %s document of order %s: %v", document.Kind, document.OrderID, err)
+		return err
+	}
+	document.ID = documentModel.ID
+	document.CreatedAt = documentModel.CreatedAt
+
+	return nil
+}
+
+func (r *OrderRepository) UpdateTrackingNumber(ctx context.Context, id, trackingNumber string) error {
+	err := r.db.WithContext(ctx).
+		Model(&order_model.Order{}).
+		Where("id = ?", id).
+		Updates(map[string]any{
+			"delivery_tracking": trackingNumber,
+			"updated_at":        gorm.Expr("now()"),
+		}).Error
+	if err != nil {
+		r.logger.Errorf("Failed to update tracking number of order %s: %v", id, err)
+		return err
+	}
+
+	return nil
+}
+
+func (r *OrderRepository) AddNotification(ctx context.Context, notification *order_entity.Notification) error {
+	notificationModel := r.converter.ToNotificationModel(notification)
+	if err := r.db.WithContext(ctx).Create(notificationModel).Error; err != nil {
+		r.logger.Errorf("Failed to record %s notification of order %s: %v", notification.Event, notification.OrderID, err)
+		return err
+	}
+	notification.ID = notificationModel.ID
+	notification.CreatedAt = notificationModel.CreatedAt
+
+	return nil
+}
+
+func (r *OrderRepository) GetNotification(ctx context.Context, id string) (*order_entity.Notification, error) {
+	var notificationModel order_model.OrderNotification
+	if err := r.db.WithContext(ctx).First(&notificationModel, "id = ?", id).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, nil
+		}
+		r.logger.Errorf("Failed to get order notification %s: %v", id, err)
+		return nil, err
+	}
+
+	return r.converter.ToNotificationEntity(&notificationModel), nil
+}
+
+func (r *OrderRepository) GetNotifications(ctx context.Context, orderID string) ([]order_entity.Notification, error) {
+	var notificationModels []order_model.OrderNotification
+	err := r.db.WithContext(ctx).
+		Where("order_id = ?", orderID).
+		Order("created_at ASC").
+		Find(&notificationModels).Error
+	if err != nil {
+		r.logger.Errorf("Failed to get notifications of order %s: %v", orderID, err)
+		return nil, err
+	}
+
+	notifications := make([]order_entity.Notification, len(notificationModels))
+	for i := range notificationModels {
+		notifications[i] = *r.converter.ToNotificationEntity(&notificationModels[i])
+	}
+
+	return notifications, nil
+}
+
+// UpdateItems сохраняет состав заказа после правки: удаляет убранные позиции,
+// обновляет количество, скидки и покрытие сертификатом остальных и суммы заказа
+func (r *OrderRepository) UpdateItems(ctx context.Context, order *order_entity.Order) error {
+	r.logger.Infof("Updating items of order %s", order.ID)
+
+	ids := make([]string, len(order.Items))
+	for i := range order.Items {
+		ids[i] = order.Items[i].ID
+	}
+	err := r.db.WithContext(ctx).
+		Where("order_id = ? AND id NOT IN ?", order.ID, ids).
+		Delete(&order_model.OrderItem{}).Error
+	if err != nil {
+		r.logger.Errorf("Failed to delete removed items of order %s: %v", order.ID, err)
+		return err
+	}
+
+	for _, item := range order.Items {
+		err := r.db.WithContext(ctx).
+			Model(&order_model.OrderItem{}).
+			Where("id = ?", item.ID).
+			Updates(map[string]any{
+				"quantity":           item.Quantity,
+				"discount":           item.Discount,
+				"certificate_amount": item.CertificateAmount,
+			}).Error
+		if err != nil {
+			r.logger.Errorf("Failed to update order item %s: %v", item.ID, err)
+			return err
+		}
+	}
+
+	err = r.db.WithContext(ctx).
+		Model(&order_model.Order{}).
+		Where("id = ?", order.ID).
+		Updates(map[string]any{
+			"total":              order.Total,
+			"discount":           order.Discount,
+			"certificate_amount": order.CertificateAmount,
+			"updated_at":         gorm.Expr("now()"),
+		}).Error
+	if err != nil {
+		r.logger.Errorf("Failed to update totals of order %s: %v", order.ID, err)
+		return err
+	}
+
+	return nil
+}
+
+func (r *OrderRepository) UpdateDelivery(ctx context.Context, order *order_entity.Order) error {
+	r.logger.Infof("Updating delivery of order %s", order.ID)
+
+	orderModel := r.converter.ToModel(order)
+	err := r.db.WithContext(ctx).
+		Model(&order_model.Order{}).
+		Where("id = ?", order.ID).
+		Updates(map[string]any{
+			"total":                order.Total,
+			"delivery_method":      orderModel.DeliveryMethod,
+			"delivery_method_id":   orderModel.DeliveryMethodID,
+			"delivery_method_name": orderModel.DeliveryMethodName,
+			"delivery_address":     orderModel.DeliveryAddress,
+			"delivery_address_id":  orderModel.DeliveryAddressID,
+			"delivery_postal_code": orderModel.DeliveryPostalCode,
+			"delivery_cost":        orderModel.DeliveryCost,
+			"delivery_from":        orderModel.DeliveryFrom,
+			"delivery_to":          orderModel.DeliveryTo,
+			"updated_at":           gorm.Expr("now()"),
+		}).Error
+	if err != nil {
+		r.logger.Errorf("Failed to update delivery of order %s: %v", order.ID, err)
+		return err
+	}
+
+	return nil
+}
+
+func (r *OrderRepository) UpdateManager(ctx context.Context, id, managerID string) error {
+	err := r.db.WithContext(ctx).
+		Model(&order_model.Order{}).
+		Where("id = ?", id).
+		Updates(map[string]any{
+			"manager_id": optional(managerID),
+			"updated_at": gorm.Expr("now()"),
+		}).Error
+	if err != nil {
+		r.logger.Errorf("Failed to update manager of order %s: %v", id, err)
+		return err
+	}
+
+	return nil
+}
+
+func (r *OrderRepository) AddComment(ctx context.Context, comment *order_entity.InternalComment) error {
+	commentModel := r.converter.ToCommentModel(comment)
+	if err := r.db.WithContext(ctx).Create(commentModel).Error; err != nil {
+		r.logger.Errorf("Failed to add comment to order %s: %v", comment.OrderID, err)
+		return err
+	}
+	comment.ID = commentModel.ID
+	comment.CreatedAt = commentModel.CreatedAt
+
+	return nil
+}
+
+func (r *OrderRepository) GetComments(ctx context.Context, orderID string) ([]order_entity.InternalComment, error) {
+	var commentModels []order_model.OrderComment
+	err := r.db.WithContext(ctx).
+		Where("order_id = ?", orderID).
+		Order("created_at ASC").
+		Find(&commentModels).Error
+	if err != nil {
+		r.logger.Errorf("Failed to get comments of order %s: %v", orderID, err)
+		return nil, err
+	}
+
+	comments := make([]order_entity.InternalComment, len(commentModels))
+	for i := range commentModels {
+		comments[i] = *r.converter.ToCommentEntity(&commentModels[i])
+	}
+
+	return comments, nil
+}
+
+func (r *OrderRepository) applyFilter(query *gorm.DB, filter *order_entity.OrderFilter) *gorm.DB {
+	if filter.Status != "" {
+		query = query.Where("status = ?", string(filter.Status))
+	}
+	if filter.UserID != "" {
+		query = query.Where("user_id = ?", filter.UserID)
+	}
+	if filter.PaymentMethod != "" {
+		query = query.Where("payment_method = ?", string(filter.PaymentMethod))
+	}
+	if filter.ManagerID != "" {
+		query = query.Where("manager_id = ?", filter.ManagerID)
+	}
+	if filter.Number != "" {
+		query = query.Where("replace(id::text, '-', '') LIKE ?", filter.Number+"%")
+	}
+	if filter.Email != "" {
+		query = query.Where("contact_email ILIKE ?", "%"+filter.Email+"%")
+	}
+	if filter.Phone != "" {
+		query = query.Where("regexp_replace(contact_phone, '\\D', '', 'g') LIKE ?", "%"+filter.Phone+"%")
+	}
+	if filter.Article != "" {
+		query = query.Where(
+			"EXISTS (SELECT 1 FROM order_module.order_items WHERE order_items.order_id = orders.id AND order_items.article ILIKE ?)",
+			"%"+filter.Article+"%",
+		)
+	}
+	if !filter.CreatedFrom.IsZero() {
+		query = query.Where("created_at >= ?", filter.CreatedFrom)
+	}
+	if !filter.CreatedTo.IsZero() {
+		query = query.Where("created_at < ?", filter.CreatedTo)
+	}
+	return query
+}
@@ -0,0 +1,153 @@
+package order_module
+
+import (
+	"github.com/Fi44er/sdmed/internal/config"
+	address_usecase "github.com/Fi44er/sdmed/internal/module/address/usecase/address"
+	cart_usecase "github.com/Fi44er/sdmed/internal/module/cart/usecase/cart"
+	delivery_usecase "github.com/Fi44er/sdmed/internal/module/delivery/usecase/delivery"
+	discount_usecase "github.com/Fi44er/sdmed/internal/module/discount/usecase/discount"
+	file_usecase "github.com/Fi44er/sdmed/internal/module/file/usecase/file"
+	"github.com/Fi44er/sdmed/internal/module/notification/service"
+	order_http "github.com/Fi44er/sdmed/internal/module/order/delivery/http"
+	order_entity "github.com/Fi44er/sdmed/internal/module/order/entity"
+	order_adapters "github.com/Fi44er/sdmed/internal/module/order/infrastructure/adapters"
+	order_document "github.com/Fi44er/sdmed/internal/module/order/infrastructure/document"
+	order_email "github.com/Fi44er/sdmed/internal/module/order/infrastructure/email"
+	order_repository "github.com/Fi44er/sdmed/internal/module/order/infrastructure/repository/order"
+	order_usecase "github.com/Fi44er/sdmed/internal/module/order/usecase/order"
+	order_usecase_contracts "github.com/Fi44er/sdmed/internal/module/order/usecase/order/contracts"
+	payment_usecase "github.com/Fi44er/sdmed/internal/module/payment/usecase/payment"
+	region_usecase "github.com/Fi44er/sdmed/internal/module/region/usecase/region"
+	stock_usecase "github.com/Fi44er/sdmed/internal/module/stock/usecase/stock"
+	tru_usecase "github.com/Fi44er/sdmed/internal/module/tru/usecase/tru"
+	user_usecase "github.com/Fi44er/sdmed/internal/module/user/usecase/user"
+	"github.com/Fi44er/sdmed/pkg/logger"
+	"github.com/Fi44er/sdmed/pkg/postgres/uow"
+	"github.com/go-playground/validator/v10"
+	"github.com/gofiber/fiber/v2"
+	"gorm.io/gorm"
+)
+
+type OrderModule struct {
+	orderRepository order_repository.IOrderRepository
+	orderUsecase    *order_usecase.OrderUsecase
+	orderHandler    *order_http.OrderHandler
+	releaser        *order_usecase.ReservationReleaser
+
+	sessionRepository   order_usecase_contracts.ISessionRepository
+	cartUsecase         cart_usecase.ICartUsecase
+	deliveryUsecase     delivery_usecase.IDeliveryUsecase
+	addressUsecase      address_usecase.IAddressUsecase
+	discountUsecase     discount_usecase.IDiscountUsecase
+	regionUsecase       region_usecase.IRegionUsecase
+	truUsecase          tru_usecase.ITRUUsecase
+	fileUsecase         file_usecase.IFileUsecase
+	userUsecase         *user_usecase.UserUsecase
+	stockUsecase        stock_usecase.IStockUsecase
+	notificationService *service.NotificationService
+
+	logger    *logger.Logger
+	validator *validator.Validate
+	db        *gorm.DB
+	uow       uow.Uow
+	config    *config.Config
+}
+
+func NewOrderModule(
+	logger *logger.Logger,
+	validator *validator.Validate,
+	db *gorm.DB,
+	uow uow.Uow,
+	config *config.Config,
+	sessionRepository order_usecase_contracts.ISessionRepository,
+	cartUsecase cart_usecase.ICartUsecase,
+	deliveryUsecase delivery_usecase.IDeliveryUsecase,
+	addressUsecase address_usecase.IAddressUsecase,
+	discountUsecase discount_usecase.IDiscountUsecase,
+	regionUsecase region_usecase.IRegionUsecase,
+	truUsecase tru_usecase.ITRUUsecase,
+	fileUsecase file_usecase.IFileUsecase,
+	userUsecase *user_usecase.UserUsecase,
+	stockUsecase stock_usecase.IStockUsecase,
+	notificationService *service.NotificationService,
+) *OrderModule {
+	return &OrderModule{
+		logger:              logger,
+		validator:           validator,
+		db:                  db,
+		uow:                 uow,
+		config:              config,
+		sessionRepository:   sessionRepository,
+		cartUsecase:         cartUsecase,
+		deliveryUsecase:     deliveryUsecase,
+		addressUsecase:      addressUsecase,
+		discountUsecase:     discountUsecase,
+		regionUsecase:       regionUsecase,
+		truUsecase:          truUsecase,
+		fileUsecase:         fileUsecase,
+		userUsecase:         userUsecase,
+		stockUsecase:        stockUsecase,
+		notificationService: notificationService,
+	}
+}
+
+func (m *OrderModule) Init() {
+	m.uow.RegisterRepository("order", func(tx *gorm.DB) (any, error) {
+		return order_repository.NewOrderRepository(m.logger, tx), nil
+	})
+
+	m.orderRepository = order_repository.NewOrderRepository(m.logger, m.db)
+	m.orderUsecase = order_usecase.NewOrderUsecase(
+		m.orderRepository,
+		m.sessionRepository,
+		order_adapters.NewCartUsecaseAdapter(m.cartUsecase),
+		order_adapters.NewDeliveryUsecaseAdapter(m.deliveryUsecase),
+		order_adapters.NewAddressUsecaseAdapter(m.addressUsecase),
+		order_adapters.NewDiscountUsecaseAdapter(m.discountUsecase),
+		order_adapters.NewRegionUsecaseAdapter(m.regionUsecase),
+		order_adapters.NewTRUUsecaseAdapter(m.truUsecase),
+		order_adapters.NewFileUsecaseAdapter(m.fileUsecase),
+		order_adapters.NewUserUsecaseAdapter(m.userUsecase),
+		order_adapters.NewStockUsecaseAdapter(m.stockUsecase),
+		order_document.NewPDFRenderer(m.logger, m.config.PDFFontDir),
+		m.seller(),
+		m.notificationService,
+		order_email.NewTemplateRenderer(m.logger, m.config.OrderEmailTemplateDir),
+		m.config.OrderEmailTemplateVersion,
+		m.config.StockReservationTTL,
+		m.uow,
+		m.logger,
+	)
+	m.orderHandler = order_http.NewOrderHandler(m.orderUsecase, m.validator, m.logger)
+	m.releaser = order_usecase.NewReservationReleaser(m.orderUsecase, m.logger, m.config.StockReleaseInterval)
+}
+
+func (m *OrderModule) seller() order_entity.Seller {
+	return order_entity.Seller{
+		Name:        m.config.SellerName,
+		INN:         m.config.SellerINN,
+		KPP:         m.config.SellerKPP,
+		Address:     m.config.SellerAddress,
+		Bank:        m.config.SellerBank,
+		BIK:         m.config.SellerBIK,
+		Account:     m.config.SellerAccount,
+		CorrAccount: m.config.SellerCorrAccount,
+	}
+}
+
+func (m *OrderModule) InitDelivery(router fiber.Router) {
+	m.orderHandler.RegisterRoutes(router)
+}
+
+func (m *OrderModule) GetOrderUsecase() order_usecase.IOrderUsecase {
+	return m.orderUsecase
+}
+
+// SetPaymentUsecase подключает проверку оплат заказа при снятии просроченных резервов
+func (m *OrderModule) SetPaymentUsecase(paymentUsecase payment_usecase.IPaymentUsecase) {
+	m.orderUsecase.SetPaymentUsecase(order_adapters.NewPaymentUsecaseAdapter(paymentUsecase))
+}
+
+func (m *OrderModule) GetReservationReleaser() *order_usecase.ReservationReleaser {
+	return m.releaser
+}
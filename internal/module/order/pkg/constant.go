@@ -0,0 +1,49 @@
+package order_constant
+
+import (
+	"time"
+
+	"github.com/Fi44er/sdmed/pkg/customerr"
+)
+
+const (
+	ProcessName = "reservation_releaser"
+
+	DefaultReleaseInterval = time.Minute
+	// ReleaseBatchSize - сколько заказов с истекшим резервом отменяется за один проход
+	ReleaseBatchSize = 50
+)
+
+var (
+	ErrOrderNotFound       = customerr.NewError(404, "order not found")
+	ErrCartEmpty           = customerr.NewError(400, "cart is empty")
+	ErrInvalidStatus       = customerr.NewError(400, "invalid order status")
+	ErrAddressRequired     = customerr.NewError(400, "delivery address is required for the chosen delivery method")
+	ErrInvalidTransition   = customerr.NewError(409, "order status transition is not allowed")
+	ErrTransitionForbidden = customerr.NewError(403, "order status transition is not permitted for this actor")
+	ErrStatusChanged       = customerr.NewError(409, "order status was changed concurrently")
+	ErrSessionUserNotFound = customerr.NewError(401, "session user not found")
+
+	ErrInvalidCertificate           = customerr.NewError(400, "invalid certificate number")
+	ErrInvalidSNILS                 = customerr.NewError(400, "invalid SNILS")
+	ErrRegionNotResolved            = customerr.NewError(400, "customer region is not resolved")
+	ErrNoCertificateItems           = customerr.NewError(400, "no items in the cart can be paid by certificate")
+	ErrNotCertificateOrder          = customerr.NewError(400, "order is not paid by certificate")
+	ErrOrderItemNotFound            = customerr.NewError(404, "order item not found")
+	ErrInvalidCertificateStatus     = customerr.NewError(400, "invalid certificate status")
+	ErrInvalidCertificateTransition = customerr.NewError(409, "certificate status transition is not allowed")
+
+	ErrInvalidDocumentKind  = customerr.NewError(400, "invalid document kind")
+	ErrDocumentNotAvailable = customerr.NewError(409, "document is not available for the order in its current status")
+
+	ErrNotificationNotFound = customerr.NewError(404, "order notification not found")
+
+	ErrOrderNotEditable    = customerr.NewError(409, "order items can be changed only before payment")
+	ErrDeliveryNotEditable = customerr.NewError(409, "order delivery can be changed only before shipment")
+	ErrDeliveryMethodFixed = customerr.NewError(409, "delivery method of a paid order cannot be changed")
+	ErrPaymentInProgress   = customerr.NewError(409, "order total cannot be changed while its payment is in progress")
+	ErrSurchargePaid       = customerr.NewError(409, "order total cannot be changed after the surcharge is paid")
+	ErrOrderEmpty          = customerr.NewError(400, "order must keep at least one item")
+	ErrDuplicateItem       = customerr.NewError(400, "order item is listed more than once")
+	ErrManagerNotFound     = customerr.NewError(404, "manager not found")
+)
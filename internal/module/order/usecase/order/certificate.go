@@ -0,0 +1,146 @@
+package order_usecase
+
+import (
+	"context"
+
+	order_entity "github.com/Fi44er/sdmed/internal/module/order/entity"
+	order_constant "github.com/Fi44er/sdmed/internal/module/order/pkg"
+)
+
+// UpdateCertificateStatus меняет статус проверки сертификата по позициям заказа.
+// Без itemIDs меняются все покрытые сертификатом позиции. Когда сертификат погашен
+// по всем позициям, а доплаты нет или она оплачена картой, заказ переводится в paid
+// с записью в историю и письмом покупателю
+func (u *OrderUsecase) UpdateCertificateStatus(ctx context.Context, id string, itemIDs []string, to order_entity.CertificateStatus) (*order_entity.Order, error) {
+	if !to.IsValid() {
+		return nil, order_constant.ErrInvalidCertificateStatus
+	}
+	u.logger.Infof("Setting certificate status %s for order %s (items: %v)", to, id, itemIDs)
+
+	var paid *order_entity.Order
+	err := u.uow.Do(ctx, func(ctx context.Context) error {
+		repo, err := u.getRepository(ctx)
+		if err != nil {
+			return err
+		}
+
+		order, err := repo.GetByID(ctx, id)
+		if err != nil {
+			return err
+		}
+		if order == nil {
+			return order_constant.ErrOrderNotFound
+		}
+		if order.PaymentMethod != order_entity.PaymentMethodCertificate {
+			return order_constant.ErrNotCertificateOrder
+		}
+		if order.Status.IsFinal() {
+			return order_constant.ErrInvalidTransition
+		}
+
+		items, err := selectCertificateItems(order, itemIDs)
+		if err != nil {
+			return err
+		}
+
+		for _, item := range items {
+			if !order_entity.CanChangeCertificateStatus(item.CertificateStatus, to) {
+				u.logger.Warnf("Order %s item %s: certificate %s -> %s is not allowed", id, item.ID, item.CertificateStatus, to)
+				return order_constant.ErrInvalidCertificateTransition
+			}
+			if err := repo.UpdateItemCertificateStatus(ctx, item.ID, to); err != nil {
+				return err
+			}
+			item.CertificateStatus = to
+		}
+
+		if err := repo.UpdateCertificateAmount(ctx, id, order.CalculateCertificateAmount()); err != nil {
+			return err
+		}
+
+		if !order.IsFullyPaid() || !order.Status.IsPayable() {
+			return nil
+		}
+
+		paid, err = u.pay(ctx, order, "certificate settled")
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	if paid != nil {
+		u.logger.Infof("Order %s is fully paid", id)
+		u.notifyStatus(ctx, paid)
+	}
+
+	return u.GetByID(ctx, id)
+}
+
+// prepareCertificate проверяет реквизиты сертификата и определяет регион,
+// по ценам возмещения которого считается покрытие
+func (u *OrderUsecase) prepareCertificate(ctx context.Context, order *order_entity.Order) error {
+	if order.Certificate == nil {
+		return order_constant.ErrInvalidCertificate
+	}
+
+	number, ok := order_entity.NormalizeCertificateNumber(order.Certificate.Number)
+	if !ok {
+		return order_constant.ErrInvalidCertificate
+	}
+	snils, ok := order_entity.NormalizeSNILS(order.Certificate.SNILS)
+	if !ok {
+		return order_constant.ErrInvalidSNILS
+	}
+	order.Certificate.Number, order.Certificate.SNILS = number, snils
+
+	regionID, err := u.regionUsecase.ResolveRegionID(ctx)
+	if err != nil || regionID == "" {
+		u.logger.Warnf("Failed to resolve region for certificate order: %v", err)
+		return order_constant.ErrRegionNotResolved
+	}
+	order.RegionID = regionID
+
+	return nil
+}
+
+func (u *OrderUsecase) splitByCertificate(ctx context.Context, order *order_entity.Order) error {
+	productIDs := make([]string, len(order.Items))
+	for i := range order.Items {
+		productIDs[i] = order.Items[i].ProductID
+	}
+
+	reimbursements, err := u.truUsecase.GetReimbursements(ctx, productIDs, order.RegionID)
+	if err != nil {
+		return err
+	}
+
+	order.ApplyCertificate(reimbursements)
+	if order.CertificateAmount == 0 {
+		return order_constant.ErrNoCertificateItems
+	}
+	u.logger.Infof("Certificate covers %.2f of %.2f, surcharge %.2f", order.CertificateAmount, order.Total, order.Surcharge())
+
+	return nil
+}
+
+func selectCertificateItems(order *order_entity.Order, itemIDs []string) ([]*order_entity.OrderItem, error) {
+	var items []*order_entity.OrderItem
+	if len(itemIDs) == 0 {
+		for i := range order.Items {
+			if order.Items[i].CertificateStatus != order_entity.CertificateStatusNone {
+				items = append(items, &order.Items[i])
+			}
+		}
+		return items, nil
+	}
+
+	for _, itemID := range itemIDs {
+		item := order.FindItem(itemID)
+		if item == nil || item.CertificateStatus == order_entity.CertificateStatusNone {
+			return nil, order_constant.ErrOrderItemNotFound
+		}
+		items = append(items, item)
+	}
+
+	return items, nil
+}
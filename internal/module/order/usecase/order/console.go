@@ -0,0 +1,319 @@
+package order_usecase
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	order_entity "github.com/Fi44er/sdmed/internal/module/order/entity"
+	order_constant "github.com/Fi44er/sdmed/internal/module/order/pkg"
+)
+
+// EditItems меняет количество позиций заказа до оплаты, нулевое количество удаляет позицию.
+// Скидки и покрытие сертификатом уменьшаются пропорционально, стоимость доставки не меняется,
+// резерв товара пересобирается по новому составу. Изменения записываются в историю,
+// выставленный счет формируется заново. Пока покупатель оплачивает заказ, состав не меняется
+func (u *OrderUsecase) EditItems(ctx context.Context, id string, changes []order_entity.ItemChange, comment string) (*order_entity.Order, error) {
+	actorID := u.getActorID(ctx)
+	u.logger.Infof("Editing items of order %s by %s", id, actorID)
+
+	var result *order_entity.Order
+	err := u.uow.Do(ctx, func(ctx context.Context) error {
+		repo, err := u.getRepository(ctx)
+		if err != nil {
+			return err
+		}
+
+		order, err := repo.GetByID(ctx, id)
+		if err != nil {
+			return err
+		}
+		if order == nil {
+			return order_constant.ErrOrderNotFound
+		}
+		if !order.Status.IsEditable() {
+			return order_constant.ErrOrderNotEditable
+		}
+		if err := u.checkTotalEditable(ctx, order); err != nil {
+			return err
+		}
+
+		notes := make([]string, 0, len(changes))
+		seen := make(map[string]struct{}, len(changes))
+		for _, change := range changes {
+			if _, ok := seen[change.ItemID]; ok {
+				return order_constant.ErrDuplicateItem
+			}
+			seen[change.ItemID] = struct{}{}
+
+			item := order.FindItem(change.ItemID)
+			if item == nil {
+				return order_constant.ErrOrderItemNotFound
+			}
+			if item.Quantity == change.Quantity {
+				continue
+			}
+			notes = append(notes, fmt.Sprintf("%s: %d → %d", item.Name, item.Quantity, change.Quantity))
+			item.ChangeQuantity(change.Quantity)
+		}
+		order.RemoveEmptyItems()
+		if len(order.Items) == 0 {
+			return order_constant.ErrOrderEmpty
+		}
+		if len(notes) == 0 {
+			result = order
+			return nil
+		}
+
+		order.CalculateTotal()
+		order.CalculateCertificateAmount()
+		if err := repo.UpdateItems(ctx, order); err != nil {
+			return err
+		}
+		if err := u.stockUsecase.Update(ctx, id, order.Items, order.ReservationDeadline(u.reservationTTL)); err != nil {
+			return err
+		}
+		if err := repo.AddHistory(ctx, &order_entity.StatusChange{
+			OrderID:    id,
+			FromStatus: order.Status,
+			ToStatus:   order.Status,
+			Actor:      order_entity.ActorManager,
+			ActorID:    actorID,
+			Comment:    withComment("Изменен состав заказа: "+strings.Join(notes, ", "), comment),
+		}); err != nil {
+			return err
+		}
+
+		result = order
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	u.refreshInvoice(ctx, result)
+	return result, nil
+}
+
+// UpdateDelivery меняет данные доставки. До оплаты можно сменить способ доставки,
+// стоимость рассчитывается заново и входит в сумму заказа, если оплата еще не начата.
+// После оплаты и до отгрузки меняются только адрес и индекс
+func (u *OrderUsecase) UpdateDelivery(ctx context.Context, id string, delivery *order_entity.Delivery, comment string) (*order_entity.Order, error) {
+	actorID := u.getActorID(ctx)
+	u.logger.Infof("Updating delivery of order %s by %s", id, actorID)
+
+	var result *order_entity.Order
+	err := u.uow.Do(ctx, func(ctx context.Context) error {
+		repo, err := u.getRepository(ctx)
+		if err != nil {
+			return err
+		}
+
+		order, err := repo.GetByID(ctx, id)
+		if err != nil {
+			return err
+		}
+		if order == nil {
+			return order_constant.ErrOrderNotFound
+		}
+		if !order.Status.IsDeliveryEditable() {
+			return order_constant.ErrDeliveryNotEditable
+		}
+
+		methodChanged := delivery.MethodID != "" && delivery.MethodID != order.Delivery.MethodID
+		order.Delivery.AddressID = ""
+		order.Delivery.Address = strings.TrimSpace(delivery.Address)
+		order.Delivery.PostalCode = strings.TrimSpace(delivery.PostalCode)
+		if order.Status.IsEditable() {
+			if err := u.checkTotalEditable(ctx, order); err != nil {
+				return err
+			}
+			if methodChanged {
+				order.Delivery.MethodID = delivery.MethodID
+			}
+			if err := u.applyDelivery(ctx, order, order.RegionID); err != nil {
+				return err
+			}
+			order.CalculateTotal()
+		} else if methodChanged {
+			return order_constant.ErrDeliveryMethodFixed
+		}
+
+		if err := repo.UpdateDelivery(ctx, order); err != nil {
+			return err
+		}
+		note := fmt.Sprintf("Изменены данные доставки: %s", order.Delivery.MethodName)
+		if order.Delivery.Address != "" {
+			note += ", " + order.Delivery.Address
+		}
+		if err := repo.AddHistory(ctx, &order_entity.StatusChange{
+			OrderID:    id,
+			FromStatus: order.Status,
+			ToStatus:   order.Status,
+			Actor:      order_entity.ActorManager,
+			ActorID:    actorID,
+			Comment:    withComment(note, comment),
+		}); err != nil {
+			return err
+		}
+
+		result = order
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	u.refreshInvoice(ctx, result)
+	return result, nil
+}
+
+// AssignManager назначает ответственного сотрудника, пустой managerID снимает назначение
+func (u *OrderUsecase) AssignManager(ctx context.Context, id, managerID string) (*order_entity.Order, error) {
+	u.logger.Infof("Assigning manager %q to order %s", managerID, id)
+
+	if managerID != "" {
+		registered, err := u.userUsecase.IsRegistered(ctx, managerID)
+		if err != nil {
+			return nil, err
+		}
+		if !registered {
+			return nil, order_constant.ErrManagerNotFound
+		}
+	}
+
+	var result *order_entity.Order
+	err := u.uow.Do(ctx, func(ctx context.Context) error {
+		repo, err := u.getRepository(ctx)
+		if err != nil {
+			return err
+		}
+
+		order, err := repo.GetByID(ctx, id)
+		if err != nil {
+			return err
+		}
+		if order == nil {
+			return order_constant.ErrOrderNotFound
+		}
+		if order.ManagerID == managerID {
+			result = order
+			return nil
+		}
+
+		if err := repo.UpdateManager(ctx, id, managerID); err != nil {
+			return err
+		}
+		note := "Снят ответственный менеджер"
+		if managerID != "" {
+			note = "Назначен ответственный менеджер " + managerID
+		}
+		if err := repo.AddHistory(ctx, &order_entity.StatusChange{
+			OrderID:    id,
+			FromStatus: order.Status,
+			ToStatus:   order.Status,
+			Actor:      order_entity.ActorManager,
+			ActorID:    u.getActorID(ctx),
+			Comment:    note,
+		}); err != nil {
+			return err
+		}
+
+		order.ManagerID = managerID
+		result = order
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// AddComment добавляет внутренний комментарий сотрудника, покупатель его не видит
+func (u *OrderUsecase) AddComment(ctx context.Context, id, text string) (*order_entity.InternalComment, error) {
+	if _, err := u.GetByID(ctx, id); err != nil {
+		return nil, err
+	}
+
+	comment := &order_entity.InternalComment{
+		OrderID:  id,
+		AuthorID: u.getActorID(ctx),
+		Text:     strings.TrimSpace(text),
+	}
+	if err := u.repository.AddComment(ctx, comment); err != nil {
+		return nil, err
+	}
+
+	return comment, nil
+}
+
+func (u *OrderUsecase) GetComments(ctx context.Context, id string) ([]order_entity.InternalComment, error) {
+	if _, err := u.GetByID(ctx, id); err != nil {
+		return nil, err
+	}
+
+	return u.repository.GetComments(ctx, id)
+}
+
+// BulkChangeStatus переводит заказы в статус to от имени менеджера. Каждый заказ
+// меняется отдельно: ошибка по одному заказу не отменяет смену остальных
+func (u *OrderUsecase) BulkChangeStatus(ctx context.Context, ids []string, to order_entity.OrderStatus, comment string) []order_entity.StatusChangeResult {
+	u.logger.Infof("Changing status of %d orders to %s", len(ids), to)
+
+	results := make([]order_entity.StatusChangeResult, len(ids))
+	for i, id := range ids {
+		results[i].OrderID = id
+		order, err := u.ChangeStatus(ctx, id, to, order_entity.ActorManager, comment)
+		if err != nil {
+			u.logger.Warnf("Failed to change status of order %s to %s: %v", id, to, err)
+			results[i].Err = err
+			continue
+		}
+		results[i].Status = order.Status
+	}
+
+	return results
+}
+
+// checkTotalEditable запрещает менять сумму заказа, пока по нему идет оплата картой
+// или после оплаты доплаты: иначе платеж пришел бы на сумму, которой уже нет в заказе
+func (u *OrderUsecase) checkTotalEditable(ctx context.Context, order *order_entity.Order) error {
+	if order.SurchargePaid > 0 {
+		u.logger.Warnf("Order %s: surcharge %.2f is already paid, total cannot be changed", order.ID, order.SurchargePaid)
+		return order_constant.ErrSurchargePaid
+	}
+
+	inProgress, err := u.hasPaymentInProgress(ctx, order.ID)
+	if err != nil {
+		return err
+	}
+	if inProgress {
+		u.logger.Warnf("Order %s: payment is in progress, total cannot be changed", order.ID)
+		return order_constant.ErrPaymentInProgress
+	}
+	return nil
+}
+
+// refreshInvoice формирует заново уже выставленный счет после изменения заказа.
+// Ошибка не прерывает изменение: счет можно переформировать вручную
+func (u *OrderUsecase) refreshInvoice(ctx context.Context, order *order_entity.Order) {
+	if !order_entity.DocumentKindInvoice.IsAvailableFor(order.Status) {
+		return
+	}
+
+	invoice, err := u.repository.GetDocument(ctx, order.ID, order_entity.DocumentKindInvoice)
+	if err != nil || invoice == nil {
+		return
+	}
+	if _, err := u.RegenerateDocument(ctx, order.ID, order_entity.DocumentKindInvoice); err != nil {
+		u.logger.Warnf("Failed to regenerate invoice of order %s: %v", order.ID, err)
+	}
+}
+
+func withComment(note, comment string) string {
+	if comment = strings.TrimSpace(comment); comment != "" {
+		return note + ". " + comment
+	}
+	return note
+}
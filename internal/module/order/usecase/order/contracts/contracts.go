@@ -0,0 +1,111 @@
+package order_usecase_contracts
+
+import (
+	"context"
+	"time"
+
+	auth_entity "github.com/Fi44er/sdmed/internal/module/auth/entity"
+	"github.com/Fi44er/sdmed/internal/module/notification/service"
+	order_entity "github.com/Fi44er/sdmed/internal/module/order/entity"
+)
+
+type IOrderRepository interface {
+	Create(ctx context.Context, order *order_entity.Order) error
+	GetByID(ctx context.Context, id string) (*order_entity.Order, error)
+	GetAll(ctx context.Context, filter *order_entity.OrderFilter) ([]order_entity.Order, error)
+	Count(ctx context.Context, filter *order_entity.OrderFilter) (int64, error)
+	UpdateStatus(ctx context.Context, id string, from, to order_entity.OrderStatus) (bool, error)
+	AddHistory(ctx context.Context, change *order_entity.StatusChange) error
+	UpdateItemCertificateStatus(ctx context.Context, itemID string, status order_entity.CertificateStatus) error
+	UpdateCertificateAmount(ctx context.Context, id string, amount float64) error
+	AddSurchargePaid(ctx context.Context, id string, amount float64) error
+	GetDocument(ctx context.Context, orderID string, kind order_entity.DocumentKind) (*order_entity.Document, error)
+	SaveDocument(ctx context.Context, document *order_entity.Document) error
+	UpdateTrackingNumber(ctx context.Context, id, trackingNumber string) error
+	AddNotification(ctx context.Context, notification *order_entity.Notification) error
+	GetNotification(ctx context.Context, id string) (*order_entity.Notification, error)
+	GetNotifications(ctx context.Context, orderID string) ([]order_entity.Notification, error)
+	UpdateItems(ctx context.Context, order *order_entity.Order) error
+	UpdateDelivery(ctx context.Context, order *order_entity.Order) error
+	UpdateManager(ctx context.Context, id, managerID string) error
+	AddComment(ctx context.Context, comment *order_entity.InternalComment) error
+	GetComments(ctx context.Context, orderID string) ([]order_entity.InternalComment, error)
+}
+
+type ISessionRepository interface {
+	GetSessionInfo(ctx context.Context) (*auth_entity.ActiveSession, error)
+}
+
+type ICartUsecaseAdapter interface {
+	GetItems(ctx context.Context, userID string) ([]order_entity.OrderItem, error)
+	Clear(ctx context.Context, userID string) error
+	// AddItem добавляет товар в корзину пользователя сессии, недоступность товара - не ошибка
+	AddItem(ctx context.Context, item *order_entity.RepeatItem) error
+}
+
+type IRegionUsecaseAdapter interface {
+	ResolveRegionID(ctx context.Context) (string, error)
+}
+
+type IDeliveryUsecaseAdapter interface {
+	GetQuotes(ctx context.Context, regionID, postalCode string, items []order_entity.OrderItem) ([]order_entity.DeliveryQuote, error)
+	GetQuote(ctx context.Context, methodID, regionID, postalCode string, items []order_entity.OrderItem) (*order_entity.DeliveryQuote, error)
+}
+
+type IAddressUsecaseAdapter interface {
+	GetByUserID(ctx context.Context, userID, id string) (*order_entity.Address, error)
+	SaveForUser(ctx context.Context, userID string, address *order_entity.Address) (*order_entity.Address, error)
+}
+
+type IDiscountUsecaseAdapter interface {
+	Calculate(ctx context.Context, userID, code string, items []order_entity.OrderItem) (*order_entity.Discount, error)
+	Redeem(ctx context.Context, promoCodeID, userID, orderID string) error
+	Release(ctx context.Context, orderID string) error
+}
+
+type ITRUUsecaseAdapter interface {
+	GetReimbursements(ctx context.Context, productIDs []string, regionID string) (map[string]order_entity.Reimbursement, error)
+}
+
+type IFileUsecaseAdapter interface {
+	Upload(ctx context.Context, data []byte, ownerID string) (*order_entity.Document, error)
+	Get(ctx context.Context, name string) ([]byte, error)
+	DeleteByID(ctx context.Context, id string) error
+}
+
+type IUserUsecaseAdapter interface {
+	// IsRegistered - пользователь существует и не является shadow-пользователем
+	IsRegistered(ctx context.Context, userID string) (bool, error)
+}
+
+// IStockUsecaseAdapter - резерв товара под заказ. Методы выполняются в транзакции вызывающего
+type IStockUsecaseAdapter interface {
+	Reserve(ctx context.Context, orderID string, items []order_entity.OrderItem, expiresAt time.Time) error
+	Update(ctx context.Context, orderID string, items []order_entity.OrderItem, expiresAt time.Time) error
+	Extend(ctx context.Context, orderID string, expiresAt time.Time) error
+	Confirm(ctx context.Context, orderID string) error
+	Release(ctx context.Context, orderID string) error
+	WriteOff(ctx context.Context, orderID string) error
+	GetExpiredOrderIDs(ctx context.Context, limit int) ([]string, error)
+	GetAvailable(ctx context.Context, productID string) (int, bool, error)
+}
+
+// IPaymentUsecaseAdapter - оплаты заказа. Модуль оплаты создается после модуля заказов
+type IPaymentUsecaseAdapter interface {
+	// HasPaymentInProgress - по заказу есть заблокированные средства или платеж,
+	// созданный после since и еще не завершенный
+	HasPaymentInProgress(ctx context.Context, orderID string, since time.Time) (bool, error)
+}
+
+type IDocumentRenderer interface {
+	Render(data *order_entity.DocumentData) ([]byte, error)
+}
+
+type INotificationService interface {
+	SendSync(msg *service.Message, selectedNotifiers ...string) error
+}
+
+// IEmailRenderer формирует письмо по шаблону события заданной версии
+type IEmailRenderer interface {
+	Render(version string, data *order_entity.NotificationData) (*order_entity.Email, error)
+}
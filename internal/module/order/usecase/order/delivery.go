@@ -0,0 +1,88 @@
+package order_usecase
+
+import (
+	"context"
+	"strings"
+
+	order_entity "github.com/Fi44er/sdmed/internal/module/order/entity"
+	order_constant "github.com/Fi44er/sdmed/internal/module/order/pkg"
+)
+
+// GetDeliveryQuotes рассчитывает доставку корзины пользователя сессии всеми
+// доступными для его региона и индекса способами
+func (u *OrderUsecase) GetDeliveryQuotes(ctx context.Context, postalCode string) ([]order_entity.DeliveryQuote, error) {
+	userID, err := u.getSessionUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	items, err := u.cartUsecase.GetItems(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if len(items) == 0 {
+		return nil, order_constant.ErrCartEmpty
+	}
+
+	return u.deliveryUsecase.GetQuotes(ctx, u.resolveDeliveryRegion(ctx), strings.TrimSpace(postalCode), items)
+}
+
+// applyAddress подставляет в заказ адрес из адресной книги покупателя. Новый адрес
+// сохраняется в адресную книгу, в том числе для shadow-пользователя: после регистрации
+// или входа он останется у покупателя. Возвращает регион для расчета доставки:
+// регион адреса, если он указан, иначе регион заказа
+func (u *OrderUsecase) applyAddress(ctx context.Context, userID string, order *order_entity.Order) (string, error) {
+	var (
+		address *order_entity.Address
+		err     error
+	)
+	switch {
+	case order.Delivery.AddressID != "":
+		address, err = u.addressUsecase.GetByUserID(ctx, userID, order.Delivery.AddressID)
+	case order.Delivery.NewAddress != nil:
+		address, err = u.addressUsecase.SaveForUser(ctx, userID, order.Delivery.NewAddress)
+	default:
+		return order.RegionID, nil
+	}
+	if err != nil {
+		return "", err
+	}
+
+	order.Delivery.AddressID = address.ID
+	order.Delivery.Address = address.Formatted
+	if address.PostalCode != "" {
+		order.Delivery.PostalCode = address.PostalCode
+	}
+	if address.RegionID != "" {
+		return address.RegionID, nil
+	}
+	return order.RegionID, nil
+}
+
+// applyDelivery пересчитывает выбранный способ доставки по позициям заказа
+// и сохраняет стоимость и сроки в заказе
+func (u *OrderUsecase) applyDelivery(ctx context.Context, order *order_entity.Order, regionID string) error {
+	order.Delivery.PostalCode = strings.TrimSpace(order.Delivery.PostalCode)
+	quote, err := u.deliveryUsecase.GetQuote(ctx, order.Delivery.MethodID, regionID, order.Delivery.PostalCode, order.Items)
+	if err != nil {
+		return err
+	}
+	if quote.RequiresAddress && strings.TrimSpace(order.Delivery.Address) == "" {
+		return order_constant.ErrAddressRequired
+	}
+
+	order.ApplyDelivery(quote)
+	u.logger.Infof("Delivery %s costs %.2f", quote.MethodName, quote.Cost)
+	return nil
+}
+
+// resolveDeliveryRegion - регион покупателя для расчета доставки. Без региона
+// подходят только зоны по индексу и зоны без ограничений
+func (u *OrderUsecase) resolveDeliveryRegion(ctx context.Context) string {
+	regionID, err := u.regionUsecase.ResolveRegionID(ctx)
+	if err != nil {
+		u.logger.Warnf("Failed to resolve region for delivery: %v", err)
+		return ""
+	}
+	return regionID
+}
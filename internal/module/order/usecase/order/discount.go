@@ -0,0 +1,22 @@
+package order_usecase
+
+import (
+	"context"
+
+	order_entity "github.com/Fi44er/sdmed/internal/module/order/entity"
+)
+
+// applyDiscount рассчитывает автоматические скидки и промокод по позициям заказа.
+// При оплате сертификатом покрытая им часть позиций в расчет не входит
+func (u *OrderUsecase) applyDiscount(ctx context.Context, userID string, order *order_entity.Order) error {
+	discount, err := u.discountUsecase.Calculate(ctx, userID, order.PromoCode, order.Items)
+	if err != nil {
+		return err
+	}
+
+	order.ApplyDiscount(discount)
+	if discount.Amount > 0 {
+		u.logger.Infof("Discount %.2f applied, promo code %q", discount.Amount, discount.PromoCode)
+	}
+	return nil
+}
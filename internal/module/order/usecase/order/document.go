@@ -0,0 +1,122 @@
+package order_usecase
+
+import (
+	"context"
+	"time"
+
+	order_entity "github.com/Fi44er/sdmed/internal/module/order/entity"
+	order_constant "github.com/Fi44er/sdmed/internal/module/order/pkg"
+)
+
+// GetOwnDocument возвращает PDF документа по заказу пользователя сессии.
+// Документ формируется при первом запросе и дальше отдается из файлового модуля
+func (u *OrderUsecase) GetOwnDocument(ctx context.Context, id string, kind order_entity.DocumentKind) (*order_entity.Document, error) {
+	if !kind.IsValid() {
+		return nil, order_constant.ErrInvalidDocumentKind
+	}
+
+	order, err := u.GetOwnByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	return u.getDocument(ctx, order, kind)
+}
+
+func (u *OrderUsecase) GetDocument(ctx context.Context, id string, kind order_entity.DocumentKind) (*order_entity.Document, error) {
+	if !kind.IsValid() {
+		return nil, order_constant.ErrInvalidDocumentKind
+	}
+
+	order, err := u.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	return u.getDocument(ctx, order, kind)
+}
+
+// RegenerateDocument формирует документ заново, например после исправления
+// реквизитов продавца. Файл предыдущей версии удаляется
+func (u *OrderUsecase) RegenerateDocument(ctx context.Context, id string, kind order_entity.DocumentKind) (*order_entity.Document, error) {
+	if !kind.IsValid() {
+		return nil, order_constant.ErrInvalidDocumentKind
+	}
+
+	order, err := u.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if !kind.IsAvailableFor(order.Status) {
+		return nil, order_constant.ErrDocumentNotAvailable
+	}
+
+	previous, err := u.repository.GetDocument(ctx, id, kind)
+	if err != nil {
+		return nil, err
+	}
+
+	document, err := u.generateDocument(ctx, order, kind)
+	if err != nil {
+		return nil, err
+	}
+
+	if previous != nil && previous.FileID != document.FileID {
+		if err := u.fileUsecase.DeleteByID(ctx, previous.FileID); err != nil {
+			u.logger.Warnf("Failed to delete previous %s file %s of order %s: %v", kind, previous.FileName, id, err)
+		}
+	}
+
+	return document, nil
+}
+
+func (u *OrderUsecase) getDocument(ctx context.Context, order *order_entity.Order, kind order_entity.DocumentKind) (*order_entity.Document, error) {
+	if !kind.IsAvailableFor(order.Status) {
+		return nil, order_constant.ErrDocumentNotAvailable
+	}
+
+	document, err := u.repository.GetDocument(ctx, order.ID, kind)
+	if err != nil {
+		return nil, err
+	}
+	if document != nil {
+		data, err := u.fileUsecase.Get(ctx, document.FileName)
+		if err == nil {
+			document.Data = data
+			return document, nil
+		}
+		u.logger.Warnf("File %s of %s document of order %s is unavailable, generating again: %v", document.FileName, kind, order.ID, err)
+	}
+
+	return u.generateDocument(ctx, order, kind)
+}
+
+func (u *OrderUsecase) generateDocument(ctx context.Context, order *order_entity.Order, kind order_entity.DocumentKind) (*order_entity.Document, error) {
+	u.logger.Infof("Generating %s document for order %s", kind, order.ID)
+
+	number := order_entity.DocumentNumber(order.ID)
+	data, err := u.renderer.Render(&order_entity.DocumentData{
+		Kind:   kind,
+		Number: number,
+		Date:   time.Now(),
+		Seller: u.seller,
+		Order:  order,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	document, err := u.fileUsecase.Upload(ctx, data, order.ID)
+	if err != nil {
+		return nil, err
+	}
+	document.Kind = kind
+	document.Number = number
+
+	if err := u.repository.SaveDocument(ctx, document); err != nil {
+		return nil, err
+	}
+	document.Data = data
+
+	return document, nil
+}
@@ -0,0 +1,1078 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./order/contracts/contracts.go
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	auth_entity "github.com/Fi44er/sdmed/internal/module/auth/entity"
+	service "github.com/Fi44er/sdmed/internal/module/notification/service"
+	order_entity "github.com/Fi44er/sdmed/internal/module/order/entity"
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockIOrderRepository is a mock of IOrderRepository interface.
+type MockIOrderRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockIOrderRepositoryMockRecorder
+}
+
+// MockIOrderRepositoryMockRecorder is the mock recorder for MockIOrderRepository.
+type MockIOrderRepositoryMockRecorder struct {
+	mock *MockIOrderRepository
+}
+
+// NewMockIOrderRepository creates a new mock instance.
+func NewMockIOrderRepository(ctrl *gomock.Controller) *MockIOrderRepository {
+	mock := &MockIOrderRepository{ctrl: ctrl}
+	mock.recorder = &MockIOrderRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockIOrderRepository) EXPECT() *MockIOrderRepositoryMockRecorder {
+	return m.recorder
+}
+
+// AddComment mocks base method.
+func (m *MockIOrderRepository) AddComment(ctx context.Context, comment *order_entity.InternalComment) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddComment", ctx, comment)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddComment indicates an expected call of AddComment.
+func (mr *MockIOrderRepositoryMockRecorder) AddComment(ctx, comment interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddComment", reflect.TypeOf((*MockIOrderRepository)(nil).AddComment), ctx, comment)
+}
+
+// AddHistory mocks base method.
+func (m *MockIOrderRepository) AddHistory(ctx context.Context, change *order_entity.StatusChange) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddHistory", ctx, change)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddHistory indicates an expected call of AddHistory.
+func (mr *MockIOrderRepositoryMockRecorder) AddHistory(ctx, change interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddHistory", reflect.TypeOf((*MockIOrderRepository)(nil).AddHistory), ctx, change)
+}
+
+// AddNotification mocks base method.
+func (m *MockIOrderRepository) AddNotification(ctx context.Context, notification *order_entity.Notification) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddNotification", ctx, notification)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddNotification indicates an expected call of AddNotification.
+func (mr *MockIOrderRepositoryMockRecorder) AddNotification(ctx, notification interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddNotification", reflect.TypeOf((*MockIOrderRepository)(nil).AddNotification), ctx, notification)
+}
+
+// AddSurchargePaid mocks base method.
+func (m *MockIOrderRepository) AddSurchargePaid(ctx context.Context, id string, amount float64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddSurchargePaid", ctx, id, amount)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddSurchargePaid indicates an expected call of AddSurchargePaid.
+func (mr *MockIOrderRepositoryMockRecorder) AddSurchargePaid(ctx, id, amount interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddSurchargePaid", reflect.TypeOf((*MockIOrderRepository)(nil).AddSurchargePaid), ctx, id, amount)
+}
+
+// Count mocks base method.
+func (m *MockIOrderRepository) Count(ctx context.Context, filter *order_entity.OrderFilter) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Count", ctx, filter)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Count indicates an expected call of Count.
+func (mr *MockIOrderRepositoryMockRecorder) Count(ctx, filter interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Count", reflect.TypeOf((*MockIOrderRepository)(nil).Count), ctx, filter)
+}
+
+// Create mocks base method.
+func (m *MockIOrderRepository) Create(ctx context.Context, order *order_entity.Order) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, order)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockIOrderRepositoryMockRecorder) Create(ctx, order interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockIOrderRepository)(nil).Create), ctx, order)
+}
+
+// GetAll mocks base method.
+func (m *MockIOrderRepository) GetAll(ctx context.Context, filter *order_entity.OrderFilter) ([]order_entity.Order, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAll", ctx, filter)
+	ret0, _ := ret[0].([]order_entity.Order)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAll indicates an expected call of GetAll.
+func (mr *MockIOrderRepositoryMockRecorder) GetAll(ctx, filter interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAll", reflect.TypeOf((*MockIOrderRepository)(nil).GetAll), ctx, filter)
+}
+
+// GetByID mocks base method.
+func (m *MockIOrderRepository) GetByID(ctx context.Context, id string) (*order_entity.Order, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByID", ctx, id)
+	ret0, _ := ret[0].(*order_entity.Order)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByID indicates an expected call of GetByID.
+func (mr *MockIOrderRepositoryMockRecorder) GetByID(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByID", reflect.TypeOf((*MockIOrderRepository)(nil).GetByID), ctx, id)
+}
+
+// GetComments mocks base method.
+func (m *MockIOrderRepository) GetComments(ctx context.Context, orderID string) ([]order_entity.InternalComment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetComments", ctx, orderID)
+	ret0, _ := ret[0].([]order_entity.InternalComment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetComments indicates an expected call of GetComments.
+func (mr *MockIOrderRepositoryMockRecorder) GetComments(ctx, orderID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetComments", reflect.TypeOf((*MockIOrderRepository)(nil).GetComments), ctx, orderID)
+}
+
+// GetDocument mocks base method.
+func (m *MockIOrderRepository) GetDocument(ctx context.Context, orderID string, kind order_entity.DocumentKind) (*order_entity.Document, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDocument", ctx, orderID, kind)
+	ret0, _ := ret[0].(*order_entity.Document)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDocument indicates an expected call of GetDocument.
+func (mr *MockIOrderRepositoryMockRecorder) GetDocument(ctx, orderID, kind interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDocument", reflect.TypeOf((*MockIOrderRepository)(nil).GetDocument), ctx, orderID, kind)
+}
+
+// GetNotification mocks base method.
+func (m *MockIOrderRepository) GetNotification(ctx context.Context, id string) (*order_entity.Notification, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetNotification", ctx, id)
+	ret0, _ := ret[0].(*order_entity.Notification)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetNotification indicates an expected call of GetNotification.
+func (mr *MockIOrderRepositoryMockRecorder) GetNotification(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetNotification", reflect.TypeOf((*MockIOrderRepository)(nil).GetNotification), ctx, id)
+}
+
+// GetNotifications mocks base method.
+func (m *MockIOrderRepository) GetNotifications(ctx context.Context, orderID string) ([]order_entity.Notification, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetNotifications", ctx, orderID)
+	ret0, _ := ret[0].([]order_entity.Notification)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetNotifications indicates an expected call of GetNotifications.
+func (mr *MockIOrderRepositoryMockRecorder) GetNotifications(ctx, orderID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetNotifications", reflect.TypeOf((*MockIOrderRepository)(nil).GetNotifications), ctx, orderID)
+}
+
+// SaveDocument mocks base method.
+func (m *MockIOrderRepository) SaveDocument(ctx context.Context, document *order_entity.Document) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SaveDocument", ctx, document)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SaveDocument indicates an expected call of SaveDocument.
+func (mr *MockIOrderRepositoryMockRecorder) SaveDocument(ctx, document interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveDocument", reflect.TypeOf((*MockIOrderRepository)(nil).SaveDocument), ctx, document)
+}
+
+// UpdateCertificateAmount mocks base method.
+func (m *MockIOrderRepository) UpdateCertificateAmount(ctx context.Context, id string, amount float64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateCertificateAmount", ctx, id, amount)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateCertificateAmount indicates an expected call of UpdateCertificateAmount.
+func (mr *MockIOrderRepositoryMockRecorder) UpdateCertificateAmount(ctx, id, amount interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateCertificateAmount", reflect.TypeOf((*MockIOrderRepository)(nil).UpdateCertificateAmount), ctx, id, amount)
+}
+
+// UpdateDelivery mocks base method.
+func (m *MockIOrderRepository) UpdateDelivery(ctx context.Context, order *order_entity.Order) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateDelivery", ctx, order)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateDelivery indicates an expected call of UpdateDelivery.
+func (mr *MockIOrderRepositoryMockRecorder) UpdateDelivery(ctx, order interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateDelivery", reflect.TypeOf((*MockIOrderRepository)(nil).UpdateDelivery), ctx, order)
+}
+
+// UpdateItemCertificateStatus mocks base method.
+func (m *MockIOrderRepository) UpdateItemCertificateStatus(ctx context.Context, itemID string, status order_entity.CertificateStatus) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateItemCertificateStatus", ctx, itemID, status)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateItemCertificateStatus indicates an expected call of UpdateItemCertificateStatus.
+func (mr *MockIOrderRepositoryMockRecorder) UpdateItemCertificateStatus(ctx, itemID, status interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateItemCertificateStatus", reflect.TypeOf((*MockIOrderRepository)(nil).UpdateItemCertificateStatus), ctx, itemID, status)
+}
+
+// UpdateItems mocks base method.
+func (m *MockIOrderRepository) UpdateItems(ctx context.Context, order *order_entity.Order) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateItems", ctx, order)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateItems indicates an expected call of UpdateItems.
+func (mr *MockIOrderRepositoryMockRecorder) UpdateItems(ctx, order interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateItems", reflect.TypeOf((*MockIOrderRepository)(nil).UpdateItems), ctx, order)
+}
+
+// UpdateManager mocks base method.
+func (m *MockIOrderRepository) UpdateManager(ctx context.Context, id, managerID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateManager", ctx, id, managerID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateManager indicates an expected call of UpdateManager.
+func (mr *MockIOrderRepositoryMockRecorder) UpdateManager(ctx, id, managerID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateManager", reflect.TypeOf((*MockIOrderRepository)(nil).UpdateManager), ctx, id, managerID)
+}
+
+// UpdateStatus mocks base method.
+func (m *MockIOrderRepository) UpdateStatus(ctx context.Context, id string, from, to order_entity.OrderStatus) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateStatus", ctx, id, from, to)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateStatus indicates an expected call of UpdateStatus.
+func (mr *MockIOrderRepositoryMockRecorder) UpdateStatus(ctx, id, from, to interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateStatus", reflect.TypeOf((*MockIOrderRepository)(nil).UpdateStatus), ctx, id, from, to)
+}
+
+// UpdateTrackingNumber mocks base method.
+func (m *MockIOrderRepository) UpdateTrackingNumber(ctx context.Context, id, trackingNumber string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateTrackingNumber", ctx, id, trackingNumber)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateTrackingNumber indicates an expected call of UpdateTrackingNumber.
+func (mr *MockIOrderRepositoryMockRecorder) UpdateTrackingNumber(ctx, id, trackingNumber interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateTrackingNumber", reflect.TypeOf((*MockIOrderRepository)(nil).UpdateTrackingNumber), ctx, id, trackingNumber)
+}
+
+// MockISessionRepository is a mock of ISessionRepository interface.
+type MockISessionRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockISessionRepositoryMockRecorder
+}
+
+// MockISessionRepositoryMockRecorder is the mock recorder for MockISessionRepository.
+type MockISessionRepositoryMockRecorder struct {
+	mock *MockISessionRepository
+}
+
+// NewMockISessionRepository creates a new mock instance.
+func NewMockISessionRepository(ctrl *gomock.Controller) *MockISessionRepository {
+	mock := &MockISessionRepository{ctrl: ctrl}
+	mock.recorder = &MockISessionRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockISessionRepository) EXPECT() *MockISessionRepositoryMockRecorder {
+	return m.recorder
+}
+
+// GetSessionInfo mocks base method.
+func (m *MockISessionRepository) GetSessionInfo(ctx context.Context) (*auth_entity.ActiveSession, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSessionInfo", ctx)
+	ret0, _ := ret[0].(*auth_entity.ActiveSession)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSessionInfo indicates an expected call of GetSessionInfo.
+func (mr *MockISessionRepositoryMockRecorder) GetSessionInfo(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSessionInfo", reflect.TypeOf((*MockISessionRepository)(nil).GetSessionInfo), ctx)
+}
+
+// MockICartUsecaseAdapter is a mock of ICartUsecaseAdapter interface.
+type MockICartUsecaseAdapter struct {
+	ctrl     *gomock.Controller
+	recorder *MockICartUsecaseAdapterMockRecorder
+}
+
+// MockICartUsecaseAdapterMockRecorder is the mock recorder for MockICartUsecaseAdapter.
+type MockICartUsecaseAdapterMockRecorder struct {
+	mock *MockICartUsecaseAdapter
+}
+
+// NewMockICartUsecaseAdapter creates a new mock instance.
+func NewMockICartUsecaseAdapter(ctrl *gomock.Controller) *MockICartUsecaseAdapter {
+	mock := &MockICartUsecaseAdapter{ctrl: ctrl}
+	mock.recorder = &MockICartUsecaseAdapterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockICartUsecaseAdapter) EXPECT() *MockICartUsecaseAdapterMockRecorder {
+	return m.recorder
+}
+
+// AddItem mocks base method.
+func (m *MockICartUsecaseAdapter) AddItem(ctx context.Context, item *order_entity.RepeatItem) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddItem", ctx, item)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddItem indicates an expected call of AddItem.
+func (mr *MockICartUsecaseAdapterMockRecorder) AddItem(ctx, item interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddItem", reflect.TypeOf((*MockICartUsecaseAdapter)(nil).AddItem), ctx, item)
+}
+
+// Clear mocks base method.
+func (m *MockICartUsecaseAdapter) Clear(ctx context.Context, userID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Clear", ctx, userID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Clear indicates an expected call of Clear.
+func (mr *MockICartUsecaseAdapterMockRecorder) Clear(ctx, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Clear", reflect.TypeOf((*MockICartUsecaseAdapter)(nil).Clear), ctx, userID)
+}
+
+// GetItems mocks base method.
+func (m *MockICartUsecaseAdapter) GetItems(ctx context.Context, userID string) ([]order_entity.OrderItem, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetItems", ctx, userID)
+	ret0, _ := ret[0].([]order_entity.OrderItem)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetItems indicates an expected call of GetItems.
+func (mr *MockICartUsecaseAdapterMockRecorder) GetItems(ctx, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetItems", reflect.TypeOf((*MockICartUsecaseAdapter)(nil).GetItems), ctx, userID)
+}
+
+// MockIRegionUsecaseAdapter is a mock of IRegionUsecaseAdapter interface.
+type MockIRegionUsecaseAdapter struct {
+	ctrl     *gomock.Controller
+	recorder *MockIRegionUsecaseAdapterMockRecorder
+}
+
+// MockIRegionUsecaseAdapterMockRecorder is the mock recorder for MockIRegionUsecaseAdapter.
+type MockIRegionUsecaseAdapterMockRecorder struct {
+	mock *MockIRegionUsecaseAdapter
+}
+
+// NewMockIRegionUsecaseAdapter creates a new mock instance.
+func NewMockIRegionUsecaseAdapter(ctrl *gomock.Controller) *MockIRegionUsecaseAdapter {
+	mock := &MockIRegionUsecaseAdapter{ctrl: ctrl}
+	mock.recorder = &MockIRegionUsecaseAdapterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockIRegionUsecaseAdapter) EXPECT() *MockIRegionUsecaseAdapterMockRecorder {
+	return m.recorder
+}
+
+// ResolveRegionID mocks base method.
+func (m *MockIRegionUsecaseAdapter) ResolveRegionID(ctx context.Context) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ResolveRegionID", ctx)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ResolveRegionID indicates an expected call of ResolveRegionID.
+func (mr *MockIRegionUsecaseAdapterMockRecorder) ResolveRegionID(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResolveRegionID", reflect.TypeOf((*MockIRegionUsecaseAdapter)(nil).ResolveRegionID), ctx)
+}
+
+// MockIDeliveryUsecaseAdapter is a mock of IDeliveryUsecaseAdapter interface.
+type MockIDeliveryUsecaseAdapter struct {
+	ctrl     *gomock.Controller
+	recorder *MockIDeliveryUsecaseAdapterMockRecorder
+}
+
+// MockIDeliveryUsecaseAdapterMockRecorder is the mock recorder for MockIDeliveryUsecaseAdapter.
+type MockIDeliveryUsecaseAdapterMockRecorder struct {
+	mock *MockIDeliveryUsecaseAdapter
+}
+
+// NewMockIDeliveryUsecaseAdapter creates a new mock instance.
+func NewMockIDeliveryUsecaseAdapter(ctrl *gomock.Controller) *MockIDeliveryUsecaseAdapter {
+	mock := &MockIDeliveryUsecaseAdapter{ctrl: ctrl}
+	mock.recorder = &MockIDeliveryUsecaseAdapterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockIDeliveryUsecaseAdapter) EXPECT() *MockIDeliveryUsecaseAdapterMockRecorder {
+	return m.recorder
+}
+
+// GetQuote mocks base method.
+func (m *MockIDeliveryUsecaseAdapter) GetQuote(ctx context.Context, methodID, regionID, postalCode string, items []order_entity.OrderItem) (*order_entity.DeliveryQuote, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetQuote", ctx, methodID, regionID, postalCode, items)
+	ret0, _ := ret[0].(*order_entity.DeliveryQuote)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetQuote indicates an expected call of GetQuote.
+func (mr *MockIDeliveryUsecaseAdapterMockRecorder) GetQuote(ctx, methodID, regionID, postalCode, items interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetQuote", reflect.TypeOf((*MockIDeliveryUsecaseAdapter)(nil).GetQuote), ctx, methodID, regionID, postalCode, items)
+}
+
+// GetQuotes mocks base method.
+func (m *MockIDeliveryUsecaseAdapter) GetQuotes(ctx context.Context, regionID, postalCode string, items []order_entity.OrderItem) ([]order_entity.DeliveryQuote, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetQuotes", ctx, regionID, postalCode, items)
+	ret0, _ := ret[0].([]order_entity.DeliveryQuote)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetQuotes indicates an expected call of GetQuotes.
+func (mr *MockIDeliveryUsecaseAdapterMockRecorder) GetQuotes(ctx, regionID, postalCode, items interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetQuotes", reflect.TypeOf((*MockIDeliveryUsecaseAdapter)(nil).GetQuotes), ctx, regionID, postalCode, items)
+}
+
+// MockIAddressUsecaseAdapter is a mock of IAddressUsecaseAdapter interface.
+type MockIAddressUsecaseAdapter struct {
+	ctrl     *gomock.Controller
+	recorder *MockIAddressUsecaseAdapterMockRecorder
+}
+
+// MockIAddressUsecaseAdapterMockRecorder is the mock recorder for MockIAddressUsecaseAdapter.
+type MockIAddressUsecaseAdapterMockRecorder struct {
+	mock *MockIAddressUsecaseAdapter
+}
+
+// NewMockIAddressUsecaseAdapter creates a new mock instance.
+func NewMockIAddressUsecaseAdapter(ctrl *gomock.Controller) *MockIAddressUsecaseAdapter {
+	mock := &MockIAddressUsecaseAdapter{ctrl: ctrl}
+	mock.recorder = &MockIAddressUsecaseAdapterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockIAddressUsecaseAdapter) EXPECT() *MockIAddressUsecaseAdapterMockRecorder {
+	return m.recorder
+}
+
+// GetByUserID mocks base method.
+func (m *MockIAddressUsecaseAdapter) GetByUserID(ctx context.Context, userID, id string) (*order_entity.Address, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByUserID", ctx, userID, id)
+	ret0, _ := ret[0].(*order_entity.Address)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByUserID indicates an expected call of GetByUserID.
+func (mr *MockIAddressUsecaseAdapterMockRecorder) GetByUserID(ctx, userID, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByUserID", reflect.TypeOf((*MockIAddressUsecaseAdapter)(nil).GetByUserID), ctx, userID, id)
+}
+
+// SaveForUser mocks base method.
+func (m *MockIAddressUsecaseAdapter) SaveForUser(ctx context.Context, userID string, address *order_entity.Address) (*order_entity.Address, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SaveForUser", ctx, userID, address)
+	ret0, _ := ret[0].(*order_entity.Address)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SaveForUser indicates an expected call of SaveForUser.
+func (mr *MockIAddressUsecaseAdapterMockRecorder) SaveForUser(ctx, userID, address interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveForUser", reflect.TypeOf((*MockIAddressUsecaseAdapter)(nil).SaveForUser), ctx, userID, address)
+}
+
+// MockIDiscountUsecaseAdapter is a mock of IDiscountUsecaseAdapter interface.
+type MockIDiscountUsecaseAdapter struct {
+	ctrl     *gomock.Controller
+	recorder *MockIDiscountUsecaseAdapterMockRecorder
+}
+
+// MockIDiscountUsecaseAdapterMockRecorder is the mock recorder for MockIDiscountUsecaseAdapter.
+type MockIDiscountUsecaseAdapterMockRecorder struct {
+	mock *MockIDiscountUsecaseAdapter
+}
+
+// NewMockIDiscountUsecaseAdapter creates a new mock instance.
+func NewMockIDiscountUsecaseAdapter(ctrl *gomock.Controller) *MockIDiscountUsecaseAdapter {
+	mock := &MockIDiscountUsecaseAdapter{ctrl: ctrl}
+	mock.recorder = &MockIDiscountUsecaseAdapterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockIDiscountUsecaseAdapter) EXPECT() *MockIDiscountUsecaseAdapterMockRecorder {
+	return m.recorder
+}
+
+// Calculate mocks base method.
+func (m *MockIDiscountUsecaseAdapter) Calculate(ctx context.Context, userID, code string, items []order_entity.OrderItem) (*order_entity.Discount, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Calculate", ctx, userID, code, items)
+	ret0, _ := ret[0].(*order_entity.Discount)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Calculate indicates an expected call of Calculate.
+func (mr *MockIDiscountUsecaseAdapterMockRecorder) Calculate(ctx, userID, code, items interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Calculate", reflect.TypeOf((*MockIDiscountUsecaseAdapter)(nil).Calculate), ctx, userID, code, items)
+}
+
+// Redeem mocks base method.
+func (m *MockIDiscountUsecaseAdapter) Redeem(ctx context.Context, promoCodeID, userID, orderID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Redeem", ctx, promoCodeID, userID, orderID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Redeem indicates an expected call of Redeem.
+func (mr *MockIDiscountUsecaseAdapterMockRecorder) Redeem(ctx, promoCodeID, userID, orderID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Redeem", reflect.TypeOf((*MockIDiscountUsecaseAdapter)(nil).Redeem), ctx, promoCodeID, userID, orderID)
+}
+
+// Release mocks base method.
+func (m *MockIDiscountUsecaseAdapter) Release(ctx context.Context, orderID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Release", ctx, orderID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Release indicates an expected call of Release.
+func (mr *MockIDiscountUsecaseAdapterMockRecorder) Release(ctx, orderID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Release", reflect.TypeOf((*MockIDiscountUsecaseAdapter)(nil).Release), ctx, orderID)
+}
+
+// MockITRUUsecaseAdapter is a mock of ITRUUsecaseAdapter interface.
+type MockITRUUsecaseAdapter struct {
+	ctrl     *gomock.Controller
+	recorder *MockITRUUsecaseAdapterMockRecorder
+}
+
+// MockITRUUsecaseAdapterMockRecorder is the mock recorder for MockITRUUsecaseAdapter.
+type MockITRUUsecaseAdapterMockRecorder struct {
+	mock *MockITRUUsecaseAdapter
+}
+
+// NewMockITRUUsecaseAdapter creates a new mock instance.
+func NewMockITRUUsecaseAdapter(ctrl *gomock.Controller) *MockITRUUsecaseAdapter {
+	mock := &MockITRUUsecaseAdapter{ctrl: ctrl}
+	mock.recorder = &MockITRUUsecaseAdapterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockITRUUsecaseAdapter) EXPECT() *MockITRUUsecaseAdapterMockRecorder {
+	return m.recorder
+}
+
+// GetReimbursements mocks base method.
+func (m *MockITRUUsecaseAdapter) GetReimbursements(ctx context.Context, productIDs []string, regionID string) (map[string]order_entity.Reimbursement, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetReimbursements", ctx, productIDs, regionID)
+	ret0, _ := ret[0].(map[string]order_entity.Reimbursement)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetReimbursements indicates an expected call of GetReimbursements.
+func (mr *MockITRUUsecaseAdapterMockRecorder) GetReimbursements(ctx, productIDs, regionID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetReimbursements", reflect.TypeOf((*MockITRUUsecaseAdapter)(nil).GetReimbursements), ctx, productIDs, regionID)
+}
+
+// MockIFileUsecaseAdapter is a mock of IFileUsecaseAdapter interface.
+type MockIFileUsecaseAdapter struct {
+	ctrl     *gomock.Controller
+	recorder *MockIFileUsecaseAdapterMockRecorder
+}
+
+// MockIFileUsecaseAdapterMockRecorder is the mock recorder for MockIFileUsecaseAdapter.
+type MockIFileUsecaseAdapterMockRecorder struct {
+	mock *MockIFileUsecaseAdapter
+}
+
+// NewMockIFileUsecaseAdapter creates a new mock instance.
+func NewMockIFileUsecaseAdapter(ctrl *gomock.Controller) *MockIFileUsecaseAdapter {
+	mock := &MockIFileUsecaseAdapter{ctrl: ctrl}
+	mock.recorder = &MockIFileUsecaseAdapterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockIFileUsecaseAdapter) EXPECT() *MockIFileUsecaseAdapterMockRecorder {
+	return m.recorder
+}
+
+// DeleteByID mocks base method.
+func (m *MockIFileUsecaseAdapter) DeleteByID(ctx context.Context, id string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteByID", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteByID indicates an expected call of DeleteByID.
+func (mr *MockIFileUsecaseAdapterMockRecorder) DeleteByID(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteByID", reflect.TypeOf((*MockIFileUsecaseAdapter)(nil).DeleteByID), ctx, id)
+}
+
+// Get mocks base method.
+func (m *MockIFileUsecaseAdapter) Get(ctx context.Context, name string) ([]byte, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", ctx, name)
+	ret0, _ := ret[0].([]byte)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockIFileUsecaseAdapterMockRecorder) Get(ctx, name interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockIFileUsecaseAdapter)(nil).Get), ctx, name)
+}
+
+// Upload mocks base method.
+func (m *MockIFileUsecaseAdapter) Upload(ctx context.Context, data []byte, ownerID string) (*order_entity.Document, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Upload", ctx, data, ownerID)
+	ret0, _ := ret[0].(*order_entity.Document)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Upload indicates an expected call of Upload.
+func (mr *MockIFileUsecaseAdapterMockRecorder) Upload(ctx, data, ownerID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Upload", reflect.TypeOf((*MockIFileUsecaseAdapter)(nil).Upload), ctx, data, ownerID)
+}
+
+// MockIUserUsecaseAdapter is a mock of IUserUsecaseAdapter interface.
+type MockIUserUsecaseAdapter struct {
+	ctrl     *gomock.Controller
+	recorder *MockIUserUsecaseAdapterMockRecorder
+}
+
+// MockIUserUsecaseAdapterMockRecorder is the mock recorder for MockIUserUsecaseAdapter.
+type MockIUserUsecaseAdapterMockRecorder struct {
+	mock *MockIUserUsecaseAdapter
+}
+
+// NewMockIUserUsecaseAdapter creates a new mock instance.
+func NewMockIUserUsecaseAdapter(ctrl *gomock.Controller) *MockIUserUsecaseAdapter {
+	mock := &MockIUserUsecaseAdapter{ctrl: ctrl}
+	mock.recorder = &MockIUserUsecaseAdapterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockIUserUsecaseAdapter) EXPECT() *MockIUserUsecaseAdapterMockRecorder {
+	return m.recorder
+}
+
+// IsRegistered mocks base method.
+func (m *MockIUserUsecaseAdapter) IsRegistered(ctx context.Context, userID string) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IsRegistered", ctx, userID)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// IsRegistered indicates an expected call of IsRegistered.
+func (mr *MockIUserUsecaseAdapterMockRecorder) IsRegistered(ctx, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsRegistered", reflect.TypeOf((*MockIUserUsecaseAdapter)(nil).IsRegistered), ctx, userID)
+}
+
+// MockIStockUsecaseAdapter is a mock of IStockUsecaseAdapter interface.
+type MockIStockUsecaseAdapter struct {
+	ctrl     *gomock.Controller
+	recorder *MockIStockUsecaseAdapterMockRecorder
+}
+
+// MockIStockUsecaseAdapterMockRecorder is the mock recorder for MockIStockUsecaseAdapter.
+type MockIStockUsecaseAdapterMockRecorder struct {
+	mock *MockIStockUsecaseAdapter
+}
+
+// NewMockIStockUsecaseAdapter creates a new mock instance.
+func NewMockIStockUsecaseAdapter(ctrl *gomock.Controller) *MockIStockUsecaseAdapter {
+	mock := &MockIStockUsecaseAdapter{ctrl: ctrl}
+	mock.recorder = &MockIStockUsecaseAdapterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockIStockUsecaseAdapter) EXPECT() *MockIStockUsecaseAdapterMockRecorder {
+	return m.recorder
+}
+
+// Confirm mocks base method.
+func (m *MockIStockUsecaseAdapter) Confirm(ctx context.Context, orderID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Confirm", ctx, orderID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Confirm indicates an expected call of Confirm.
+func (mr *MockIStockUsecaseAdapterMockRecorder) Confirm(ctx, orderID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Confirm", reflect.TypeOf((*MockIStockUsecaseAdapter)(nil).Confirm), ctx, orderID)
+}
+
+// Extend mocks base method.
+func (m *MockIStockUsecaseAdapter) Extend(ctx context.Context, orderID string, expiresAt time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Extend", ctx, orderID, expiresAt)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Extend indicates an expected call of Extend.
+func (mr *MockIStockUsecaseAdapterMockRecorder) Extend(ctx, orderID, expiresAt interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Extend", reflect.TypeOf((*MockIStockUsecaseAdapter)(nil).Extend), ctx, orderID, expiresAt)
+}
+
+// GetAvailable mocks base method.
+func (m *MockIStockUsecaseAdapter) GetAvailable(ctx context.Context, productID string) (int, bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAvailable", ctx, productID)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(bool)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetAvailable indicates an expected call of GetAvailable.
+func (mr *MockIStockUsecaseAdapterMockRecorder) GetAvailable(ctx, productID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAvailable", reflect.TypeOf((*MockIStockUsecaseAdapter)(nil).GetAvailable), ctx, productID)
+}
+
+// GetExpiredOrderIDs mocks base method.
+func (m *MockIStockUsecaseAdapter) GetExpiredOrderIDs(ctx context.Context, limit int) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetExpiredOrderIDs", ctx, limit)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetExpiredOrderIDs indicates an expected call of GetExpiredOrderIDs.
+func (mr *MockIStockUsecaseAdapterMockRecorder) GetExpiredOrderIDs(ctx, limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetExpiredOrderIDs", reflect.TypeOf((*MockIStockUsecaseAdapter)(nil).GetExpiredOrderIDs), ctx, limit)
+}
+
+// Release mocks base method.
+func (m *MockIStockUsecaseAdapter) Release(ctx context.Context, orderID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Release", ctx, orderID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Release indicates an expected call of Release.
+func (mr *MockIStockUsecaseAdapterMockRecorder) Release(ctx, orderID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Release", reflect.TypeOf((*MockIStockUsecaseAdapter)(nil).Release), ctx, orderID)
+}
+
+// Reserve mocks base method.
+func (m *MockIStockUsecaseAdapter) Reserve(ctx context.Context, orderID string, items []order_entity.OrderItem, expiresAt time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Reserve", ctx, orderID, items, expiresAt)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Reserve indicates an expected call of Reserve.
+func (mr *MockIStockUsecaseAdapterMockRecorder) Reserve(ctx, orderID, items, expiresAt interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Reserve", reflect.TypeOf((*MockIStockUsecaseAdapter)(nil).Reserve), ctx, orderID, items, expiresAt)
+}
+
+// Update mocks base method.
+func (m *MockIStockUsecaseAdapter) Update(ctx context.Context, orderID string, items []order_entity.OrderItem, expiresAt time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", ctx, orderID, items, expiresAt)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockIStockUsecaseAdapterMockRecorder) Update(ctx, orderID, items, expiresAt interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockIStockUsecaseAdapter)(nil).Update), ctx, orderID, items, expiresAt)
+}
+
+// WriteOff mocks base method.
+func (m *MockIStockUsecaseAdapter) WriteOff(ctx context.Context, orderID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WriteOff", ctx, orderID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// WriteOff indicates an expected call of WriteOff.
+func (mr *MockIStockUsecaseAdapterMockRecorder) WriteOff(ctx, orderID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WriteOff", reflect.TypeOf((*MockIStockUsecaseAdapter)(nil).WriteOff), ctx, orderID)
+}
+
+// MockIPaymentUsecaseAdapter is a mock of IPaymentUsecaseAdapter interface.
+type MockIPaymentUsecaseAdapter struct {
+	ctrl     *gomock.Controller
+	recorder *MockIPaymentUsecaseAdapterMockRecorder
+}
+
+// MockIPaymentUsecaseAdapterMockRecorder is the mock recorder for MockIPaymentUsecaseAdapter.
+type MockIPaymentUsecaseAdapterMockRecorder struct {
+	mock *MockIPaymentUsecaseAdapter
+}
+
+// NewMockIPaymentUsecaseAdapter creates a new mock instance.
+func NewMockIPaymentUsecaseAdapter(ctrl *gomock.Controller) *MockIPaymentUsecaseAdapter {
+	mock := &MockIPaymentUsecaseAdapter{ctrl: ctrl}
+	mock.recorder = &MockIPaymentUsecaseAdapterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockIPaymentUsecaseAdapter) EXPECT() *MockIPaymentUsecaseAdapterMockRecorder {
+	return m.recorder
+}
+
+// HasPaymentInProgress mocks base method.
+func (m *MockIPaymentUsecaseAdapter) HasPaymentInProgress(ctx context.Context, orderID string, since time.Time) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "HasPaymentInProgress", ctx, orderID, since)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// HasPaymentInProgress indicates an expected call of HasPaymentInProgress.
+func (mr *MockIPaymentUsecaseAdapterMockRecorder) HasPaymentInProgress(ctx, orderID, since interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HasPaymentInProgress", reflect.TypeOf((*MockIPaymentUsecaseAdapter)(nil).HasPaymentInProgress), ctx, orderID, since)
+}
+
+// MockIDocumentRenderer is a mock of IDocumentRenderer interface.
+type MockIDocumentRenderer struct {
+	ctrl     *gomock.Controller
+	recorder *MockIDocumentRendererMockRecorder
+}
+
+// MockIDocumentRendererMockRecorder is the mock recorder for MockIDocumentRenderer.
+type MockIDocumentRendererMockRecorder struct {
+	mock *MockIDocumentRenderer
+}
+
+// NewMockIDocumentRenderer creates a new mock instance.
+func NewMockIDocumentRenderer(ctrl *gomock.Controller) *MockIDocumentRenderer {
+	mock := &MockIDocumentRenderer{ctrl: ctrl}
+	mock.recorder = &MockIDocumentRendererMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockIDocumentRenderer) EXPECT() *MockIDocumentRendererMockRecorder {
+	return m.recorder
+}
+
+// Render mocks base method.
+func (m *MockIDocumentRenderer) Render(data *order_entity.DocumentData) ([]byte, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Render", data)
+	ret0, _ := ret[0].([]byte)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Render indicates an expected call of Render.
+func (mr *MockIDocumentRendererMockRecorder) Render(data interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Render", reflect.TypeOf((*MockIDocumentRenderer)(nil).Render), data)
+}
+
+// MockINotificationService is a mock of INotificationService interface.
+type MockINotificationService struct {
+	ctrl     *gomock.Controller
+	recorder *MockINotificationServiceMockRecorder
+}
+
+// MockINotificationServiceMockRecorder is the mock recorder for MockINotificationService.
+type MockINotificationServiceMockRecorder struct {
+	mock *MockINotificationService
+}
+
+// NewMockINotificationService creates a new mock instance.
+func NewMockINotificationService(ctrl *gomock.Controller) *MockINotificationService {
+	mock := &MockINotificationService{ctrl: ctrl}
+	mock.recorder = &MockINotificationServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockINotificationService) EXPECT() *MockINotificationServiceMockRecorder {
+	return m.recorder
+}
+
+// SendSync mocks base method.
+func (m *MockINotificationService) SendSync(msg *service.Message, selectedNotifiers ...string) error {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{msg}
+	for _, a := range selectedNotifiers {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "SendSync", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SendSync indicates an expected call of SendSync.
+func (mr *MockINotificationServiceMockRecorder) SendSync(msg interface{}, selectedNotifiers ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{msg}, selectedNotifiers...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendSync", reflect.TypeOf((*MockINotificationService)(nil).SendSync), varargs...)
+}
+
+// MockIEmailRenderer is a mock of IEmailRenderer interface.
+type MockIEmailRenderer struct {
+	ctrl     *gomock.Controller
+	recorder *MockIEmailRendererMockRecorder
+}
+
+// MockIEmailRendererMockRecorder is the mock recorder for MockIEmailRenderer.
+type MockIEmailRendererMockRecorder struct {
+	mock *MockIEmailRenderer
+}
+
+// NewMockIEmailRenderer creates a new mock instance.
+func NewMockIEmailRenderer(ctrl *gomock.Controller) *MockIEmailRenderer {
+	mock := &MockIEmailRenderer{ctrl: ctrl}
+	mock.recorder = &MockIEmailRendererMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockIEmailRenderer) EXPECT() *MockIEmailRendererMockRecorder {
+	return m.recorder
+}
+
+// Render mocks base method.
+func (m *MockIEmailRenderer) Render(version string, data *order_entity.NotificationData) (*order_entity.Email, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Render", version, data)
+	ret0, _ := ret[0].(*order_entity.Email)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Render indicates an expected call of Render.
+func (mr *MockIEmailRendererMockRecorder) Render(version, data interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Render", reflect.TypeOf((*MockIEmailRenderer)(nil).Render), version, data)
+}
@@ -0,0 +1,110 @@
+package order_usecase
+
+import (
+	"context"
+
+	"github.com/Fi44er/sdmed/internal/module/notification/service"
+	order_entity "github.com/Fi44er/sdmed/internal/module/order/entity"
+	order_constant "github.com/Fi44er/sdmed/internal/module/order/pkg"
+)
+
+// emailNotifier - канал сервиса уведомлений для писем покупателю
+const emailNotifier = "smtp"
+
+// NotifyPaymentFailed сообщает покупателю об отклоненном платеже по заказу
+func (u *OrderUsecase) NotifyPaymentFailed(ctx context.Context, id string) error {
+	order, err := u.GetByID(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	u.notify(ctx, order, order_entity.NotificationEventPaymentFailed, u.templateVersion, "")
+	return nil
+}
+
+// GetNotifications возвращает историю писем по заказу
+func (u *OrderUsecase) GetNotifications(ctx context.Context, id string) ([]order_entity.Notification, error) {
+	if _, err := u.GetByID(ctx, id); err != nil {
+		return nil, err
+	}
+
+	return u.repository.GetNotifications(ctx, id)
+}
+
+// ResendNotification отправляет письмо повторно по тому же шаблону с текущими данными заказа.
+// Повторная отправка записывается отдельно со ссылкой на исходную
+func (u *OrderUsecase) ResendNotification(ctx context.Context, id, notificationID string) (*order_entity.Notification, error) {
+	original, err := u.repository.GetNotification(ctx, notificationID)
+	if err != nil {
+		return nil, err
+	}
+	if original == nil || original.OrderID != id {
+		return nil, order_constant.ErrNotificationNotFound
+	}
+
+	order, err := u.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	u.logger.Infof("Resending %s notification %s of order %s", original.Event, original.ID, id)
+
+	return u.notify(ctx, order, original.Event, original.TemplateVersion, original.ID), nil
+}
+
+// notifyStatus отправляет письмо о переходе заказа в текущий статус, если для него есть событие
+func (u *OrderUsecase) notifyStatus(ctx context.Context, order *order_entity.Order) {
+	if event, ok := order_entity.NotificationEventFor(order.Status); ok {
+		u.notify(ctx, order, event, u.templateVersion, "")
+	}
+}
+
+// notify формирует письмо по шаблону и отправляет его, дожидаясь ответа сервиса уведомлений.
+// Результат отправки записывается в историю писем заказа; ошибки не прерывают операцию с заказом.
+// Вызывается только после фиксации транзакции, чтобы не держать ее открытой на время отправки
+func (u *OrderUsecase) notify(
+	ctx context.Context,
+	order *order_entity.Order,
+	event order_entity.NotificationEvent,
+	version, resendOfID string,
+) *order_entity.Notification {
+	notification := &order_entity.Notification{
+		OrderID:         order.ID,
+		Event:           event,
+		TemplateVersion: version,
+		Recipient:       order.Contact.Email,
+		ResendOfID:      resendOfID,
+	}
+
+	email, err := u.emailRenderer.Render(version, &order_entity.NotificationData{
+		Event:  event,
+		Number: order_entity.DocumentNumber(order.ID),
+		Order:  order,
+	})
+	switch {
+	case err != nil:
+		u.logger.Errorf("Failed to render %s email of order %s: %v", event, order.ID, err)
+		notification.Status, notification.Error = order_entity.NotificationStatusFailed, err.Error()
+	case notification.Recipient == "":
+		u.logger.Debugf("Order %s has no email, %s notification skipped", order.ID, event)
+		notification.Subject, notification.Status = email.Subject, order_entity.NotificationStatusSkipped
+	default:
+		notification.Subject = email.Subject
+		err := u.notificationService.SendSync(&service.Message{
+			Recipient: notification.Recipient,
+			Subject:   email.Subject,
+			Content:   email.Body,
+		}, emailNotifier)
+		if err != nil {
+			u.logger.Errorf("Failed to send %s email of order %s: %v", event, order.ID, err)
+			notification.Status, notification.Error = order_entity.NotificationStatusFailed, err.Error()
+		} else {
+			notification.Status = order_entity.NotificationStatusSent
+		}
+	}
+
+	if err := u.repository.AddNotification(ctx, notification); err != nil {
+		u.logger.Warnf("Failed to record %s notification of order %s: %v", event, order.ID, err)
+	}
+
+	return notification
+}
@@ -0,0 +1,98 @@
+package order_usecase
+
+import (
+	"context"
+	"math"
+	"time"
+
+	order_entity "github.com/Fi44er/sdmed/internal/module/order/entity"
+	order_constant "github.com/Fi44er/sdmed/internal/module/order/pkg"
+)
+
+// MarkPaid фиксирует оплату картой на сумму amount. Заказ с сертификатом переходит в paid,
+// только когда сертификат погашен по всем позициям и доплата оплачена полностью,
+// до этого в истории отмечается оплата доплаты.
+// Письмо не отправляется: вызывающий может выполнять метод в своей транзакции,
+// поэтому после ее фиксации он вызывает NotifyPaid.
+// Возвращает false, если заказ уже нельзя оплатить, например он отменен
+func (u *OrderUsecase) MarkPaid(ctx context.Context, id string, amount float64, comment string) (bool, error) {
+	u.logger.Infof("Card payment received for order %s", id)
+
+	var applied bool
+	err := u.uow.Do(ctx, func(ctx context.Context) error {
+		repo, err := u.getRepository(ctx)
+		if err != nil {
+			return err
+		}
+
+		order, err := repo.GetByID(ctx, id)
+		if err != nil {
+			return err
+		}
+		if order == nil {
+			return order_constant.ErrOrderNotFound
+		}
+		if !order.Status.IsPayable() {
+			u.logger.Warnf("Order %s in status %s cannot be marked as paid", id, order.Status)
+			return nil
+		}
+		applied = true
+
+		if order.PaymentMethod == order_entity.PaymentMethodCertificate {
+			if err := repo.AddSurchargePaid(ctx, id, amount); err != nil {
+				return err
+			}
+			now := time.Now()
+			order.SurchargePaid = math.Round((order.SurchargePaid+amount)*100) / 100
+			order.SurchargePaidAt = &now
+
+			if err := repo.AddHistory(ctx, &order_entity.StatusChange{
+				OrderID:    id,
+				FromStatus: order.Status,
+				ToStatus:   order.Status,
+				Actor:      order_entity.ActorSystem,
+				Comment:    "surcharge paid: " + comment,
+			}); err != nil {
+				return err
+			}
+
+			if !order.IsFullyPaid() {
+				u.logger.Infof("Order %s: surcharge paid, due %.2f, waiting for certificate settlement", id, order.AmountDue())
+				return nil
+			}
+		}
+
+		_, err = u.pay(ctx, order, comment)
+		return err
+	})
+	if err != nil {
+		return false, err
+	}
+
+	return applied, nil
+}
+
+// NotifyPaid отправляет покупателю письмо об оплате, если заказ оплачен
+func (u *OrderUsecase) NotifyPaid(ctx context.Context, id string) error {
+	order, err := u.GetByID(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	if order.Status == order_entity.OrderStatusPaid {
+		u.notifyStatus(ctx, order)
+	}
+	return nil
+}
+
+// pay переводит заказ в paid от имени системы, новый заказ сначала подтверждается.
+// Выполняется в транзакции вызывающего
+func (u *OrderUsecase) pay(ctx context.Context, order *order_entity.Order, comment string) (*order_entity.Order, error) {
+	if order.Status == order_entity.OrderStatusNew {
+		if _, err := u.transition(ctx, order.ID, order_entity.OrderStatusConfirmed, order_entity.ActorSystem, "", comment, nil); err != nil {
+			return nil, err
+		}
+	}
+
+	return u.transition(ctx, order.ID, order_entity.OrderStatusPaid, order_entity.ActorSystem, "", comment, nil)
+}
@@ -0,0 +1,107 @@
+package order_usecase
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	order_constant "github.com/Fi44er/sdmed/internal/module/order/pkg"
+	"github.com/Fi44er/sdmed/pkg/logger"
+)
+
+// ReservationReleaser периодически отменяет заказы, не оплаченные до истечения резерва товара
+type ReservationReleaser struct {
+	usecase  IOrderUsecase
+	logger   *logger.Logger
+	interval time.Duration
+	stopCh   chan struct{}
+	running  bool
+	mutex    sync.RWMutex
+}
+
+func (rr *ReservationReleaser) Name() string {
+	return order_constant.ProcessName
+}
+
+func NewReservationReleaser(usecase IOrderUsecase, logger *logger.Logger, interval time.Duration) *ReservationReleaser {
+	if interval <= 0 {
+		interval = order_constant.DefaultReleaseInterval
+	}
+
+	return &ReservationReleaser{
+		usecase:  usecase,
+		logger:   logger,
+		interval: interval,
+		stopCh:   make(chan struct{}),
+	}
+}
+
+func (rr *ReservationReleaser) Start() {
+	rr.mutex.Lock()
+	defer rr.mutex.Unlock()
+
+	if rr.running {
+		rr.logger.Warn("Reservation releaser is already running")
+		return
+	}
+
+	rr.stopCh = make(chan struct{})
+	rr.running = true
+
+	ticker := time.NewTicker(rr.interval)
+
+	go func() {
+		rr.logger.Infof("Reservation releaser started with interval: %v", rr.interval)
+
+		rr.releaseExpired()
+		for {
+			select {
+			case <-ticker.C:
+				rr.releaseExpired()
+			case <-rr.stopCh:
+				ticker.Stop()
+				rr.mutex.Lock()
+				rr.running = false
+				rr.mutex.Unlock()
+				rr.logger.Info("Reservation releaser stopped")
+				return
+			}
+		}
+	}()
+}
+
+func (rr *ReservationReleaser) Stop(ctx context.Context) error {
+	rr.mutex.Lock()
+	defer rr.mutex.Unlock()
+
+	if !rr.running {
+		return nil
+	}
+
+	close(rr.stopCh)
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-time.After(100 * time.Millisecond):
+		return nil
+	}
+}
+
+func (rr *ReservationReleaser) IsRunning() bool {
+	rr.mutex.RLock()
+	defer rr.mutex.RUnlock()
+	return rr.running
+}
+
+func (rr *ReservationReleaser) releaseExpired() {
+	released, err := rr.usecase.ReleaseExpired(context.Background())
+	if err != nil {
+		rr.logger.Errorf("Failed to release expired reservations: %v", err)
+		return
+	}
+
+	if released > 0 {
+		rr.logger.Infof("Cancelled %d orders with expired reservations", released)
+	}
+}
@@ -0,0 +1,64 @@
+package order_usecase
+
+import (
+	"context"
+
+	order_entity "github.com/Fi44er/sdmed/internal/module/order/entity"
+)
+
+// Repeat собирает корзину пользователя заново из позиций его заказа по актуальным ценам.
+// Текущее содержимое корзины заменяется, недоступные товары не добавляются и
+// возвращаются в Unavailable с причиной. Товар с учетом остатков добавляется
+// не больше свободного остатка склада, такая позиция помечается как доступная частично
+func (u *OrderUsecase) Repeat(ctx context.Context, id string) (*order_entity.RepeatResult, error) {
+	order, err := u.GetOwnByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	u.logger.Infof("Repeating order %s of user %s", order.ID, order.UserID)
+
+	result := &order_entity.RepeatResult{
+		OrderID:     order.ID,
+		Added:       []order_entity.RepeatItem{},
+		Unavailable: []order_entity.RepeatItem{},
+	}
+	err = u.uow.Do(ctx, func(ctx context.Context) error {
+		if err := u.cartUsecase.Clear(ctx, order.UserID); err != nil {
+			return err
+		}
+
+		for _, item := range order.RepeatItems() {
+			available, tracked, err := u.stockUsecase.GetAvailable(ctx, item.ProductID)
+			if err != nil {
+				return err
+			}
+			if tracked && available == 0 {
+				u.logger.Debugf("Product %s of order %s is out of stock", item.ProductID, order.ID)
+				item.Reason = order_entity.RepeatReasonOutOfStock
+				result.Unavailable = append(result.Unavailable, item)
+				continue
+			}
+			if tracked && available < item.Quantity {
+				u.logger.Debugf("Product %s of order %s is partially available: %d of %d", item.ProductID, order.ID, available, item.Quantity)
+				item.Quantity = available
+			}
+
+			if err := u.cartUsecase.AddItem(ctx, &item); err != nil {
+				return err
+			}
+			if item.Reason != "" {
+				u.logger.Debugf("Product %s of order %s is not added to cart: %s", item.ProductID, order.ID, item.Reason)
+				result.Unavailable = append(result.Unavailable, item)
+				continue
+			}
+			result.Added = append(result.Added, item)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
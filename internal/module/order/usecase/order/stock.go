@@ -0,0 +1,66 @@
+package order_usecase
+
+import (
+	"context"
+	"time"
+
+	order_entity "github.com/Fi44er/sdmed/internal/module/order/entity"
+	order_constant "github.com/Fi44er/sdmed/internal/module/order/pkg"
+)
+
+// ReleaseExpired отменяет неоплаченные заказы, резерв которых истек: отмена снимает резерв
+// и уведомляет покупателя. Заказ, оплаченный в этот момент, отменить нельзя, он пропускается.
+// Если по заказу идет оплата, он не отменяется, а резерв продлевается
+func (u *OrderUsecase) ReleaseExpired(ctx context.Context) (int, error) {
+	ids, err := u.stockUsecase.GetExpiredOrderIDs(ctx, order_constant.ReleaseBatchSize)
+	if err != nil {
+		return 0, err
+	}
+
+	released := 0
+	for _, id := range ids {
+		inProgress, err := u.hasPaymentInProgress(ctx, id)
+		if err != nil {
+			u.logger.Warnf("Failed to check payments of order %s with expired reservation: %v", id, err)
+			continue
+		}
+		if inProgress {
+			u.logger.Infof("Order %s has a payment in progress, reservation is extended", id)
+			if err := u.stockUsecase.Extend(ctx, id, time.Now().Add(u.reservationTTL)); err != nil {
+				u.logger.Warnf("Failed to extend reservation of order %s: %v", id, err)
+			}
+			continue
+		}
+
+		_, err = u.ChangeStatus(ctx, id, order_entity.OrderStatusCancelled, order_entity.ActorSystem, "Заказ не оплачен вовремя, резерв товара снят")
+		if err != nil {
+			u.logger.Warnf("Failed to cancel order %s with expired reservation: %v", id, err)
+			continue
+		}
+		released++
+	}
+
+	return released, nil
+}
+
+// hasPaymentInProgress проверяет оплаты заказа, начатые не раньше срока резерва
+func (u *OrderUsecase) hasPaymentInProgress(ctx context.Context, id string) (bool, error) {
+	if u.paymentUsecase == nil {
+		return false, nil
+	}
+	return u.paymentUsecase.HasPaymentInProgress(ctx, id, time.Now().Add(-u.reservationTTL))
+}
+
+// applyStock меняет резерв товара вместе со статусом заказа: после оплаты резерв
+// держится без срока, при отгрузке списывается, при отмене возвращается в остаток
+func (u *OrderUsecase) applyStock(ctx context.Context, id string, to order_entity.OrderStatus) error {
+	switch to {
+	case order_entity.OrderStatusPaid:
+		return u.stockUsecase.Confirm(ctx, id)
+	case order_entity.OrderStatusShipped:
+		return u.stockUsecase.WriteOff(ctx, id)
+	case order_entity.OrderStatusCancelled:
+		return u.stockUsecase.Release(ctx, id)
+	}
+	return nil
+}
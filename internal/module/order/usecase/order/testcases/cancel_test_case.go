@@ -0,0 +1,69 @@
+package order_testcases
+
+import (
+	"context"
+
+	order_entity "github.com/Fi44er/sdmed/internal/module/order/entity"
+	order_constant "github.com/Fi44er/sdmed/internal/module/order/pkg"
+	"github.com/Fi44er/sdmed/internal/module/order/usecase/order/mock"
+	uow_mock "github.com/Fi44er/sdmed/pkg/postgres/uow/mock"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+type MockCancel struct {
+	Ctrl              *gomock.Controller
+	Ctx               context.Context
+	RepoMock          *mock.MockIOrderRepository
+	SessionMock       *mock.MockISessionRepository
+	StockMock         *mock.MockIStockUsecaseAdapter
+	NotificationMock  *mock.MockINotificationService
+	EmailRendererMock *mock.MockIEmailRenderer
+	UowMock           *uow_mock.MockUow
+	T                 assert.TestingT
+}
+
+type CancelTestCase struct {
+	Name          string
+	Comment       string
+	SetupMocks    func(m *MockCancel)
+	ExpectedError error
+}
+
+func GetCancelTestCases() []CancelTestCase {
+	return []CancelTestCase{
+		{
+			Name:    "cancelled_by_owner",
+			Comment: "Передумал",
+			SetupMocks: func(m *MockCancel) {
+				expectSession(m.Ctx, m.SessionMock, UserID)
+				expectTx(m.Ctx, m.UowMock, m.RepoMock)
+				gomock.InOrder(
+					m.RepoMock.EXPECT().GetByID(m.Ctx, OrderID).Return(order(order_entity.OrderStatusConfirmed), nil),
+					m.RepoMock.EXPECT().UpdateStatus(m.Ctx, OrderID, order_entity.OrderStatusConfirmed, order_entity.OrderStatusCancelled).Return(true, nil),
+					m.RepoMock.EXPECT().AddHistory(m.Ctx, history(order_entity.OrderStatusConfirmed, order_entity.OrderStatusCancelled, order_entity.ActorCustomer, UserID, "Передумал")).Return(nil),
+					m.StockMock.EXPECT().Release(m.Ctx, OrderID).Return(nil),
+				)
+				expectEmail(m.Ctx, m.EmailRendererMock, m.NotificationMock, m.RepoMock, order_entity.NotificationEventCancelled, nil)
+			},
+		},
+		{
+			Name: "paid_order_cannot_be_cancelled_by_customer",
+			SetupMocks: func(m *MockCancel) {
+				expectSession(m.Ctx, m.SessionMock, UserID)
+				expectTx(m.Ctx, m.UowMock, m.RepoMock)
+				m.RepoMock.EXPECT().GetByID(m.Ctx, OrderID).Return(order(order_entity.OrderStatusPaid), nil)
+			},
+			ExpectedError: order_constant.ErrTransitionForbidden,
+		},
+		{
+			Name: "foreign_order",
+			SetupMocks: func(m *MockCancel) {
+				expectSession(m.Ctx, m.SessionMock, "other")
+				expectTx(m.Ctx, m.UowMock, m.RepoMock)
+				m.RepoMock.EXPECT().GetByID(m.Ctx, OrderID).Return(order(order_entity.OrderStatusNew), nil)
+			},
+			ExpectedError: order_constant.ErrOrderNotFound,
+		},
+	}
+}
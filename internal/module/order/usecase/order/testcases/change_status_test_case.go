@@ -0,0 +1,198 @@
+package order_testcases
+
+import (
+	"context"
+	"errors"
+
+	order_entity "github.com/Fi44er/sdmed/internal/module/order/entity"
+	order_constant "github.com/Fi44er/sdmed/internal/module/order/pkg"
+	"github.com/Fi44er/sdmed/internal/module/order/usecase/order/mock"
+	uow_mock "github.com/Fi44er/sdmed/pkg/postgres/uow/mock"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+type MockChangeStatus struct {
+	Ctrl              *gomock.Controller
+	Ctx               context.Context
+	RepoMock          *mock.MockIOrderRepository
+	SessionMock       *mock.MockISessionRepository
+	DiscountMock      *mock.MockIDiscountUsecaseAdapter
+	StockMock         *mock.MockIStockUsecaseAdapter
+	NotificationMock  *mock.MockINotificationService
+	EmailRendererMock *mock.MockIEmailRenderer
+	UowMock           *uow_mock.MockUow
+	T                 assert.TestingT
+}
+
+type ChangeStatusTestCase struct {
+	Name           string
+	To             order_entity.OrderStatus
+	Actor          order_entity.Actor
+	Comment        string
+	SetupMocks     func(m *MockChangeStatus)
+	ExpectedStatus order_entity.OrderStatus
+	ExpectedError  error
+}
+
+func order(status order_entity.OrderStatus) *order_entity.Order {
+	return &order_entity.Order{
+		ID:            OrderID,
+		UserID:        UserID,
+		Status:        status,
+		PaymentMethod: order_entity.PaymentMethodCard,
+		Items:         cartItems(),
+		Contact:       order_entity.Contact{Email: Email},
+	}
+}
+
+func history(from, to order_entity.OrderStatus, actor order_entity.Actor, actorID, comment string) *order_entity.StatusChange {
+	return &order_entity.StatusChange{
+		OrderID:    OrderID,
+		FromStatus: from,
+		ToStatus:   to,
+		Actor:      actor,
+		ActorID:    actorID,
+		Comment:    comment,
+	}
+}
+
+func GetChangeStatusTestCases() []ChangeStatusTestCase {
+	return []ChangeStatusTestCase{
+		{
+			Name:    "paid_by_manager",
+			To:      order_entity.OrderStatusPaid,
+			Actor:   order_entity.ActorManager,
+			Comment: "Оплата по счету",
+			SetupMocks: func(m *MockChangeStatus) {
+				expectSession(m.Ctx, m.SessionMock, ManagerID)
+				expectTx(m.Ctx, m.UowMock, m.RepoMock)
+				gomock.InOrder(
+					m.RepoMock.EXPECT().GetByID(m.Ctx, OrderID).Return(order(order_entity.OrderStatusConfirmed), nil),
+					m.RepoMock.EXPECT().UpdateStatus(m.Ctx, OrderID, order_entity.OrderStatusConfirmed, order_entity.OrderStatusPaid).Return(true, nil),
+					m.RepoMock.EXPECT().AddHistory(m.Ctx, history(order_entity.OrderStatusConfirmed, order_entity.OrderStatusPaid, order_entity.ActorManager, ManagerID, "Оплата по счету")).Return(nil),
+					m.StockMock.EXPECT().Confirm(m.Ctx, OrderID).Return(nil),
+				)
+				expectEmail(m.Ctx, m.EmailRendererMock, m.NotificationMock, m.RepoMock, order_entity.NotificationEventPaid, nil)
+			},
+			ExpectedStatus: order_entity.OrderStatusPaid,
+		},
+		{
+			Name:  "cancel_releases_promo_code_and_stock",
+			To:    order_entity.OrderStatusCancelled,
+			Actor: order_entity.ActorManager,
+			SetupMocks: func(m *MockChangeStatus) {
+				withPromoCode := order(order_entity.OrderStatusNew)
+				withPromoCode.PromoCodeID = "promo-1"
+
+				expectSession(m.Ctx, m.SessionMock, ManagerID)
+				expectTx(m.Ctx, m.UowMock, m.RepoMock)
+				gomock.InOrder(
+					m.RepoMock.EXPECT().GetByID(m.Ctx, OrderID).Return(withPromoCode, nil),
+					m.RepoMock.EXPECT().UpdateStatus(m.Ctx, OrderID, order_entity.OrderStatusNew, order_entity.OrderStatusCancelled).Return(true, nil),
+					m.RepoMock.EXPECT().AddHistory(m.Ctx, history(order_entity.OrderStatusNew, order_entity.OrderStatusCancelled, order_entity.ActorManager, ManagerID, "")).Return(nil),
+					m.DiscountMock.EXPECT().Release(m.Ctx, OrderID).Return(nil),
+					m.StockMock.EXPECT().Release(m.Ctx, OrderID).Return(nil),
+				)
+				expectEmail(m.Ctx, m.EmailRendererMock, m.NotificationMock, m.RepoMock, order_entity.NotificationEventCancelled, nil)
+			},
+			ExpectedStatus: order_entity.OrderStatusCancelled,
+		},
+		{
+			Name:  "shipped_stock_written_off",
+			To:    order_entity.OrderStatusShipped,
+			Actor: order_entity.ActorManager,
+			SetupMocks: func(m *MockChangeStatus) {
+				expectSession(m.Ctx, m.SessionMock, ManagerID)
+				expectTx(m.Ctx, m.UowMock, m.RepoMock)
+				gomock.InOrder(
+					m.RepoMock.EXPECT().GetByID(m.Ctx, OrderID).Return(order(order_entity.OrderStatusAssembling), nil),
+					m.RepoMock.EXPECT().UpdateStatus(m.Ctx, OrderID, order_entity.OrderStatusAssembling, order_entity.OrderStatusShipped).Return(true, nil),
+					m.RepoMock.EXPECT().AddHistory(m.Ctx, history(order_entity.OrderStatusAssembling, order_entity.OrderStatusShipped, order_entity.ActorManager, ManagerID, "")).Return(nil),
+					m.StockMock.EXPECT().WriteOff(m.Ctx, OrderID).Return(nil),
+				)
+				expectEmail(m.Ctx, m.EmailRendererMock, m.NotificationMock, m.RepoMock, order_entity.NotificationEventShipped, nil)
+			},
+			ExpectedStatus: order_entity.OrderStatusShipped,
+		},
+		{
+			Name:  "assembling_without_email",
+			To:    order_entity.OrderStatusAssembling,
+			Actor: order_entity.ActorManager,
+			SetupMocks: func(m *MockChangeStatus) {
+				expectSession(m.Ctx, m.SessionMock, ManagerID)
+				expectTx(m.Ctx, m.UowMock, m.RepoMock)
+				m.RepoMock.EXPECT().GetByID(m.Ctx, OrderID).Return(order(order_entity.OrderStatusPaid), nil)
+				m.RepoMock.EXPECT().UpdateStatus(m.Ctx, OrderID, order_entity.OrderStatusPaid, order_entity.OrderStatusAssembling).Return(true, nil)
+				m.RepoMock.EXPECT().AddHistory(m.Ctx, history(order_entity.OrderStatusPaid, order_entity.OrderStatusAssembling, order_entity.ActorManager, ManagerID, "")).Return(nil)
+			},
+			ExpectedStatus: order_entity.OrderStatusAssembling,
+		},
+		{
+			Name:  "email_failure_does_not_fail_transition",
+			To:    order_entity.OrderStatusDelivered,
+			Actor: order_entity.ActorSystem,
+			SetupMocks: func(m *MockChangeStatus) {
+				m.SessionMock.EXPECT().GetSessionInfo(m.Ctx).Return(nil, errors.New("no session"))
+				expectTx(m.Ctx, m.UowMock, m.RepoMock)
+				m.RepoMock.EXPECT().GetByID(m.Ctx, OrderID).Return(order(order_entity.OrderStatusShipped), nil)
+				m.RepoMock.EXPECT().UpdateStatus(m.Ctx, OrderID, order_entity.OrderStatusShipped, order_entity.OrderStatusDelivered).Return(true, nil)
+				m.RepoMock.EXPECT().AddHistory(m.Ctx, history(order_entity.OrderStatusShipped, order_entity.OrderStatusDelivered, order_entity.ActorSystem, "", "")).Return(nil)
+				expectEmail(m.Ctx, m.EmailRendererMock, m.NotificationMock, m.RepoMock, order_entity.NotificationEventDelivered, errSMTP)
+			},
+			ExpectedStatus: order_entity.OrderStatusDelivered,
+		},
+		{
+			Name:  "transition_does_not_exist",
+			To:    order_entity.OrderStatusShipped,
+			Actor: order_entity.ActorManager,
+			SetupMocks: func(m *MockChangeStatus) {
+				expectSession(m.Ctx, m.SessionMock, ManagerID)
+				expectTx(m.Ctx, m.UowMock, m.RepoMock)
+				m.RepoMock.EXPECT().GetByID(m.Ctx, OrderID).Return(order(order_entity.OrderStatusNew), nil)
+			},
+			ExpectedError: order_constant.ErrInvalidTransition,
+		},
+		{
+			Name:  "transition_forbidden_for_actor",
+			To:    order_entity.OrderStatusAssembling,
+			Actor: order_entity.ActorSystem,
+			SetupMocks: func(m *MockChangeStatus) {
+				m.SessionMock.EXPECT().GetSessionInfo(m.Ctx).Return(nil, errors.New("no session"))
+				expectTx(m.Ctx, m.UowMock, m.RepoMock)
+				m.RepoMock.EXPECT().GetByID(m.Ctx, OrderID).Return(order(order_entity.OrderStatusPaid), nil)
+			},
+			ExpectedError: order_constant.ErrTransitionForbidden,
+		},
+		{
+			Name:  "status_changed_concurrently",
+			To:    order_entity.OrderStatusConfirmed,
+			Actor: order_entity.ActorManager,
+			SetupMocks: func(m *MockChangeStatus) {
+				expectSession(m.Ctx, m.SessionMock, ManagerID)
+				expectTx(m.Ctx, m.UowMock, m.RepoMock)
+				m.RepoMock.EXPECT().GetByID(m.Ctx, OrderID).Return(order(order_entity.OrderStatusNew), nil)
+				m.RepoMock.EXPECT().UpdateStatus(m.Ctx, OrderID, order_entity.OrderStatusNew, order_entity.OrderStatusConfirmed).Return(false, nil)
+			},
+			ExpectedError: order_constant.ErrStatusChanged,
+		},
+		{
+			Name:  "order_not_found",
+			To:    order_entity.OrderStatusConfirmed,
+			Actor: order_entity.ActorManager,
+			SetupMocks: func(m *MockChangeStatus) {
+				expectSession(m.Ctx, m.SessionMock, ManagerID)
+				expectTx(m.Ctx, m.UowMock, m.RepoMock)
+				m.RepoMock.EXPECT().GetByID(m.Ctx, OrderID).Return(nil, nil)
+			},
+			ExpectedError: order_constant.ErrOrderNotFound,
+		},
+		{
+			Name:          "invalid_status",
+			To:            order_entity.OrderStatus("unknown"),
+			Actor:         order_entity.ActorManager,
+			SetupMocks:    func(m *MockChangeStatus) {},
+			ExpectedError: order_constant.ErrInvalidStatus,
+		},
+	}
+}
@@ -0,0 +1,219 @@
+package order_testcases
+
+import (
+	"context"
+	"errors"
+
+	auth_entity "github.com/Fi44er/sdmed/internal/module/auth/entity"
+	"github.com/Fi44er/sdmed/internal/module/notification/service"
+	order_entity "github.com/Fi44er/sdmed/internal/module/order/entity"
+	order_constant "github.com/Fi44er/sdmed/internal/module/order/pkg"
+	"github.com/Fi44er/sdmed/internal/module/order/usecase/order/mock"
+	uow_mock "github.com/Fi44er/sdmed/pkg/postgres/uow/mock"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+const (
+	OrderID         = "9b1deb4d-3b7d-4bad-9bdd-2b0d7b3dcb6d"
+	UserID          = "user-1"
+	ManagerID       = "manager-1"
+	Email           = "buyer@example.com"
+	TemplateVersion = "v1"
+)
+
+var (
+	errStock    = errors.New("stock error")
+	errSMTP     = errors.New("smtp unavailable")
+	errTemplate = errors.New("template not found")
+)
+
+type MockCreate struct {
+	Ctrl              *gomock.Controller
+	Ctx               context.Context
+	RepoMock          *mock.MockIOrderRepository
+	SessionMock       *mock.MockISessionRepository
+	CartMock          *mock.MockICartUsecaseAdapter
+	RegionMock        *mock.MockIRegionUsecaseAdapter
+	DeliveryMock      *mock.MockIDeliveryUsecaseAdapter
+	AddressMock       *mock.MockIAddressUsecaseAdapter
+	DiscountMock      *mock.MockIDiscountUsecaseAdapter
+	StockMock         *mock.MockIStockUsecaseAdapter
+	NotificationMock  *mock.MockINotificationService
+	EmailRendererMock *mock.MockIEmailRenderer
+	UowMock           *uow_mock.MockUow
+	T                 assert.TestingT
+}
+
+type CreateTestCase struct {
+	Name          string
+	Order         *order_entity.Order
+	SetupMocks    func(m *MockCreate)
+	ExpectedTotal float64
+	ExpectedError error
+}
+
+func expectTx(ctx context.Context, uowMock *uow_mock.MockUow, repo any) {
+	uowMock.EXPECT().
+		Do(ctx, gomock.Any()).
+		DoAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+			return fn(ctx)
+		})
+	uowMock.EXPECT().GetRepository(ctx, "order").Return(repo, nil)
+}
+
+func expectSession(ctx context.Context, sessionMock *mock.MockISessionRepository, userID string) {
+	sessionMock.EXPECT().GetSessionInfo(ctx).Return(&auth_entity.ActiveSession{UserID: userID}, nil)
+}
+
+// expectEmail ожидает письмо покупателю по событию и запись результата отправки
+func expectEmail(
+	ctx context.Context,
+	renderer *mock.MockIEmailRenderer,
+	notifier *mock.MockINotificationService,
+	repo *mock.MockIOrderRepository,
+	event order_entity.NotificationEvent,
+	sendErr error,
+) {
+	email := &order_entity.Email{Subject: "Заказ " + order_entity.DocumentNumber(OrderID), Body: string(event)}
+	renderer.EXPECT().Render(TemplateVersion, gomock.Any()).Return(email, nil)
+	notifier.EXPECT().
+		SendSync(&service.Message{Recipient: Email, Subject: email.Subject, Content: email.Body}, "smtp").
+		Return(sendErr)
+
+	notification := &order_entity.Notification{
+		OrderID:         OrderID,
+		Event:           event,
+		TemplateVersion: TemplateVersion,
+		Recipient:       Email,
+		Subject:         email.Subject,
+		Status:          order_entity.NotificationStatusSent,
+	}
+	if sendErr != nil {
+		notification.Status, notification.Error = order_entity.NotificationStatusFailed, sendErr.Error()
+	}
+	repo.EXPECT().AddNotification(ctx, notification).Return(nil)
+}
+
+func cartItems() []order_entity.OrderItem {
+	return []order_entity.OrderItem{{ProductID: "cane", Name: "Трость", Quantity: 2, Price: 1000}}
+}
+
+func newOrder() *order_entity.Order {
+	return &order_entity.Order{
+		PromoCode: "SALE",
+		Contact:   order_entity.Contact{Name: "Иван", Phone: "+79990000000", Email: Email},
+		Delivery:  order_entity.Delivery{MethodID: "courier", AddressID: "address-1"},
+	}
+}
+
+func courierQuote() *order_entity.DeliveryQuote {
+	return &order_entity.DeliveryQuote{
+		MethodID:        "courier",
+		MethodName:      "Курьер",
+		Method:          order_entity.DeliveryMethodCourier,
+		Cost:            300,
+		RequiresAddress: true,
+	}
+}
+
+// expectCheckout ожидает сборку заказа из корзины до сохранения
+func expectCheckout(m *MockCreate, quote *order_entity.DeliveryQuote) {
+	expectSession(m.Ctx, m.SessionMock, UserID)
+	m.RegionMock.EXPECT().ResolveRegionID(m.Ctx).Return("region-1", nil)
+	expectTx(m.Ctx, m.UowMock, m.RepoMock)
+	m.CartMock.EXPECT().GetItems(m.Ctx, UserID).Return(cartItems(), nil)
+	m.AddressMock.EXPECT().GetByUserID(m.Ctx, UserID, "address-1").Return(&order_entity.Address{
+		ID:         "address-1",
+		RegionID:   "region-2",
+		PostalCode: "101000",
+		Formatted:  "Москва, ул. Тверская, д. 1",
+	}, nil)
+	m.DeliveryMock.EXPECT().GetQuote(m.Ctx, "courier", "region-2", "101000", cartItems()).Return(quote, nil)
+}
+
+func GetCreateTestCases() []CreateTestCase {
+	discounted := cartItems()
+	discounted[0].Discount = 100
+
+	return []CreateTestCase{
+		{
+			Name:  "order_created_from_cart",
+			Order: newOrder(),
+			SetupMocks: func(m *MockCreate) {
+				expectCheckout(m, courierQuote())
+				m.DiscountMock.EXPECT().Calculate(m.Ctx, UserID, "SALE", cartItems()).Return(&order_entity.Discount{
+					PromoCodeID: "promo-1",
+					PromoCode:   "SALE",
+					Lines:       []float64{100},
+					Amount:      100,
+				}, nil)
+				gomock.InOrder(
+					m.RepoMock.EXPECT().Create(m.Ctx, gomock.Any()).DoAndReturn(func(ctx context.Context, order *order_entity.Order) error {
+						order.ID = OrderID
+						return nil
+					}),
+					m.StockMock.EXPECT().Reserve(m.Ctx, OrderID, discounted, gomock.Any()).Return(nil),
+					m.DiscountMock.EXPECT().Redeem(m.Ctx, "promo-1", UserID, OrderID).Return(nil),
+					m.RepoMock.EXPECT().AddHistory(m.Ctx, &order_entity.StatusChange{
+						OrderID:  OrderID,
+						ToStatus: order_entity.OrderStatusNew,
+						Actor:    order_entity.ActorCustomer,
+						ActorID:  UserID,
+					}).Return(nil),
+					m.CartMock.EXPECT().Clear(m.Ctx, UserID).Return(nil),
+				)
+				expectEmail(m.Ctx, m.EmailRendererMock, m.NotificationMock, m.RepoMock, order_entity.NotificationEventCreated, nil)
+			},
+			ExpectedTotal: 2200,
+		},
+		{
+			Name:  "not_enough_stock",
+			Order: newOrder(),
+			SetupMocks: func(m *MockCreate) {
+				expectCheckout(m, courierQuote())
+				m.DiscountMock.EXPECT().Calculate(m.Ctx, UserID, "SALE", cartItems()).Return(&order_entity.Discount{}, nil)
+				m.RepoMock.EXPECT().Create(m.Ctx, gomock.Any()).DoAndReturn(func(ctx context.Context, order *order_entity.Order) error {
+					order.ID = OrderID
+					return nil
+				})
+				m.StockMock.EXPECT().Reserve(m.Ctx, OrderID, cartItems(), gomock.Any()).Return(errStock)
+			},
+			ExpectedError: errStock,
+		},
+		{
+			Name: "address_required",
+			Order: &order_entity.Order{
+				Contact:  order_entity.Contact{Email: Email},
+				Delivery: order_entity.Delivery{MethodID: "courier"},
+			},
+			SetupMocks: func(m *MockCreate) {
+				expectSession(m.Ctx, m.SessionMock, UserID)
+				m.RegionMock.EXPECT().ResolveRegionID(m.Ctx).Return("region-1", nil)
+				expectTx(m.Ctx, m.UowMock, m.RepoMock)
+				m.CartMock.EXPECT().GetItems(m.Ctx, UserID).Return(cartItems(), nil)
+				m.DeliveryMock.EXPECT().GetQuote(m.Ctx, "courier", "region-1", "", cartItems()).Return(courierQuote(), nil)
+			},
+			ExpectedError: order_constant.ErrAddressRequired,
+		},
+		{
+			Name:  "cart_empty",
+			Order: newOrder(),
+			SetupMocks: func(m *MockCreate) {
+				expectSession(m.Ctx, m.SessionMock, UserID)
+				m.RegionMock.EXPECT().ResolveRegionID(m.Ctx).Return("region-1", nil)
+				expectTx(m.Ctx, m.UowMock, m.RepoMock)
+				m.CartMock.EXPECT().GetItems(m.Ctx, UserID).Return(nil, nil)
+			},
+			ExpectedError: order_constant.ErrCartEmpty,
+		},
+		{
+			Name:  "no_session_user",
+			Order: newOrder(),
+			SetupMocks: func(m *MockCreate) {
+				m.SessionMock.EXPECT().GetSessionInfo(m.Ctx).Return(nil, errors.New("session not found"))
+			},
+			ExpectedError: order_constant.ErrSessionUserNotFound,
+		},
+	}
+}
@@ -0,0 +1,97 @@
+package order_testcases
+
+import (
+	"context"
+
+	order_entity "github.com/Fi44er/sdmed/internal/module/order/entity"
+	order_constant "github.com/Fi44er/sdmed/internal/module/order/pkg"
+	"github.com/Fi44er/sdmed/internal/module/order/usecase/order/mock"
+	uow_mock "github.com/Fi44er/sdmed/pkg/postgres/uow/mock"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+type MockEditItems struct {
+	Ctrl        *gomock.Controller
+	Ctx         context.Context
+	RepoMock    *mock.MockIOrderRepository
+	SessionMock *mock.MockISessionRepository
+	StockMock   *mock.MockIStockUsecaseAdapter
+	PaymentMock *mock.MockIPaymentUsecaseAdapter
+	UowMock     *uow_mock.MockUow
+	T           assert.TestingT
+}
+
+type EditItemsTestCase struct {
+	Name          string
+	Changes       []order_entity.ItemChange
+	SetupMocks    func(m *MockEditItems)
+	ExpectedTotal float64
+	ExpectedError error
+}
+
+// editableOrder - подтвержденный заказ с оплатой картой на 2000: две трости по 1000
+func editableOrder(status order_entity.OrderStatus) *order_entity.Order {
+	result := order(status)
+	result.Items[0].ID = "item-1"
+	result.Delivery = order_entity.Delivery{MethodID: "pickup", MethodName: "Самовывоз"}
+	result.CalculateTotal()
+	return result
+}
+
+func GetEditItemsTestCases() []EditItemsTestCase {
+	return []EditItemsTestCase{
+		{
+			Name:    "quantity_changed_before_payment",
+			Changes: []order_entity.ItemChange{{ItemID: "item-1", Quantity: 1}},
+			SetupMocks: func(m *MockEditItems) {
+				expectSession(m.Ctx, m.SessionMock, ManagerID)
+				expectTx(m.Ctx, m.UowMock, m.RepoMock)
+				gomock.InOrder(
+					m.RepoMock.EXPECT().GetByID(m.Ctx, OrderID).Return(editableOrder(order_entity.OrderStatusConfirmed), nil),
+					m.PaymentMock.EXPECT().HasPaymentInProgress(m.Ctx, OrderID, gomock.Any()).Return(false, nil),
+					m.RepoMock.EXPECT().UpdateItems(m.Ctx, gomock.Any()).Return(nil),
+					m.StockMock.EXPECT().Update(m.Ctx, OrderID, gomock.Any(), gomock.Any()).Return(nil),
+					m.RepoMock.EXPECT().
+						AddHistory(m.Ctx, history(order_entity.OrderStatusConfirmed, order_entity.OrderStatusConfirmed, order_entity.ActorManager, ManagerID, "Изменен состав заказа: Трость: 2 → 1")).
+						Return(nil),
+					m.RepoMock.EXPECT().GetDocument(m.Ctx, OrderID, order_entity.DocumentKindInvoice).Return(nil, nil),
+				)
+			},
+			ExpectedTotal: 1000,
+		},
+		{
+			Name:    "payment_in_progress",
+			Changes: []order_entity.ItemChange{{ItemID: "item-1", Quantity: 1}},
+			SetupMocks: func(m *MockEditItems) {
+				expectSession(m.Ctx, m.SessionMock, ManagerID)
+				expectTx(m.Ctx, m.UowMock, m.RepoMock)
+				gomock.InOrder(
+					m.RepoMock.EXPECT().GetByID(m.Ctx, OrderID).Return(editableOrder(order_entity.OrderStatusConfirmed), nil),
+					m.PaymentMock.EXPECT().HasPaymentInProgress(m.Ctx, OrderID, gomock.Any()).Return(true, nil),
+				)
+			},
+			ExpectedError: order_constant.ErrPaymentInProgress,
+		},
+		{
+			Name:    "surcharge_already_paid",
+			Changes: []order_entity.ItemChange{{ItemID: "item-1", Quantity: 1}},
+			SetupMocks: func(m *MockEditItems) {
+				expectSession(m.Ctx, m.SessionMock, ManagerID)
+				expectTx(m.Ctx, m.UowMock, m.RepoMock)
+				m.RepoMock.EXPECT().GetByID(m.Ctx, OrderID).Return(certificateOrder(order_entity.OrderStatusConfirmed, order_entity.CertificateStatusVerified, true), nil)
+			},
+			ExpectedError: order_constant.ErrSurchargePaid,
+		},
+		{
+			Name:    "paid_order",
+			Changes: []order_entity.ItemChange{{ItemID: "item-1", Quantity: 1}},
+			SetupMocks: func(m *MockEditItems) {
+				expectSession(m.Ctx, m.SessionMock, ManagerID)
+				expectTx(m.Ctx, m.UowMock, m.RepoMock)
+				m.RepoMock.EXPECT().GetByID(m.Ctx, OrderID).Return(editableOrder(order_entity.OrderStatusPaid), nil)
+			},
+			ExpectedError: order_constant.ErrOrderNotEditable,
+		},
+	}
+}
@@ -0,0 +1,53 @@
+package order_testcases
+
+import (
+	"context"
+
+	order_entity "github.com/Fi44er/sdmed/internal/module/order/entity"
+	order_constant "github.com/Fi44er/sdmed/internal/module/order/pkg"
+	"github.com/Fi44er/sdmed/internal/module/order/usecase/order/mock"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+type MockGetOwnByID struct {
+	Ctrl        *gomock.Controller
+	Ctx         context.Context
+	RepoMock    *mock.MockIOrderRepository
+	SessionMock *mock.MockISessionRepository
+	T           assert.TestingT
+}
+
+type GetOwnByIDTestCase struct {
+	Name          string
+	SetupMocks    func(m *MockGetOwnByID)
+	ExpectedError error
+}
+
+func GetGetOwnByIDTestCases() []GetOwnByIDTestCase {
+	return []GetOwnByIDTestCase{
+		{
+			Name: "own_order",
+			SetupMocks: func(m *MockGetOwnByID) {
+				expectSession(m.Ctx, m.SessionMock, UserID)
+				m.RepoMock.EXPECT().GetByID(m.Ctx, OrderID).Return(order(order_entity.OrderStatusNew), nil)
+			},
+		},
+		{
+			Name: "foreign_order",
+			SetupMocks: func(m *MockGetOwnByID) {
+				expectSession(m.Ctx, m.SessionMock, "other")
+				m.RepoMock.EXPECT().GetByID(m.Ctx, OrderID).Return(order(order_entity.OrderStatusNew), nil)
+			},
+			ExpectedError: order_constant.ErrOrderNotFound,
+		},
+		{
+			Name: "order_not_found",
+			SetupMocks: func(m *MockGetOwnByID) {
+				expectSession(m.Ctx, m.SessionMock, UserID)
+				m.RepoMock.EXPECT().GetByID(m.Ctx, OrderID).Return(nil, nil)
+			},
+			ExpectedError: order_constant.ErrOrderNotFound,
+		},
+	}
+}
@@ -0,0 +1,196 @@
+package order_testcases
+
+import (
+	"context"
+	"time"
+
+	order_entity "github.com/Fi44er/sdmed/internal/module/order/entity"
+	order_constant "github.com/Fi44er/sdmed/internal/module/order/pkg"
+	"github.com/Fi44er/sdmed/internal/module/order/usecase/order/mock"
+	uow_mock "github.com/Fi44er/sdmed/pkg/postgres/uow/mock"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+type MockMarkPaid struct {
+	Ctrl      *gomock.Controller
+	Ctx       context.Context
+	RepoMock  *mock.MockIOrderRepository
+	StockMock *mock.MockIStockUsecaseAdapter
+	UowMock   *uow_mock.MockUow
+	T         assert.TestingT
+}
+
+type MarkPaidTestCase struct {
+	Name            string
+	Amount          float64
+	Comment         string
+	SetupMocks      func(m *MockMarkPaid)
+	ExpectedApplied bool
+	ExpectedError   error
+}
+
+// certificateOrder - заказ с оплатой сертификатом: сертификат покрывает 1500 из 2000, доплата 500
+func certificateOrder(status order_entity.OrderStatus, certificateStatus order_entity.CertificateStatus, surchargePaid bool) *order_entity.Order {
+	result := order(status)
+	result.PaymentMethod = order_entity.PaymentMethodCertificate
+	result.Total = 2000
+	result.CertificateAmount = 1500
+	result.Items[0].ID = "item-1"
+	result.Items[0].CertificateAmount = 1500
+	result.Items[0].CertificateStatus = certificateStatus
+	if surchargePaid {
+		paidAt := time.Now()
+		result.SurchargePaid = 500
+		result.SurchargePaidAt = &paidAt
+	}
+	return result
+}
+
+// splitCertificateOrder - заказ с сертификатом на две позиции: item-1 погашена на 1500,
+// item-2 покрывается на 300 в статусе second. Доплата 700 по обеим позициям уже оплачена
+func splitCertificateOrder(status order_entity.OrderStatus, second order_entity.CertificateStatus) *order_entity.Order {
+	result := certificateOrder(status, order_entity.CertificateStatusSettled, true)
+	result.Items = append(result.Items, order_entity.OrderItem{
+		ID:                "item-2",
+		ProductID:         "walker",
+		Name:              "Ходунки",
+		Quantity:          1,
+		Price:             500,
+		CertificateAmount: 300,
+		CertificateStatus: second,
+	})
+	result.Total = 2500
+	result.SurchargePaid = 700
+	result.CalculateCertificateAmount()
+	return result
+}
+
+// expectTransition ожидает смену статуса заказа системой в отдельной транзакции
+func expectTransition(
+	ctx context.Context,
+	uowMock *uow_mock.MockUow,
+	repo *mock.MockIOrderRepository,
+	current *order_entity.Order,
+	to order_entity.OrderStatus,
+	comment string,
+) []*gomock.Call {
+	expectTx(ctx, uowMock, repo)
+	return []*gomock.Call{
+		repo.EXPECT().GetByID(ctx, OrderID).Return(current, nil),
+		repo.EXPECT().UpdateStatus(ctx, OrderID, current.Status, to).Return(true, nil),
+		repo.EXPECT().AddHistory(ctx, history(current.Status, to, order_entity.ActorSystem, "", comment)).Return(nil),
+	}
+}
+
+func GetMarkPaidTestCases() []MarkPaidTestCase {
+	return []MarkPaidTestCase{
+		{
+			Name:    "card_order_paid",
+			Amount:  2000,
+			Comment: "payment 1",
+			SetupMocks: func(m *MockMarkPaid) {
+				expectTx(m.Ctx, m.UowMock, m.RepoMock)
+				calls := []*gomock.Call{m.RepoMock.EXPECT().GetByID(m.Ctx, OrderID).Return(order(order_entity.OrderStatusConfirmed), nil)}
+				calls = append(calls, expectTransition(m.Ctx, m.UowMock, m.RepoMock, order(order_entity.OrderStatusConfirmed), order_entity.OrderStatusPaid, "payment 1")...)
+				calls = append(calls, m.StockMock.EXPECT().Confirm(m.Ctx, OrderID).Return(nil))
+				gomock.InOrder(calls...)
+			},
+			ExpectedApplied: true,
+		},
+		{
+			Name:    "new_order_confirmed_before_payment",
+			Amount:  2000,
+			Comment: "payment 1",
+			SetupMocks: func(m *MockMarkPaid) {
+				expectTx(m.Ctx, m.UowMock, m.RepoMock)
+				calls := []*gomock.Call{m.RepoMock.EXPECT().GetByID(m.Ctx, OrderID).Return(order(order_entity.OrderStatusNew), nil)}
+				calls = append(calls, expectTransition(m.Ctx, m.UowMock, m.RepoMock, order(order_entity.OrderStatusNew), order_entity.OrderStatusConfirmed, "payment 1")...)
+				calls = append(calls, expectTransition(m.Ctx, m.UowMock, m.RepoMock, order(order_entity.OrderStatusConfirmed), order_entity.OrderStatusPaid, "payment 1")...)
+				calls = append(calls, m.StockMock.EXPECT().Confirm(m.Ctx, OrderID).Return(nil))
+				gomock.InOrder(calls...)
+			},
+			ExpectedApplied: true,
+		},
+		{
+			Name:    "surcharge_paid_before_certificate_settlement",
+			Amount:  500,
+			Comment: "payment 1",
+			SetupMocks: func(m *MockMarkPaid) {
+				expectTx(m.Ctx, m.UowMock, m.RepoMock)
+				gomock.InOrder(
+					m.RepoMock.EXPECT().GetByID(m.Ctx, OrderID).Return(certificateOrder(order_entity.OrderStatusConfirmed, order_entity.CertificateStatusVerified, false), nil),
+					m.RepoMock.EXPECT().AddSurchargePaid(m.Ctx, OrderID, 500.0).Return(nil),
+					m.RepoMock.EXPECT().AddHistory(m.Ctx, history(order_entity.OrderStatusConfirmed, order_entity.OrderStatusConfirmed, order_entity.ActorSystem, "", "surcharge paid: payment 1")).Return(nil),
+				)
+			},
+			ExpectedApplied: true,
+		},
+		{
+			Name:    "surcharge_paid_after_certificate_settlement",
+			Amount:  500,
+			Comment: "payment 1",
+			SetupMocks: func(m *MockMarkPaid) {
+				expectTx(m.Ctx, m.UowMock, m.RepoMock)
+				calls := []*gomock.Call{
+					m.RepoMock.EXPECT().GetByID(m.Ctx, OrderID).Return(certificateOrder(order_entity.OrderStatusConfirmed, order_entity.CertificateStatusSettled, false), nil),
+					m.RepoMock.EXPECT().AddSurchargePaid(m.Ctx, OrderID, 500.0).Return(nil),
+					m.RepoMock.EXPECT().AddHistory(m.Ctx, history(order_entity.OrderStatusConfirmed, order_entity.OrderStatusConfirmed, order_entity.ActorSystem, "", "surcharge paid: payment 1")).Return(nil),
+				}
+				calls = append(calls, expectTransition(m.Ctx, m.UowMock, m.RepoMock, certificateOrder(order_entity.OrderStatusConfirmed, order_entity.CertificateStatusSettled, true), order_entity.OrderStatusPaid, "payment 1")...)
+				calls = append(calls, m.StockMock.EXPECT().Confirm(m.Ctx, OrderID).Return(nil))
+				gomock.InOrder(calls...)
+			},
+			ExpectedApplied: true,
+		},
+		{
+			Name:    "partial_surcharge_waits_for_rest",
+			Amount:  200,
+			Comment: "payment 1",
+			SetupMocks: func(m *MockMarkPaid) {
+				expectTx(m.Ctx, m.UowMock, m.RepoMock)
+				gomock.InOrder(
+					m.RepoMock.EXPECT().GetByID(m.Ctx, OrderID).Return(certificateOrder(order_entity.OrderStatusConfirmed, order_entity.CertificateStatusSettled, false), nil),
+					m.RepoMock.EXPECT().AddSurchargePaid(m.Ctx, OrderID, 200.0).Return(nil),
+					m.RepoMock.EXPECT().AddHistory(m.Ctx, history(order_entity.OrderStatusConfirmed, order_entity.OrderStatusConfirmed, order_entity.ActorSystem, "", "surcharge paid: payment 1")).Return(nil),
+				)
+			},
+			ExpectedApplied: true,
+		},
+		{
+			Name:    "rest_of_surcharge_paid_after_rejection",
+			Amount:  300,
+			Comment: "payment 2",
+			SetupMocks: func(m *MockMarkPaid) {
+				rejected := splitCertificateOrder(order_entity.OrderStatusConfirmed, order_entity.CertificateStatusRejected)
+
+				expectTx(m.Ctx, m.UowMock, m.RepoMock)
+				calls := []*gomock.Call{
+					m.RepoMock.EXPECT().GetByID(m.Ctx, OrderID).Return(rejected, nil),
+					m.RepoMock.EXPECT().AddSurchargePaid(m.Ctx, OrderID, 300.0).Return(nil),
+					m.RepoMock.EXPECT().AddHistory(m.Ctx, history(order_entity.OrderStatusConfirmed, order_entity.OrderStatusConfirmed, order_entity.ActorSystem, "", "surcharge paid: payment 2")).Return(nil),
+				}
+				calls = append(calls, expectTransition(m.Ctx, m.UowMock, m.RepoMock, rejected, order_entity.OrderStatusPaid, "payment 2")...)
+				calls = append(calls, m.StockMock.EXPECT().Confirm(m.Ctx, OrderID).Return(nil))
+				gomock.InOrder(calls...)
+			},
+			ExpectedApplied: true,
+		},
+		{
+			Name:    "cancelled_order_not_paid",
+			Comment: "payment 1",
+			SetupMocks: func(m *MockMarkPaid) {
+				expectTx(m.Ctx, m.UowMock, m.RepoMock)
+				m.RepoMock.EXPECT().GetByID(m.Ctx, OrderID).Return(order(order_entity.OrderStatusCancelled), nil)
+			},
+		},
+		{
+			Name: "order_not_found",
+			SetupMocks: func(m *MockMarkPaid) {
+				expectTx(m.Ctx, m.UowMock, m.RepoMock)
+				m.RepoMock.EXPECT().GetByID(m.Ctx, OrderID).Return(nil, nil)
+			},
+			ExpectedError: order_constant.ErrOrderNotFound,
+		},
+	}
+}
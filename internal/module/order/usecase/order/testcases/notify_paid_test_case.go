@@ -0,0 +1,58 @@
+package order_testcases
+
+import (
+	"context"
+
+	order_entity "github.com/Fi44er/sdmed/internal/module/order/entity"
+	order_constant "github.com/Fi44er/sdmed/internal/module/order/pkg"
+	"github.com/Fi44er/sdmed/internal/module/order/usecase/order/mock"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+type MockNotifyPaid struct {
+	Ctrl              *gomock.Controller
+	Ctx               context.Context
+	RepoMock          *mock.MockIOrderRepository
+	NotificationMock  *mock.MockINotificationService
+	EmailRendererMock *mock.MockIEmailRenderer
+	T                 assert.TestingT
+}
+
+type NotifyPaidTestCase struct {
+	Name          string
+	SetupMocks    func(m *MockNotifyPaid)
+	ExpectedError error
+}
+
+func GetNotifyPaidTestCases() []NotifyPaidTestCase {
+	return []NotifyPaidTestCase{
+		{
+			Name: "paid_order_notified",
+			SetupMocks: func(m *MockNotifyPaid) {
+				m.RepoMock.EXPECT().GetByID(m.Ctx, OrderID).Return(order(order_entity.OrderStatusPaid), nil)
+				expectEmail(m.Ctx, m.EmailRendererMock, m.NotificationMock, m.RepoMock, order_entity.NotificationEventPaid, nil)
+			},
+		},
+		{
+			Name: "send_failure_recorded",
+			SetupMocks: func(m *MockNotifyPaid) {
+				m.RepoMock.EXPECT().GetByID(m.Ctx, OrderID).Return(order(order_entity.OrderStatusPaid), nil)
+				expectEmail(m.Ctx, m.EmailRendererMock, m.NotificationMock, m.RepoMock, order_entity.NotificationEventPaid, errSMTP)
+			},
+		},
+		{
+			Name: "surcharge_paid_without_email",
+			SetupMocks: func(m *MockNotifyPaid) {
+				m.RepoMock.EXPECT().GetByID(m.Ctx, OrderID).Return(certificateOrder(order_entity.OrderStatusConfirmed, order_entity.CertificateStatusVerified, true), nil)
+			},
+		},
+		{
+			Name: "order_not_found",
+			SetupMocks: func(m *MockNotifyPaid) {
+				m.RepoMock.EXPECT().GetByID(m.Ctx, OrderID).Return(nil, nil)
+			},
+			ExpectedError: order_constant.ErrOrderNotFound,
+		},
+	}
+}
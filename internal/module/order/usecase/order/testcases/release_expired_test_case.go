@@ -0,0 +1,108 @@
+package order_testcases
+
+import (
+	"context"
+	"errors"
+
+	order_entity "github.com/Fi44er/sdmed/internal/module/order/entity"
+	order_constant "github.com/Fi44er/sdmed/internal/module/order/pkg"
+	"github.com/Fi44er/sdmed/internal/module/order/usecase/order/mock"
+	uow_mock "github.com/Fi44er/sdmed/pkg/postgres/uow/mock"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+const releaseComment = "Заказ не оплачен вовремя, резерв товара снят"
+
+type MockReleaseExpired struct {
+	Ctrl              *gomock.Controller
+	Ctx               context.Context
+	RepoMock          *mock.MockIOrderRepository
+	SessionMock       *mock.MockISessionRepository
+	StockMock         *mock.MockIStockUsecaseAdapter
+	PaymentMock       *mock.MockIPaymentUsecaseAdapter
+	NotificationMock  *mock.MockINotificationService
+	EmailRendererMock *mock.MockIEmailRenderer
+	UowMock           *uow_mock.MockUow
+	T                 assert.TestingT
+}
+
+type ReleaseExpiredTestCase struct {
+	Name string
+	// PaymentDisabled - модуль оплаты не подключен
+	PaymentDisabled  bool
+	SetupMocks       func(m *MockReleaseExpired)
+	ExpectedReleased int
+	ExpectedError    error
+}
+
+// expectRelease ожидает отмену заказа с истекшим резервом от имени системы
+func expectRelease(m *MockReleaseExpired) {
+	m.SessionMock.EXPECT().GetSessionInfo(m.Ctx).Return(nil, errors.New("no session"))
+	expectTx(m.Ctx, m.UowMock, m.RepoMock)
+	gomock.InOrder(
+		m.RepoMock.EXPECT().GetByID(m.Ctx, OrderID).Return(order(order_entity.OrderStatusNew), nil),
+		m.RepoMock.EXPECT().UpdateStatus(m.Ctx, OrderID, order_entity.OrderStatusNew, order_entity.OrderStatusCancelled).Return(true, nil),
+		m.RepoMock.EXPECT().AddHistory(m.Ctx, history(order_entity.OrderStatusNew, order_entity.OrderStatusCancelled, order_entity.ActorSystem, "", releaseComment)).Return(nil),
+		m.StockMock.EXPECT().Release(m.Ctx, OrderID).Return(nil),
+	)
+	expectEmail(m.Ctx, m.EmailRendererMock, m.NotificationMock, m.RepoMock, order_entity.NotificationEventCancelled, nil)
+}
+
+func GetReleaseExpiredTestCases() []ReleaseExpiredTestCase {
+	return []ReleaseExpiredTestCase{
+		{
+			Name: "unpaid_order_cancelled",
+			SetupMocks: func(m *MockReleaseExpired) {
+				m.StockMock.EXPECT().GetExpiredOrderIDs(m.Ctx, order_constant.ReleaseBatchSize).Return([]string{OrderID}, nil)
+				m.PaymentMock.EXPECT().HasPaymentInProgress(m.Ctx, OrderID, gomock.Any()).Return(false, nil)
+				expectRelease(m)
+			},
+			ExpectedReleased: 1,
+		},
+		{
+			Name: "payment_in_progress_extends_reservation",
+			SetupMocks: func(m *MockReleaseExpired) {
+				m.StockMock.EXPECT().GetExpiredOrderIDs(m.Ctx, order_constant.ReleaseBatchSize).Return([]string{"order-paying", OrderID}, nil)
+				m.PaymentMock.EXPECT().HasPaymentInProgress(m.Ctx, "order-paying", gomock.Any()).Return(true, nil)
+				m.StockMock.EXPECT().Extend(m.Ctx, "order-paying", gomock.Any()).Return(nil)
+				m.PaymentMock.EXPECT().HasPaymentInProgress(m.Ctx, OrderID, gomock.Any()).Return(false, nil)
+				expectRelease(m)
+			},
+			ExpectedReleased: 1,
+		},
+		{
+			Name: "payment_check_failure_skips_order",
+			SetupMocks: func(m *MockReleaseExpired) {
+				m.StockMock.EXPECT().GetExpiredOrderIDs(m.Ctx, order_constant.ReleaseBatchSize).Return([]string{"order-unknown"}, nil)
+				m.PaymentMock.EXPECT().HasPaymentInProgress(m.Ctx, "order-unknown", gomock.Any()).Return(false, errors.New("payment repository error"))
+			},
+		},
+		{
+			Name: "order_paid_meanwhile_skipped",
+			SetupMocks: func(m *MockReleaseExpired) {
+				m.StockMock.EXPECT().GetExpiredOrderIDs(m.Ctx, order_constant.ReleaseBatchSize).Return([]string{OrderID}, nil)
+				m.PaymentMock.EXPECT().HasPaymentInProgress(m.Ctx, OrderID, gomock.Any()).Return(false, nil)
+				m.SessionMock.EXPECT().GetSessionInfo(m.Ctx).Return(nil, errors.New("no session"))
+				expectTx(m.Ctx, m.UowMock, m.RepoMock)
+				m.RepoMock.EXPECT().GetByID(m.Ctx, OrderID).Return(order(order_entity.OrderStatusPaid), nil)
+			},
+		},
+		{
+			Name:            "payment_module_not_connected",
+			PaymentDisabled: true,
+			SetupMocks: func(m *MockReleaseExpired) {
+				m.StockMock.EXPECT().GetExpiredOrderIDs(m.Ctx, order_constant.ReleaseBatchSize).Return([]string{OrderID}, nil)
+				expectRelease(m)
+			},
+			ExpectedReleased: 1,
+		},
+		{
+			Name: "expired_reservations_not_loaded",
+			SetupMocks: func(m *MockReleaseExpired) {
+				m.StockMock.EXPECT().GetExpiredOrderIDs(m.Ctx, order_constant.ReleaseBatchSize).Return(nil, errStock)
+			},
+			ExpectedError: errStock,
+		},
+	}
+}
@@ -0,0 +1,119 @@
+package order_testcases
+
+import (
+	"context"
+
+	order_entity "github.com/Fi44er/sdmed/internal/module/order/entity"
+	order_constant "github.com/Fi44er/sdmed/internal/module/order/pkg"
+	"github.com/Fi44er/sdmed/internal/module/order/usecase/order/mock"
+	uow_mock "github.com/Fi44er/sdmed/pkg/postgres/uow/mock"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+type MockRepeat struct {
+	Ctrl        *gomock.Controller
+	Ctx         context.Context
+	RepoMock    *mock.MockIOrderRepository
+	SessionMock *mock.MockISessionRepository
+	CartMock    *mock.MockICartUsecaseAdapter
+	StockMock   *mock.MockIStockUsecaseAdapter
+	UowMock     *uow_mock.MockUow
+	T           assert.TestingT
+}
+
+type RepeatTestCase struct {
+	Name                string
+	SetupMocks          func(m *MockRepeat)
+	ExpectedAdded       []order_entity.RepeatItem
+	ExpectedUnavailable []order_entity.RepeatItem
+	// ExpectedPartial - товары, добавленные в меньшем количестве, чем в заказе
+	ExpectedPartial []string
+	ExpectedError   error
+}
+
+func repeatedOrder() *order_entity.Order {
+	result := order(order_entity.OrderStatusDelivered)
+	result.Items = []order_entity.OrderItem{
+		{ProductID: "cane", Name: "Трость", Quantity: 2, Price: 1000},
+		{ProductID: "walker", Name: "Ходунки", Quantity: 1, Price: 5000},
+		{ProductID: "bandage", Name: "Бинт", Quantity: 3, Price: 100},
+		{ProductID: "gloves", Name: "Перчатки", Quantity: 1, Price: 300},
+	}
+	return result
+}
+
+// expectCartTx ожидает транзакцию, в которой корзина собирается заново
+func expectCartTx(ctx context.Context, uowMock *uow_mock.MockUow) {
+	uowMock.EXPECT().
+		Do(ctx, gomock.Any()).
+		DoAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+			return fn(ctx)
+		})
+}
+
+func GetRepeatTestCases() []RepeatTestCase {
+	return []RepeatTestCase{
+		{
+			Name: "cart_rebuilt_within_free_stock",
+			SetupMocks: func(m *MockRepeat) {
+				expectSession(m.Ctx, m.SessionMock, UserID)
+				m.RepoMock.EXPECT().GetByID(m.Ctx, OrderID).Return(repeatedOrder(), nil)
+				expectCartTx(m.Ctx, m.UowMock)
+				gomock.InOrder(
+					m.CartMock.EXPECT().Clear(m.Ctx, UserID).Return(nil),
+					m.StockMock.EXPECT().GetAvailable(m.Ctx, "cane").Return(5, true, nil),
+					m.CartMock.EXPECT().
+						AddItem(m.Ctx, &order_entity.RepeatItem{ProductID: "cane", Name: "Трость", Quantity: 2, OrderedQuantity: 2, OrderPrice: 1000}).
+						DoAndReturn(func(ctx context.Context, item *order_entity.RepeatItem) error {
+							item.Price = 1100
+							return nil
+						}),
+					m.StockMock.EXPECT().GetAvailable(m.Ctx, "walker").Return(0, true, nil),
+					m.StockMock.EXPECT().GetAvailable(m.Ctx, "bandage").Return(1, true, nil),
+					m.CartMock.EXPECT().
+						AddItem(m.Ctx, &order_entity.RepeatItem{ProductID: "bandage", Name: "Бинт", Quantity: 1, OrderedQuantity: 3, OrderPrice: 100}).
+						DoAndReturn(func(ctx context.Context, item *order_entity.RepeatItem) error {
+							item.Price = 100
+							return nil
+						}),
+					m.StockMock.EXPECT().GetAvailable(m.Ctx, "gloves").Return(0, false, nil),
+					m.CartMock.EXPECT().
+						AddItem(m.Ctx, &order_entity.RepeatItem{ProductID: "gloves", Name: "Перчатки", Quantity: 1, OrderedQuantity: 1, OrderPrice: 300}).
+						DoAndReturn(func(ctx context.Context, item *order_entity.RepeatItem) error {
+							item.Reason = order_entity.RepeatReasonUnavailable
+							return nil
+						}),
+				)
+			},
+			ExpectedAdded: []order_entity.RepeatItem{
+				{ProductID: "cane", Name: "Трость", Quantity: 2, OrderedQuantity: 2, OrderPrice: 1000, Price: 1100},
+				{ProductID: "bandage", Name: "Бинт", Quantity: 1, OrderedQuantity: 3, OrderPrice: 100, Price: 100},
+			},
+			ExpectedUnavailable: []order_entity.RepeatItem{
+				{ProductID: "walker", Name: "Ходунки", Quantity: 1, OrderedQuantity: 1, OrderPrice: 5000, Reason: order_entity.RepeatReasonOutOfStock},
+				{ProductID: "gloves", Name: "Перчатки", Quantity: 1, OrderedQuantity: 1, OrderPrice: 300, Reason: order_entity.RepeatReasonUnavailable},
+			},
+			ExpectedPartial: []string{"bandage"},
+		},
+		{
+			Name: "stock_error",
+			SetupMocks: func(m *MockRepeat) {
+				expectSession(m.Ctx, m.SessionMock, UserID)
+				m.RepoMock.EXPECT().GetByID(m.Ctx, OrderID).Return(repeatedOrder(), nil)
+				expectCartTx(m.Ctx, m.UowMock)
+				m.CartMock.EXPECT().Clear(m.Ctx, UserID).Return(nil)
+				m.StockMock.EXPECT().GetAvailable(m.Ctx, "cane").Return(0, false, errStock)
+			},
+			ExpectedError: errStock,
+		},
+		{
+			Name: "foreign_order",
+			SetupMocks: func(m *MockRepeat) {
+				expectSession(m.Ctx, m.SessionMock, "other")
+				m.RepoMock.EXPECT().GetByID(m.Ctx, OrderID).Return(repeatedOrder(), nil)
+			},
+			ExpectedError: order_constant.ErrOrderNotFound,
+		},
+	}
+}
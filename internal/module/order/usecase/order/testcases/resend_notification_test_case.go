@@ -0,0 +1,94 @@
+package order_testcases
+
+import (
+	"context"
+
+	"github.com/Fi44er/sdmed/internal/module/notification/service"
+	order_entity "github.com/Fi44er/sdmed/internal/module/order/entity"
+	order_constant "github.com/Fi44er/sdmed/internal/module/order/pkg"
+	"github.com/Fi44er/sdmed/internal/module/order/usecase/order/mock"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+const NotificationID = "notification-1"
+
+type MockResendNotification struct {
+	Ctrl              *gomock.Controller
+	Ctx               context.Context
+	RepoMock          *mock.MockIOrderRepository
+	NotificationMock  *mock.MockINotificationService
+	EmailRendererMock *mock.MockIEmailRenderer
+	T                 assert.TestingT
+}
+
+type ResendNotificationTestCase struct {
+	Name           string
+	SetupMocks     func(m *MockResendNotification)
+	ExpectedStatus order_entity.NotificationStatus
+	ExpectedError  error
+}
+
+func original(orderID string) *order_entity.Notification {
+	return &order_entity.Notification{
+		ID:              NotificationID,
+		OrderID:         orderID,
+		Event:           order_entity.NotificationEventShipped,
+		TemplateVersion: "v0",
+		Recipient:       Email,
+		Status:          order_entity.NotificationStatusFailed,
+	}
+}
+
+func GetResendNotificationTestCases() []ResendNotificationTestCase {
+	email := &order_entity.Email{Subject: "Заказ отправлен", Body: "shipped"}
+
+	return []ResendNotificationTestCase{
+		{
+			Name: "resent_with_original_template",
+			SetupMocks: func(m *MockResendNotification) {
+				m.RepoMock.EXPECT().GetNotification(m.Ctx, NotificationID).Return(original(OrderID), nil)
+				m.RepoMock.EXPECT().GetByID(m.Ctx, OrderID).Return(order(order_entity.OrderStatusShipped), nil)
+				m.EmailRendererMock.EXPECT().Render("v0", gomock.Any()).Return(email, nil)
+				m.NotificationMock.EXPECT().
+					SendSync(&service.Message{Recipient: Email, Subject: email.Subject, Content: email.Body}, "smtp").
+					Return(nil)
+				m.RepoMock.EXPECT().AddNotification(m.Ctx, &order_entity.Notification{
+					OrderID:         OrderID,
+					Event:           order_entity.NotificationEventShipped,
+					TemplateVersion: "v0",
+					Recipient:       Email,
+					Subject:         email.Subject,
+					Status:          order_entity.NotificationStatusSent,
+					ResendOfID:      NotificationID,
+				}).Return(nil)
+			},
+			ExpectedStatus: order_entity.NotificationStatusSent,
+		},
+		{
+			Name: "template_render_failure_recorded",
+			SetupMocks: func(m *MockResendNotification) {
+				m.RepoMock.EXPECT().GetNotification(m.Ctx, NotificationID).Return(original(OrderID), nil)
+				m.RepoMock.EXPECT().GetByID(m.Ctx, OrderID).Return(order(order_entity.OrderStatusShipped), nil)
+				m.EmailRendererMock.EXPECT().Render("v0", gomock.Any()).Return(nil, errTemplate)
+				m.RepoMock.EXPECT().AddNotification(m.Ctx, &order_entity.Notification{
+					OrderID:         OrderID,
+					Event:           order_entity.NotificationEventShipped,
+					TemplateVersion: "v0",
+					Recipient:       Email,
+					Status:          order_entity.NotificationStatusFailed,
+					Error:           errTemplate.Error(),
+					ResendOfID:      NotificationID,
+				}).Return(nil)
+			},
+			ExpectedStatus: order_entity.NotificationStatusFailed,
+		},
+		{
+			Name: "notification_of_another_order",
+			SetupMocks: func(m *MockResendNotification) {
+				m.RepoMock.EXPECT().GetNotification(m.Ctx, NotificationID).Return(original("other-order"), nil)
+			},
+			ExpectedError: order_constant.ErrNotificationNotFound,
+		},
+	}
+}
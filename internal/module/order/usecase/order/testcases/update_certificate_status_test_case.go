@@ -0,0 +1,135 @@
+package order_testcases
+
+import (
+	"context"
+
+	order_entity "github.com/Fi44er/sdmed/internal/module/order/entity"
+	order_constant "github.com/Fi44er/sdmed/internal/module/order/pkg"
+	"github.com/Fi44er/sdmed/internal/module/order/usecase/order/mock"
+	uow_mock "github.com/Fi44er/sdmed/pkg/postgres/uow/mock"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+type MockUpdateCertificateStatus struct {
+	Ctrl              *gomock.Controller
+	Ctx               context.Context
+	RepoMock          *mock.MockIOrderRepository
+	StockMock         *mock.MockIStockUsecaseAdapter
+	NotificationMock  *mock.MockINotificationService
+	EmailRendererMock *mock.MockIEmailRenderer
+	UowMock           *uow_mock.MockUow
+	T                 assert.TestingT
+}
+
+type UpdateCertificateStatusTestCase struct {
+	Name           string
+	ItemIDs        []string
+	To             order_entity.CertificateStatus
+	SetupMocks     func(m *MockUpdateCertificateStatus)
+	ExpectedStatus order_entity.OrderStatus
+	ExpectedError  error
+}
+
+func GetUpdateCertificateStatusTestCases() []UpdateCertificateStatusTestCase {
+	return []UpdateCertificateStatusTestCase{
+		{
+			Name: "settlement_pays_order",
+			To:   order_entity.CertificateStatusSettled,
+			SetupMocks: func(m *MockUpdateCertificateStatus) {
+				paid := certificateOrder(order_entity.OrderStatusPaid, order_entity.CertificateStatusSettled, true)
+
+				expectTx(m.Ctx, m.UowMock, m.RepoMock)
+				calls := []*gomock.Call{
+					m.RepoMock.EXPECT().GetByID(m.Ctx, OrderID).Return(certificateOrder(order_entity.OrderStatusConfirmed, order_entity.CertificateStatusVerified, true), nil),
+					m.RepoMock.EXPECT().UpdateItemCertificateStatus(m.Ctx, "item-1", order_entity.CertificateStatusSettled).Return(nil),
+					m.RepoMock.EXPECT().UpdateCertificateAmount(m.Ctx, OrderID, 1500.0).Return(nil),
+				}
+				calls = append(calls, expectTransition(m.Ctx, m.UowMock, m.RepoMock, certificateOrder(order_entity.OrderStatusConfirmed, order_entity.CertificateStatusSettled, true), order_entity.OrderStatusPaid, "certificate settled")...)
+				calls = append(calls, m.StockMock.EXPECT().Confirm(m.Ctx, OrderID).Return(nil))
+				calls = append(calls, m.RepoMock.EXPECT().GetByID(m.Ctx, OrderID).Return(paid, nil))
+				gomock.InOrder(calls...)
+				expectEmail(m.Ctx, m.EmailRendererMock, m.NotificationMock, m.RepoMock, order_entity.NotificationEventPaid, nil)
+			},
+			ExpectedStatus: order_entity.OrderStatusPaid,
+		},
+		{
+			Name:    "verified_waits_for_settlement",
+			ItemIDs: []string{"item-1"},
+			To:      order_entity.CertificateStatusVerified,
+			SetupMocks: func(m *MockUpdateCertificateStatus) {
+				expectTx(m.Ctx, m.UowMock, m.RepoMock)
+				gomock.InOrder(
+					m.RepoMock.EXPECT().GetByID(m.Ctx, OrderID).Return(certificateOrder(order_entity.OrderStatusConfirmed, order_entity.CertificateStatusPending, true), nil),
+					m.RepoMock.EXPECT().UpdateItemCertificateStatus(m.Ctx, "item-1", order_entity.CertificateStatusVerified).Return(nil),
+					m.RepoMock.EXPECT().UpdateCertificateAmount(m.Ctx, OrderID, 1500.0).Return(nil),
+					m.RepoMock.EXPECT().GetByID(m.Ctx, OrderID).Return(certificateOrder(order_entity.OrderStatusConfirmed, order_entity.CertificateStatusVerified, true), nil),
+				)
+			},
+			ExpectedStatus: order_entity.OrderStatusConfirmed,
+		},
+		{
+			Name: "settled_without_surcharge_payment",
+			To:   order_entity.CertificateStatusSettled,
+			SetupMocks: func(m *MockUpdateCertificateStatus) {
+				expectTx(m.Ctx, m.UowMock, m.RepoMock)
+				gomock.InOrder(
+					m.RepoMock.EXPECT().GetByID(m.Ctx, OrderID).Return(certificateOrder(order_entity.OrderStatusConfirmed, order_entity.CertificateStatusVerified, false), nil),
+					m.RepoMock.EXPECT().UpdateItemCertificateStatus(m.Ctx, "item-1", order_entity.CertificateStatusSettled).Return(nil),
+					m.RepoMock.EXPECT().UpdateCertificateAmount(m.Ctx, OrderID, 1500.0).Return(nil),
+					m.RepoMock.EXPECT().GetByID(m.Ctx, OrderID).Return(certificateOrder(order_entity.OrderStatusConfirmed, order_entity.CertificateStatusSettled, false), nil),
+				)
+			},
+			ExpectedStatus: order_entity.OrderStatusConfirmed,
+		},
+		{
+			Name:    "rejection_after_surcharge_paid_reopens_surcharge",
+			ItemIDs: []string{"item-2"},
+			To:      order_entity.CertificateStatusRejected,
+			SetupMocks: func(m *MockUpdateCertificateStatus) {
+				expectTx(m.Ctx, m.UowMock, m.RepoMock)
+				gomock.InOrder(
+					m.RepoMock.EXPECT().GetByID(m.Ctx, OrderID).Return(splitCertificateOrder(order_entity.OrderStatusConfirmed, order_entity.CertificateStatusVerified), nil),
+					m.RepoMock.EXPECT().UpdateItemCertificateStatus(m.Ctx, "item-2", order_entity.CertificateStatusRejected).Return(nil),
+					m.RepoMock.EXPECT().UpdateCertificateAmount(m.Ctx, OrderID, 1500.0).Return(nil),
+					m.RepoMock.EXPECT().GetByID(m.Ctx, OrderID).Return(splitCertificateOrder(order_entity.OrderStatusConfirmed, order_entity.CertificateStatusRejected), nil),
+				)
+			},
+			ExpectedStatus: order_entity.OrderStatusConfirmed,
+		},
+		{
+			Name: "certificate_transition_not_allowed",
+			To:   order_entity.CertificateStatusSettled,
+			SetupMocks: func(m *MockUpdateCertificateStatus) {
+				expectTx(m.Ctx, m.UowMock, m.RepoMock)
+				m.RepoMock.EXPECT().GetByID(m.Ctx, OrderID).Return(certificateOrder(order_entity.OrderStatusConfirmed, order_entity.CertificateStatusPending, true), nil)
+			},
+			ExpectedError: order_constant.ErrInvalidCertificateTransition,
+		},
+		{
+			Name:    "item_not_covered",
+			ItemIDs: []string{"item-2"},
+			To:      order_entity.CertificateStatusVerified,
+			SetupMocks: func(m *MockUpdateCertificateStatus) {
+				expectTx(m.Ctx, m.UowMock, m.RepoMock)
+				m.RepoMock.EXPECT().GetByID(m.Ctx, OrderID).Return(certificateOrder(order_entity.OrderStatusConfirmed, order_entity.CertificateStatusPending, true), nil)
+			},
+			ExpectedError: order_constant.ErrOrderItemNotFound,
+		},
+		{
+			Name: "card_order",
+			To:   order_entity.CertificateStatusVerified,
+			SetupMocks: func(m *MockUpdateCertificateStatus) {
+				expectTx(m.Ctx, m.UowMock, m.RepoMock)
+				m.RepoMock.EXPECT().GetByID(m.Ctx, OrderID).Return(order(order_entity.OrderStatusConfirmed), nil)
+			},
+			ExpectedError: order_constant.ErrNotCertificateOrder,
+		},
+		{
+			Name:          "invalid_status",
+			To:            order_entity.CertificateStatus("unknown"),
+			SetupMocks:    func(m *MockUpdateCertificateStatus) {},
+			ExpectedError: order_constant.ErrInvalidCertificateStatus,
+		},
+	}
+}
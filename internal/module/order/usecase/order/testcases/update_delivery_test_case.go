@@ -0,0 +1,97 @@
+package order_testcases
+
+import (
+	"context"
+
+	order_entity "github.com/Fi44er/sdmed/internal/module/order/entity"
+	order_constant "github.com/Fi44er/sdmed/internal/module/order/pkg"
+	"github.com/Fi44er/sdmed/internal/module/order/usecase/order/mock"
+	uow_mock "github.com/Fi44er/sdmed/pkg/postgres/uow/mock"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+type MockUpdateDelivery struct {
+	Ctrl         *gomock.Controller
+	Ctx          context.Context
+	RepoMock     *mock.MockIOrderRepository
+	SessionMock  *mock.MockISessionRepository
+	DeliveryMock *mock.MockIDeliveryUsecaseAdapter
+	PaymentMock  *mock.MockIPaymentUsecaseAdapter
+	UowMock      *uow_mock.MockUow
+	T            assert.TestingT
+}
+
+type UpdateDeliveryTestCase struct {
+	Name          string
+	Delivery      *order_entity.Delivery
+	SetupMocks    func(m *MockUpdateDelivery)
+	ExpectedTotal float64
+	ExpectedError error
+}
+
+const deliveryAddress = "Москва, ул. Ленина, 1"
+
+func GetUpdateDeliveryTestCases() []UpdateDeliveryTestCase {
+	return []UpdateDeliveryTestCase{
+		{
+			Name:     "method_changed_before_payment",
+			Delivery: &order_entity.Delivery{MethodID: "courier", Address: deliveryAddress},
+			SetupMocks: func(m *MockUpdateDelivery) {
+				expectSession(m.Ctx, m.SessionMock, ManagerID)
+				expectTx(m.Ctx, m.UowMock, m.RepoMock)
+				gomock.InOrder(
+					m.RepoMock.EXPECT().GetByID(m.Ctx, OrderID).Return(editableOrder(order_entity.OrderStatusConfirmed), nil),
+					m.PaymentMock.EXPECT().HasPaymentInProgress(m.Ctx, OrderID, gomock.Any()).Return(false, nil),
+					m.DeliveryMock.EXPECT().GetQuote(m.Ctx, "courier", "", "", gomock.Any()).Return(courierQuote(), nil),
+					m.RepoMock.EXPECT().UpdateDelivery(m.Ctx, gomock.Any()).Return(nil),
+					m.RepoMock.EXPECT().
+						AddHistory(m.Ctx, history(order_entity.OrderStatusConfirmed, order_entity.OrderStatusConfirmed, order_entity.ActorManager, ManagerID, "Изменены данные доставки: Курьер, "+deliveryAddress)).
+						Return(nil),
+					m.RepoMock.EXPECT().GetDocument(m.Ctx, OrderID, order_entity.DocumentKindInvoice).Return(nil, nil),
+				)
+			},
+			ExpectedTotal: 2300,
+		},
+		{
+			Name:     "payment_in_progress",
+			Delivery: &order_entity.Delivery{MethodID: "courier", Address: deliveryAddress},
+			SetupMocks: func(m *MockUpdateDelivery) {
+				expectSession(m.Ctx, m.SessionMock, ManagerID)
+				expectTx(m.Ctx, m.UowMock, m.RepoMock)
+				gomock.InOrder(
+					m.RepoMock.EXPECT().GetByID(m.Ctx, OrderID).Return(editableOrder(order_entity.OrderStatusConfirmed), nil),
+					m.PaymentMock.EXPECT().HasPaymentInProgress(m.Ctx, OrderID, gomock.Any()).Return(true, nil),
+				)
+			},
+			ExpectedError: order_constant.ErrPaymentInProgress,
+		},
+		{
+			Name:     "surcharge_already_paid",
+			Delivery: &order_entity.Delivery{MethodID: "courier", Address: deliveryAddress},
+			SetupMocks: func(m *MockUpdateDelivery) {
+				expectSession(m.Ctx, m.SessionMock, ManagerID)
+				expectTx(m.Ctx, m.UowMock, m.RepoMock)
+				m.RepoMock.EXPECT().GetByID(m.Ctx, OrderID).Return(certificateOrder(order_entity.OrderStatusConfirmed, order_entity.CertificateStatusVerified, true), nil)
+			},
+			ExpectedError: order_constant.ErrSurchargePaid,
+		},
+		{
+			Name:     "address_changed_after_payment",
+			Delivery: &order_entity.Delivery{Address: deliveryAddress},
+			SetupMocks: func(m *MockUpdateDelivery) {
+				expectSession(m.Ctx, m.SessionMock, ManagerID)
+				expectTx(m.Ctx, m.UowMock, m.RepoMock)
+				gomock.InOrder(
+					m.RepoMock.EXPECT().GetByID(m.Ctx, OrderID).Return(editableOrder(order_entity.OrderStatusPaid), nil),
+					m.RepoMock.EXPECT().UpdateDelivery(m.Ctx, gomock.Any()).Return(nil),
+					m.RepoMock.EXPECT().
+						AddHistory(m.Ctx, history(order_entity.OrderStatusPaid, order_entity.OrderStatusPaid, order_entity.ActorManager, ManagerID, "Изменены данные доставки: Самовывоз, "+deliveryAddress)).
+						Return(nil),
+					m.RepoMock.EXPECT().GetDocument(m.Ctx, OrderID, order_entity.DocumentKindInvoice).Return(nil, nil),
+				)
+			},
+			ExpectedTotal: 2000,
+		},
+	}
+}
@@ -0,0 +1,491 @@
+package order_usecase
+
+import (
+	"context"
+	"time"
+
+	order_entity "github.com/Fi44er/sdmed/internal/module/order/entity"
+	order_constant "github.com/Fi44er/sdmed/internal/module/order/pkg"
+	order_usecase_contracts "github.com/Fi44er/sdmed/internal/module/order/usecase/order/contracts"
+	"github.com/Fi44er/sdmed/pkg/logger"
+	"github.com/Fi44er/sdmed/pkg/postgres/uow"
+	"github.com/Fi44er/sdmed/pkg/utils"
+)
+
+type IOrderUsecase interface {
+	Create(ctx context.Context, order *order_entity.Order) (*order_entity.Order, error)
+	GetOwn(ctx context.Context, filter *order_entity.OrderFilter, page, pageSize int) ([]order_entity.Order, int64, error)
+	GetOwnByID(ctx context.Context, id string) (*order_entity.Order, error)
+	Cancel(ctx context.Context, id, comment string) (*order_entity.Order, error)
+	GetDeliveryQuotes(ctx context.Context, postalCode string) ([]order_entity.DeliveryQuote, error)
+	Repeat(ctx context.Context, id string) (*order_entity.RepeatResult, error)
+
+	GetByID(ctx context.Context, id string) (*order_entity.Order, error)
+	GetAll(ctx context.Context, filter *order_entity.OrderFilter, page, pageSize int) ([]order_entity.Order, int64, error)
+	ChangeStatus(ctx context.Context, id string, to order_entity.OrderStatus, actor order_entity.Actor, comment string) (*order_entity.Order, error)
+	MarkPaid(ctx context.Context, id string, amount float64, comment string) (bool, error)
+	NotifyPaid(ctx context.Context, id string) error
+	AddHistoryNote(ctx context.Context, id string, actor order_entity.Actor, comment string) error
+	UpdateCertificateStatus(ctx context.Context, id string, itemIDs []string, to order_entity.CertificateStatus) (*order_entity.Order, error)
+
+	GetOwnDocument(ctx context.Context, id string, kind order_entity.DocumentKind) (*order_entity.Document, error)
+	GetDocument(ctx context.Context, id string, kind order_entity.DocumentKind) (*order_entity.Document, error)
+	RegenerateDocument(ctx context.Context, id string, kind order_entity.DocumentKind) (*order_entity.Document, error)
+
+	Ship(ctx context.Context, id, trackingNumber, comment string) (*order_entity.Order, error)
+	NotifyPaymentFailed(ctx context.Context, id string) error
+	GetNotifications(ctx context.Context, id string) ([]order_entity.Notification, error)
+	ResendNotification(ctx context.Context, id, notificationID string) (*order_entity.Notification, error)
+	ReleaseExpired(ctx context.Context) (int, error)
+
+	EditItems(ctx context.Context, id string, changes []order_entity.ItemChange, comment string) (*order_entity.Order, error)
+	UpdateDelivery(ctx context.Context, id string, delivery *order_entity.Delivery, comment string) (*order_entity.Order, error)
+	AssignManager(ctx context.Context, id, managerID string) (*order_entity.Order, error)
+	AddComment(ctx context.Context, id, text string) (*order_entity.InternalComment, error)
+	GetComments(ctx context.Context, id string) ([]order_entity.InternalComment, error)
+	BulkChangeStatus(ctx context.Context, ids []string, to order_entity.OrderStatus, comment string) []order_entity.StatusChangeResult
+}
+
+type OrderUsecase struct {
+	repository        order_usecase_contracts.IOrderRepository
+	sessionRepository order_usecase_contracts.ISessionRepository
+	cartUsecase       order_usecase_contracts.ICartUsecaseAdapter
+	deliveryUsecase   order_usecase_contracts.IDeliveryUsecaseAdapter
+	addressUsecase    order_usecase_contracts.IAddressUsecaseAdapter
+	discountUsecase   order_usecase_contracts.IDiscountUsecaseAdapter
+	regionUsecase     order_usecase_contracts.IRegionUsecaseAdapter
+	truUsecase        order_usecase_contracts.ITRUUsecaseAdapter
+	fileUsecase       order_usecase_contracts.IFileUsecaseAdapter
+	userUsecase       order_usecase_contracts.IUserUsecaseAdapter
+	stockUsecase      order_usecase_contracts.IStockUsecaseAdapter
+	paymentUsecase    order_usecase_contracts.IPaymentUsecaseAdapter
+	renderer          order_usecase_contracts.IDocumentRenderer
+	seller            order_entity.Seller
+
+	notificationService order_usecase_contracts.INotificationService
+	emailRenderer       order_usecase_contracts.IEmailRenderer
+	// templateVersion - версия шаблонов для новых писем, повторная отправка берет версию исходного письма
+	templateVersion string
+	// reservationTTL - сколько держится резерв товара неоплаченного заказа
+	reservationTTL time.Duration
+
+	uow    uow.Uow
+	logger *logger.Logger
+}
+
+func NewOrderUsecase(
+	repository order_usecase_contracts.IOrderRepository,
+	sessionRepository order_usecase_contracts.ISessionRepository,
+	cartUsecase order_usecase_contracts.ICartUsecaseAdapter,
+	deliveryUsecase order_usecase_contracts.IDeliveryUsecaseAdapter,
+	addressUsecase order_usecase_contracts.IAddressUsecaseAdapter,
+	discountUsecase order_usecase_contracts.IDiscountUsecaseAdapter,
+	regionUsecase order_usecase_contracts.IRegionUsecaseAdapter,
+	truUsecase order_usecase_contracts.ITRUUsecaseAdapter,
+	fileUsecase order_usecase_contracts.IFileUsecaseAdapter,
+	userUsecase order_usecase_contracts.IUserUsecaseAdapter,
+	stockUsecase order_usecase_contracts.IStockUsecaseAdapter,
+	renderer order_usecase_contracts.IDocumentRenderer,
+	seller order_entity.Seller,
+	notificationService order_usecase_contracts.INotificationService,
+	emailRenderer order_usecase_contracts.IEmailRenderer,
+	templateVersion string,
+	reservationTTL time.Duration,
+	uow uow.Uow,
+	logger *logger.Logger,
+) *OrderUsecase {
+	return &OrderUsecase{
+		repository:          repository,
+		sessionRepository:   sessionRepository,
+		cartUsecase:         cartUsecase,
+		deliveryUsecase:     deliveryUsecase,
+		addressUsecase:      addressUsecase,
+		discountUsecase:     discountUsecase,
+		regionUsecase:       regionUsecase,
+		truUsecase:          truUsecase,
+		fileUsecase:         fileUsecase,
+		userUsecase:         userUsecase,
+		stockUsecase:        stockUsecase,
+		renderer:            renderer,
+		seller:              seller,
+		notificationService: notificationService,
+		emailRenderer:       emailRenderer,
+		templateVersion:     templateVersion,
+		reservationTTL:      reservationTTL,
+		uow:                 uow,
+		logger:              logger,
+	}
+}
+
+// SetPaymentUsecase подключает проверку оплат при снятии просроченных резервов.
+// Модуль оплаты создается после модуля заказов
+func (u *OrderUsecase) SetPaymentUsecase(paymentUsecase order_usecase_contracts.IPaymentUsecaseAdapter) {
+	u.paymentUsecase = paymentUsecase
+}
+
+// Create оформляет заказ из корзины текущей сессии. Цены позиций берутся из корзины,
+// стоимость доставки выбранным способом рассчитывается заново и входит в сумму заказа,
+// адрес берется из адресной книги или сохраняется в нее, товар резервируется
+// на складе в той же транзакции, после оформления корзина очищается. При оплате
+// сертификатом сумма делится на покрытую сертификатом часть и доплату картой.
+// Скидки и промокод действуют только на доплату
+func (u *OrderUsecase) Create(ctx context.Context, order *order_entity.Order) (*order_entity.Order, error) {
+	userID, err := u.getSessionUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	u.logger.Infof("Creating order from cart of user %s", userID)
+
+	if order.PaymentMethod == "" {
+		order.PaymentMethod = order_entity.PaymentMethodCard
+	}
+	if order.PaymentMethod == order_entity.PaymentMethodCertificate {
+		if err := u.prepareCertificate(ctx, order); err != nil {
+			return nil, err
+		}
+	}
+	if order.RegionID == "" {
+		order.RegionID = u.resolveDeliveryRegion(ctx)
+	}
+
+	err = u.uow.Do(ctx, func(ctx context.Context) error {
+		repo, err := u.getRepository(ctx)
+		if err != nil {
+			return err
+		}
+
+		items, err := u.cartUsecase.GetItems(ctx, userID)
+		if err != nil {
+			return err
+		}
+		if len(items) == 0 {
+			return order_constant.ErrCartEmpty
+		}
+
+		order.UserID = userID
+		order.Status = order_entity.OrderStatusNew
+		order.Items = items
+		regionID, err := u.applyAddress(ctx, userID, order)
+		if err != nil {
+			return err
+		}
+		if err := u.applyDelivery(ctx, order, regionID); err != nil {
+			return err
+		}
+		order.CalculateTotal()
+		if order.PaymentMethod == order_entity.PaymentMethodCertificate {
+			if err := u.splitByCertificate(ctx, order); err != nil {
+				return err
+			}
+		}
+		if err := u.applyDiscount(ctx, userID, order); err != nil {
+			return err
+		}
+		if err := repo.Create(ctx, order); err != nil {
+			return err
+		}
+		if err := u.stockUsecase.Reserve(ctx, order.ID, order.Items, order.ReservationExpiresAt(time.Now(), u.reservationTTL)); err != nil {
+			return err
+		}
+		if order.PromoCodeID != "" {
+			if err := u.discountUsecase.Redeem(ctx, order.PromoCodeID, userID, order.ID); err != nil {
+				return err
+			}
+		}
+
+		change := &order_entity.StatusChange{
+			OrderID:  order.ID,
+			ToStatus: order_entity.OrderStatusNew,
+			Actor:    order_entity.ActorCustomer,
+			ActorID:  userID,
+		}
+		if err := repo.AddHistory(ctx, change); err != nil {
+			return err
+		}
+		order.History = []order_entity.StatusChange{*change}
+
+		return u.cartUsecase.Clear(ctx, userID)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	u.notify(ctx, order, order_entity.NotificationEventCreated, u.templateVersion, "")
+	return order, nil
+}
+
+// GetOwn возвращает заказы пользователя сессии, новые первыми
+func (u *OrderUsecase) GetOwn(ctx context.Context, filter *order_entity.OrderFilter, page, pageSize int) ([]order_entity.Order, int64, error) {
+	userID, err := u.getSessionUserID(ctx)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	filter.UserID = userID
+	return u.GetAll(ctx, filter, page, pageSize)
+}
+
+// GetOwnByID возвращает заказ, только если он принадлежит пользователю сессии
+func (u *OrderUsecase) GetOwnByID(ctx context.Context, id string) (*order_entity.Order, error) {
+	userID, err := u.getSessionUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	order, err := u.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if order.UserID != userID {
+		return nil, order_constant.ErrOrderNotFound
+	}
+
+	return order, nil
+}
+
+// Cancel - отмена заказа покупателем, доступна до оплаты
+func (u *OrderUsecase) Cancel(ctx context.Context, id, comment string) (*order_entity.Order, error) {
+	userID, err := u.getSessionUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	u.logger.Infof("Cancelling order %s by user %s", id, userID)
+
+	order, err := u.transition(ctx, id, order_entity.OrderStatusCancelled, order_entity.ActorCustomer, userID, comment, func(order *order_entity.Order) error {
+		if order.UserID != userID {
+			return order_constant.ErrOrderNotFound
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	u.notifyStatus(ctx, order)
+	return order, nil
+}
+
+func (u *OrderUsecase) GetByID(ctx context.Context, id string) (*order_entity.Order, error) {
+	order, err := u.repository.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if order == nil {
+		return nil, order_constant.ErrOrderNotFound
+	}
+
+	return order, nil
+}
+
+func (u *OrderUsecase) GetAll(ctx context.Context, filter *order_entity.OrderFilter, page, pageSize int) ([]order_entity.Order, int64, error) {
+	u.logger.Debugf("Getting orders (page: %d, pageSize: %d)", page, pageSize)
+
+	if filter.Status != "" && !filter.Status.IsValid() {
+		return nil, 0, order_constant.ErrInvalidStatus
+	}
+
+	filter.Offset, filter.Limit = utils.SafeCalculateForPostgres(page, pageSize)
+	orders, err := u.repository.GetAll(ctx, filter)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	count, err := u.repository.Count(ctx, filter)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return orders, count, nil
+}
+
+// ChangeStatus переводит заказ в статус to от имени actor. Пользователь сессии,
+// если он есть, записывается в историю как автор изменения
+func (u *OrderUsecase) ChangeStatus(ctx context.Context, id string, to order_entity.OrderStatus, actor order_entity.Actor, comment string) (*order_entity.Order, error) {
+	if !to.IsValid() {
+		return nil, order_constant.ErrInvalidStatus
+	}
+
+	actorID := u.getActorID(ctx)
+	u.logger.Infof("Changing order %s status to %s by %s %s", id, to, actor, actorID)
+
+	order, err := u.transition(ctx, id, to, actor, actorID, comment, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	u.notifyStatus(ctx, order)
+	return order, nil
+}
+
+// AddHistoryNote записывает в историю заказа событие без смены статуса, например шаг возврата.
+// Запись сохраняется в транзакции вызывающего, если она есть
+func (u *OrderUsecase) AddHistoryNote(ctx context.Context, id string, actor order_entity.Actor, comment string) error {
+	actorID := u.getActorID(ctx)
+
+	return u.uow.Do(ctx, func(ctx context.Context) error {
+		repo, err := u.getRepository(ctx)
+		if err != nil {
+			return err
+		}
+
+		order, err := repo.GetByID(ctx, id)
+		if err != nil {
+			return err
+		}
+		if order == nil {
+			return order_constant.ErrOrderNotFound
+		}
+
+		return repo.AddHistory(ctx, &order_entity.StatusChange{
+			OrderID:    id,
+			FromStatus: order.Status,
+			ToStatus:   order.Status,
+			Actor:      actor,
+			ActorID:    actorID,
+			Comment:    comment,
+		})
+	})
+}
+
+// Ship переводит заказ в статус shipped менеджером и сохраняет трек-номер отправления,
+// который попадает в письмо покупателю
+func (u *OrderUsecase) Ship(ctx context.Context, id, trackingNumber, comment string) (*order_entity.Order, error) {
+	actorID := u.getActorID(ctx)
+	u.logger.Infof("Shipping order %s by %s, tracking number %q", id, actorID, trackingNumber)
+
+	var result *order_entity.Order
+	err := u.uow.Do(ctx, func(ctx context.Context) error {
+		repo, err := u.getRepository(ctx)
+		if err != nil {
+			return err
+		}
+
+		order, err := u.transition(ctx, id, order_entity.OrderStatusShipped, order_entity.ActorManager, actorID, comment, nil)
+		if err != nil {
+			return err
+		}
+		if trackingNumber != "" {
+			if err := repo.UpdateTrackingNumber(ctx, id, trackingNumber); err != nil {
+				return err
+			}
+			order.Delivery.TrackingNumber = trackingNumber
+		}
+
+		result = order
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	u.notifyStatus(ctx, result)
+	return result, nil
+}
+
+// transition проверяет переход по машине состояний и меняет статус вместе с записью в историю.
+// check выполняется над текущим заказом до проверки перехода
+func (u *OrderUsecase) transition(
+	ctx context.Context,
+	id string,
+	to order_entity.OrderStatus,
+	actor order_entity.Actor,
+	actorID, comment string,
+	check func(order *order_entity.Order) error,
+) (*order_entity.Order, error) {
+	var result *order_entity.Order
+	err := u.uow.Do(ctx, func(ctx context.Context) error {
+		repo, err := u.getRepository(ctx)
+		if err != nil {
+			return err
+		}
+
+		order, err := repo.GetByID(ctx, id)
+		if err != nil {
+			return err
+		}
+		if order == nil {
+			return order_constant.ErrOrderNotFound
+		}
+		if check != nil {
+			if err := check(order); err != nil {
+				return err
+			}
+		}
+
+		from := order.Status
+		if !order_entity.CanTransition(from, to) {
+			u.logger.Warnf("Order %s: transition %s -> %s does not exist", id, from, to)
+			return order_constant.ErrInvalidTransition
+		}
+		if !order_entity.CanPerform(from, to, actor) {
+			u.logger.Warnf("Order %s: transition %s -> %s is not permitted for %s", id, from, to, actor)
+			return order_constant.ErrTransitionForbidden
+		}
+
+		updated, err := repo.UpdateStatus(ctx, id, from, to)
+		if err != nil {
+			return err
+		}
+		if !updated {
+			return order_constant.ErrStatusChanged
+		}
+
+		change := &order_entity.StatusChange{
+			OrderID:    id,
+			FromStatus: from,
+			ToStatus:   to,
+			Actor:      actor,
+			ActorID:    actorID,
+			Comment:    comment,
+		}
+		if err := repo.AddHistory(ctx, change); err != nil {
+			return err
+		}
+
+		if to == order_entity.OrderStatusCancelled && order.PromoCodeID != "" {
+			if err := u.discountUsecase.Release(ctx, id); err != nil {
+				return err
+			}
+		}
+		if err := u.applyStock(ctx, id, to); err != nil {
+			return err
+		}
+
+		order.Status = to
+		order.History = append(order.History, *change)
+		result = order
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// getActorID возвращает пользователя сессии, если он есть
+func (u *OrderUsecase) getActorID(ctx context.Context) string {
+	if sessionInfo, err := u.sessionRepository.GetSessionInfo(ctx); err == nil && sessionInfo != nil {
+		return sessionInfo.UserID
+	}
+	return ""
+}
+
+func (u *OrderUsecase) getSessionUserID(ctx context.Context) (string, error) {
+	sessionInfo, err := u.sessionRepository.GetSessionInfo(ctx)
+	if err != nil || sessionInfo.UserID == "" {
+		u.logger.Warnf("Order requested without session user: %v", err)
+		return "", order_constant.ErrSessionUserNotFound
+	}
+
+	return sessionInfo.UserID, nil
+}
+
+func (u *OrderUsecase) getRepository(ctx context.Context) (order_usecase_contracts.IOrderRepository, error) {
+	repo, err := u.uow.GetRepository(ctx, "order")
+	if err != nil {
+		u.logger.Errorf("Failed to get repository: %v", err)
+		return nil, err
+	}
+	return repo.(order_usecase_contracts.IOrderRepository), nil
+}
@@ -0,0 +1,571 @@
+package order_usecase_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	order_entity "github.com/Fi44er/sdmed/internal/module/order/entity"
+	order_usecase "github.com/Fi44er/sdmed/internal/module/order/usecase/order"
+	"github.com/Fi44er/sdmed/internal/module/order/usecase/order/mock"
+	order_testcases "github.com/Fi44er/sdmed/internal/module/order/usecase/order/testcases"
+	"github.com/Fi44er/sdmed/pkg/logger"
+	uow_mock "github.com/Fi44er/sdmed/pkg/postgres/uow/mock"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+)
+
+type OrderUsecaseTestSuite struct {
+	suite.Suite
+	ctx    context.Context
+	logger *logger.Logger
+}
+
+func (s *OrderUsecaseTestSuite) SetupSuite() {
+	s.ctx = context.Background()
+	s.logger = logger.NewLogger()
+}
+
+func TestOrderUsecase(t *testing.T) {
+	suite.Run(t, new(OrderUsecaseTestSuite))
+}
+
+// dependencies - зависимости usecase, которые использует тест. Незаполненные не вызываются
+type dependencies struct {
+	repo          *mock.MockIOrderRepository
+	session       *mock.MockISessionRepository
+	cart          *mock.MockICartUsecaseAdapter
+	delivery      *mock.MockIDeliveryUsecaseAdapter
+	address       *mock.MockIAddressUsecaseAdapter
+	discount      *mock.MockIDiscountUsecaseAdapter
+	region        *mock.MockIRegionUsecaseAdapter
+	stock         *mock.MockIStockUsecaseAdapter
+	payment       *mock.MockIPaymentUsecaseAdapter
+	notification  *mock.MockINotificationService
+	emailRenderer *mock.MockIEmailRenderer
+	uow           *uow_mock.MockUow
+}
+
+func (s *OrderUsecaseTestSuite) newUsecase(d dependencies) *order_usecase.OrderUsecase {
+	usecase := order_usecase.NewOrderUsecase(
+		d.repo, d.session, d.cart, d.delivery, d.address, d.discount, d.region,
+		nil, nil, nil, d.stock, nil, order_entity.Seller{},
+		d.notification, d.emailRenderer, order_testcases.TemplateVersion, time.Hour,
+		d.uow, s.logger,
+	)
+	if d.payment != nil {
+		usecase.SetPaymentUsecase(d.payment)
+	}
+	return usecase
+}
+
+func (s *OrderUsecaseTestSuite) TestCreate() {
+	tests := order_testcases.GetCreateTestCases()
+
+	for _, tc := range tests {
+		s.T().Run(tc.Name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockStruct := &order_testcases.MockCreate{
+				Ctrl:              ctrl,
+				Ctx:               s.ctx,
+				RepoMock:          mock.NewMockIOrderRepository(ctrl),
+				SessionMock:       mock.NewMockISessionRepository(ctrl),
+				CartMock:          mock.NewMockICartUsecaseAdapter(ctrl),
+				RegionMock:        mock.NewMockIRegionUsecaseAdapter(ctrl),
+				DeliveryMock:      mock.NewMockIDeliveryUsecaseAdapter(ctrl),
+				AddressMock:       mock.NewMockIAddressUsecaseAdapter(ctrl),
+				DiscountMock:      mock.NewMockIDiscountUsecaseAdapter(ctrl),
+				StockMock:         mock.NewMockIStockUsecaseAdapter(ctrl),
+				NotificationMock:  mock.NewMockINotificationService(ctrl),
+				EmailRendererMock: mock.NewMockIEmailRenderer(ctrl),
+				UowMock:           uow_mock.NewMockUow(ctrl),
+				T:                 t,
+			}
+
+			usecase := s.newUsecase(dependencies{
+				repo:          mockStruct.RepoMock,
+				session:       mockStruct.SessionMock,
+				cart:          mockStruct.CartMock,
+				delivery:      mockStruct.DeliveryMock,
+				address:       mockStruct.AddressMock,
+				discount:      mockStruct.DiscountMock,
+				region:        mockStruct.RegionMock,
+				stock:         mockStruct.StockMock,
+				notification:  mockStruct.NotificationMock,
+				emailRenderer: mockStruct.EmailRendererMock,
+				uow:           mockStruct.UowMock,
+			})
+
+			tc.SetupMocks(mockStruct)
+			order, err := usecase.Create(s.ctx, tc.Order)
+
+			if tc.ExpectedError != nil {
+				assert.ErrorIs(t, err, tc.ExpectedError)
+				assert.Nil(t, order)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, order_testcases.OrderID, order.ID)
+				assert.Equal(t, order_entity.OrderStatusNew, order.Status)
+				assert.Equal(t, tc.ExpectedTotal, order.Total)
+				assert.Len(t, order.History, 1)
+			}
+		})
+	}
+}
+
+func (s *OrderUsecaseTestSuite) TestGetOwnByID() {
+	tests := order_testcases.GetGetOwnByIDTestCases()
+
+	for _, tc := range tests {
+		s.T().Run(tc.Name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockStruct := &order_testcases.MockGetOwnByID{
+				Ctrl:        ctrl,
+				Ctx:         s.ctx,
+				RepoMock:    mock.NewMockIOrderRepository(ctrl),
+				SessionMock: mock.NewMockISessionRepository(ctrl),
+				T:           t,
+			}
+
+			usecase := s.newUsecase(dependencies{
+				repo:    mockStruct.RepoMock,
+				session: mockStruct.SessionMock,
+			})
+
+			tc.SetupMocks(mockStruct)
+			order, err := usecase.GetOwnByID(s.ctx, order_testcases.OrderID)
+
+			if tc.ExpectedError != nil {
+				assert.ErrorIs(t, err, tc.ExpectedError)
+				assert.Nil(t, order)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, order_testcases.OrderID, order.ID)
+			}
+		})
+	}
+}
+
+func (s *OrderUsecaseTestSuite) TestCancel() {
+	tests := order_testcases.GetCancelTestCases()
+
+	for _, tc := range tests {
+		s.T().Run(tc.Name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockStruct := &order_testcases.MockCancel{
+				Ctrl:              ctrl,
+				Ctx:               s.ctx,
+				RepoMock:          mock.NewMockIOrderRepository(ctrl),
+				SessionMock:       mock.NewMockISessionRepository(ctrl),
+				StockMock:         mock.NewMockIStockUsecaseAdapter(ctrl),
+				NotificationMock:  mock.NewMockINotificationService(ctrl),
+				EmailRendererMock: mock.NewMockIEmailRenderer(ctrl),
+				UowMock:           uow_mock.NewMockUow(ctrl),
+				T:                 t,
+			}
+
+			usecase := s.newUsecase(dependencies{
+				repo:          mockStruct.RepoMock,
+				session:       mockStruct.SessionMock,
+				stock:         mockStruct.StockMock,
+				notification:  mockStruct.NotificationMock,
+				emailRenderer: mockStruct.EmailRendererMock,
+				uow:           mockStruct.UowMock,
+			})
+
+			tc.SetupMocks(mockStruct)
+			order, err := usecase.Cancel(s.ctx, order_testcases.OrderID, tc.Comment)
+
+			if tc.ExpectedError != nil {
+				assert.ErrorIs(t, err, tc.ExpectedError)
+				assert.Nil(t, order)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, order_entity.OrderStatusCancelled, order.Status)
+			}
+		})
+	}
+}
+
+func (s *OrderUsecaseTestSuite) TestChangeStatus() {
+	tests := order_testcases.GetChangeStatusTestCases()
+
+	for _, tc := range tests {
+		s.T().Run(tc.Name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockStruct := &order_testcases.MockChangeStatus{
+				Ctrl:              ctrl,
+				Ctx:               s.ctx,
+				RepoMock:          mock.NewMockIOrderRepository(ctrl),
+				SessionMock:       mock.NewMockISessionRepository(ctrl),
+				DiscountMock:      mock.NewMockIDiscountUsecaseAdapter(ctrl),
+				StockMock:         mock.NewMockIStockUsecaseAdapter(ctrl),
+				NotificationMock:  mock.NewMockINotificationService(ctrl),
+				EmailRendererMock: mock.NewMockIEmailRenderer(ctrl),
+				UowMock:           uow_mock.NewMockUow(ctrl),
+				T:                 t,
+			}
+
+			usecase := s.newUsecase(dependencies{
+				repo:          mockStruct.RepoMock,
+				session:       mockStruct.SessionMock,
+				discount:      mockStruct.DiscountMock,
+				stock:         mockStruct.StockMock,
+				notification:  mockStruct.NotificationMock,
+				emailRenderer: mockStruct.EmailRendererMock,
+				uow:           mockStruct.UowMock,
+			})
+
+			tc.SetupMocks(mockStruct)
+			order, err := usecase.ChangeStatus(s.ctx, order_testcases.OrderID, tc.To, tc.Actor, tc.Comment)
+
+			if tc.ExpectedError != nil {
+				assert.ErrorIs(t, err, tc.ExpectedError)
+				assert.Nil(t, order)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tc.ExpectedStatus, order.Status)
+			}
+		})
+	}
+}
+
+func (s *OrderUsecaseTestSuite) TestMarkPaid() {
+	tests := order_testcases.GetMarkPaidTestCases()
+
+	for _, tc := range tests {
+		s.T().Run(tc.Name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockStruct := &order_testcases.MockMarkPaid{
+				Ctrl:      ctrl,
+				Ctx:       s.ctx,
+				RepoMock:  mock.NewMockIOrderRepository(ctrl),
+				StockMock: mock.NewMockIStockUsecaseAdapter(ctrl),
+				UowMock:   uow_mock.NewMockUow(ctrl),
+				T:         t,
+			}
+
+			usecase := s.newUsecase(dependencies{
+				repo:  mockStruct.RepoMock,
+				stock: mockStruct.StockMock,
+				uow:   mockStruct.UowMock,
+			})
+
+			tc.SetupMocks(mockStruct)
+			applied, err := usecase.MarkPaid(s.ctx, order_testcases.OrderID, tc.Amount, tc.Comment)
+
+			if tc.ExpectedError != nil {
+				assert.ErrorIs(t, err, tc.ExpectedError)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, tc.ExpectedApplied, applied)
+		})
+	}
+}
+
+func (s *OrderUsecaseTestSuite) TestUpdateCertificateStatus() {
+	tests := order_testcases.GetUpdateCertificateStatusTestCases()
+
+	for _, tc := range tests {
+		s.T().Run(tc.Name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockStruct := &order_testcases.MockUpdateCertificateStatus{
+				Ctrl:              ctrl,
+				Ctx:               s.ctx,
+				RepoMock:          mock.NewMockIOrderRepository(ctrl),
+				StockMock:         mock.NewMockIStockUsecaseAdapter(ctrl),
+				NotificationMock:  mock.NewMockINotificationService(ctrl),
+				EmailRendererMock: mock.NewMockIEmailRenderer(ctrl),
+				UowMock:           uow_mock.NewMockUow(ctrl),
+				T:                 t,
+			}
+
+			usecase := s.newUsecase(dependencies{
+				repo:          mockStruct.RepoMock,
+				stock:         mockStruct.StockMock,
+				notification:  mockStruct.NotificationMock,
+				emailRenderer: mockStruct.EmailRendererMock,
+				uow:           mockStruct.UowMock,
+			})
+
+			tc.SetupMocks(mockStruct)
+			order, err := usecase.UpdateCertificateStatus(s.ctx, order_testcases.OrderID, tc.ItemIDs, tc.To)
+
+			if tc.ExpectedError != nil {
+				assert.ErrorIs(t, err, tc.ExpectedError)
+				assert.Nil(t, order)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tc.ExpectedStatus, order.Status)
+			}
+		})
+	}
+}
+
+func (s *OrderUsecaseTestSuite) TestNotifyPaid() {
+	tests := order_testcases.GetNotifyPaidTestCases()
+
+	for _, tc := range tests {
+		s.T().Run(tc.Name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockStruct := &order_testcases.MockNotifyPaid{
+				Ctrl:              ctrl,
+				Ctx:               s.ctx,
+				RepoMock:          mock.NewMockIOrderRepository(ctrl),
+				NotificationMock:  mock.NewMockINotificationService(ctrl),
+				EmailRendererMock: mock.NewMockIEmailRenderer(ctrl),
+				T:                 t,
+			}
+
+			usecase := s.newUsecase(dependencies{
+				repo:          mockStruct.RepoMock,
+				notification:  mockStruct.NotificationMock,
+				emailRenderer: mockStruct.EmailRendererMock,
+			})
+
+			tc.SetupMocks(mockStruct)
+			err := usecase.NotifyPaid(s.ctx, order_testcases.OrderID)
+
+			if tc.ExpectedError != nil {
+				assert.ErrorIs(t, err, tc.ExpectedError)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func (s *OrderUsecaseTestSuite) TestResendNotification() {
+	tests := order_testcases.GetResendNotificationTestCases()
+
+	for _, tc := range tests {
+		s.T().Run(tc.Name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockStruct := &order_testcases.MockResendNotification{
+				Ctrl:              ctrl,
+				Ctx:               s.ctx,
+				RepoMock:          mock.NewMockIOrderRepository(ctrl),
+				NotificationMock:  mock.NewMockINotificationService(ctrl),
+				EmailRendererMock: mock.NewMockIEmailRenderer(ctrl),
+				T:                 t,
+			}
+
+			usecase := s.newUsecase(dependencies{
+				repo:          mockStruct.RepoMock,
+				notification:  mockStruct.NotificationMock,
+				emailRenderer: mockStruct.EmailRendererMock,
+			})
+
+			tc.SetupMocks(mockStruct)
+			notification, err := usecase.ResendNotification(s.ctx, order_testcases.OrderID, order_testcases.NotificationID)
+
+			if tc.ExpectedError != nil {
+				assert.ErrorIs(t, err, tc.ExpectedError)
+				assert.Nil(t, notification)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tc.ExpectedStatus, notification.Status)
+				assert.Equal(t, order_testcases.NotificationID, notification.ResendOfID)
+			}
+		})
+	}
+}
+
+func (s *OrderUsecaseTestSuite) TestRepeat() {
+	tests := order_testcases.GetRepeatTestCases()
+
+	for _, tc := range tests {
+		s.T().Run(tc.Name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockStruct := &order_testcases.MockRepeat{
+				Ctrl:        ctrl,
+				Ctx:         s.ctx,
+				RepoMock:    mock.NewMockIOrderRepository(ctrl),
+				SessionMock: mock.NewMockISessionRepository(ctrl),
+				CartMock:    mock.NewMockICartUsecaseAdapter(ctrl),
+				StockMock:   mock.NewMockIStockUsecaseAdapter(ctrl),
+				UowMock:     uow_mock.NewMockUow(ctrl),
+				T:           t,
+			}
+
+			usecase := s.newUsecase(dependencies{
+				repo:    mockStruct.RepoMock,
+				session: mockStruct.SessionMock,
+				cart:    mockStruct.CartMock,
+				stock:   mockStruct.StockMock,
+				uow:     mockStruct.UowMock,
+			})
+
+			tc.SetupMocks(mockStruct)
+			result, err := usecase.Repeat(s.ctx, order_testcases.OrderID)
+
+			if tc.ExpectedError != nil {
+				assert.ErrorIs(t, err, tc.ExpectedError)
+				assert.Nil(t, result)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, order_testcases.OrderID, result.OrderID)
+				assert.Equal(t, tc.ExpectedAdded, result.Added)
+				assert.Equal(t, tc.ExpectedUnavailable, result.Unavailable)
+
+				partial := []string{}
+				for i := range result.Added {
+					if result.Added[i].PartiallyAvailable() {
+						partial = append(partial, result.Added[i].ProductID)
+					}
+				}
+				assert.Equal(t, tc.ExpectedPartial, partial)
+			}
+		})
+	}
+}
+
+func (s *OrderUsecaseTestSuite) TestReleaseExpired() {
+	tests := order_testcases.GetReleaseExpiredTestCases()
+
+	for _, tc := range tests {
+		s.T().Run(tc.Name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockStruct := &order_testcases.MockReleaseExpired{
+				Ctrl:              ctrl,
+				Ctx:               s.ctx,
+				RepoMock:          mock.NewMockIOrderRepository(ctrl),
+				SessionMock:       mock.NewMockISessionRepository(ctrl),
+				StockMock:         mock.NewMockIStockUsecaseAdapter(ctrl),
+				PaymentMock:       mock.NewMockIPaymentUsecaseAdapter(ctrl),
+				NotificationMock:  mock.NewMockINotificationService(ctrl),
+				EmailRendererMock: mock.NewMockIEmailRenderer(ctrl),
+				UowMock:           uow_mock.NewMockUow(ctrl),
+				T:                 t,
+			}
+
+			deps := dependencies{
+				repo:          mockStruct.RepoMock,
+				session:       mockStruct.SessionMock,
+				stock:         mockStruct.StockMock,
+				payment:       mockStruct.PaymentMock,
+				notification:  mockStruct.NotificationMock,
+				emailRenderer: mockStruct.EmailRendererMock,
+				uow:           mockStruct.UowMock,
+			}
+			if tc.PaymentDisabled {
+				deps.payment = nil
+			}
+			usecase := s.newUsecase(deps)
+
+			tc.SetupMocks(mockStruct)
+			released, err := usecase.ReleaseExpired(s.ctx)
+
+			if tc.ExpectedError != nil {
+				assert.ErrorIs(t, err, tc.ExpectedError)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, tc.ExpectedReleased, released)
+		})
+	}
+}
+
+func (s *OrderUsecaseTestSuite) TestEditItems() {
+	tests := order_testcases.GetEditItemsTestCases()
+
+	for _, tc := range tests {
+		s.T().Run(tc.Name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockStruct := &order_testcases.MockEditItems{
+				Ctrl:        ctrl,
+				Ctx:         s.ctx,
+				RepoMock:    mock.NewMockIOrderRepository(ctrl),
+				SessionMock: mock.NewMockISessionRepository(ctrl),
+				StockMock:   mock.NewMockIStockUsecaseAdapter(ctrl),
+				PaymentMock: mock.NewMockIPaymentUsecaseAdapter(ctrl),
+				UowMock:     uow_mock.NewMockUow(ctrl),
+				T:           t,
+			}
+
+			usecase := s.newUsecase(dependencies{
+				repo:    mockStruct.RepoMock,
+				session: mockStruct.SessionMock,
+				stock:   mockStruct.StockMock,
+				payment: mockStruct.PaymentMock,
+				uow:     mockStruct.UowMock,
+			})
+
+			tc.SetupMocks(mockStruct)
+			order, err := usecase.EditItems(s.ctx, order_testcases.OrderID, tc.Changes, "")
+
+			if tc.ExpectedError != nil {
+				assert.ErrorIs(t, err, tc.ExpectedError)
+				assert.Nil(t, order)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tc.ExpectedTotal, order.Total)
+			}
+		})
+	}
+}
+
+func (s *OrderUsecaseTestSuite) TestUpdateDelivery() {
+	tests := order_testcases.GetUpdateDeliveryTestCases()
+
+	for _, tc := range tests {
+		s.T().Run(tc.Name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockStruct := &order_testcases.MockUpdateDelivery{
+				Ctrl:         ctrl,
+				Ctx:          s.ctx,
+				RepoMock:     mock.NewMockIOrderRepository(ctrl),
+				SessionMock:  mock.NewMockISessionRepository(ctrl),
+				DeliveryMock: mock.NewMockIDeliveryUsecaseAdapter(ctrl),
+				PaymentMock:  mock.NewMockIPaymentUsecaseAdapter(ctrl),
+				UowMock:      uow_mock.NewMockUow(ctrl),
+				T:            t,
+			}
+
+			usecase := s.newUsecase(dependencies{
+				repo:     mockStruct.RepoMock,
+				session:  mockStruct.SessionMock,
+				delivery: mockStruct.DeliveryMock,
+				payment:  mockStruct.PaymentMock,
+				uow:      mockStruct.UowMock,
+			})
+
+			tc.SetupMocks(mockStruct)
+			order, err := usecase.UpdateDelivery(s.ctx, order_testcases.OrderID, tc.Delivery, "")
+
+			if tc.ExpectedError != nil {
+				assert.ErrorIs(t, err, tc.ExpectedError)
+				assert.Nil(t, order)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tc.ExpectedTotal, order.Total)
+			}
+		})
+	}
+}
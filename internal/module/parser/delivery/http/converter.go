@@ -0,0 +1,92 @@
+package parser_http
+
+import (
+	"math"
+
+	parser_dto "github.com/Fi44er/sdmed/internal/module/parser/dto"
+	parser_entity "github.com/Fi44er/sdmed/internal/module/parser/entity"
+	dto_utils "github.com/Fi44er/sdmed/pkg/utils/dto"
+)
+
+type Converter struct{}
+
+func (c *Converter) ToChangesetResponse(changeset *parser_entity.Changeset) parser_dto.ChangesetResponse {
+	stats := make(map[string]int, len(changeset.Stats))
+	for changeType, count := range changeset.Stats {
+		stats[string(changeType)] = count
+	}
+
+	return parser_dto.ChangesetResponse{
+		ID:         changeset.ID,
+		StartedAt:  changeset.StartedAt,
+		FinishedAt: changeset.FinishedAt,
+		Stats:      stats,
+	}
+}
+
+func (c *Converter) ToChangesetListResponse(changesets []parser_entity.Changeset, count int64, page, pageSize int) *dto_utils.ListResponse[parser_dto.ChangesetResponse] {
+	data := make([]parser_dto.ChangesetResponse, len(changesets))
+	for i := range changesets {
+		data[i] = c.ToChangesetResponse(&changesets[i])
+	}
+
+	return &dto_utils.ListResponse[parser_dto.ChangesetResponse]{
+		Data: data,
+		Pagination: dto_utils.PaginationInfo{
+			Total:    count,
+			Page:     page,
+			PageSize: pageSize,
+			Pages:    int(math.Ceil(float64(count) / float64(pageSize))),
+		},
+	}
+}
+
+func (c *Converter) ToChangesetReportResponse(report *parser_entity.ChangesetReport) *parser_dto.ChangesetReportResponse {
+	changes := make([]parser_dto.ChangesetItemResponse, len(report.Items))
+	for i, item := range report.Items {
+		changes[i] = c.toItemResponse(item)
+	}
+
+	products := make([]parser_dto.AffectedProductResponse, len(report.Products))
+	for i, product := range report.Products {
+		products[i] = parser_dto.AffectedProductResponse{
+			ProductID: product.ProductID,
+			Name:      product.Name,
+			Article:   product.Article,
+			Price:     product.Price,
+			Change:    c.toItemResponse(product.Item),
+			Coverage:  c.toCoverageResponse(product.Coverage),
+		}
+	}
+
+	return &parser_dto.ChangesetReportResponse{
+		ChangesetResponse: c.ToChangesetResponse(&report.Changeset),
+		Changes:           changes,
+		Products:          products,
+	}
+}
+
+func (c *Converter) toItemResponse(item parser_entity.ChangesetItem) parser_dto.ChangesetItemResponse {
+	return parser_dto.ChangesetItemResponse{
+		Type:      string(item.Type),
+		TRUCodeID: item.TRUCodeID,
+		Code:      item.Code,
+		RegionID:  item.RegionID,
+		OldPrice:  item.OldPrice,
+		NewPrice:  item.NewPrice,
+	}
+}
+
+func (c *Converter) toCoverageResponse(coverage *parser_entity.Coverage) *parser_dto.CoverageResponse {
+	if coverage == nil {
+		return nil
+	}
+
+	return &parser_dto.CoverageResponse{
+		LimitPrice:     coverage.LimitPrice,
+		CoveredAmount:  coverage.CoveredAmount,
+		Surcharge:      coverage.Surcharge,
+		IsEligible:     coverage.IsEligible,
+		IsFullyCovered: coverage.IsFullyCovered,
+	}
+}
@@ -0,0 +1,87 @@
+package parser_http
+
+import (
+	"context"
+
+	parser_dto "github.com/Fi44er/sdmed/internal/module/parser/dto"
+	parser_entity "github.com/Fi44er/sdmed/internal/module/parser/entity"
+	"github.com/Fi44er/sdmed/pkg/logger"
+	_ "github.com/Fi44er/sdmed/pkg/response"
+	"github.com/gofiber/fiber/v2"
+)
+
+type IChangesetUsecase interface {
+	GetAll(ctx context.Context, page, pageSize int) ([]parser_entity.Changeset, int64, error)
+	GetReport(ctx context.Context, id string) (*parser_entity.ChangesetReport, error)
+}
+
+type ParserHandler struct {
+	changesetUsecase IChangesetUsecase
+
+	logger    *logger.Logger
+	converter *Converter
+}
+
+func NewParserHandler(changesetUsecase IChangesetUsecase, logger *logger.Logger) *ParserHandler {
+	return &ParserHandler{
+		changesetUsecase: changesetUsecase,
+		logger:           logger,
+		converter:        &Converter{},
+	}
+}
+
+// GetChangesets godoc
+// @Summary Get catalogue changesets
+// @Description Get SFR catalogue parser runs with counts of changes by type
+// @Tags parser
+// @Accept json
+// @Produce json
+// @Param page query int false "Page number (default 1)"
+// @Param page_size query int false "Items per page (default 20)"
+// @Success 200 {object} response.ResponseData{data=[]parser_dto.ChangesetResponse} "OK"
+// @Failure 500 {object} response.Response "Error"
+// @Router /parser/changesets [get]
+func (h *ParserHandler) GetChangesets(ctx *fiber.Ctx) error {
+	params := &parser_dto.ChangesetQueryParams{
+		Page:     1,
+		PageSize: 20,
+	}
+
+	if err := ctx.QueryParser(params); err != nil {
+		h.logger.Errorf("Failed to parse query params: %v", err)
+		return fiber.NewError(fiber.StatusBadRequest, "Invalid query parameters")
+	}
+
+	changesets, count, err := h.changesetUsecase.GetAll(ctx.Context(), params.Page, params.PageSize)
+	if err != nil {
+		return err
+	}
+
+	return ctx.Status(200).JSON(fiber.Map{
+		"status": "success",
+		"data":   h.converter.ToChangesetListResponse(changesets, count, params.Page, params.PageSize),
+	})
+}
+
+// GetChangesetReport godoc
+// @Summary Get price-change report
+// @Description Lists catalogue changes of a parser run and the products linked to changed codes with their new certificate coverage
+// @Tags parser
+// @Accept json
+// @Produce json
+// @Param id path string true "Changeset ID"
+// @Success 200 {object} response.ResponseData{data=parser_dto.ChangesetReportResponse} "OK"
+// @Failure 404 {object} response.Response "Not found"
+// @Failure 500 {object} response.Response "Error"
+// @Router /parser/changesets/{id}/report [get]
+func (h *ParserHandler) GetChangesetReport(ctx *fiber.Ctx) error {
+	report, err := h.changesetUsecase.GetReport(ctx.Context(), ctx.Params("id"))
+	if err != nil {
+		return err
+	}
+
+	return ctx.Status(200).JSON(fiber.Map{
+		"status": "success",
+		"data":   h.converter.ToChangesetReportResponse(report),
+	})
+}
@@ -0,0 +1,12 @@
+package parser_http
+
+import (
+	"github.com/Fi44er/sdmed/internal/middlewares"
+	"github.com/gofiber/fiber/v2"
+)
+
+func (h *ParserHandler) RegisterRoutes(router fiber.Router) {
+	parser := router.Group("/parser", middlewares.Authorize("tru", "read"))
+	parser.Get("/changesets", h.GetChangesets)
+	parser.Get("/changesets/:id/report", h.GetChangesetReport)
+}
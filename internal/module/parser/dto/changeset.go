@@ -0,0 +1,47 @@
+package parser_dto
+
+import "time"
+
+type ChangesetQueryParams struct {
+	Page     int `query:"page"`
+	PageSize int `query:"page_size"`
+}
+
+type ChangesetResponse struct {
+	ID         string         `json:"id"`
+	StartedAt  time.Time      `json:"started_at"`
+	FinishedAt *time.Time     `json:"finished_at"`
+	Stats      map[string]int `json:"stats"`
+}
+
+type ChangesetItemResponse struct {
+	Type      string   `json:"type"`
+	TRUCodeID string   `json:"tru_code_id"`
+	Code      string   `json:"code"`
+	RegionID  string   `json:"region_id,omitempty"`
+	OldPrice  *float64 `json:"old_price"`
+	NewPrice  *float64 `json:"new_price"`
+}
+
+type CoverageResponse struct {
+	LimitPrice     float64 `json:"limit_price"`
+	CoveredAmount  float64 `json:"covered_amount"`
+	Surcharge      float64 `json:"surcharge"`
+	IsEligible     bool    `json:"is_certificate_eligible"`
+	IsFullyCovered bool    `json:"is_fully_covered"`
+}
+
+type AffectedProductResponse struct {
+	ProductID string                `json:"product_id"`
+	Name      string                `json:"name"`
+	Article   string                `json:"article"`
+	Price     float64               `json:"price"`
+	Change    ChangesetItemResponse `json:"change"`
+	Coverage  *CoverageResponse     `json:"coverage"`
+}
+
+type ChangesetReportResponse struct {
+	ChangesetResponse
+	Changes  []ChangesetItemResponse   `json:"changes"`
+	Products []AffectedProductResponse `json:"products"`
+}
@@ -0,0 +1,32 @@
+package parser_entity
+
+// CatalogCode - позиция каталога КТРУ на ktsr.sfr.gov.ru
+type CatalogCode struct {
+	// TRUCodeID заполняется после сохранения кода
+	TRUCodeID string
+	Code      string
+	Name      string
+	Prices    []CatalogPrice
+}
+
+// CatalogPrice - цена возмещения в субъекте.
+// RegionID заполняется при сопоставлении с нашим справочником регионов.
+type CatalogPrice struct {
+	RegionCode string
+	OKATO      string
+	RegionName string
+	RegionID   string
+	Price      float64
+}
+
+type CatalogPage struct {
+	Page       int
+	TotalPages int
+	Codes      []CatalogCode
+}
+
+type Region struct {
+	ID    string
+	Code  string
+	OKATO string
+}
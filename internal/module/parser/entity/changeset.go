@@ -0,0 +1,70 @@
+package parser_entity
+
+import "time"
+
+type ChangeType string
+
+const (
+	ChangeTypeCodeAdded    ChangeType = "code_added"
+	ChangeTypeCodeRemoved  ChangeType = "code_removed"
+	ChangeTypePriceAdded   ChangeType = "price_added"
+	ChangeTypePriceRemoved ChangeType = "price_removed"
+	ChangeTypePriceUp      ChangeType = "price_up"
+	ChangeTypePriceDown    ChangeType = "price_down"
+	// ChangeTypeCodeConflict - код каталога совпал с пользовательским кодом и не был сохранен
+	ChangeTypeCodeConflict ChangeType = "code_conflict"
+)
+
+// Changeset - изменения каталога, найденные за один обход
+type Changeset struct {
+	ID         string
+	StartedAt  time.Time
+	FinishedAt *time.Time
+	Stats      map[ChangeType]int
+	Items      []ChangesetItem
+}
+
+// ChangesetItem - одно изменение: код целиком или цена кода в регионе
+type ChangesetItem struct {
+	ID          string
+	ChangesetID string
+	Type        ChangeType
+	TRUCodeID   string
+	Code        string
+	RegionID    string
+	OldPrice    *float64
+	NewPrice    *float64
+	CreatedAt   time.Time
+}
+
+// StoredCode - код КТРУ в нашей базе до сохранения результата обхода
+type StoredCode struct {
+	ID     string
+	Prices map[string]float64 // region_id -> цена
+}
+
+// ChangesetReport - изменения обхода и затронутые ими товары
+type ChangesetReport struct {
+	Changeset
+	Products []AffectedProduct
+}
+
+// AffectedProduct - товар, привязанный к измененному коду.
+// Coverage заполняется для изменений цены в регионе.
+type AffectedProduct struct {
+	ProductID string
+	Name      string
+	Article   string
+	Price     float64
+	Item      ChangesetItem
+	Coverage  *Coverage
+}
+
+// Coverage - покрытие товара электронным сертификатом после изменения
+type Coverage struct {
+	LimitPrice     float64
+	CoveredAmount  float64
+	Surcharge      float64
+	IsEligible     bool
+	IsFullyCovered bool
+}
@@ -0,0 +1,30 @@
+package parser_entity
+
+import "time"
+
+type ProgressStatus string
+
+const (
+	ProgressStatusRunning   ProgressStatus = "running"
+	ProgressStatusCompleted ProgressStatus = "completed"
+	ProgressStatusFailed    ProgressStatus = "failed"
+)
+
+// Progress - состояние обхода каталога, по нему прерванный запуск продолжается с последней страницы
+type Progress struct {
+	Name           string
+	ChangesetID    string
+	Status         ProgressStatus
+	LastPage       int
+	TotalPages     int
+	ProcessedCodes int
+	LastError      string
+	StartedAt      time.Time
+	FinishedAt     *time.Time
+	UpdatedAt      time.Time
+}
+
+// IsResumable - предыдущий запуск не дошел до конца каталога
+func (p *Progress) IsResumable() bool {
+	return p != nil && p.Status != ProgressStatusCompleted
+}
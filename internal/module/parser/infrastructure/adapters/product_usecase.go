@@ -0,0 +1,57 @@
+package parser_adapters
+
+import (
+	"context"
+
+	parser_entity "github.com/Fi44er/sdmed/internal/module/parser/entity"
+	product_usecase "github.com/Fi44er/sdmed/internal/module/product/usecase/product"
+)
+
+type IProductUsecaseAdapter interface {
+	GetByIDs(ctx context.Context, ids []string) ([]parser_entity.AffectedProduct, error)
+	GetCoverage(ctx context.Context, productID, regionID string) (*parser_entity.Coverage, error)
+}
+
+type ProductUsecaseAdapter struct {
+	productUsecase product_usecase.IProductUsecase
+}
+
+func NewProductUsecaseAdapter(productUsecase product_usecase.IProductUsecase) IProductUsecaseAdapter {
+	return &ProductUsecaseAdapter{
+		productUsecase: productUsecase,
+	}
+}
+
+func (a *ProductUsecaseAdapter) GetByIDs(ctx context.Context, ids []string) ([]parser_entity.AffectedProduct, error) {
+	products, err := a.productUsecase.GetByIDs(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]parser_entity.AffectedProduct, len(products))
+	for i := range products {
+		result[i] = parser_entity.AffectedProduct{
+			ProductID: products[i].ID,
+			Name:      products[i].Name,
+			Article:   products[i].Article,
+			Price:     products[i].Price(),
+		}
+	}
+
+	return result, nil
+}
+
+func (a *ProductUsecaseAdapter) GetCoverage(ctx context.Context, productID, regionID string) (*parser_entity.Coverage, error) {
+	calculation, err := a.productUsecase.CalculateCertificate(ctx, productID, regionID, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return &parser_entity.Coverage{
+		LimitPrice:     calculation.LimitPrice,
+		CoveredAmount:  calculation.CoveredAmount,
+		Surcharge:      calculation.Surcharge,
+		IsEligible:     calculation.IsEligible,
+		IsFullyCovered: calculation.IsFullyCovered,
+	}, nil
+}
@@ -0,0 +1,40 @@
+package parser_adapters
+
+import (
+	"context"
+
+	parser_entity "github.com/Fi44er/sdmed/internal/module/parser/entity"
+	region_usecase "github.com/Fi44er/sdmed/internal/module/region/usecase/region"
+)
+
+type IRegionUsecaseAdapter interface {
+	GetRegions(ctx context.Context) ([]parser_entity.Region, error)
+}
+
+type RegionUsecaseAdapter struct {
+	regionUsecase region_usecase.IRegionUsecase
+}
+
+func NewRegionUsecaseAdapter(regionUsecase region_usecase.IRegionUsecase) IRegionUsecaseAdapter {
+	return &RegionUsecaseAdapter{
+		regionUsecase: regionUsecase,
+	}
+}
+
+func (a *RegionUsecaseAdapter) GetRegions(ctx context.Context) ([]parser_entity.Region, error) {
+	regions, err := a.regionUsecase.GetAll(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]parser_entity.Region, len(regions))
+	for i, region := range regions {
+		result[i] = parser_entity.Region{
+			ID:    region.ID,
+			Code:  region.Code,
+			OKATO: region.OKATO,
+		}
+	}
+
+	return result, nil
+}
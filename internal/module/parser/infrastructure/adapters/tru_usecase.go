@@ -0,0 +1,103 @@
+package parser_adapters
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	parser_entity "github.com/Fi44er/sdmed/internal/module/parser/entity"
+	parser_pkg "github.com/Fi44er/sdmed/internal/module/parser/pkg"
+	tru_entity "github.com/Fi44er/sdmed/internal/module/tru/entity"
+	tru_constant "github.com/Fi44er/sdmed/internal/module/tru/pkg"
+	tru_usecase "github.com/Fi44er/sdmed/internal/module/tru/usecase/tru"
+)
+
+type ITRUUsecaseAdapter interface {
+	GetCode(ctx context.Context, code string) (*parser_entity.StoredCode, error)
+	SaveCode(ctx context.Context, code *parser_entity.CatalogCode) error
+	GetStaleCodes(ctx context.Context, since time.Time) ([]parser_entity.CatalogCode, error)
+	GetProductIDsByCodes(ctx context.Context, truCodeIDs []string) (map[string][]string, error)
+}
+
+type TRUUsecaseAdapter struct {
+	truUsecase tru_usecase.ITRUUsecase
+}
+
+func NewTRUUsecaseAdapter(truUsecase tru_usecase.ITRUUsecase) ITRUUsecaseAdapter {
+	return &TRUUsecaseAdapter{
+		truUsecase: truUsecase,
+	}
+}
+
+// GetCode возвращает сохраненный код с ценами по регионам или nil, если кода еще нет
+func (a *TRUUsecaseAdapter) GetCode(ctx context.Context, code string) (*parser_entity.StoredCode, error) {
+	truCode, err := a.truUsecase.GetByCode(ctx, code)
+	if err != nil {
+		if errors.Is(err, tru_constant.ErrTRUCodeNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	prices := make(map[string]float64, len(truCode.Prices))
+	for _, price := range truCode.Prices {
+		prices[price.RegionID] = price.Price
+	}
+
+	return &parser_entity.StoredCode{
+		ID:     truCode.ID,
+		Prices: prices,
+	}, nil
+}
+
+// SaveCode сохраняет код и цены тех регионов, которые удалось сопоставить.
+// Если такой код уже заведен как пользовательский, возвращает ErrCustomCodeConflict.
+func (a *TRUUsecaseAdapter) SaveCode(ctx context.Context, code *parser_entity.CatalogCode) error {
+	prices := make([]tru_entity.TRUCodePrice, 0, len(code.Prices))
+	for _, price := range code.Prices {
+		if price.RegionID == "" {
+			continue
+		}
+		prices = append(prices, tru_entity.TRUCodePrice{
+			RegionID: price.RegionID,
+			Price:    price.Price,
+		})
+	}
+
+	truCode := &tru_entity.TRUCode{
+		Code:   code.Code,
+		Name:   code.Name,
+		Prices: prices,
+	}
+	if err := a.truUsecase.SaveParsedCode(ctx, truCode); err != nil {
+		if errors.Is(err, tru_constant.ErrParsedCodeIsCustom) {
+			return parser_pkg.ErrCustomCodeConflict
+		}
+		return err
+	}
+	code.TRUCodeID = truCode.ID
+
+	return nil
+}
+
+func (a *TRUUsecaseAdapter) GetStaleCodes(ctx context.Context, since time.Time) ([]parser_entity.CatalogCode, error) {
+	codes, err := a.truUsecase.GetStaleOfficialCodes(ctx, since)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]parser_entity.CatalogCode, len(codes))
+	for i, code := range codes {
+		result[i] = parser_entity.CatalogCode{
+			TRUCodeID: code.ID,
+			Code:      code.Code,
+			Name:      code.Name,
+		}
+	}
+
+	return result, nil
+}
+
+func (a *TRUUsecaseAdapter) GetProductIDsByCodes(ctx context.Context, truCodeIDs []string) (map[string][]string, error) {
+	return a.truUsecase.GetProductIDsByCodes(ctx, truCodeIDs)
+}
@@ -0,0 +1,190 @@
+package ktsr_client
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	parser_entity "github.com/Fi44er/sdmed/internal/module/parser/entity"
+	parser_pkg "github.com/Fi44er/sdmed/internal/module/parser/pkg"
+	"github.com/Fi44er/sdmed/pkg/logger"
+)
+
+type Options struct {
+	BaseURL         string
+	PageSize        int
+	RequestInterval time.Duration
+	MaxRetries      int
+	RetryBackoff    time.Duration
+	Timeout         time.Duration
+}
+
+// Client - клиент каталога ktsr.sfr.gov.ru с ограничением частоты запросов и повторами
+type Client struct {
+	httpClient *http.Client
+	limiter    *rateLimiter
+	options    Options
+	logger     *logger.Logger
+}
+
+type statusError struct {
+	statusCode int
+	url        string
+}
+
+func (e *statusError) Error() string {
+	return fmt.Sprintf("unexpected status %d from %s", e.statusCode, e.url)
+}
+
+func NewClient(logger *logger.Logger, options Options) *Client {
+	if options.BaseURL == "" {
+		options.BaseURL = parser_pkg.MainURL
+	}
+	if options.PageSize <= 0 {
+		options.PageSize = parser_pkg.DefaultPageSize
+	}
+	if options.MaxRetries < 0 {
+		options.MaxRetries = 0
+	}
+	if options.Timeout <= 0 {
+		options.Timeout = parser_pkg.DefaultRequestTimeout
+	}
+	options.BaseURL = strings.TrimRight(options.BaseURL, "/")
+
+	return &Client{
+		httpClient: &http.Client{Timeout: options.Timeout},
+		limiter:    newRateLimiter(options.RequestInterval),
+		options:    options,
+		logger:     logger,
+	}
+}
+
+func (c *Client) GetPage(ctx context.Context, page int) (*parser_entity.CatalogPage, error) {
+	query := url.Values{}
+	query.Set("page", strconv.Itoa(page))
+	query.Set("size", strconv.Itoa(c.options.PageSize))
+
+	var response catalogPageResponse
+	if err := c.getJSON(ctx, parser_pkg.CatalogPath, query, &response); err != nil {
+		return nil, err
+	}
+
+	codes := make([]parser_entity.CatalogCode, 0, len(response.Items))
+	for _, item := range response.Items {
+		code := strings.TrimSpace(item.Code)
+		if code == "" {
+			continue
+		}
+		codes = append(codes, parser_entity.CatalogCode{
+			Code: code,
+			Name: strings.TrimSpace(item.Name),
+		})
+	}
+
+	return &parser_entity.CatalogPage{
+		Page:       response.Page,
+		TotalPages: response.TotalPages,
+		Codes:      codes,
+	}, nil
+}
+
+func (c *Client) GetPrices(ctx context.Context, code string) ([]parser_entity.CatalogPrice, error) {
+	var response pricesResponse
+	path := fmt.Sprintf(parser_pkg.PricesPath, url.PathEscape(code))
+	if err := c.getJSON(ctx, path, nil, &response); err != nil {
+		return nil, err
+	}
+
+	prices := make([]parser_entity.CatalogPrice, 0, len(response.Prices))
+	for _, price := range response.Prices {
+		prices = append(prices, parser_entity.CatalogPrice{
+			RegionCode: strings.TrimSpace(price.RegionCode),
+			OKATO:      strings.TrimSpace(price.OKATO),
+			RegionName: strings.TrimSpace(price.RegionName),
+			Price:      price.Price,
+		})
+	}
+
+	return prices, nil
+}
+
+func (c *Client) getJSON(ctx context.Context, path string, query url.Values, dst any) error {
+	requestURL := c.options.BaseURL + path
+	if len(query) > 0 {
+		requestURL += "?" + query.Encode()
+	}
+
+	var lastErr error
+	for attempt := 0; attempt <= c.options.MaxRetries; attempt++ {
+		if attempt > 0 {
+			backoff := c.options.RetryBackoff * time.Duration(1<<(attempt-1))
+			c.logger.Warnf("Retrying %s in %v (attempt %d/%d): %v", requestURL, backoff, attempt, c.options.MaxRetries, lastErr)
+
+			timer := time.NewTimer(backoff)
+			select {
+			case <-ctx.Done():
+				timer.Stop()
+				return ctx.Err()
+			case <-timer.C:
+			}
+		}
+
+		if err := c.limiter.Wait(ctx); err != nil {
+			return err
+		}
+
+		lastErr = c.doJSON(ctx, requestURL, dst)
+		if lastErr == nil || !isRetryable(lastErr) || ctx.Err() != nil {
+			return lastErr
+		}
+	}
+
+	return fmt.Errorf("request %s failed after %d retries: %w", requestURL, c.options.MaxRetries, lastErr)
+}
+
+func (c *Client) doJSON(ctx context.Context, requestURL string, dst any) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, requestURL, nil)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Accept", "application/json")
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		io.Copy(io.Discard, resp.Body)
+		return &statusError{statusCode: resp.StatusCode, url: requestURL}
+	}
+
+	if err := json.NewDecoder(resp.Body).Decode(dst); err != nil {
+		return fmt.Errorf("decode response from %s: %w", requestURL, err)
+	}
+
+	return nil
+}
+
+// isRetryable - повторяем сетевые ошибки, 429 и ответы 5xx
+func isRetryable(err error) bool {
+	var statusErr *statusError
+	if errors.As(err, &statusErr) {
+		return statusErr.statusCode == http.StatusTooManyRequests || statusErr.statusCode >= http.StatusInternalServerError
+	}
+
+	if errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+		return false
+	}
+
+	var urlErr *url.Error
+	return errors.As(err, &urlErr)
+}
@@ -0,0 +1,15 @@
+// Package ktsr_client читает каталог КТРУ и региональные цены возмещения СФР.
+//
+// Публичный сайт ktsr.sfr.gov.ru не публикует документированного API, а HTML-страницы
+// каталога клиент не разбирает. Клиент работает с JSON-источником по адресу PARSER_BASE_URL,
+// который должен реализовать следующий контракт:
+//
+//	GET {CatalogPath}?page=N&size=M
+//	{"page": N, "total_pages": T, "items": [{"code": "32.50.22.121-00000001", "name": "..."}]}
+//
+//	GET {PricesPath}, где %s - код КТРУ (экранируется как сегмент пути)
+//	{"code": "...", "prices": [{"region_code": "77", "okato": "45000000000", "region_name": "...", "price": 21500.50}]}
+//
+// Страницы нумеруются с 1. Сетевые ошибки, ответы 429 и 5xx повторяются, остальные прерывают обход.
+// Пример ответов - testdata парсера (internal/module/parser/service/testdata).
+package ktsr_client
@@ -0,0 +1,24 @@
+package ktsr_client
+
+type catalogPageResponse struct {
+	Page       int                   `json:"page"`
+	TotalPages int                   `json:"total_pages"`
+	Items      []catalogItemResponse `json:"items"`
+}
+
+type catalogItemResponse struct {
+	Code string `json:"code"`
+	Name string `json:"name"`
+}
+
+type pricesResponse struct {
+	Code   string          `json:"code"`
+	Prices []priceResponse `json:"prices"`
+}
+
+type priceResponse struct {
+	RegionCode string  `json:"region_code"`
+	OKATO      string  `json:"okato"`
+	RegionName string  `json:"region_name"`
+	Price      float64 `json:"price"`
+}
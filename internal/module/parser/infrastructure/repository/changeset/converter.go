@@ -0,0 +1,59 @@
+package changeset_repository
+
+import (
+	parser_entity "github.com/Fi44er/sdmed/internal/module/parser/entity"
+	parser_model "github.com/Fi44er/sdmed/internal/module/parser/infrastructure/repository/model"
+	"github.com/Fi44er/sdmed/pkg/utils"
+)
+
+type Converter struct{}
+
+func (c *Converter) ToModel(entity *parser_entity.Changeset) *parser_model.Changeset {
+	return &parser_model.Changeset{
+		ID:         entity.ID,
+		StartedAt:  entity.StartedAt,
+		FinishedAt: entity.FinishedAt,
+	}
+}
+
+func (c *Converter) ToEntity(model *parser_model.Changeset) *parser_entity.Changeset {
+	items := make([]parser_entity.ChangesetItem, len(model.Items))
+	for i := range model.Items {
+		items[i] = *c.ToItemEntity(&model.Items[i])
+	}
+
+	return &parser_entity.Changeset{
+		ID:         model.ID,
+		StartedAt:  model.StartedAt,
+		FinishedAt: model.FinishedAt,
+		Stats:      make(map[parser_entity.ChangeType]int),
+		Items:      items,
+	}
+}
+
+func (c *Converter) ToItemModel(entity *parser_entity.ChangesetItem) *parser_model.ChangesetItem {
+	return &parser_model.ChangesetItem{
+		ID:          entity.ID,
+		ChangesetID: entity.ChangesetID,
+		Type:        string(entity.Type),
+		TRUCodeID:   entity.TRUCodeID,
+		Code:        entity.Code,
+		RegionID:    utils.NilIfEmpty(entity.RegionID),
+		OldPrice:    entity.OldPrice,
+		NewPrice:    entity.NewPrice,
+	}
+}
+
+func (c *Converter) ToItemEntity(model *parser_model.ChangesetItem) *parser_entity.ChangesetItem {
+	return &parser_entity.ChangesetItem{
+		ID:          model.ID,
+		ChangesetID: model.ChangesetID,
+		Type:        parser_entity.ChangeType(model.Type),
+		TRUCodeID:   model.TRUCodeID,
+		Code:        model.Code,
+		RegionID:    utils.Deref(model.RegionID),
+		OldPrice:    model.OldPrice,
+		NewPrice:    model.NewPrice,
+		CreatedAt:   model.CreatedAt,
+	}
+}
@@ -0,0 +1,165 @@
+package changeset_repository
+
+import (
+	"context"
+	"time"
+
+	parser_entity "github.com/Fi44er/sdmed/internal/module/parser/entity"
+	parser_model "github.com/Fi44er/sdmed/internal/module/parser/infrastructure/repository/model"
+	"github.com/Fi44er/sdmed/pkg/logger"
+	"gorm.io/gorm"
+)
+
+type IChangesetRepository interface {
+	Create(ctx context.Context, changeset *parser_entity.Changeset) error
+	AddItems(ctx context.Context, items []parser_entity.ChangesetItem) error
+	Finish(ctx context.Context, id string, finishedAt time.Time) error
+	GetByID(ctx context.Context, id string) (*parser_entity.Changeset, error)
+	GetAll(ctx context.Context, offset, limit int) ([]parser_entity.Changeset, error)
+	Count(ctx context.Context) (int64, error)
+}
+
+type ChangesetRepository struct {
+	logger    *logger.Logger
+	db        *gorm.DB
+	converter *Converter
+}
+
+func NewChangesetRepository(logger *logger.Logger, db *gorm.DB) IChangesetRepository {
+	return &ChangesetRepository{
+		logger:    logger,
+		db:        db,
+		converter: &Converter{},
+	}
+}
+
+func (r *ChangesetRepository) Create(ctx context.Context, changeset *parser_entity.Changeset) error {
+	r.logger.Infof("Creating changeset started at %v", changeset.StartedAt)
+
+	changesetModel := r.converter.ToModel(changeset)
+	if err := r.db.WithContext(ctx).Create(changesetModel).Error; err != nil {
+		r.logger.Errorf("Failed to create changeset: %v", err)
+		return err
+	}
+	changeset.ID = changesetModel.ID
+
+	return nil
+}
+
+func (r *ChangesetRepository) AddItems(ctx context.Context, items []parser_entity.ChangesetItem) error {
+	if len(items) == 0 {
+		return nil
+	}
+	r.logger.Debugf("Adding %d items to changeset %s", len(items), items[0].ChangesetID)
+
+	itemModels := make([]parser_model.ChangesetItem, len(items))
+	for i := range items {
+		itemModels[i] = *r.converter.ToItemModel(&items[i])
+	}
+
+	if err := r.db.WithContext(ctx).Create(&itemModels).Error; err != nil {
+		r.logger.Errorf("Failed to add changeset items: %v", err)
+		return err
+	}
+
+	for i := range items {
+		items[i].ID = itemModels[i].ID
+	}
+
+	return nil
+}
+
+func (r *ChangesetRepository) Finish(ctx context.Context, id string, finishedAt time.Time) error {
+	r.logger.Infof("Finishing changeset %s", id)
+
+	if err := r.db.WithContext(ctx).Model(&parser_model.Changeset{}).Where("id = ?", id).Update("finished_at", finishedAt).Error; err != nil {
+		r.logger.Errorf("Failed to finish changeset %s: %v", id, err)
+		return err
+	}
+
+	return nil
+}
+
+func (r *ChangesetRepository) GetByID(ctx context.Context, id string) (*parser_entity.Changeset, error) {
+	r.logger.Debugf("Getting changeset: %s", id)
+
+	var changesetModel parser_model.Changeset
+	err := r.db.WithContext(ctx).
+		Preload("Items", func(db *gorm.DB) *gorm.DB {
+			return db.Order("code ASC, type ASC")
+		}).
+		First(&changesetModel, "id = ?", id).Error
+	if err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, nil
+		}
+		r.logger.Errorf("Failed to get changeset %s: %v", id, err)
+		return nil, err
+	}
+
+	changeset := r.converter.ToEntity(&changesetModel)
+	for _, item := range changeset.Items {
+		changeset.Stats[item.Type]++
+	}
+
+	return changeset, nil
+}
+
+// GetAll возвращает обходы без самих изменений, только со статистикой по типам
+func (r *ChangesetRepository) GetAll(ctx context.Context, offset, limit int) ([]parser_entity.Changeset, error) {
+	r.logger.Debugf("Getting changesets (offset: %d, limit: %d)", offset, limit)
+
+	var changesetModels []parser_model.Changeset
+	if err := r.db.WithContext(ctx).Order("started_at DESC").Offset(offset).Limit(limit).Find(&changesetModels).Error; err != nil {
+		r.logger.Errorf("Failed to get changesets: %v", err)
+		return nil, err
+	}
+
+	changesets := make([]parser_entity.Changeset, len(changesetModels))
+	ids := make([]string, len(changesetModels))
+	for i := range changesetModels {
+		changesets[i] = *r.converter.ToEntity(&changesetModels[i])
+		ids[i] = changesetModels[i].ID
+	}
+
+	if len(ids) == 0 {
+		return changesets, nil
+	}
+
+	var stats []struct {
+		ChangesetID string
+		Type        string
+		Count       int
+	}
+	err := r.db.WithContext(ctx).
+		Model(&parser_model.ChangesetItem{}).
+		Select("changeset_id, type, COUNT(*) AS count").
+		Where("changeset_id IN ?", ids).
+		Group("changeset_id, type").
+		Scan(&stats).Error
+	if err != nil {
+		r.logger.Errorf("Failed to get changeset stats: %v", err)
+		return nil, err
+	}
+
+	byID := make(map[string]*parser_entity.Changeset, len(changesets))
+	for i := range changesets {
+		byID[changesets[i].ID] = &changesets[i]
+	}
+	for _, stat := range stats {
+		if changeset, ok := byID[stat.ChangesetID]; ok {
+			changeset.Stats[parser_entity.ChangeType(stat.Type)] = stat.Count
+		}
+	}
+
+	return changesets, nil
+}
+
+func (r *ChangesetRepository) Count(ctx context.Context) (int64, error) {
+	var count int64
+	if err := r.db.WithContext(ctx).Model(&parser_model.Changeset{}).Count(&count).Error; err != nil {
+		r.logger.Errorf("Failed to count changesets: %v", err)
+		return 0, err
+	}
+	return count, nil
+}
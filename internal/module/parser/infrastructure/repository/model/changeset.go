@@ -0,0 +1,31 @@
+package parser_model
+
+import "time"
+
+type Changeset struct {
+	ID         string          `gorm:"primaryKey;type:uuid;default:uuid_generate_v4();"`
+	StartedAt  time.Time       `gorm:"not null"`
+	FinishedAt *time.Time      `gorm:""`
+	Items      []ChangesetItem `gorm:"foreignKey:ChangesetID;constraint:OnDelete:CASCADE"`
+	CreatedAt  time.Time       `gorm:"not null;default:now()"`
+}
+
+func (Changeset) TableName() string {
+	return "parser_module.changesets"
+}
+
+type ChangesetItem struct {
+	ID          string    `gorm:"primaryKey;type:uuid;default:uuid_generate_v4();"`
+	ChangesetID string    `gorm:"type:uuid;not null;index"`
+	Type        string    `gorm:"type:varchar(20);not null;index"`
+	TRUCodeID   string    `gorm:"type:uuid;not null;index"`
+	Code        string    `gorm:"type:varchar(30);not null"`
+	RegionID    *string   `gorm:"type:uuid"`
+	OldPrice    *float64  `gorm:"type:float"`
+	NewPrice    *float64  `gorm:"type:float"`
+	CreatedAt   time.Time `gorm:"not null;default:now()"`
+}
+
+func (ChangesetItem) TableName() string {
+	return "parser_module.changeset_items"
+}
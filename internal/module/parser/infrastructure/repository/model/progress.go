@@ -0,0 +1,20 @@
+package parser_model
+
+import "time"
+
+type ParserProgress struct {
+	Name           string     `gorm:"primaryKey;type:varchar(100)"`
+	ChangesetID    *string    `gorm:"type:uuid"`
+	Status         string     `gorm:"type:varchar(20);not null"`
+	LastPage       int        `gorm:"not null;default:0"`
+	TotalPages     int        `gorm:"not null;default:0"`
+	ProcessedCodes int        `gorm:"not null;default:0"`
+	LastError      string     `gorm:"type:text;not null;default:''"`
+	StartedAt      time.Time  `gorm:"not null"`
+	FinishedAt     *time.Time `gorm:""`
+	UpdatedAt      time.Time  `gorm:"not null;default:now()"`
+}
+
+func (ParserProgress) TableName() string {
+	return "parser_module.parser_progress"
+}
@@ -0,0 +1,38 @@
+package progress_repository
+
+import (
+	parser_entity "github.com/Fi44er/sdmed/internal/module/parser/entity"
+	parser_model "github.com/Fi44er/sdmed/internal/module/parser/infrastructure/repository/model"
+	"github.com/Fi44er/sdmed/pkg/utils"
+)
+
+type Converter struct{}
+
+func (c *Converter) ToModel(entity *parser_entity.Progress) *parser_model.ParserProgress {
+	return &parser_model.ParserProgress{
+		Name:           entity.Name,
+		ChangesetID:    utils.NilIfEmpty(entity.ChangesetID),
+		Status:         string(entity.Status),
+		LastPage:       entity.LastPage,
+		TotalPages:     entity.TotalPages,
+		ProcessedCodes: entity.ProcessedCodes,
+		LastError:      entity.LastError,
+		StartedAt:      entity.StartedAt,
+		FinishedAt:     entity.FinishedAt,
+	}
+}
+
+func (c *Converter) ToEntity(model *parser_model.ParserProgress) *parser_entity.Progress {
+	return &parser_entity.Progress{
+		Name:           model.Name,
+		ChangesetID:    utils.Deref(model.ChangesetID),
+		Status:         parser_entity.ProgressStatus(model.Status),
+		LastPage:       model.LastPage,
+		TotalPages:     model.TotalPages,
+		ProcessedCodes: model.ProcessedCodes,
+		LastError:      model.LastError,
+		StartedAt:      model.StartedAt,
+		FinishedAt:     model.FinishedAt,
+		UpdatedAt:      model.UpdatedAt,
+	}
+}
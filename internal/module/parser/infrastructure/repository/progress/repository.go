@@ -0,0 +1,58 @@
+package progress_repository
+
+import (
+	"context"
+
+	parser_entity "github.com/Fi44er/sdmed/internal/module/parser/entity"
+	parser_model "github.com/Fi44er/sdmed/internal/module/parser/infrastructure/repository/model"
+	"github.com/Fi44er/sdmed/pkg/logger"
+	"gorm.io/gorm"
+)
+
+type IProgressRepository interface {
+	Get(ctx context.Context, name string) (*parser_entity.Progress, error)
+	Save(ctx context.Context, progress *parser_entity.Progress) error
+}
+
+type ProgressRepository struct {
+	logger    *logger.Logger
+	db        *gorm.DB
+	converter *Converter
+}
+
+func NewProgressRepository(logger *logger.Logger, db *gorm.DB) IProgressRepository {
+	return &ProgressRepository{
+		logger:    logger,
+		db:        db,
+		converter: &Converter{},
+	}
+}
+
+func (r *ProgressRepository) Get(ctx context.Context, name string) (*parser_entity.Progress, error) {
+	r.logger.Debugf("Getting parser progress: %s", name)
+
+	var progressModel parser_model.ParserProgress
+	if err := r.db.WithContext(ctx).First(&progressModel, "name = ?", name).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, nil
+		}
+		r.logger.Errorf("Failed to get parser progress %s: %v", name, err)
+		return nil, err
+	}
+
+	return r.converter.ToEntity(&progressModel), nil
+}
+
+// Save создает или перезаписывает состояние обхода
+func (r *ProgressRepository) Save(ctx context.Context, progress *parser_entity.Progress) error {
+	r.logger.Debugf("Saving parser progress %s: page %d/%d (%s)", progress.Name, progress.LastPage, progress.TotalPages, progress.Status)
+
+	progressModel := r.converter.ToModel(progress)
+	if err := r.db.WithContext(ctx).Save(progressModel).Error; err != nil {
+		r.logger.Errorf("Failed to save parser progress %s: %v", progress.Name, err)
+		return err
+	}
+	progress.UpdatedAt = progressModel.UpdatedAt
+
+	return nil
+}
@@ -1 +1,98 @@
 package parser_module
+
+import (
+	"github.com/Fi44er/sdmed/internal/config"
+	parser_http "github.com/Fi44er/sdmed/internal/module/parser/delivery/http"
+	parser_adapters "github.com/Fi44er/sdmed/internal/module/parser/infrastructure/adapters"
+	ktsr_client "github.com/Fi44er/sdmed/internal/module/parser/infrastructure/client/ktsr"
+	changeset_repository "github.com/Fi44er/sdmed/internal/module/parser/infrastructure/repository/changeset"
+	progress_repository "github.com/Fi44er/sdmed/internal/module/parser/infrastructure/repository/progress"
+	parser_pkg "github.com/Fi44er/sdmed/internal/module/parser/pkg"
+	parser_service "github.com/Fi44er/sdmed/internal/module/parser/service"
+	changeset_usecase "github.com/Fi44er/sdmed/internal/module/parser/usecase/changeset"
+	product_usecase "github.com/Fi44er/sdmed/internal/module/product/usecase/product"
+	region_usecase "github.com/Fi44er/sdmed/internal/module/region/usecase/region"
+	tru_usecase "github.com/Fi44er/sdmed/internal/module/tru/usecase/tru"
+	"github.com/Fi44er/sdmed/pkg/logger"
+	"github.com/gofiber/fiber/v2"
+	"gorm.io/gorm"
+)
+
+type ParserModule struct {
+	progressRepository progress_repository.IProgressRepository
+	client             *ktsr_client.Client
+	parser             *parser_service.Parser
+
+	changesetRepository changeset_repository.IChangesetRepository
+	changesetUsecase    changeset_usecase.IChangesetUsecase
+	parserHandler       *parser_http.ParserHandler
+
+	truUsecase     tru_usecase.ITRUUsecase
+	regionUsecase  region_usecase.IRegionUsecase
+	productUsecase product_usecase.IProductUsecase
+
+	logger *logger.Logger
+	db     *gorm.DB
+	config *config.Config
+}
+
+func NewParserModule(
+	logger *logger.Logger,
+	db *gorm.DB,
+	config *config.Config,
+	truUsecase tru_usecase.ITRUUsecase,
+	regionUsecase region_usecase.IRegionUsecase,
+	productUsecase product_usecase.IProductUsecase,
+) *ParserModule {
+	return &ParserModule{
+		logger:         logger,
+		db:             db,
+		config:         config,
+		truUsecase:     truUsecase,
+		regionUsecase:  regionUsecase,
+		productUsecase: productUsecase,
+	}
+}
+
+func (m *ParserModule) Init() {
+	truUsecaseAdapter := parser_adapters.NewTRUUsecaseAdapter(m.truUsecase)
+
+	m.progressRepository = progress_repository.NewProgressRepository(m.logger, m.db)
+	m.changesetRepository = changeset_repository.NewChangesetRepository(m.logger, m.db)
+	m.client = ktsr_client.NewClient(m.logger, ktsr_client.Options{
+		BaseURL:         m.config.ParserBaseURL,
+		PageSize:        m.config.ParserPageSize,
+		RequestInterval: m.config.ParserRequestInterval,
+		MaxRetries:      m.config.ParserMaxRetries,
+		RetryBackoff:    parser_pkg.DefaultRetryBackoff,
+	})
+	m.parser = parser_service.NewParser(
+		m.client,
+		m.progressRepository,
+		m.changesetRepository,
+		truUsecaseAdapter,
+		parser_adapters.NewRegionUsecaseAdapter(m.regionUsecase),
+		m.logger,
+		m.config.ParserInterval,
+	)
+
+	m.changesetUsecase = changeset_usecase.NewChangesetUsecase(
+		m.changesetRepository,
+		truUsecaseAdapter,
+		parser_adapters.NewProductUsecaseAdapter(m.productUsecase),
+		m.logger,
+	)
+	m.parserHandler = parser_http.NewParserHandler(m.changesetUsecase, m.logger)
+}
+
+func (m *ParserModule) InitDelivery(router fiber.Router) {
+	m.parserHandler.RegisterRoutes(router)
+}
+
+// GetParser возвращает процесс парсера, если он включен в конфигурации
+func (m *ParserModule) GetParser() *parser_service.Parser {
+	if !m.config.ParserEnabled {
+		return nil
+	}
+	return m.parser
+}
@@ -1,5 +1,30 @@
 package parser_pkg
 
+import (
+	"time"
+
+	"github.com/Fi44er/sdmed/pkg/customerr"
+)
+
 const (
 	MainURL = "https://ktsr.sfr.gov.ru"
+
+	// CatalogPath - постраничный список кодов КТРУ каталога, контракт описан в пакете ktsr_client
+	CatalogPath = "/api/public/v1/tru"
+	// PricesPath - региональные цены возмещения по коду, %s - код КТРУ
+	PricesPath = "/api/public/v1/tru/%s/prices"
+
+	ProcessName = "ktsr_parser"
+
+	DefaultPageSize        = 100
+	DefaultRequestInterval = time.Second
+	DefaultMaxRetries      = 3
+	DefaultRetryBackoff    = 2 * time.Second
+	DefaultRequestTimeout  = 30 * time.Second
+	DefaultInterval        = 24 * time.Hour
+)
+
+var (
+	ErrChangesetNotFound  = customerr.NewError(404, "changeset not found")
+	ErrCustomCodeConflict = customerr.NewError(409, "catalogue code is registered as custom")
 )
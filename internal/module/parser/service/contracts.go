@@ -0,0 +1,34 @@
+package parser_service
+
+import (
+	"context"
+	"time"
+
+	parser_entity "github.com/Fi44er/sdmed/internal/module/parser/entity"
+)
+
+type ICatalogClient interface {
+	GetPage(ctx context.Context, page int) (*parser_entity.CatalogPage, error)
+	GetPrices(ctx context.Context, code string) ([]parser_entity.CatalogPrice, error)
+}
+
+type IProgressRepository interface {
+	Get(ctx context.Context, name string) (*parser_entity.Progress, error)
+	Save(ctx context.Context, progress *parser_entity.Progress) error
+}
+
+type IChangesetRepository interface {
+	Create(ctx context.Context, changeset *parser_entity.Changeset) error
+	AddItems(ctx context.Context, items []parser_entity.ChangesetItem) error
+	Finish(ctx context.Context, id string, finishedAt time.Time) error
+}
+
+type ITRUUsecaseAdapter interface {
+	GetCode(ctx context.Context, code string) (*parser_entity.StoredCode, error)
+	SaveCode(ctx context.Context, code *parser_entity.CatalogCode) error
+	GetStaleCodes(ctx context.Context, since time.Time) ([]parser_entity.CatalogCode, error)
+}
+
+type IRegionUsecaseAdapter interface {
+	GetRegions(ctx context.Context) ([]parser_entity.Region, error)
+}
@@ -0,0 +1,64 @@
+package parser_service
+
+import (
+	"sort"
+
+	parser_entity "github.com/Fi44er/sdmed/internal/module/parser/entity"
+)
+
+// priceEpsilon - цены сравниваются с точностью до копейки
+const priceEpsilon = 0.005
+
+// diffCode сравнивает код из каталога с сохраненным до обхода.
+// Для нового кода фиксируется только его появление, цены без регионов не учитываются.
+func diffCode(changesetID string, stored *parser_entity.StoredCode, parsed *parser_entity.CatalogCode) []parser_entity.ChangesetItem {
+	newItem := func(changeType parser_entity.ChangeType, regionID string, oldPrice, newPrice *float64) parser_entity.ChangesetItem {
+		return parser_entity.ChangesetItem{
+			ChangesetID: changesetID,
+			Type:        changeType,
+			TRUCodeID:   parsed.TRUCodeID,
+			Code:        parsed.Code,
+			RegionID:    regionID,
+			OldPrice:    oldPrice,
+			NewPrice:    newPrice,
+		}
+	}
+
+	if stored == nil {
+		return []parser_entity.ChangesetItem{newItem(parser_entity.ChangeTypeCodeAdded, "", nil, nil)}
+	}
+
+	items := make([]parser_entity.ChangesetItem, 0)
+	seen := make(map[string]struct{}, len(parsed.Prices))
+	for _, price := range parsed.Prices {
+		if price.RegionID == "" {
+			continue
+		}
+		seen[price.RegionID] = struct{}{}
+		newPrice := price.Price
+
+		oldPrice, ok := stored.Prices[price.RegionID]
+		switch {
+		case !ok:
+			items = append(items, newItem(parser_entity.ChangeTypePriceAdded, price.RegionID, nil, &newPrice))
+		case newPrice-oldPrice > priceEpsilon:
+			items = append(items, newItem(parser_entity.ChangeTypePriceUp, price.RegionID, &oldPrice, &newPrice))
+		case oldPrice-newPrice > priceEpsilon:
+			items = append(items, newItem(parser_entity.ChangeTypePriceDown, price.RegionID, &oldPrice, &newPrice))
+		}
+	}
+
+	removed := make([]string, 0)
+	for regionID := range stored.Prices {
+		if _, ok := seen[regionID]; !ok {
+			removed = append(removed, regionID)
+		}
+	}
+	sort.Strings(removed)
+	for _, regionID := range removed {
+		oldPrice := stored.Prices[regionID]
+		items = append(items, newItem(parser_entity.ChangeTypePriceRemoved, regionID, &oldPrice, nil))
+	}
+
+	return items
+}
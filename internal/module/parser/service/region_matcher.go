@@ -0,0 +1,61 @@
+package parser_service
+
+import (
+	"strings"
+
+	parser_entity "github.com/Fi44er/sdmed/internal/module/parser/entity"
+)
+
+// okatoRegionPrefixLen - первые две цифры ОКАТО обозначают субъект
+const okatoRegionPrefixLen = 2
+
+// regionMatcher сопоставляет регион каталога с нашим справочником по коду субъекта или ОКАТО.
+// ОКАТО сравнивается по самому длинному префиксу: автономные округа (например, 71100 - Югра)
+// вложены в ОКАТО области (71 - Тюменская область).
+type regionMatcher struct {
+	byCode  map[string]string
+	byOKATO map[string]string
+}
+
+func newRegionMatcher(regions []parser_entity.Region) *regionMatcher {
+	matcher := &regionMatcher{
+		byCode:  make(map[string]string, len(regions)),
+		byOKATO: make(map[string]string, len(regions)),
+	}
+
+	for _, region := range regions {
+		if region.Code != "" {
+			matcher.byCode[region.Code] = region.ID
+		}
+		if okato := trimOKATO(region.OKATO); len(okato) >= okatoRegionPrefixLen {
+			matcher.byOKATO[okato] = region.ID
+		}
+	}
+
+	return matcher
+}
+
+func (m *regionMatcher) match(price parser_entity.CatalogPrice) string {
+	if id, ok := m.byCode[price.RegionCode]; ok {
+		return id
+	}
+
+	okato := trimOKATO(price.OKATO)
+	for length := len(okato); length >= okatoRegionPrefixLen; length-- {
+		if id, ok := m.byOKATO[okato[:length]]; ok {
+			return id
+		}
+	}
+
+	return ""
+}
+
+// trimOKATO отбрасывает незначащие нули, оставляя не меньше кода субъекта
+func trimOKATO(okato string) string {
+	okato = strings.TrimSpace(okato)
+	trimmed := strings.TrimRight(okato, "0")
+	if len(trimmed) < okatoRegionPrefixLen && len(okato) >= okatoRegionPrefixLen {
+		return okato[:okatoRegionPrefixLen]
+	}
+	return trimmed
+}
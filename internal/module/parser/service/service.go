@@ -1,3 +1,288 @@
 package parser_service
 
-// логика парсера
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	parser_entity "github.com/Fi44er/sdmed/internal/module/parser/entity"
+	parser_pkg "github.com/Fi44er/sdmed/internal/module/parser/pkg"
+	"github.com/Fi44er/sdmed/pkg/logger"
+)
+
+// Parser - фоновый процесс обхода каталога ktsr.sfr.gov.ru.
+// Коды и региональные цены сохраняются постранично, после каждой страницы
+// фиксируется прогресс, поэтому прерванный обход продолжается с того же места.
+// Отличия от сохраненных данных записываются в changeset обхода.
+type Parser struct {
+	client              ICatalogClient
+	progressRepository  IProgressRepository
+	changesetRepository IChangesetRepository
+	truUsecase          ITRUUsecaseAdapter
+	regionUsecase       IRegionUsecaseAdapter
+	logger              *logger.Logger
+	interval            time.Duration
+
+	stopCh  chan struct{}
+	doneCh  chan struct{}
+	cancel  context.CancelFunc
+	running bool
+	mutex   sync.RWMutex
+}
+
+func NewParser(
+	client ICatalogClient,
+	progressRepository IProgressRepository,
+	changesetRepository IChangesetRepository,
+	truUsecase ITRUUsecaseAdapter,
+	regionUsecase IRegionUsecaseAdapter,
+	logger *logger.Logger,
+	interval time.Duration,
+) *Parser {
+	if interval <= 0 {
+		interval = parser_pkg.DefaultInterval
+	}
+
+	return &Parser{
+		client:              client,
+		progressRepository:  progressRepository,
+		changesetRepository: changesetRepository,
+		truUsecase:          truUsecase,
+		regionUsecase:       regionUsecase,
+		logger:              logger,
+		interval:            interval,
+	}
+}
+
+func (p *Parser) Name() string {
+	return parser_pkg.ProcessName
+}
+
+func (p *Parser) Start() {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+
+	if p.running {
+		p.logger.Warn("Catalogue parser is already running")
+		return
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	p.stopCh = make(chan struct{})
+	p.doneCh = make(chan struct{})
+	p.cancel = cancel
+	p.running = true
+
+	go func() {
+		defer close(p.doneCh)
+
+		p.logger.Infof("Catalogue parser started with interval: %v", p.interval)
+		ticker := time.NewTicker(p.interval)
+		defer ticker.Stop()
+
+		p.runScheduled(ctx)
+		for {
+			select {
+			case <-ticker.C:
+				p.runScheduled(ctx)
+			case <-p.stopCh:
+				p.mutex.Lock()
+				p.running = false
+				p.mutex.Unlock()
+				p.logger.Info("Catalogue parser stopped")
+				return
+			}
+		}
+	}()
+}
+
+func (p *Parser) Stop(ctx context.Context) error {
+	p.mutex.Lock()
+	if !p.running {
+		p.mutex.Unlock()
+		return nil
+	}
+	p.cancel()
+	close(p.stopCh)
+	doneCh := p.doneCh
+	p.mutex.Unlock()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-doneCh:
+		return nil
+	}
+}
+
+func (p *Parser) IsRunning() bool {
+	p.mutex.RLock()
+	defer p.mutex.RUnlock()
+	return p.running
+}
+
+func (p *Parser) runScheduled(ctx context.Context) {
+	if err := p.Run(ctx); err != nil {
+		p.logger.Errorf("Catalogue parsing failed: %v", err)
+	}
+}
+
+// Run выполняет один обход каталога, продолжая незавершенный запуск, если он есть
+func (p *Parser) Run(ctx context.Context) error {
+	progress, err := p.progressRepository.Get(ctx, parser_pkg.ProcessName)
+	if err != nil {
+		return err
+	}
+
+	if progress.IsResumable() {
+		p.logger.Infof("Resuming catalogue parsing after page %d", progress.LastPage)
+		progress.LastError = ""
+	} else {
+		progress = &parser_entity.Progress{
+			Name:      parser_pkg.ProcessName,
+			StartedAt: time.Now(),
+		}
+		p.logger.Info("Starting catalogue parsing")
+	}
+	progress.Status = parser_entity.ProgressStatusRunning
+	progress.FinishedAt = nil
+
+	if progress.ChangesetID == "" {
+		changeset := &parser_entity.Changeset{StartedAt: progress.StartedAt}
+		if err := p.changesetRepository.Create(ctx, changeset); err != nil {
+			return err
+		}
+		progress.ChangesetID = changeset.ID
+	}
+
+	if err := p.progressRepository.Save(ctx, progress); err != nil {
+		return err
+	}
+
+	if err := p.crawl(ctx, progress); err != nil {
+		progress.Status = parser_entity.ProgressStatusFailed
+		progress.LastError = err.Error()
+
+		// контекст обхода может быть уже отменен, прогресс сохраняем в любом случае
+		if saveErr := p.progressRepository.Save(context.Background(), progress); saveErr != nil {
+			p.logger.Errorf("Failed to save parser progress: %v", saveErr)
+		}
+		return err
+	}
+
+	finishedAt := time.Now()
+	if err := p.changesetRepository.Finish(ctx, progress.ChangesetID, finishedAt); err != nil {
+		return err
+	}
+
+	progress.Status = parser_entity.ProgressStatusCompleted
+	progress.FinishedAt = &finishedAt
+	if err := p.progressRepository.Save(ctx, progress); err != nil {
+		return err
+	}
+
+	p.logger.Infof("Catalogue parsing completed: %d codes from %d pages", progress.ProcessedCodes, progress.TotalPages)
+	return nil
+}
+
+func (p *Parser) crawl(ctx context.Context, progress *parser_entity.Progress) error {
+	regions, err := p.regionUsecase.GetRegions(ctx)
+	if err != nil {
+		return fmt.Errorf("get regions: %w", err)
+	}
+	matcher := newRegionMatcher(regions)
+
+	for page := progress.LastPage + 1; ; page++ {
+		catalogPage, err := p.client.GetPage(ctx, page)
+		if err != nil {
+			return fmt.Errorf("get catalogue page %d: %w", page, err)
+		}
+
+		for i := range catalogPage.Codes {
+			code := &catalogPage.Codes[i]
+
+			prices, err := p.client.GetPrices(ctx, code.Code)
+			if err != nil {
+				return fmt.Errorf("get prices for %s: %w", code.Code, err)
+			}
+
+			for j := range prices {
+				prices[j].RegionID = matcher.match(prices[j])
+				if prices[j].RegionID == "" {
+					p.logger.Warnf("Unknown region in catalogue for %s: %s (code %s, OKATO %s)",
+						code.Code, prices[j].RegionName, prices[j].RegionCode, prices[j].OKATO)
+				}
+			}
+			code.Prices = prices
+
+			stored, err := p.truUsecase.GetCode(ctx, code.Code)
+			if err != nil {
+				return fmt.Errorf("get stored tru code %s: %w", code.Code, err)
+			}
+
+			if err := p.truUsecase.SaveCode(ctx, code); err != nil {
+				if !errors.Is(err, parser_pkg.ErrCustomCodeConflict) {
+					return fmt.Errorf("save tru code %s: %w", code.Code, err)
+				}
+
+				p.logger.Warnf("Catalogue code %s is registered as custom, skipped until merged", code.Code)
+				conflict := parser_entity.ChangesetItem{
+					ChangesetID: progress.ChangesetID,
+					Type:        parser_entity.ChangeTypeCodeConflict,
+					Code:        code.Code,
+				}
+				if stored != nil {
+					conflict.TRUCodeID = stored.ID
+				}
+				if err := p.changesetRepository.AddItems(ctx, []parser_entity.ChangesetItem{conflict}); err != nil {
+					return fmt.Errorf("save conflict of tru code %s: %w", code.Code, err)
+				}
+				continue
+			}
+
+			if err := p.changesetRepository.AddItems(ctx, diffCode(progress.ChangesetID, stored, code)); err != nil {
+				return fmt.Errorf("save changes of tru code %s: %w", code.Code, err)
+			}
+		}
+
+		progress.LastPage = page
+		progress.TotalPages = catalogPage.TotalPages
+		progress.ProcessedCodes += len(catalogPage.Codes)
+		if err := p.progressRepository.Save(ctx, progress); err != nil {
+			return err
+		}
+
+		p.logger.Debugf("Catalogue page %d/%d parsed (%d codes)", page, catalogPage.TotalPages, len(catalogPage.Codes))
+
+		if page >= catalogPage.TotalPages || len(catalogPage.Codes) == 0 {
+			return p.detectRemovedCodes(ctx, progress)
+		}
+	}
+}
+
+// detectRemovedCodes отмечает официальные коды, которые не обновлялись за время обхода,
+// то есть пропали из каталога. Коды не удаляются, чтобы не терять привязки товаров.
+func (p *Parser) detectRemovedCodes(ctx context.Context, progress *parser_entity.Progress) error {
+	staleCodes, err := p.truUsecase.GetStaleCodes(ctx, progress.StartedAt)
+	if err != nil {
+		return fmt.Errorf("get removed codes: %w", err)
+	}
+
+	items := make([]parser_entity.ChangesetItem, len(staleCodes))
+	for i, code := range staleCodes {
+		items[i] = parser_entity.ChangesetItem{
+			ChangesetID: progress.ChangesetID,
+			Type:        parser_entity.ChangeTypeCodeRemoved,
+			TRUCodeID:   code.TRUCodeID,
+			Code:        code.Code,
+		}
+	}
+
+	if len(items) > 0 {
+		p.logger.Infof("%d tru codes are missing from the catalogue", len(items))
+	}
+
+	return p.changesetRepository.AddItems(ctx, items)
+}
@@ -0,0 +1,340 @@
+package parser_service_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	parser_entity "github.com/Fi44er/sdmed/internal/module/parser/entity"
+	ktsr_client "github.com/Fi44er/sdmed/internal/module/parser/infrastructure/client/ktsr"
+	parser_pkg "github.com/Fi44er/sdmed/internal/module/parser/pkg"
+	parser_service "github.com/Fi44er/sdmed/internal/module/parser/service"
+	"github.com/Fi44er/sdmed/pkg/logger"
+	"github.com/stretchr/testify/suite"
+)
+
+// catalogStub отдает записанные ответы каталога из testdata и позволяет имитировать сбои
+type catalogStub struct {
+	mutex    sync.Mutex
+	requests []string
+	failures map[string]int
+}
+
+func (s *catalogStub) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	s.mutex.Lock()
+	key := r.URL.Path
+	if page := r.URL.Query().Get("page"); page != "" {
+		key += "?page=" + page
+	}
+	s.requests = append(s.requests, key)
+	failures := s.failures[key]
+	if failures > 0 {
+		s.failures[key] = failures - 1
+	}
+	s.mutex.Unlock()
+
+	if failures != 0 {
+		w.WriteHeader(http.StatusServiceUnavailable)
+		return
+	}
+
+	var fixture string
+	switch {
+	case r.URL.Path == parser_pkg.CatalogPath:
+		fixture = "catalog_page_" + r.URL.Query().Get("page") + ".json"
+	case strings.HasSuffix(r.URL.Path, "/prices"):
+		code := strings.TrimSuffix(strings.TrimPrefix(r.URL.Path, parser_pkg.CatalogPath+"/"), "/prices")
+		fixture = "prices_" + code + ".json"
+	}
+
+	data, err := os.ReadFile(filepath.Join("testdata", fixture))
+	if err != nil {
+		w.WriteHeader(http.StatusNotFound)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.Write(data)
+}
+
+func (s *catalogStub) requested(key string) int {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	count := 0
+	for _, request := range s.requests {
+		if request == key {
+			count++
+		}
+	}
+	return count
+}
+
+type progressRepositoryFake struct {
+	progress *parser_entity.Progress
+}
+
+func (r *progressRepositoryFake) Get(ctx context.Context, name string) (*parser_entity.Progress, error) {
+	if r.progress == nil {
+		return nil, nil
+	}
+	progress := *r.progress
+	return &progress, nil
+}
+
+func (r *progressRepositoryFake) Save(ctx context.Context, progress *parser_entity.Progress) error {
+	saved := *progress
+	r.progress = &saved
+	return nil
+}
+
+type changesetRepositoryFake struct {
+	changesets map[string]*parser_entity.Changeset
+}
+
+func (r *changesetRepositoryFake) Create(ctx context.Context, changeset *parser_entity.Changeset) error {
+	changeset.ID = "changeset-" + strconv.Itoa(len(r.changesets)+1)
+	r.changesets[changeset.ID] = changeset
+	return nil
+}
+
+func (r *changesetRepositoryFake) AddItems(ctx context.Context, items []parser_entity.ChangesetItem) error {
+	for _, item := range items {
+		changeset := r.changesets[item.ChangesetID]
+		changeset.Items = append(changeset.Items, item)
+	}
+	return nil
+}
+
+func (r *changesetRepositoryFake) Finish(ctx context.Context, id string, finishedAt time.Time) error {
+	r.changesets[id].FinishedAt = &finishedAt
+	return nil
+}
+
+// truUsecaseFake хранит коды как таблица КТРУ: сохраненные до обхода и обновленные им
+type truUsecaseFake struct {
+	codes  map[string]parser_entity.CatalogCode
+	stored map[string]*parser_entity.StoredCode
+	custom map[string]bool
+}
+
+func (u *truUsecaseFake) GetCode(ctx context.Context, code string) (*parser_entity.StoredCode, error) {
+	return u.stored[code], nil
+}
+
+func (u *truUsecaseFake) SaveCode(ctx context.Context, code *parser_entity.CatalogCode) error {
+	if u.custom[code.Code] {
+		return parser_pkg.ErrCustomCodeConflict
+	}
+	code.TRUCodeID = "id-" + code.Code
+
+	prices := make(map[string]float64)
+	for _, price := range code.Prices {
+		if price.RegionID != "" {
+			prices[price.RegionID] = price.Price
+		}
+	}
+	u.stored[code.Code] = &parser_entity.StoredCode{ID: code.TRUCodeID, Prices: prices}
+	u.codes[code.Code] = *code
+	return nil
+}
+
+func (u *truUsecaseFake) GetStaleCodes(ctx context.Context, since time.Time) ([]parser_entity.CatalogCode, error) {
+	stale := make([]parser_entity.CatalogCode, 0)
+	for code, storedCode := range u.stored {
+		if _, ok := u.codes[code]; !ok {
+			stale = append(stale, parser_entity.CatalogCode{TRUCodeID: storedCode.ID, Code: code})
+		}
+	}
+	return stale, nil
+}
+
+type regionUsecaseFake struct{}
+
+func (u *regionUsecaseFake) GetRegions(ctx context.Context) ([]parser_entity.Region, error) {
+	return []parser_entity.Region{
+		{ID: "region-moscow", Code: "77", OKATO: "45000000000"},
+		{ID: "region-tyumen", Code: "72", OKATO: "71000000000"},
+		{ID: "region-ugra", Code: "86", OKATO: "71100000000"},
+	}, nil
+}
+
+type ParserTestSuite struct {
+	suite.Suite
+	ctx        context.Context
+	stub       *catalogStub
+	server     *httptest.Server
+	progress   *progressRepositoryFake
+	changesets *changesetRepositoryFake
+	tru        *truUsecaseFake
+	parser     *parser_service.Parser
+}
+
+func (s *ParserTestSuite) SetupTest() {
+	s.ctx = context.Background()
+	s.stub = &catalogStub{failures: make(map[string]int)}
+	s.server = httptest.NewServer(s.stub)
+	s.progress = &progressRepositoryFake{}
+	s.changesets = &changesetRepositoryFake{changesets: make(map[string]*parser_entity.Changeset)}
+	s.tru = &truUsecaseFake{
+		codes:  make(map[string]parser_entity.CatalogCode),
+		stored: make(map[string]*parser_entity.StoredCode),
+		custom: make(map[string]bool),
+	}
+
+	log := logger.NewLogger()
+	client := ktsr_client.NewClient(log, ktsr_client.Options{
+		BaseURL:         s.server.URL,
+		PageSize:        2,
+		RequestInterval: time.Millisecond,
+		MaxRetries:      2,
+		RetryBackoff:    time.Millisecond,
+	})
+	s.parser = parser_service.NewParser(client, s.progress, s.changesets, s.tru, &regionUsecaseFake{}, log, time.Hour)
+}
+
+func (s *ParserTestSuite) TearDownTest() {
+	s.server.Close()
+}
+
+func (s *ParserTestSuite) TestRun() {
+	s.Run("parses all pages and maps regions", func() {
+		s.Require().NoError(s.parser.Run(s.ctx))
+
+		s.Len(s.tru.codes, 3)
+
+		wheelchair := s.tru.codes["32.50.22.121-00000001"]
+		s.Equal("Кресло-коляска с ручным приводом комнатная", wheelchair.Name)
+		s.Require().Len(wheelchair.Prices, 2)
+		s.Equal("region-moscow", wheelchair.Prices[0].RegionID)
+		s.Equal(21500.50, wheelchair.Prices[0].Price)
+		s.Equal("region-ugra", wheelchair.Prices[1].RegionID, "autonomous okrug must win over the enclosing oblast")
+
+		unknown := s.tru.codes["32.50.22.121-00000002"].Prices[1]
+		s.Empty(unknown.RegionID)
+
+		s.Equal("region-tyumen", s.tru.codes["32.50.13.190-00000003"].Prices[0].RegionID)
+
+		s.Equal(parser_entity.ProgressStatusCompleted, s.progress.progress.Status)
+		s.Equal(2, s.progress.progress.LastPage)
+		s.Equal(3, s.progress.progress.ProcessedCodes)
+		s.NotNil(s.progress.progress.FinishedAt)
+	})
+}
+
+func (s *ParserTestSuite) TestChangeset() {
+	s.tru.stored["32.50.22.121-00000001"] = &parser_entity.StoredCode{
+		ID: "id-32.50.22.121-00000001",
+		Prices: map[string]float64{
+			"region-moscow": 20000,
+			"region-ugra":   19800,
+			"region-tyumen": 500,
+		},
+	}
+	s.tru.stored["32.50.22.121-00000002"] = &parser_entity.StoredCode{
+		ID:     "id-32.50.22.121-00000002",
+		Prices: map[string]float64{"region-moscow": 25000},
+	}
+	s.tru.stored["32.50.99.000-00000099"] = &parser_entity.StoredCode{ID: "id-removed"}
+
+	s.Require().NoError(s.parser.Run(s.ctx))
+
+	changeset := s.changesets.changesets[s.progress.progress.ChangesetID]
+	s.Require().NotNil(changeset)
+	s.NotNil(changeset.FinishedAt)
+
+	changes := make(map[string]parser_entity.ChangesetItem)
+	for _, item := range changeset.Items {
+		changes[item.Code+"/"+item.RegionID+"/"+string(item.Type)] = item
+	}
+	s.Len(changes, 5)
+
+	up := changes["32.50.22.121-00000001/region-moscow/price_up"]
+	s.Equal(20000.0, *up.OldPrice)
+	s.Equal(21500.50, *up.NewPrice)
+	s.Contains(changes, "32.50.22.121-00000001/region-tyumen/price_removed")
+	s.Contains(changes, "32.50.22.121-00000002/region-moscow/price_down")
+	s.Contains(changes, "32.50.13.190-00000003//code_added")
+	s.Equal("id-removed", changes["32.50.99.000-00000099//code_removed"].TRUCodeID)
+}
+
+func (s *ParserTestSuite) TestCustomCodeConflict() {
+	s.tru.stored["32.50.22.121-00000002"] = &parser_entity.StoredCode{ID: "id-custom"}
+	s.tru.custom["32.50.22.121-00000002"] = true
+
+	s.Require().NoError(s.parser.Run(s.ctx))
+
+	s.Len(s.tru.codes, 2)
+	s.NotContains(s.tru.codes, "32.50.22.121-00000002")
+	s.Equal(parser_entity.ProgressStatusCompleted, s.progress.progress.Status)
+
+	changeset := s.changesets.changesets[s.progress.progress.ChangesetID]
+	s.Require().NotNil(changeset)
+
+	var conflicts []parser_entity.ChangesetItem
+	for _, item := range changeset.Items {
+		if item.Type == parser_entity.ChangeTypeCodeConflict {
+			conflicts = append(conflicts, item)
+		}
+	}
+	s.Require().Len(conflicts, 1)
+	s.Equal("32.50.22.121-00000002", conflicts[0].Code)
+	s.Equal("id-custom", conflicts[0].TRUCodeID)
+}
+
+func (s *ParserTestSuite) TestRetry() {
+	s.stub.failures[parser_pkg.CatalogPath+"?page=1"] = 2
+
+	s.Require().NoError(s.parser.Run(s.ctx))
+
+	s.Equal(3, s.stub.requested(parser_pkg.CatalogPath+"?page=1"))
+	s.Len(s.tru.codes, 3)
+}
+
+func (s *ParserTestSuite) TestResume() {
+	s.stub.failures[parser_pkg.CatalogPath+"?page=2"] = 3
+
+	err := s.parser.Run(s.ctx)
+	s.Require().Error(err)
+	s.Equal(parser_entity.ProgressStatusFailed, s.progress.progress.Status)
+	s.Equal(1, s.progress.progress.LastPage)
+	s.NotEmpty(s.progress.progress.LastError)
+	s.Len(s.tru.codes, 2)
+
+	s.Require().NoError(s.parser.Run(s.ctx))
+
+	s.Equal(1, s.stub.requested(parser_pkg.CatalogPath+"?page=1"), "first page must not be parsed again")
+	s.Equal(parser_entity.ProgressStatusCompleted, s.progress.progress.Status)
+	s.Equal(3, s.progress.progress.ProcessedCodes)
+	s.Len(s.tru.codes, 3)
+}
+
+func (s *ParserTestSuite) TestNewRunAfterCompleted() {
+	s.Require().NoError(s.parser.Run(s.ctx))
+	s.Require().NoError(s.parser.Run(s.ctx))
+
+	s.Equal(2, s.stub.requested(parser_pkg.CatalogPath+"?page=1"))
+	s.Equal(3, s.progress.progress.ProcessedCodes)
+}
+
+func (s *ParserTestSuite) TestStartStop() {
+	s.parser.Start()
+	s.True(s.parser.IsRunning())
+
+	ctx, cancel := context.WithTimeout(s.ctx, 5*time.Second)
+	defer cancel()
+
+	s.Require().NoError(s.parser.Stop(ctx))
+	s.False(s.parser.IsRunning())
+}
+
+func TestParser(t *testing.T) {
+	suite.Run(t, new(ParserTestSuite))
+}
@@ -0,0 +1,22 @@
+package changeset_usecase_contracts
+
+import (
+	"context"
+
+	parser_entity "github.com/Fi44er/sdmed/internal/module/parser/entity"
+)
+
+type IChangesetRepository interface {
+	GetByID(ctx context.Context, id string) (*parser_entity.Changeset, error)
+	GetAll(ctx context.Context, offset, limit int) ([]parser_entity.Changeset, error)
+	Count(ctx context.Context) (int64, error)
+}
+
+type ITRUUsecaseAdapter interface {
+	GetProductIDsByCodes(ctx context.Context, truCodeIDs []string) (map[string][]string, error)
+}
+
+type IProductUsecaseAdapter interface {
+	GetByIDs(ctx context.Context, ids []string) ([]parser_entity.AffectedProduct, error)
+	GetCoverage(ctx context.Context, productID, regionID string) (*parser_entity.Coverage, error)
+}
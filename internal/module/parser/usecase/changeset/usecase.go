@@ -0,0 +1,135 @@
+package changeset_usecase
+
+import (
+	"context"
+
+	parser_entity "github.com/Fi44er/sdmed/internal/module/parser/entity"
+	parser_pkg "github.com/Fi44er/sdmed/internal/module/parser/pkg"
+	changeset_usecase_contracts "github.com/Fi44er/sdmed/internal/module/parser/usecase/changeset/contracts"
+	"github.com/Fi44er/sdmed/pkg/logger"
+	"github.com/Fi44er/sdmed/pkg/utils"
+)
+
+type IChangesetUsecase interface {
+	GetAll(ctx context.Context, page, pageSize int) ([]parser_entity.Changeset, int64, error)
+	GetReport(ctx context.Context, id string) (*parser_entity.ChangesetReport, error)
+}
+
+type ChangesetUsecase struct {
+	repository     changeset_usecase_contracts.IChangesetRepository
+	truUsecase     changeset_usecase_contracts.ITRUUsecaseAdapter
+	productUsecase changeset_usecase_contracts.IProductUsecaseAdapter
+	logger         *logger.Logger
+}
+
+func NewChangesetUsecase(
+	repository changeset_usecase_contracts.IChangesetRepository,
+	truUsecase changeset_usecase_contracts.ITRUUsecaseAdapter,
+	productUsecase changeset_usecase_contracts.IProductUsecaseAdapter,
+	logger *logger.Logger,
+) IChangesetUsecase {
+	return &ChangesetUsecase{
+		repository:     repository,
+		truUsecase:     truUsecase,
+		productUsecase: productUsecase,
+		logger:         logger,
+	}
+}
+
+func (u *ChangesetUsecase) GetAll(ctx context.Context, page, pageSize int) ([]parser_entity.Changeset, int64, error) {
+	u.logger.Debugf("Getting changesets (page: %d, pageSize: %d)", page, pageSize)
+
+	offset, limit := utils.SafeCalculateForPostgres(page, pageSize)
+	changesets, err := u.repository.GetAll(ctx, offset, limit)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	count, err := u.repository.Count(ctx)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return changesets, count, nil
+}
+
+// GetReport собирает товары, привязанные к измененным кодам.
+// Для изменений цены в регионе покрытие сертификатом считается по текущим ценам.
+func (u *ChangesetUsecase) GetReport(ctx context.Context, id string) (*parser_entity.ChangesetReport, error) {
+	u.logger.Debugf("Building report for changeset %s", id)
+
+	changeset, err := u.repository.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if changeset == nil {
+		return nil, parser_pkg.ErrChangesetNotFound
+	}
+
+	report := &parser_entity.ChangesetReport{
+		Changeset: *changeset,
+		Products:  make([]parser_entity.AffectedProduct, 0),
+	}
+
+	codeIDs := make([]string, 0)
+	seenCode := make(map[string]struct{})
+	for _, item := range changeset.Items {
+		if _, ok := seenCode[item.TRUCodeID]; !ok {
+			seenCode[item.TRUCodeID] = struct{}{}
+			codeIDs = append(codeIDs, item.TRUCodeID)
+		}
+	}
+
+	productIDsByCode, err := u.truUsecase.GetProductIDsByCodes(ctx, codeIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	productIDs := make([]string, 0)
+	seenProduct := make(map[string]struct{})
+	for _, ids := range productIDsByCode {
+		for _, productID := range ids {
+			if _, ok := seenProduct[productID]; !ok {
+				seenProduct[productID] = struct{}{}
+				productIDs = append(productIDs, productID)
+			}
+		}
+	}
+
+	if len(productIDs) == 0 {
+		return report, nil
+	}
+
+	products, err := u.productUsecase.GetByIDs(ctx, productIDs)
+	if err != nil {
+		return nil, err
+	}
+	productByID := make(map[string]parser_entity.AffectedProduct, len(products))
+	for _, product := range products {
+		productByID[product.ProductID] = product
+	}
+
+	for _, item := range changeset.Items {
+		for _, productID := range productIDsByCode[item.TRUCodeID] {
+			affected, ok := productByID[productID]
+			if !ok {
+				continue
+			}
+			affected.Item = item
+
+			if item.RegionID != "" {
+				coverage, err := u.productUsecase.GetCoverage(ctx, productID, item.RegionID)
+				if err != nil {
+					u.logger.Warnf("Failed to calculate coverage for product %s in region %s: %v", productID, item.RegionID, err)
+				} else {
+					affected.Coverage = coverage
+				}
+			}
+
+			report.Products = append(report.Products, affected)
+		}
+	}
+
+	u.logger.Debugf("Changeset %s affects %d product positions", id, len(report.Products))
+	return report, nil
+}
@@ -0,0 +1,74 @@
+package payment_http
+
+import (
+	"net/url"
+
+	payment_dto "github.com/Fi44er/sdmed/internal/module/payment/dto"
+	payment_entity "github.com/Fi44er/sdmed/internal/module/payment/entity"
+	"github.com/Fi44er/sdmed/internal/module/payment/gateway"
+	"github.com/gofiber/fiber/v2"
+)
+
+type Converter struct{}
+
+func (c *Converter) ToPayment(dto *payment_dto.CreatePaymentRequest) *payment_entity.Payment {
+	return &payment_entity.Payment{
+		OrderID:  dto.OrderID,
+		Provider: dto.Provider,
+	}
+}
+
+func (c *Converter) ToRefund(dto *payment_dto.RefundRequest) *payment_entity.Payment {
+	return &payment_entity.Payment{
+		ID:             dto.ID,
+		RefundedAmount: dto.Amount,
+	}
+}
+
+func (c *Converter) ToPaymentResponse(entity *payment_entity.Payment) *payment_dto.PaymentResponse {
+	paymentURL := ""
+	if entity.Status == payment_entity.PaymentStatusPending {
+		paymentURL = entity.PaymentURL
+	}
+
+	return &payment_dto.PaymentResponse{
+		ID:                entity.ID,
+		OrderID:           entity.OrderID,
+		Provider:          entity.Provider,
+		Status:            string(entity.Status),
+		Amount:            entity.Amount,
+		RefundedAmount:    entity.RefundedAmount,
+		PaymentURL:        paymentURL,
+		ExternalID:        entity.ExternalID,
+		ProviderPaymentID: entity.ProviderPaymentID,
+		FailureReason:     entity.FailureReason,
+		PaidAt:            entity.PaidAt,
+		CreatedAt:         entity.CreatedAt,
+	}
+}
+
+func (c *Converter) ToPaymentResponses(entities []payment_entity.Payment) []payment_dto.PaymentResponse {
+	result := make([]payment_dto.PaymentResponse, len(entities))
+	for i := range entities {
+		result[i] = *c.ToPaymentResponse(&entities[i])
+	}
+	return result
+}
+
+func (c *Converter) ToWebhookRequest(ctx *fiber.Ctx) *gateway.WebhookRequest {
+	form := url.Values{}
+	ctx.Request().PostArgs().VisitAll(func(key, value []byte) {
+		form.Add(string(key), string(value))
+	})
+
+	headers := make(map[string]string)
+	ctx.Request().Header.VisitAll(func(key, value []byte) {
+		headers[string(key)] = string(value)
+	})
+
+	return &gateway.WebhookRequest{
+		Headers: headers,
+		Form:    form,
+		Body:    append([]byte(nil), ctx.Body()...),
+	}
+}
@@ -0,0 +1,229 @@
+package payment_http
+
+import (
+	"context"
+
+	payment_dto "github.com/Fi44er/sdmed/internal/module/payment/dto"
+	payment_entity "github.com/Fi44er/sdmed/internal/module/payment/entity"
+	"github.com/Fi44er/sdmed/internal/module/payment/gateway"
+	"github.com/Fi44er/sdmed/pkg/logger"
+	_ "github.com/Fi44er/sdmed/pkg/response"
+	"github.com/Fi44er/sdmed/pkg/session"
+	"github.com/Fi44er/sdmed/pkg/utils"
+	"github.com/go-playground/validator/v10"
+	"github.com/gofiber/fiber/v2"
+)
+
+type IPaymentUsecase interface {
+	Create(ctx context.Context, orderID, provider string) (*payment_entity.Payment, error)
+	GetOwn(ctx context.Context, id string) (*payment_entity.Payment, error)
+	HandleWebhook(ctx context.Context, provider string, request *gateway.WebhookRequest) (string, error)
+
+	GetByOrderID(ctx context.Context, orderID string) ([]payment_entity.Payment, error)
+	Capture(ctx context.Context, id string) (*payment_entity.Payment, error)
+	Refund(ctx context.Context, id string, amount float64, items []payment_entity.RefundItem) (*payment_entity.Payment, error)
+}
+
+type PaymentHandler struct {
+	usecase IPaymentUsecase
+
+	validator *validator.Validate
+	logger    *logger.Logger
+	converter *Converter
+}
+
+func NewPaymentHandler(
+	usecase IPaymentUsecase,
+	validator *validator.Validate,
+	logger *logger.Logger,
+) *PaymentHandler {
+	return &PaymentHandler{
+		usecase:   usecase,
+		validator: validator,
+		logger:    logger,
+		converter: &Converter{},
+	}
+}
+
+// Create godoc
+// @Summary Pay for an order
+// @Description Create a payment for an own order through the chosen gateway (paykeeper by default). The customer is redirected to payment_url
+// @Tags payments
+// @Accept json
+// @Produce json
+// @Param request body payment_dto.CreatePaymentRequest true "Order"
+// @Success 201 {object} response.ResponseData{data=payment_dto.PaymentResponse} "Created"
+// @Failure 400 {object} response.Response "Invalid request or unknown provider"
+// @Failure 404 {object} response.Response "Order not found"
+// @Failure 409 {object} response.Response "Order cannot be paid"
+// @Failure 502 {object} response.Response "Payment provider error"
+// @Router /payments [post]
+func (h *PaymentHandler) Create(ctx *fiber.Ctx) error {
+	dto := new(payment_dto.CreatePaymentRequest)
+
+	request, err := utils.ParseAndValidate(ctx, dto, h.validator, h.converter.ToPayment, h.logger)
+	if err != nil {
+		return ctx.Status(400).JSON(fiber.Map{
+			"status":  "fail",
+			"message": err.Error(),
+		})
+	}
+
+	payment, err := h.usecase.Create(h.getCtxWithSession(ctx), request.OrderID, request.Provider)
+	if err != nil {
+		return err
+	}
+
+	return ctx.Status(201).JSON(fiber.Map{
+		"status": "success",
+		"data":   h.converter.ToPaymentResponse(payment),
+	})
+}
+
+// GetOwn godoc
+// @Summary Get payment status
+// @Description Status of an own payment. A pending payment is checked against its gateway
+// @Tags payments
+// @Produce json
+// @Param id path string true "Payment ID"
+// @Success 200 {object} response.ResponseData{data=payment_dto.PaymentResponse} "OK"
+// @Failure 404 {object} response.Response "Payment not found"
+// @Failure 500 {object} response.Response "Error"
+// @Router /payments/{id} [get]
+func (h *PaymentHandler) GetOwn(ctx *fiber.Ctx) error {
+	payment, err := h.usecase.GetOwn(h.getCtxWithSession(ctx), ctx.Params("id"))
+	if err != nil {
+		return err
+	}
+
+	return ctx.Status(200).JSON(fiber.Map{
+		"status": "success",
+		"data":   h.converter.ToPaymentResponse(payment),
+	})
+}
+
+// Webhook godoc
+// @Summary Payment gateway notification
+// @Description Callback called by a payment gateway when a payment status changes. The request is verified by the gateway, the response body is what the gateway expects
+// @Tags payments
+// @Accept x-www-form-urlencoded
+// @Produce plain
+// @Param provider path string true "Gateway name, e.g. paykeeper"
+// @Success 200 {string} string "Gateway specific acknowledgement"
+// @Failure 400 {object} response.Response "Unknown provider or amount mismatch"
+// @Failure 403 {object} response.Response "Invalid signature"
+// @Failure 404 {object} response.Response "Payment not found"
+// @Router /payments/{provider}/callback [post]
+func (h *PaymentHandler) Webhook(ctx *fiber.Ctx) error {
+	request := h.converter.ToWebhookRequest(ctx)
+
+	answer, err := h.usecase.HandleWebhook(ctx.Context(), ctx.Params("provider"), request)
+	if err != nil {
+		return err
+	}
+
+	return ctx.Status(200).SendString(answer)
+}
+
+// GetByOrderID godoc
+// @Summary Get order payments
+// @Description All payment attempts of an order, newest first
+// @Tags payments-admin
+// @Produce json
+// @Param order_id query string true "Order ID"
+// @Success 200 {object} response.ResponseData{data=[]payment_dto.PaymentResponse} "OK"
+// @Failure 400 {object} response.Response "Invalid request"
+// @Failure 404 {object} response.Response "Order not found"
+// @Router /admin/payments [get]
+func (h *PaymentHandler) GetByOrderID(ctx *fiber.Ctx) error {
+	params := new(payment_dto.PaymentQueryParams)
+	if err := ctx.QueryParser(params); err != nil {
+		h.logger.Errorf("Failed to parse query params: %v", err)
+		return fiber.NewError(fiber.StatusBadRequest, "Invalid query parameters")
+	}
+	if err := h.validator.Struct(params); err != nil {
+		return ctx.Status(400).JSON(fiber.Map{
+			"status":  "fail",
+			"message": err.Error(),
+		})
+	}
+
+	payments, err := h.usecase.GetByOrderID(ctx.Context(), params.OrderID)
+	if err != nil {
+		return err
+	}
+
+	return ctx.Status(200).JSON(fiber.Map{
+		"status": "success",
+		"data":   h.converter.ToPaymentResponses(payments),
+	})
+}
+
+// Capture godoc
+// @Summary Capture an authorized payment
+// @Description Charge funds held by a two-stage payment
+// @Tags payments-admin
+// @Produce json
+// @Param id path string true "Payment ID"
+// @Success 200 {object} response.ResponseData{data=payment_dto.PaymentResponse} "OK"
+// @Failure 404 {object} response.Response "Payment not found"
+// @Failure 409 {object} response.Response "Payment is not authorized"
+// @Failure 502 {object} response.Response "Payment provider error"
+// @Router /admin/payments/{id}/capture [post]
+func (h *PaymentHandler) Capture(ctx *fiber.Ctx) error {
+	payment, err := h.usecase.Capture(ctx.Context(), ctx.Params("id"))
+	if err != nil {
+		return err
+	}
+
+	return ctx.Status(200).JSON(fiber.Map{
+		"status": "success",
+		"data":   h.converter.ToPaymentResponse(payment),
+	})
+}
+
+// Refund godoc
+// @Summary Refund a payment
+// @Description Full or partial refund through the payment gateway. Zero amount refunds the remaining sum
+// @Tags payments-admin
+// @Accept json
+// @Produce json
+// @Param id path string true "Payment ID"
+// @Param request body payment_dto.RefundRequest true "Refund amount"
+// @Success 200 {object} response.ResponseData{data=payment_dto.PaymentResponse} "OK"
+// @Failure 400 {object} response.Response "Invalid amount"
+// @Failure 404 {object} response.Response "Payment not found"
+// @Failure 409 {object} response.Response "Payment is not paid"
+// @Failure 502 {object} response.Response "Payment provider error"
+// @Router /admin/payments/{id}/refund [post]
+func (h *PaymentHandler) Refund(ctx *fiber.Ctx) error {
+	dto := new(payment_dto.RefundRequest)
+	dto.ID = ctx.Params("id")
+
+	refund, err := utils.ParseAndValidate(ctx, dto, h.validator, h.converter.ToRefund, h.logger)
+	if err != nil {
+		return ctx.Status(400).JSON(fiber.Map{
+			"status":  "fail",
+			"message": err.Error(),
+		})
+	}
+
+	payment, err := h.usecase.Refund(ctx.Context(), refund.ID, refund.RefundedAmount, nil)
+	if err != nil {
+		return err
+	}
+
+	return ctx.Status(200).JSON(fiber.Map{
+		"status": "success",
+		"data":   h.converter.ToPaymentResponse(payment),
+	})
+}
+
+func (h *PaymentHandler) getCtxWithSession(ctx *fiber.Ctx) context.Context {
+	sess := session.FromFiberContext(ctx)
+	if sess == nil {
+		return ctx.Context()
+	}
+
+	return context.WithValue(ctx.Context(), "session", *sess)
+}
@@ -0,0 +1,18 @@
+package payment_http
+
+import (
+	"github.com/Fi44er/sdmed/internal/middlewares"
+	"github.com/gofiber/fiber/v2"
+)
+
+func (h *PaymentHandler) RegisterRoutes(router fiber.Router) {
+	payments := router.Group("/payments")
+	payments.Post("/", h.Create)
+	payments.Post("/:provider/callback", h.Webhook)
+	payments.Get("/:id", h.GetOwn)
+
+	admin := router.Group("/admin/payments", middlewares.Authorize("payment", "read"))
+	admin.Get("/", h.GetByOrderID)
+	admin.Post("/:id/capture", middlewares.Authorize("payment", "capture"), h.Capture)
+	admin.Post("/:id/refund", middlewares.Authorize("payment", "refund"), h.Refund)
+}
@@ -0,0 +1,32 @@
+package payment_dto
+
+import "time"
+
+type CreatePaymentRequest struct {
+	OrderID  string `json:"order_id" validate:"required,uuid"`
+	Provider string `json:"provider" validate:"omitempty,max=50"`
+}
+
+type RefundRequest struct {
+	ID     string  `json:"-" validate:"required"`
+	Amount float64 `json:"amount" validate:"gte=0"`
+}
+
+type PaymentQueryParams struct {
+	OrderID string `query:"order_id" validate:"required,uuid"`
+}
+
+type PaymentResponse struct {
+	ID                string     `json:"id"`
+	OrderID           string     `json:"order_id"`
+	Provider          string     `json:"provider"`
+	Status            string     `json:"status"`
+	Amount            float64    `json:"amount"`
+	RefundedAmount    float64    `json:"refunded_amount"`
+	PaymentURL        string     `json:"payment_url,omitempty"`
+	ExternalID        string     `json:"external_id,omitempty"`
+	ProviderPaymentID string     `json:"provider_payment_id,omitempty"`
+	FailureReason     string     `json:"failure_reason,omitempty"`
+	PaidAt            *time.Time `json:"paid_at,omitempty"`
+	CreatedAt         time.Time  `json:"created_at"`
+}
@@ -0,0 +1,70 @@
+package payment_entity
+
+import (
+	"math"
+	"time"
+)
+
+type PaymentStatus string
+
+const (
+	PaymentStatusPending PaymentStatus = "pending"
+	// PaymentStatusAuthorized - средства заблокированы шлюзом и ждут списания
+	PaymentStatusAuthorized PaymentStatus = "authorized"
+	PaymentStatusPaid       PaymentStatus = "paid"
+	PaymentStatusExpired    PaymentStatus = "expired"
+	// PaymentStatusFailed - шлюз отклонил платеж или не смог его создать
+	PaymentStatusFailed   PaymentStatus = "failed"
+	PaymentStatusRefunded PaymentStatus = "refunded"
+)
+
+// Payment - попытка оплаты заказа через платежный шлюз. На один заказ может быть
+// несколько попыток, оплаченной считается не более одной. ExternalID - идентификатор
+// платежа (счета) в шлюзе, ProviderPaymentID - идентификатор проведенной транзакции
+type Payment struct {
+	ID                string
+	OrderID           string
+	Provider          string
+	ExternalID        string
+	ProviderPaymentID string
+	Amount            float64
+	RefundedAmount    float64
+	Status            PaymentStatus
+	PaymentURL        string
+	FailureReason     string
+	PaidAt            *time.Time
+	CreatedAt         time.Time
+	UpdatedAt         time.Time
+}
+
+// IsActive - платеж еще может быть оплачен или списан
+func (p *Payment) IsActive() bool {
+	return p.Status == PaymentStatusPending || p.Status == PaymentStatusAuthorized
+}
+
+// Refundable - сумма, которую еще можно вернуть
+func (p *Payment) Refundable() float64 {
+	if p.Status != PaymentStatusPaid {
+		return 0
+	}
+	return math.Round((p.Amount-p.RefundedAmount)*100) / 100
+}
+
+// RefundItem - возвращаемая позиция заказа: количество и сумма возврата за него
+type RefundItem struct {
+	OrderItemID string
+	Quantity    int
+	Amount      float64
+}
+
+// PaymentOrder - данные заказа, нужные для оплаты. Total - сумма к оплате картой,
+// при оплате сертификатом это только доплата
+type PaymentOrder struct {
+	ID          string
+	UserID      string
+	Status      string
+	Total       float64
+	ContactName string
+	Email       string
+	Phone       string
+}
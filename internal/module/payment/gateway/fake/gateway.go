@@ -0,0 +1,201 @@
+package fake
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"sync"
+
+	"github.com/Fi44er/sdmed/internal/module/payment/gateway"
+)
+
+const Name = "fake"
+
+// Refund - возврат, принятый фейковым шлюзом
+type Refund struct {
+	ProviderPaymentID string
+	Amount            float64
+	Partial           bool
+}
+
+type payment struct {
+	request           gateway.PaymentRequest
+	providerPaymentID string
+	status            gateway.Status
+}
+
+// FakeGateway - шлюз в памяти для тестов. Платежи переводятся в нужный статус
+// вызовами Authorize и Pay, а Webhook строит соответствующее уведомление
+type FakeGateway struct {
+	mu       sync.Mutex
+	payments map[string]*payment
+	captures map[string]float64
+	refunds  []Refund
+	sequence int
+
+	// Err возвращается из всех операций шлюза, если задана
+	Err error
+}
+
+func NewFakeGateway() *FakeGateway {
+	return &FakeGateway{
+		payments: make(map[string]*payment),
+		captures: make(map[string]float64),
+	}
+}
+
+func (g *FakeGateway) CreatePayment(ctx context.Context, request *gateway.PaymentRequest) (*gateway.Payment, error) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	if g.Err != nil {
+		return nil, g.Err
+	}
+
+	g.sequence++
+	externalID := fmt.Sprintf("fake-%d", g.sequence)
+	g.payments[externalID] = &payment{request: *request, status: gateway.StatusPending}
+
+	return &gateway.Payment{
+		ExternalID:      externalID,
+		ConfirmationURL: "https://fake.local/pay/" + externalID,
+		Status:          gateway.StatusPending,
+	}, nil
+}
+
+func (g *FakeGateway) Capture(ctx context.Context, providerPaymentID string, amount float64) error {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	if g.Err != nil {
+		return g.Err
+	}
+
+	p := g.findByProviderID(providerPaymentID)
+	if p == nil || p.status != gateway.StatusAuthorized {
+		return fmt.Errorf("fake payment %s is not authorized", providerPaymentID)
+	}
+	p.status = gateway.StatusPaid
+	g.captures[providerPaymentID] = amount
+
+	return nil
+}
+
+func (g *FakeGateway) Refund(ctx context.Context, providerPaymentID string, amount float64, partial bool) error {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	if g.Err != nil {
+		return g.Err
+	}
+
+	g.refunds = append(g.refunds, Refund{ProviderPaymentID: providerPaymentID, Amount: amount, Partial: partial})
+	return nil
+}
+
+func (g *FakeGateway) GetStatus(ctx context.Context, externalID string) (*gateway.PaymentInfo, error) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	if g.Err != nil {
+		return nil, g.Err
+	}
+
+	p, ok := g.payments[externalID]
+	if !ok {
+		return nil, fmt.Errorf("fake payment %s not found", externalID)
+	}
+
+	return &gateway.PaymentInfo{
+		ExternalID:        externalID,
+		ProviderPaymentID: p.providerPaymentID,
+		Status:            p.status,
+		Amount:            p.request.Amount,
+	}, nil
+}
+
+// ParseWebhook принимает уведомления, построенные Webhook. Подпись - заголовок X-Fake-Signature
+func (g *FakeGateway) ParseWebhook(ctx context.Context, request *gateway.WebhookRequest) (*gateway.WebhookEvent, error) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	externalID := request.Form.Get("external_id")
+	p, ok := g.payments[externalID]
+	if !ok || request.Headers["X-Fake-Signature"] != signature(externalID) {
+		return nil, gateway.ErrInvalidSignature
+	}
+
+	return &gateway.WebhookEvent{
+		PaymentID:         p.request.PaymentID,
+		ProviderPaymentID: p.providerPaymentID,
+		Status:            p.status,
+		Amount:            p.request.Amount,
+		Response:          "OK",
+	}, nil
+}
+
+// Authorize блокирует средства по платежу, дальше нужен Capture
+func (g *FakeGateway) Authorize(externalID string) {
+	g.setStatus(externalID, gateway.StatusAuthorized)
+}
+
+// Pay отмечает платеж оплаченным, как если бы покупатель прошел оплату
+func (g *FakeGateway) Pay(externalID string) {
+	g.setStatus(externalID, gateway.StatusPaid)
+}
+
+// Decline отмечает платеж отклоненным банком
+func (g *FakeGateway) Decline(externalID string) {
+	g.setStatus(externalID, gateway.StatusFailed)
+}
+
+// Webhook - подписанное уведомление о текущем статусе платежа
+func (g *FakeGateway) Webhook(externalID string) *gateway.WebhookRequest {
+	form := url.Values{}
+	form.Set("external_id", externalID)
+
+	return &gateway.WebhookRequest{
+		Headers: map[string]string{"X-Fake-Signature": signature(externalID)},
+		Form:    form,
+	}
+}
+
+func (g *FakeGateway) Refunds() []Refund {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	return append([]Refund(nil), g.refunds...)
+}
+
+func (g *FakeGateway) Captured(providerPaymentID string) (float64, bool) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	amount, ok := g.captures[providerPaymentID]
+	return amount, ok
+}
+
+func (g *FakeGateway) setStatus(externalID string, status gateway.Status) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	if p, ok := g.payments[externalID]; ok {
+		p.status = status
+		if p.providerPaymentID == "" {
+			p.providerPaymentID = "txn-" + externalID
+		}
+	}
+}
+
+func (g *FakeGateway) findByProviderID(providerPaymentID string) *payment {
+	for _, p := range g.payments {
+		if p.providerPaymentID == providerPaymentID {
+			return p
+		}
+	}
+	return nil
+}
+
+func signature(externalID string) string {
+	return "signed:" + externalID
+}
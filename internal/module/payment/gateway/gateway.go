@@ -0,0 +1,107 @@
+package gateway
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"sort"
+)
+
+var (
+	ErrGatewayNotFound  = errors.New("payment gateway not found")
+	ErrInvalidSignature = errors.New("invalid webhook signature")
+	ErrNotSupported     = errors.New("operation is not supported by the gateway")
+)
+
+type Status string
+
+const (
+	StatusPending Status = "pending"
+	// StatusAuthorized - средства заблокированы и ждут списания через Capture
+	StatusAuthorized Status = "authorized"
+	StatusPaid       Status = "paid"
+	StatusExpired    Status = "expired"
+	StatusFailed     Status = "failed"
+)
+
+type Customer struct {
+	Name  string
+	Email string
+	Phone string
+}
+
+// PaymentRequest - создание платежа. PaymentID - идентификатор попытки оплаты
+// на нашей стороне, шлюз возвращает его в уведомлениях
+type PaymentRequest struct {
+	PaymentID   string
+	OrderID     string
+	Amount      float64
+	Description string
+	Customer    Customer
+}
+
+// Payment - платеж на стороне шлюза. ConfirmationURL - куда перенаправить покупателя
+type Payment struct {
+	ExternalID      string
+	ConfirmationURL string
+	Status          Status
+}
+
+type PaymentInfo struct {
+	ExternalID        string
+	ProviderPaymentID string
+	Status            Status
+	Amount            float64
+}
+
+type WebhookRequest struct {
+	Headers map[string]string
+	Form    url.Values
+	Body    []byte
+}
+
+// WebhookEvent - разобранное уведомление шлюза. Response - тело ответа,
+// которое шлюз ожидает в подтверждение приема
+type WebhookEvent struct {
+	PaymentID         string
+	ProviderPaymentID string
+	Status            Status
+	Amount            float64
+	Response          string
+}
+
+// Gateway - платежный шлюз: PayKeeper, СБП, счет для юрлиц
+type Gateway interface {
+	CreatePayment(ctx context.Context, request *PaymentRequest) (*Payment, error)
+	Capture(ctx context.Context, providerPaymentID string, amount float64) error
+	Refund(ctx context.Context, providerPaymentID string, amount float64, partial bool) error
+	GetStatus(ctx context.Context, externalID string) (*PaymentInfo, error)
+	ParseWebhook(ctx context.Context, request *WebhookRequest) (*WebhookEvent, error)
+}
+
+type Registry struct {
+	gateways map[string]Gateway
+}
+
+func NewRegistry(gateways map[string]Gateway) *Registry {
+	return &Registry{
+		gateways: gateways,
+	}
+}
+
+func (r *Registry) Get(name string) (Gateway, error) {
+	if gateway, ok := r.gateways[name]; ok {
+		return gateway, nil
+	}
+	return nil, fmt.Errorf("%w: %s", ErrGatewayNotFound, name)
+}
+
+func (r *Registry) Names() []string {
+	names := make([]string, 0, len(r.gateways))
+	for name := range r.gateways {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
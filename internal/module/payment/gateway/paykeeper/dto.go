@@ -0,0 +1,25 @@
+package paykeeper
+
+import "encoding/json"
+
+type tokenResponse struct {
+	Token string `json:"token"`
+}
+
+type invoiceResponse struct {
+	InvoiceID  string `json:"invoice_id"`
+	InvoiceURL string `json:"invoice_url"`
+}
+
+type invoiceInfoResponse struct {
+	ID        string      `json:"id"`
+	Status    string      `json:"status"`
+	PaymentID string      `json:"paymentid"`
+	PayAmount json.Number `json:"pay_amount"`
+}
+
+// resultResponse - PayKeeper сообщает об ошибках полем result=fail с описанием в msg
+type resultResponse struct {
+	Result string `json:"result"`
+	Msg    string `json:"msg"`
+}
@@ -0,0 +1,278 @@
+package paykeeper
+
+import (
+	"bytes"
+	"context"
+	"crypto/md5"
+	"crypto/subtle"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/Fi44er/sdmed/internal/module/payment/gateway"
+	"github.com/Fi44er/sdmed/pkg/logger"
+)
+
+const (
+	Name = "paykeeper"
+
+	// tokenPath - одноразовый токен, обязательный для изменяющих запросов
+	tokenPath = "/info/settings/token/"
+	// invoicePath - создание счета на оплату
+	invoicePath = "/change/invoice/preview/"
+	// invoiceStatusPath - данные счета по идентификатору
+	invoiceStatusPath = "/info/invoice/byid/"
+	// capturePath - списание заблокированных при двухстадийной оплате средств
+	capturePath = "/change/payment/capture/"
+	// refundPath - полный или частичный возврат платежа
+	refundPath = "/change/payment/reverse/"
+	// billPath - страница оплаты счета, %s - идентификатор счета
+	billPath = "/bill/%s/"
+
+	defaultRequestTimeout = 30 * time.Second
+)
+
+type Options struct {
+	Server  string
+	User    string
+	Pass    string
+	Secret  string
+	Timeout time.Duration
+}
+
+// PayKeeperGateway - шлюз PayKeeper поверх его JSON API
+type PayKeeperGateway struct {
+	httpClient *http.Client
+	options    Options
+	logger     *logger.Logger
+}
+
+func NewPayKeeperGateway(logger *logger.Logger, options Options) *PayKeeperGateway {
+	if options.Timeout <= 0 {
+		options.Timeout = defaultRequestTimeout
+	}
+	options.Server = strings.TrimRight(options.Server, "/")
+
+	return &PayKeeperGateway{
+		httpClient: &http.Client{Timeout: options.Timeout},
+		options:    options,
+		logger:     logger,
+	}
+}
+
+// CreatePayment выставляет счет. orderid у PayKeeper - идентификатор нашей попытки оплаты
+func (g *PayKeeperGateway) CreatePayment(ctx context.Context, request *gateway.PaymentRequest) (*gateway.Payment, error) {
+	token, err := g.getToken(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	form := url.Values{}
+	form.Set("pay_amount", formatAmount(request.Amount))
+	form.Set("orderid", request.PaymentID)
+	form.Set("clientid", request.Customer.Name)
+	form.Set("client_email", request.Customer.Email)
+	form.Set("client_phone", request.Customer.Phone)
+	form.Set("service_name", request.Description)
+	form.Set("token", token)
+
+	var response invoiceResponse
+	if err := g.post(ctx, invoicePath, form, &response); err != nil {
+		return nil, err
+	}
+	if response.InvoiceID == "" {
+		return nil, fmt.Errorf("paykeeper returned empty invoice id")
+	}
+
+	invoiceURL := response.InvoiceURL
+	if invoiceURL == "" {
+		invoiceURL = g.options.Server + fmt.Sprintf(billPath, url.PathEscape(response.InvoiceID))
+	}
+
+	return &gateway.Payment{
+		ExternalID:      response.InvoiceID,
+		ConfirmationURL: invoiceURL,
+		Status:          gateway.StatusPending,
+	}, nil
+}
+
+func (g *PayKeeperGateway) Capture(ctx context.Context, providerPaymentID string, amount float64) error {
+	token, err := g.getToken(ctx)
+	if err != nil {
+		return err
+	}
+
+	form := url.Values{}
+	form.Set("id", providerPaymentID)
+	form.Set("amount", formatAmount(amount))
+	form.Set("token", token)
+
+	var response resultResponse
+	return g.post(ctx, capturePath, form, &response)
+}
+
+// Refund возвращает amount по платежу. partial - возврат части суммы платежа
+func (g *PayKeeperGateway) Refund(ctx context.Context, providerPaymentID string, amount float64, partial bool) error {
+	token, err := g.getToken(ctx)
+	if err != nil {
+		return err
+	}
+
+	form := url.Values{}
+	form.Set("id", providerPaymentID)
+	form.Set("amount", formatAmount(amount))
+	form.Set("partial", strconv.FormatBool(partial))
+	form.Set("token", token)
+
+	var response resultResponse
+	return g.post(ctx, refundPath, form, &response)
+}
+
+func (g *PayKeeperGateway) GetStatus(ctx context.Context, externalID string) (*gateway.PaymentInfo, error) {
+	query := url.Values{}
+	query.Set("id", externalID)
+
+	var response invoiceInfoResponse
+	if err := g.get(ctx, invoiceStatusPath, query, &response); err != nil {
+		return nil, err
+	}
+
+	amount, _ := response.PayAmount.Float64()
+	return &gateway.PaymentInfo{
+		ExternalID:        response.ID,
+		ProviderPaymentID: response.PaymentID,
+		Status:            toStatus(response.Status),
+		Amount:            amount,
+	}, nil
+}
+
+// ParseWebhook проверяет подпись уведомления об оплате:
+// key = md5(id + sum + clientid + orderid + secret). Без секрета подпись можно подделать,
+// поэтому уведомления не принимаются
+func (g *PayKeeperGateway) ParseWebhook(ctx context.Context, request *gateway.WebhookRequest) (*gateway.WebhookEvent, error) {
+	if g.options.Secret == "" {
+		g.logger.Error("PayKeeper notification received, but notification secret is not configured")
+		return nil, gateway.ErrInvalidSignature
+	}
+
+	id, sum := request.Form.Get("id"), request.Form.Get("sum")
+	clientID, orderID := request.Form.Get("clientid"), request.Form.Get("orderid")
+
+	expected := md5Hex(id + sum + clientID + orderID + g.options.Secret)
+	if subtle.ConstantTimeCompare([]byte(expected), []byte(strings.ToLower(request.Form.Get("key")))) != 1 {
+		return nil, gateway.ErrInvalidSignature
+	}
+
+	amount, err := strconv.ParseFloat(sum, 64)
+	if err != nil {
+		return nil, fmt.Errorf("invalid paykeeper notification sum %q: %w", sum, err)
+	}
+
+	// без этого ответа PayKeeper будет повторять уведомление
+	return &gateway.WebhookEvent{
+		PaymentID:         orderID,
+		ProviderPaymentID: id,
+		Status:            gateway.StatusPaid,
+		Amount:            amount,
+		Response:          "OK " + md5Hex(id+g.options.Secret),
+	}, nil
+}
+
+func (g *PayKeeperGateway) getToken(ctx context.Context) (string, error) {
+	var response tokenResponse
+	if err := g.get(ctx, tokenPath, nil, &response); err != nil {
+		return "", err
+	}
+	if response.Token == "" {
+		return "", fmt.Errorf("paykeeper returned empty token")
+	}
+
+	return response.Token, nil
+}
+
+func (g *PayKeeperGateway) get(ctx context.Context, path string, query url.Values, dst any) error {
+	requestURL := g.options.Server + path
+	if len(query) > 0 {
+		requestURL += "?" + query.Encode()
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, requestURL, nil)
+	if err != nil {
+		return err
+	}
+
+	return g.do(req, dst)
+}
+
+func (g *PayKeeperGateway) post(ctx context.Context, path string, form url.Values, dst any) error {
+	requestURL := g.options.Server + path
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, requestURL, strings.NewReader(form.Encode()))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+	return g.do(req, dst)
+}
+
+func (g *PayKeeperGateway) do(req *http.Request, dst any) error {
+	req.SetBasicAuth(g.options.User, g.options.Pass)
+	req.Header.Set("Accept", "application/json")
+
+	resp, err := g.httpClient.Do(req)
+	if err != nil {
+		g.logger.Errorf("PayKeeper request %s failed: %v", req.URL.Path, err)
+		return err
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return err
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		g.logger.Errorf("PayKeeper request %s returned status %d", req.URL.Path, resp.StatusCode)
+		return fmt.Errorf("unexpected status %d from paykeeper %s", resp.StatusCode, req.URL.Path)
+	}
+
+	// ошибки PayKeeper приходят с кодом 200 и result=fail
+	var result resultResponse
+	if bytes.HasPrefix(bytes.TrimSpace(body), []byte("{")) && json.Unmarshal(body, &result) == nil && result.Result == "fail" {
+		g.logger.Errorf("PayKeeper request %s failed: %s", req.URL.Path, result.Msg)
+		return fmt.Errorf("paykeeper %s: %s", req.URL.Path, result.Msg)
+	}
+
+	if err := json.Unmarshal(body, dst); err != nil {
+		return fmt.Errorf("decode response from paykeeper %s: %w", req.URL.Path, err)
+	}
+
+	return nil
+}
+
+// toStatus - статусы счета PayKeeper: created, sent, paid, expired
+func toStatus(status string) gateway.Status {
+	switch status {
+	case "paid":
+		return gateway.StatusPaid
+	case "expired":
+		return gateway.StatusExpired
+	default:
+		return gateway.StatusPending
+	}
+}
+
+func formatAmount(amount float64) string {
+	return fmt.Sprintf("%.2f", amount)
+}
+
+func md5Hex(value string) string {
+	sum := md5.Sum([]byte(value))
+	return hex.EncodeToString(sum[:])
+}
@@ -0,0 +1,170 @@
+package paykeeper
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/Fi44er/sdmed/internal/module/payment/gateway"
+	"github.com/Fi44er/sdmed/pkg/logger"
+)
+
+const (
+	stubUser   = "admin"
+	stubPass   = "secret-pass"
+	stubSecret = "notify-secret"
+	stubToken  = "token-123"
+)
+
+// newStubServer имитирует JSON API PayKeeper
+func newStubServer(t *testing.T, refunds *[]map[string]string) *httptest.Server {
+	t.Helper()
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/info/settings/token/", func(w http.ResponseWriter, r *http.Request) {
+		json.NewEncoder(w).Encode(map[string]string{"token": stubToken})
+	})
+	mux.HandleFunc("/change/invoice/preview/", func(w http.ResponseWriter, r *http.Request) {
+		r.ParseForm()
+		if r.PostForm.Get("token") != stubToken {
+			json.NewEncoder(w).Encode(map[string]string{"result": "fail", "msg": "invalid token"})
+			return
+		}
+		if r.PostForm.Get("pay_amount") != "1500.50" {
+			json.NewEncoder(w).Encode(map[string]string{"result": "fail", "msg": "invalid amount " + r.PostForm.Get("pay_amount")})
+			return
+		}
+		json.NewEncoder(w).Encode(map[string]string{"invoice_id": "inv-1"})
+	})
+	mux.HandleFunc("/info/invoice/byid/", func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"id":"` + r.URL.Query().Get("id") + `","status":"paid","paymentid":"pay-7","pay_amount":"1500.50"}`))
+	})
+	mux.HandleFunc("/change/payment/capture/", func(w http.ResponseWriter, r *http.Request) {
+		r.ParseForm()
+		if r.PostForm.Get("token") != stubToken || r.PostForm.Get("id") != "pay-7" {
+			json.NewEncoder(w).Encode(map[string]string{"result": "fail", "msg": "invalid capture"})
+			return
+		}
+		json.NewEncoder(w).Encode(map[string]string{"result": "success"})
+	})
+	mux.HandleFunc("/change/payment/reverse/", func(w http.ResponseWriter, r *http.Request) {
+		r.ParseForm()
+		*refunds = append(*refunds, map[string]string{
+			"id":      r.PostForm.Get("id"),
+			"amount":  r.PostForm.Get("amount"),
+			"partial": r.PostForm.Get("partial"),
+		})
+		json.NewEncoder(w).Encode(map[string]string{"result": "success"})
+	})
+
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if user, pass, ok := r.BasicAuth(); !ok || user != stubUser || pass != stubPass {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		mux.ServeHTTP(w, r)
+	}))
+}
+
+func newTestGateway(server string, user string) *PayKeeperGateway {
+	return NewPayKeeperGateway(logger.NewLogger(), Options{
+		Server: server + "/",
+		User:   user,
+		Pass:   stubPass,
+		Secret: stubSecret,
+	})
+}
+
+func TestGateway(t *testing.T) {
+	var refunds []map[string]string
+	server := newStubServer(t, &refunds)
+	defer server.Close()
+
+	g := newTestGateway(server.URL, stubUser)
+	ctx := context.Background()
+
+	t.Run("create payment", func(t *testing.T) {
+		payment, err := g.CreatePayment(ctx, &gateway.PaymentRequest{PaymentID: "p-1", Amount: 1500.5})
+		if err != nil {
+			t.Fatalf("CreatePayment: %v", err)
+		}
+		if payment.ExternalID != "inv-1" || payment.ConfirmationURL != server.URL+"/bill/inv-1/" || payment.Status != gateway.StatusPending {
+			t.Errorf("unexpected payment %+v", payment)
+		}
+	})
+
+	t.Run("provider error", func(t *testing.T) {
+		if _, err := g.CreatePayment(ctx, &gateway.PaymentRequest{PaymentID: "p-1", Amount: 10}); err == nil {
+			t.Error("expected error for result=fail")
+		}
+	})
+
+	t.Run("status", func(t *testing.T) {
+		info, err := g.GetStatus(ctx, "inv-1")
+		if err != nil {
+			t.Fatalf("GetStatus: %v", err)
+		}
+		if info.Status != gateway.StatusPaid || info.ProviderPaymentID != "pay-7" || info.Amount != 1500.5 {
+			t.Errorf("unexpected payment info %+v", info)
+		}
+	})
+
+	t.Run("capture", func(t *testing.T) {
+		if err := g.Capture(ctx, "pay-7", 1500.5); err != nil {
+			t.Fatalf("Capture: %v", err)
+		}
+		if err := g.Capture(ctx, "pay-8", 1500.5); err == nil {
+			t.Error("expected error for unknown payment")
+		}
+	})
+
+	t.Run("partial refund", func(t *testing.T) {
+		if err := g.Refund(ctx, "pay-7", 500, true); err != nil {
+			t.Fatalf("Refund: %v", err)
+		}
+		if len(refunds) != 1 || refunds[0]["id"] != "pay-7" || refunds[0]["amount"] != "500.00" || refunds[0]["partial"] != "true" {
+			t.Errorf("unexpected refund request %+v", refunds)
+		}
+	})
+
+	t.Run("unauthorized", func(t *testing.T) {
+		if _, err := newTestGateway(server.URL, "other").GetStatus(ctx, "inv-1"); err == nil {
+			t.Error("expected error for wrong credentials")
+		}
+	})
+}
+
+func TestParseWebhook(t *testing.T) {
+	g := newTestGateway("http://localhost", stubUser)
+	form := url.Values{}
+	form.Set("id", "pay-7")
+	form.Set("sum", "1500.50")
+	form.Set("clientid", "Иванов")
+	form.Set("orderid", "p-1")
+	form.Set("key", md5Hex("pay-7"+"1500.50"+"Иванов"+"p-1"+stubSecret))
+
+	event, err := g.ParseWebhook(context.Background(), &gateway.WebhookRequest{Form: form})
+	if err != nil {
+		t.Fatalf("valid signature rejected: %v", err)
+	}
+	if event.PaymentID != "p-1" || event.ProviderPaymentID != "pay-7" || event.Amount != 1500.5 || event.Status != gateway.StatusPaid {
+		t.Errorf("unexpected event %+v", event)
+	}
+	if want := "OK " + md5Hex("pay-7"+stubSecret); event.Response != want {
+		t.Errorf("Response = %q, want %q", event.Response, want)
+	}
+
+	form.Set("sum", "1.00")
+	if _, err := g.ParseWebhook(context.Background(), &gateway.WebhookRequest{Form: form}); err != gateway.ErrInvalidSignature {
+		t.Errorf("tampered notification: got %v, want ErrInvalidSignature", err)
+	}
+
+	noSecret := NewPayKeeperGateway(logger.NewLogger(), Options{Server: "http://localhost"})
+	form.Set("key", md5Hex("pay-7"+"1.00"+"Иванов"+"p-1"))
+	if _, err := noSecret.ParseWebhook(context.Background(), &gateway.WebhookRequest{Form: form}); err != gateway.ErrInvalidSignature {
+		t.Errorf("notification without configured secret: got %v, want ErrInvalidSignature", err)
+	}
+}
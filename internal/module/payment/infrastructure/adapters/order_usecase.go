@@ -0,0 +1,97 @@
+package payment_adapters
+
+import (
+	"context"
+	"errors"
+
+	order_entity "github.com/Fi44er/sdmed/internal/module/order/entity"
+	order_constant "github.com/Fi44er/sdmed/internal/module/order/pkg"
+	order_usecase "github.com/Fi44er/sdmed/internal/module/order/usecase/order"
+	payment_entity "github.com/Fi44er/sdmed/internal/module/payment/entity"
+)
+
+type IOrderUsecaseAdapter interface {
+	GetOwn(ctx context.Context, id string) (*payment_entity.PaymentOrder, error)
+	GetByID(ctx context.Context, id string) (*payment_entity.PaymentOrder, error)
+	IsPayable(order *payment_entity.PaymentOrder) bool
+	MarkPaid(ctx context.Context, id string, amount float64, comment string) (bool, error)
+	NotifyPaid(ctx context.Context, id string) error
+	FlagRefundRequired(ctx context.Context, id, comment string) error
+	NotifyPaymentFailed(ctx context.Context, id string) error
+}
+
+type OrderUsecaseAdapter struct {
+	orderUsecase order_usecase.IOrderUsecase
+}
+
+func NewOrderUsecaseAdapter(orderUsecase order_usecase.IOrderUsecase) IOrderUsecaseAdapter {
+	return &OrderUsecaseAdapter{
+		orderUsecase: orderUsecase,
+	}
+}
+
+// GetOwn возвращает заказ пользователя сессии
+func (a *OrderUsecaseAdapter) GetOwn(ctx context.Context, id string) (*payment_entity.PaymentOrder, error) {
+	order, err := a.orderUsecase.GetOwnByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	return toPaymentOrder(order), nil
+}
+
+func (a *OrderUsecaseAdapter) GetByID(ctx context.Context, id string) (*payment_entity.PaymentOrder, error) {
+	order, err := a.orderUsecase.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	return toPaymentOrder(order), nil
+}
+
+// IsPayable - оплатить можно новый или подтвержденный заказ
+func (a *OrderUsecaseAdapter) IsPayable(order *payment_entity.PaymentOrder) bool {
+	return order_entity.OrderStatus(order.Status).IsPayable()
+}
+
+// MarkPaid передает заказу оплату картой на сумму amount. Заказ с сертификатом становится
+// оплаченным, только когда погашен и сертификат. Возвращает false, если заказ уже нельзя оплатить
+func (a *OrderUsecaseAdapter) MarkPaid(ctx context.Context, id string, amount float64, comment string) (bool, error) {
+	applied, err := a.orderUsecase.MarkPaid(ctx, id, amount, comment)
+	if err != nil {
+		return skipTransitionError(err)
+	}
+	return applied, nil
+}
+
+// NotifyPaid отправляет покупателю письмо об оплате заказа
+func (a *OrderUsecaseAdapter) NotifyPaid(ctx context.Context, id string) error {
+	return a.orderUsecase.NotifyPaid(ctx, id)
+}
+
+// FlagRefundRequired записывает в историю заказа, что полученный платеж нужно вернуть вручную
+func (a *OrderUsecaseAdapter) FlagRefundRequired(ctx context.Context, id, comment string) error {
+	return a.orderUsecase.AddHistoryNote(ctx, id, order_entity.ActorSystem, comment)
+}
+
+// NotifyPaymentFailed отправляет покупателю письмо об отклоненном платеже
+func (a *OrderUsecaseAdapter) NotifyPaymentFailed(ctx context.Context, id string) error {
+	return a.orderUsecase.NotifyPaymentFailed(ctx, id)
+}
+
+func skipTransitionError(err error) (bool, error) {
+	if errors.Is(err, order_constant.ErrInvalidTransition) || errors.Is(err, order_constant.ErrTransitionForbidden) {
+		return false, nil
+	}
+	return false, err
+}
+
+func toPaymentOrder(order *order_entity.Order) *payment_entity.PaymentOrder {
+	return &payment_entity.PaymentOrder{
+		ID:          order.ID,
+		UserID:      order.UserID,
+		Status:      string(order.Status),
+		Total:       order.AmountDue(),
+		ContactName: order.Contact.Name,
+		Email:       order.Contact.Email,
+		Phone:       order.Contact.Phone,
+	}
+}
@@ -0,0 +1,64 @@
+package payment_adapters
+
+import (
+	"context"
+	"fmt"
+
+	payment_entity "github.com/Fi44er/sdmed/internal/module/payment/entity"
+	receipt_entity "github.com/Fi44er/sdmed/internal/module/receipt/entity"
+	receipt_usecase "github.com/Fi44er/sdmed/internal/module/receipt/usecase/receipt"
+)
+
+type IReceiptUsecaseAdapter interface {
+	EnqueueSell(ctx context.Context, payment *payment_entity.Payment) error
+	EnqueueRefund(ctx context.Context, payment *payment_entity.Payment, amount float64, items []payment_entity.RefundItem) error
+}
+
+type ReceiptUsecaseAdapter struct {
+	receiptUsecase receipt_usecase.IReceiptUsecase
+}
+
+func NewReceiptUsecaseAdapter(receiptUsecase receipt_usecase.IReceiptUsecase) IReceiptUsecaseAdapter {
+	return &ReceiptUsecaseAdapter{
+		receiptUsecase: receiptUsecase,
+	}
+}
+
+// EnqueueSell - чек прихода на сумму платежа
+func (a *ReceiptUsecaseAdapter) EnqueueSell(ctx context.Context, payment *payment_entity.Payment) error {
+	return a.receiptUsecase.Enqueue(ctx, &receipt_entity.ReceiptRequest{
+		OrderID:   payment.OrderID,
+		PaymentID: payment.ID,
+		Type:      receipt_entity.ReceiptTypeSell,
+		Amount:    payment.Amount,
+	})
+}
+
+// EnqueueRefund - чек возврата на amount по возвращаемым позициям items. Ключ включает сумму
+// возвратов после этого, поэтому каждый частичный возврат получает свой чек
+func (a *ReceiptUsecaseAdapter) EnqueueRefund(ctx context.Context, payment *payment_entity.Payment, amount float64, items []payment_entity.RefundItem) error {
+	return a.receiptUsecase.Enqueue(ctx, &receipt_entity.ReceiptRequest{
+		OrderID:   payment.OrderID,
+		PaymentID: payment.ID,
+		Type:      receipt_entity.ReceiptTypeSellRefund,
+		Amount:    amount,
+		Items:     toRefundLines(items),
+		Key:       fmt.Sprintf("%s:%s:%.2f", receipt_entity.ReceiptTypeSellRefund, payment.ID, payment.RefundedAmount+amount),
+	})
+}
+
+func toRefundLines(items []payment_entity.RefundItem) []receipt_entity.RefundLine {
+	if len(items) == 0 {
+		return nil
+	}
+
+	lines := make([]receipt_entity.RefundLine, len(items))
+	for i, item := range items {
+		lines[i] = receipt_entity.RefundLine{
+			OrderItemID: item.OrderItemID,
+			Quantity:    item.Quantity,
+			Amount:      item.Amount,
+		}
+	}
+	return lines
+}
@@ -0,0 +1,23 @@
+package payment_model
+
+import "time"
+
+type Payment struct {
+	ID                string     `gorm:"primaryKey;type:uuid;default:uuid_generate_v4();"`
+	OrderID           string     `gorm:"type:uuid;not null;index"`
+	Provider          string     `gorm:"type:varchar(50);not null;uniqueIndex:idx_payment_provider_payment"`
+	ExternalID        string     `gorm:"type:varchar(100);not null;default:'';index"`
+	ProviderPaymentID *string    `gorm:"type:varchar(100);uniqueIndex:idx_payment_provider_payment"`
+	Amount            float64    `gorm:"type:float;not null"`
+	RefundedAmount    float64    `gorm:"type:float;not null;default:0"`
+	Status            string     `gorm:"type:varchar(20);not null;index"`
+	PaymentURL        string     `gorm:"type:text;not null;default:''"`
+	FailureReason     string     `gorm:"type:text;not null;default:''"`
+	PaidAt            *time.Time `gorm:"type:timestamp"`
+	CreatedAt         time.Time  `gorm:"not null;default:now()"`
+	UpdatedAt         time.Time  `gorm:"not null;default:now()"`
+}
+
+func (Payment) TableName() string {
+	return "payment_module.payments"
+}
@@ -0,0 +1,52 @@
+package payment_repository
+
+import (
+	payment_entity "github.com/Fi44er/sdmed/internal/module/payment/entity"
+	payment_model "github.com/Fi44er/sdmed/internal/module/payment/infrastructure/repository/model"
+)
+
+type Converter struct{}
+
+func (c *Converter) ToModel(entity *payment_entity.Payment) *payment_model.Payment {
+	var providerPaymentID *string
+	if entity.ProviderPaymentID != "" {
+		providerPaymentID = &entity.ProviderPaymentID
+	}
+
+	return &payment_model.Payment{
+		ID:                entity.ID,
+		OrderID:           entity.OrderID,
+		Provider:          entity.Provider,
+		ExternalID:        entity.ExternalID,
+		ProviderPaymentID: providerPaymentID,
+		Amount:            entity.Amount,
+		RefundedAmount:    entity.RefundedAmount,
+		Status:            string(entity.Status),
+		PaymentURL:        entity.PaymentURL,
+		FailureReason:     entity.FailureReason,
+		PaidAt:            entity.PaidAt,
+	}
+}
+
+func (c *Converter) ToEntity(model *payment_model.Payment) *payment_entity.Payment {
+	providerPaymentID := ""
+	if model.ProviderPaymentID != nil {
+		providerPaymentID = *model.ProviderPaymentID
+	}
+
+	return &payment_entity.Payment{
+		ID:                model.ID,
+		OrderID:           model.OrderID,
+		Provider:          model.Provider,
+		ExternalID:        model.ExternalID,
+		ProviderPaymentID: providerPaymentID,
+		Amount:            model.Amount,
+		RefundedAmount:    model.RefundedAmount,
+		Status:            payment_entity.PaymentStatus(model.Status),
+		PaymentURL:        model.PaymentURL,
+		FailureReason:     model.FailureReason,
+		PaidAt:            model.PaidAt,
+		CreatedAt:         model.CreatedAt,
+		UpdatedAt:         model.UpdatedAt,
+	}
+}
@@ -0,0 +1,225 @@
+package payment_repository
+
+import (
+	"context"
+
+	payment_entity "github.com/Fi44er/sdmed/internal/module/payment/entity"
+	payment_model "github.com/Fi44er/sdmed/internal/module/payment/infrastructure/repository/model"
+	"github.com/Fi44er/sdmed/pkg/logger"
+	"gorm.io/gorm"
+)
+
+type IPaymentRepository interface {
+	Create(ctx context.Context, payment *payment_entity.Payment) error
+	GetByID(ctx context.Context, id string) (*payment_entity.Payment, error)
+	GetByOrderID(ctx context.Context, orderID string) ([]payment_entity.Payment, error)
+	SetExternal(ctx context.Context, id, externalID, paymentURL string) error
+	MarkFailed(ctx context.Context, id, reason string) (bool, error)
+	MarkAuthorized(ctx context.Context, id, providerPaymentID string) (bool, error)
+	MarkPaid(ctx context.Context, id, providerPaymentID string) (bool, error)
+	UpdateStatus(ctx context.Context, id string, from, to payment_entity.PaymentStatus) (bool, error)
+	AddRefund(ctx context.Context, id string, amount float64) (bool, error)
+}
+
+type PaymentRepository struct {
+	logger    *logger.Logger
+	db        *gorm.DB
+	converter *Converter
+}
+
+func NewPaymentRepository(logger *logger.Logger, db *gorm.DB) IPaymentRepository {
+	return &PaymentRepository{
+		logger:    logger,
+		db:        db,
+		converter: &Converter{},
+	}
+}
+
+func (r *PaymentRepository) Create(ctx context.Context, payment *payment_entity.Payment) error {
+	r.logger.Infof("Creating %s payment for order: %s", payment.Provider, payment.OrderID)
+
+	paymentModel := r.converter.ToModel(payment)
+	if err := r.db.WithContext(ctx).Create(paymentModel).Error; err != nil {
+		r.logger.Errorf("Failed to create payment for order %s: %v", payment.OrderID, err)
+		return err
+	}
+	payment.ID = paymentModel.ID
+	payment.CreatedAt = paymentModel.CreatedAt
+	payment.UpdatedAt = paymentModel.UpdatedAt
+
+	return nil
+}
+
+func (r *PaymentRepository) GetByID(ctx context.Context, id string) (*payment_entity.Payment, error) {
+	var paymentModel payment_model.Payment
+	if err := r.db.WithContext(ctx).First(&paymentModel, "id = ?", id).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, nil
+		}
+		r.logger.Errorf("Failed to get payment %s: %v", id, err)
+		return nil, err
+	}
+
+	return r.converter.ToEntity(&paymentModel), nil
+}
+
+// GetByOrderID возвращает попытки оплаты заказа, новые первыми
+func (r *PaymentRepository) GetByOrderID(ctx context.Context, orderID string) ([]payment_entity.Payment, error) {
+	var paymentModels []payment_model.Payment
+	err := r.db.WithContext(ctx).
+		Where("order_id = ?", orderID).
+		Order("created_at DESC").
+		Find(&paymentModels).Error
+	if err != nil {
+		r.logger.Errorf("Failed to get payments of order %s: %v", orderID, err)
+		return nil, err
+	}
+
+	payments := make([]payment_entity.Payment, len(paymentModels))
+	for i := range paymentModels {
+		payments[i] = *r.converter.ToEntity(&paymentModels[i])
+	}
+
+	return payments, nil
+}
+
+// SetExternal сохраняет идентификатор платежа в шлюзе и ссылку на оплату
+func (r *PaymentRepository) SetExternal(ctx context.Context, id, externalID, paymentURL string) error {
+	r.logger.Infof("Setting external id %s for payment %s", externalID, id)
+
+	err := r.db.WithContext(ctx).
+		Model(&payment_model.Payment{}).
+		Where("id = ?", id).
+		Updates(map[string]any{
+			"external_id": externalID,
+			"payment_url": paymentURL,
+			"updated_at":  gorm.Expr("now()"),
+		}).Error
+	if err != nil {
+		r.logger.Errorf("Failed to set external id for payment %s: %v", id, err)
+		return err
+	}
+
+	return nil
+}
+
+// MarkFailed переводит ожидающий платеж в неуспешные с причиной от шлюза
+func (r *PaymentRepository) MarkFailed(ctx context.Context, id, reason string) (bool, error) {
+	r.logger.Infof("Marking payment %s as failed: %s", id, reason)
+
+	result := r.db.WithContext(ctx).
+		Model(&payment_model.Payment{}).
+		Where("id = ? AND status = ?", id, string(payment_entity.PaymentStatusPending)).
+		Updates(map[string]any{
+			"status":         string(payment_entity.PaymentStatusFailed),
+			"failure_reason": reason,
+			"updated_at":     gorm.Expr("now()"),
+		})
+	if result.Error != nil {
+		r.logger.Errorf("Failed to mark payment %s as failed: %v", id, result.Error)
+		return false, result.Error
+	}
+
+	return result.RowsAffected > 0, nil
+}
+
+// MarkAuthorized отмечает, что средства по ожидающему платежу заблокированы
+func (r *PaymentRepository) MarkAuthorized(ctx context.Context, id, providerPaymentID string) (bool, error) {
+	r.logger.Infof("Marking payment %s as authorized (provider payment %s)", id, providerPaymentID)
+
+	result := r.db.WithContext(ctx).
+		Model(&payment_model.Payment{}).
+		Where("id = ? AND status = ?", id, string(payment_entity.PaymentStatusPending)).
+		Updates(map[string]any{
+			"status":              string(payment_entity.PaymentStatusAuthorized),
+			"provider_payment_id": nullable(providerPaymentID),
+			"updated_at":          gorm.Expr("now()"),
+		})
+	if result.Error != nil {
+		r.logger.Errorf("Failed to mark payment %s as authorized: %v", id, result.Error)
+		return false, result.Error
+	}
+
+	return result.RowsAffected > 0, nil
+}
+
+// MarkPaid переводит платеж в оплаченные. Истекший или отклоненный платеж тоже переводится:
+// шлюз сообщил о поступивших деньгах, и их нужно учесть. Возвращает false, если платеж
+// уже оплачен или возвращен, - так повторные уведомления не применяются дважды
+func (r *PaymentRepository) MarkPaid(ctx context.Context, id, providerPaymentID string) (bool, error) {
+	r.logger.Infof("Marking payment %s as paid (provider payment %s)", id, providerPaymentID)
+
+	updates := map[string]any{
+		"status":         string(payment_entity.PaymentStatusPaid),
+		"failure_reason": "",
+		"paid_at":        gorm.Expr("now()"),
+		"updated_at":     gorm.Expr("now()"),
+	}
+	// при списании авторизованного платежа идентификатор транзакции уже сохранен
+	if providerPaymentID != "" {
+		updates["provider_payment_id"] = providerPaymentID
+	}
+
+	result := r.db.WithContext(ctx).
+		Model(&payment_model.Payment{}).
+		Where("id = ? AND status IN ?", id, []string{
+			string(payment_entity.PaymentStatusPending),
+			string(payment_entity.PaymentStatusAuthorized),
+			string(payment_entity.PaymentStatusExpired),
+			string(payment_entity.PaymentStatusFailed),
+		}).
+		Updates(updates)
+	if result.Error != nil {
+		r.logger.Errorf("Failed to mark payment %s as paid: %v", id, result.Error)
+		return false, result.Error
+	}
+
+	return result.RowsAffected > 0, nil
+}
+
+func (r *PaymentRepository) UpdateStatus(ctx context.Context, id string, from, to payment_entity.PaymentStatus) (bool, error) {
+	r.logger.Infof("Updating payment %s status: %s -> %s", id, from, to)
+
+	result := r.db.WithContext(ctx).
+		Model(&payment_model.Payment{}).
+		Where("id = ? AND status = ?", id, string(from)).
+		Updates(map[string]any{
+			"status":     string(to),
+			"updated_at": gorm.Expr("now()"),
+		})
+	if result.Error != nil {
+		r.logger.Errorf("Failed to update payment %s status: %v", id, result.Error)
+		return false, result.Error
+	}
+
+	return result.RowsAffected > 0, nil
+}
+
+// AddRefund увеличивает сумму возврата, если она не превысит сумму платежа.
+// При полном возврате платеж переходит в статус refunded
+func (r *PaymentRepository) AddRefund(ctx context.Context, id string, amount float64) (bool, error) {
+	r.logger.Infof("Adding refund %.2f to payment %s", amount, id)
+
+	result := r.db.WithContext(ctx).
+		Model(&payment_model.Payment{}).
+		Where("id = ? AND status = ? AND refunded_amount + ? <= amount + 0.005", id, string(payment_entity.PaymentStatusPaid), amount).
+		Updates(map[string]any{
+			"refunded_amount": gorm.Expr("ROUND((refunded_amount + ?)::numeric, 2)", amount),
+			"status": gorm.Expr("CASE WHEN refunded_amount + ? >= amount - 0.005 THEN ? ELSE status END",
+				amount, string(payment_entity.PaymentStatusRefunded)),
+			"updated_at": gorm.Expr("now()"),
+		})
+	if result.Error != nil {
+		r.logger.Errorf("Failed to add refund to payment %s: %v", id, result.Error)
+		return false, result.Error
+	}
+
+	return result.RowsAffected > 0, nil
+}
+
+func nullable(value string) any {
+	if value == "" {
+		return nil
+	}
+	return value
+}
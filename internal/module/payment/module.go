@@ -0,0 +1,91 @@
+package payment_module
+
+import (
+	"github.com/Fi44er/sdmed/internal/config"
+	order_usecase "github.com/Fi44er/sdmed/internal/module/order/usecase/order"
+	payment_http "github.com/Fi44er/sdmed/internal/module/payment/delivery/http"
+	"github.com/Fi44er/sdmed/internal/module/payment/gateway"
+	"github.com/Fi44er/sdmed/internal/module/payment/gateway/paykeeper"
+	payment_adapters "github.com/Fi44er/sdmed/internal/module/payment/infrastructure/adapters"
+	payment_repository "github.com/Fi44er/sdmed/internal/module/payment/infrastructure/repository/payment"
+	payment_usecase "github.com/Fi44er/sdmed/internal/module/payment/usecase/payment"
+	receipt_usecase "github.com/Fi44er/sdmed/internal/module/receipt/usecase/receipt"
+	"github.com/Fi44er/sdmed/pkg/logger"
+	"github.com/Fi44er/sdmed/pkg/postgres/uow"
+	"github.com/go-playground/validator/v10"
+	"github.com/gofiber/fiber/v2"
+	"gorm.io/gorm"
+)
+
+type PaymentModule struct {
+	paymentRepository payment_repository.IPaymentRepository
+	paymentUsecase    payment_usecase.IPaymentUsecase
+	paymentHandler    *payment_http.PaymentHandler
+
+	orderUsecase   order_usecase.IOrderUsecase
+	receiptUsecase receipt_usecase.IReceiptUsecase
+
+	logger    *logger.Logger
+	validator *validator.Validate
+	db        *gorm.DB
+	uow       uow.Uow
+	config    *config.Config
+}
+
+func NewPaymentModule(
+	logger *logger.Logger,
+	validator *validator.Validate,
+	db *gorm.DB,
+	uow uow.Uow,
+	config *config.Config,
+	orderUsecase order_usecase.IOrderUsecase,
+	receiptUsecase receipt_usecase.IReceiptUsecase,
+) *PaymentModule {
+	return &PaymentModule{
+		logger:         logger,
+		validator:      validator,
+		db:             db,
+		uow:            uow,
+		config:         config,
+		orderUsecase:   orderUsecase,
+		receiptUsecase: receiptUsecase,
+	}
+}
+
+func (m *PaymentModule) Init() {
+	m.uow.RegisterRepository("payment", func(tx *gorm.DB) (any, error) {
+		return payment_repository.NewPaymentRepository(m.logger, tx), nil
+	})
+
+	// без секрета уведомлений оплату PayKeeper нельзя подтвердить, шлюз не подключается
+	gateways := map[string]gateway.Gateway{}
+	if m.config.PayKeeperSecret != "" {
+		gateways[paykeeper.Name] = paykeeper.NewPayKeeperGateway(m.logger, paykeeper.Options{
+			Server: m.config.PayKeeperServer,
+			User:   m.config.PayKeeperUser,
+			Pass:   m.config.PayKeeperPass,
+			Secret: m.config.PayKeeperSecret,
+		})
+	} else {
+		m.logger.Warn("PAY_KEEPER_SECRET is not set, PayKeeper payments are disabled")
+	}
+
+	m.paymentRepository = payment_repository.NewPaymentRepository(m.logger, m.db)
+	m.paymentUsecase = payment_usecase.NewPaymentUsecase(
+		m.paymentRepository,
+		gateway.NewRegistry(gateways),
+		payment_adapters.NewOrderUsecaseAdapter(m.orderUsecase),
+		payment_adapters.NewReceiptUsecaseAdapter(m.receiptUsecase),
+		m.uow,
+		m.logger,
+	)
+	m.paymentHandler = payment_http.NewPaymentHandler(m.paymentUsecase, m.validator, m.logger)
+}
+
+func (m *PaymentModule) InitDelivery(router fiber.Router) {
+	m.paymentHandler.RegisterRoutes(router)
+}
+
+func (m *PaymentModule) GetPaymentUsecase() payment_usecase.IPaymentUsecase {
+	return m.paymentUsecase
+}
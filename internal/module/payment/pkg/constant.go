@@ -0,0 +1,25 @@
+package payment_constant
+
+import "github.com/Fi44er/sdmed/pkg/customerr"
+
+const (
+	// DefaultProvider - шлюз, через который оплачивается заказ, если провайдер не указан
+	DefaultProvider = "paykeeper"
+
+	// AmountEpsilon - допустимое расхождение сумм при сравнении в рублях
+	AmountEpsilon = 0.005
+)
+
+var (
+	ErrPaymentNotFound      = customerr.NewError(404, "payment not found")
+	ErrOrderNotPayable      = customerr.NewError(409, "order cannot be paid in its current status")
+	ErrNothingToPay         = customerr.NewError(409, "order has no amount due")
+	ErrProviderNotFound     = customerr.NewError(400, "payment provider is not supported")
+	ErrPaymentNotPaid       = customerr.NewError(409, "payment is not paid")
+	ErrPaymentNotAuthorized = customerr.NewError(409, "payment is not authorized")
+	ErrRefundAmountInvalid  = customerr.NewError(400, "refund amount exceeds the refundable amount")
+	ErrInvalidSignature     = customerr.NewError(403, "invalid payment notification signature")
+	ErrAmountMismatch       = customerr.NewError(400, "payment notification amount does not match the payment")
+	ErrProviderUnavailable  = customerr.NewError(502, "payment provider request failed")
+	ErrSessionUserNotFound  = customerr.NewError(401, "session user not found")
+)
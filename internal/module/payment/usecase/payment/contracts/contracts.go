@@ -0,0 +1,41 @@
+package payment_usecase_contracts
+
+import (
+	"context"
+
+	payment_entity "github.com/Fi44er/sdmed/internal/module/payment/entity"
+	"github.com/Fi44er/sdmed/internal/module/payment/gateway"
+)
+
+type IPaymentRepository interface {
+	Create(ctx context.Context, payment *payment_entity.Payment) error
+	GetByID(ctx context.Context, id string) (*payment_entity.Payment, error)
+	GetByOrderID(ctx context.Context, orderID string) ([]payment_entity.Payment, error)
+	SetExternal(ctx context.Context, id, externalID, paymentURL string) error
+	MarkFailed(ctx context.Context, id, reason string) (bool, error)
+	MarkAuthorized(ctx context.Context, id, providerPaymentID string) (bool, error)
+	MarkPaid(ctx context.Context, id, providerPaymentID string) (bool, error)
+	UpdateStatus(ctx context.Context, id string, from, to payment_entity.PaymentStatus) (bool, error)
+	AddRefund(ctx context.Context, id string, amount float64) (bool, error)
+}
+
+type IGatewayRegistry interface {
+	Get(name string) (gateway.Gateway, error)
+}
+
+type IOrderUsecaseAdapter interface {
+	GetOwn(ctx context.Context, id string) (*payment_entity.PaymentOrder, error)
+	GetByID(ctx context.Context, id string) (*payment_entity.PaymentOrder, error)
+	IsPayable(order *payment_entity.PaymentOrder) bool
+	MarkPaid(ctx context.Context, id string, amount float64, comment string) (bool, error)
+	NotifyPaid(ctx context.Context, id string) error
+	FlagRefundRequired(ctx context.Context, id, comment string) error
+	NotifyPaymentFailed(ctx context.Context, id string) error
+}
+
+// IReceiptUsecaseAdapter ставит фискальные чеки в очередь. Вызывается внутри
+// транзакции платежа, чтобы чек не потерялся и не появился без оплаты
+type IReceiptUsecaseAdapter interface {
+	EnqueueSell(ctx context.Context, payment *payment_entity.Payment) error
+	EnqueueRefund(ctx context.Context, payment *payment_entity.Payment, amount float64, items []payment_entity.RefundItem) error
+}
@@ -0,0 +1,383 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./payment/contracts/contracts.go
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	context "context"
+	reflect "reflect"
+
+	payment_entity "github.com/Fi44er/sdmed/internal/module/payment/entity"
+	gateway "github.com/Fi44er/sdmed/internal/module/payment/gateway"
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockIPaymentRepository is a mock of IPaymentRepository interface.
+type MockIPaymentRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockIPaymentRepositoryMockRecorder
+}
+
+// MockIPaymentRepositoryMockRecorder is the mock recorder for MockIPaymentRepository.
+type MockIPaymentRepositoryMockRecorder struct {
+	mock *MockIPaymentRepository
+}
+
+// NewMockIPaymentRepository creates a new mock instance.
+func NewMockIPaymentRepository(ctrl *gomock.Controller) *MockIPaymentRepository {
+	mock := &MockIPaymentRepository{ctrl: ctrl}
+	mock.recorder = &MockIPaymentRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockIPaymentRepository) EXPECT() *MockIPaymentRepositoryMockRecorder {
+	return m.recorder
+}
+
+// AddRefund mocks base method.
+func (m *MockIPaymentRepository) AddRefund(ctx context.Context, id string, amount float64) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddRefund", ctx, id, amount)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddRefund indicates an expected call of AddRefund.
+func (mr *MockIPaymentRepositoryMockRecorder) AddRefund(ctx, id, amount interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddRefund", reflect.TypeOf((*MockIPaymentRepository)(nil).AddRefund), ctx, id, amount)
+}
+
+// Create mocks base method.
+func (m *MockIPaymentRepository) Create(ctx context.Context, payment *payment_entity.Payment) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, payment)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockIPaymentRepositoryMockRecorder) Create(ctx, payment interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockIPaymentRepository)(nil).Create), ctx, payment)
+}
+
+// GetByID mocks base method.
+func (m *MockIPaymentRepository) GetByID(ctx context.Context, id string) (*payment_entity.Payment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByID", ctx, id)
+	ret0, _ := ret[0].(*payment_entity.Payment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByID indicates an expected call of GetByID.
+func (mr *MockIPaymentRepositoryMockRecorder) GetByID(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByID", reflect.TypeOf((*MockIPaymentRepository)(nil).GetByID), ctx, id)
+}
+
+// GetByOrderID mocks base method.
+func (m *MockIPaymentRepository) GetByOrderID(ctx context.Context, orderID string) ([]payment_entity.Payment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByOrderID", ctx, orderID)
+	ret0, _ := ret[0].([]payment_entity.Payment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByOrderID indicates an expected call of GetByOrderID.
+func (mr *MockIPaymentRepositoryMockRecorder) GetByOrderID(ctx, orderID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByOrderID", reflect.TypeOf((*MockIPaymentRepository)(nil).GetByOrderID), ctx, orderID)
+}
+
+// MarkAuthorized mocks base method.
+func (m *MockIPaymentRepository) MarkAuthorized(ctx context.Context, id, providerPaymentID string) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MarkAuthorized", ctx, id, providerPaymentID)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MarkAuthorized indicates an expected call of MarkAuthorized.
+func (mr *MockIPaymentRepositoryMockRecorder) MarkAuthorized(ctx, id, providerPaymentID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkAuthorized", reflect.TypeOf((*MockIPaymentRepository)(nil).MarkAuthorized), ctx, id, providerPaymentID)
+}
+
+// MarkFailed mocks base method.
+func (m *MockIPaymentRepository) MarkFailed(ctx context.Context, id, reason string) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MarkFailed", ctx, id, reason)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MarkFailed indicates an expected call of MarkFailed.
+func (mr *MockIPaymentRepositoryMockRecorder) MarkFailed(ctx, id, reason interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkFailed", reflect.TypeOf((*MockIPaymentRepository)(nil).MarkFailed), ctx, id, reason)
+}
+
+// MarkPaid mocks base method.
+func (m *MockIPaymentRepository) MarkPaid(ctx context.Context, id, providerPaymentID string) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MarkPaid", ctx, id, providerPaymentID)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MarkPaid indicates an expected call of MarkPaid.
+func (mr *MockIPaymentRepositoryMockRecorder) MarkPaid(ctx, id, providerPaymentID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkPaid", reflect.TypeOf((*MockIPaymentRepository)(nil).MarkPaid), ctx, id, providerPaymentID)
+}
+
+// SetExternal mocks base method.
+func (m *MockIPaymentRepository) SetExternal(ctx context.Context, id, externalID, paymentURL string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetExternal", ctx, id, externalID, paymentURL)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetExternal indicates an expected call of SetExternal.
+func (mr *MockIPaymentRepositoryMockRecorder) SetExternal(ctx, id, externalID, paymentURL interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetExternal", reflect.TypeOf((*MockIPaymentRepository)(nil).SetExternal), ctx, id, externalID, paymentURL)
+}
+
+// UpdateStatus mocks base method.
+func (m *MockIPaymentRepository) UpdateStatus(ctx context.Context, id string, from, to payment_entity.PaymentStatus) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateStatus", ctx, id, from, to)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateStatus indicates an expected call of UpdateStatus.
+func (mr *MockIPaymentRepositoryMockRecorder) UpdateStatus(ctx, id, from, to interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateStatus", reflect.TypeOf((*MockIPaymentRepository)(nil).UpdateStatus), ctx, id, from, to)
+}
+
+// MockIGatewayRegistry is a mock of IGatewayRegistry interface.
+type MockIGatewayRegistry struct {
+	ctrl     *gomock.Controller
+	recorder *MockIGatewayRegistryMockRecorder
+}
+
+// MockIGatewayRegistryMockRecorder is the mock recorder for MockIGatewayRegistry.
+type MockIGatewayRegistryMockRecorder struct {
+	mock *MockIGatewayRegistry
+}
+
+// NewMockIGatewayRegistry creates a new mock instance.
+func NewMockIGatewayRegistry(ctrl *gomock.Controller) *MockIGatewayRegistry {
+	mock := &MockIGatewayRegistry{ctrl: ctrl}
+	mock.recorder = &MockIGatewayRegistryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockIGatewayRegistry) EXPECT() *MockIGatewayRegistryMockRecorder {
+	return m.recorder
+}
+
+// Get mocks base method.
+func (m *MockIGatewayRegistry) Get(name string) (gateway.Gateway, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", name)
+	ret0, _ := ret[0].(gateway.Gateway)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockIGatewayRegistryMockRecorder) Get(name interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockIGatewayRegistry)(nil).Get), name)
+}
+
+// MockIOrderUsecaseAdapter is a mock of IOrderUsecaseAdapter interface.
+type MockIOrderUsecaseAdapter struct {
+	ctrl     *gomock.Controller
+	recorder *MockIOrderUsecaseAdapterMockRecorder
+}
+
+// MockIOrderUsecaseAdapterMockRecorder is the mock recorder for MockIOrderUsecaseAdapter.
+type MockIOrderUsecaseAdapterMockRecorder struct {
+	mock *MockIOrderUsecaseAdapter
+}
+
+// NewMockIOrderUsecaseAdapter creates a new mock instance.
+func NewMockIOrderUsecaseAdapter(ctrl *gomock.Controller) *MockIOrderUsecaseAdapter {
+	mock := &MockIOrderUsecaseAdapter{ctrl: ctrl}
+	mock.recorder = &MockIOrderUsecaseAdapterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockIOrderUsecaseAdapter) EXPECT() *MockIOrderUsecaseAdapterMockRecorder {
+	return m.recorder
+}
+
+// FlagRefundRequired mocks base method.
+func (m *MockIOrderUsecaseAdapter) FlagRefundRequired(ctx context.Context, id, comment string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FlagRefundRequired", ctx, id, comment)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// FlagRefundRequired indicates an expected call of FlagRefundRequired.
+func (mr *MockIOrderUsecaseAdapterMockRecorder) FlagRefundRequired(ctx, id, comment interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FlagRefundRequired", reflect.TypeOf((*MockIOrderUsecaseAdapter)(nil).FlagRefundRequired), ctx, id, comment)
+}
+
+// GetByID mocks base method.
+func (m *MockIOrderUsecaseAdapter) GetByID(ctx context.Context, id string) (*payment_entity.PaymentOrder, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByID", ctx, id)
+	ret0, _ := ret[0].(*payment_entity.PaymentOrder)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByID indicates an expected call of GetByID.
+func (mr *MockIOrderUsecaseAdapterMockRecorder) GetByID(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByID", reflect.TypeOf((*MockIOrderUsecaseAdapter)(nil).GetByID), ctx, id)
+}
+
+// GetOwn mocks base method.
+func (m *MockIOrderUsecaseAdapter) GetOwn(ctx context.Context, id string) (*payment_entity.PaymentOrder, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetOwn", ctx, id)
+	ret0, _ := ret[0].(*payment_entity.PaymentOrder)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetOwn indicates an expected call of GetOwn.
+func (mr *MockIOrderUsecaseAdapterMockRecorder) GetOwn(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOwn", reflect.TypeOf((*MockIOrderUsecaseAdapter)(nil).GetOwn), ctx, id)
+}
+
+// IsPayable mocks base method.
+func (m *MockIOrderUsecaseAdapter) IsPayable(order *payment_entity.PaymentOrder) bool {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IsPayable", order)
+	ret0, _ := ret[0].(bool)
+	return ret0
+}
+
+// IsPayable indicates an expected call of IsPayable.
+func (mr *MockIOrderUsecaseAdapterMockRecorder) IsPayable(order interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsPayable", reflect.TypeOf((*MockIOrderUsecaseAdapter)(nil).IsPayable), order)
+}
+
+// MarkPaid mocks base method.
+func (m *MockIOrderUsecaseAdapter) MarkPaid(ctx context.Context, id string, amount float64, comment string) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MarkPaid", ctx, id, amount, comment)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MarkPaid indicates an expected call of MarkPaid.
+func (mr *MockIOrderUsecaseAdapterMockRecorder) MarkPaid(ctx, id, amount, comment interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkPaid", reflect.TypeOf((*MockIOrderUsecaseAdapter)(nil).MarkPaid), ctx, id, amount, comment)
+}
+
+// NotifyPaid mocks base method.
+func (m *MockIOrderUsecaseAdapter) NotifyPaid(ctx context.Context, id string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NotifyPaid", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// NotifyPaid indicates an expected call of NotifyPaid.
+func (mr *MockIOrderUsecaseAdapterMockRecorder) NotifyPaid(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NotifyPaid", reflect.TypeOf((*MockIOrderUsecaseAdapter)(nil).NotifyPaid), ctx, id)
+}
+
+// NotifyPaymentFailed mocks base method.
+func (m *MockIOrderUsecaseAdapter) NotifyPaymentFailed(ctx context.Context, id string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NotifyPaymentFailed", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// NotifyPaymentFailed indicates an expected call of NotifyPaymentFailed.
+func (mr *MockIOrderUsecaseAdapterMockRecorder) NotifyPaymentFailed(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NotifyPaymentFailed", reflect.TypeOf((*MockIOrderUsecaseAdapter)(nil).NotifyPaymentFailed), ctx, id)
+}
+
+// MockIReceiptUsecaseAdapter is a mock of IReceiptUsecaseAdapter interface.
+type MockIReceiptUsecaseAdapter struct {
+	ctrl     *gomock.Controller
+	recorder *MockIReceiptUsecaseAdapterMockRecorder
+}
+
+// MockIReceiptUsecaseAdapterMockRecorder is the mock recorder for MockIReceiptUsecaseAdapter.
+type MockIReceiptUsecaseAdapterMockRecorder struct {
+	mock *MockIReceiptUsecaseAdapter
+}
+
+// NewMockIReceiptUsecaseAdapter creates a new mock instance.
+func NewMockIReceiptUsecaseAdapter(ctrl *gomock.Controller) *MockIReceiptUsecaseAdapter {
+	mock := &MockIReceiptUsecaseAdapter{ctrl: ctrl}
+	mock.recorder = &MockIReceiptUsecaseAdapterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockIReceiptUsecaseAdapter) EXPECT() *MockIReceiptUsecaseAdapterMockRecorder {
+	return m.recorder
+}
+
+// EnqueueRefund mocks base method.
+func (m *MockIReceiptUsecaseAdapter) EnqueueRefund(ctx context.Context, payment *payment_entity.Payment, amount float64, items []payment_entity.RefundItem) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EnqueueRefund", ctx, payment, amount, items)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// EnqueueRefund indicates an expected call of EnqueueRefund.
+func (mr *MockIReceiptUsecaseAdapterMockRecorder) EnqueueRefund(ctx, payment, amount, items interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EnqueueRefund", reflect.TypeOf((*MockIReceiptUsecaseAdapter)(nil).EnqueueRefund), ctx, payment, amount, items)
+}
+
+// EnqueueSell mocks base method.
+func (m *MockIReceiptUsecaseAdapter) EnqueueSell(ctx context.Context, payment *payment_entity.Payment) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EnqueueSell", ctx, payment)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// EnqueueSell indicates an expected call of EnqueueSell.
+func (mr *MockIReceiptUsecaseAdapterMockRecorder) EnqueueSell(ctx, payment interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EnqueueSell", reflect.TypeOf((*MockIReceiptUsecaseAdapter)(nil).EnqueueSell), ctx, payment)
+}
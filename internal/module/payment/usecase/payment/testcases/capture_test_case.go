@@ -0,0 +1,66 @@
+package payment_testcases
+
+import (
+	"context"
+
+	payment_entity "github.com/Fi44er/sdmed/internal/module/payment/entity"
+	"github.com/Fi44er/sdmed/internal/module/payment/gateway/fake"
+	payment_constant "github.com/Fi44er/sdmed/internal/module/payment/pkg"
+	"github.com/Fi44er/sdmed/internal/module/payment/usecase/payment/mock"
+	uow_mock "github.com/Fi44er/sdmed/pkg/postgres/uow/mock"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+type MockCapture struct {
+	Ctrl        *gomock.Controller
+	Ctx         context.Context
+	RepoMock    *mock.MockIPaymentRepository
+	OrderMock   *mock.MockIOrderUsecaseAdapter
+	ReceiptMock *mock.MockIReceiptUsecaseAdapter
+	UowMock     *uow_mock.MockUow
+	Gateway     *fake.FakeGateway
+	T           assert.TestingT
+}
+
+type CaptureTestCase struct {
+	Name             string
+	SetupMocks       func(m *MockCapture)
+	ExpectedStatus   payment_entity.PaymentStatus
+	ExpectedCaptured float64
+	ExpectedError    error
+}
+
+func GetCaptureTestCases() []CaptureTestCase {
+	return []CaptureTestCase{
+		{
+			Name: "authorized_payment_captured",
+			SetupMocks: func(m *MockCapture) {
+				payment := pendingPayment(m.Ctx, m.Gateway)
+				m.Gateway.Authorize(payment.ExternalID)
+				payment.Status, payment.ProviderPaymentID = payment_entity.PaymentStatusAuthorized, "txn-"+payment.ExternalID
+
+				m.RepoMock.EXPECT().GetByID(m.Ctx, PaymentID).Return(payment, nil)
+				expectTx(m.Ctx, m.UowMock, m.RepoMock)
+				m.RepoMock.EXPECT().MarkPaid(m.Ctx, PaymentID, "").Return(true, nil)
+				m.OrderMock.EXPECT().GetByID(m.Ctx, OrderID).Return(newOrder, nil)
+				m.OrderMock.EXPECT().MarkPaid(m.Ctx, OrderID, payment.Amount, "fake payment "+payment.ProviderPaymentID).Return(true, nil)
+				m.ReceiptMock.EXPECT().EnqueueSell(m.Ctx, payment).Return(nil)
+				m.OrderMock.EXPECT().NotifyPaid(m.Ctx, OrderID).Return(nil)
+
+				paid := *payment
+				paid.Status = payment_entity.PaymentStatusPaid
+				m.RepoMock.EXPECT().GetByID(m.Ctx, PaymentID).Return(&paid, nil)
+			},
+			ExpectedStatus:   payment_entity.PaymentStatusPaid,
+			ExpectedCaptured: Amount,
+		},
+		{
+			Name: "payment_not_authorized",
+			SetupMocks: func(m *MockCapture) {
+				m.RepoMock.EXPECT().GetByID(m.Ctx, PaymentID).Return(pendingPayment(m.Ctx, m.Gateway), nil)
+			},
+			ExpectedError: payment_constant.ErrPaymentNotAuthorized,
+		},
+	}
+}
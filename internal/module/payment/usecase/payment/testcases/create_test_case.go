@@ -0,0 +1,125 @@
+package payment_testcases
+
+import (
+	"context"
+	"errors"
+
+	payment_entity "github.com/Fi44er/sdmed/internal/module/payment/entity"
+	"github.com/Fi44er/sdmed/internal/module/payment/gateway/fake"
+	payment_constant "github.com/Fi44er/sdmed/internal/module/payment/pkg"
+	"github.com/Fi44er/sdmed/internal/module/payment/usecase/payment/mock"
+	uow_mock "github.com/Fi44er/sdmed/pkg/postgres/uow/mock"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+type MockCreate struct {
+	Ctrl        *gomock.Controller
+	Ctx         context.Context
+	RepoMock    *mock.MockIPaymentRepository
+	OrderMock   *mock.MockIOrderUsecaseAdapter
+	ReceiptMock *mock.MockIReceiptUsecaseAdapter
+	UowMock     *uow_mock.MockUow
+	Gateway     *fake.FakeGateway
+	T           assert.TestingT
+}
+
+type CreateTestCase struct {
+	Name               string
+	OrderID            string
+	Provider           string
+	SetupMocks         func(m *MockCreate)
+	ExpectedPaymentID  string
+	ExpectedExternalID string
+	ExpectedError      error
+}
+
+const (
+	PaymentID = "5d0c1d8e-6f0b-4a53-9f5e-2c8b7f1e9a10"
+	OrderID   = "order-1"
+	Amount    = 1500.5
+)
+
+var newOrder = &payment_entity.PaymentOrder{ID: OrderID, UserID: "user-1", Status: "new", Total: Amount, Email: "buyer@example.com"}
+
+func GetCreateTestCases() []CreateTestCase {
+	return []CreateTestCase{
+		{
+			Name:     "payment_created_in_gateway",
+			OrderID:  OrderID,
+			Provider: fake.Name,
+			SetupMocks: func(m *MockCreate) {
+				m.OrderMock.EXPECT().GetOwn(m.Ctx, OrderID).Return(newOrder, nil)
+				m.OrderMock.EXPECT().IsPayable(newOrder).Return(true)
+				m.RepoMock.EXPECT().GetByOrderID(m.Ctx, OrderID).Return(nil, nil)
+				m.RepoMock.EXPECT().
+					Create(m.Ctx, gomock.Any()).
+					DoAndReturn(func(ctx context.Context, payment *payment_entity.Payment) error {
+						assert.Equal(m.T, fake.Name, payment.Provider)
+						assert.Equal(m.T, Amount, payment.Amount)
+						assert.Equal(m.T, payment_entity.PaymentStatusPending, payment.Status)
+						return nil
+					})
+				m.RepoMock.EXPECT().SetExternal(m.Ctx, gomock.Any(), "fake-1", "https://fake.local/pay/fake-1").Return(nil)
+			},
+			ExpectedExternalID: "fake-1",
+		},
+		{
+			Name:     "pending_payment_reused",
+			OrderID:  OrderID,
+			Provider: fake.Name,
+			SetupMocks: func(m *MockCreate) {
+				m.OrderMock.EXPECT().GetOwn(m.Ctx, OrderID).Return(newOrder, nil)
+				m.OrderMock.EXPECT().IsPayable(newOrder).Return(true)
+				m.RepoMock.EXPECT().GetByOrderID(m.Ctx, OrderID).Return([]payment_entity.Payment{
+					{ID: "expired", OrderID: OrderID, Provider: fake.Name, ExternalID: "fake-0", Amount: Amount, Status: payment_entity.PaymentStatusExpired},
+					{ID: PaymentID, OrderID: OrderID, Provider: fake.Name, ExternalID: "fake-7", Amount: Amount, Status: payment_entity.PaymentStatusPending},
+				}, nil)
+			},
+			ExpectedPaymentID:  PaymentID,
+			ExpectedExternalID: "fake-7",
+		},
+		{
+			Name:     "gateway_failure_is_persisted",
+			OrderID:  OrderID,
+			Provider: fake.Name,
+			SetupMocks: func(m *MockCreate) {
+				m.Gateway.Err = errors.New("gateway is down")
+				m.OrderMock.EXPECT().GetOwn(m.Ctx, OrderID).Return(newOrder, nil)
+				m.OrderMock.EXPECT().IsPayable(newOrder).Return(true)
+				m.RepoMock.EXPECT().GetByOrderID(m.Ctx, OrderID).Return(nil, nil)
+				m.RepoMock.EXPECT().Create(m.Ctx, gomock.Any()).Return(nil)
+				m.RepoMock.EXPECT().MarkFailed(m.Ctx, gomock.Any(), "gateway is down").Return(true, nil)
+			},
+			ExpectedError: payment_constant.ErrProviderUnavailable,
+		},
+		{
+			Name:     "order_not_payable",
+			OrderID:  OrderID,
+			Provider: fake.Name,
+			SetupMocks: func(m *MockCreate) {
+				m.OrderMock.EXPECT().GetOwn(m.Ctx, OrderID).Return(newOrder, nil)
+				m.OrderMock.EXPECT().IsPayable(newOrder).Return(false)
+			},
+			ExpectedError: payment_constant.ErrOrderNotPayable,
+		},
+		{
+			Name:     "nothing_to_pay",
+			OrderID:  OrderID,
+			Provider: fake.Name,
+			SetupMocks: func(m *MockCreate) {
+				covered := &payment_entity.PaymentOrder{ID: OrderID, Status: "new"}
+				m.OrderMock.EXPECT().GetOwn(m.Ctx, OrderID).Return(covered, nil)
+				m.OrderMock.EXPECT().IsPayable(covered).Return(true)
+			},
+			ExpectedError: payment_constant.ErrNothingToPay,
+		},
+		{
+			Name:          "unknown_provider",
+			OrderID:       OrderID,
+			Provider:      "unknown",
+			SetupMocks:    func(m *MockCreate) {},
+			ExpectedError: payment_constant.ErrProviderNotFound,
+		},
+	}
+}
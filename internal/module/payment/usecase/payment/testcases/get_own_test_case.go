@@ -0,0 +1,83 @@
+package payment_testcases
+
+import (
+	"context"
+
+	payment_entity "github.com/Fi44er/sdmed/internal/module/payment/entity"
+	"github.com/Fi44er/sdmed/internal/module/payment/gateway/fake"
+	payment_constant "github.com/Fi44er/sdmed/internal/module/payment/pkg"
+	"github.com/Fi44er/sdmed/internal/module/payment/usecase/payment/mock"
+	uow_mock "github.com/Fi44er/sdmed/pkg/postgres/uow/mock"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+type MockGetOwn struct {
+	Ctrl        *gomock.Controller
+	Ctx         context.Context
+	RepoMock    *mock.MockIPaymentRepository
+	OrderMock   *mock.MockIOrderUsecaseAdapter
+	ReceiptMock *mock.MockIReceiptUsecaseAdapter
+	UowMock     *uow_mock.MockUow
+	Gateway     *fake.FakeGateway
+	T           assert.TestingT
+}
+
+type GetOwnTestCase struct {
+	Name           string
+	ID             string
+	SetupMocks     func(m *MockGetOwn)
+	ExpectedStatus payment_entity.PaymentStatus
+	ExpectedError  error
+}
+
+func GetGetOwnTestCases() []GetOwnTestCase {
+	return []GetOwnTestCase{
+		{
+			Name: "lost_webhook_synced_from_gateway",
+			ID:   PaymentID,
+			SetupMocks: func(m *MockGetOwn) {
+				payment := pendingPayment(m.Ctx, m.Gateway)
+				m.Gateway.Pay(payment.ExternalID)
+
+				m.RepoMock.EXPECT().GetByID(m.Ctx, PaymentID).Return(payment, nil)
+				m.OrderMock.EXPECT().GetOwn(m.Ctx, OrderID).Return(newOrder, nil)
+				expectTx(m.Ctx, m.UowMock, m.RepoMock)
+				m.RepoMock.EXPECT().MarkPaid(m.Ctx, PaymentID, "txn-"+payment.ExternalID).Return(true, nil)
+				m.OrderMock.EXPECT().GetByID(m.Ctx, OrderID).Return(newOrder, nil)
+				m.OrderMock.EXPECT().MarkPaid(m.Ctx, OrderID, payment.Amount, gomock.Any()).Return(true, nil)
+				m.ReceiptMock.EXPECT().EnqueueSell(m.Ctx, payment).Return(nil)
+				m.OrderMock.EXPECT().NotifyPaid(m.Ctx, OrderID).Return(nil)
+
+				paid := *payment
+				paid.Status = payment_entity.PaymentStatusPaid
+				m.RepoMock.EXPECT().GetByID(m.Ctx, PaymentID).Return(&paid, nil)
+			},
+			ExpectedStatus: payment_entity.PaymentStatusPaid,
+		},
+		{
+			Name: "finished_payment_not_synced",
+			ID:   PaymentID,
+			SetupMocks: func(m *MockGetOwn) {
+				m.RepoMock.EXPECT().GetByID(m.Ctx, PaymentID).Return(paidPayment(0), nil)
+				m.OrderMock.EXPECT().GetOwn(m.Ctx, OrderID).Return(newOrder, nil)
+			},
+			ExpectedStatus: payment_entity.PaymentStatusPaid,
+		},
+		{
+			Name: "foreign_order",
+			ID:   PaymentID,
+			SetupMocks: func(m *MockGetOwn) {
+				m.RepoMock.EXPECT().GetByID(m.Ctx, PaymentID).Return(paidPayment(0), nil)
+				m.OrderMock.EXPECT().GetOwn(m.Ctx, OrderID).Return(nil, payment_constant.ErrSessionUserNotFound)
+			},
+			ExpectedError: payment_constant.ErrPaymentNotFound,
+		},
+		{
+			Name:          "invalid_id",
+			ID:            "not-a-uuid",
+			SetupMocks:    func(m *MockGetOwn) {},
+			ExpectedError: payment_constant.ErrPaymentNotFound,
+		},
+	}
+}
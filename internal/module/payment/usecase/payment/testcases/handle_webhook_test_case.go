@@ -0,0 +1,201 @@
+package payment_testcases
+
+import (
+	"context"
+
+	payment_entity "github.com/Fi44er/sdmed/internal/module/payment/entity"
+	"github.com/Fi44er/sdmed/internal/module/payment/gateway"
+	"github.com/Fi44er/sdmed/internal/module/payment/gateway/fake"
+	payment_constant "github.com/Fi44er/sdmed/internal/module/payment/pkg"
+	"github.com/Fi44er/sdmed/internal/module/payment/usecase/payment/mock"
+	uow_mock "github.com/Fi44er/sdmed/pkg/postgres/uow/mock"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+type MockHandleWebhook struct {
+	Ctrl        *gomock.Controller
+	Ctx         context.Context
+	RepoMock    *mock.MockIPaymentRepository
+	OrderMock   *mock.MockIOrderUsecaseAdapter
+	ReceiptMock *mock.MockIReceiptUsecaseAdapter
+	UowMock     *uow_mock.MockUow
+	Gateway     *fake.FakeGateway
+	T           assert.TestingT
+
+	// Request - уведомление шлюза, которое строит SetupMocks
+	Request *gateway.WebhookRequest
+}
+
+type HandleWebhookTestCase struct {
+	Name           string
+	Provider       string
+	SetupMocks     func(m *MockHandleWebhook)
+	ExpectedAnswer string
+	ExpectedError  error
+}
+
+// pendingPayment создает платеж в фейковом шлюзе и возвращает его запись на нашей стороне
+func pendingPayment(ctx context.Context, gw *fake.FakeGateway) *payment_entity.Payment {
+	created, _ := gw.CreatePayment(ctx, &gateway.PaymentRequest{PaymentID: PaymentID, OrderID: OrderID, Amount: Amount})
+	return &payment_entity.Payment{
+		ID:         PaymentID,
+		OrderID:    OrderID,
+		Provider:   fake.Name,
+		ExternalID: created.ExternalID,
+		Amount:     Amount,
+		Status:     payment_entity.PaymentStatusPending,
+	}
+}
+
+func expectTx(ctx context.Context, uowMock *uow_mock.MockUow, repo any) {
+	uowMock.EXPECT().
+		Do(ctx, gomock.Any()).
+		DoAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+			return fn(ctx)
+		})
+	uowMock.EXPECT().GetRepository(ctx, "payment").Return(repo, nil)
+}
+
+func GetHandleWebhookTestCases() []HandleWebhookTestCase {
+	return []HandleWebhookTestCase{
+		{
+			Name:     "paid_order_notified_after_commit",
+			Provider: fake.Name,
+			SetupMocks: func(m *MockHandleWebhook) {
+				payment := pendingPayment(m.Ctx, m.Gateway)
+				m.Gateway.Pay(payment.ExternalID)
+				m.Request = m.Gateway.Webhook(payment.ExternalID)
+
+				m.RepoMock.EXPECT().GetByID(m.Ctx, PaymentID).Return(payment, nil)
+				expectTx(m.Ctx, m.UowMock, m.RepoMock)
+				m.RepoMock.EXPECT().MarkPaid(m.Ctx, PaymentID, "txn-"+payment.ExternalID).Return(true, nil)
+				gomock.InOrder(
+					m.OrderMock.EXPECT().GetByID(m.Ctx, OrderID).Return(newOrder, nil),
+					m.OrderMock.EXPECT().MarkPaid(m.Ctx, OrderID, payment.Amount, "fake payment txn-"+payment.ExternalID).Return(true, nil),
+					m.ReceiptMock.EXPECT().EnqueueSell(m.Ctx, payment).Return(nil),
+					m.OrderMock.EXPECT().NotifyPaid(m.Ctx, OrderID).Return(nil),
+				)
+			},
+			ExpectedAnswer: "OK",
+		},
+		{
+			Name:     "repeated_webhook_acknowledged_without_changes",
+			Provider: fake.Name,
+			SetupMocks: func(m *MockHandleWebhook) {
+				payment := pendingPayment(m.Ctx, m.Gateway)
+				m.Gateway.Pay(payment.ExternalID)
+				m.Request = m.Gateway.Webhook(payment.ExternalID)
+
+				m.RepoMock.EXPECT().GetByID(m.Ctx, PaymentID).Return(payment, nil)
+				expectTx(m.Ctx, m.UowMock, m.RepoMock)
+				m.RepoMock.EXPECT().MarkPaid(m.Ctx, PaymentID, gomock.Any()).Return(false, nil)
+			},
+			ExpectedAnswer: "OK",
+		},
+		{
+			Name:     "payment_after_order_cancel_flags_refund",
+			Provider: fake.Name,
+			SetupMocks: func(m *MockHandleWebhook) {
+				payment := pendingPayment(m.Ctx, m.Gateway)
+				payment.Status = payment_entity.PaymentStatusExpired
+				m.Gateway.Pay(payment.ExternalID)
+				m.Request = m.Gateway.Webhook(payment.ExternalID)
+
+				m.RepoMock.EXPECT().GetByID(m.Ctx, PaymentID).Return(payment, nil)
+				expectTx(m.Ctx, m.UowMock, m.RepoMock)
+				m.RepoMock.EXPECT().MarkPaid(m.Ctx, PaymentID, gomock.Any()).Return(true, nil)
+				m.OrderMock.EXPECT().GetByID(m.Ctx, OrderID).Return(&payment_entity.PaymentOrder{ID: OrderID, Status: "cancelled", Total: Amount}, nil)
+				m.OrderMock.EXPECT().MarkPaid(m.Ctx, OrderID, payment.Amount, gomock.Any()).Return(false, nil)
+				m.OrderMock.EXPECT().
+					FlagRefundRequired(m.Ctx, OrderID, gomock.Any()).
+					DoAndReturn(func(ctx context.Context, id, comment string) error {
+						assert.Contains(m.T, comment, "refund is required")
+						return nil
+					})
+				m.ReceiptMock.EXPECT().EnqueueSell(m.Ctx, payment).Return(nil)
+			},
+			ExpectedAnswer: "OK",
+		},
+		{
+			Name:     "payment_after_order_total_changed_flags_refund",
+			Provider: fake.Name,
+			SetupMocks: func(m *MockHandleWebhook) {
+				payment := pendingPayment(m.Ctx, m.Gateway)
+				m.Gateway.Pay(payment.ExternalID)
+				m.Request = m.Gateway.Webhook(payment.ExternalID)
+
+				m.RepoMock.EXPECT().GetByID(m.Ctx, PaymentID).Return(payment, nil)
+				expectTx(m.Ctx, m.UowMock, m.RepoMock)
+				m.RepoMock.EXPECT().MarkPaid(m.Ctx, PaymentID, gomock.Any()).Return(true, nil)
+				m.OrderMock.EXPECT().GetByID(m.Ctx, OrderID).Return(&payment_entity.PaymentOrder{ID: OrderID, Status: "confirmed", Total: 2000}, nil)
+				m.OrderMock.EXPECT().
+					FlagRefundRequired(m.Ctx, OrderID, gomock.Any()).
+					DoAndReturn(func(ctx context.Context, id, comment string) error {
+						assert.Contains(m.T, comment, "does not match amount due 2000.00")
+						return nil
+					})
+				m.ReceiptMock.EXPECT().EnqueueSell(m.Ctx, payment).Return(nil)
+			},
+			ExpectedAnswer: "OK",
+		},
+		{
+			Name:     "declined_payment_notifies_customer",
+			Provider: fake.Name,
+			SetupMocks: func(m *MockHandleWebhook) {
+				payment := pendingPayment(m.Ctx, m.Gateway)
+				m.Gateway.Decline(payment.ExternalID)
+				m.Request = m.Gateway.Webhook(payment.ExternalID)
+
+				m.RepoMock.EXPECT().GetByID(m.Ctx, PaymentID).Return(payment, nil)
+				m.RepoMock.EXPECT().MarkFailed(m.Ctx, PaymentID, "declined by provider").Return(true, nil)
+				m.OrderMock.EXPECT().NotifyPaymentFailed(m.Ctx, OrderID).Return(nil)
+			},
+			ExpectedAnswer: "OK",
+		},
+		{
+			Name:     "repeated_decline_notifies_once",
+			Provider: fake.Name,
+			SetupMocks: func(m *MockHandleWebhook) {
+				payment := pendingPayment(m.Ctx, m.Gateway)
+				m.Gateway.Decline(payment.ExternalID)
+				m.Request = m.Gateway.Webhook(payment.ExternalID)
+
+				m.RepoMock.EXPECT().GetByID(m.Ctx, PaymentID).Return(payment, nil)
+				m.RepoMock.EXPECT().MarkFailed(m.Ctx, PaymentID, "declined by provider").Return(false, nil)
+			},
+			ExpectedAnswer: "OK",
+		},
+		{
+			Name:     "amount_mismatch",
+			Provider: fake.Name,
+			SetupMocks: func(m *MockHandleWebhook) {
+				payment := pendingPayment(m.Ctx, m.Gateway)
+				m.Gateway.Pay(payment.ExternalID)
+				m.Request = m.Gateway.Webhook(payment.ExternalID)
+
+				payment.Amount = 100
+				m.RepoMock.EXPECT().GetByID(m.Ctx, PaymentID).Return(payment, nil)
+			},
+			ExpectedError: payment_constant.ErrAmountMismatch,
+		},
+		{
+			Name:     "invalid_signature",
+			Provider: fake.Name,
+			SetupMocks: func(m *MockHandleWebhook) {
+				payment := pendingPayment(m.Ctx, m.Gateway)
+				m.Request = m.Gateway.Webhook(payment.ExternalID)
+				m.Request.Headers["X-Fake-Signature"] = "forged"
+			},
+			ExpectedError: payment_constant.ErrInvalidSignature,
+		},
+		{
+			Name:     "unknown_provider",
+			Provider: "unknown",
+			SetupMocks: func(m *MockHandleWebhook) {
+				m.Request = m.Gateway.Webhook("fake-1")
+			},
+			ExpectedError: payment_constant.ErrProviderNotFound,
+		},
+	}
+}
@@ -0,0 +1,110 @@
+package payment_testcases
+
+import (
+	"context"
+	"errors"
+
+	payment_entity "github.com/Fi44er/sdmed/internal/module/payment/entity"
+	"github.com/Fi44er/sdmed/internal/module/payment/gateway/fake"
+	payment_constant "github.com/Fi44er/sdmed/internal/module/payment/pkg"
+	"github.com/Fi44er/sdmed/internal/module/payment/usecase/payment/mock"
+	uow_mock "github.com/Fi44er/sdmed/pkg/postgres/uow/mock"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+type MockRefund struct {
+	Ctrl        *gomock.Controller
+	Ctx         context.Context
+	RepoMock    *mock.MockIPaymentRepository
+	OrderMock   *mock.MockIOrderUsecaseAdapter
+	ReceiptMock *mock.MockIReceiptUsecaseAdapter
+	UowMock     *uow_mock.MockUow
+	Gateway     *fake.FakeGateway
+	T           assert.TestingT
+}
+
+type RefundTestCase struct {
+	Name            string
+	Amount          float64
+	Items           []payment_entity.RefundItem
+	SetupMocks      func(m *MockRefund)
+	ExpectedRefunds []fake.Refund
+	ExpectedError   error
+}
+
+func paidPayment(refunded float64) *payment_entity.Payment {
+	return &payment_entity.Payment{
+		ID:                PaymentID,
+		OrderID:           OrderID,
+		Provider:          fake.Name,
+		ProviderPaymentID: "txn-1",
+		Amount:            Amount,
+		RefundedAmount:    refunded,
+		Status:            payment_entity.PaymentStatusPaid,
+	}
+}
+
+// refundItems - возвращаемая позиция заказа, по которой формируется чек возврата
+func refundItems() []payment_entity.RefundItem {
+	return []payment_entity.RefundItem{{OrderItemID: "item-1", Quantity: 1, Amount: 500}}
+}
+
+func GetRefundTestCases() []RefundTestCase {
+	return []RefundTestCase{
+		{
+			Name:   "partial_refund_with_receipt",
+			Amount: 500,
+			Items:  refundItems(),
+			SetupMocks: func(m *MockRefund) {
+				payment := paidPayment(0)
+				m.RepoMock.EXPECT().GetByID(m.Ctx, PaymentID).Return(payment, nil)
+				expectTx(m.Ctx, m.UowMock, m.RepoMock)
+				m.RepoMock.EXPECT().AddRefund(m.Ctx, PaymentID, 500.0).Return(true, nil)
+				m.ReceiptMock.EXPECT().EnqueueRefund(m.Ctx, payment, 500.0, refundItems()).Return(nil)
+				m.RepoMock.EXPECT().GetByID(m.Ctx, PaymentID).Return(paidPayment(500), nil)
+			},
+			ExpectedRefunds: []fake.Refund{{ProviderPaymentID: "txn-1", Amount: 500, Partial: true}},
+		},
+		{
+			Name:   "zero_amount_refunds_the_rest",
+			Amount: 0,
+			SetupMocks: func(m *MockRefund) {
+				payment := paidPayment(500)
+				m.RepoMock.EXPECT().GetByID(m.Ctx, PaymentID).Return(payment, nil)
+				expectTx(m.Ctx, m.UowMock, m.RepoMock)
+				m.RepoMock.EXPECT().AddRefund(m.Ctx, PaymentID, 1000.5).Return(true, nil)
+				m.ReceiptMock.EXPECT().EnqueueRefund(m.Ctx, payment, 1000.5, []payment_entity.RefundItem(nil)).Return(nil)
+				m.RepoMock.EXPECT().GetByID(m.Ctx, PaymentID).Return(paidPayment(Amount), nil)
+			},
+			ExpectedRefunds: []fake.Refund{{ProviderPaymentID: "txn-1", Amount: 1000.5, Partial: true}},
+		},
+		{
+			Name:   "amount_exceeds_refundable",
+			Amount: 1001,
+			SetupMocks: func(m *MockRefund) {
+				m.RepoMock.EXPECT().GetByID(m.Ctx, PaymentID).Return(paidPayment(500), nil)
+			},
+			ExpectedError: payment_constant.ErrRefundAmountInvalid,
+		},
+		{
+			Name:   "payment_not_paid",
+			Amount: 100,
+			SetupMocks: func(m *MockRefund) {
+				m.RepoMock.EXPECT().GetByID(m.Ctx, PaymentID).Return(&payment_entity.Payment{
+					ID: PaymentID, OrderID: OrderID, Provider: fake.Name, Amount: Amount, Status: payment_entity.PaymentStatusPending,
+				}, nil)
+			},
+			ExpectedError: payment_constant.ErrPaymentNotPaid,
+		},
+		{
+			Name:   "provider_error_records_nothing",
+			Amount: 100,
+			SetupMocks: func(m *MockRefund) {
+				m.Gateway.Err = errors.New("gateway is down")
+				m.RepoMock.EXPECT().GetByID(m.Ctx, PaymentID).Return(paidPayment(0), nil)
+			},
+			ExpectedError: payment_constant.ErrProviderUnavailable,
+		},
+	}
+}
@@ -0,0 +1,422 @@
+package payment_usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+
+	payment_entity "github.com/Fi44er/sdmed/internal/module/payment/entity"
+	"github.com/Fi44er/sdmed/internal/module/payment/gateway"
+	payment_constant "github.com/Fi44er/sdmed/internal/module/payment/pkg"
+	payment_usecase_contracts "github.com/Fi44er/sdmed/internal/module/payment/usecase/payment/contracts"
+	"github.com/Fi44er/sdmed/pkg/logger"
+	"github.com/Fi44er/sdmed/pkg/postgres/uow"
+	"github.com/google/uuid"
+)
+
+type IPaymentUsecase interface {
+	Create(ctx context.Context, orderID, provider string) (*payment_entity.Payment, error)
+	GetOwn(ctx context.Context, id string) (*payment_entity.Payment, error)
+	HandleWebhook(ctx context.Context, provider string, request *gateway.WebhookRequest) (string, error)
+
+	GetByOrderID(ctx context.Context, orderID string) ([]payment_entity.Payment, error)
+	Capture(ctx context.Context, id string) (*payment_entity.Payment, error)
+	Refund(ctx context.Context, id string, amount float64, items []payment_entity.RefundItem) (*payment_entity.Payment, error)
+}
+
+type PaymentUsecase struct {
+	repository     payment_usecase_contracts.IPaymentRepository
+	gateways       payment_usecase_contracts.IGatewayRegistry
+	orderUsecase   payment_usecase_contracts.IOrderUsecaseAdapter
+	receiptUsecase payment_usecase_contracts.IReceiptUsecaseAdapter
+	uow            uow.Uow
+	logger         *logger.Logger
+}
+
+func NewPaymentUsecase(
+	repository payment_usecase_contracts.IPaymentRepository,
+	gateways payment_usecase_contracts.IGatewayRegistry,
+	orderUsecase payment_usecase_contracts.IOrderUsecaseAdapter,
+	receiptUsecase payment_usecase_contracts.IReceiptUsecaseAdapter,
+	uow uow.Uow,
+	logger *logger.Logger,
+) IPaymentUsecase {
+	return &PaymentUsecase{
+		repository:     repository,
+		gateways:       gateways,
+		orderUsecase:   orderUsecase,
+		receiptUsecase: receiptUsecase,
+		uow:            uow,
+		logger:         logger,
+	}
+}
+
+// Create создает платеж на сумму заказа через шлюз provider. Если по заказу уже есть
+// ожидающий платеж этого шлюза на ту же сумму, возвращается он. Попытка сохраняется
+// до обращения к шлюзу, при ошибке шлюза она остается в статусе failed
+func (u *PaymentUsecase) Create(ctx context.Context, orderID, provider string) (*payment_entity.Payment, error) {
+	if provider == "" {
+		provider = payment_constant.DefaultProvider
+	}
+	gw, err := u.getGateway(provider)
+	if err != nil {
+		return nil, err
+	}
+
+	order, err := u.orderUsecase.GetOwn(ctx, orderID)
+	if err != nil {
+		return nil, err
+	}
+	if !u.orderUsecase.IsPayable(order) {
+		return nil, payment_constant.ErrOrderNotPayable
+	}
+	if order.Total <= 0 {
+		return nil, payment_constant.ErrNothingToPay
+	}
+
+	payments, err := u.repository.GetByOrderID(ctx, orderID)
+	if err != nil {
+		return nil, err
+	}
+	for i := range payments {
+		existing := &payments[i]
+		if existing.Provider == provider && existing.Status == payment_entity.PaymentStatusPending &&
+			existing.ExternalID != "" && equalAmounts(existing.Amount, order.Total) {
+			u.logger.Infof("Reusing pending payment %s for order %s", existing.ID, orderID)
+			return existing, nil
+		}
+	}
+
+	payment := &payment_entity.Payment{
+		ID:       uuid.NewString(),
+		OrderID:  order.ID,
+		Provider: provider,
+		Amount:   order.Total,
+		Status:   payment_entity.PaymentStatusPending,
+	}
+	if err := u.repository.Create(ctx, payment); err != nil {
+		return nil, err
+	}
+
+	created, err := gw.CreatePayment(ctx, &gateway.PaymentRequest{
+		PaymentID:   payment.ID,
+		OrderID:     order.ID,
+		Amount:      payment.Amount,
+		Description: fmt.Sprintf("Заказ %s", order.ID),
+		Customer: gateway.Customer{
+			Name:  order.ContactName,
+			Email: order.Email,
+			Phone: order.Phone,
+		},
+	})
+	if err != nil {
+		u.logger.Errorf("Gateway %s failed to create payment %s: %v", provider, payment.ID, err)
+		if _, markErr := u.repository.MarkFailed(ctx, payment.ID, err.Error()); markErr != nil {
+			u.logger.Errorf("Failed to record failed payment %s: %v", payment.ID, markErr)
+		}
+		return nil, payment_constant.ErrProviderUnavailable.WithCause(err)
+	}
+
+	if err := u.repository.SetExternal(ctx, payment.ID, created.ExternalID, created.ConfirmationURL); err != nil {
+		return nil, err
+	}
+	payment.ExternalID, payment.PaymentURL = created.ExternalID, created.ConfirmationURL
+
+	return payment, nil
+}
+
+// GetOwn возвращает платеж по заказу пользователя сессии. Незавершенный платеж
+// сверяется со статусом в шлюзе на случай потерянного уведомления
+func (u *PaymentUsecase) GetOwn(ctx context.Context, id string) (*payment_entity.Payment, error) {
+	payment, err := u.getByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := u.orderUsecase.GetOwn(ctx, payment.OrderID); err != nil {
+		return nil, payment_constant.ErrPaymentNotFound
+	}
+
+	if !payment.IsActive() || payment.ExternalID == "" {
+		return payment, nil
+	}
+
+	if err := u.sync(ctx, payment); err != nil {
+		u.logger.Warnf("Failed to sync payment %s with provider: %v", payment.ID, err)
+		return payment, nil
+	}
+
+	return u.getByID(ctx, id)
+}
+
+// HandleWebhook обрабатывает уведомление шлюза provider и возвращает ответ, который
+// шлюз ждет в подтверждение. Повторные уведомления подтверждаются без изменений
+func (u *PaymentUsecase) HandleWebhook(ctx context.Context, provider string, request *gateway.WebhookRequest) (string, error) {
+	gw, err := u.getGateway(provider)
+	if err != nil {
+		return "", err
+	}
+
+	event, err := gw.ParseWebhook(ctx, request)
+	if err != nil {
+		if errors.Is(err, gateway.ErrInvalidSignature) {
+			u.logger.Warnf("Rejected %s webhook with invalid signature", provider)
+			return "", payment_constant.ErrInvalidSignature
+		}
+		u.logger.Errorf("Failed to parse %s webhook: %v", provider, err)
+		return "", err
+	}
+	u.logger.Infof("%s webhook for payment %s: %s, amount %.2f", provider, event.PaymentID, event.Status, event.Amount)
+
+	payment, err := u.getByID(ctx, event.PaymentID)
+	if err != nil {
+		return "", err
+	}
+	if payment.Provider != provider {
+		u.logger.Warnf("Payment %s belongs to %s, not %s", payment.ID, payment.Provider, provider)
+		return "", payment_constant.ErrPaymentNotFound
+	}
+
+	if (event.Status == gateway.StatusPaid || event.Status == gateway.StatusAuthorized) && !equalAmounts(event.Amount, payment.Amount) {
+		u.logger.Errorf("Payment %s: webhook amount %.2f does not match amount %.2f", payment.ID, event.Amount, payment.Amount)
+		return "", payment_constant.ErrAmountMismatch
+	}
+
+	if err := u.apply(ctx, payment, event.Status, event.ProviderPaymentID); err != nil {
+		return "", err
+	}
+
+	return event.Response, nil
+}
+
+func (u *PaymentUsecase) GetByOrderID(ctx context.Context, orderID string) ([]payment_entity.Payment, error) {
+	if _, err := u.orderUsecase.GetByID(ctx, orderID); err != nil {
+		return nil, err
+	}
+
+	return u.repository.GetByOrderID(ctx, orderID)
+}
+
+// Capture списывает заблокированные по платежу средства при двухстадийной оплате
+func (u *PaymentUsecase) Capture(ctx context.Context, id string) (*payment_entity.Payment, error) {
+	payment, err := u.getByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if payment.Status != payment_entity.PaymentStatusAuthorized {
+		return nil, payment_constant.ErrPaymentNotAuthorized
+	}
+
+	gw, err := u.getGateway(payment.Provider)
+	if err != nil {
+		return nil, err
+	}
+
+	u.logger.Infof("Capturing %.2f of payment %s", payment.Amount, id)
+	if err := gw.Capture(ctx, payment.ProviderPaymentID, payment.Amount); err != nil {
+		return nil, payment_constant.ErrProviderUnavailable.WithCause(err)
+	}
+
+	if err := u.markPaid(ctx, payment, ""); err != nil {
+		return nil, err
+	}
+
+	return u.getByID(ctx, id)
+}
+
+// Refund возвращает amount по оплаченному платежу. Нулевая сумма - возврат остатка целиком.
+// items - возвращаемые позиции для чека, без них чек формируется по заказу целиком
+func (u *PaymentUsecase) Refund(ctx context.Context, id string, amount float64, items []payment_entity.RefundItem) (*payment_entity.Payment, error) {
+	payment, err := u.getByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if payment.Status != payment_entity.PaymentStatusPaid {
+		return nil, payment_constant.ErrPaymentNotPaid
+	}
+
+	refundable := payment.Refundable()
+	if amount == 0 {
+		amount = refundable
+	}
+	amount = math.Round(amount*100) / 100
+	if amount <= 0 || amount > refundable+payment_constant.AmountEpsilon {
+		return nil, payment_constant.ErrRefundAmountInvalid
+	}
+
+	gw, err := u.getGateway(payment.Provider)
+	if err != nil {
+		return nil, err
+	}
+	u.logger.Infof("Refunding %.2f of payment %s", amount, id)
+
+	partial := !equalAmounts(amount, payment.Amount)
+	if err := gw.Refund(ctx, payment.ProviderPaymentID, amount, partial); err != nil {
+		return nil, payment_constant.ErrProviderUnavailable.WithCause(err)
+	}
+
+	err = u.uow.Do(ctx, func(ctx context.Context) error {
+		repo, err := u.getRepository(ctx)
+		if err != nil {
+			return err
+		}
+
+		applied, err := repo.AddRefund(ctx, id, amount)
+		if err != nil {
+			return err
+		}
+		if !applied {
+			u.logger.Errorf("Payment %s: refund %.2f accepted by provider but not recorded", id, amount)
+			return payment_constant.ErrRefundAmountInvalid
+		}
+
+		return u.receiptUsecase.EnqueueRefund(ctx, payment, amount, items)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return u.getByID(ctx, id)
+}
+
+// sync подтягивает статус платежа из шлюза
+func (u *PaymentUsecase) sync(ctx context.Context, payment *payment_entity.Payment) error {
+	gw, err := u.getGateway(payment.Provider)
+	if err != nil {
+		return err
+	}
+
+	info, err := gw.GetStatus(ctx, payment.ExternalID)
+	if err != nil {
+		return err
+	}
+
+	return u.apply(ctx, payment, info.Status, info.ProviderPaymentID)
+}
+
+// apply переводит платеж в статус, о котором сообщил шлюз
+func (u *PaymentUsecase) apply(ctx context.Context, payment *payment_entity.Payment, status gateway.Status, providerPaymentID string) error {
+	var err error
+	switch status {
+	case gateway.StatusPaid:
+		return u.markPaid(ctx, payment, providerPaymentID)
+	case gateway.StatusAuthorized:
+		_, err = u.repository.MarkAuthorized(ctx, payment.ID, providerPaymentID)
+	case gateway.StatusExpired:
+		_, err = u.repository.UpdateStatus(ctx, payment.ID, payment_entity.PaymentStatusPending, payment_entity.PaymentStatusExpired)
+	case gateway.StatusFailed:
+		return u.markFailed(ctx, payment)
+	}
+
+	return err
+}
+
+// markPaid отмечает платеж и заказ оплаченными и ставит в очередь чек прихода в одной транзакции.
+// Чек формируется, даже если заказ уже нельзя перевести в paid или сумма платежа не совпадает
+// с текущей суммой к оплате, например после изменения заказа: деньги получены, а в истории
+// заказа отмечается, что платеж нужно вернуть. Письмо об оплате отправляется после фиксации
+func (u *PaymentUsecase) markPaid(ctx context.Context, payment *payment_entity.Payment, providerPaymentID string) error {
+	var applied bool
+	err := u.uow.Do(ctx, func(ctx context.Context) error {
+		repo, err := u.getRepository(ctx)
+		if err != nil {
+			return err
+		}
+
+		updated, err := repo.MarkPaid(ctx, payment.ID, providerPaymentID)
+		if err != nil {
+			return err
+		}
+		if !updated {
+			u.logger.Infof("Payment %s is already processed", payment.ID)
+			return nil
+		}
+
+		if providerPaymentID == "" {
+			providerPaymentID = payment.ProviderPaymentID
+		}
+
+		order, err := u.orderUsecase.GetByID(ctx, payment.OrderID)
+		if err != nil {
+			return err
+		}
+
+		note := fmt.Sprintf("%s payment %s received but the order cannot be paid, refund is required", payment.Provider, payment.ID)
+		if equalAmounts(payment.Amount, order.Total) {
+			applied, err = u.orderUsecase.MarkPaid(ctx, payment.OrderID, payment.Amount, fmt.Sprintf("%s payment %s", payment.Provider, providerPaymentID))
+			if err != nil {
+				return err
+			}
+		} else {
+			u.logger.Errorf("Payment %s: amount %.2f does not match amount due %.2f of order %s", payment.ID, payment.Amount, order.Total, payment.OrderID)
+			note = fmt.Sprintf("%s payment %s of %.2f does not match amount due %.2f, refund is required", payment.Provider, payment.ID, payment.Amount, order.Total)
+		}
+		if !applied {
+			u.logger.Errorf("Payment %s received for order %s that cannot be marked as paid, refund is required", payment.ID, payment.OrderID)
+			if err := u.orderUsecase.FlagRefundRequired(ctx, payment.OrderID, note); err != nil {
+				return err
+			}
+		}
+
+		return u.receiptUsecase.EnqueueSell(ctx, payment)
+	})
+	if err != nil || !applied {
+		return err
+	}
+
+	if err := u.orderUsecase.NotifyPaid(ctx, payment.OrderID); err != nil {
+		u.logger.Warnf("Failed to notify about paid order %s: %v", payment.OrderID, err)
+	}
+	return nil
+}
+
+// markFailed отмечает платеж отклоненным и сообщает об этом покупателю один раз
+func (u *PaymentUsecase) markFailed(ctx context.Context, payment *payment_entity.Payment) error {
+	updated, err := u.repository.MarkFailed(ctx, payment.ID, "declined by provider")
+	if err != nil || !updated {
+		return err
+	}
+
+	if err := u.orderUsecase.NotifyPaymentFailed(ctx, payment.OrderID); err != nil {
+		u.logger.Warnf("Failed to notify about declined payment %s of order %s: %v", payment.ID, payment.OrderID, err)
+	}
+	return nil
+}
+
+func (u *PaymentUsecase) getGateway(provider string) (gateway.Gateway, error) {
+	gw, err := u.gateways.Get(provider)
+	if err != nil {
+		u.logger.Warnf("Payment gateway %q is not registered", provider)
+		return nil, payment_constant.ErrProviderNotFound
+	}
+	return gw, nil
+}
+
+func (u *PaymentUsecase) getByID(ctx context.Context, id string) (*payment_entity.Payment, error) {
+	if _, err := uuid.Parse(id); err != nil {
+		return nil, payment_constant.ErrPaymentNotFound
+	}
+
+	payment, err := u.repository.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if payment == nil {
+		return nil, payment_constant.ErrPaymentNotFound
+	}
+
+	return payment, nil
+}
+
+func (u *PaymentUsecase) getRepository(ctx context.Context) (payment_usecase_contracts.IPaymentRepository, error) {
+	repo, err := u.uow.GetRepository(ctx, "payment")
+	if err != nil {
+		u.logger.Errorf("Failed to get repository: %v", err)
+		return nil, err
+	}
+	return repo.(payment_usecase_contracts.IPaymentRepository), nil
+}
+
+func equalAmounts(a, b float64) bool {
+	return math.Abs(a-b) < payment_constant.AmountEpsilon
+}
@@ -0,0 +1,217 @@
+package payment_usecase_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/Fi44er/sdmed/internal/module/payment/gateway"
+	"github.com/Fi44er/sdmed/internal/module/payment/gateway/fake"
+	payment_usecase "github.com/Fi44er/sdmed/internal/module/payment/usecase/payment"
+	"github.com/Fi44er/sdmed/internal/module/payment/usecase/payment/mock"
+	payment_testcases "github.com/Fi44er/sdmed/internal/module/payment/usecase/payment/testcases"
+	"github.com/Fi44er/sdmed/pkg/logger"
+	uow_mock "github.com/Fi44er/sdmed/pkg/postgres/uow/mock"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+)
+
+type PaymentUsecaseTestSuite struct {
+	suite.Suite
+	ctx    context.Context
+	logger *logger.Logger
+}
+
+func (s *PaymentUsecaseTestSuite) SetupSuite() {
+	s.ctx = context.Background()
+	s.logger = logger.NewLogger()
+}
+
+func TestPaymentUsecase(t *testing.T) {
+	suite.Run(t, new(PaymentUsecaseTestSuite))
+}
+
+func registry(gw *fake.FakeGateway) *gateway.Registry {
+	return gateway.NewRegistry(map[string]gateway.Gateway{fake.Name: gw})
+}
+
+func (s *PaymentUsecaseTestSuite) TestCreate() {
+	tests := payment_testcases.GetCreateTestCases()
+
+	for _, tc := range tests {
+		s.T().Run(tc.Name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockStruct := &payment_testcases.MockCreate{
+				Ctrl:        ctrl,
+				Ctx:         s.ctx,
+				RepoMock:    mock.NewMockIPaymentRepository(ctrl),
+				OrderMock:   mock.NewMockIOrderUsecaseAdapter(ctrl),
+				ReceiptMock: mock.NewMockIReceiptUsecaseAdapter(ctrl),
+				UowMock:     uow_mock.NewMockUow(ctrl),
+				Gateway:     fake.NewFakeGateway(),
+				T:           t,
+			}
+
+			usecase := payment_usecase.NewPaymentUsecase(mockStruct.RepoMock, registry(mockStruct.Gateway), mockStruct.OrderMock, mockStruct.ReceiptMock, mockStruct.UowMock, s.logger)
+
+			tc.SetupMocks(mockStruct)
+			payment, err := usecase.Create(s.ctx, tc.OrderID, tc.Provider)
+
+			if tc.ExpectedError != nil {
+				assert.ErrorIs(t, err, tc.ExpectedError)
+				assert.Nil(t, payment)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tc.ExpectedExternalID, payment.ExternalID)
+				if tc.ExpectedPaymentID != "" {
+					assert.Equal(t, tc.ExpectedPaymentID, payment.ID)
+				}
+			}
+		})
+	}
+}
+
+func (s *PaymentUsecaseTestSuite) TestGetOwn() {
+	tests := payment_testcases.GetGetOwnTestCases()
+
+	for _, tc := range tests {
+		s.T().Run(tc.Name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockStruct := &payment_testcases.MockGetOwn{
+				Ctrl:        ctrl,
+				Ctx:         s.ctx,
+				RepoMock:    mock.NewMockIPaymentRepository(ctrl),
+				OrderMock:   mock.NewMockIOrderUsecaseAdapter(ctrl),
+				ReceiptMock: mock.NewMockIReceiptUsecaseAdapter(ctrl),
+				UowMock:     uow_mock.NewMockUow(ctrl),
+				Gateway:     fake.NewFakeGateway(),
+				T:           t,
+			}
+
+			usecase := payment_usecase.NewPaymentUsecase(mockStruct.RepoMock, registry(mockStruct.Gateway), mockStruct.OrderMock, mockStruct.ReceiptMock, mockStruct.UowMock, s.logger)
+
+			tc.SetupMocks(mockStruct)
+			payment, err := usecase.GetOwn(s.ctx, tc.ID)
+
+			if tc.ExpectedError != nil {
+				assert.ErrorIs(t, err, tc.ExpectedError)
+				assert.Nil(t, payment)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tc.ExpectedStatus, payment.Status)
+			}
+		})
+	}
+}
+
+func (s *PaymentUsecaseTestSuite) TestHandleWebhook() {
+	tests := payment_testcases.GetHandleWebhookTestCases()
+
+	for _, tc := range tests {
+		s.T().Run(tc.Name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockStruct := &payment_testcases.MockHandleWebhook{
+				Ctrl:        ctrl,
+				Ctx:         s.ctx,
+				RepoMock:    mock.NewMockIPaymentRepository(ctrl),
+				OrderMock:   mock.NewMockIOrderUsecaseAdapter(ctrl),
+				ReceiptMock: mock.NewMockIReceiptUsecaseAdapter(ctrl),
+				UowMock:     uow_mock.NewMockUow(ctrl),
+				Gateway:     fake.NewFakeGateway(),
+				T:           t,
+			}
+
+			usecase := payment_usecase.NewPaymentUsecase(mockStruct.RepoMock, registry(mockStruct.Gateway), mockStruct.OrderMock, mockStruct.ReceiptMock, mockStruct.UowMock, s.logger)
+
+			tc.SetupMocks(mockStruct)
+			answer, err := usecase.HandleWebhook(s.ctx, tc.Provider, mockStruct.Request)
+
+			if tc.ExpectedError != nil {
+				assert.ErrorIs(t, err, tc.ExpectedError)
+				assert.Empty(t, answer)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tc.ExpectedAnswer, answer)
+			}
+		})
+	}
+}
+
+func (s *PaymentUsecaseTestSuite) TestCapture() {
+	tests := payment_testcases.GetCaptureTestCases()
+
+	for _, tc := range tests {
+		s.T().Run(tc.Name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockStruct := &payment_testcases.MockCapture{
+				Ctrl:        ctrl,
+				Ctx:         s.ctx,
+				RepoMock:    mock.NewMockIPaymentRepository(ctrl),
+				OrderMock:   mock.NewMockIOrderUsecaseAdapter(ctrl),
+				ReceiptMock: mock.NewMockIReceiptUsecaseAdapter(ctrl),
+				UowMock:     uow_mock.NewMockUow(ctrl),
+				Gateway:     fake.NewFakeGateway(),
+				T:           t,
+			}
+
+			usecase := payment_usecase.NewPaymentUsecase(mockStruct.RepoMock, registry(mockStruct.Gateway), mockStruct.OrderMock, mockStruct.ReceiptMock, mockStruct.UowMock, s.logger)
+
+			tc.SetupMocks(mockStruct)
+			payment, err := usecase.Capture(s.ctx, payment_testcases.PaymentID)
+
+			if tc.ExpectedError != nil {
+				assert.ErrorIs(t, err, tc.ExpectedError)
+				assert.Nil(t, payment)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tc.ExpectedStatus, payment.Status)
+				captured, ok := mockStruct.Gateway.Captured(payment.ProviderPaymentID)
+				assert.True(t, ok)
+				assert.Equal(t, tc.ExpectedCaptured, captured)
+			}
+		})
+	}
+}
+
+func (s *PaymentUsecaseTestSuite) TestRefund() {
+	tests := payment_testcases.GetRefundTestCases()
+
+	for _, tc := range tests {
+		s.T().Run(tc.Name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockStruct := &payment_testcases.MockRefund{
+				Ctrl:        ctrl,
+				Ctx:         s.ctx,
+				RepoMock:    mock.NewMockIPaymentRepository(ctrl),
+				OrderMock:   mock.NewMockIOrderUsecaseAdapter(ctrl),
+				ReceiptMock: mock.NewMockIReceiptUsecaseAdapter(ctrl),
+				UowMock:     uow_mock.NewMockUow(ctrl),
+				Gateway:     fake.NewFakeGateway(),
+				T:           t,
+			}
+
+			usecase := payment_usecase.NewPaymentUsecase(mockStruct.RepoMock, registry(mockStruct.Gateway), mockStruct.OrderMock, mockStruct.ReceiptMock, mockStruct.UowMock, s.logger)
+
+			tc.SetupMocks(mockStruct)
+			payment, err := usecase.Refund(s.ctx, payment_testcases.PaymentID, tc.Amount, tc.Items)
+
+			if tc.ExpectedError != nil {
+				assert.ErrorIs(t, err, tc.ExpectedError)
+				assert.Nil(t, payment)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, tc.ExpectedRefunds, mockStruct.Gateway.Refunds())
+		})
+	}
+}
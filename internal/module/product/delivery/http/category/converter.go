@@ -8,6 +8,7 @@ import (
 	"github.com/Fi44er/sdmed/internal/config"
 	product_dto "github.com/Fi44er/sdmed/internal/module/product/dto"
 	product_entity "github.com/Fi44er/sdmed/internal/module/product/entity"
+	"github.com/Fi44er/sdmed/pkg/utils"
 	dto_utils "github.com/Fi44er/sdmed/pkg/utils/dto"
 )
 
@@ -69,6 +70,19 @@ func (c *Converter) ToEntityFromUpdate(dto *product_dto.UpdateCategoryRequest) *
 	}
 }
 
+func (c *Converter) ToEntityFromUpdateSEO(dto *product_dto.UpdateCategorySEORequest) *product_entity.Category {
+	return &product_entity.Category{
+		ID:              dto.ID,
+		MetaTitle:       dto.MetaTitle,
+		MetaDescription: dto.MetaDescription,
+		H1:              dto.H1,
+		Intro:           dto.Intro,
+		FooterText:      dto.FooterText,
+		CanonicalURL:    dto.CanonicalURL,
+		SortPriority:    dto.SortPriority,
+	}
+}
+
 func (c *Converter) ToCategoryListResponse(categories []product_entity.Category, count int64, page, pageSize int) *dto_utils.ListResponse[product_dto.CategoryResponse] {
 	if len(categories) == 0 {
 		return &dto_utils.ListResponse[product_dto.CategoryResponse]{}
@@ -98,6 +112,8 @@ func (c *Converter) toCategoryResponse(category *product_entity.Category) *produ
 		ID:              category.ID,
 		Name:            category.Name,
 		Slug:            category.Slug,
+		SEO:             c.toCategorySEOResponse(category),
+		SortPriority:    utils.Deref(category.SortPriority),
 		Images:          c.toFileResponses(category.Images),
 		Characteristics: c.toCharacteristicResponses(category.Characteristics),
 		CreatedAt:       category.CreatedAt,
@@ -105,6 +121,20 @@ func (c *Converter) toCategoryResponse(category *product_entity.Category) *produ
 	}
 }
 
+func (c *Converter) toCategorySEOResponse(category *product_entity.Category) product_dto.CategorySEOResponse {
+	seo := *category
+	seo.ApplySEODefaults()
+
+	return product_dto.CategorySEOResponse{
+		MetaTitle:       utils.Deref(seo.MetaTitle),
+		MetaDescription: utils.Deref(seo.MetaDescription),
+		H1:              utils.Deref(seo.H1),
+		Intro:           utils.Deref(seo.Intro),
+		FooterText:      utils.Deref(seo.FooterText),
+		CanonicalURL:    utils.Deref(seo.CanonicalURL),
+	}
+}
+
 func (c *Converter) toCharacteristicResponses(characteristics []product_entity.Characteristic) []product_dto.CharacteristicResponse {
 	if len(characteristics) == 0 {
 		return []product_dto.CharacteristicResponse{}
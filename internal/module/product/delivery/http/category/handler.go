@@ -20,6 +20,7 @@ type ICategoryUsecase interface {
 	GetBySlug(ctx context.Context, slug string) (*product_entity.Category, error)
 	Delete(ctx context.Context, id string) error
 	Update(ctx context.Context, category *product_entity.Category) error
+	UpdateSEO(ctx context.Context, category *product_entity.Category) error
 }
 
 type CategoryHandler struct {
@@ -118,6 +119,41 @@ func (h *CategoryHandler) Update(ctx *fiber.Ctx) error {
 	})
 }
 
+// UpdateSEO godoc
+// @Summary Update category SEO metadata
+// @Description Updates meta title, meta description, H1, intro, footer text, canonical URL and sort priority. Omitted fields are left unchanged, empty strings reset the field to the template default
+// @Tags categories
+// @Accept json
+// @Produce json
+// @Param id path string true "Category ID"
+// @Param seo body product_dto.UpdateCategorySEORequest true "SEO metadata"
+// @Success 200 {object} response.Response "OK"
+// @Failure 403 {object} response.Response "Forbidden"
+// @Failure 404 {object} response.Response "Category not found"
+// @Failure 500 {object} response.Response "Error"
+// @Router /categories/{id}/seo [patch]
+func (h *CategoryHandler) UpdateSEO(ctx *fiber.Ctx) error {
+	dto := new(product_dto.UpdateCategorySEORequest)
+	dto.ID = ctx.Params("id")
+
+	entity, err := utils.ParseAndValidate(ctx, dto, h.validator, h.converter.ToEntityFromUpdateSEO, h.logger)
+	if err != nil {
+		return ctx.Status(400).JSON(fiber.Map{
+			"status":  "fail",
+			"message": err.Error(),
+		})
+	}
+
+	if err := h.usecase.UpdateSEO(ctx.Context(), entity); err != nil {
+		return err
+	}
+
+	return ctx.Status(200).JSON(fiber.Map{
+		"status":  "success",
+		"message": "category seo updated successfully",
+	})
+}
+
 // GetByID godoc
 // @Summary Get category by ID
 // @Description Get a single category by its ID
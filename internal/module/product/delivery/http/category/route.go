@@ -1,6 +1,9 @@
 package category_http
 
-import "github.com/gofiber/fiber/v2"
+import (
+	"github.com/Fi44er/sdmed/internal/middlewares"
+	"github.com/gofiber/fiber/v2"
+)
 
 func (h *CategoryHandler) RegisterRoutes(router fiber.Router) {
 	categories := router.Group("/categories")
@@ -9,6 +12,7 @@ func (h *CategoryHandler) RegisterRoutes(router fiber.Router) {
 	categories.Get("/", h.GetAll)
 	categories.Delete("/:id", h.Delete)
 	categories.Put("/:id", h.Update)
+	categories.Patch("/:id/seo", middlewares.Authorize("category", "update"), h.UpdateSEO)
 	categories.Get("/by-slug/:slug", h.GetBySlug)
 
 	// categories.Get("/filters/:category_id", h.GetFiltersByCategoryID)
@@ -78,11 +78,43 @@ func (c *Converter) ToProductResponse(product *product_entity.Product) *product_
 		IsActive:             product.IsActive,
 		Images:               c.toFileResponses(product.Images),
 		CharacteristicValues: charValuesDTO,
+		Reimbursement:        c.toReimbursementResponse(product.Reimbursement),
+		Certificate:          c.ToCertificateResponse(product.Certificate),
 		CreateAt:             product.CreatedAt,
 		UpdateAt:             product.UpdatedAt,
 	}
 }
 
+func (c *Converter) toReimbursementResponse(reimbursement *product_entity.Reimbursement) *product_dto.ReimbursementResponse {
+	if reimbursement == nil {
+		return nil
+	}
+
+	return &product_dto.ReimbursementResponse{
+		RegionID: reimbursement.RegionID,
+		TRUCode:  reimbursement.Code,
+		Price:    reimbursement.Price,
+	}
+}
+
+func (c *Converter) ToCertificateResponse(calculation *product_entity.CertificateCalculation) *product_dto.CertificateResponse {
+	if calculation == nil {
+		return nil
+	}
+
+	return &product_dto.CertificateResponse{
+		ProductID:         calculation.ProductID,
+		RegionID:          calculation.RegionID,
+		ProductPrice:      calculation.ProductPrice,
+		LimitPrice:        calculation.LimitPrice,
+		CertificateAmount: calculation.CertificateAmount,
+		CoveredAmount:     calculation.CoveredAmount,
+		Surcharge:         calculation.Surcharge,
+		IsEligible:        calculation.IsEligible,
+		IsFullyCovered:    calculation.IsFullyCovered,
+	}
+}
+
 // ToProductListResponse конвертирует список сущностей продукта в DTO ответа (если нужен список)
 func (c *Converter) ToProductListResponse(products []product_entity.Product, count int64, page, pageSize int) *dto_utils.ListResponse[product_dto.ProductResponse] {
 	if len(products) == 0 {
@@ -161,6 +193,63 @@ func (c *Converter) ToFilterEntity(filter product_dto.ProductQueryParams) produc
 		MinPrice:        filter.MinPrice,
 		MaxPrice:        filter.MaxPrice,
 		Sort:            filter.Sort,
+		RegionID:        filter.RegionID,
 		Characteristics: filter.Characteristics,
+
+		IsCertificateEligible: filter.IsCertificateEligible,
+	}
+}
+
+func (c *Converter) ToCategoryChangeEntity(dto *product_dto.ChangeCategoryRequest) *product_entity.CategoryChange {
+	mappings := make(map[string]string, len(dto.Mappings))
+	for _, mapping := range dto.Mappings {
+		mappings[mapping.FromCharacteristicID] = mapping.ToCharacteristicID
+	}
+
+	return &product_entity.CategoryChange{
+		ProductIDs:       dto.ProductIDs,
+		TargetCategoryID: dto.CategoryID,
+		ManualMappings:   mappings,
+		DropUnmapped:     dto.DropUnmapped,
+	}
+}
+
+func (c *Converter) ToCategoryChangeResponse(change *product_entity.CategoryChange) *product_dto.CategoryChangeResponse {
+	res := &product_dto.CategoryChangeResponse{
+		CategoryID:      change.TargetCategoryID,
+		Mappings:        make([]product_dto.CharMappingResponse, len(change.Mappings)),
+		Unmapped:        make([]product_dto.UnmappedCharValueResponse, len(change.Unmapped)),
+		MissingRequired: make([]product_dto.MissingRequiredCharResponse, len(change.MissingRequired)),
 	}
+
+	for i, mapping := range change.Mappings {
+		res.Mappings[i] = product_dto.CharMappingResponse{
+			FromCharacteristicID:   mapping.FromCharacteristicID,
+			FromCharacteristicName: mapping.FromCharacteristicName,
+			ToCharacteristicID:     mapping.ToCharacteristicID,
+			ToCharacteristicName:   mapping.ToCharacteristicName,
+			IsManual:               mapping.IsManual,
+		}
+	}
+
+	for i, value := range change.Unmapped {
+		res.Unmapped[i] = product_dto.UnmappedCharValueResponse{
+			ProductID:          value.ProductID,
+			CharValueID:        value.CharValueID,
+			CharacteristicID:   value.CharacteristicID,
+			CharacteristicName: value.CharacteristicName,
+			Value:              value.Value,
+			Reason:             string(value.Reason),
+		}
+	}
+
+	for i, missing := range change.MissingRequired {
+		res.MissingRequired[i] = product_dto.MissingRequiredCharResponse{
+			ProductID:          missing.ProductID,
+			CharacteristicID:   missing.CharacteristicID,
+			CharacteristicName: missing.CharacteristicName,
+		}
+	}
+
+	return res
 }
@@ -7,6 +7,7 @@ import (
 	product_dto "github.com/Fi44er/sdmed/internal/module/product/dto"
 	product_entity "github.com/Fi44er/sdmed/internal/module/product/entity"
 	"github.com/Fi44er/sdmed/pkg/logger"
+	"github.com/Fi44er/sdmed/pkg/session"
 	"github.com/Fi44er/sdmed/pkg/utils"
 	"github.com/go-playground/validator/v10"
 	"github.com/gofiber/fiber/v2"
@@ -14,9 +15,12 @@ import (
 
 type IProductUsecase interface {
 	Create(ctx context.Context, product *product_entity.Product) error
-	GetBySlug(ctx context.Context, slug string) (*product_entity.Product, error)
+	GetBySlug(ctx context.Context, slug, regionID string) (*product_entity.Product, error)
 	GetAll(ctx context.Context, params *product_entity.ProductFilterParams) ([]product_entity.Product, int64, error)
 	GetFilters(ctx context.Context, categoryID string) ([]product_entity.Filter, error)
+	PreviewCategoryChange(ctx context.Context, change *product_entity.CategoryChange) error
+	ChangeCategory(ctx context.Context, change *product_entity.CategoryChange) error
+	CalculateCertificate(ctx context.Context, productID, regionID string, certificateAmount *float64) (*product_entity.CertificateCalculation, error)
 }
 
 type ProductHandler struct {
@@ -80,13 +84,27 @@ func (h *ProductHandler) Create(ctx *fiber.Ctx) error {
 // @Accept json
 // @Produce json
 // @Param slug path string true "Slug"
+// @Param region_id query string false "Region ID for reimbursement price, defaults to the customer region"
 // @Success 200 {object} response.Response "OK"
+// @Failure 400 {object} response.Response "Invalid region ID"
 // @Failure 500 {object} response.Response "Error"
 // @Router /products/{slug} [get]
 func (h *ProductHandler) GetBySlug(ctx *fiber.Ctx) error {
 	slug := ctx.Params("slug")
 
-	product, err := h.usecase.GetBySlug(ctx.Context(), slug)
+	query := new(product_dto.ProductRegionQuery)
+	if err := ctx.QueryParser(query); err != nil {
+		h.logger.Errorf("Failed to parse query params: %v", err)
+		return fiber.NewError(fiber.StatusBadRequest, "Invalid query parameters")
+	}
+	if err := h.validator.Struct(query); err != nil {
+		return ctx.Status(400).JSON(fiber.Map{
+			"status":  "fail",
+			"message": err.Error(),
+		})
+	}
+
+	product, err := h.usecase.GetBySlug(h.getCtxWithSession(ctx), slug, query.RegionID)
 	if err != nil {
 		return err
 	}
@@ -112,7 +130,10 @@ func (h *ProductHandler) GetBySlug(ctx *fiber.Ctx) error {
 // @Param max_price query number false "Maximum price"
 // @Param sort query string false "Sorting order: price_asc, price_desc, newest"
 // @Param chars query []string false "Dynamic filters in format chars[char_id]=value"
+// @Param region_id query string false "Region ID for reimbursement prices, defaults to the customer region"
+// @Param is_certificate_eligible query bool false "Only products that can (true) or cannot (false) be paid with an electronic certificate in the region"
 // @Success 200 {object} response.Response "OK"
+// @Failure 400 {object} response.Response "Invalid query parameters or region could not be detected for certificate filter"
 // @Failure 500 {object} response.Response "Internal Server Error"
 // @Router /products [get]
 func (h *ProductHandler) GetAll(ctx *fiber.Ctx) error {
@@ -139,6 +160,13 @@ func (h *ProductHandler) GetAll(ctx *fiber.Ctx) error {
 
 	h.logger.Debugf("Parsed query params: %+v", params)
 
+	if err := h.validator.Struct(params); err != nil {
+		return ctx.Status(400).JSON(fiber.Map{
+			"status":  "fail",
+			"message": err.Error(),
+		})
+	}
+
 	if params.Page <= 0 {
 		params.Page = 1
 	}
@@ -148,7 +176,7 @@ func (h *ProductHandler) GetAll(ctx *fiber.Ctx) error {
 
 	filterParamsEntity := h.converter.ToFilterEntity(*params)
 
-	products, count, err := h.usecase.GetAll(ctx.Context(), &filterParamsEntity)
+	products, count, err := h.usecase.GetAll(h.getCtxWithSession(ctx), &filterParamsEntity)
 	if err != nil {
 		return err
 	}
@@ -185,3 +213,119 @@ func (h *ProductHandler) GetFilters(ctx *fiber.Ctx) error {
 		"data":   filtersRes,
 	})
 }
+
+// @Summary Preview moving products to another category
+// @Description Shows how characteristic values will be re-mapped, which values cannot be mapped and which required characteristics are missing
+// @Tags products
+// @Accept json
+// @Produce json
+// @Param body body product_dto.ChangeCategoryRequest true "Category change"
+// @Success 200 {object} response.Response "OK"
+// @Failure 400 {object} response.Response "Invalid mapping"
+// @Failure 404 {object} response.Response "Category or product not found"
+// @Failure 409 {object} response.Response "Product is already in target category"
+// @Failure 500 {object} response.Response "Error"
+// @Router /products/category-change/preview [post]
+func (h *ProductHandler) PreviewCategoryChange(ctx *fiber.Ctx) error {
+	dto := new(product_dto.ChangeCategoryRequest)
+
+	entity, err := utils.ParseAndValidate(ctx, dto, h.validator, h.converter.ToCategoryChangeEntity, h.logger)
+	if err != nil {
+		return ctx.Status(400).JSON(fiber.Map{
+			"status":  "fail",
+			"message": err.Error(),
+		})
+	}
+
+	if err := h.usecase.PreviewCategoryChange(ctx.Context(), entity); err != nil {
+		return err
+	}
+
+	return ctx.Status(200).JSON(fiber.Map{
+		"status": "success",
+		"data":   h.converter.ToCategoryChangeResponse(entity),
+	})
+}
+
+// @Summary Move products to another category
+// @Description Moves products and re-maps their characteristic values. Fails with 409 if some values cannot be mapped and drop_unmapped is false
+// @Tags products
+// @Accept json
+// @Produce json
+// @Param body body product_dto.ChangeCategoryRequest true "Category change"
+// @Success 200 {object} response.Response "OK"
+// @Failure 400 {object} response.Response "Invalid mapping"
+// @Failure 404 {object} response.Response "Category or product not found"
+// @Failure 409 {object} response.Response "Unmapped characteristic values or product is already in target category"
+// @Failure 500 {object} response.Response "Error"
+// @Router /products/category-change [post]
+func (h *ProductHandler) ChangeCategory(ctx *fiber.Ctx) error {
+	dto := new(product_dto.ChangeCategoryRequest)
+
+	entity, err := utils.ParseAndValidate(ctx, dto, h.validator, h.converter.ToCategoryChangeEntity, h.logger)
+	if err != nil {
+		return ctx.Status(400).JSON(fiber.Map{
+			"status":  "fail",
+			"message": err.Error(),
+		})
+	}
+
+	if err := h.usecase.ChangeCategory(ctx.Context(), entity); err != nil {
+		return err
+	}
+
+	return ctx.Status(200).JSON(fiber.Map{
+		"status":  "success",
+		"message": "product category changed successfully",
+		"data":    h.converter.ToCategoryChangeResponse(entity),
+	})
+}
+
+// @Summary Calculate electronic certificate coverage
+// @Description Returns the part of the product price covered by an SFR electronic certificate in the region and the customer's surcharge
+// @Tags products
+// @Accept json
+// @Produce json
+// @Param id path string true "Product ID"
+// @Param body body product_dto.CertificateCalculationRequest true "Region and certificate amount"
+// @Success 200 {object} response.Response "OK"
+// @Failure 400 {object} response.Response "Invalid request"
+// @Failure 404 {object} response.Response "Product not found"
+// @Failure 500 {object} response.Response "Error"
+// @Router /products/{id}/certificate [post]
+func (h *ProductHandler) CalculateCertificate(ctx *fiber.Ctx) error {
+	dto := new(product_dto.CertificateCalculationRequest)
+
+	if err := ctx.BodyParser(dto); err != nil {
+		return ctx.Status(400).JSON(fiber.Map{
+			"status":  "fail",
+			"message": err.Error(),
+		})
+	}
+
+	if err := h.validator.Struct(dto); err != nil {
+		return ctx.Status(400).JSON(fiber.Map{
+			"status":  "fail",
+			"message": err.Error(),
+		})
+	}
+
+	calculation, err := h.usecase.CalculateCertificate(h.getCtxWithSession(ctx), ctx.Params("id"), dto.RegionID, dto.CertificateAmount)
+	if err != nil {
+		return err
+	}
+
+	return ctx.Status(200).JSON(fiber.Map{
+		"status": "success",
+		"data":   h.converter.ToCertificateResponse(calculation),
+	})
+}
+
+func (h *ProductHandler) getCtxWithSession(ctx *fiber.Ctx) context.Context {
+	sess := session.FromFiberContext(ctx)
+	if sess == nil {
+		return ctx.Context()
+	}
+
+	return context.WithValue(ctx.Context(), "session", *sess)
+}
@@ -1,10 +1,16 @@
 package product_http
 
-import "github.com/gofiber/fiber/v2"
+import (
+	"github.com/Fi44er/sdmed/internal/middlewares"
+	"github.com/gofiber/fiber/v2"
+)
 
 func (h *ProductHandler) RegisterRoutes(router fiber.Router) {
 	products := router.Group("/products")
 	products.Post("/", h.Create)
+	products.Post("/category-change/preview", middlewares.Authorize("category", "update"), h.PreviewCategoryChange)
+	products.Post("/category-change", middlewares.Authorize("category", "update"), h.ChangeCategory)
+	products.Post("/:id/certificate", h.CalculateCertificate)
 	products.Get("/:slug", h.GetBySlug)
 	products.Get("/", h.GetAll)
 	products.Get("/filters/:category_id", h.GetFilters)
@@ -15,10 +15,32 @@ type UpdateCategoryRequest struct {
 	Characteristics []CreateCharacteristicRequest `json:"characteristics,omitempty" validate:"omitempty,dive"`
 }
 
+type UpdateCategorySEORequest struct {
+	ID              string  `json:"-" validate:"required"`
+	MetaTitle       *string `json:"meta_title" validate:"omitempty,max=255"`
+	MetaDescription *string `json:"meta_description" validate:"omitempty,max=512"`
+	H1              *string `json:"h1" validate:"omitempty,max=255"`
+	Intro           *string `json:"intro" validate:"omitempty,max=20000"`
+	FooterText      *string `json:"footer_text" validate:"omitempty,max=20000"`
+	CanonicalURL    *string `json:"canonical_url" validate:"omitempty,max=512,url|len=0"`
+	SortPriority    *int    `json:"sort_priority" validate:"omitempty"`
+}
+
+type CategorySEOResponse struct {
+	MetaTitle       string `json:"meta_title"`
+	MetaDescription string `json:"meta_description"`
+	H1              string `json:"h1"`
+	Intro           string `json:"intro"`
+	FooterText      string `json:"footer_text"`
+	CanonicalURL    string `json:"canonical_url"`
+}
+
 type CategoryResponse struct {
 	ID              string                   `json:"id"`
 	Name            string                   `json:"name"`
 	Slug            string                   `json:"slug"`
+	SEO             CategorySEOResponse      `json:"seo"`
+	SortPriority    int                      `json:"sort_priority"`
 	Images          []FileResponse           `json:"images"`
 	Characteristics []CharacteristicResponse `json:"characteristics"`
 	CreatedAt       time.Time                `json:"created_at"`
@@ -14,16 +14,41 @@ type CreateProductRequest struct {
 }
 
 type ProductResponse struct {
-	ID                   string              `json:"id"`
-	Name                 string              `json:"name"`
-	Article              string              `json:"article"`
-	Description          string              `json:"description"`
-	ManualPrice          float64             `json:"manual_price"`
-	IsActive             bool                `json:"is_active"`
-	Images               []FileResponse      `json:"images"`
-	CharacteristicValues []CharValueResponse `json:"characteristic_values"`
-	CreateAt             time.Time           `json:"created_at"`
-	UpdateAt             time.Time           `json:"updated_at"`
+	ID                   string                 `json:"id"`
+	Name                 string                 `json:"name"`
+	Article              string                 `json:"article"`
+	Description          string                 `json:"description"`
+	ManualPrice          float64                `json:"manual_price"`
+	IsActive             bool                   `json:"is_active"`
+	Images               []FileResponse         `json:"images"`
+	CharacteristicValues []CharValueResponse    `json:"characteristic_values"`
+	Reimbursement        *ReimbursementResponse `json:"reimbursement"`
+	Certificate          *CertificateResponse   `json:"certificate"`
+	CreateAt             time.Time              `json:"created_at"`
+	UpdateAt             time.Time              `json:"updated_at"`
+}
+
+type ReimbursementResponse struct {
+	RegionID string  `json:"region_id"`
+	TRUCode  string  `json:"tru_code"`
+	Price    float64 `json:"price"`
+}
+
+type CertificateCalculationRequest struct {
+	RegionID          string   `json:"region_id" validate:"omitempty,uuid"`
+	CertificateAmount *float64 `json:"certificate_amount" validate:"omitempty,gte=0"`
+}
+
+type CertificateResponse struct {
+	ProductID         string   `json:"product_id"`
+	RegionID          string   `json:"region_id"`
+	ProductPrice      float64  `json:"product_price"`
+	LimitPrice        float64  `json:"limit_price"`
+	CertificateAmount *float64 `json:"certificate_amount,omitempty"`
+	CoveredAmount     float64  `json:"covered_amount"`
+	Surcharge         float64  `json:"surcharge"`
+	IsEligible        bool     `json:"is_certificate_eligible"`
+	IsFullyCovered    bool     `json:"is_fully_covered"`
 }
 
 type FilterResponse struct {
@@ -40,6 +65,55 @@ type ProductQueryParams struct {
 	MaxPrice        *float64          `query:"max_price"`
 	Characteristics map[string]string `query:"chars"`
 	Sort            string            `query:"sort"` // например: price_asc, price_desc, newest
-	Page            int               `query:"page"`
-	PageSize        int               `query:"page_size"`
+	RegionID        string            `query:"region_id" validate:"omitempty,uuid"`
+	// IsCertificateEligible - только товары с ценой возмещения в регионе (true) или без нее (false)
+	IsCertificateEligible *bool `query:"is_certificate_eligible"`
+	Page                  int   `query:"page"`
+	PageSize              int   `query:"page_size"`
+}
+
+type ProductRegionQuery struct {
+	RegionID string `query:"region_id" validate:"omitempty,uuid"`
+}
+
+type CharMappingRequest struct {
+	FromCharacteristicID string `json:"from_characteristic_id" validate:"required"`
+	ToCharacteristicID   string `json:"to_characteristic_id" validate:"required"`
+}
+
+type ChangeCategoryRequest struct {
+	ProductIDs   []string             `json:"product_ids" validate:"required,min=1,dive,required"`
+	CategoryID   string               `json:"category_id" validate:"required"`
+	Mappings     []CharMappingRequest `json:"mappings" validate:"omitempty,dive"`
+	DropUnmapped bool                 `json:"drop_unmapped"`
+}
+
+type CharMappingResponse struct {
+	FromCharacteristicID   string `json:"from_characteristic_id"`
+	FromCharacteristicName string `json:"from_characteristic_name"`
+	ToCharacteristicID     string `json:"to_characteristic_id"`
+	ToCharacteristicName   string `json:"to_characteristic_name"`
+	IsManual               bool   `json:"is_manual"`
+}
+
+type UnmappedCharValueResponse struct {
+	ProductID          string `json:"product_id"`
+	CharValueID        string `json:"char_value_id"`
+	CharacteristicID   string `json:"characteristic_id"`
+	CharacteristicName string `json:"characteristic_name"`
+	Value              string `json:"value"`
+	Reason             string `json:"reason"`
+}
+
+type MissingRequiredCharResponse struct {
+	ProductID          string `json:"product_id"`
+	CharacteristicID   string `json:"characteristic_id"`
+	CharacteristicName string `json:"characteristic_name"`
+}
+
+type CategoryChangeResponse struct {
+	CategoryID      string                        `json:"category_id"`
+	Mappings        []CharMappingResponse         `json:"mappings"`
+	Unmapped        []UnmappedCharValueResponse   `json:"unmapped"`
+	MissingRequired []MissingRequiredCharResponse `json:"missing_required"`
 }
@@ -1,16 +1,26 @@
 package product_entity
 
 import (
+	"strings"
 	"time"
 
 	"github.com/Fi44er/sdmed/pkg/utils"
 )
 
 type Category struct {
-	ID        string
-	Name      string
-	Slug      string
-	Images    []File
+	ID     string
+	Name   string
+	Slug   string
+	Images []File
+
+	MetaTitle       *string
+	MetaDescription *string
+	H1              *string
+	Intro           *string
+	FooterText      *string
+	CanonicalURL    *string
+	SortPriority    *int
+
 	CreatedAt time.Time
 	UpdatedAt time.Time
 	DeletedAt *time.Time
@@ -21,3 +31,28 @@ type Category struct {
 func (c *Category) Slugify() {
 	c.Slug = utils.CreateSlugRU(c.Name)
 }
+
+// Шаблоны SEO по умолчанию, {name} заменяется на название категории
+const (
+	DefaultMetaTitleTemplate       = "Купить {name} по электронному сертификату"
+	DefaultMetaDescriptionTemplate = "{name} — каталог товаров с оплатой электронным сертификатом СФР. Доставка по России."
+	DefaultH1Template              = "{name}"
+)
+
+// ApplySEODefaults заполняет незаданные SEO-поля значениями из шаблонов
+func (c *Category) ApplySEODefaults() {
+	c.MetaTitle = withDefault(c.MetaTitle, c.renderSEOTemplate(DefaultMetaTitleTemplate))
+	c.MetaDescription = withDefault(c.MetaDescription, c.renderSEOTemplate(DefaultMetaDescriptionTemplate))
+	c.H1 = withDefault(c.H1, c.renderSEOTemplate(DefaultH1Template))
+}
+
+func (c *Category) renderSEOTemplate(template string) string {
+	return strings.ReplaceAll(template, "{name}", c.Name)
+}
+
+func withDefault(value *string, def string) *string {
+	if value != nil && strings.TrimSpace(*value) != "" {
+		return value
+	}
+	return &def
+}
@@ -0,0 +1,73 @@
+package product_entity
+
+import "strings"
+
+type UnmappedReason string
+
+const (
+	UnmappedReasonNoMatch        UnmappedReason = "no_matching_characteristic"
+	UnmappedReasonOptionNotFound UnmappedReason = "option_not_found"
+)
+
+// CategoryChange описывает перенос товаров в другую категорию.
+// Mappings, Unmapped и MissingRequired заполняются usecase'ом.
+type CategoryChange struct {
+	ProductIDs       []string
+	TargetCategoryID string
+
+	// ManualMappings - ручное сопоставление старой характеристики с новой,
+	// имеет приоритет над автоматическим
+	ManualMappings map[string]string
+	DropUnmapped   bool
+
+	Mappings        []CharMapping
+	Unmapped        []UnmappedCharValue
+	MissingRequired []MissingRequiredChar
+}
+
+type CharMapping struct {
+	FromCharacteristicID   string
+	FromCharacteristicName string
+	ToCharacteristicID     string
+	ToCharacteristicName   string
+	IsManual               bool
+}
+
+type UnmappedCharValue struct {
+	ProductID          string
+	CharValueID        string
+	CharacteristicID   string
+	CharacteristicName string
+	Value              string
+	Reason             UnmappedReason
+}
+
+type MissingRequiredChar struct {
+	ProductID          string
+	CharacteristicID   string
+	CharacteristicName string
+}
+
+// MatchKey - ключ автоматического сопоставления характеристик: имя, единица измерения и тип
+func (e *Characteristic) MatchKey() string {
+	unit := ""
+	if e.Unit != nil {
+		unit = strings.ToLower(strings.TrimSpace(*e.Unit))
+	}
+	return strings.ToLower(strings.TrimSpace(e.Name)) + "|" + unit + "|" + string(e.DataType)
+}
+
+// FindOption ищет вариант значения без учета регистра
+func (e *Characteristic) FindOption(value string) *CharOption {
+	value = strings.ToLower(strings.TrimSpace(value))
+	for i := range e.Options {
+		if strings.ToLower(strings.TrimSpace(e.Options[i].Value)) == value {
+			return &e.Options[i]
+		}
+	}
+	return nil
+}
+
+func (c *CategoryChange) HasUnmapped() bool {
+	return len(c.Unmapped) > 0
+}
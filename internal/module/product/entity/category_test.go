@@ -0,0 +1,44 @@
+package product_entity
+
+import "testing"
+
+func TestCategoryApplySEODefaults(t *testing.T) {
+	t.Run("fallback_title_description_and_h1", func(t *testing.T) {
+		category := &Category{Name: "Трости"}
+
+		category.ApplySEODefaults()
+
+		if category.MetaTitle == nil || *category.MetaTitle != "Купить Трости по электронному сертификату" {
+			t.Errorf("MetaTitle = %v, want title from template", category.MetaTitle)
+		}
+		want := "Трости — каталог товаров с оплатой электронным сертификатом СФР. Доставка по России."
+		if category.MetaDescription == nil || *category.MetaDescription != want {
+			t.Errorf("MetaDescription = %v, want %q", category.MetaDescription, want)
+		}
+		if category.H1 == nil || *category.H1 != "Трости" {
+			t.Errorf("H1 = %v, want category name", category.H1)
+		}
+	})
+
+	t.Run("blank_values_replaced", func(t *testing.T) {
+		blank := "  "
+		category := &Category{Name: "Трости", MetaDescription: &blank}
+
+		category.ApplySEODefaults()
+
+		if *category.MetaDescription == blank {
+			t.Error("blank MetaDescription must be replaced with default")
+		}
+	})
+
+	t.Run("existing_values_preserved", func(t *testing.T) {
+		title, description, h1 := "Трости для ходьбы", "Описание", "Трости и костыли"
+		category := &Category{Name: "Трости", MetaTitle: &title, MetaDescription: &description, H1: &h1}
+
+		category.ApplySEODefaults()
+
+		if category.MetaTitle != &title || category.MetaDescription != &description || category.H1 != &h1 {
+			t.Errorf("existing SEO values were overwritten: %q, %q, %q", *category.MetaTitle, *category.MetaDescription, *category.H1)
+		}
+	})
+}
@@ -0,0 +1,58 @@
+package product_entity
+
+import "math"
+
+// CertificateCalculation - расчет оплаты товара электронным сертификатом СФР.
+// Сертификат покрывает не больше цены возмещения по КТРУ в регионе,
+// остаток покупатель доплачивает сам.
+type CertificateCalculation struct {
+	ProductID         string
+	RegionID          string
+	ProductPrice      float64
+	LimitPrice        float64
+	CertificateAmount *float64
+	CoveredAmount     float64
+	Surcharge         float64
+	IsEligible        bool
+	IsFullyCovered    bool
+}
+
+// CalculateCertificate считает покрытую сертификатом часть и доплату.
+// certificateAmount nil означает, что номинал сертификата не ограничивает покрытие.
+// Товар без цены не считается полностью покрытым.
+func CalculateCertificate(product *Product, reimbursement *Reimbursement, certificateAmount *float64) *CertificateCalculation {
+	calculation := &CertificateCalculation{
+		ProductID:         product.ID,
+		ProductPrice:      product.Price(),
+		CertificateAmount: certificateAmount,
+	}
+	calculation.Surcharge = calculation.ProductPrice
+
+	if reimbursement == nil {
+		return calculation
+	}
+
+	calculation.RegionID = reimbursement.RegionID
+	calculation.LimitPrice = reimbursement.Price
+	calculation.IsEligible = true
+
+	limit := reimbursement.Price
+	if certificateAmount != nil && *certificateAmount < limit {
+		limit = *certificateAmount
+	}
+
+	if !product.HasPrice() {
+		// без цены продажи покрытие посчитать нельзя, товар не считается оплаченным сертификатом
+		return calculation
+	}
+
+	calculation.CoveredAmount = roundMoney(math.Max(0, math.Min(calculation.ProductPrice, limit)))
+	calculation.Surcharge = roundMoney(calculation.ProductPrice - calculation.CoveredAmount)
+	calculation.IsFullyCovered = calculation.Surcharge == 0
+
+	return calculation
+}
+
+func roundMoney(value float64) float64 {
+	return math.Round(value*100) / 100
+}
@@ -0,0 +1,70 @@
+package product_entity
+
+import "testing"
+
+func TestCalculateCertificate(t *testing.T) {
+	price := func(value float64) *float64 { return &value }
+
+	tests := []struct {
+		name              string
+		product           *Product
+		reimbursement     *Reimbursement
+		certificateAmount *float64
+		covered           float64
+		surcharge         float64
+		eligible          bool
+		fullyCovered      bool
+	}{
+		{
+			name:          "fully_covered_by_limit_price",
+			product:       &Product{ID: "p", ManualPrice: price(900)},
+			reimbursement: &Reimbursement{Price: 1000},
+			covered:       900,
+			eligible:      true,
+			fullyCovered:  true,
+		},
+		{
+			name:          "surcharge_above_limit_price",
+			product:       &Product{ID: "p", ManualPrice: price(1500.5)},
+			reimbursement: &Reimbursement{Price: 1000},
+			covered:       1000,
+			surcharge:     500.5,
+			eligible:      true,
+		},
+		{
+			name:              "limited_by_certificate_amount",
+			product:           &Product{ID: "p", ManualPrice: price(900)},
+			reimbursement:     &Reimbursement{Price: 1000},
+			certificateAmount: price(600),
+			covered:           600,
+			surcharge:         300,
+			eligible:          true,
+		},
+		{
+			name:      "no_reimbursement_price",
+			product:   &Product{ID: "p", ManualPrice: price(900)},
+			surcharge: 900,
+		},
+		{
+			name:          "product_without_price_is_not_covered",
+			product:       &Product{ID: "p"},
+			reimbursement: &Reimbursement{Price: 1000},
+			eligible:      true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			calculation := CalculateCertificate(tt.product, tt.reimbursement, tt.certificateAmount)
+
+			if calculation.CoveredAmount != tt.covered || calculation.Surcharge != tt.surcharge {
+				t.Errorf("covered %.2f, surcharge %.2f, want %.2f and %.2f",
+					calculation.CoveredAmount, calculation.Surcharge, tt.covered, tt.surcharge)
+			}
+			if calculation.IsEligible != tt.eligible || calculation.IsFullyCovered != tt.fullyCovered {
+				t.Errorf("eligible %t, fully covered %t, want %t and %t",
+					calculation.IsEligible, calculation.IsFullyCovered, tt.eligible, tt.fullyCovered)
+			}
+		})
+	}
+}
@@ -25,6 +25,11 @@ type Product struct {
 
 	IsActive bool
 
+	// Reimbursement заполняется для региона покупателя, nil если цены возмещения нет
+	Reimbursement *Reimbursement
+	// Certificate - покрытие электронным сертификатом по цене возмещения
+	Certificate *CertificateCalculation
+
 	CreatedAt time.Time
 	UpdatedAt time.Time
 }
@@ -37,6 +42,24 @@ type ProductFilterParams struct {
 	MaxPrice        *float64
 	Characteristics map[string]string
 	Sort            string
+	RegionID        string
+	// IsCertificateEligible отбирает товары, у которых есть цена возмещения в регионе
+	IsCertificateEligible *bool
+	// EligibleProductIDs - товары с ценой возмещения в регионе, заполняется для IsCertificateEligible
+	EligibleProductIDs []string
+}
+
+// Price - цена товара для покупателя
+func (p *Product) Price() float64 {
+	if p.ManualPrice == nil {
+		return 0
+	}
+	return *p.ManualPrice
+}
+
+// HasPrice сообщает, задана ли у товара цена продажи
+func (p *Product) HasPrice() bool {
+	return p.ManualPrice != nil && *p.ManualPrice > 0
 }
 
 func (p *Product) Slogify() {
@@ -0,0 +1,9 @@
+package product_entity
+
+// Reimbursement - цена возмещения товара по коду КТРУ в регионе покупателя
+type Reimbursement struct {
+	RegionID  string
+	TRUCodeID string
+	Code      string
+	Price     float64
+}
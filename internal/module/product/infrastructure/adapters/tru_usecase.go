@@ -0,0 +1,46 @@
+package product_adapters
+
+import (
+	"context"
+
+	product_entity "github.com/Fi44er/sdmed/internal/module/product/entity"
+	tru_usecase "github.com/Fi44er/sdmed/internal/module/tru/usecase/tru"
+)
+
+type ITRUUsecaseAdapter interface {
+	GetReimbursements(ctx context.Context, productIDs []string, regionID string) (map[string]product_entity.Reimbursement, error)
+	GetEligibleProductIDs(ctx context.Context, regionID string) ([]string, error)
+}
+
+type TRUUsecaseAdapter struct {
+	truUsecase tru_usecase.ITRUUsecase
+}
+
+func NewTRUUsecaseAdapter(truUsecase tru_usecase.ITRUUsecase) ITRUUsecaseAdapter {
+	return &TRUUsecaseAdapter{
+		truUsecase: truUsecase,
+	}
+}
+
+func (a *TRUUsecaseAdapter) GetReimbursements(ctx context.Context, productIDs []string, regionID string) (map[string]product_entity.Reimbursement, error) {
+	reimbursements, err := a.truUsecase.GetReimbursements(ctx, productIDs, regionID)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make(map[string]product_entity.Reimbursement, len(reimbursements))
+	for productID, reimbursement := range reimbursements {
+		result[productID] = product_entity.Reimbursement{
+			RegionID:  reimbursement.RegionID,
+			TRUCodeID: reimbursement.TRUCodeID,
+			Code:      reimbursement.Code,
+			Price:     reimbursement.Price,
+		}
+	}
+
+	return result, nil
+}
+
+func (a *TRUUsecaseAdapter) GetEligibleProductIDs(ctx context.Context, regionID string) ([]string, error) {
+	return a.truUsecase.GetEligibleProductIDs(ctx, regionID)
+}
@@ -15,6 +15,14 @@ func (c *Converter) ToModel(entity *product_entity.Category) *product_model.Cate
 		Slug:      entity.Slug,
 		CreatedAt: entity.CreatedAt,
 		UpdatedAt: entity.UpdatedAt,
+
+		MetaTitle:       entity.MetaTitle,
+		MetaDescription: entity.MetaDescription,
+		H1:              entity.H1,
+		Intro:           entity.Intro,
+		FooterText:      entity.FooterText,
+		CanonicalURL:    entity.CanonicalURL,
+		SortPriority:    entity.SortPriority,
 	}
 
 	if entity.DeletedAt != nil {
@@ -64,6 +72,14 @@ func (c *Converter) ToEntity(model *product_model.Category) *product_entity.Cate
 		Characteristics: characteristicsEntity,
 		CreatedAt:       model.CreatedAt,
 		UpdatedAt:       model.UpdatedAt,
+
+		MetaTitle:       model.MetaTitle,
+		MetaDescription: model.MetaDescription,
+		H1:              model.H1,
+		Intro:           model.Intro,
+		FooterText:      model.FooterText,
+		CanonicalURL:    model.CanonicalURL,
+		SortPriority:    model.SortPriority,
 	}
 
 	if model.DeletedAt.Valid {
@@ -94,7 +94,7 @@ func (r *CategoryRepository) GetAll(ctx context.Context, offset, limit int) ([]p
 	if offset == 0 {
 		offset = -1
 	}
-	if err := r.db.WithContext(ctx).Preload("Characteristics.Options").Limit(limit).Offset(offset).Find(&categoryModels).Error; err != nil {
+	if err := r.db.WithContext(ctx).Preload("Characteristics.Options").Order("sort_priority DESC, name ASC").Limit(limit).Offset(offset).Find(&categoryModels).Error; err != nil {
 		r.logger.Errorf("Failed to get categories: %v", err)
 		return nil, err
 	}
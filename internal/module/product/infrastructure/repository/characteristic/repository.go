@@ -114,7 +114,7 @@ func (r *CharacteristicRepository) GetByCategoryID(ctx context.Context, category
 	r.logger.Debugf("Getting characteristics by category ID: %s", categoryID)
 
 	characteristicModels := []*product_model.Characteristic{}
-	if err := r.db.WithContext(ctx).Where("category_id = ?", categoryID).Find(&characteristicModels).Error; err != nil {
+	if err := r.db.WithContext(ctx).Preload("Options").Where("category_id = ?", categoryID).Find(&characteristicModels).Error; err != nil {
 		if err == gorm.ErrRecordNotFound {
 			r.logger.Warnf("No characteristics found for category: %s", categoryID)
 			return nil, nil
@@ -13,6 +13,8 @@ type ICharValueRepository interface {
 	Create(ctx context.Context, charValue *product_entity.ProductCharValue) error
 	CreateMany(ctx context.Context, charValues []product_entity.ProductCharValue) error
 	Delete(ctx context.Context, id string) error
+	Update(ctx context.Context, charValue *product_entity.ProductCharValue) error
+	DeleteByIDs(ctx context.Context, ids []string) error
 }
 
 type CharValueRepository struct {
@@ -77,3 +79,38 @@ func (r *CharValueRepository) Delete(ctx context.Context, id string) error {
 	r.logger.Infof("Characteristic value deleted successfully: %s", id)
 	return nil
 }
+
+func (r *CharValueRepository) Update(ctx context.Context, charValue *product_entity.ProductCharValue) error {
+	r.logger.Infof("Updating characteristic value: %s", charValue.ID)
+
+	err := r.db.WithContext(ctx).
+		Model(&product_model.CharacteristicValue{}).
+		Where("id = ?", charValue.ID).
+		Updates(map[string]any{
+			"characteristic_id": charValue.CharacteristicID,
+			"string_value":      charValue.StringValue,
+			"number_value":      charValue.NumberValue,
+			"boolean_value":     charValue.BooleanValue,
+			"option_id":         charValue.OptionID,
+			"updated_at":        gorm.Expr("now()"),
+		}).Error
+	if err != nil {
+		r.logger.Errorf("Failed to update characteristic value %s: %v", charValue.ID, err)
+		return err
+	}
+
+	r.logger.Infof("Characteristic value updated successfully: %s", charValue.ID)
+	return nil
+}
+
+func (r *CharValueRepository) DeleteByIDs(ctx context.Context, ids []string) error {
+	r.logger.Infof("Deleting %d characteristic values", len(ids))
+
+	if err := r.db.WithContext(ctx).Where("id IN ?", ids).Delete(&product_model.CharacteristicValue{}).Error; err != nil {
+		r.logger.Errorf("Failed to delete characteristic values: %v", err)
+		return err
+	}
+
+	r.logger.Infof("Characteristic values deleted successfully")
+	return nil
+}
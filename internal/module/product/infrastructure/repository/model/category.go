@@ -7,9 +7,18 @@ import (
 )
 
 type Category struct {
-	ID        string         `gorm:"primaryKey;type:uuid;default:uuid_generate_v4()"`
-	Slug      string         `gorm:"type:varchar(255);not null;uniqueIndex"`
-	Name      string         `gorm:"type:varchar(255);not null;uniqueIndex"`
+	ID   string `gorm:"primaryKey;type:uuid;default:uuid_generate_v4()"`
+	Slug string `gorm:"type:varchar(255);not null;uniqueIndex"`
+	Name string `gorm:"type:varchar(255);not null;uniqueIndex"`
+
+	MetaTitle       *string `gorm:"type:varchar(255)"`
+	MetaDescription *string `gorm:"type:varchar(512)"`
+	H1              *string `gorm:"column:h1;type:varchar(255)"`
+	Intro           *string `gorm:"type:text"`
+	FooterText      *string `gorm:"type:text"`
+	CanonicalURL    *string `gorm:"column:canonical_url;type:varchar(512)"`
+	SortPriority    *int    `gorm:"not null;default:0;index"`
+
 	CreatedAt time.Time      `gorm:"not null;default:now()"`
 	UpdatedAt time.Time      `gorm:"not null;default:now()"`
 	DeletedAt gorm.DeletedAt `gorm:"index"`
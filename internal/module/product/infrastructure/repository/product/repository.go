@@ -21,6 +21,8 @@ type IProductRepository interface {
 	GetBySlug(ctx context.Context, slug string) (*product_entity.Product, error)
 	Count(ctx context.Context) (int64, error)
 	GetFiltersByCategory(ctx context.Context, categoryID string) ([]product_entity.Filter, error)
+	GetByIDs(ctx context.Context, ids []string) ([]product_entity.Product, error)
+	UpdateCategory(ctx context.Context, ids []string, categoryID string) error
 }
 
 type ProductRepository struct {
@@ -98,6 +100,15 @@ func (r *ProductRepository) GetAll(ctx context.Context, params product_entity.Pr
 		query = query.Where("EXISTS (?)", subQuery)
 	}
 
+	if params.IsCertificateEligible != nil {
+		switch {
+		case *params.IsCertificateEligible:
+			query = query.Where("id IN ?", params.EligibleProductIDs)
+		case len(params.EligibleProductIDs) > 0:
+			query = query.Where("id NOT IN ?", params.EligibleProductIDs)
+		}
+	}
+
 	if err := query.Count(&total).Error; err != nil {
 		return nil, 0, err
 	}
@@ -212,6 +223,49 @@ func (r *ProductRepository) GetBySlug(ctx context.Context, slug string) (*produc
 	return product, nil
 }
 
+func (r *ProductRepository) GetByIDs(ctx context.Context, ids []string) ([]product_entity.Product, error) {
+	r.logger.Debugf("Getting products by IDs: %v", ids)
+
+	var productModels []product_model.Product
+	err := r.db.WithContext(ctx).
+		Preload("Characteristics", func(db *gorm.DB) *gorm.DB {
+			return db.
+				Joins("JOIN characteristics ON characteristics.id = characteristic_values.characteristic_id").
+				Select("characteristic_values.*, characteristics.name as characteristic_name")
+		}).
+		Preload("Characteristics.Option").
+		Where("id IN ?", ids).
+		Find(&productModels).Error
+	if err != nil {
+		r.logger.Errorf("Failed to get products by IDs: %v", err)
+		return nil, err
+	}
+
+	products := make([]product_entity.Product, len(productModels))
+	for i, productModel := range productModels {
+		products[i] = *r.converter.ToEntity(&productModel)
+	}
+
+	r.logger.Debugf("Retrieved %d products by IDs", len(products))
+	return products, nil
+}
+
+func (r *ProductRepository) UpdateCategory(ctx context.Context, ids []string, categoryID string) error {
+	r.logger.Infof("Moving %d products to category %s", len(ids), categoryID)
+
+	err := r.db.WithContext(ctx).
+		Model(&product_model.Product{}).
+		Where("id IN ?", ids).
+		Updates(map[string]any{"category_id": categoryID, "updated_at": gorm.Expr("now()")}).Error
+	if err != nil {
+		r.logger.Errorf("Failed to move products to category %s: %v", categoryID, err)
+		return err
+	}
+
+	r.logger.Infof("Products moved successfully to category %s", categoryID)
+	return nil
+}
+
 func (r *ProductRepository) Count(ctx context.Context) (int64, error) {
 	var count int64
 	err := r.db.WithContext(ctx).Model(&product_model.Product{}).Count(&count).Error
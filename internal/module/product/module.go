@@ -14,6 +14,8 @@ import (
 	char_value_usecase "github.com/Fi44er/sdmed/internal/module/product/usecase/char_value"
 	characteristic_usecase "github.com/Fi44er/sdmed/internal/module/product/usecase/characteristic"
 	product_usecase "github.com/Fi44er/sdmed/internal/module/product/usecase/product"
+	region_usecase "github.com/Fi44er/sdmed/internal/module/region/usecase/region"
+	tru_usecase "github.com/Fi44er/sdmed/internal/module/tru/usecase/tru"
 	"github.com/Fi44er/sdmed/pkg/logger"
 	"github.com/Fi44er/sdmed/pkg/postgres/uow"
 	"github.com/Fi44er/sdmed/pkg/redis"
@@ -30,6 +32,9 @@ type ProductModule struct {
 	fileUsecaseAdapter product_adapters.IFileUsecaseAdapter
 	fileUsecase        file_usecase.IFileUsecase
 
+	truUsecase    tru_usecase.ITRUUsecase
+	regionUsecase region_usecase.IRegionUsecase
+
 	characteristicRepository characteristic_repository.ICharacteristicRepository
 	characteristicUsecase    characteristic_usecase.ICharacteristicUsecase
 
@@ -56,15 +61,19 @@ func NewProductModule(
 	fileUsecase file_usecase.IFileUsecase,
 	config *config.Config,
 	redisManager redis.IRedisManager,
+	truUsecase tru_usecase.ITRUUsecase,
+	regionUsecase region_usecase.IRegionUsecase,
 ) *ProductModule {
 	return &ProductModule{
-		logger:       logger,
-		validator:    validator,
-		db:           db,
-		uow:          uow,
-		fileUsecase:  fileUsecase,
-		config:       config,
-		redisManager: redisManager,
+		logger:        logger,
+		validator:     validator,
+		db:            db,
+		uow:           uow,
+		fileUsecase:   fileUsecase,
+		config:        config,
+		redisManager:  redisManager,
+		truUsecase:    truUsecase,
+		regionUsecase: regionUsecase,
 	}
 }
 
@@ -97,7 +106,8 @@ func (m *ProductModule) Init() {
 	m.charValueUsecase = char_value_usecase.NewCharValueUsecase(m.logger, m.charValueRepository, m.uow, m.characteristicUsecase)
 
 	m.productRepository = product_repository.NewProductRepository(m.logger, m.db)
-	m.productUsecase = product_usecase.NewProductUsecase(m.productRepository, m.logger, m.uow, m.redisManager, m.fileUsecaseAdapter, m.charValueUsecase)
+	m.productUsecase = product_usecase.NewProductUsecase(m.productRepository, m.logger, m.uow, m.redisManager, m.fileUsecaseAdapter, m.charValueUsecase, m.characteristicUsecase,
+		product_adapters.NewTRUUsecaseAdapter(m.truUsecase), product_adapters.NewRegionUsecaseAdapter(m.regionUsecase))
 	m.productHandler = product_http.NewProductHandler(m.productUsecase, m.validator, m.logger, m.config)
 }
 
@@ -105,3 +115,11 @@ func (m *ProductModule) InitDelivery(router fiber.Router) {
 	m.categoryHandler.RegisterRoutes(router)
 	m.productHandler.RegisterRoutes(router)
 }
+
+func (m *ProductModule) GetProductUsecase() product_usecase.IProductUsecase {
+	return m.productUsecase
+}
+
+func (m *ProductModule) GetCategoryUsecase() category_usecase.ICategoryUsecase {
+	return m.categoryUsecase
+}
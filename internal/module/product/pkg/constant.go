@@ -16,6 +16,11 @@ var (
 
 	ErrProductAlreadyExists = customerr.NewError(409, "product already exists")
 	ErrProductNotFound      = customerr.NewError(404, "product not found")
+	ErrRegionNotResolved    = customerr.NewError(400, "region is not specified and could not be detected")
+
+	ErrCategoryChangeUnmapped      = customerr.NewError(409, "some characteristic values could not be mapped to target category")
+	ErrCategoryChangeInvalidTarget = customerr.NewError(400, "invalid target characteristic for mapping")
+	ErrCategoryChangeSameCategory  = customerr.NewError(409, "product is already in target category")
 
 	ErrCharacteristicNotFound      = customerr.NewError(404, "characteristic not found")
 	ErrInvalidDataType             = customerr.NewError(400, "invalid data type")
@@ -21,6 +21,7 @@ type ICategoryUsecase interface {
 	GetAll(ctx context.Context, offset, limit int) ([]product_entity.Category, int64, error)
 	Delete(ctx context.Context, id string) error
 	Update(ctx context.Context, category *product_entity.Category) error
+	UpdateSEO(ctx context.Context, category *product_entity.Category) error
 }
 
 type CategoryUsecase struct {
@@ -124,6 +125,49 @@ func (u *CategoryUsecase) Update(ctx context.Context, category *product_entity.C
 	})
 }
 
+// UpdateSEO обновляет только SEO-поля категории, nil-поля не изменяются
+func (u *CategoryUsecase) UpdateSEO(ctx context.Context, category *product_entity.Category) error {
+	u.logger.Infof("Updating SEO metadata for category: %s", category.ID)
+
+	return u.uow.Do(ctx, func(ctx context.Context) error {
+		repo, err := u.uow.GetRepository(ctx, ownerType)
+		if err != nil {
+			u.logger.Errorf("Failed to get repository: %v", err)
+			return err
+		}
+		categoryRepo := repo.(category_usecase_contracts.ICategoryRepository)
+
+		existCategory, err := categoryRepo.GetByID(ctx, category.ID)
+		if err != nil {
+			u.logger.Errorf("Failed to get category from repository: %v", err)
+			return err
+		}
+
+		if existCategory == nil {
+			u.logger.Warnf("Category not found: %s", category.ID)
+			return product_constant.ErrCategoryNotFound
+		}
+
+		seo := &product_entity.Category{
+			ID:              category.ID,
+			MetaTitle:       category.MetaTitle,
+			MetaDescription: category.MetaDescription,
+			H1:              category.H1,
+			Intro:           category.Intro,
+			FooterText:      category.FooterText,
+			CanonicalURL:    category.CanonicalURL,
+			SortPriority:    category.SortPriority,
+		}
+		if err := categoryRepo.Update(ctx, seo); err != nil {
+			u.logger.Errorf("Failed to update SEO metadata for category %s: %v", category.ID, err)
+			return err
+		}
+
+		u.logger.Infof("SEO metadata updated successfully for category: %s", category.ID)
+		return nil
+	})
+}
+
 func (u *CategoryUsecase) Create(ctx context.Context, category *product_entity.Category) error {
 	u.logger.Infof("Creating category: %s", category.Name)
 
@@ -29,6 +29,7 @@ type ICharacteristicUsecase interface {
 	Delete(ctx context.Context, id string) error
 	DeleteByCategory(ctx context.Context, categoryID string) error
 	GetByIDs(ctx context.Context, ids []string) ([]product_entity.Characteristic, error)
+	GetByCategoryID(ctx context.Context, categoryID string) ([]product_entity.Characteristic, error)
 }
 
 type CharacteristicUsecase struct {
@@ -57,6 +58,18 @@ func (u *CharacteristicUsecase) GetByIDs(ctx context.Context, ids []string) ([]p
 	return characteristics, nil
 }
 
+func (u *CharacteristicUsecase) GetByCategoryID(ctx context.Context, categoryID string) ([]product_entity.Characteristic, error) {
+	u.logger.Debugf("Getting characteristics by category: %s", categoryID)
+
+	characteristics, err := u.repository.GetByCategoryID(ctx, categoryID)
+	if err != nil {
+		u.logger.Errorf("Failed to get characteristics by category %s: %v", categoryID, err)
+		return nil, err
+	}
+
+	return characteristics, nil
+}
+
 func (u *CharacteristicUsecase) Delete(ctx context.Context, id string) error {
 	return u.uow.Do(ctx, func(ctx context.Context) error {
 		repo, err := u.uow.GetRepository(ctx, ownerType)
@@ -0,0 +1,280 @@
+package product_usecase
+
+import (
+	"context"
+
+	product_entity "github.com/Fi44er/sdmed/internal/module/product/entity"
+	product_constant "github.com/Fi44er/sdmed/internal/module/product/pkg"
+	product_usecase_contracts "github.com/Fi44er/sdmed/internal/module/product/usecase/product/contracts"
+)
+
+// plannedCategoryChange - результат сопоставления, который применяется в транзакции
+type plannedCategoryChange struct {
+	productIDs       []string
+	sourceCategories []string
+	remapped         []product_entity.ProductCharValue
+	unmappedIDs      []string
+}
+
+func (u *ProductUsecase) PreviewCategoryChange(ctx context.Context, change *product_entity.CategoryChange) error {
+	u.logger.Debugf("Previewing category change of %d products to %s", len(change.ProductIDs), change.TargetCategoryID)
+
+	_, err := u.planCategoryChange(ctx, change)
+	return err
+}
+
+func (u *ProductUsecase) ChangeCategory(ctx context.Context, change *product_entity.CategoryChange) error {
+	u.logger.Infof("Changing category of %d products to %s", len(change.ProductIDs), change.TargetCategoryID)
+
+	var plan *plannedCategoryChange
+	err := u.uow.Do(ctx, func(ctx context.Context) error {
+		var err error
+		plan, err = u.planCategoryChange(ctx, change)
+		if err != nil {
+			return err
+		}
+
+		if change.HasUnmapped() && !change.DropUnmapped {
+			u.logger.Warnf("Category change aborted: %d unmapped characteristic values", len(change.Unmapped))
+			return product_constant.ErrCategoryChangeUnmapped
+		}
+
+		repo, err := u.uow.GetRepository(ctx, "char_value")
+		if err != nil {
+			u.logger.Errorf("Failed to get repository: %v", err)
+			return err
+		}
+		charValueRepo := repo.(product_usecase_contracts.ICharValueRepository)
+
+		for i := range plan.remapped {
+			if err := charValueRepo.Update(ctx, &plan.remapped[i]); err != nil {
+				u.logger.Errorf("Failed to remap characteristic value %s: %v", plan.remapped[i].ID, err)
+				return err
+			}
+		}
+
+		if len(plan.unmappedIDs) > 0 {
+			if err := charValueRepo.DeleteByIDs(ctx, plan.unmappedIDs); err != nil {
+				u.logger.Errorf("Failed to drop unmapped characteristic values: %v", err)
+				return err
+			}
+		}
+
+		repo, err = u.uow.GetRepository(ctx, ownerType)
+		if err != nil {
+			u.logger.Errorf("Failed to get repository: %v", err)
+			return err
+		}
+		productRepo := repo.(product_usecase_contracts.IProductRepository)
+
+		if err := productRepo.UpdateCategory(ctx, plan.productIDs, change.TargetCategoryID); err != nil {
+			u.logger.Errorf("Failed to move products to category %s: %v", change.TargetCategoryID, err)
+			return err
+		}
+
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, categoryID := range append(plan.sourceCategories, change.TargetCategoryID) {
+		if err := u.cache.Del(ctx, product_constant.CategoryFiltersKeyPrefix+categoryID); err != nil {
+			u.logger.Warnf("Failed to invalidate filters cache for category %s: %v", categoryID, err)
+		}
+	}
+
+	u.logger.Infof("Moved %d products to category %s (remapped: %d, dropped: %d)",
+		len(plan.productIDs), change.TargetCategoryID, len(plan.remapped), len(plan.unmappedIDs))
+	return nil
+}
+
+func (u *ProductUsecase) planCategoryChange(ctx context.Context, change *product_entity.CategoryChange) (*plannedCategoryChange, error) {
+	change.Mappings = make([]product_entity.CharMapping, 0)
+	change.Unmapped = make([]product_entity.UnmappedCharValue, 0)
+	change.MissingRequired = make([]product_entity.MissingRequiredChar, 0)
+
+	repo, err := u.uow.GetRepository(ctx, "category")
+	if err != nil {
+		u.logger.Errorf("Failed to get repository: %v", err)
+		return nil, err
+	}
+	categoryRepo := repo.(product_usecase_contracts.ICategoryRepository)
+
+	category, err := categoryRepo.GetByID(ctx, change.TargetCategoryID)
+	if err != nil {
+		u.logger.Errorf("Failed to get category %s: %v", change.TargetCategoryID, err)
+		return nil, err
+	}
+	if category == nil {
+		return nil, product_constant.ErrCategoryNotFound
+	}
+
+	repo, err = u.uow.GetRepository(ctx, ownerType)
+	if err != nil {
+		u.logger.Errorf("Failed to get repository: %v", err)
+		return nil, err
+	}
+	productRepo := repo.(product_usecase_contracts.IProductRepository)
+
+	products, err := productRepo.GetByIDs(ctx, change.ProductIDs)
+	if err != nil {
+		u.logger.Errorf("Failed to get products: %v", err)
+		return nil, err
+	}
+
+	found := make(map[string]struct{}, len(products))
+	for _, product := range products {
+		found[product.ID] = struct{}{}
+	}
+	for _, id := range change.ProductIDs {
+		if _, ok := found[id]; !ok {
+			return nil, product_constant.ErrProductNotFound.WithContext("product " + id)
+		}
+	}
+
+	targetChars, err := u.characteristicUsecase.GetByCategoryID(ctx, change.TargetCategoryID)
+	if err != nil {
+		return nil, err
+	}
+
+	targetByID := make(map[string]*product_entity.Characteristic, len(targetChars))
+	targetByKey := make(map[string]*product_entity.Characteristic, len(targetChars))
+	for i := range targetChars {
+		targetByID[targetChars[i].ID] = &targetChars[i]
+		targetByKey[targetChars[i].MatchKey()] = &targetChars[i]
+	}
+
+	sourceCharIDs := make([]string, 0)
+	seenChar := make(map[string]struct{})
+	for _, product := range products {
+		for _, value := range product.CharValues {
+			if _, ok := seenChar[value.CharacteristicID]; !ok {
+				seenChar[value.CharacteristicID] = struct{}{}
+				sourceCharIDs = append(sourceCharIDs, value.CharacteristicID)
+			}
+		}
+	}
+
+	sourceByID := make(map[string]*product_entity.Characteristic, len(sourceCharIDs))
+	if len(sourceCharIDs) > 0 {
+		sourceChars, err := u.characteristicUsecase.GetByIDs(ctx, sourceCharIDs)
+		if err != nil {
+			return nil, err
+		}
+		for i := range sourceChars {
+			sourceByID[sourceChars[i].ID] = &sourceChars[i]
+		}
+	}
+
+	mapped := make(map[string]*product_entity.Characteristic)
+	for _, charID := range sourceCharIDs {
+		source, ok := sourceByID[charID]
+		if !ok {
+			continue
+		}
+
+		var target *product_entity.Characteristic
+		isManual := false
+		if targetID, ok := change.ManualMappings[charID]; ok {
+			target, ok = targetByID[targetID]
+			if !ok || target.DataType != source.DataType {
+				return nil, product_constant.ErrCategoryChangeInvalidTarget.WithContext("characteristic " + source.Name)
+			}
+			isManual = true
+		} else {
+			target = targetByKey[source.MatchKey()]
+		}
+
+		if target == nil {
+			continue
+		}
+
+		mapped[charID] = target
+		change.Mappings = append(change.Mappings, product_entity.CharMapping{
+			FromCharacteristicID:   source.ID,
+			FromCharacteristicName: source.Name,
+			ToCharacteristicID:     target.ID,
+			ToCharacteristicName:   target.Name,
+			IsManual:               isManual,
+		})
+	}
+
+	plan := &plannedCategoryChange{
+		productIDs:       make([]string, 0, len(products)),
+		sourceCategories: make([]string, 0),
+		remapped:         make([]product_entity.ProductCharValue, 0),
+		unmappedIDs:      make([]string, 0),
+	}
+	seenCategory := make(map[string]struct{})
+
+	for _, product := range products {
+		if product.CategoryID != nil && *product.CategoryID == change.TargetCategoryID {
+			return nil, product_constant.ErrCategoryChangeSameCategory.WithContext("product " + product.ID)
+		}
+		plan.productIDs = append(plan.productIDs, product.ID)
+
+		if product.CategoryID != nil {
+			if _, ok := seenCategory[*product.CategoryID]; !ok {
+				seenCategory[*product.CategoryID] = struct{}{}
+				plan.sourceCategories = append(plan.sourceCategories, *product.CategoryID)
+			}
+		}
+
+		covered := make(map[string]struct{})
+		for _, value := range product.CharValues {
+			target, ok := mapped[value.CharacteristicID]
+			if !ok {
+				u.addUnmapped(change, plan, product.ID, value, product_entity.UnmappedReasonNoMatch)
+				continue
+			}
+
+			remapped := value
+			remapped.CharacteristicID = target.ID
+			if target.DataType == product_entity.DataTypeSelect {
+				option := target.FindOption(value.GetStringValue())
+				if option == nil {
+					u.addUnmapped(change, plan, product.ID, value, product_entity.UnmappedReasonOptionNotFound)
+					continue
+				}
+				remapped.OptionID = &option.ID
+				remapped.Option = option
+			}
+
+			covered[target.ID] = struct{}{}
+			plan.remapped = append(plan.remapped, remapped)
+		}
+
+		for _, target := range targetChars {
+			if _, ok := covered[target.ID]; target.IsRequired && !ok {
+				change.MissingRequired = append(change.MissingRequired, product_entity.MissingRequiredChar{
+					ProductID:          product.ID,
+					CharacteristicID:   target.ID,
+					CharacteristicName: target.Name,
+				})
+			}
+		}
+	}
+
+	u.logger.Debugf("Category change plan: %d mappings, %d unmapped values, %d missing required",
+		len(change.Mappings), len(change.Unmapped), len(change.MissingRequired))
+	return plan, nil
+}
+
+func (u *ProductUsecase) addUnmapped(
+	change *product_entity.CategoryChange,
+	plan *plannedCategoryChange,
+	productID string,
+	value product_entity.ProductCharValue,
+	reason product_entity.UnmappedReason,
+) {
+	change.Unmapped = append(change.Unmapped, product_entity.UnmappedCharValue{
+		ProductID:          productID,
+		CharValueID:        value.ID,
+		CharacteristicID:   value.CharacteristicID,
+		CharacteristicName: value.CharacteristicName,
+		Value:              value.GetStringValue(),
+		Reason:             reason,
+	})
+	plan.unmappedIDs = append(plan.unmappedIDs, value.ID)
+}
@@ -0,0 +1,48 @@
+package product_usecase
+
+import (
+	"context"
+
+	product_entity "github.com/Fi44er/sdmed/internal/module/product/entity"
+	product_constant "github.com/Fi44er/sdmed/internal/module/product/pkg"
+)
+
+func (u *ProductUsecase) CalculateCertificate(
+	ctx context.Context,
+	productID, regionID string,
+	certificateAmount *float64,
+) (*product_entity.CertificateCalculation, error) {
+	u.logger.Debugf("Calculating certificate coverage for product %s (region: %s)", productID, regionID)
+
+	product, err := u.repository.GetByID(ctx, productID)
+	if err != nil {
+		u.logger.Errorf("Failed to get product %s: %v", productID, err)
+		return nil, err
+	}
+	if product == nil {
+		return nil, product_constant.ErrProductNotFound
+	}
+
+	regionID = u.resolveRegionID(ctx, regionID)
+	if regionID == "" {
+		return nil, product_constant.ErrRegionNotResolved
+	}
+
+	reimbursements, err := u.truUsecase.GetReimbursements(ctx, []string{product.ID}, regionID)
+	if err != nil {
+		u.logger.Errorf("Failed to get reimbursement for product %s in region %s: %v", product.ID, regionID, err)
+		return nil, err
+	}
+
+	var reimbursement *product_entity.Reimbursement
+	if r, ok := reimbursements[product.ID]; ok {
+		reimbursement = &r
+	}
+
+	calculation := product_entity.CalculateCertificate(product, reimbursement, certificateAmount)
+	calculation.RegionID = regionID
+
+	u.logger.Debugf("Certificate coverage for product %s: covered %.2f, surcharge %.2f",
+		product.ID, calculation.CoveredAmount, calculation.Surcharge)
+	return calculation, nil
+}
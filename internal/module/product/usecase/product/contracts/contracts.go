@@ -26,6 +26,12 @@ type IProductRepository interface {
 	GetBySlug(ctx context.Context, slug string) (*product_entity.Product, error)
 	Count(ctx context.Context) (int64, error)
 	GetFiltersByCategory(ctx context.Context, categoryID string) ([]product_entity.Filter, error)
+	GetByIDs(ctx context.Context, ids []string) ([]product_entity.Product, error)
+	UpdateCategory(ctx context.Context, ids []string, categoryID string) error
+}
+
+type ICategoryRepository interface {
+	GetByID(ctx context.Context, id string) (*product_entity.Category, error)
 }
 
 type ICache interface {
@@ -37,3 +43,22 @@ type ICache interface {
 type ICharValueUsecase interface {
 	CreateMany(ctx context.Context, charValues []product_entity.ProductCharValue) error
 }
+
+type ICharValueRepository interface {
+	Update(ctx context.Context, charValue *product_entity.ProductCharValue) error
+	DeleteByIDs(ctx context.Context, ids []string) error
+}
+
+type ITRUUsecaseAdapter interface {
+	GetReimbursements(ctx context.Context, productIDs []string, regionID string) (map[string]product_entity.Reimbursement, error)
+	GetEligibleProductIDs(ctx context.Context, regionID string) ([]string, error)
+}
+
+type IRegionUsecaseAdapter interface {
+	ResolveRegionID(ctx context.Context) (string, error)
+}
+
+type ICharacteristicUsecase interface {
+	GetByIDs(ctx context.Context, ids []string) ([]product_entity.Characteristic, error)
+	GetByCategoryID(ctx context.Context, categoryID string) ([]product_entity.Characteristic, error)
+}
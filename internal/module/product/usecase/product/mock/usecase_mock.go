@@ -0,0 +1,629 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./product/contracts/contracts.go
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	product_entity "github.com/Fi44er/sdmed/internal/module/product/entity"
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockIFileUsecaseAdapter is a mock of IFileUsecaseAdapter interface.
+type MockIFileUsecaseAdapter struct {
+	ctrl     *gomock.Controller
+	recorder *MockIFileUsecaseAdapterMockRecorder
+}
+
+// MockIFileUsecaseAdapterMockRecorder is the mock recorder for MockIFileUsecaseAdapter.
+type MockIFileUsecaseAdapterMockRecorder struct {
+	mock *MockIFileUsecaseAdapter
+}
+
+// NewMockIFileUsecaseAdapter creates a new mock instance.
+func NewMockIFileUsecaseAdapter(ctrl *gomock.Controller) *MockIFileUsecaseAdapter {
+	mock := &MockIFileUsecaseAdapter{ctrl: ctrl}
+	mock.recorder = &MockIFileUsecaseAdapterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockIFileUsecaseAdapter) EXPECT() *MockIFileUsecaseAdapterMockRecorder {
+	return m.recorder
+}
+
+// DeleteByID mocks base method.
+func (m *MockIFileUsecaseAdapter) DeleteByID(ctx context.Context, id string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteByID", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteByID indicates an expected call of DeleteByID.
+func (mr *MockIFileUsecaseAdapterMockRecorder) DeleteByID(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteByID", reflect.TypeOf((*MockIFileUsecaseAdapter)(nil).DeleteByID), ctx, id)
+}
+
+// DeleteByOwner mocks base method.
+func (m *MockIFileUsecaseAdapter) DeleteByOwner(ctx context.Context, ownerID, ownerType string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteByOwner", ctx, ownerID, ownerType)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteByOwner indicates an expected call of DeleteByOwner.
+func (mr *MockIFileUsecaseAdapterMockRecorder) DeleteByOwner(ctx, ownerID, ownerType interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteByOwner", reflect.TypeOf((*MockIFileUsecaseAdapter)(nil).DeleteByOwner), ctx, ownerID, ownerType)
+}
+
+// GetByOwner mocks base method.
+func (m *MockIFileUsecaseAdapter) GetByOwner(ctx context.Context, ownerID, ownerType string) ([]product_entity.File, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByOwner", ctx, ownerID, ownerType)
+	ret0, _ := ret[0].([]product_entity.File)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByOwner indicates an expected call of GetByOwner.
+func (mr *MockIFileUsecaseAdapterMockRecorder) GetByOwner(ctx, ownerID, ownerType interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByOwner", reflect.TypeOf((*MockIFileUsecaseAdapter)(nil).GetByOwner), ctx, ownerID, ownerType)
+}
+
+// GetByOwners mocks base method.
+func (m *MockIFileUsecaseAdapter) GetByOwners(ctx context.Context, ownerIDs []string, ownerType string) (map[string][]product_entity.File, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByOwners", ctx, ownerIDs, ownerType)
+	ret0, _ := ret[0].(map[string][]product_entity.File)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByOwners indicates an expected call of GetByOwners.
+func (mr *MockIFileUsecaseAdapterMockRecorder) GetByOwners(ctx, ownerIDs, ownerType interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByOwners", reflect.TypeOf((*MockIFileUsecaseAdapter)(nil).GetByOwners), ctx, ownerIDs, ownerType)
+}
+
+// MakeFilesPermanent mocks base method.
+func (m *MockIFileUsecaseAdapter) MakeFilesPermanent(ctx context.Context, fileIDs []string, ownerID, ownerType string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MakeFilesPermanent", ctx, fileIDs, ownerID, ownerType)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// MakeFilesPermanent indicates an expected call of MakeFilesPermanent.
+func (mr *MockIFileUsecaseAdapterMockRecorder) MakeFilesPermanent(ctx, fileIDs, ownerID, ownerType interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MakeFilesPermanent", reflect.TypeOf((*MockIFileUsecaseAdapter)(nil).MakeFilesPermanent), ctx, fileIDs, ownerID, ownerType)
+}
+
+// MockIProductRepository is a mock of IProductRepository interface.
+type MockIProductRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockIProductRepositoryMockRecorder
+}
+
+// MockIProductRepositoryMockRecorder is the mock recorder for MockIProductRepository.
+type MockIProductRepositoryMockRecorder struct {
+	mock *MockIProductRepository
+}
+
+// NewMockIProductRepository creates a new mock instance.
+func NewMockIProductRepository(ctrl *gomock.Controller) *MockIProductRepository {
+	mock := &MockIProductRepository{ctrl: ctrl}
+	mock.recorder = &MockIProductRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockIProductRepository) EXPECT() *MockIProductRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Count mocks base method.
+func (m *MockIProductRepository) Count(ctx context.Context) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Count", ctx)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Count indicates an expected call of Count.
+func (mr *MockIProductRepositoryMockRecorder) Count(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Count", reflect.TypeOf((*MockIProductRepository)(nil).Count), ctx)
+}
+
+// Create mocks base method.
+func (m *MockIProductRepository) Create(ctx context.Context, entity *product_entity.Product) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, entity)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockIProductRepositoryMockRecorder) Create(ctx, entity interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockIProductRepository)(nil).Create), ctx, entity)
+}
+
+// Delete mocks base method.
+func (m *MockIProductRepository) Delete(ctx context.Context, id string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockIProductRepositoryMockRecorder) Delete(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockIProductRepository)(nil).Delete), ctx, id)
+}
+
+// GetAll mocks base method.
+func (m *MockIProductRepository) GetAll(ctx context.Context, params product_entity.ProductFilterParams) ([]product_entity.Product, int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAll", ctx, params)
+	ret0, _ := ret[0].([]product_entity.Product)
+	ret1, _ := ret[1].(int64)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetAll indicates an expected call of GetAll.
+func (mr *MockIProductRepositoryMockRecorder) GetAll(ctx, params interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAll", reflect.TypeOf((*MockIProductRepository)(nil).GetAll), ctx, params)
+}
+
+// GetByArticle mocks base method.
+func (m *MockIProductRepository) GetByArticle(ctx context.Context, article string) (*product_entity.Product, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByArticle", ctx, article)
+	ret0, _ := ret[0].(*product_entity.Product)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByArticle indicates an expected call of GetByArticle.
+func (mr *MockIProductRepositoryMockRecorder) GetByArticle(ctx, article interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByArticle", reflect.TypeOf((*MockIProductRepository)(nil).GetByArticle), ctx, article)
+}
+
+// GetByID mocks base method.
+func (m *MockIProductRepository) GetByID(ctx context.Context, id string) (*product_entity.Product, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByID", ctx, id)
+	ret0, _ := ret[0].(*product_entity.Product)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByID indicates an expected call of GetByID.
+func (mr *MockIProductRepositoryMockRecorder) GetByID(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByID", reflect.TypeOf((*MockIProductRepository)(nil).GetByID), ctx, id)
+}
+
+// GetByIDs mocks base method.
+func (m *MockIProductRepository) GetByIDs(ctx context.Context, ids []string) ([]product_entity.Product, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByIDs", ctx, ids)
+	ret0, _ := ret[0].([]product_entity.Product)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByIDs indicates an expected call of GetByIDs.
+func (mr *MockIProductRepositoryMockRecorder) GetByIDs(ctx, ids interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByIDs", reflect.TypeOf((*MockIProductRepository)(nil).GetByIDs), ctx, ids)
+}
+
+// GetBySlug mocks base method.
+func (m *MockIProductRepository) GetBySlug(ctx context.Context, slug string) (*product_entity.Product, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetBySlug", ctx, slug)
+	ret0, _ := ret[0].(*product_entity.Product)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetBySlug indicates an expected call of GetBySlug.
+func (mr *MockIProductRepositoryMockRecorder) GetBySlug(ctx, slug interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBySlug", reflect.TypeOf((*MockIProductRepository)(nil).GetBySlug), ctx, slug)
+}
+
+// GetFiltersByCategory mocks base method.
+func (m *MockIProductRepository) GetFiltersByCategory(ctx context.Context, categoryID string) ([]product_entity.Filter, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetFiltersByCategory", ctx, categoryID)
+	ret0, _ := ret[0].([]product_entity.Filter)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetFiltersByCategory indicates an expected call of GetFiltersByCategory.
+func (mr *MockIProductRepositoryMockRecorder) GetFiltersByCategory(ctx, categoryID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFiltersByCategory", reflect.TypeOf((*MockIProductRepository)(nil).GetFiltersByCategory), ctx, categoryID)
+}
+
+// Update mocks base method.
+func (m *MockIProductRepository) Update(ctx context.Context, entity *product_entity.Product) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", ctx, entity)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockIProductRepositoryMockRecorder) Update(ctx, entity interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockIProductRepository)(nil).Update), ctx, entity)
+}
+
+// UpdateCategory mocks base method.
+func (m *MockIProductRepository) UpdateCategory(ctx context.Context, ids []string, categoryID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateCategory", ctx, ids, categoryID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateCategory indicates an expected call of UpdateCategory.
+func (mr *MockIProductRepositoryMockRecorder) UpdateCategory(ctx, ids, categoryID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateCategory", reflect.TypeOf((*MockIProductRepository)(nil).UpdateCategory), ctx, ids, categoryID)
+}
+
+// MockICategoryRepository is a mock of ICategoryRepository interface.
+type MockICategoryRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockICategoryRepositoryMockRecorder
+}
+
+// MockICategoryRepositoryMockRecorder is the mock recorder for MockICategoryRepository.
+type MockICategoryRepositoryMockRecorder struct {
+	mock *MockICategoryRepository
+}
+
+// NewMockICategoryRepository creates a new mock instance.
+func NewMockICategoryRepository(ctrl *gomock.Controller) *MockICategoryRepository {
+	mock := &MockICategoryRepository{ctrl: ctrl}
+	mock.recorder = &MockICategoryRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockICategoryRepository) EXPECT() *MockICategoryRepositoryMockRecorder {
+	return m.recorder
+}
+
+// GetByID mocks base method.
+func (m *MockICategoryRepository) GetByID(ctx context.Context, id string) (*product_entity.Category, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByID", ctx, id)
+	ret0, _ := ret[0].(*product_entity.Category)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByID indicates an expected call of GetByID.
+func (mr *MockICategoryRepositoryMockRecorder) GetByID(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByID", reflect.TypeOf((*MockICategoryRepository)(nil).GetByID), ctx, id)
+}
+
+// MockICache is a mock of ICache interface.
+type MockICache struct {
+	ctrl     *gomock.Controller
+	recorder *MockICacheMockRecorder
+}
+
+// MockICacheMockRecorder is the mock recorder for MockICache.
+type MockICacheMockRecorder struct {
+	mock *MockICache
+}
+
+// NewMockICache creates a new mock instance.
+func NewMockICache(ctrl *gomock.Controller) *MockICache {
+	mock := &MockICache{ctrl: ctrl}
+	mock.recorder = &MockICacheMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockICache) EXPECT() *MockICacheMockRecorder {
+	return m.recorder
+}
+
+// Del mocks base method.
+func (m *MockICache) Del(ctx context.Context, key string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Del", ctx, key)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Del indicates an expected call of Del.
+func (mr *MockICacheMockRecorder) Del(ctx, key interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Del", reflect.TypeOf((*MockICache)(nil).Del), ctx, key)
+}
+
+// Get mocks base method.
+func (m *MockICache) Get(ctx context.Context, key string, dest any) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", ctx, key, dest)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockICacheMockRecorder) Get(ctx, key, dest interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockICache)(nil).Get), ctx, key, dest)
+}
+
+// Set mocks base method.
+func (m *MockICache) Set(ctx context.Context, key string, value any, expiration time.Duration) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Set", ctx, key, value, expiration)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Set indicates an expected call of Set.
+func (mr *MockICacheMockRecorder) Set(ctx, key, value, expiration interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Set", reflect.TypeOf((*MockICache)(nil).Set), ctx, key, value, expiration)
+}
+
+// MockICharValueUsecase is a mock of ICharValueUsecase interface.
+type MockICharValueUsecase struct {
+	ctrl     *gomock.Controller
+	recorder *MockICharValueUsecaseMockRecorder
+}
+
+// MockICharValueUsecaseMockRecorder is the mock recorder for MockICharValueUsecase.
+type MockICharValueUsecaseMockRecorder struct {
+	mock *MockICharValueUsecase
+}
+
+// NewMockICharValueUsecase creates a new mock instance.
+func NewMockICharValueUsecase(ctrl *gomock.Controller) *MockICharValueUsecase {
+	mock := &MockICharValueUsecase{ctrl: ctrl}
+	mock.recorder = &MockICharValueUsecaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockICharValueUsecase) EXPECT() *MockICharValueUsecaseMockRecorder {
+	return m.recorder
+}
+
+// CreateMany mocks base method.
+func (m *MockICharValueUsecase) CreateMany(ctx context.Context, charValues []product_entity.ProductCharValue) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateMany", ctx, charValues)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateMany indicates an expected call of CreateMany.
+func (mr *MockICharValueUsecaseMockRecorder) CreateMany(ctx, charValues interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateMany", reflect.TypeOf((*MockICharValueUsecase)(nil).CreateMany), ctx, charValues)
+}
+
+// MockICharValueRepository is a mock of ICharValueRepository interface.
+type MockICharValueRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockICharValueRepositoryMockRecorder
+}
+
+// MockICharValueRepositoryMockRecorder is the mock recorder for MockICharValueRepository.
+type MockICharValueRepositoryMockRecorder struct {
+	mock *MockICharValueRepository
+}
+
+// NewMockICharValueRepository creates a new mock instance.
+func NewMockICharValueRepository(ctrl *gomock.Controller) *MockICharValueRepository {
+	mock := &MockICharValueRepository{ctrl: ctrl}
+	mock.recorder = &MockICharValueRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockICharValueRepository) EXPECT() *MockICharValueRepositoryMockRecorder {
+	return m.recorder
+}
+
+// DeleteByIDs mocks base method.
+func (m *MockICharValueRepository) DeleteByIDs(ctx context.Context, ids []string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteByIDs", ctx, ids)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteByIDs indicates an expected call of DeleteByIDs.
+func (mr *MockICharValueRepositoryMockRecorder) DeleteByIDs(ctx, ids interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteByIDs", reflect.TypeOf((*MockICharValueRepository)(nil).DeleteByIDs), ctx, ids)
+}
+
+// Update mocks base method.
+func (m *MockICharValueRepository) Update(ctx context.Context, charValue *product_entity.ProductCharValue) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", ctx, charValue)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockICharValueRepositoryMockRecorder) Update(ctx, charValue interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockICharValueRepository)(nil).Update), ctx, charValue)
+}
+
+// MockITRUUsecaseAdapter is a mock of ITRUUsecaseAdapter interface.
+type MockITRUUsecaseAdapter struct {
+	ctrl     *gomock.Controller
+	recorder *MockITRUUsecaseAdapterMockRecorder
+}
+
+// MockITRUUsecaseAdapterMockRecorder is the mock recorder for MockITRUUsecaseAdapter.
+type MockITRUUsecaseAdapterMockRecorder struct {
+	mock *MockITRUUsecaseAdapter
+}
+
+// NewMockITRUUsecaseAdapter creates a new mock instance.
+func NewMockITRUUsecaseAdapter(ctrl *gomock.Controller) *MockITRUUsecaseAdapter {
+	mock := &MockITRUUsecaseAdapter{ctrl: ctrl}
+	mock.recorder = &MockITRUUsecaseAdapterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockITRUUsecaseAdapter) EXPECT() *MockITRUUsecaseAdapterMockRecorder {
+	return m.recorder
+}
+
+// GetEligibleProductIDs mocks base method.
+func (m *MockITRUUsecaseAdapter) GetEligibleProductIDs(ctx context.Context, regionID string) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetEligibleProductIDs", ctx, regionID)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetEligibleProductIDs indicates an expected call of GetEligibleProductIDs.
+func (mr *MockITRUUsecaseAdapterMockRecorder) GetEligibleProductIDs(ctx, regionID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetEligibleProductIDs", reflect.TypeOf((*MockITRUUsecaseAdapter)(nil).GetEligibleProductIDs), ctx, regionID)
+}
+
+// GetReimbursements mocks base method.
+func (m *MockITRUUsecaseAdapter) GetReimbursements(ctx context.Context, productIDs []string, regionID string) (map[string]product_entity.Reimbursement, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetReimbursements", ctx, productIDs, regionID)
+	ret0, _ := ret[0].(map[string]product_entity.Reimbursement)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetReimbursements indicates an expected call of GetReimbursements.
+func (mr *MockITRUUsecaseAdapterMockRecorder) GetReimbursements(ctx, productIDs, regionID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetReimbursements", reflect.TypeOf((*MockITRUUsecaseAdapter)(nil).GetReimbursements), ctx, productIDs, regionID)
+}
+
+// MockIRegionUsecaseAdapter is a mock of IRegionUsecaseAdapter interface.
+type MockIRegionUsecaseAdapter struct {
+	ctrl     *gomock.Controller
+	recorder *MockIRegionUsecaseAdapterMockRecorder
+}
+
+// MockIRegionUsecaseAdapterMockRecorder is the mock recorder for MockIRegionUsecaseAdapter.
+type MockIRegionUsecaseAdapterMockRecorder struct {
+	mock *MockIRegionUsecaseAdapter
+}
+
+// NewMockIRegionUsecaseAdapter creates a new mock instance.
+func NewMockIRegionUsecaseAdapter(ctrl *gomock.Controller) *MockIRegionUsecaseAdapter {
+	mock := &MockIRegionUsecaseAdapter{ctrl: ctrl}
+	mock.recorder = &MockIRegionUsecaseAdapterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockIRegionUsecaseAdapter) EXPECT() *MockIRegionUsecaseAdapterMockRecorder {
+	return m.recorder
+}
+
+// ResolveRegionID mocks base method.
+func (m *MockIRegionUsecaseAdapter) ResolveRegionID(ctx context.Context) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ResolveRegionID", ctx)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ResolveRegionID indicates an expected call of ResolveRegionID.
+func (mr *MockIRegionUsecaseAdapterMockRecorder) ResolveRegionID(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResolveRegionID", reflect.TypeOf((*MockIRegionUsecaseAdapter)(nil).ResolveRegionID), ctx)
+}
+
+// MockICharacteristicUsecase is a mock of ICharacteristicUsecase interface.
+type MockICharacteristicUsecase struct {
+	ctrl     *gomock.Controller
+	recorder *MockICharacteristicUsecaseMockRecorder
+}
+
+// MockICharacteristicUsecaseMockRecorder is the mock recorder for MockICharacteristicUsecase.
+type MockICharacteristicUsecaseMockRecorder struct {
+	mock *MockICharacteristicUsecase
+}
+
+// NewMockICharacteristicUsecase creates a new mock instance.
+func NewMockICharacteristicUsecase(ctrl *gomock.Controller) *MockICharacteristicUsecase {
+	mock := &MockICharacteristicUsecase{ctrl: ctrl}
+	mock.recorder = &MockICharacteristicUsecaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockICharacteristicUsecase) EXPECT() *MockICharacteristicUsecaseMockRecorder {
+	return m.recorder
+}
+
+// GetByCategoryID mocks base method.
+func (m *MockICharacteristicUsecase) GetByCategoryID(ctx context.Context, categoryID string) ([]product_entity.Characteristic, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByCategoryID", ctx, categoryID)
+	ret0, _ := ret[0].([]product_entity.Characteristic)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByCategoryID indicates an expected call of GetByCategoryID.
+func (mr *MockICharacteristicUsecaseMockRecorder) GetByCategoryID(ctx, categoryID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByCategoryID", reflect.TypeOf((*MockICharacteristicUsecase)(nil).GetByCategoryID), ctx, categoryID)
+}
+
+// GetByIDs mocks base method.
+func (m *MockICharacteristicUsecase) GetByIDs(ctx context.Context, ids []string) ([]product_entity.Characteristic, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByIDs", ctx, ids)
+	ret0, _ := ret[0].([]product_entity.Characteristic)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByIDs indicates an expected call of GetByIDs.
+func (mr *MockICharacteristicUsecaseMockRecorder) GetByIDs(ctx, ids interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByIDs", reflect.TypeOf((*MockICharacteristicUsecase)(nil).GetByIDs), ctx, ids)
+}
@@ -0,0 +1,200 @@
+package product_testcases
+
+import (
+	"context"
+
+	product_entity "github.com/Fi44er/sdmed/internal/module/product/entity"
+	product_constant "github.com/Fi44er/sdmed/internal/module/product/pkg"
+	"github.com/Fi44er/sdmed/internal/module/product/usecase/product/mock"
+	uow_mock "github.com/Fi44er/sdmed/pkg/postgres/uow/mock"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+type MockCategoryChange struct {
+	Ctrl               *gomock.Controller
+	Ctx                context.Context
+	RepoMock           *mock.MockIProductRepository
+	CategoryRepoMock   *mock.MockICategoryRepository
+	CharValueRepoMock  *mock.MockICharValueRepository
+	CharacteristicMock *mock.MockICharacteristicUsecase
+	CacheMock          *mock.MockICache
+	UowMock            *uow_mock.MockUow
+	T                  assert.TestingT
+}
+
+type CategoryChangeTestCase struct {
+	Name                    string
+	InputChange             *product_entity.CategoryChange
+	SetupMocks              func(m *MockCategoryChange)
+	ExpectedError           error
+	ExpectedUnmapped        int
+	ExpectedMissingRequired int
+}
+
+const (
+	sourceCategoryID = "cat-old"
+	targetCategoryID = "cat-new"
+)
+
+func categoryChangeProduct(categoryID string) product_entity.Product {
+	color := "Красный"
+	return product_entity.Product{
+		ID:         "product-1",
+		CategoryID: &categoryID,
+		CharValues: []product_entity.ProductCharValue{
+			{ID: "value-1", CharacteristicID: "char-old", ProductID: "product-1", StringValue: &color},
+		},
+	}
+}
+
+func sourceChars() []product_entity.Characteristic {
+	return []product_entity.Characteristic{
+		{ID: "char-old", Name: "Цвет", CategoryID: sourceCategoryID, DataType: product_entity.DataTypeString},
+	}
+}
+
+// setupPlan ожидает загрузку целевой категории, товаров и характеристик
+func setupPlan(m *MockCategoryChange, products []product_entity.Product, targetChars []product_entity.Characteristic) {
+	m.UowMock.EXPECT().GetRepository(m.Ctx, "category").Return(m.CategoryRepoMock, nil)
+	m.UowMock.EXPECT().GetRepository(m.Ctx, "product").Return(m.RepoMock, nil).AnyTimes()
+	m.UowMock.EXPECT().GetRepository(m.Ctx, "char_value").Return(m.CharValueRepoMock, nil).AnyTimes()
+
+	m.CategoryRepoMock.EXPECT().GetByID(m.Ctx, targetCategoryID).Return(&product_entity.Category{ID: targetCategoryID}, nil)
+	m.RepoMock.EXPECT().GetByIDs(m.Ctx, []string{"product-1"}).Return(products, nil)
+	m.CharacteristicMock.EXPECT().GetByCategoryID(m.Ctx, targetCategoryID).Return(targetChars, nil)
+	m.CharacteristicMock.EXPECT().GetByIDs(m.Ctx, []string{"char-old"}).Return(sourceChars(), nil).AnyTimes()
+}
+
+func setupTransaction(m *MockCategoryChange) {
+	m.UowMock.EXPECT().Do(m.Ctx, gomock.Any()).DoAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+		return fn(ctx)
+	})
+}
+
+func setupCacheInvalidation(m *MockCategoryChange) {
+	m.CacheMock.EXPECT().Del(m.Ctx, product_constant.CategoryFiltersKeyPrefix+sourceCategoryID).Return(nil)
+	m.CacheMock.EXPECT().Del(m.Ctx, product_constant.CategoryFiltersKeyPrefix+targetCategoryID).Return(nil)
+}
+
+func GetChangeCategoryTestCases() []CategoryChangeTestCase {
+	return []CategoryChangeTestCase{
+		{
+			Name:        "successful_change_with_remapping",
+			InputChange: &product_entity.CategoryChange{ProductIDs: []string{"product-1"}, TargetCategoryID: targetCategoryID},
+			SetupMocks: func(m *MockCategoryChange) {
+				setupTransaction(m)
+				setupPlan(m, []product_entity.Product{categoryChangeProduct(sourceCategoryID)}, []product_entity.Characteristic{
+					{ID: "char-new", Name: " цвет ", CategoryID: targetCategoryID, DataType: product_entity.DataTypeString},
+				})
+
+				m.CharValueRepoMock.EXPECT().
+					Update(m.Ctx, gomock.Any()).
+					DoAndReturn(func(ctx context.Context, value *product_entity.ProductCharValue) error {
+						assert.Equal(m.T, "value-1", value.ID)
+						assert.Equal(m.T, "char-new", value.CharacteristicID)
+						return nil
+					})
+				m.RepoMock.EXPECT().UpdateCategory(m.Ctx, []string{"product-1"}, targetCategoryID).Return(nil)
+				setupCacheInvalidation(m)
+			},
+		},
+		{
+			Name:        "drop_unmapped_values",
+			InputChange: &product_entity.CategoryChange{ProductIDs: []string{"product-1"}, TargetCategoryID: targetCategoryID, DropUnmapped: true},
+			SetupMocks: func(m *MockCategoryChange) {
+				setupTransaction(m)
+				setupPlan(m, []product_entity.Product{categoryChangeProduct(sourceCategoryID)}, []product_entity.Characteristic{})
+
+				m.CharValueRepoMock.EXPECT().DeleteByIDs(m.Ctx, []string{"value-1"}).Return(nil)
+				m.RepoMock.EXPECT().UpdateCategory(m.Ctx, []string{"product-1"}, targetCategoryID).Return(nil)
+				setupCacheInvalidation(m)
+			},
+			ExpectedUnmapped: 1,
+		},
+		{
+			Name:        "unmapped_values_abort_change",
+			InputChange: &product_entity.CategoryChange{ProductIDs: []string{"product-1"}, TargetCategoryID: targetCategoryID},
+			SetupMocks: func(m *MockCategoryChange) {
+				setupTransaction(m)
+				setupPlan(m, []product_entity.Product{categoryChangeProduct(sourceCategoryID)}, []product_entity.Characteristic{})
+			},
+			ExpectedError:    product_constant.ErrCategoryChangeUnmapped,
+			ExpectedUnmapped: 1,
+		},
+		{
+			Name:        "product_already_in_target_category",
+			InputChange: &product_entity.CategoryChange{ProductIDs: []string{"product-1"}, TargetCategoryID: targetCategoryID},
+			SetupMocks: func(m *MockCategoryChange) {
+				setupTransaction(m)
+				setupPlan(m, []product_entity.Product{categoryChangeProduct(targetCategoryID)}, []product_entity.Characteristic{
+					{ID: "char-new", Name: "Цвет", CategoryID: targetCategoryID, DataType: product_entity.DataTypeString},
+				})
+			},
+			ExpectedError: product_constant.ErrCategoryChangeSameCategory,
+		},
+		{
+			Name:        "target_category_not_found",
+			InputChange: &product_entity.CategoryChange{ProductIDs: []string{"product-1"}, TargetCategoryID: targetCategoryID},
+			SetupMocks: func(m *MockCategoryChange) {
+				setupTransaction(m)
+				m.UowMock.EXPECT().GetRepository(m.Ctx, "category").Return(m.CategoryRepoMock, nil)
+				m.CategoryRepoMock.EXPECT().GetByID(m.Ctx, targetCategoryID).Return(nil, nil)
+			},
+			ExpectedError: product_constant.ErrCategoryNotFound,
+		},
+		{
+			Name:        "product_not_found",
+			InputChange: &product_entity.CategoryChange{ProductIDs: []string{"product-1"}, TargetCategoryID: targetCategoryID},
+			SetupMocks: func(m *MockCategoryChange) {
+				setupTransaction(m)
+				m.UowMock.EXPECT().GetRepository(m.Ctx, "category").Return(m.CategoryRepoMock, nil)
+				m.UowMock.EXPECT().GetRepository(m.Ctx, "product").Return(m.RepoMock, nil)
+				m.CategoryRepoMock.EXPECT().GetByID(m.Ctx, targetCategoryID).Return(&product_entity.Category{ID: targetCategoryID}, nil)
+				m.RepoMock.EXPECT().GetByIDs(m.Ctx, []string{"product-1"}).Return([]product_entity.Product{}, nil)
+			},
+			ExpectedError: product_constant.ErrProductNotFound,
+		},
+	}
+}
+
+func GetPreviewCategoryChangeTestCases() []CategoryChangeTestCase {
+	return []CategoryChangeTestCase{
+		{
+			Name:        "preview_reports_option_not_found_and_missing_required",
+			InputChange: &product_entity.CategoryChange{ProductIDs: []string{"product-1"}, TargetCategoryID: targetCategoryID},
+			SetupMocks: func(m *MockCategoryChange) {
+				setupPlan(m, []product_entity.Product{categoryChangeProduct(sourceCategoryID)}, []product_entity.Characteristic{
+					{
+						ID:         "char-new",
+						Name:       "Цвет",
+						CategoryID: targetCategoryID,
+						DataType:   product_entity.DataTypeString,
+					},
+					{
+						ID:         "char-size",
+						Name:       "Размер",
+						CategoryID: targetCategoryID,
+						DataType:   product_entity.DataTypeSelect,
+						IsRequired: true,
+					},
+				})
+			},
+			ExpectedMissingRequired: 1,
+		},
+		{
+			Name: "manual_mapping_with_different_type",
+			InputChange: &product_entity.CategoryChange{
+				ProductIDs:       []string{"product-1"},
+				TargetCategoryID: targetCategoryID,
+				ManualMappings:   map[string]string{"char-old": "char-size"},
+			},
+			SetupMocks: func(m *MockCategoryChange) {
+				setupPlan(m, []product_entity.Product{categoryChangeProduct(sourceCategoryID)}, []product_entity.Characteristic{
+					{ID: "char-size", Name: "Размер", CategoryID: targetCategoryID, DataType: product_entity.DataTypeNumber},
+				})
+			},
+			ExpectedError: product_constant.ErrCategoryChangeInvalidTarget,
+		},
+	}
+}
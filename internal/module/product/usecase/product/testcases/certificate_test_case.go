@@ -0,0 +1,115 @@
+package product_testcases
+
+import (
+	"context"
+
+	product_entity "github.com/Fi44er/sdmed/internal/module/product/entity"
+	product_constant "github.com/Fi44er/sdmed/internal/module/product/pkg"
+	"github.com/Fi44er/sdmed/internal/module/product/usecase/product/mock"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+type MockCalculateCertificate struct {
+	Ctrl       *gomock.Controller
+	Ctx        context.Context
+	RepoMock   *mock.MockIProductRepository
+	TRUMock    *mock.MockITRUUsecaseAdapter
+	RegionMock *mock.MockIRegionUsecaseAdapter
+	T          assert.TestingT
+}
+
+type CalculateCertificateTestCase struct {
+	Name                   string
+	InputProductID         string
+	InputRegionID          string
+	InputCertificateAmount *float64
+	SetupMocks             func(m *MockCalculateCertificate)
+	ExpectedCalculation    *product_entity.CertificateCalculation
+	ExpectedError          error
+}
+
+func GetCalculateCertificateTestCases() []CalculateCertificateTestCase {
+	price := 1200.0
+	certificateAmount := 800.0
+	product := &product_entity.Product{ID: "product-1", ManualPrice: &price, UseManualPrice: true}
+
+	return []CalculateCertificateTestCase{
+		{
+			Name:           "covered_in_customer_region",
+			InputProductID: "product-1",
+			SetupMocks: func(m *MockCalculateCertificate) {
+				m.RepoMock.EXPECT().GetByID(m.Ctx, "product-1").Return(product, nil)
+				m.RegionMock.EXPECT().ResolveRegionID(m.Ctx).Return("region-moscow", nil)
+				m.TRUMock.EXPECT().
+					GetReimbursements(m.Ctx, []string{"product-1"}, "region-moscow").
+					Return(map[string]product_entity.Reimbursement{"product-1": {RegionID: "region-moscow", Price: 1000}}, nil)
+			},
+			ExpectedCalculation: &product_entity.CertificateCalculation{
+				ProductID:     "product-1",
+				RegionID:      "region-moscow",
+				ProductPrice:  1200,
+				LimitPrice:    1000,
+				CoveredAmount: 1000,
+				Surcharge:     200,
+				IsEligible:    true,
+			},
+		},
+		{
+			Name:                   "limited_by_certificate_amount",
+			InputProductID:         "product-1",
+			InputRegionID:          "region-kazan",
+			InputCertificateAmount: &certificateAmount,
+			SetupMocks: func(m *MockCalculateCertificate) {
+				m.RepoMock.EXPECT().GetByID(m.Ctx, "product-1").Return(product, nil)
+				m.TRUMock.EXPECT().
+					GetReimbursements(m.Ctx, []string{"product-1"}, "region-kazan").
+					Return(map[string]product_entity.Reimbursement{"product-1": {RegionID: "region-kazan", Price: 1000}}, nil)
+			},
+			ExpectedCalculation: &product_entity.CertificateCalculation{
+				ProductID:         "product-1",
+				RegionID:          "region-kazan",
+				ProductPrice:      1200,
+				LimitPrice:        1000,
+				CertificateAmount: &certificateAmount,
+				CoveredAmount:     800,
+				Surcharge:         400,
+				IsEligible:        true,
+			},
+		},
+		{
+			Name:           "not_eligible_without_reimbursement",
+			InputProductID: "product-1",
+			InputRegionID:  "region-kazan",
+			SetupMocks: func(m *MockCalculateCertificate) {
+				m.RepoMock.EXPECT().GetByID(m.Ctx, "product-1").Return(product, nil)
+				m.TRUMock.EXPECT().
+					GetReimbursements(m.Ctx, []string{"product-1"}, "region-kazan").
+					Return(map[string]product_entity.Reimbursement{}, nil)
+			},
+			ExpectedCalculation: &product_entity.CertificateCalculation{
+				ProductID:    "product-1",
+				RegionID:     "region-kazan",
+				ProductPrice: 1200,
+				Surcharge:    1200,
+			},
+		},
+		{
+			Name:           "product_not_found",
+			InputProductID: "missing",
+			SetupMocks: func(m *MockCalculateCertificate) {
+				m.RepoMock.EXPECT().GetByID(m.Ctx, "missing").Return(nil, nil)
+			},
+			ExpectedError: product_constant.ErrProductNotFound,
+		},
+		{
+			Name:           "region_not_resolved",
+			InputProductID: "product-1",
+			SetupMocks: func(m *MockCalculateCertificate) {
+				m.RepoMock.EXPECT().GetByID(m.Ctx, "product-1").Return(product, nil)
+				m.RegionMock.EXPECT().ResolveRegionID(m.Ctx).Return("", product_constant.ErrRegionNotResolved)
+			},
+			ExpectedError: product_constant.ErrRegionNotResolved,
+		},
+	}
+}
@@ -0,0 +1,106 @@
+package product_testcases
+
+import (
+	"context"
+	"errors"
+
+	product_entity "github.com/Fi44er/sdmed/internal/module/product/entity"
+	product_constant "github.com/Fi44er/sdmed/internal/module/product/pkg"
+	"github.com/Fi44er/sdmed/internal/module/product/usecase/product/mock"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+var errTRU = errors.New("tru module unavailable")
+
+type MockGetAll struct {
+	Ctrl       *gomock.Controller
+	Ctx        context.Context
+	RepoMock   *mock.MockIProductRepository
+	FileMock   *mock.MockIFileUsecaseAdapter
+	TRUMock    *mock.MockITRUUsecaseAdapter
+	RegionMock *mock.MockIRegionUsecaseAdapter
+	T          assert.TestingT
+}
+
+type GetAllTestCase struct {
+	Name             string
+	InputParams      *product_entity.ProductFilterParams
+	SetupMocks       func(m *MockGetAll)
+	ExpectedRegionID string
+	ExpectedError    error
+}
+
+func setupGetAllProducts(m *MockGetAll, regionID string, eligibleIDs ...string) {
+	price := 1500.0
+	m.RepoMock.EXPECT().
+		GetAll(m.Ctx, gomock.Any()).
+		DoAndReturn(func(ctx context.Context, params product_entity.ProductFilterParams) ([]product_entity.Product, int64, error) {
+			assert.Equal(m.T, regionID, params.RegionID)
+			if len(eligibleIDs) > 0 {
+				assert.Equal(m.T, eligibleIDs, params.EligibleProductIDs)
+			}
+			return []product_entity.Product{{ID: "product-1", ManualPrice: &price, UseManualPrice: true}}, 1, nil
+		})
+	m.FileMock.EXPECT().GetByOwners(m.Ctx, []string{"product-1"}, "product").Return(map[string][]product_entity.File{}, nil)
+	m.TRUMock.EXPECT().
+		GetReimbursements(m.Ctx, []string{"product-1"}, regionID).
+		Return(map[string]product_entity.Reimbursement{"product-1": {RegionID: regionID, Price: 1000}}, nil)
+}
+
+func GetGetAllTestCases() []GetAllTestCase {
+	eligible, notEligible := true, false
+	return []GetAllTestCase{
+		{
+			Name:        "region_from_request_skips_lookup",
+			InputParams: &product_entity.ProductFilterParams{RegionID: "region-kazan", Page: 1, PageSize: 10},
+			SetupMocks: func(m *MockGetAll) {
+				setupGetAllProducts(m, "region-kazan")
+			},
+			ExpectedRegionID: "region-kazan",
+		},
+		{
+			Name:        "customer_region_resolved_once",
+			InputParams: &product_entity.ProductFilterParams{Page: 1, PageSize: 10},
+			SetupMocks: func(m *MockGetAll) {
+				m.RegionMock.EXPECT().ResolveRegionID(m.Ctx).Return("region-moscow", nil).Times(1)
+				setupGetAllProducts(m, "region-moscow")
+			},
+			ExpectedRegionID: "region-moscow",
+		},
+		{
+			Name:        "certificate_filter_by_eligible_products",
+			InputParams: &product_entity.ProductFilterParams{RegionID: "region-kazan", IsCertificateEligible: &eligible, Page: 1, PageSize: 10},
+			SetupMocks: func(m *MockGetAll) {
+				m.TRUMock.EXPECT().GetEligibleProductIDs(m.Ctx, "region-kazan").Return([]string{"product-1", "product-2"}, nil)
+				setupGetAllProducts(m, "region-kazan", "product-1", "product-2")
+			},
+			ExpectedRegionID: "region-kazan",
+		},
+		{
+			Name:        "not_eligible_filter_uses_same_products",
+			InputParams: &product_entity.ProductFilterParams{RegionID: "region-kazan", IsCertificateEligible: &notEligible, Page: 1, PageSize: 10},
+			SetupMocks: func(m *MockGetAll) {
+				m.TRUMock.EXPECT().GetEligibleProductIDs(m.Ctx, "region-kazan").Return([]string{"product-2"}, nil)
+				setupGetAllProducts(m, "region-kazan", "product-2")
+			},
+			ExpectedRegionID: "region-kazan",
+		},
+		{
+			Name:        "eligible_products_not_loaded",
+			InputParams: &product_entity.ProductFilterParams{RegionID: "region-kazan", IsCertificateEligible: &eligible, Page: 1, PageSize: 10},
+			SetupMocks: func(m *MockGetAll) {
+				m.TRUMock.EXPECT().GetEligibleProductIDs(m.Ctx, "region-kazan").Return(nil, errTRU)
+			},
+			ExpectedError: errTRU,
+		},
+		{
+			Name:        "certificate_filter_without_region",
+			InputParams: &product_entity.ProductFilterParams{IsCertificateEligible: &eligible, Page: 1, PageSize: 10},
+			SetupMocks: func(m *MockGetAll) {
+				m.RegionMock.EXPECT().ResolveRegionID(m.Ctx).Return("", product_constant.ErrRegionNotResolved)
+			},
+			ExpectedError: product_constant.ErrRegionNotResolved,
+		},
+	}
+}
@@ -15,10 +15,16 @@ const ownerType = "product"
 
 type IProductUsecase interface {
 	Create(ctx context.Context, product *product_entity.Product) error
-	GetBySlug(ctx context.Context, slug string) (*product_entity.Product, error)
+	GetBySlug(ctx context.Context, slug, regionID string) (*product_entity.Product, error)
 	GetAll(ctx context.Context, params *product_entity.ProductFilterParams) ([]product_entity.Product, int64, error)
+	GetByIDs(ctx context.Context, ids []string) ([]product_entity.Product, error)
 
 	GetFilters(ctx context.Context, categoryID string) ([]product_entity.Filter, error)
+
+	PreviewCategoryChange(ctx context.Context, change *product_entity.CategoryChange) error
+	ChangeCategory(ctx context.Context, change *product_entity.CategoryChange) error
+
+	CalculateCertificate(ctx context.Context, productID, regionID string, certificateAmount *float64) (*product_entity.CertificateCalculation, error)
 }
 
 type ProductUsecase struct {
@@ -26,9 +32,12 @@ type ProductUsecase struct {
 	logger     *logger.Logger
 	cache      product_usecase_contracts.ICache
 
-	uow              uow.Uow
-	fileUsecase      product_usecase_contracts.IFileUsecaseAdapter
-	charValueUsecase product_usecase_contracts.ICharValueUsecase
+	uow                   uow.Uow
+	fileUsecase           product_usecase_contracts.IFileUsecaseAdapter
+	charValueUsecase      product_usecase_contracts.ICharValueUsecase
+	characteristicUsecase product_usecase_contracts.ICharacteristicUsecase
+	truUsecase            product_usecase_contracts.ITRUUsecaseAdapter
+	regionUsecase         product_usecase_contracts.IRegionUsecaseAdapter
 }
 
 func NewProductUsecase(
@@ -38,20 +47,40 @@ func NewProductUsecase(
 	cache product_usecase_contracts.ICache,
 	fileUsecase product_usecase_contracts.IFileUsecaseAdapter,
 	charValueUsecase product_usecase_contracts.ICharValueUsecase,
+	characteristicUsecase product_usecase_contracts.ICharacteristicUsecase,
+	truUsecase product_usecase_contracts.ITRUUsecaseAdapter,
+	regionUsecase product_usecase_contracts.IRegionUsecaseAdapter,
 ) IProductUsecase {
 	return &ProductUsecase{
-		repository:       repository,
-		logger:           logger,
-		uow:              uow,
-		cache:            cache,
-		fileUsecase:      fileUsecase,
-		charValueUsecase: charValueUsecase,
+		repository:            repository,
+		logger:                logger,
+		uow:                   uow,
+		cache:                 cache,
+		fileUsecase:           fileUsecase,
+		charValueUsecase:      charValueUsecase,
+		characteristicUsecase: characteristicUsecase,
+		truUsecase:            truUsecase,
+		regionUsecase:         regionUsecase,
 	}
 }
 
 func (u *ProductUsecase) GetAll(ctx context.Context, params *product_entity.ProductFilterParams) ([]product_entity.Product, int64, error) {
 	u.logger.Debugf("Getting all products (page: %d, pageSize: %d)", params.Page, params.PageSize)
 
+	params.RegionID = u.resolveRegionID(ctx, params.RegionID)
+	if params.IsCertificateEligible != nil && params.RegionID == "" {
+		u.logger.Warnf("Certificate eligibility filter requested, but region could not be resolved")
+		return nil, 0, product_constant.ErrRegionNotResolved
+	}
+	if params.IsCertificateEligible != nil {
+		eligibleIDs, err := u.truUsecase.GetEligibleProductIDs(ctx, params.RegionID)
+		if err != nil {
+			u.logger.Errorf("Failed to get certificate eligible products: %v", err)
+			return nil, 0, err
+		}
+		params.EligibleProductIDs = eligibleIDs
+	}
+
 	u.logger.Debugf("Filter params: %+v", params)
 	products, total, err := u.repository.GetAll(ctx, *params)
 	if err != nil {
@@ -71,10 +100,12 @@ func (u *ProductUsecase) GetAll(ctx context.Context, params *product_entity.Prod
 		u.logger.Debugf("Successfully enriched %d products with images", len(products))
 	}
 
+	u.enrichWithReimbursements(ctx, products, params.RegionID)
+
 	return products, total, nil
 }
 
-func (u *ProductUsecase) GetBySlug(ctx context.Context, slug string) (*product_entity.Product, error) {
+func (u *ProductUsecase) GetBySlug(ctx context.Context, slug, regionID string) (*product_entity.Product, error) {
 	u.logger.Debugf("Getting product by slug: %s", slug)
 
 	product, err := u.repository.GetBySlug(ctx, slug)
@@ -96,10 +127,26 @@ func (u *ProductUsecase) GetBySlug(ctx context.Context, slug string) (*product_e
 	}
 
 	product.Images = files
+
+	products := []product_entity.Product{*product}
+	u.enrichWithReimbursements(ctx, products, u.resolveRegionID(ctx, regionID))
+	product.Reimbursement = products[0].Reimbursement
+	product.Certificate = products[0].Certificate
+
 	u.logger.Debugf("Product %s retrieved successfully", product.ID)
 	return product, nil
 }
 
+func (u *ProductUsecase) GetByIDs(ctx context.Context, ids []string) ([]product_entity.Product, error) {
+	u.logger.Debugf("Getting %d products by IDs", len(ids))
+
+	if len(ids) == 0 {
+		return []product_entity.Product{}, nil
+	}
+
+	return u.repository.GetByIDs(ctx, ids)
+}
+
 func (u *ProductUsecase) Create(ctx context.Context, product *product_entity.Product) error {
 	u.logger.Infof("Creating product: %s", product.Name)
 
@@ -232,3 +279,42 @@ func (u *ProductUsecase) enrichWithBatch(ctx context.Context, products []product
 	u.logger.Debugf("Enriched %d out of %d categories with files", enrichedCount, len(products))
 	return nil
 }
+
+// resolveRegionID возвращает регион из запроса, а если он не передан - регион покупателя по сессии
+func (u *ProductUsecase) resolveRegionID(ctx context.Context, regionID string) string {
+	if regionID != "" {
+		return regionID
+	}
+
+	resolved, err := u.regionUsecase.ResolveRegionID(ctx)
+	if err != nil {
+		u.logger.Warnf("Failed to resolve customer region: %v", err)
+		return ""
+	}
+	return resolved
+}
+
+// enrichWithReimbursements подставляет цены возмещения и покрытие сертификатом для региона
+func (u *ProductUsecase) enrichWithReimbursements(ctx context.Context, products []product_entity.Product, regionID string) {
+	if len(products) == 0 || regionID == "" {
+		return
+	}
+
+	productIDs := make([]string, len(products))
+	for i, product := range products {
+		productIDs[i] = product.ID
+	}
+
+	reimbursements, err := u.truUsecase.GetReimbursements(ctx, productIDs, regionID)
+	if err != nil {
+		u.logger.Warnf("Failed to get reimbursements for region %s: %v", regionID, err)
+		return
+	}
+
+	for i := range products {
+		if reimbursement, ok := reimbursements[products[i].ID]; ok {
+			products[i].Reimbursement = &reimbursement
+		}
+		products[i].Certificate = product_entity.CalculateCertificate(&products[i], products[i].Reimbursement, nil)
+	}
+}
@@ -0,0 +1,170 @@
+package product_usecase_test
+
+import (
+	"context"
+	"testing"
+
+	product_usecase "github.com/Fi44er/sdmed/internal/module/product/usecase/product"
+	"github.com/Fi44er/sdmed/internal/module/product/usecase/product/mock"
+	product_testcases "github.com/Fi44er/sdmed/internal/module/product/usecase/product/testcases"
+	"github.com/Fi44er/sdmed/pkg/logger"
+	uow_mock "github.com/Fi44er/sdmed/pkg/postgres/uow/mock"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+)
+
+type ProductUsecaseTestSuite struct {
+	suite.Suite
+	ctx    context.Context
+	logger *logger.Logger
+}
+
+func (s *ProductUsecaseTestSuite) SetupSuite() {
+	s.ctx = context.Background()
+	s.logger = logger.NewLogger()
+}
+
+func TestProductUsecase(t *testing.T) {
+	suite.Run(t, new(ProductUsecaseTestSuite))
+}
+
+func (s *ProductUsecaseTestSuite) newCategoryChangeMocks(t *testing.T, ctrl *gomock.Controller) (*product_testcases.MockCategoryChange, product_usecase.IProductUsecase) {
+	m := &product_testcases.MockCategoryChange{
+		Ctrl:               ctrl,
+		Ctx:                s.ctx,
+		RepoMock:           mock.NewMockIProductRepository(ctrl),
+		CategoryRepoMock:   mock.NewMockICategoryRepository(ctrl),
+		CharValueRepoMock:  mock.NewMockICharValueRepository(ctrl),
+		CharacteristicMock: mock.NewMockICharacteristicUsecase(ctrl),
+		CacheMock:          mock.NewMockICache(ctrl),
+		UowMock:            uow_mock.NewMockUow(ctrl),
+		T:                  t,
+	}
+
+	usecase := product_usecase.NewProductUsecase(m.RepoMock, s.logger, m.UowMock, m.CacheMock, nil, nil, m.CharacteristicMock, nil, nil)
+	return m, usecase
+}
+
+func (s *ProductUsecaseTestSuite) TestChangeCategory() {
+	tests := product_testcases.GetChangeCategoryTestCases()
+
+	for _, tc := range tests {
+		s.T().Run(tc.Name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockStruct, usecase := s.newCategoryChangeMocks(t, ctrl)
+			tc.SetupMocks(mockStruct)
+
+			err := usecase.ChangeCategory(s.ctx, tc.InputChange)
+
+			if tc.ExpectedError != nil {
+				assert.Error(t, err)
+				assert.Contains(t, err.Error(), tc.ExpectedError.Error())
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Len(t, tc.InputChange.Unmapped, tc.ExpectedUnmapped)
+		})
+	}
+}
+
+func (s *ProductUsecaseTestSuite) TestPreviewCategoryChange() {
+	tests := product_testcases.GetPreviewCategoryChangeTestCases()
+
+	for _, tc := range tests {
+		s.T().Run(tc.Name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockStruct, usecase := s.newCategoryChangeMocks(t, ctrl)
+			tc.SetupMocks(mockStruct)
+
+			err := usecase.PreviewCategoryChange(s.ctx, tc.InputChange)
+
+			if tc.ExpectedError != nil {
+				assert.Error(t, err)
+				assert.Contains(t, err.Error(), tc.ExpectedError.Error())
+				return
+			}
+			assert.NoError(t, err)
+			assert.Len(t, tc.InputChange.Unmapped, tc.ExpectedUnmapped)
+			assert.Len(t, tc.InputChange.MissingRequired, tc.ExpectedMissingRequired)
+		})
+	}
+}
+
+func (s *ProductUsecaseTestSuite) TestGetAll() {
+	tests := product_testcases.GetGetAllTestCases()
+
+	for _, tc := range tests {
+		s.T().Run(tc.Name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockStruct := &product_testcases.MockGetAll{
+				Ctrl:       ctrl,
+				Ctx:        s.ctx,
+				RepoMock:   mock.NewMockIProductRepository(ctrl),
+				FileMock:   mock.NewMockIFileUsecaseAdapter(ctrl),
+				TRUMock:    mock.NewMockITRUUsecaseAdapter(ctrl),
+				RegionMock: mock.NewMockIRegionUsecaseAdapter(ctrl),
+				T:          t,
+			}
+
+			usecase := product_usecase.NewProductUsecase(
+				mockStruct.RepoMock, s.logger, nil, nil, mockStruct.FileMock, nil, nil, mockStruct.TRUMock, mockStruct.RegionMock,
+			)
+
+			tc.SetupMocks(mockStruct)
+			products, _, err := usecase.GetAll(s.ctx, tc.InputParams)
+
+			if tc.ExpectedError != nil {
+				assert.Error(t, err)
+				assert.Contains(t, err.Error(), tc.ExpectedError.Error())
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tc.ExpectedRegionID, tc.InputParams.RegionID)
+			if assert.Len(t, products, 1) && assert.NotNil(t, products[0].Reimbursement) {
+				assert.Equal(t, tc.ExpectedRegionID, products[0].Reimbursement.RegionID)
+			}
+		})
+	}
+}
+
+func (s *ProductUsecaseTestSuite) TestCalculateCertificate() {
+	tests := product_testcases.GetCalculateCertificateTestCases()
+
+	for _, tc := range tests {
+		s.T().Run(tc.Name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockStruct := &product_testcases.MockCalculateCertificate{
+				Ctrl:       ctrl,
+				Ctx:        s.ctx,
+				RepoMock:   mock.NewMockIProductRepository(ctrl),
+				TRUMock:    mock.NewMockITRUUsecaseAdapter(ctrl),
+				RegionMock: mock.NewMockIRegionUsecaseAdapter(ctrl),
+				T:          t,
+			}
+
+			usecase := product_usecase.NewProductUsecase(
+				mockStruct.RepoMock, s.logger, nil, nil, nil, nil, nil, mockStruct.TRUMock, mockStruct.RegionMock,
+			)
+
+			tc.SetupMocks(mockStruct)
+			calculation, err := usecase.CalculateCertificate(s.ctx, tc.InputProductID, tc.InputRegionID, tc.InputCertificateAmount)
+
+			if tc.ExpectedError != nil {
+				assert.ErrorIs(t, err, tc.ExpectedError)
+				assert.Nil(t, calculation)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tc.ExpectedCalculation, calculation)
+		})
+	}
+}
@@ -0,0 +1,86 @@
+package receipt_http
+
+import (
+	"math"
+
+	receipt_dto "github.com/Fi44er/sdmed/internal/module/receipt/dto"
+	receipt_entity "github.com/Fi44er/sdmed/internal/module/receipt/entity"
+	dto_utils "github.com/Fi44er/sdmed/pkg/utils/dto"
+)
+
+type Converter struct{}
+
+func (c *Converter) ToFilterEntity(dto *receipt_dto.ReceiptQueryParams) *receipt_entity.ReceiptFilter {
+	return &receipt_entity.ReceiptFilter{
+		OrderID: dto.OrderID,
+		Status:  receipt_entity.ReceiptStatus(dto.Status),
+	}
+}
+
+func (c *Converter) ToReceiptResponse(entity *receipt_entity.Receipt) *receipt_dto.ReceiptResponse {
+	response := &receipt_dto.ReceiptResponse{
+		ID:            entity.ID,
+		OrderID:       entity.OrderID,
+		PaymentID:     entity.PaymentID,
+		Type:          string(entity.Type),
+		Status:        string(entity.Status),
+		Total:         entity.Payload.Total,
+		Items:         make([]receipt_dto.ReceiptItemResponse, len(entity.Payload.Items)),
+		Payments:      make([]receipt_dto.ReceiptPaymentResponse, len(entity.Payload.Payments)),
+		Provider:      entity.Provider,
+		ExternalID:    entity.ExternalID,
+		Attempts:      entity.Attempts,
+		NextAttemptAt: entity.NextAttemptAt,
+		LastError:     entity.LastError,
+		CreatedAt:     entity.CreatedAt,
+		UpdatedAt:     entity.UpdatedAt,
+	}
+
+	for i, item := range entity.Payload.Items {
+		response.Items[i] = receipt_dto.ReceiptItemResponse{
+			Name:     item.Name,
+			Price:    item.Price,
+			Quantity: item.Quantity,
+			Sum:      item.Sum,
+			VAT:      string(item.VAT),
+		}
+	}
+	for i, payment := range entity.Payload.Payments {
+		response.Payments[i] = receipt_dto.ReceiptPaymentResponse{
+			Type: int(payment.Type),
+			Sum:  payment.Sum,
+		}
+	}
+	if entity.Fiscal != nil {
+		response.Fiscal = &receipt_dto.FiscalDataResponse{
+			ReceiptNumber:           entity.Fiscal.ReceiptNumber,
+			ShiftNumber:             entity.Fiscal.ShiftNumber,
+			ReceiptDatetime:         entity.Fiscal.ReceiptDatetime,
+			Total:                   entity.Fiscal.Total,
+			FNNumber:                entity.Fiscal.FNNumber,
+			ECRRegistrationNumber:   entity.Fiscal.ECRRegistrationNumber,
+			FiscalDocumentNumber:    entity.Fiscal.FiscalDocumentNumber,
+			FiscalDocumentAttribute: entity.Fiscal.FiscalDocumentAttribute,
+			FNSSite:                 entity.Fiscal.FNSSite,
+		}
+	}
+
+	return response
+}
+
+func (c *Converter) ToReceiptListResponse(receipts []receipt_entity.Receipt, count int64, page, pageSize int) *dto_utils.ListResponse[receipt_dto.ReceiptResponse] {
+	data := make([]receipt_dto.ReceiptResponse, len(receipts))
+	for i := range receipts {
+		data[i] = *c.ToReceiptResponse(&receipts[i])
+	}
+
+	return &dto_utils.ListResponse[receipt_dto.ReceiptResponse]{
+		Data: data,
+		Pagination: dto_utils.PaginationInfo{
+			Total:    count,
+			Page:     page,
+			PageSize: pageSize,
+			Pages:    int(math.Ceil(float64(count) / float64(pageSize))),
+		},
+	}
+}
@@ -0,0 +1,118 @@
+package receipt_http
+
+import (
+	"context"
+
+	receipt_dto "github.com/Fi44er/sdmed/internal/module/receipt/dto"
+	receipt_entity "github.com/Fi44er/sdmed/internal/module/receipt/entity"
+	"github.com/Fi44er/sdmed/pkg/logger"
+	_ "github.com/Fi44er/sdmed/pkg/response"
+	"github.com/go-playground/validator/v10"
+	"github.com/gofiber/fiber/v2"
+)
+
+type IReceiptUsecase interface {
+	GetByID(ctx context.Context, id string) (*receipt_entity.Receipt, error)
+	GetAll(ctx context.Context, filter *receipt_entity.ReceiptFilter, page, pageSize int) ([]receipt_entity.Receipt, int64, error)
+	Retry(ctx context.Context, id string) (*receipt_entity.Receipt, error)
+}
+
+type ReceiptHandler struct {
+	usecase IReceiptUsecase
+
+	validator *validator.Validate
+	logger    *logger.Logger
+	converter *Converter
+}
+
+func NewReceiptHandler(
+	usecase IReceiptUsecase,
+	validator *validator.Validate,
+	logger *logger.Logger,
+) *ReceiptHandler {
+	return &ReceiptHandler{
+		usecase:   usecase,
+		validator: validator,
+		logger:    logger,
+		converter: &Converter{},
+	}
+}
+
+// GetAll godoc
+// @Summary Get receipts
+// @Description Fiscal receipts outbox, newest first
+// @Tags receipts-admin
+// @Produce json
+// @Param order_id query string false "Filter by order"
+// @Param status query string false "Filter by status (pending, sent, done, failed)"
+// @Param page query int false "Page number (default 1)"
+// @Param page_size query int false "Items per page (default 20)"
+// @Success 200 {object} response.ResponseData{data=[]receipt_dto.ReceiptResponse} "OK"
+// @Failure 400 {object} response.Response "Invalid status"
+// @Failure 500 {object} response.Response "Error"
+// @Router /admin/receipts [get]
+func (h *ReceiptHandler) GetAll(ctx *fiber.Ctx) error {
+	params := &receipt_dto.ReceiptQueryParams{
+		Page:     1,
+		PageSize: 20,
+	}
+
+	if err := ctx.QueryParser(params); err != nil {
+		h.logger.Errorf("Failed to parse query params: %v", err)
+		return fiber.NewError(fiber.StatusBadRequest, "Invalid query parameters")
+	}
+
+	receipts, count, err := h.usecase.GetAll(ctx.Context(), h.converter.ToFilterEntity(params), params.Page, params.PageSize)
+	if err != nil {
+		return err
+	}
+
+	return ctx.Status(200).JSON(fiber.Map{
+		"status": "success",
+		"data":   h.converter.ToReceiptListResponse(receipts, count, params.Page, params.PageSize),
+	})
+}
+
+// GetByID godoc
+// @Summary Get receipt
+// @Description Receipt with line items, sending state and fiscal data
+// @Tags receipts-admin
+// @Produce json
+// @Param id path string true "Receipt ID"
+// @Success 200 {object} response.ResponseData{data=receipt_dto.ReceiptResponse} "OK"
+// @Failure 404 {object} response.Response "Receipt not found"
+// @Failure 500 {object} response.Response "Error"
+// @Router /admin/receipts/{id} [get]
+func (h *ReceiptHandler) GetByID(ctx *fiber.Ctx) error {
+	receipt, err := h.usecase.GetByID(ctx.Context(), ctx.Params("id"))
+	if err != nil {
+		return err
+	}
+
+	return ctx.Status(200).JSON(fiber.Map{
+		"status": "success",
+		"data":   h.converter.ToReceiptResponse(receipt),
+	})
+}
+
+// Retry godoc
+// @Summary Retry a failed receipt
+// @Description Put a receipt whose sending attempts are exhausted back into the queue
+// @Tags receipts-admin
+// @Produce json
+// @Param id path string true "Receipt ID"
+// @Success 200 {object} response.ResponseData{data=receipt_dto.ReceiptResponse} "OK"
+// @Failure 404 {object} response.Response "Receipt not found"
+// @Failure 409 {object} response.Response "Receipt is not failed"
+// @Router /admin/receipts/{id}/retry [post]
+func (h *ReceiptHandler) Retry(ctx *fiber.Ctx) error {
+	receipt, err := h.usecase.Retry(ctx.Context(), ctx.Params("id"))
+	if err != nil {
+		return err
+	}
+
+	return ctx.Status(200).JSON(fiber.Map{
+		"status": "success",
+		"data":   h.converter.ToReceiptResponse(receipt),
+	})
+}
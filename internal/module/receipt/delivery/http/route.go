@@ -0,0 +1,13 @@
+package receipt_http
+
+import (
+	"github.com/Fi44er/sdmed/internal/middlewares"
+	"github.com/gofiber/fiber/v2"
+)
+
+func (h *ReceiptHandler) RegisterRoutes(router fiber.Router) {
+	admin := router.Group("/admin/receipts", middlewares.Authorize("receipt", "read"))
+	admin.Get("/", h.GetAll)
+	admin.Get("/:id", h.GetByID)
+	admin.Post("/:id/retry", middlewares.Authorize("receipt", "update"), h.Retry)
+}
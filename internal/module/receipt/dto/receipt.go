@@ -0,0 +1,54 @@
+package receipt_dto
+
+import "time"
+
+type ReceiptQueryParams struct {
+	OrderID  string `query:"order_id"`
+	Status   string `query:"status"`
+	Page     int    `query:"page"`
+	PageSize int    `query:"page_size"`
+}
+
+type ReceiptItemResponse struct {
+	Name     string  `json:"name"`
+	Price    float64 `json:"price"`
+	Quantity float64 `json:"quantity"`
+	Sum      float64 `json:"sum"`
+	VAT      string  `json:"vat"`
+}
+
+type ReceiptPaymentResponse struct {
+	Type int     `json:"type"`
+	Sum  float64 `json:"sum"`
+}
+
+type FiscalDataResponse struct {
+	ReceiptNumber           int64   `json:"receipt_number"`
+	ShiftNumber             int64   `json:"shift_number"`
+	ReceiptDatetime         string  `json:"receipt_datetime"`
+	Total                   float64 `json:"total"`
+	FNNumber                string  `json:"fn_number"`
+	ECRRegistrationNumber   string  `json:"ecr_registration_number"`
+	FiscalDocumentNumber    int64   `json:"fiscal_document_number"`
+	FiscalDocumentAttribute int64   `json:"fiscal_document_attribute"`
+	FNSSite                 string  `json:"fns_site"`
+}
+
+type ReceiptResponse struct {
+	ID            string                   `json:"id"`
+	OrderID       string                   `json:"order_id"`
+	PaymentID     string                   `json:"payment_id"`
+	Type          string                   `json:"type"`
+	Status        string                   `json:"status"`
+	Total         float64                  `json:"total"`
+	Items         []ReceiptItemResponse    `json:"items"`
+	Payments      []ReceiptPaymentResponse `json:"payments"`
+	Provider      string                   `json:"provider,omitempty"`
+	ExternalID    string                   `json:"external_id,omitempty"`
+	Attempts      int                      `json:"attempts"`
+	NextAttemptAt time.Time                `json:"next_attempt_at"`
+	LastError     string                   `json:"last_error,omitempty"`
+	Fiscal        *FiscalDataResponse      `json:"fiscal,omitempty"`
+	CreatedAt     time.Time                `json:"created_at"`
+	UpdatedAt     time.Time                `json:"updated_at"`
+}
@@ -0,0 +1,309 @@
+package receipt_entity
+
+import (
+	"math"
+)
+
+type VAT string
+
+const (
+	VATNone VAT = "none"
+	VAT0    VAT = "vat0"
+	VAT10   VAT = "vat10"
+	VAT20   VAT = "vat20"
+)
+
+func (v VAT) IsValid() bool {
+	switch v {
+	case VATNone, VAT0, VAT10, VAT20:
+		return true
+	}
+	return false
+}
+
+type PaymentType int
+
+const (
+	// PaymentTypeElectronic - безналичная оплата картой
+	PaymentTypeElectronic PaymentType = 1
+	// PaymentTypeOther - иная форма оплаты, здесь - электронный сертификат СФР
+	PaymentTypeOther PaymentType = 4
+)
+
+const (
+	// PaymentMethodFullPayment - полный расчет, признак способа расчета 1214
+	PaymentMethodFullPayment = "full_payment"
+	// PaymentObjectCommodity - товар, признак предмета расчета 1212
+	PaymentObjectCommodity = "commodity"
+	// PaymentObjectService - услуга, здесь - доставка
+	PaymentObjectService = "service"
+	MeasurementUnitPiece = "шт"
+)
+
+// Payload - содержимое чека по 54-ФЗ
+type Payload struct {
+	Client   Client
+	Company  Company
+	Items    []Item
+	Payments []Payment
+	Total    float64
+}
+
+type Client struct {
+	Name  string
+	Email string
+	Phone string
+}
+
+// Company - реквизиты продавца. SNO - система налогообложения: osn, usn_income и т.д.
+type Company struct {
+	INN            string
+	Email          string
+	SNO            string
+	PaymentAddress string
+}
+
+type Item struct {
+	Name            string
+	Price           float64
+	Quantity        float64
+	Sum             float64
+	MeasurementUnit string
+	PaymentMethod   string
+	PaymentObject   string
+	VAT             VAT
+}
+
+type Payment struct {
+	Type PaymentType
+	Sum  float64
+}
+
+// ReceiptOrder - данные заказа, нужные для чека
+type ReceiptOrder struct {
+	ID                string
+	Contact           Client
+	Items             []OrderLine
+	Total             float64
+	CertificateAmount float64
+}
+
+// OrderLine - позиция заказа. Позиции с кодом ТРУ - технические средства реабилитации,
+// их реализация освобождена от НДС. Service - строка услуги доставки.
+// Discount - скидка на позицию целиком
+type OrderLine struct {
+	ID       string
+	Name     string
+	Price    float64
+	Quantity int
+	Discount float64
+	TRUCode  string
+	Service  bool
+}
+
+// Sum - сумма позиции со скидкой
+func (l *OrderLine) Sum() float64 {
+	return round(l.Price*float64(l.Quantity) - l.Discount)
+}
+
+// RefundLine - возвращаемая позиция заказа: количество и сумма возврата за него
+type RefundLine struct {
+	OrderItemID string
+	Quantity    int
+	Amount      float64
+}
+
+// ReceiptRequest - запрос на формирование чека по платежу. Amount - сумма,
+// поступившая картой; для возврата - сумма возврата. Items - возвращаемые позиции,
+// без них чек возврата формируется по заказу целиком
+type ReceiptRequest struct {
+	OrderID   string
+	PaymentID string
+	Type      ReceiptType
+	Amount    float64
+	Items     []RefundLine
+	Key       string
+}
+
+// NewSellPayload - чек прихода по всем позициям заказа. Часть, покрытая
+// сертификатом, проводится отдельной оплатой иного вида
+func NewSellPayload(order *ReceiptOrder, company Company, vat VAT, cardAmount float64) *Payload {
+	payload := &Payload{
+		Client:  order.Contact,
+		Company: company,
+	}
+	for _, line := range order.Items {
+		sum := line.Sum()
+		payload.Items = append(payload.Items, sellItems(line, sum, vat)...)
+		payload.Total += sum
+	}
+	payload.Total = round(payload.Total)
+
+	if certificate := round(payload.Total - cardAmount); order.CertificateAmount > 0 && certificate > 0 {
+		payload.Payments = []Payment{
+			{Type: PaymentTypeElectronic, Sum: round(cardAmount)},
+			{Type: PaymentTypeOther, Sum: certificate},
+		}
+	} else {
+		payload.Payments = []Payment{{Type: PaymentTypeElectronic, Sum: payload.Total}}
+	}
+
+	return payload
+}
+
+// NewRefundPayload - чек возврата прихода. С возвращаемыми позициями в чек попадают только они
+// с их количеством. Без них при полном возврате повторяются позиции заказа, при частичном
+// сумма распределяется по позициям пропорционально их стоимости
+func NewRefundPayload(order *ReceiptOrder, company Company, vat VAT, amount float64, returned []RefundLine) *Payload {
+	if len(returned) > 0 {
+		return newReturnedPayload(order, company, vat, round(amount), returned)
+	}
+
+	sell := NewSellPayload(order, company, vat, amount)
+	amount = round(amount)
+	if math.Abs(sell.Total-amount) < 0.005 {
+		sell.Payments = []Payment{{Type: PaymentTypeElectronic, Sum: amount}}
+		return sell
+	}
+
+	payload := &Payload{
+		Client:   order.Contact,
+		Company:  company,
+		Payments: []Payment{{Type: PaymentTypeElectronic, Sum: amount}},
+		Total:    amount,
+	}
+
+	var allocated float64
+	largest := 0
+	for i, line := range order.Items {
+		sum := round(line.Sum() * amount / sell.Total)
+		allocated += sum
+		payload.Items = append(payload.Items, newItem(line, sum, 1, sum, vat))
+		if sum > payload.Items[largest].Sum {
+			largest = i
+		}
+	}
+
+	// копейки округления относятся на самую дорогую позицию
+	if diff := round(amount - allocated); diff != 0 && len(payload.Items) > 0 {
+		item := &payload.Items[largest]
+		item.Sum = round(item.Sum + diff)
+		item.Price = item.Sum
+	}
+
+	filtered := payload.Items[:0]
+	for _, item := range payload.Items {
+		if item.Sum > 0 {
+			filtered = append(filtered, item)
+		}
+	}
+	payload.Items = filtered
+
+	return payload
+}
+
+// newReturnedPayload - чек возврата по возвращаемым позициям. Если сумма возврата отличается
+// от суммы позиций, например при удержании, разница распределяется между ними пропорционально
+func newReturnedPayload(order *ReceiptOrder, company Company, vat VAT, amount float64, returned []RefundLine) *Payload {
+	payload := &Payload{
+		Client:   order.Contact,
+		Company:  company,
+		Payments: []Payment{{Type: PaymentTypeElectronic, Sum: amount}},
+		Total:    amount,
+	}
+
+	lines := make([]OrderLine, 0, len(returned))
+	sums := make([]float64, 0, len(returned))
+	var total float64
+	for _, item := range returned {
+		line := order.findLine(item.OrderItemID)
+		if line == nil || item.Quantity <= 0 || item.Amount <= 0 {
+			continue
+		}
+		returnedLine := *line
+		returnedLine.Quantity = item.Quantity
+		lines = append(lines, returnedLine)
+		sums = append(sums, round(item.Amount))
+		total += item.Amount
+	}
+	if len(lines) == 0 {
+		return payload
+	}
+
+	if total = round(total); total != amount {
+		var allocated float64
+		largest := 0
+		for i := range sums {
+			sums[i] = round(sums[i] * amount / total)
+			allocated += sums[i]
+			if sums[i] > sums[largest] {
+				largest = i
+			}
+		}
+		// копейки округления относятся на самую дорогую позицию
+		sums[largest] = round(sums[largest] + amount - allocated)
+	}
+
+	for i, line := range lines {
+		if sums[i] <= 0 {
+			continue
+		}
+		line.Discount = round(line.Price*float64(line.Quantity) - sums[i])
+		payload.Items = append(payload.Items, sellItems(line, sums[i], vat)...)
+	}
+
+	return payload
+}
+
+func (o *ReceiptOrder) findLine(id string) *OrderLine {
+	for i := range o.Items {
+		if id != "" && o.Items[i].ID == id {
+			return &o.Items[i]
+		}
+	}
+	return nil
+}
+
+// sellItems - строки чека по позиции. Цена со скидкой, которая не делится на количество
+// без остатка, дает две строки: единицы по цене с округлением вниз и последнюю единицу с остатком
+func sellItems(line OrderLine, sum float64, vat VAT) []Item {
+	quantity := float64(line.Quantity)
+	if line.Discount == 0 {
+		return []Item{newItem(line, line.Price, quantity, sum, vat)}
+	}
+	if price := round(sum / quantity); round(price*quantity) == sum {
+		return []Item{newItem(line, price, quantity, sum, vat)}
+	}
+
+	price := math.Floor(sum/quantity*100) / 100
+	rest := round(sum - price*(quantity-1))
+	return []Item{
+		newItem(line, price, quantity-1, round(price*(quantity-1)), vat),
+		newItem(line, rest, 1, rest, vat),
+	}
+}
+
+func newItem(line OrderLine, price, quantity, sum float64, vat VAT) Item {
+	if line.TRUCode != "" {
+		vat = VATNone
+	}
+	object := PaymentObjectCommodity
+	if line.Service {
+		object = PaymentObjectService
+	}
+
+	return Item{
+		Name:            line.Name,
+		Price:           round(price),
+		Quantity:        quantity,
+		Sum:             sum,
+		MeasurementUnit: MeasurementUnitPiece,
+		PaymentMethod:   PaymentMethodFullPayment,
+		PaymentObject:   object,
+		VAT:             vat,
+	}
+}
+
+func round(value float64) float64 {
+	return math.Round(value*100) / 100
+}
@@ -0,0 +1,136 @@
+package receipt_entity
+
+import (
+	"testing"
+	"time"
+)
+
+func newTestOrder() *ReceiptOrder {
+	return &ReceiptOrder{
+		ID:      "order-1",
+		Contact: Client{Name: "Иванов", Email: "user@example.com"},
+		Items: []OrderLine{
+			{ID: "item-1", Name: "Кресло-коляска", Price: 1000, Quantity: 1, TRUCode: "30.92.20.000-00000001"},
+			{ID: "item-2", Name: "Чехол", Price: 100.1, Quantity: 3},
+		},
+		Total: 1300.3,
+	}
+}
+
+func sumItems(payload *Payload) float64 {
+	total := 0.0
+	for _, item := range payload.Items {
+		total += item.Sum
+	}
+	return round(total)
+}
+
+func TestNewSellPayload(t *testing.T) {
+	company := Company{INN: "7700000000", SNO: "osn"}
+
+	t.Run("card payment", func(t *testing.T) {
+		payload := NewSellPayload(newTestOrder(), company, VAT20, 1300.3)
+		if payload.Total != 1300.3 || sumItems(payload) != payload.Total {
+			t.Errorf("unexpected totals %+v", payload)
+		}
+		if payload.Items[0].VAT != VATNone || payload.Items[1].VAT != VAT20 {
+			t.Errorf("unexpected VAT: %s, %s", payload.Items[0].VAT, payload.Items[1].VAT)
+		}
+		if len(payload.Payments) != 1 || payload.Payments[0].Type != PaymentTypeElectronic || payload.Payments[0].Sum != 1300.3 {
+			t.Errorf("unexpected payments %+v", payload.Payments)
+		}
+	})
+
+	t.Run("delivery is a service line", func(t *testing.T) {
+		order := newTestOrder()
+		order.Items = append(order.Items, OrderLine{Name: "Доставка", Price: 300, Quantity: 1, Service: true})
+		payload := NewSellPayload(order, company, VAT20, 1600.3)
+		delivery := payload.Items[2]
+		if delivery.PaymentObject != PaymentObjectService || delivery.VAT != VAT20 || payload.Total != 1600.3 {
+			t.Errorf("unexpected delivery line %+v, total %.2f", delivery, payload.Total)
+		}
+	})
+
+	t.Run("discounted line", func(t *testing.T) {
+		order := newTestOrder()
+		order.Items[1].Discount = 30.01
+		payload := NewSellPayload(order, company, VAT20, 1270.29)
+		if payload.Total != 1270.29 || sumItems(payload) != payload.Total || len(payload.Items) != 3 {
+			t.Fatalf("unexpected payload %+v", payload)
+		}
+		if payload.Items[1].Quantity != 2 || payload.Items[1].Price != 90.09 || payload.Items[2].Price != 90.11 {
+			t.Errorf("uneven discounted price must be split: %+v, %+v", payload.Items[1], payload.Items[2])
+		}
+	})
+
+	t.Run("certificate surcharge", func(t *testing.T) {
+		order := newTestOrder()
+		order.CertificateAmount = 900
+		payload := NewSellPayload(order, company, VAT20, 400.3)
+		if len(payload.Payments) != 2 ||
+			payload.Payments[0] != (Payment{Type: PaymentTypeElectronic, Sum: 400.3}) ||
+			payload.Payments[1] != (Payment{Type: PaymentTypeOther, Sum: 900}) {
+			t.Errorf("unexpected payments %+v", payload.Payments)
+		}
+	})
+}
+
+func TestNewRefundPayload(t *testing.T) {
+	company := Company{INN: "7700000000", SNO: "osn"}
+
+	t.Run("full refund repeats lines", func(t *testing.T) {
+		payload := NewRefundPayload(newTestOrder(), company, VAT20, 1300.3, nil)
+		if len(payload.Items) != 2 || payload.Items[1].Quantity != 3 || payload.Total != 1300.3 {
+			t.Errorf("unexpected payload %+v", payload)
+		}
+	})
+
+	t.Run("partial refund is distributed", func(t *testing.T) {
+		payload := NewRefundPayload(newTestOrder(), company, VAT20, 100, nil)
+		if payload.Total != 100 || sumItems(payload) != 100 {
+			t.Errorf("items sum %.2f, total %.2f, want 100", sumItems(payload), payload.Total)
+		}
+		if payload.Items[0].VAT != VATNone || payload.Payments[0].Sum != 100 {
+			t.Errorf("unexpected payload %+v", payload)
+		}
+	})
+
+	t.Run("returned lines keep their quantity", func(t *testing.T) {
+		payload := NewRefundPayload(newTestOrder(), company, VAT20, 200.2, []RefundLine{{OrderItemID: "item-2", Quantity: 2, Amount: 200.2}})
+		if len(payload.Items) != 1 || payload.Total != 200.2 || sumItems(payload) != 200.2 {
+			t.Fatalf("unexpected payload %+v", payload)
+		}
+		if item := payload.Items[0]; item.Name != "Чехол" || item.Quantity != 2 || item.Price != 100.1 || item.VAT != VAT20 {
+			t.Errorf("unexpected returned line %+v", item)
+		}
+	})
+
+	t.Run("withheld amount is distributed over returned lines", func(t *testing.T) {
+		payload := NewRefundPayload(newTestOrder(), company, VAT20, 900, []RefundLine{
+			{OrderItemID: "item-1", Quantity: 1, Amount: 1000},
+			{OrderItemID: "item-2", Quantity: 1, Amount: 100.1},
+		})
+		if payload.Total != 900 || sumItems(payload) != 900 || len(payload.Items) != 2 {
+			t.Fatalf("unexpected payload %+v", payload)
+		}
+		if payload.Items[0].VAT != VATNone || payload.Items[0].Quantity != 1 || payload.Items[1].Quantity != 1 {
+			t.Errorf("unexpected returned lines %+v", payload.Items)
+		}
+	})
+
+	t.Run("unknown returned line is skipped", func(t *testing.T) {
+		payload := NewRefundPayload(newTestOrder(), company, VAT20, 100, []RefundLine{{OrderItemID: "item-9", Quantity: 1, Amount: 100}})
+		if len(payload.Items) != 0 {
+			t.Errorf("unexpected items %+v", payload.Items)
+		}
+	})
+}
+
+func TestRetryDelay(t *testing.T) {
+	cases := map[int]time.Duration{0: time.Minute, 1: time.Minute, 3: 4 * time.Minute, 20: time.Hour}
+	for attempt, want := range cases {
+		if got := RetryDelay(attempt, time.Minute, time.Hour); got != want {
+			t.Errorf("RetryDelay(%d) = %v, want %v", attempt, got, want)
+		}
+	}
+}
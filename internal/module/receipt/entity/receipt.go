@@ -0,0 +1,95 @@
+package receipt_entity
+
+import (
+	"math"
+	"time"
+)
+
+type ReceiptType string
+
+const (
+	// ReceiptTypeSell - приход, формируется при оплате
+	ReceiptTypeSell ReceiptType = "sell"
+	// ReceiptTypeSellRefund - возврат прихода, формируется при возврате платежа
+	ReceiptTypeSellRefund ReceiptType = "sell_refund"
+)
+
+type ReceiptStatus string
+
+const (
+	// ReceiptStatusPending - ждет отправки оператору фискальных данных
+	ReceiptStatusPending ReceiptStatus = "pending"
+	// ReceiptStatusSent - принят оператором, ждет фискализации
+	ReceiptStatusSent ReceiptStatus = "sent"
+	ReceiptStatusDone ReceiptStatus = "done"
+	// ReceiptStatusFailed - попытки исчерпаны, нужна ручная повторная отправка
+	ReceiptStatusFailed ReceiptStatus = "failed"
+)
+
+func (s ReceiptStatus) IsValid() bool {
+	switch s {
+	case ReceiptStatusPending, ReceiptStatusSent, ReceiptStatusDone, ReceiptStatusFailed:
+		return true
+	}
+	return false
+}
+
+// Receipt - запись outbox: чек, который нужно передать оператору фискальных данных.
+// Создается в одной транзакции с оплатой или возвратом, отправляется фоновым процессом
+type Receipt struct {
+	ID             string
+	OrderID        string
+	PaymentID      string
+	Type           ReceiptType
+	Status         ReceiptStatus
+	IdempotencyKey string
+	Payload        Payload
+	Provider       string
+	ExternalID     string
+	Attempts       int
+	NextAttemptAt  time.Time
+	LastError      string
+	Fiscal         *FiscalData
+	CreatedAt      time.Time
+	UpdatedAt      time.Time
+}
+
+// FiscalData - реквизиты фискализированного чека
+type FiscalData struct {
+	ReceiptNumber           int64
+	ShiftNumber             int64
+	ReceiptDatetime         string
+	Total                   float64
+	FNNumber                string
+	ECRRegistrationNumber   string
+	FiscalDocumentNumber    int64
+	FiscalDocumentAttribute int64
+	FNSSite                 string
+}
+
+// FiscalResult - ответ оператора фискальных данных на отправку или запрос статуса
+type FiscalResult struct {
+	ExternalID string
+	Status     ReceiptStatus
+	Fiscal     *FiscalData
+	Error      string
+}
+
+type ReceiptFilter struct {
+	OrderID string
+	Status  ReceiptStatus
+	Offset  int
+	Limit   int
+}
+
+// RetryDelay - задержка перед попыткой attempt: 1, 2, 4 ... минуты, но не больше maxDelay
+func RetryDelay(attempt int, baseDelay, maxDelay time.Duration) time.Duration {
+	if attempt < 1 {
+		attempt = 1
+	}
+	delay := time.Duration(float64(baseDelay) * math.Pow(2, float64(attempt-1)))
+	if delay <= 0 || delay > maxDelay {
+		return maxDelay
+	}
+	return delay
+}
@@ -0,0 +1,64 @@
+package receipt_adapters
+
+import (
+	"context"
+
+	order_entity "github.com/Fi44er/sdmed/internal/module/order/entity"
+	order_usecase "github.com/Fi44er/sdmed/internal/module/order/usecase/order"
+	receipt_entity "github.com/Fi44er/sdmed/internal/module/receipt/entity"
+)
+
+type IOrderUsecaseAdapter interface {
+	GetByID(ctx context.Context, id string) (*receipt_entity.ReceiptOrder, error)
+}
+
+type OrderUsecaseAdapter struct {
+	orderUsecase order_usecase.IOrderUsecase
+}
+
+func NewOrderUsecaseAdapter(orderUsecase order_usecase.IOrderUsecase) IOrderUsecaseAdapter {
+	return &OrderUsecaseAdapter{
+		orderUsecase: orderUsecase,
+	}
+}
+
+func (a *OrderUsecaseAdapter) GetByID(ctx context.Context, id string) (*receipt_entity.ReceiptOrder, error) {
+	order, err := a.orderUsecase.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	receiptOrder := &receipt_entity.ReceiptOrder{
+		ID: order.ID,
+		Contact: receipt_entity.Client{
+			Name:  order.Contact.Name,
+			Email: order.Contact.Email,
+			Phone: order.Contact.Phone,
+		},
+		Items: make([]receipt_entity.OrderLine, len(order.Items)),
+		Total: order.Total,
+	}
+	if order.PaymentMethod == order_entity.PaymentMethodCertificate {
+		receiptOrder.CertificateAmount = order.CertificateAmount
+	}
+	for i, item := range order.Items {
+		receiptOrder.Items[i] = receipt_entity.OrderLine{
+			ID:       item.ID,
+			Name:     item.Name,
+			Price:    item.Price,
+			Quantity: item.Quantity,
+			Discount: item.Discount,
+			TRUCode:  item.TRUCode,
+		}
+	}
+	if order.Delivery.Cost > 0 {
+		receiptOrder.Items = append(receiptOrder.Items, receipt_entity.OrderLine{
+			Name:     "Доставка: " + order.Delivery.MethodName,
+			Price:    order.Delivery.Cost,
+			Quantity: 1,
+			Service:  true,
+		})
+	}
+
+	return receiptOrder, nil
+}
@@ -0,0 +1,277 @@
+package atol_client
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	receipt_entity "github.com/Fi44er/sdmed/internal/module/receipt/entity"
+	"github.com/Fi44er/sdmed/pkg/logger"
+)
+
+const (
+	// tokenPath - получение токена авторизации, токен действует 24 часа
+	tokenPath = "/getToken"
+	// documentPath - регистрация документа, %s - код группы и операция sell или sell_refund
+	documentPath = "/%s/%s"
+	// reportPath - результат обработки документа, %s - код группы и uuid документа
+	reportPath = "/%s/report/%s"
+
+	tokenTTL        = 23 * time.Hour
+	timestampLayout = "02.01.2006 15:04:05"
+
+	// codeDuplicate - документ с таким external_id уже зарегистрирован, в ответе его uuid
+	codeDuplicate = 10
+
+	defaultRequestTimeout = 30 * time.Second
+)
+
+var errUnauthorized = errors.New("atol token is rejected")
+
+type Options struct {
+	URL       string
+	Login     string
+	Password  string
+	GroupCode string
+	Timeout   time.Duration
+}
+
+// ATOLClient - клиент АТОЛ Онлайн, API v4
+type ATOLClient struct {
+	httpClient *http.Client
+	options    Options
+	logger     *logger.Logger
+
+	mutex     sync.Mutex
+	token     string
+	expiresAt time.Time
+}
+
+func NewATOLClient(logger *logger.Logger, options Options) *ATOLClient {
+	if options.Timeout <= 0 {
+		options.Timeout = defaultRequestTimeout
+	}
+	options.URL = strings.TrimRight(options.URL, "/")
+
+	return &ATOLClient{
+		httpClient: &http.Client{Timeout: options.Timeout},
+		options:    options,
+		logger:     logger,
+	}
+}
+
+// Send регистрирует чек. external_id - идентификатор чека в outbox, поэтому повторная
+// отправка после потерянного ответа возвращает уже зарегистрированный документ
+func (c *ATOLClient) Send(ctx context.Context, receipt *receipt_entity.Receipt) (*receipt_entity.FiscalResult, error) {
+	request := &sellRequest{
+		ExternalID: receipt.ID,
+		Receipt:    toReceipt(&receipt.Payload),
+		Timestamp:  time.Now().Format(timestampLayout),
+	}
+	path := fmt.Sprintf(documentPath, url.PathEscape(c.options.GroupCode), string(receipt.Type))
+
+	var response documentResponse
+	if err := c.withToken(ctx, http.MethodPost, path, request, &response); err != nil {
+		return nil, err
+	}
+	if response.Error != nil {
+		if response.Error.Code == codeDuplicate && response.UUID != "" {
+			c.logger.Infof("ATOL: receipt %s is already registered as %s", receipt.ID, response.UUID)
+			return &receipt_entity.FiscalResult{ExternalID: response.UUID, Status: receipt_entity.ReceiptStatusSent}, nil
+		}
+		return nil, response.Error
+	}
+
+	return toResult(&response), nil
+}
+
+func (c *ATOLClient) GetStatus(ctx context.Context, receipt *receipt_entity.Receipt) (*receipt_entity.FiscalResult, error) {
+	path := fmt.Sprintf(reportPath, url.PathEscape(c.options.GroupCode), url.PathEscape(receipt.ExternalID))
+
+	var response documentResponse
+	if err := c.withToken(ctx, http.MethodGet, path, nil, &response); err != nil {
+		return nil, err
+	}
+	if response.UUID == "" {
+		response.UUID = receipt.ExternalID
+	}
+
+	return toResult(&response), nil
+}
+
+// withToken выполняет запрос с токеном. Отклоненный токен сбрасывается,
+// и запрос повторяется один раз с новым
+func (c *ATOLClient) withToken(ctx context.Context, method, path string, body, dst any) error {
+	for attempt := 0; ; attempt++ {
+		token, err := c.getToken(ctx)
+		if err != nil {
+			return err
+		}
+
+		err = c.do(ctx, method, path, token, body, dst)
+		if errors.Is(err, errUnauthorized) && attempt == 0 {
+			c.resetToken(token)
+			continue
+		}
+		return err
+	}
+}
+
+func (c *ATOLClient) getToken(ctx context.Context) (string, error) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	if c.token != "" && time.Now().Before(c.expiresAt) {
+		return c.token, nil
+	}
+
+	var response tokenResponse
+	err := c.do(ctx, http.MethodPost, tokenPath, "", &tokenRequest{Login: c.options.Login, Pass: c.options.Password}, &response)
+	if err != nil {
+		return "", err
+	}
+	if response.Error != nil {
+		return "", response.Error
+	}
+	if response.Token == "" {
+		return "", fmt.Errorf("atol returned empty token")
+	}
+
+	c.token, c.expiresAt = response.Token, time.Now().Add(tokenTTL)
+	return c.token, nil
+}
+
+func (c *ATOLClient) resetToken(token string) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	if c.token == token {
+		c.token = ""
+	}
+}
+
+func (c *ATOLClient) do(ctx context.Context, method, path, token string, body, dst any) error {
+	var reader io.Reader
+	if body != nil {
+		data, err := json.Marshal(body)
+		if err != nil {
+			return err
+		}
+		reader = bytes.NewReader(data)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, c.options.URL+path, reader)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json; charset=utf-8")
+	if token != "" {
+		req.Header.Set("Token", token)
+	}
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		c.logger.Errorf("ATOL request %s failed: %v", path, err)
+		return err
+	}
+	defer resp.Body.Close()
+
+	data, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return err
+	}
+
+	if resp.StatusCode == http.StatusUnauthorized {
+		return errUnauthorized
+	}
+	// ошибки валидации документа приходят с кодом 400 и телом с описанием ошибки
+	if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusBadRequest {
+		c.logger.Errorf("ATOL request %s returned status %d", path, resp.StatusCode)
+		return fmt.Errorf("unexpected status %d from atol %s", resp.StatusCode, path)
+	}
+
+	if err := json.Unmarshal(data, dst); err != nil {
+		return fmt.Errorf("decode response from atol %s: %w", path, err)
+	}
+
+	return nil
+}
+
+func (e *apiError) Error() string {
+	return fmt.Sprintf("atol error %d: %s", e.Code, e.Text)
+}
+
+// toResult - статусы документа АТОЛ: wait, done, fail
+func toResult(response *documentResponse) *receipt_entity.FiscalResult {
+	result := &receipt_entity.FiscalResult{ExternalID: response.UUID}
+	switch response.Status {
+	case "done":
+		result.Status = receipt_entity.ReceiptStatusDone
+		if response.Payload != nil {
+			result.Fiscal = &receipt_entity.FiscalData{
+				ReceiptNumber:           response.Payload.FiscalReceiptNumber,
+				ShiftNumber:             response.Payload.ShiftNumber,
+				ReceiptDatetime:         response.Payload.ReceiptDatetime,
+				Total:                   response.Payload.Total,
+				FNNumber:                response.Payload.FNNumber,
+				ECRRegistrationNumber:   response.Payload.ECRRegistrationNumber,
+				FiscalDocumentNumber:    response.Payload.FiscalDocumentNumber,
+				FiscalDocumentAttribute: response.Payload.FiscalDocumentAttribute,
+				FNSSite:                 response.Payload.FNSSite,
+			}
+		}
+	case "fail":
+		result.Status = receipt_entity.ReceiptStatusFailed
+		if response.Error != nil {
+			result.Error = response.Error.Error()
+		}
+	default:
+		result.Status = receipt_entity.ReceiptStatusSent
+	}
+
+	return result
+}
+
+func toReceipt(payload *receipt_entity.Payload) receipt {
+	result := receipt{
+		Client: client{
+			Name:  payload.Client.Name,
+			Email: payload.Client.Email,
+			Phone: payload.Client.Phone,
+		},
+		Company: company{
+			Email:          payload.Company.Email,
+			SNO:            payload.Company.SNO,
+			INN:            payload.Company.INN,
+			PaymentAddress: payload.Company.PaymentAddress,
+		},
+		Items:    make([]item, len(payload.Items)),
+		Payments: make([]payment, len(payload.Payments)),
+		Total:    payload.Total,
+	}
+	for i, it := range payload.Items {
+		result.Items[i] = item{
+			Name:            it.Name,
+			Price:           it.Price,
+			Quantity:        it.Quantity,
+			Sum:             it.Sum,
+			MeasurementUnit: it.MeasurementUnit,
+			PaymentMethod:   it.PaymentMethod,
+			PaymentObject:   it.PaymentObject,
+			VAT:             vat{Type: string(it.VAT)},
+		}
+	}
+	for i, p := range payload.Payments {
+		result.Payments[i] = payment{Type: int(p.Type), Sum: p.Sum}
+	}
+
+	return result
+}
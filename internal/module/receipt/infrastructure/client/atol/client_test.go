@@ -0,0 +1,171 @@
+package atol_client
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+
+	receipt_entity "github.com/Fi44er/sdmed/internal/module/receipt/entity"
+	"github.com/Fi44er/sdmed/pkg/logger"
+)
+
+const (
+	stubGroup = "group_1"
+	stubUUID  = "doc-1"
+)
+
+// newStubServer имитирует API v4 АТОЛ Онлайн. Первый выданный токен сервер
+// отклоняет, чтобы проверить его обновление
+func newStubServer(t *testing.T, sent *[]sellRequest) *httptest.Server {
+	t.Helper()
+
+	var tokens atomic.Int32
+	valid := func(r *http.Request) bool {
+		return r.Header.Get("Token") == "token-2"
+	}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/getToken", func(w http.ResponseWriter, r *http.Request) {
+		var request tokenRequest
+		json.NewDecoder(r.Body).Decode(&request)
+		if request.Login != "login" || request.Pass != "pass" {
+			w.Write([]byte(`{"error":{"code":12,"text":"wrong credentials"},"token":""}`))
+			return
+		}
+		n := tokens.Add(1)
+		json.NewEncoder(w).Encode(map[string]string{"token": "token-" + string(rune('0'+n))})
+	})
+	mux.HandleFunc("/"+stubGroup+"/sell", func(w http.ResponseWriter, r *http.Request) {
+		if !valid(r) {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		var request sellRequest
+		json.NewDecoder(r.Body).Decode(&request)
+		*sent = append(*sent, request)
+		if len(*sent) > 1 {
+			w.WriteHeader(http.StatusBadRequest)
+			w.Write([]byte(`{"uuid":"` + stubUUID + `","status":"fail","error":{"code":10,"text":"duplicate"}}`))
+			return
+		}
+		w.Write([]byte(`{"uuid":"` + stubUUID + `","status":"wait","error":null}`))
+	})
+	mux.HandleFunc("/"+stubGroup+"/sell_refund", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadRequest)
+		w.Write([]byte(`{"status":"fail","error":{"code":32,"text":"validation error"}}`))
+	})
+	mux.HandleFunc("/"+stubGroup+"/report/"+stubUUID, func(w http.ResponseWriter, r *http.Request) {
+		if !valid(r) {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		w.Write([]byte(`{"uuid":"` + stubUUID + `","status":"done","payload":{"total":1500.5,"fn_number":"9999","shift_number":3,` +
+			`"fiscal_receipt_number":12,"fiscal_document_number":345,"fiscal_document_attribute":678,"fns_site":"www.nalog.gov.ru"}}`))
+	})
+	mux.HandleFunc("/"+stubGroup+"/report/doc-2", func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"uuid":"doc-2","status":"fail","error":{"code":34,"text":"fn is closed"}}`))
+	})
+
+	return httptest.NewServer(mux)
+}
+
+func newTestClient(server string) *ATOLClient {
+	return NewATOLClient(logger.NewLogger(), Options{
+		URL:       server + "/",
+		Login:     "login",
+		Password:  "pass",
+		GroupCode: stubGroup,
+	})
+}
+
+func newTestReceipt() *receipt_entity.Receipt {
+	return &receipt_entity.Receipt{
+		ID:   "receipt-1",
+		Type: receipt_entity.ReceiptTypeSell,
+		Payload: receipt_entity.Payload{
+			Client:  receipt_entity.Client{Email: "user@example.com"},
+			Company: receipt_entity.Company{INN: "7700000000", SNO: "osn"},
+			Items: []receipt_entity.Item{{
+				Name: "Кресло-коляска", Price: 1500.5, Quantity: 1, Sum: 1500.5, VAT: receipt_entity.VATNone,
+			}},
+			Payments: []receipt_entity.Payment{{Type: receipt_entity.PaymentTypeElectronic, Sum: 1500.5}},
+			Total:    1500.5,
+		},
+	}
+}
+
+func TestClient(t *testing.T) {
+	var sent []sellRequest
+	server := newStubServer(t, &sent)
+	defer server.Close()
+
+	c := newTestClient(server.URL)
+	ctx := context.Background()
+
+	t.Run("send refreshes rejected token", func(t *testing.T) {
+		result, err := c.Send(ctx, newTestReceipt())
+		if err != nil {
+			t.Fatalf("Send: %v", err)
+		}
+		if result.ExternalID != stubUUID || result.Status != receipt_entity.ReceiptStatusSent {
+			t.Errorf("unexpected result %+v", result)
+		}
+		if len(sent) != 1 || sent[0].ExternalID != "receipt-1" || sent[0].Receipt.Items[0].VAT.Type != "none" || sent[0].Receipt.Payments[0].Type != 1 {
+			t.Errorf("unexpected request %+v", sent)
+		}
+	})
+
+	t.Run("duplicate returns registered document", func(t *testing.T) {
+		result, err := c.Send(ctx, newTestReceipt())
+		if err != nil {
+			t.Fatalf("Send: %v", err)
+		}
+		if result.ExternalID != stubUUID || result.Status != receipt_entity.ReceiptStatusSent {
+			t.Errorf("unexpected result %+v", result)
+		}
+	})
+
+	t.Run("validation error", func(t *testing.T) {
+		receipt := newTestReceipt()
+		receipt.Type = receipt_entity.ReceiptTypeSellRefund
+		if _, err := c.Send(ctx, receipt); err == nil {
+			t.Error("expected error for rejected document")
+		}
+	})
+
+	t.Run("report done", func(t *testing.T) {
+		receipt := newTestReceipt()
+		receipt.ExternalID = stubUUID
+		result, err := c.GetStatus(ctx, receipt)
+		if err != nil {
+			t.Fatalf("GetStatus: %v", err)
+		}
+		if result.Status != receipt_entity.ReceiptStatusDone || result.Fiscal == nil ||
+			result.Fiscal.FiscalDocumentNumber != 345 || result.Fiscal.FNNumber != "9999" {
+			t.Errorf("unexpected result %+v", result)
+		}
+	})
+
+	t.Run("report fail", func(t *testing.T) {
+		receipt := newTestReceipt()
+		receipt.ExternalID = "doc-2"
+		result, err := c.GetStatus(ctx, receipt)
+		if err != nil {
+			t.Fatalf("GetStatus: %v", err)
+		}
+		if result.Status != receipt_entity.ReceiptStatusFailed || result.Error == "" {
+			t.Errorf("unexpected result %+v", result)
+		}
+	})
+
+	t.Run("wrong credentials", func(t *testing.T) {
+		other := newTestClient(server.URL)
+		other.options.Login = "other"
+		if _, err := other.Send(ctx, newTestReceipt()); err == nil {
+			t.Error("expected error for wrong credentials")
+		}
+	})
+}
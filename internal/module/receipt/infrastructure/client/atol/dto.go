@@ -0,0 +1,84 @@
+package atol_client
+
+type tokenRequest struct {
+	Login string `json:"login"`
+	Pass  string `json:"pass"`
+}
+
+type tokenResponse struct {
+	Token string    `json:"token"`
+	Error *apiError `json:"error"`
+}
+
+type sellRequest struct {
+	ExternalID string  `json:"external_id"`
+	Receipt    receipt `json:"receipt"`
+	Timestamp  string  `json:"timestamp"`
+}
+
+type receipt struct {
+	Client   client    `json:"client"`
+	Company  company   `json:"company"`
+	Items    []item    `json:"items"`
+	Payments []payment `json:"payments"`
+	Total    float64   `json:"total"`
+}
+
+type client struct {
+	Name  string `json:"name,omitempty"`
+	Email string `json:"email,omitempty"`
+	Phone string `json:"phone,omitempty"`
+}
+
+type company struct {
+	Email          string `json:"email"`
+	SNO            string `json:"sno"`
+	INN            string `json:"inn"`
+	PaymentAddress string `json:"payment_address"`
+}
+
+type item struct {
+	Name            string  `json:"name"`
+	Price           float64 `json:"price"`
+	Quantity        float64 `json:"quantity"`
+	Sum             float64 `json:"sum"`
+	MeasurementUnit string  `json:"measurement_unit"`
+	PaymentMethod   string  `json:"payment_method"`
+	PaymentObject   string  `json:"payment_object"`
+	VAT             vat     `json:"vat"`
+}
+
+type vat struct {
+	Type string `json:"type"`
+}
+
+type payment struct {
+	Type int     `json:"type"`
+	Sum  float64 `json:"sum"`
+}
+
+// documentResponse - ответ на регистрацию документа и на запрос его результата
+type documentResponse struct {
+	UUID    string         `json:"uuid"`
+	Status  string         `json:"status"`
+	Error   *apiError      `json:"error"`
+	Payload *fiscalPayload `json:"payload"`
+}
+
+type fiscalPayload struct {
+	Total                   float64 `json:"total"`
+	FNSSite                 string  `json:"fns_site"`
+	FNNumber                string  `json:"fn_number"`
+	ShiftNumber             int64   `json:"shift_number"`
+	ReceiptDatetime         string  `json:"receipt_datetime"`
+	FiscalReceiptNumber     int64   `json:"fiscal_receipt_number"`
+	FiscalDocumentNumber    int64   `json:"fiscal_document_number"`
+	ECRRegistrationNumber   string  `json:"ecr_registration_number"`
+	FiscalDocumentAttribute int64   `json:"fiscal_document_attribute"`
+}
+
+type apiError struct {
+	Code int    `json:"code"`
+	Text string `json:"text"`
+	Type string `json:"type"`
+}
@@ -0,0 +1,29 @@
+package receipt_model
+
+import (
+	"time"
+
+	"gorm.io/datatypes"
+)
+
+type Receipt struct {
+	ID             string         `gorm:"primaryKey;type:uuid;default:uuid_generate_v4();"`
+	OrderID        string         `gorm:"type:uuid;not null;index"`
+	PaymentID      string         `gorm:"type:uuid;not null;index"`
+	Type           string         `gorm:"type:varchar(20);not null"`
+	Status         string         `gorm:"type:varchar(20);not null;index:idx_receipt_due,priority:1"`
+	IdempotencyKey string         `gorm:"type:varchar(100);not null;uniqueIndex"`
+	Payload        datatypes.JSON `gorm:"type:jsonb;not null"`
+	Provider       string         `gorm:"type:varchar(50);not null;default:''"`
+	ExternalID     string         `gorm:"type:varchar(100);not null;default:''"`
+	Attempts       int            `gorm:"not null;default:0"`
+	NextAttemptAt  time.Time      `gorm:"not null;default:now();index:idx_receipt_due,priority:2"`
+	LastError      string         `gorm:"type:text;not null;default:''"`
+	Fiscal         datatypes.JSON `gorm:"type:jsonb"`
+	CreatedAt      time.Time      `gorm:"not null;default:now();index"`
+	UpdatedAt      time.Time      `gorm:"not null;default:now()"`
+}
+
+func (Receipt) TableName() string {
+	return "receipt_module.receipts"
+}
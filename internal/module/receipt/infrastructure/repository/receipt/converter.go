@@ -0,0 +1,82 @@
+package receipt_repository
+
+import (
+	"encoding/json"
+
+	receipt_entity "github.com/Fi44er/sdmed/internal/module/receipt/entity"
+	receipt_model "github.com/Fi44er/sdmed/internal/module/receipt/infrastructure/repository/model"
+)
+
+type Converter struct{}
+
+func (c *Converter) ToModel(entity *receipt_entity.Receipt) (*receipt_model.Receipt, error) {
+	payload, err := json.Marshal(entity.Payload)
+	if err != nil {
+		return nil, err
+	}
+
+	var fiscal []byte
+	if entity.Fiscal != nil {
+		if fiscal, err = json.Marshal(entity.Fiscal); err != nil {
+			return nil, err
+		}
+	}
+
+	return &receipt_model.Receipt{
+		ID:             entity.ID,
+		OrderID:        entity.OrderID,
+		PaymentID:      entity.PaymentID,
+		Type:           string(entity.Type),
+		Status:         string(entity.Status),
+		IdempotencyKey: entity.IdempotencyKey,
+		Payload:        payload,
+		Provider:       entity.Provider,
+		ExternalID:     entity.ExternalID,
+		Attempts:       entity.Attempts,
+		NextAttemptAt:  entity.NextAttemptAt,
+		LastError:      entity.LastError,
+		Fiscal:         fiscal,
+	}, nil
+}
+
+func (c *Converter) ToEntity(model *receipt_model.Receipt) (*receipt_entity.Receipt, error) {
+	receipt := &receipt_entity.Receipt{
+		ID:             model.ID,
+		OrderID:        model.OrderID,
+		PaymentID:      model.PaymentID,
+		Type:           receipt_entity.ReceiptType(model.Type),
+		Status:         receipt_entity.ReceiptStatus(model.Status),
+		IdempotencyKey: model.IdempotencyKey,
+		Provider:       model.Provider,
+		ExternalID:     model.ExternalID,
+		Attempts:       model.Attempts,
+		NextAttemptAt:  model.NextAttemptAt,
+		LastError:      model.LastError,
+		CreatedAt:      model.CreatedAt,
+		UpdatedAt:      model.UpdatedAt,
+	}
+
+	if err := json.Unmarshal(model.Payload, &receipt.Payload); err != nil {
+		return nil, err
+	}
+	if len(model.Fiscal) > 0 && string(model.Fiscal) != "null" {
+		receipt.Fiscal = &receipt_entity.FiscalData{}
+		if err := json.Unmarshal(model.Fiscal, receipt.Fiscal); err != nil {
+			return nil, err
+		}
+	}
+
+	return receipt, nil
+}
+
+func (c *Converter) ToEntities(models []receipt_model.Receipt) ([]receipt_entity.Receipt, error) {
+	receipts := make([]receipt_entity.Receipt, len(models))
+	for i := range models {
+		receipt, err := c.ToEntity(&models[i])
+		if err != nil {
+			return nil, err
+		}
+		receipts[i] = *receipt
+	}
+	return receipts, nil
+}
@@ -0,0 +1,201 @@
+package receipt_repository
+
+import (
+	"context"
+	"time"
+
+	receipt_entity "github.com/Fi44er/sdmed/internal/module/receipt/entity"
+	receipt_model "github.com/Fi44er/sdmed/internal/module/receipt/infrastructure/repository/model"
+	"github.com/Fi44er/sdmed/pkg/logger"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type IReceiptRepository interface {
+	Create(ctx context.Context, receipt *receipt_entity.Receipt) (bool, error)
+	GetByID(ctx context.Context, id string) (*receipt_entity.Receipt, error)
+	GetAll(ctx context.Context, filter *receipt_entity.ReceiptFilter) ([]receipt_entity.Receipt, error)
+	Count(ctx context.Context, filter *receipt_entity.ReceiptFilter) (int64, error)
+	ClaimDue(ctx context.Context, limit int, lease time.Duration) ([]receipt_entity.Receipt, error)
+	Update(ctx context.Context, receipt *receipt_entity.Receipt) error
+	Retry(ctx context.Context, id string) (bool, error)
+}
+
+type ReceiptRepository struct {
+	logger    *logger.Logger
+	db        *gorm.DB
+	converter *Converter
+}
+
+func NewReceiptRepository(logger *logger.Logger, db *gorm.DB) IReceiptRepository {
+	return &ReceiptRepository{
+		logger:    logger,
+		db:        db,
+		converter: &Converter{},
+	}
+}
+
+// Create добавляет чек в outbox. Возвращает false, если чек с тем же ключом
+// идемпотентности уже есть - так повторная обработка платежа не создает дубль
+func (r *ReceiptRepository) Create(ctx context.Context, receipt *receipt_entity.Receipt) (bool, error) {
+	r.logger.Infof("Creating %s receipt for payment %s", receipt.Type, receipt.PaymentID)
+
+	receiptModel, err := r.converter.ToModel(receipt)
+	if err != nil {
+		r.logger.Errorf("Failed to convert receipt for payment %s: %v", receipt.PaymentID, err)
+		return false, err
+	}
+
+	result := r.db.WithContext(ctx).
+		Clauses(clause.OnConflict{
+			Columns:   []clause.Column{{Name: "idempotency_key"}},
+			DoNothing: true,
+		}).
+		Create(receiptModel)
+	if result.Error != nil {
+		r.logger.Errorf("Failed to create receipt for payment %s: %v", receipt.PaymentID, result.Error)
+		return false, result.Error
+	}
+	if result.RowsAffected == 0 {
+		return false, nil
+	}
+
+	receipt.ID = receiptModel.ID
+	receipt.NextAttemptAt = receiptModel.NextAttemptAt
+	receipt.CreatedAt = receiptModel.CreatedAt
+	receipt.UpdatedAt = receiptModel.UpdatedAt
+
+	return true, nil
+}
+
+func (r *ReceiptRepository) GetByID(ctx context.Context, id string) (*receipt_entity.Receipt, error) {
+	var receiptModel receipt_model.Receipt
+	if err := r.db.WithContext(ctx).First(&receiptModel, "id = ?", id).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, nil
+		}
+		r.logger.Errorf("Failed to get receipt %s: %v", id, err)
+		return nil, err
+	}
+
+	return r.converter.ToEntity(&receiptModel)
+}
+
+func (r *ReceiptRepository) GetAll(ctx context.Context, filter *receipt_entity.ReceiptFilter) ([]receipt_entity.Receipt, error) {
+	var receiptModels []receipt_model.Receipt
+	err := r.applyFilter(r.db.WithContext(ctx).Model(&receipt_model.Receipt{}), filter).
+		Order("created_at DESC").
+		Offset(filter.Offset).
+		Limit(filter.Limit).
+		Find(&receiptModels).Error
+	if err != nil {
+		r.logger.Errorf("Failed to get receipts: %v", err)
+		return nil, err
+	}
+
+	return r.converter.ToEntities(receiptModels)
+}
+
+func (r *ReceiptRepository) Count(ctx context.Context, filter *receipt_entity.ReceiptFilter) (int64, error) {
+	var count int64
+	query := r.applyFilter(r.db.WithContext(ctx).Model(&receipt_model.Receipt{}), filter)
+	if err := query.Count(&count).Error; err != nil {
+		r.logger.Errorf("Failed to count receipts: %v", err)
+		return 0, err
+	}
+
+	return count, nil
+}
+
+// ClaimDue забирает в обработку до limit чеков, срок попытки которых наступил, и откладывает
+// их на lease. Строки, заблокированные другим экземпляром, пропускаются
+func (r *ReceiptRepository) ClaimDue(ctx context.Context, limit int, lease time.Duration) ([]receipt_entity.Receipt, error) {
+	var receiptModels []receipt_model.Receipt
+	err := r.db.WithContext(ctx).Raw(`
+		UPDATE receipt_module.receipts
+		SET next_attempt_at = now() + make_interval(secs => ?), updated_at = now()
+		WHERE id IN (
+			SELECT id FROM receipt_module.receipts
+			WHERE status IN ? AND next_attempt_at <= now()
+			ORDER BY next_attempt_at
+			LIMIT ?
+			FOR UPDATE SKIP LOCKED
+		)
+		RETURNING *`,
+		lease.Seconds(),
+		[]string{string(receipt_entity.ReceiptStatusPending), string(receipt_entity.ReceiptStatusSent)},
+		limit,
+	).Scan(&receiptModels).Error
+	if err != nil {
+		r.logger.Errorf("Failed to claim due receipts: %v", err)
+		return nil, err
+	}
+
+	return r.converter.ToEntities(receiptModels)
+}
+
+// Update сохраняет результат попытки отправки
+func (r *ReceiptRepository) Update(ctx context.Context, receipt *receipt_entity.Receipt) error {
+	receiptModel, err := r.converter.ToModel(receipt)
+	if err != nil {
+		r.logger.Errorf("Failed to convert receipt %s: %v", receipt.ID, err)
+		return err
+	}
+
+	var fiscal any
+	if receiptModel.Fiscal != nil {
+		fiscal = receiptModel.Fiscal
+	}
+
+	err = r.db.WithContext(ctx).
+		Model(&receipt_model.Receipt{}).
+		Where("id = ?", receipt.ID).
+		Updates(map[string]any{
+			"status":          receiptModel.Status,
+			"provider":        receiptModel.Provider,
+			"external_id":     receiptModel.ExternalID,
+			"attempts":        receiptModel.Attempts,
+			"next_attempt_at": receiptModel.NextAttemptAt,
+			"last_error":      receiptModel.LastError,
+			"fiscal":          fiscal,
+			"updated_at":      gorm.Expr("now()"),
+		}).Error
+	if err != nil {
+		r.logger.Errorf("Failed to update receipt %s: %v", receipt.ID, err)
+		return err
+	}
+
+	return nil
+}
+
+// Retry возвращает неотправленный чек в очередь со сброшенным счетчиком попыток
+func (r *ReceiptRepository) Retry(ctx context.Context, id string) (bool, error) {
+	r.logger.Infof("Retrying receipt %s", id)
+
+	result := r.db.WithContext(ctx).
+		Model(&receipt_model.Receipt{}).
+		Where("id = ? AND status = ?", id, string(receipt_entity.ReceiptStatusFailed)).
+		Updates(map[string]any{
+			"status":          string(receipt_entity.ReceiptStatusPending),
+			"external_id":     "",
+			"attempts":        0,
+			"next_attempt_at": gorm.Expr("now()"),
+			"updated_at":      gorm.Expr("now()"),
+		})
+	if result.Error != nil {
+		r.logger.Errorf("Failed to retry receipt %s: %v", id, result.Error)
+		return false, result.Error
+	}
+
+	return result.RowsAffected > 0, nil
+}
+
+func (r *ReceiptRepository) applyFilter(query *gorm.DB, filter *receipt_entity.ReceiptFilter) *gorm.DB {
+	if filter.OrderID != "" {
+		query = query.Where("order_id = ?", filter.OrderID)
+	}
+	if filter.Status != "" {
+		query = query.Where("status = ?", string(filter.Status))
+	}
+	return query
+}
@@ -0,0 +1,116 @@
+package receipt_module
+
+import (
+	"github.com/Fi44er/sdmed/internal/config"
+	order_usecase "github.com/Fi44er/sdmed/internal/module/order/usecase/order"
+	receipt_http "github.com/Fi44er/sdmed/internal/module/receipt/delivery/http"
+	receipt_entity "github.com/Fi44er/sdmed/internal/module/receipt/entity"
+	receipt_adapters "github.com/Fi44er/sdmed/internal/module/receipt/infrastructure/adapters"
+	atol_client "github.com/Fi44er/sdmed/internal/module/receipt/infrastructure/client/atol"
+	receipt_repository "github.com/Fi44er/sdmed/internal/module/receipt/infrastructure/repository/receipt"
+	receipt_constant "github.com/Fi44er/sdmed/internal/module/receipt/pkg"
+	receipt_usecase "github.com/Fi44er/sdmed/internal/module/receipt/usecase/receipt"
+	receipt_usecase_contracts "github.com/Fi44er/sdmed/internal/module/receipt/usecase/receipt/contracts"
+	"github.com/Fi44er/sdmed/pkg/logger"
+	"github.com/Fi44er/sdmed/pkg/postgres/uow"
+	"github.com/go-playground/validator/v10"
+	"github.com/gofiber/fiber/v2"
+	"gorm.io/gorm"
+)
+
+type ReceiptModule struct {
+	receiptRepository receipt_repository.IReceiptRepository
+	receiptUsecase    receipt_usecase.IReceiptUsecase
+	receiptHandler    *receipt_http.ReceiptHandler
+	receiptSender     *receipt_usecase.ReceiptSender
+
+	orderUsecase order_usecase.IOrderUsecase
+
+	logger    *logger.Logger
+	validator *validator.Validate
+	db        *gorm.DB
+	uow       uow.Uow
+	config    *config.Config
+}
+
+func NewReceiptModule(
+	logger *logger.Logger,
+	validator *validator.Validate,
+	db *gorm.DB,
+	uow uow.Uow,
+	config *config.Config,
+	orderUsecase order_usecase.IOrderUsecase,
+) *ReceiptModule {
+	return &ReceiptModule{
+		logger:       logger,
+		validator:    validator,
+		db:           db,
+		uow:          uow,
+		config:       config,
+		orderUsecase: orderUsecase,
+	}
+}
+
+func (m *ReceiptModule) Init() {
+	m.uow.RegisterRepository("receipt", func(tx *gorm.DB) (any, error) {
+		return receipt_repository.NewReceiptRepository(m.logger, tx), nil
+	})
+
+	clients := map[string]receipt_usecase_contracts.IFiscalClient{
+		receipt_constant.ProviderATOL: atol_client.NewATOLClient(m.logger, atol_client.Options{
+			URL:       m.config.ATOLURL,
+			Login:     m.config.ATOLLogin,
+			Password:  m.config.ATOLPassword,
+			GroupCode: m.config.ATOLGroupCode,
+		}),
+	}
+
+	m.receiptRepository = receipt_repository.NewReceiptRepository(m.logger, m.db)
+	m.receiptUsecase = receipt_usecase.NewReceiptUsecase(
+		m.receiptRepository,
+		receipt_adapters.NewOrderUsecaseAdapter(m.orderUsecase),
+		clients,
+		m.settings(),
+		m.uow,
+		m.logger,
+	)
+	m.receiptHandler = receipt_http.NewReceiptHandler(m.receiptUsecase, m.validator, m.logger)
+
+	if _, ok := clients[m.config.ReceiptProvider]; ok {
+		m.receiptSender = receipt_usecase.NewReceiptSender(m.receiptUsecase, m.logger, m.config.ReceiptSendInterval)
+	} else {
+		m.logger.Warnf("Fiscal provider %q is not configured, receipts will stay queued", m.config.ReceiptProvider)
+	}
+}
+
+func (m *ReceiptModule) settings() receipt_usecase.Settings {
+	vat := receipt_entity.VAT(m.config.ReceiptVAT)
+	if !vat.IsValid() {
+		m.logger.Warnf("Unknown receipt VAT %q, using %s", m.config.ReceiptVAT, receipt_entity.VAT20)
+		vat = receipt_entity.VAT20
+	}
+
+	return receipt_usecase.Settings{
+		Company: receipt_entity.Company{
+			INN:            m.config.SellerINN,
+			Email:          m.config.ReceiptCompanyEmail,
+			SNO:            m.config.ReceiptSNO,
+			PaymentAddress: m.config.ReceiptPaymentAddress,
+		},
+		VAT:      vat,
+		Provider: m.config.ReceiptProvider,
+	}
+}
+
+func (m *ReceiptModule) InitDelivery(router fiber.Router) {
+	m.receiptHandler.RegisterRoutes(router)
+}
+
+func (m *ReceiptModule) GetReceiptUsecase() receipt_usecase.IReceiptUsecase {
+	return m.receiptUsecase
+}
+
+// GetReceiptSender возвращает nil, если оператор фискальных данных не настроен
+func (m *ReceiptModule) GetReceiptSender() *receipt_usecase.ReceiptSender {
+	return m.receiptSender
+}
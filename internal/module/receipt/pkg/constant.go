@@ -0,0 +1,34 @@
+package receipt_constant
+
+import (
+	"time"
+
+	"github.com/Fi44er/sdmed/pkg/customerr"
+)
+
+const (
+	ProcessName = "receipt_sender"
+
+	ProviderATOL = "atol"
+
+	DefaultSendInterval = 30 * time.Second
+	// BatchSize - сколько чеков отправитель забирает за один проход
+	BatchSize = 20
+	// MaxAttempts - после стольких неудачных попыток чек переходит в failed
+	MaxAttempts = 10
+	// RetryBaseDelay и RetryMaxDelay - границы экспоненциальной задержки между попытками
+	RetryBaseDelay = time.Minute
+	RetryMaxDelay  = 6 * time.Hour
+	// StatusPollDelay - через сколько запрашивать результат фискализации отправленного чека
+	StatusPollDelay = 30 * time.Second
+	// ClaimLease - на сколько откладывается чек, взятый в обработку, чтобы его
+	// не забрал другой экземпляр приложения
+	ClaimLease = 5 * time.Minute
+)
+
+var (
+	ErrReceiptNotFound     = customerr.NewError(404, "receipt not found")
+	ErrReceiptNotRetryable = customerr.NewError(409, "only failed receipts can be retried")
+	ErrInvalidStatus       = customerr.NewError(400, "invalid receipt status")
+	ErrEmptyReceipt        = customerr.NewError(400, "receipt has no items")
+)
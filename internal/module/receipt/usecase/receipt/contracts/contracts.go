@@ -0,0 +1,30 @@
+package receipt_usecase_contracts
+
+import (
+	"context"
+	"time"
+
+	receipt_entity "github.com/Fi44er/sdmed/internal/module/receipt/entity"
+)
+
+type IReceiptRepository interface {
+	Create(ctx context.Context, receipt *receipt_entity.Receipt) (bool, error)
+	GetByID(ctx context.Context, id string) (*receipt_entity.Receipt, error)
+	GetAll(ctx context.Context, filter *receipt_entity.ReceiptFilter) ([]receipt_entity.Receipt, error)
+	Count(ctx context.Context, filter *receipt_entity.ReceiptFilter) (int64, error)
+	ClaimDue(ctx context.Context, limit int, lease time.Duration) ([]receipt_entity.Receipt, error)
+	Update(ctx context.Context, receipt *receipt_entity.Receipt) error
+	Retry(ctx context.Context, id string) (bool, error)
+}
+
+type IOrderUsecaseAdapter interface {
+	GetByID(ctx context.Context, id string) (*receipt_entity.ReceiptOrder, error)
+}
+
+// IFiscalClient - клиент оператора фискальных данных
+type IFiscalClient interface {
+	// Send передает чек на фискализацию
+	Send(ctx context.Context, receipt *receipt_entity.Receipt) (*receipt_entity.FiscalResult, error)
+	// GetStatus запрашивает результат фискализации отправленного чека
+	GetStatus(ctx context.Context, receipt *receipt_entity.Receipt) (*receipt_entity.FiscalResult, error)
+}
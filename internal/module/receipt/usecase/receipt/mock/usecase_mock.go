@@ -0,0 +1,232 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./receipt/contracts/contracts.go
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	receipt_entity "github.com/Fi44er/sdmed/internal/module/receipt/entity"
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockIReceiptRepository is a mock of IReceiptRepository interface.
+type MockIReceiptRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockIReceiptRepositoryMockRecorder
+}
+
+// MockIReceiptRepositoryMockRecorder is the mock recorder for MockIReceiptRepository.
+type MockIReceiptRepositoryMockRecorder struct {
+	mock *MockIReceiptRepository
+}
+
+// NewMockIReceiptRepository creates a new mock instance.
+func NewMockIReceiptRepository(ctrl *gomock.Controller) *MockIReceiptRepository {
+	mock := &MockIReceiptRepository{ctrl: ctrl}
+	mock.recorder = &MockIReceiptRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockIReceiptRepository) EXPECT() *MockIReceiptRepositoryMockRecorder {
+	return m.recorder
+}
+
+// ClaimDue mocks base method.
+func (m *MockIReceiptRepository) ClaimDue(ctx context.Context, limit int, lease time.Duration) ([]receipt_entity.Receipt, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ClaimDue", ctx, limit, lease)
+	ret0, _ := ret[0].([]receipt_entity.Receipt)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ClaimDue indicates an expected call of ClaimDue.
+func (mr *MockIReceiptRepositoryMockRecorder) ClaimDue(ctx, limit, lease interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ClaimDue", reflect.TypeOf((*MockIReceiptRepository)(nil).ClaimDue), ctx, limit, lease)
+}
+
+// Count mocks base method.
+func (m *MockIReceiptRepository) Count(ctx context.Context, filter *receipt_entity.ReceiptFilter) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Count", ctx, filter)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Count indicates an expected call of Count.
+func (mr *MockIReceiptRepositoryMockRecorder) Count(ctx, filter interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Count", reflect.TypeOf((*MockIReceiptRepository)(nil).Count), ctx, filter)
+}
+
+// Create mocks base method.
+func (m *MockIReceiptRepository) Create(ctx context.Context, receipt *receipt_entity.Receipt) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, receipt)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockIReceiptRepositoryMockRecorder) Create(ctx, receipt interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockIReceiptRepository)(nil).Create), ctx, receipt)
+}
+
+// GetAll mocks base method.
+func (m *MockIReceiptRepository) GetAll(ctx context.Context, filter *receipt_entity.ReceiptFilter) ([]receipt_entity.Receipt, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAll", ctx, filter)
+	ret0, _ := ret[0].([]receipt_entity.Receipt)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAll indicates an expected call of GetAll.
+func (mr *MockIReceiptRepositoryMockRecorder) GetAll(ctx, filter interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAll", reflect.TypeOf((*MockIReceiptRepository)(nil).GetAll), ctx, filter)
+}
+
+// GetByID mocks base method.
+func (m *MockIReceiptRepository) GetByID(ctx context.Context, id string) (*receipt_entity.Receipt, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByID", ctx, id)
+	ret0, _ := ret[0].(*receipt_entity.Receipt)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByID indicates an expected call of GetByID.
+func (mr *MockIReceiptRepositoryMockRecorder) GetByID(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByID", reflect.TypeOf((*MockIReceiptRepository)(nil).GetByID), ctx, id)
+}
+
+// Retry mocks base method.
+func (m *MockIReceiptRepository) Retry(ctx context.Context, id string) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Retry", ctx, id)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Retry indicates an expected call of Retry.
+func (mr *MockIReceiptRepositoryMockRecorder) Retry(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Retry", reflect.TypeOf((*MockIReceiptRepository)(nil).Retry), ctx, id)
+}
+
+// Update mocks base method.
+func (m *MockIReceiptRepository) Update(ctx context.Context, receipt *receipt_entity.Receipt) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", ctx, receipt)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockIReceiptRepositoryMockRecorder) Update(ctx, receipt interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockIReceiptRepository)(nil).Update), ctx, receipt)
+}
+
+// MockIOrderUsecaseAdapter is a mock of IOrderUsecaseAdapter interface.
+type MockIOrderUsecaseAdapter struct {
+	ctrl     *gomock.Controller
+	recorder *MockIOrderUsecaseAdapterMockRecorder
+}
+
+// MockIOrderUsecaseAdapterMockRecorder is the mock recorder for MockIOrderUsecaseAdapter.
+type MockIOrderUsecaseAdapterMockRecorder struct {
+	mock *MockIOrderUsecaseAdapter
+}
+
+// NewMockIOrderUsecaseAdapter creates a new mock instance.
+func NewMockIOrderUsecaseAdapter(ctrl *gomock.Controller) *MockIOrderUsecaseAdapter {
+	mock := &MockIOrderUsecaseAdapter{ctrl: ctrl}
+	mock.recorder = &MockIOrderUsecaseAdapterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockIOrderUsecaseAdapter) EXPECT() *MockIOrderUsecaseAdapterMockRecorder {
+	return m.recorder
+}
+
+// GetByID mocks base method.
+func (m *MockIOrderUsecaseAdapter) GetByID(ctx context.Context, id string) (*receipt_entity.ReceiptOrder, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByID", ctx, id)
+	ret0, _ := ret[0].(*receipt_entity.ReceiptOrder)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByID indicates an expected call of GetByID.
+func (mr *MockIOrderUsecaseAdapterMockRecorder) GetByID(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByID", reflect.TypeOf((*MockIOrderUsecaseAdapter)(nil).GetByID), ctx, id)
+}
+
+// MockIFiscalClient is a mock of IFiscalClient interface.
+type MockIFiscalClient struct {
+	ctrl     *gomock.Controller
+	recorder *MockIFiscalClientMockRecorder
+}
+
+// MockIFiscalClientMockRecorder is the mock recorder for MockIFiscalClient.
+type MockIFiscalClientMockRecorder struct {
+	mock *MockIFiscalClient
+}
+
+// NewMockIFiscalClient creates a new mock instance.
+func NewMockIFiscalClient(ctrl *gomock.Controller) *MockIFiscalClient {
+	mock := &MockIFiscalClient{ctrl: ctrl}
+	mock.recorder = &MockIFiscalClientMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockIFiscalClient) EXPECT() *MockIFiscalClientMockRecorder {
+	return m.recorder
+}
+
+// GetStatus mocks base method.
+func (m *MockIFiscalClient) GetStatus(ctx context.Context, receipt *receipt_entity.Receipt) (*receipt_entity.FiscalResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetStatus", ctx, receipt)
+	ret0, _ := ret[0].(*receipt_entity.FiscalResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetStatus indicates an expected call of GetStatus.
+func (mr *MockIFiscalClientMockRecorder) GetStatus(ctx, receipt interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetStatus", reflect.TypeOf((*MockIFiscalClient)(nil).GetStatus), ctx, receipt)
+}
+
+// Send mocks base method.
+func (m *MockIFiscalClient) Send(ctx context.Context, receipt *receipt_entity.Receipt) (*receipt_entity.FiscalResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Send", ctx, receipt)
+	ret0, _ := ret[0].(*receipt_entity.FiscalResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Send indicates an expected call of Send.
+func (mr *MockIFiscalClientMockRecorder) Send(ctx, receipt interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Send", reflect.TypeOf((*MockIFiscalClient)(nil).Send), ctx, receipt)
+}
@@ -0,0 +1,113 @@
+package receipt_usecase
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	receipt_constant "github.com/Fi44er/sdmed/internal/module/receipt/pkg"
+	"github.com/Fi44er/sdmed/pkg/logger"
+)
+
+// ReceiptSender периодически разбирает outbox чеков: отправляет новые оператору
+// фискальных данных и опрашивает статус отправленных
+type ReceiptSender struct {
+	usecase  IReceiptUsecase
+	logger   *logger.Logger
+	interval time.Duration
+	stopCh   chan struct{}
+	running  bool
+	mutex    sync.RWMutex
+}
+
+func (rs *ReceiptSender) Name() string {
+	return receipt_constant.ProcessName
+}
+
+func NewReceiptSender(usecase IReceiptUsecase, logger *logger.Logger, interval time.Duration) *ReceiptSender {
+	if interval <= 0 {
+		interval = receipt_constant.DefaultSendInterval
+	}
+
+	return &ReceiptSender{
+		usecase:  usecase,
+		logger:   logger,
+		interval: interval,
+		stopCh:   make(chan struct{}),
+	}
+}
+
+func (rs *ReceiptSender) Start() {
+	rs.mutex.Lock()
+	defer rs.mutex.Unlock()
+
+	if rs.running {
+		rs.logger.Warn("Receipt sender is already running")
+		return
+	}
+
+	rs.stopCh = make(chan struct{})
+	rs.running = true
+
+	ticker := time.NewTicker(rs.interval)
+
+	go func() {
+		rs.logger.Infof("Receipt sender started with interval: %v", rs.interval)
+
+		rs.sendDue()
+		for {
+			select {
+			case <-ticker.C:
+				rs.sendDue()
+			case <-rs.stopCh:
+				ticker.Stop()
+				rs.mutex.Lock()
+				rs.running = false
+				rs.mutex.Unlock()
+				rs.logger.Info("Receipt sender stopped")
+				return
+			}
+		}
+	}()
+}
+
+func (rs *ReceiptSender) Stop(ctx context.Context) error {
+	rs.mutex.Lock()
+	defer rs.mutex.Unlock()
+
+	if !rs.running {
+		return nil
+	}
+
+	close(rs.stopCh)
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-time.After(100 * time.Millisecond):
+		return nil
+	}
+}
+
+func (rs *ReceiptSender) IsRunning() bool {
+	rs.mutex.RLock()
+	defer rs.mutex.RUnlock()
+	return rs.running
+}
+
+// sendDue обрабатывает очередь пачками, пока в ней есть чеки с наступившим сроком
+func (rs *ReceiptSender) sendDue() {
+	for {
+		processed, err := rs.usecase.ProcessDue(context.Background())
+		if err != nil {
+			rs.logger.Errorf("Failed to process receipts: %v", err)
+			return
+		}
+		if processed > 0 {
+			rs.logger.Infof("Processed %d receipts", processed)
+		}
+		if processed < receipt_constant.BatchSize {
+			return
+		}
+	}
+}
@@ -0,0 +1,116 @@
+package receipt_testcases
+
+import (
+	"context"
+	"errors"
+
+	receipt_entity "github.com/Fi44er/sdmed/internal/module/receipt/entity"
+	receipt_constant "github.com/Fi44er/sdmed/internal/module/receipt/pkg"
+	"github.com/Fi44er/sdmed/internal/module/receipt/usecase/receipt/mock"
+	uow_mock "github.com/Fi44er/sdmed/pkg/postgres/uow/mock"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+type MockEnqueue struct {
+	Ctrl      *gomock.Controller
+	Ctx       context.Context
+	RepoMock  *mock.MockIReceiptRepository
+	OrderMock *mock.MockIOrderUsecaseAdapter
+	UowMock   *uow_mock.MockUow
+	T         assert.TestingT
+}
+
+type EnqueueTestCase struct {
+	Name          string
+	Request       *receipt_entity.ReceiptRequest
+	SetupMocks    func(m *MockEnqueue)
+	ExpectedError error
+}
+
+const (
+	OrderID   = "order-1"
+	PaymentID = "payment-1"
+	ReceiptID = "0b6c1f4e-3d2a-4c58-9b7e-5a1d2c3e4f60"
+	INN       = "7700000000"
+)
+
+var errOrder = errors.New("order not found")
+
+var order = &receipt_entity.ReceiptOrder{
+	ID:      OrderID,
+	Contact: receipt_entity.Client{Email: "user@example.com"},
+	Items:   []receipt_entity.OrderLine{{Name: "Трость", Price: 750.25, Quantity: 2}},
+	Total:   1500.5,
+}
+
+func expectTx(ctx context.Context, uowMock *uow_mock.MockUow, repo any) {
+	uowMock.EXPECT().
+		Do(ctx, gomock.Any()).
+		DoAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+			return fn(ctx)
+		})
+	uowMock.EXPECT().GetRepository(ctx, "receipt").Return(repo, nil)
+}
+
+func GetEnqueueTestCases() []EnqueueTestCase {
+	return []EnqueueTestCase{
+		{
+			Name:    "sell_receipt_enqueued",
+			Request: &receipt_entity.ReceiptRequest{OrderID: OrderID, PaymentID: PaymentID, Type: receipt_entity.ReceiptTypeSell, Amount: 1500.5},
+			SetupMocks: func(m *MockEnqueue) {
+				m.OrderMock.EXPECT().GetByID(m.Ctx, OrderID).Return(order, nil)
+				expectTx(m.Ctx, m.UowMock, m.RepoMock)
+				m.RepoMock.EXPECT().
+					Create(m.Ctx, gomock.Any()).
+					DoAndReturn(func(ctx context.Context, receipt *receipt_entity.Receipt) (bool, error) {
+						assert.Equal(m.T, "sell:"+PaymentID, receipt.IdempotencyKey)
+						assert.Equal(m.T, receipt_entity.ReceiptStatusPending, receipt.Status)
+						assert.Equal(m.T, 1500.5, receipt.Payload.Total)
+						assert.Equal(m.T, INN, receipt.Payload.Company.INN)
+						return true, nil
+					})
+			},
+		},
+		{
+			Name:    "refund_receipt_uses_given_key",
+			Request: &receipt_entity.ReceiptRequest{OrderID: OrderID, PaymentID: PaymentID, Type: receipt_entity.ReceiptTypeSellRefund, Amount: 500, Key: "sell_refund:payment-1:1"},
+			SetupMocks: func(m *MockEnqueue) {
+				m.OrderMock.EXPECT().GetByID(m.Ctx, OrderID).Return(order, nil)
+				expectTx(m.Ctx, m.UowMock, m.RepoMock)
+				m.RepoMock.EXPECT().
+					Create(m.Ctx, gomock.Any()).
+					DoAndReturn(func(ctx context.Context, receipt *receipt_entity.Receipt) (bool, error) {
+						assert.Equal(m.T, "sell_refund:payment-1:1", receipt.IdempotencyKey)
+						assert.Equal(m.T, 500.0, receipt.Payload.Total)
+						return true, nil
+					})
+			},
+		},
+		{
+			Name:    "repeated_enqueue_ignored",
+			Request: &receipt_entity.ReceiptRequest{OrderID: OrderID, PaymentID: PaymentID, Type: receipt_entity.ReceiptTypeSell, Amount: 1500.5},
+			SetupMocks: func(m *MockEnqueue) {
+				m.OrderMock.EXPECT().GetByID(m.Ctx, OrderID).Return(order, nil)
+				expectTx(m.Ctx, m.UowMock, m.RepoMock)
+				m.RepoMock.EXPECT().Create(m.Ctx, gomock.Any()).Return(false, nil)
+			},
+		},
+		{
+			Name:    "order_without_items",
+			Request: &receipt_entity.ReceiptRequest{OrderID: OrderID, PaymentID: PaymentID, Type: receipt_entity.ReceiptTypeSell, Amount: 1500.5},
+			SetupMocks: func(m *MockEnqueue) {
+				m.OrderMock.EXPECT().GetByID(m.Ctx, OrderID).Return(&receipt_entity.ReceiptOrder{ID: OrderID}, nil)
+			},
+			ExpectedError: receipt_constant.ErrEmptyReceipt,
+		},
+		{
+			Name:    "order_error",
+			Request: &receipt_entity.ReceiptRequest{OrderID: OrderID, PaymentID: PaymentID, Type: receipt_entity.ReceiptTypeSell, Amount: 1500.5},
+			SetupMocks: func(m *MockEnqueue) {
+				m.OrderMock.EXPECT().GetByID(m.Ctx, OrderID).Return(nil, errOrder)
+			},
+			ExpectedError: errOrder,
+		},
+	}
+}
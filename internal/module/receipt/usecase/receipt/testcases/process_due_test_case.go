@@ -0,0 +1,193 @@
+package receipt_testcases
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	receipt_entity "github.com/Fi44er/sdmed/internal/module/receipt/entity"
+	receipt_constant "github.com/Fi44er/sdmed/internal/module/receipt/pkg"
+	"github.com/Fi44er/sdmed/internal/module/receipt/usecase/receipt/mock"
+	uow_mock "github.com/Fi44er/sdmed/pkg/postgres/uow/mock"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+type MockProcessDue struct {
+	Ctrl       *gomock.Controller
+	Ctx        context.Context
+	RepoMock   *mock.MockIReceiptRepository
+	OrderMock  *mock.MockIOrderUsecaseAdapter
+	ClientMock *mock.MockIFiscalClient
+	UowMock    *uow_mock.MockUow
+	T          assert.TestingT
+}
+
+type ProcessDueTestCase struct {
+	Name              string
+	Provider          string
+	SetupMocks        func(m *MockProcessDue)
+	ExpectedProcessed int
+	ExpectedError     error
+}
+
+func pendingReceipt() receipt_entity.Receipt {
+	return receipt_entity.Receipt{
+		ID:             ReceiptID,
+		OrderID:        OrderID,
+		PaymentID:      PaymentID,
+		Type:           receipt_entity.ReceiptTypeSell,
+		Status:         receipt_entity.ReceiptStatusPending,
+		IdempotencyKey: "sell:" + PaymentID,
+	}
+}
+
+func sentReceipt() receipt_entity.Receipt {
+	receipt := pendingReceipt()
+	receipt.Status, receipt.Provider, receipt.ExternalID, receipt.Attempts = receipt_entity.ReceiptStatusSent, receipt_constant.ProviderATOL, "doc-1", 1
+	return receipt
+}
+
+func claim(m *MockProcessDue, receipts ...receipt_entity.Receipt) {
+	m.RepoMock.EXPECT().ClaimDue(m.Ctx, receipt_constant.BatchSize, receipt_constant.ClaimLease).Return(receipts, nil)
+}
+
+func GetProcessDueTestCases() []ProcessDueTestCase {
+	return []ProcessDueTestCase{
+		{
+			Name:     "new_receipt_sent",
+			Provider: receipt_constant.ProviderATOL,
+			SetupMocks: func(m *MockProcessDue) {
+				claim(m, pendingReceipt())
+				m.ClientMock.EXPECT().Send(m.Ctx, gomock.Any()).Return(&receipt_entity.FiscalResult{ExternalID: "doc-1", Status: receipt_entity.ReceiptStatusSent}, nil)
+				m.RepoMock.EXPECT().
+					Update(m.Ctx, gomock.Any()).
+					DoAndReturn(func(ctx context.Context, receipt *receipt_entity.Receipt) error {
+						assert.Equal(m.T, receipt_entity.ReceiptStatusSent, receipt.Status)
+						assert.Equal(m.T, "doc-1", receipt.ExternalID)
+						assert.Equal(m.T, receipt_constant.ProviderATOL, receipt.Provider)
+						assert.Equal(m.T, 1, receipt.Attempts)
+						assert.True(m.T, receipt.NextAttemptAt.After(time.Now()))
+						return nil
+					})
+			},
+			ExpectedProcessed: 1,
+		},
+		{
+			Name:     "waiting_document_polled_again",
+			Provider: receipt_constant.ProviderATOL,
+			SetupMocks: func(m *MockProcessDue) {
+				claim(m, sentReceipt())
+				m.ClientMock.EXPECT().GetStatus(m.Ctx, gomock.Any()).Return(&receipt_entity.FiscalResult{ExternalID: "doc-1", Status: receipt_entity.ReceiptStatusSent}, nil)
+				m.RepoMock.EXPECT().
+					Update(m.Ctx, gomock.Any()).
+					DoAndReturn(func(ctx context.Context, receipt *receipt_entity.Receipt) error {
+						assert.Equal(m.T, receipt_entity.ReceiptStatusSent, receipt.Status)
+						assert.Equal(m.T, 1, receipt.Attempts)
+						return nil
+					})
+			},
+			ExpectedProcessed: 1,
+		},
+		{
+			Name:     "sent_document_fiscalized",
+			Provider: receipt_constant.ProviderATOL,
+			SetupMocks: func(m *MockProcessDue) {
+				claim(m, sentReceipt())
+				m.ClientMock.EXPECT().GetStatus(m.Ctx, gomock.Any()).Return(&receipt_entity.FiscalResult{
+					ExternalID: "doc-1",
+					Status:     receipt_entity.ReceiptStatusDone,
+					Fiscal:     &receipt_entity.FiscalData{FiscalDocumentNumber: 345},
+				}, nil)
+				m.RepoMock.EXPECT().
+					Update(m.Ctx, gomock.Any()).
+					DoAndReturn(func(ctx context.Context, receipt *receipt_entity.Receipt) error {
+						assert.Equal(m.T, receipt_entity.ReceiptStatusDone, receipt.Status)
+						if assert.NotNil(m.T, receipt.Fiscal) {
+							assert.Equal(m.T, int64(345), receipt.Fiscal.FiscalDocumentNumber)
+						}
+						return nil
+					})
+			},
+			ExpectedProcessed: 1,
+		},
+		{
+			Name:     "rejected_document_resent_later",
+			Provider: receipt_constant.ProviderATOL,
+			SetupMocks: func(m *MockProcessDue) {
+				claim(m, sentReceipt())
+				m.ClientMock.EXPECT().GetStatus(m.Ctx, gomock.Any()).Return(&receipt_entity.FiscalResult{
+					ExternalID: "doc-1",
+					Status:     receipt_entity.ReceiptStatusFailed,
+					Error:      "fn is closed",
+				}, nil)
+				m.RepoMock.EXPECT().
+					Update(m.Ctx, gomock.Any()).
+					DoAndReturn(func(ctx context.Context, receipt *receipt_entity.Receipt) error {
+						assert.Equal(m.T, receipt_entity.ReceiptStatusPending, receipt.Status)
+						assert.Empty(m.T, receipt.ExternalID)
+						assert.Equal(m.T, "fn is closed", receipt.LastError)
+						assert.True(m.T, receipt.NextAttemptAt.After(time.Now()))
+						return nil
+					})
+			},
+			ExpectedProcessed: 1,
+		},
+		{
+			Name:     "send_error_rescheduled",
+			Provider: receipt_constant.ProviderATOL,
+			SetupMocks: func(m *MockProcessDue) {
+				claim(m, pendingReceipt())
+				m.ClientMock.EXPECT().Send(m.Ctx, gomock.Any()).Return(nil, errors.New("operator is down"))
+				m.RepoMock.EXPECT().
+					Update(m.Ctx, gomock.Any()).
+					DoAndReturn(func(ctx context.Context, receipt *receipt_entity.Receipt) error {
+						assert.Equal(m.T, receipt_entity.ReceiptStatusPending, receipt.Status)
+						assert.Equal(m.T, "operator is down", receipt.LastError)
+						assert.True(m.T, receipt.NextAttemptAt.After(time.Now()))
+						return nil
+					})
+			},
+			ExpectedProcessed: 1,
+		},
+		{
+			Name:     "attempts_exhausted",
+			Provider: receipt_constant.ProviderATOL,
+			SetupMocks: func(m *MockProcessDue) {
+				receipt := pendingReceipt()
+				receipt.Attempts = receipt_constant.MaxAttempts - 1
+				claim(m, receipt)
+				m.ClientMock.EXPECT().Send(m.Ctx, gomock.Any()).Return(nil, errors.New("operator is down"))
+				m.RepoMock.EXPECT().
+					Update(m.Ctx, gomock.Any()).
+					DoAndReturn(func(ctx context.Context, receipt *receipt_entity.Receipt) error {
+						assert.Equal(m.T, receipt_entity.ReceiptStatusFailed, receipt.Status)
+						assert.Equal(m.T, receipt_constant.MaxAttempts, receipt.Attempts)
+						return nil
+					})
+			},
+			ExpectedProcessed: 1,
+		},
+		{
+			Name:     "update_error_does_not_stop_batch",
+			Provider: receipt_constant.ProviderATOL,
+			SetupMocks: func(m *MockProcessDue) {
+				second := pendingReceipt()
+				second.ID = "second"
+				claim(m, pendingReceipt(), second)
+				m.ClientMock.EXPECT().Send(m.Ctx, gomock.Any()).Return(&receipt_entity.FiscalResult{ExternalID: "doc-1", Status: receipt_entity.ReceiptStatusSent}, nil).Times(2)
+				gomock.InOrder(
+					m.RepoMock.EXPECT().Update(m.Ctx, gomock.Any()).Return(errors.New("connection lost")),
+					m.RepoMock.EXPECT().Update(m.Ctx, gomock.Any()).Return(nil),
+				)
+			},
+			ExpectedProcessed: 2,
+		},
+		{
+			Name:              "sending_disabled",
+			Provider:          "",
+			SetupMocks:        func(m *MockProcessDue) {},
+			ExpectedProcessed: 0,
+		},
+	}
+}
@@ -0,0 +1,71 @@
+package receipt_testcases
+
+import (
+	"context"
+
+	receipt_entity "github.com/Fi44er/sdmed/internal/module/receipt/entity"
+	receipt_constant "github.com/Fi44er/sdmed/internal/module/receipt/pkg"
+	"github.com/Fi44er/sdmed/internal/module/receipt/usecase/receipt/mock"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+type MockRetry struct {
+	Ctrl     *gomock.Controller
+	Ctx      context.Context
+	RepoMock *mock.MockIReceiptRepository
+	T        assert.TestingT
+}
+
+type RetryTestCase struct {
+	Name           string
+	ID             string
+	SetupMocks     func(m *MockRetry)
+	ExpectedStatus receipt_entity.ReceiptStatus
+	ExpectedError  error
+}
+
+func GetRetryTestCases() []RetryTestCase {
+	return []RetryTestCase{
+		{
+			Name: "failed_receipt_requeued",
+			ID:   ReceiptID,
+			SetupMocks: func(m *MockRetry) {
+				failed := pendingReceipt()
+				failed.Status, failed.Attempts = receipt_entity.ReceiptStatusFailed, receipt_constant.MaxAttempts
+				requeued := pendingReceipt()
+
+				gomock.InOrder(
+					m.RepoMock.EXPECT().GetByID(m.Ctx, ReceiptID).Return(&failed, nil),
+					m.RepoMock.EXPECT().Retry(m.Ctx, ReceiptID).Return(true, nil),
+					m.RepoMock.EXPECT().GetByID(m.Ctx, ReceiptID).Return(&requeued, nil),
+				)
+			},
+			ExpectedStatus: receipt_entity.ReceiptStatusPending,
+		},
+		{
+			Name: "receipt_not_failed",
+			ID:   ReceiptID,
+			SetupMocks: func(m *MockRetry) {
+				receipt := pendingReceipt()
+				m.RepoMock.EXPECT().GetByID(m.Ctx, ReceiptID).Return(&receipt, nil)
+				m.RepoMock.EXPECT().Retry(m.Ctx, ReceiptID).Return(false, nil)
+			},
+			ExpectedError: receipt_constant.ErrReceiptNotRetryable,
+		},
+		{
+			Name: "receipt_not_found",
+			ID:   ReceiptID,
+			SetupMocks: func(m *MockRetry) {
+				m.RepoMock.EXPECT().GetByID(m.Ctx, ReceiptID).Return(nil, nil)
+			},
+			ExpectedError: receipt_constant.ErrReceiptNotFound,
+		},
+		{
+			Name:          "invalid_id",
+			ID:            "not-a-uuid",
+			SetupMocks:    func(m *MockRetry) {},
+			ExpectedError: receipt_constant.ErrReceiptNotFound,
+		},
+	}
+}
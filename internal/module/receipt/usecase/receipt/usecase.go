@@ -0,0 +1,258 @@
+package receipt_usecase
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	receipt_entity "github.com/Fi44er/sdmed/internal/module/receipt/entity"
+	receipt_constant "github.com/Fi44er/sdmed/internal/module/receipt/pkg"
+	receipt_usecase_contracts "github.com/Fi44er/sdmed/internal/module/receipt/usecase/receipt/contracts"
+	"github.com/Fi44er/sdmed/pkg/logger"
+	"github.com/Fi44er/sdmed/pkg/postgres/uow"
+	"github.com/Fi44er/sdmed/pkg/utils"
+	"github.com/google/uuid"
+)
+
+type IReceiptUsecase interface {
+	Enqueue(ctx context.Context, request *receipt_entity.ReceiptRequest) error
+	ProcessDue(ctx context.Context) (int, error)
+
+	GetByID(ctx context.Context, id string) (*receipt_entity.Receipt, error)
+	GetAll(ctx context.Context, filter *receipt_entity.ReceiptFilter, page, pageSize int) ([]receipt_entity.Receipt, int64, error)
+	Retry(ctx context.Context, id string) (*receipt_entity.Receipt, error)
+}
+
+// Settings - реквизиты продавца для чеков и оператор фискальных данных, через которого
+// они отправляются. Пустой Provider - отправка выключена, чеки копятся в outbox
+type Settings struct {
+	Company  receipt_entity.Company
+	VAT      receipt_entity.VAT
+	Provider string
+}
+
+type ReceiptUsecase struct {
+	repository   receipt_usecase_contracts.IReceiptRepository
+	orderUsecase receipt_usecase_contracts.IOrderUsecaseAdapter
+	clients      map[string]receipt_usecase_contracts.IFiscalClient
+	settings     Settings
+	uow          uow.Uow
+	logger       *logger.Logger
+}
+
+func NewReceiptUsecase(
+	repository receipt_usecase_contracts.IReceiptRepository,
+	orderUsecase receipt_usecase_contracts.IOrderUsecaseAdapter,
+	clients map[string]receipt_usecase_contracts.IFiscalClient,
+	settings Settings,
+	uow uow.Uow,
+	logger *logger.Logger,
+) IReceiptUsecase {
+	return &ReceiptUsecase{
+		repository:   repository,
+		orderUsecase: orderUsecase,
+		clients:      clients,
+		settings:     settings,
+		uow:          uow,
+		logger:       logger,
+	}
+}
+
+// Enqueue формирует чек по платежу и кладет его в outbox. Вызывается в транзакции
+// оплаты или возврата, поэтому чек появляется только вместе с ними. Повторный вызов
+// с тем же ключом ничего не меняет
+func (u *ReceiptUsecase) Enqueue(ctx context.Context, request *receipt_entity.ReceiptRequest) error {
+	order, err := u.orderUsecase.GetByID(ctx, request.OrderID)
+	if err != nil {
+		return err
+	}
+
+	var payload *receipt_entity.Payload
+	switch request.Type {
+	case receipt_entity.ReceiptTypeSell:
+		payload = receipt_entity.NewSellPayload(order, u.settings.Company, u.settings.VAT, request.Amount)
+	case receipt_entity.ReceiptTypeSellRefund:
+		payload = receipt_entity.NewRefundPayload(order, u.settings.Company, u.settings.VAT, request.Amount, request.Items)
+	default:
+		return fmt.Errorf("unknown receipt type %q", request.Type)
+	}
+	if len(payload.Items) == 0 {
+		return receipt_constant.ErrEmptyReceipt
+	}
+
+	key := request.Key
+	if key == "" {
+		key = fmt.Sprintf("%s:%s", request.Type, request.PaymentID)
+	}
+	receipt := &receipt_entity.Receipt{
+		ID:             uuid.NewString(),
+		OrderID:        request.OrderID,
+		PaymentID:      request.PaymentID,
+		Type:           request.Type,
+		Status:         receipt_entity.ReceiptStatusPending,
+		IdempotencyKey: key,
+		Payload:        *payload,
+		NextAttemptAt:  time.Now(),
+	}
+
+	return u.uow.Do(ctx, func(ctx context.Context) error {
+		repo, err := u.getRepository(ctx)
+		if err != nil {
+			return err
+		}
+
+		created, err := repo.Create(ctx, receipt)
+		if err != nil {
+			return err
+		}
+		if !created {
+			u.logger.Infof("Receipt %s is already enqueued", key)
+		}
+		return nil
+	})
+}
+
+// ProcessDue отправляет чеки, срок попытки которых наступил, и опрашивает статус
+// отправленных. Возвращает число обработанных чеков
+func (u *ReceiptUsecase) ProcessDue(ctx context.Context) (int, error) {
+	if _, ok := u.clients[u.settings.Provider]; !ok {
+		return 0, nil
+	}
+
+	receipts, err := u.repository.ClaimDue(ctx, receipt_constant.BatchSize, receipt_constant.ClaimLease)
+	if err != nil {
+		return 0, err
+	}
+
+	for i := range receipts {
+		if err := u.process(ctx, &receipts[i]); err != nil {
+			u.logger.Errorf("Failed to save receipt %s: %v", receipts[i].ID, err)
+		}
+	}
+
+	return len(receipts), nil
+}
+
+func (u *ReceiptUsecase) GetByID(ctx context.Context, id string) (*receipt_entity.Receipt, error) {
+	if _, err := uuid.Parse(id); err != nil {
+		return nil, receipt_constant.ErrReceiptNotFound
+	}
+
+	receipt, err := u.repository.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if receipt == nil {
+		return nil, receipt_constant.ErrReceiptNotFound
+	}
+
+	return receipt, nil
+}
+
+func (u *ReceiptUsecase) GetAll(ctx context.Context, filter *receipt_entity.ReceiptFilter, page, pageSize int) ([]receipt_entity.Receipt, int64, error) {
+	u.logger.Debugf("Getting receipts (page: %d, pageSize: %d)", page, pageSize)
+
+	if filter.Status != "" && !filter.Status.IsValid() {
+		return nil, 0, receipt_constant.ErrInvalidStatus
+	}
+
+	filter.Offset, filter.Limit = utils.SafeCalculateForPostgres(page, pageSize)
+	receipts, err := u.repository.GetAll(ctx, filter)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	count, err := u.repository.Count(ctx, filter)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return receipts, count, nil
+}
+
+// Retry возвращает в очередь чек, для которого исчерпаны попытки отправки
+func (u *ReceiptUsecase) Retry(ctx context.Context, id string) (*receipt_entity.Receipt, error) {
+	receipt, err := u.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	retried, err := u.repository.Retry(ctx, receipt.ID)
+	if err != nil {
+		return nil, err
+	}
+	if !retried {
+		return nil, receipt_constant.ErrReceiptNotRetryable
+	}
+
+	return u.GetByID(ctx, id)
+}
+
+// process выполняет одну попытку: новый чек отправляется оператору, у отправленного
+// запрашивается результат. Ошибка возвращается, только если не удалось сохранить итог
+func (u *ReceiptUsecase) process(ctx context.Context, receipt *receipt_entity.Receipt) error {
+	var (
+		result *receipt_entity.FiscalResult
+		err    error
+	)
+
+	if receipt.Status == receipt_entity.ReceiptStatusSent && receipt.ExternalID != "" {
+		client, ok := u.clients[receipt.Provider]
+		if !ok {
+			u.logger.Warnf("Receipt %s: fiscal provider %q is not registered", receipt.ID, receipt.Provider)
+			return nil
+		}
+		result, err = client.GetStatus(ctx, receipt)
+	} else {
+		receipt.Provider = u.settings.Provider
+		receipt.Attempts++
+		result, err = u.clients[receipt.Provider].Send(ctx, receipt)
+	}
+
+	now := time.Now()
+	switch {
+	case err != nil:
+		u.logger.Warnf("Receipt %s: attempt %d failed: %v", receipt.ID, receipt.Attempts, err)
+		u.reschedule(receipt, err.Error(), now)
+	case result.Status == receipt_entity.ReceiptStatusDone:
+		u.logger.Infof("Receipt %s is fiscalized", receipt.ID)
+		receipt.Status = receipt_entity.ReceiptStatusDone
+		receipt.Fiscal = result.Fiscal
+		receipt.LastError = ""
+		if result.ExternalID != "" {
+			receipt.ExternalID = result.ExternalID
+		}
+	case result.Status == receipt_entity.ReceiptStatusFailed:
+		u.logger.Warnf("Receipt %s is rejected by %s: %s", receipt.ID, receipt.Provider, result.Error)
+		receipt.Status = receipt_entity.ReceiptStatusPending
+		receipt.ExternalID = ""
+		u.reschedule(receipt, result.Error, now)
+	default:
+		receipt.Status = receipt_entity.ReceiptStatusSent
+		receipt.ExternalID = result.ExternalID
+		receipt.NextAttemptAt = now.Add(receipt_constant.StatusPollDelay)
+	}
+
+	return u.repository.Update(ctx, receipt)
+}
+
+// reschedule откладывает следующую попытку с растущей задержкой, а после
+// MaxAttempts неудач переводит чек в failed
+func (u *ReceiptUsecase) reschedule(receipt *receipt_entity.Receipt, reason string, now time.Time) {
+	receipt.LastError = reason
+	if receipt.Attempts >= receipt_constant.MaxAttempts {
+		u.logger.Errorf("Receipt %s: attempts are exhausted, manual retry is required", receipt.ID)
+		receipt.Status = receipt_entity.ReceiptStatusFailed
+		return
+	}
+	receipt.NextAttemptAt = now.Add(receipt_entity.RetryDelay(receipt.Attempts, receipt_constant.RetryBaseDelay, receipt_constant.RetryMaxDelay))
+}
+
+func (u *ReceiptUsecase) getRepository(ctx context.Context) (receipt_usecase_contracts.IReceiptRepository, error) {
+	repo, err := u.uow.GetRepository(ctx, "receipt")
+	if err != nil {
+		u.logger.Errorf("Failed to get repository: %v", err)
+		return nil, err
+	}
+	return repo.(receipt_usecase_contracts.IReceiptRepository), nil
+}
@@ -0,0 +1,137 @@
+package receipt_usecase_test
+
+import (
+	"context"
+	"testing"
+
+	receipt_entity "github.com/Fi44er/sdmed/internal/module/receipt/entity"
+	receipt_constant "github.com/Fi44er/sdmed/internal/module/receipt/pkg"
+	receipt_usecase "github.com/Fi44er/sdmed/internal/module/receipt/usecase/receipt"
+	receipt_usecase_contracts "github.com/Fi44er/sdmed/internal/module/receipt/usecase/receipt/contracts"
+	"github.com/Fi44er/sdmed/internal/module/receipt/usecase/receipt/mock"
+	receipt_testcases "github.com/Fi44er/sdmed/internal/module/receipt/usecase/receipt/testcases"
+	"github.com/Fi44er/sdmed/pkg/logger"
+	uow_mock "github.com/Fi44er/sdmed/pkg/postgres/uow/mock"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+)
+
+type ReceiptUsecaseTestSuite struct {
+	suite.Suite
+	ctx    context.Context
+	logger *logger.Logger
+}
+
+func (s *ReceiptUsecaseTestSuite) SetupSuite() {
+	s.ctx = context.Background()
+	s.logger = logger.NewLogger()
+}
+
+func TestReceiptUsecase(t *testing.T) {
+	suite.Run(t, new(ReceiptUsecaseTestSuite))
+}
+
+func settings(provider string) receipt_usecase.Settings {
+	return receipt_usecase.Settings{
+		Company:  receipt_entity.Company{INN: receipt_testcases.INN, SNO: "osn"},
+		VAT:      receipt_entity.VAT20,
+		Provider: provider,
+	}
+}
+
+func (s *ReceiptUsecaseTestSuite) TestEnqueue() {
+	tests := receipt_testcases.GetEnqueueTestCases()
+
+	for _, tc := range tests {
+		s.T().Run(tc.Name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockStruct := &receipt_testcases.MockEnqueue{
+				Ctrl:      ctrl,
+				Ctx:       s.ctx,
+				RepoMock:  mock.NewMockIReceiptRepository(ctrl),
+				OrderMock: mock.NewMockIOrderUsecaseAdapter(ctrl),
+				UowMock:   uow_mock.NewMockUow(ctrl),
+				T:         t,
+			}
+
+			usecase := receipt_usecase.NewReceiptUsecase(mockStruct.RepoMock, mockStruct.OrderMock, nil, settings(receipt_constant.ProviderATOL), mockStruct.UowMock, s.logger)
+
+			tc.SetupMocks(mockStruct)
+			err := usecase.Enqueue(s.ctx, tc.Request)
+
+			if tc.ExpectedError != nil {
+				assert.ErrorIs(t, err, tc.ExpectedError)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func (s *ReceiptUsecaseTestSuite) TestProcessDue() {
+	tests := receipt_testcases.GetProcessDueTestCases()
+
+	for _, tc := range tests {
+		s.T().Run(tc.Name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockStruct := &receipt_testcases.MockProcessDue{
+				Ctrl:       ctrl,
+				Ctx:        s.ctx,
+				RepoMock:   mock.NewMockIReceiptRepository(ctrl),
+				OrderMock:  mock.NewMockIOrderUsecaseAdapter(ctrl),
+				ClientMock: mock.NewMockIFiscalClient(ctrl),
+				UowMock:    uow_mock.NewMockUow(ctrl),
+				T:          t,
+			}
+
+			clients := map[string]receipt_usecase_contracts.IFiscalClient{receipt_constant.ProviderATOL: mockStruct.ClientMock}
+			usecase := receipt_usecase.NewReceiptUsecase(mockStruct.RepoMock, mockStruct.OrderMock, clients, settings(tc.Provider), mockStruct.UowMock, s.logger)
+
+			tc.SetupMocks(mockStruct)
+			processed, err := usecase.ProcessDue(s.ctx)
+
+			if tc.ExpectedError != nil {
+				assert.ErrorIs(t, err, tc.ExpectedError)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, tc.ExpectedProcessed, processed)
+		})
+	}
+}
+
+func (s *ReceiptUsecaseTestSuite) TestRetry() {
+	tests := receipt_testcases.GetRetryTestCases()
+
+	for _, tc := range tests {
+		s.T().Run(tc.Name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockStruct := &receipt_testcases.MockRetry{
+				Ctrl:     ctrl,
+				Ctx:      s.ctx,
+				RepoMock: mock.NewMockIReceiptRepository(ctrl),
+				T:        t,
+			}
+
+			usecase := receipt_usecase.NewReceiptUsecase(mockStruct.RepoMock, nil, nil, settings(receipt_constant.ProviderATOL), nil, s.logger)
+
+			tc.SetupMocks(mockStruct)
+			receipt, err := usecase.Retry(s.ctx, tc.ID)
+
+			if tc.ExpectedError != nil {
+				assert.ErrorIs(t, err, tc.ExpectedError)
+				assert.Nil(t, receipt)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tc.ExpectedStatus, receipt.Status)
+			}
+		})
+	}
+}
@@ -0,0 +1,32 @@
+package region_http
+
+import (
+	region_dto "github.com/Fi44er/sdmed/internal/module/region/dto"
+	region_entity "github.com/Fi44er/sdmed/internal/module/region/entity"
+)
+
+type Converter struct{}
+
+func (c *Converter) ToRegionResponse(region *region_entity.Region) region_dto.RegionResponse {
+	return region_dto.RegionResponse{
+		ID:    region.ID,
+		Code:  region.Code,
+		Name:  region.Name,
+		OKATO: region.OKATO,
+	}
+}
+
+func (c *Converter) ToRegionResponses(regions []region_entity.Region) []region_dto.RegionResponse {
+	result := make([]region_dto.RegionResponse, len(regions))
+	for i := range regions {
+		result[i] = c.ToRegionResponse(&regions[i])
+	}
+	return result
+}
+
+func (c *Converter) ToCurrentRegionResponse(region *region_entity.CurrentRegion) *region_dto.CurrentRegionResponse {
+	return &region_dto.CurrentRegionResponse{
+		RegionResponse: c.ToRegionResponse(&region.Region),
+		Source:         string(region.Source),
+	}
+}
@@ -0,0 +1,129 @@
+package region_http
+
+import (
+	"context"
+
+	region_dto "github.com/Fi44er/sdmed/internal/module/region/dto"
+	region_entity "github.com/Fi44er/sdmed/internal/module/region/entity"
+	"github.com/Fi44er/sdmed/pkg/logger"
+	_ "github.com/Fi44er/sdmed/pkg/response"
+	"github.com/Fi44er/sdmed/pkg/session"
+	"github.com/go-playground/validator/v10"
+	"github.com/gofiber/fiber/v2"
+)
+
+type IRegionUsecase interface {
+	GetAll(ctx context.Context) ([]region_entity.Region, error)
+	GetCurrent(ctx context.Context) (*region_entity.CurrentRegion, error)
+	SetCurrent(ctx context.Context, regionID string) (*region_entity.Region, error)
+}
+
+type RegionHandler struct {
+	usecase IRegionUsecase
+
+	validator *validator.Validate
+	logger    *logger.Logger
+	converter *Converter
+}
+
+func NewRegionHandler(
+	usecase IRegionUsecase,
+	validator *validator.Validate,
+	logger *logger.Logger,
+) *RegionHandler {
+	return &RegionHandler{
+		usecase:   usecase,
+		validator: validator,
+		logger:    logger,
+		converter: &Converter{},
+	}
+}
+
+// GetAll godoc
+// @Summary Get regions
+// @Description Get the directory of Russian Federation subjects
+// @Tags regions
+// @Produce json
+// @Success 200 {object} response.ResponseData{data=[]region_dto.RegionResponse} "OK"
+// @Failure 500 {object} response.Response "Error"
+// @Router /regions [get]
+func (h *RegionHandler) GetAll(ctx *fiber.Ctx) error {
+	regions, err := h.usecase.GetAll(ctx.Context())
+	if err != nil {
+		return err
+	}
+
+	return ctx.Status(200).JSON(fiber.Map{
+		"status": "success",
+		"data":   h.converter.ToRegionResponses(regions),
+	})
+}
+
+// GetCurrent godoc
+// @Summary Get customer region
+// @Description Get the region used for prices: selected in session, saved for the user, taken from the delivery address or the default one
+// @Tags regions
+// @Produce json
+// @Success 200 {object} response.ResponseData{data=region_dto.CurrentRegionResponse} "OK"
+// @Failure 500 {object} response.Response "Error"
+// @Router /regions/current [get]
+func (h *RegionHandler) GetCurrent(ctx *fiber.Ctx) error {
+	region, err := h.usecase.GetCurrent(h.getCtxWithSession(ctx))
+	if err != nil {
+		return err
+	}
+
+	return ctx.Status(200).JSON(fiber.Map{
+		"status": "success",
+		"data":   h.converter.ToCurrentRegionResponse(region),
+	})
+}
+
+// SetCurrent godoc
+// @Summary Select customer region
+// @Description Save the selected region in the session and for the user
+// @Tags regions
+// @Accept json
+// @Produce json
+// @Param region body region_dto.SetCurrentRegionRequest true "Region"
+// @Success 200 {object} response.ResponseData{data=region_dto.RegionResponse} "OK"
+// @Failure 404 {object} response.Response "Region not found"
+// @Failure 500 {object} response.Response "Error"
+// @Router /regions/current [put]
+func (h *RegionHandler) SetCurrent(ctx *fiber.Ctx) error {
+	dto := new(region_dto.SetCurrentRegionRequest)
+	if err := ctx.BodyParser(dto); err != nil {
+		h.logger.Warnf("error while parsing body: %s", err)
+		return ctx.Status(400).JSON(fiber.Map{
+			"status":  "fail",
+			"message": err.Error(),
+		})
+	}
+
+	if err := h.validator.Struct(dto); err != nil {
+		h.logger.Warnf("error while validating dto: %s", err)
+		return ctx.Status(400).JSON(fiber.Map{
+			"status":  "fail",
+			"message": err.Error(),
+		})
+	}
+
+	region, err := h.usecase.SetCurrent(h.getCtxWithSession(ctx), dto.RegionID)
+	if err != nil {
+		return err
+	}
+
+	return ctx.Status(200).JSON(fiber.Map{
+		"status": "success",
+		"data":   h.converter.ToRegionResponse(region),
+	})
+}
+
+func (h *RegionHandler) getCtxWithSession(ctx *fiber.Ctx) context.Context {
+	sess := session.FromFiberContext(ctx)
+	if sess == nil {
+		return ctx.Context()
+	}
+
+	return context.WithValue(ctx.Context(), "session", *sess)
+}
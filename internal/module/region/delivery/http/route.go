@@ -0,0 +1,10 @@
+package region_http
+
+import "github.com/gofiber/fiber/v2"
+
+func (h *RegionHandler) RegisterRoutes(router fiber.Router) {
+	regions := router.Group("/regions")
+	regions.Get("/", h.GetAll)
+	regions.Get("/current", h.GetCurrent)
+	regions.Put("/current", h.SetCurrent)
+}
@@ -0,0 +1,17 @@
+package region_dto
+
+type RegionResponse struct {
+	ID    string `json:"id"`
+	Code  string `json:"code"`
+	Name  string `json:"name"`
+	OKATO string `json:"okato"`
+}
+
+type CurrentRegionResponse struct {
+	RegionResponse
+	Source string `json:"source"`
+}
+
+type SetCurrentRegionRequest struct {
+	RegionID string `json:"region_id" validate:"required,uuid"`
+}
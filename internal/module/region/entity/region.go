@@ -0,0 +1,24 @@
+package region_entity
+
+// Region - субъект Российской Федерации
+type Region struct {
+	ID    string
+	Code  string // код субъекта (01-99)
+	Name  string
+	OKATO string
+}
+
+type RegionSource string
+
+const (
+	RegionSourceSession RegionSource = "session"
+	RegionSourceUser    RegionSource = "user"
+	RegionSourceAddress RegionSource = "address"
+	RegionSourceDefault RegionSource = "default"
+)
+
+// CurrentRegion - регион покупателя и источник, из которого он определен
+type CurrentRegion struct {
+	Region
+	Source RegionSource
+}
@@ -0,0 +1,38 @@
+package region_adapters
+
+import (
+	"context"
+
+	user_usecase "github.com/Fi44er/sdmed/internal/module/user/usecase/user"
+)
+
+type IUserUsecaseAdapter interface {
+	GetRegionID(ctx context.Context, userID string) (string, error)
+	UpdateRegion(ctx context.Context, userID, regionID string) error
+}
+
+type UserUsecaseAdapter struct {
+	userUsecase *user_usecase.UserUsecase
+}
+
+func NewUserUsecaseAdapter(userUsecase *user_usecase.UserUsecase) IUserUsecaseAdapter {
+	return &UserUsecaseAdapter{
+		userUsecase: userUsecase,
+	}
+}
+
+func (a *UserUsecaseAdapter) GetRegionID(ctx context.Context, userID string) (string, error) {
+	user, err := a.userUsecase.GetByID(ctx, userID)
+	if err != nil {
+		return "", err
+	}
+
+	if user.RegionID == nil {
+		return "", nil
+	}
+	return *user.RegionID, nil
+}
+
+func (a *UserUsecaseAdapter) UpdateRegion(ctx context.Context, userID, regionID string) error {
+	return a.userUsecase.UpdateRegion(ctx, userID, regionID)
+}
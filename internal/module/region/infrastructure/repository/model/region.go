@@ -0,0 +1,8 @@
+package region_model
+
+type Region struct {
+	ID    string `gorm:"primaryKey;type:uuid;default:uuid_generate_v4();"`
+	Code  string `gorm:"type:varchar(3);not null;uniqueIndex"`
+	Name  string `gorm:"type:varchar(255);not null"`
+	OKATO string `gorm:"column:okato;type:varchar(11);not null;uniqueIndex"`
+}
@@ -0,0 +1,92 @@
+package region_model
+
+// DefaultRegions - справочник субъектов РФ: код субъекта, название и код ОКАТО
+func DefaultRegions() []Region {
+	return []Region{
+		{Code: "01", Name: "Республика Адыгея", OKATO: "79000000000"},
+		{Code: "02", Name: "Республика Башкортостан", OKATO: "80000000000"},
+		{Code: "03", Name: "Республика Бурятия", OKATO: "81000000000"},
+		{Code: "04", Name: "Республика Алтай", OKATO: "84000000000"},
+		{Code: "05", Name: "Республика Дагестан", OKATO: "82000000000"},
+		{Code: "06", Name: "Республика Ингушетия", OKATO: "26000000000"},
+		{Code: "07", Name: "Кабардино-Балкарская Республика", OKATO: "83000000000"},
+		{Code: "08", Name: "Республика Калмыкия", OKATO: "85000000000"},
+		{Code: "09", Name: "Карачаево-Черкесская Республика", OKATO: "91000000000"},
+		{Code: "10", Name: "Республика Карелия", OKATO: "86000000000"},
+		{Code: "11", Name: "Республика Коми", OKATO: "87000000000"},
+		{Code: "12", Name: "Республика Марий Эл", OKATO: "88000000000"},
+		{Code: "13", Name: "Республика Мордовия", OKATO: "89000000000"},
+		{Code: "14", Name: "Республика Саха (Якутия)", OKATO: "98000000000"},
+		{Code: "15", Name: "Республика Северная Осетия — Алания", OKATO: "90000000000"},
+		{Code: "16", Name: "Республика Татарстан", OKATO: "92000000000"},
+		{Code: "17", Name: "Республика Тыва", OKATO: "93000000000"},
+		{Code: "18", Name: "Удмуртская Республика", OKATO: "94000000000"},
+		{Code: "19", Name: "Республика Хакасия", OKATO: "95000000000"},
+		{Code: "20", Name: "Чеченская Республика", OKATO: "96000000000"},
+		{Code: "21", Name: "Чувашская Республика", OKATO: "97000000000"},
+		{Code: "22", Name: "Алтайский край", OKATO: "01000000000"},
+		{Code: "23", Name: "Краснодарский край", OKATO: "03000000000"},
+		{Code: "24", Name: "Красноярский край", OKATO: "04000000000"},
+		{Code: "25", Name: "Приморский край", OKATO: "05000000000"},
+		{Code: "26", Name: "Ставропольский край", OKATO: "07000000000"},
+		{Code: "27", Name: "Хабаровский край", OKATO: "08000000000"},
+		{Code: "28", Name: "Амурская область", OKATO: "10000000000"},
+		{Code: "29", Name: "Архангельская область", OKATO: "11000000000"},
+		{Code: "30", Name: "Астраханская область", OKATO: "12000000000"},
+		{Code: "31", Name: "Белгородская область", OKATO: "14000000000"},
+		{Code: "32", Name: "Брянская область", OKATO: "15000000000"},
+		{Code: "33", Name: "Владимирская область", OKATO: "17000000000"},
+		{Code: "34", Name: "Волгоградская область", OKATO: "18000000000"},
+		{Code: "35", Name: "Вологодская область", OKATO: "19000000000"},
+		{Code: "36", Name: "Воронежская область", OKATO: "20000000000"},
+		{Code: "37", Name: "Ивановская область", OKATO: "24000000000"},
+		{Code: "38", Name: "Иркутская область", OKATO: "25000000000"},
+		{Code: "39", Name: "Калининградская область", OKATO: "27000000000"},
+		{Code: "40", Name: "Калужская область", OKATO: "29000000000"},
+		{Code: "41", Name: "Камчатский край", OKATO: "30000000000"},
+		{Code: "42", Name: "Кемеровская область — Кузбасс", OKATO: "32000000000"},
+		{Code: "43", Name: "Кировская область", OKATO: "33000000000"},
+		{Code: "44", Name: "Костромская область", OKATO: "34000000000"},
+		{Code: "45", Name: "Курганская область", OKATO: "37000000000"},
+		{Code: "46", Name: "Курская область", OKATO: "38000000000"},
+		{Code: "47", Name: "Ленинградская область", OKATO: "41000000000"},
+		{Code: "48", Name: "Липецкая область", OKATO: "42000000000"},
+		{Code: "49", Name: "Магаданская область", OKATO: "44000000000"},
+		{Code: "50", Name: "Московская область", OKATO: "46000000000"},
+		{Code: "51", Name: "Мурманская область", OKATO: "47000000000"},
+		{Code: "52", Name: "Нижегородская область", OKATO: "22000000000"},
+		{Code: "53", Name: "Новгородская область", OKATO: "49000000000"},
+		{Code: "54", Name: "Новосибирская область", OKATO: "50000000000"},
+		{Code: "55", Name: "Омская область", OKATO: "52000000000"},
+		{Code: "56", Name: "Оренбургская область", OKATO: "53000000000"},
+		{Code: "57", Name: "Орловская область", OKATO: "54000000000"},
+		{Code: "58", Name: "Пензенская область", OKATO: "56000000000"},
+		{Code: "59", Name: "Пермский край", OKATO: "57000000000"},
+		{Code: "60", Name: "Псковская область", OKATO: "58000000000"},
+		{Code: "61", Name: "Ростовская область", OKATO: "60000000000"},
+		{Code: "62", Name: "Рязанская область", OKATO: "61000000000"},
+		{Code: "63", Name: "Самарская область", OKATO: "36000000000"},
+		{Code: "64", Name: "Саратовская область", OKATO: "63000000000"},
+		{Code: "65", Name: "Сахалинская область", OKATO: "64000000000"},
+		{Code: "66", Name: "Свердловская область", OKATO: "65000000000"},
+		{Code: "67", Name: "Смоленская область", OKATO: "66000000000"},
+		{Code: "68", Name: "Тамбовская область", OKATO: "68000000000"},
+		{Code: "69", Name: "Тверская область", OKATO: "28000000000"},
+		{Code: "70", Name: "Томская область", OKATO: "69000000000"},
+		{Code: "71", Name: "Тульская область", OKATO: "70000000000"},
+		{Code: "72", Name: "Тюменская область", OKATO: "71000000000"},
+		{Code: "73", Name: "Ульяновская область", OKATO: "73000000000"},
+		{Code: "74", Name: "Челябинская область", OKATO: "75000000000"},
+		{Code: "75", Name: "Забайкальский край", OKATO: "76000000000"},
+		{Code: "76", Name: "Ярославская область", OKATO: "78000000000"},
+		{Code: "77", Name: "Москва", OKATO: "45000000000"},
+		{Code: "78", Name: "Санкт-Петербург", OKATO: "40000000000"},
+		{Code: "79", Name: "Еврейская автономная область", OKATO: "99000000000"},
+		{Code: "83", Name: "Ненецкий автономный округ", OKATO: "11100000000"},
+		{Code: "86", Name: "Ханты-Мансийский автономный округ — Югра", OKATO: "71100000000"},
+		{Code: "87", Name: "Чукотский автономный округ", OKATO: "77000000000"},
+		{Code: "89", Name: "Ямало-Ненецкий автономный округ", OKATO: "71140000000"},
+		{Code: "91", Name: "Республика Крым", OKATO: "35000000000"},
+		{Code: "92", Name: "Севастополь", OKATO: "67000000000"},
+	}
+}
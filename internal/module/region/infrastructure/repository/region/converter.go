@@ -0,0 +1,26 @@
+package region_repository
+
+import (
+	region_entity "github.com/Fi44er/sdmed/internal/module/region/entity"
+	region_model "github.com/Fi44er/sdmed/internal/module/region/infrastructure/repository/model"
+)
+
+type Converter struct{}
+
+func (c *Converter) ToModel(entity *region_entity.Region) *region_model.Region {
+	return &region_model.Region{
+		ID:    entity.ID,
+		Code:  entity.Code,
+		Name:  entity.Name,
+		OKATO: entity.OKATO,
+	}
+}
+
+func (c *Converter) ToEntity(model *region_model.Region) *region_entity.Region {
+	return &region_entity.Region{
+		ID:    model.ID,
+		Code:  model.Code,
+		Name:  model.Name,
+		OKATO: model.OKATO,
+	}
+}
@@ -0,0 +1,79 @@
+package region_repository
+
+import (
+	"context"
+
+	region_entity "github.com/Fi44er/sdmed/internal/module/region/entity"
+	region_model "github.com/Fi44er/sdmed/internal/module/region/infrastructure/repository/model"
+	"github.com/Fi44er/sdmed/pkg/logger"
+	"gorm.io/gorm"
+)
+
+type IRegionRepository interface {
+	GetAll(ctx context.Context) ([]region_entity.Region, error)
+	GetByID(ctx context.Context, id string) (*region_entity.Region, error)
+	GetByCode(ctx context.Context, code string) (*region_entity.Region, error)
+}
+
+type RegionRepository struct {
+	logger    *logger.Logger
+	db        *gorm.DB
+	converter *Converter
+}
+
+func NewRegionRepository(logger *logger.Logger, db *gorm.DB) IRegionRepository {
+	return &RegionRepository{
+		logger:    logger,
+		db:        db,
+		converter: &Converter{},
+	}
+}
+
+func (r *RegionRepository) GetAll(ctx context.Context) ([]region_entity.Region, error) {
+	r.logger.Debug("Getting all regions")
+
+	var regionModels []region_model.Region
+	if err := r.db.WithContext(ctx).Order("name ASC").Find(&regionModels).Error; err != nil {
+		r.logger.Errorf("Failed to get regions: %v", err)
+		return nil, err
+	}
+
+	regions := make([]region_entity.Region, len(regionModels))
+	for i := range regionModels {
+		regions[i] = *r.converter.ToEntity(&regionModels[i])
+	}
+
+	return regions, nil
+}
+
+func (r *RegionRepository) GetByID(ctx context.Context, id string) (*region_entity.Region, error) {
+	r.logger.Debugf("Getting region by ID: %s", id)
+
+	var regionModel region_model.Region
+	if err := r.db.WithContext(ctx).First(&regionModel, "id = ?", id).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			r.logger.Debugf("Region not found: %s", id)
+			return nil, nil
+		}
+		r.logger.Errorf("Failed to get region by ID %s: %v", id, err)
+		return nil, err
+	}
+
+	return r.converter.ToEntity(&regionModel), nil
+}
+
+func (r *RegionRepository) GetByCode(ctx context.Context, code string) (*region_entity.Region, error) {
+	r.logger.Debugf("Getting region by code: %s", code)
+
+	var regionModel region_model.Region
+	if err := r.db.WithContext(ctx).First(&regionModel, "code = ?", code).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			r.logger.Debugf("Region not found: %s", code)
+			return nil, nil
+		}
+		r.logger.Errorf("Failed to get region by code %s: %v", code, err)
+		return nil, err
+	}
+
+	return r.converter.ToEntity(&regionModel), nil
+}
@@ -0,0 +1,67 @@
+package region_module
+
+import (
+	"github.com/Fi44er/sdmed/internal/config"
+	region_http "github.com/Fi44er/sdmed/internal/module/region/delivery/http"
+	region_adapters "github.com/Fi44er/sdmed/internal/module/region/infrastructure/adapters"
+	region_repository "github.com/Fi44er/sdmed/internal/module/region/infrastructure/repository/region"
+	region_usecase "github.com/Fi44er/sdmed/internal/module/region/usecase/region"
+	region_usecase_contracts "github.com/Fi44er/sdmed/internal/module/region/usecase/region/contracts"
+	user_usecase "github.com/Fi44er/sdmed/internal/module/user/usecase/user"
+	"github.com/Fi44er/sdmed/pkg/logger"
+	"github.com/go-playground/validator/v10"
+	"github.com/gofiber/fiber/v2"
+	"gorm.io/gorm"
+)
+
+type RegionModule struct {
+	regionRepository region_repository.IRegionRepository
+	regionUsecase    *region_usecase.RegionUsecase
+	regionHandler    *region_http.RegionHandler
+
+	userUsecase       *user_usecase.UserUsecase
+	sessionRepository region_usecase_contracts.ISessionRepository
+
+	logger    *logger.Logger
+	validator *validator.Validate
+	db        *gorm.DB
+	config    *config.Config
+}
+
+func NewRegionModule(
+	logger *logger.Logger,
+	validator *validator.Validate,
+	db *gorm.DB,
+	config *config.Config,
+	userUsecase *user_usecase.UserUsecase,
+	sessionRepository region_usecase_contracts.ISessionRepository,
+) *RegionModule {
+	return &RegionModule{
+		logger:            logger,
+		validator:         validator,
+		db:                db,
+		config:            config,
+		userUsecase:       userUsecase,
+		sessionRepository: sessionRepository,
+	}
+}
+
+func (m *RegionModule) Init() {
+	m.regionRepository = region_repository.NewRegionRepository(m.logger, m.db)
+	m.regionUsecase = region_usecase.NewRegionUsecase(
+		m.regionRepository,
+		m.sessionRepository,
+		region_adapters.NewUserUsecaseAdapter(m.userUsecase),
+		m.logger,
+		m.config,
+	)
+	m.regionHandler = region_http.NewRegionHandler(m.regionUsecase, m.validator, m.logger)
+}
+
+func (m *RegionModule) InitDelivery(router fiber.Router) {
+	m.regionHandler.RegisterRoutes(router)
+}
+
+func (m *RegionModule) GetRegionUsecase() *region_usecase.RegionUsecase {
+	return m.regionUsecase
+}
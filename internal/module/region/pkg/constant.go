@@ -0,0 +1,7 @@
+package region_constant
+
+import "github.com/Fi44er/sdmed/pkg/customerr"
+
+var (
+	ErrRegionNotFound = customerr.NewError(404, "region not found")
+)
@@ -0,0 +1,30 @@
+package region_usecase_contracts
+
+import (
+	"context"
+
+	auth_entity "github.com/Fi44er/sdmed/internal/module/auth/entity"
+	region_entity "github.com/Fi44er/sdmed/internal/module/region/entity"
+)
+
+type IRegionRepository interface {
+	GetAll(ctx context.Context) ([]region_entity.Region, error)
+	GetByID(ctx context.Context, id string) (*region_entity.Region, error)
+	GetByCode(ctx context.Context, code string) (*region_entity.Region, error)
+}
+
+type ISessionRepository interface {
+	GetSessionInfo(ctx context.Context) (*auth_entity.ActiveSession, error)
+	PutSessionInfo(ctx context.Context, sessionInfo *auth_entity.ActiveSession) error
+}
+
+type IUserUsecaseAdapter interface {
+	GetRegionID(ctx context.Context, userID string) (string, error)
+	UpdateRegion(ctx context.Context, userID, regionID string) error
+}
+
+// IAddressRegionProvider возвращает регион адреса доставки пользователя по умолчанию.
+// Пустая строка означает, что адрес неизвестен.
+type IAddressRegionProvider interface {
+	GetDefaultRegionID(ctx context.Context, userID string) (string, error)
+}
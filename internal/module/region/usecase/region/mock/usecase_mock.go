@@ -0,0 +1,224 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./internal/module/region/usecase/region/contracts/contracts.go
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	context "context"
+	reflect "reflect"
+
+	auth_entity "github.com/Fi44er/sdmed/internal/module/auth/entity"
+	region_entity "github.com/Fi44er/sdmed/internal/module/region/entity"
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockIRegionRepository is a mock of IRegionRepository interface.
+type MockIRegionRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockIRegionRepositoryMockRecorder
+}
+
+// MockIRegionRepositoryMockRecorder is the mock recorder for MockIRegionRepository.
+type MockIRegionRepositoryMockRecorder struct {
+	mock *MockIRegionRepository
+}
+
+// NewMockIRegionRepository creates a new mock instance.
+func NewMockIRegionRepository(ctrl *gomock.Controller) *MockIRegionRepository {
+	mock := &MockIRegionRepository{ctrl: ctrl}
+	mock.recorder = &MockIRegionRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockIRegionRepository) EXPECT() *MockIRegionRepositoryMockRecorder {
+	return m.recorder
+}
+
+// GetAll mocks base method.
+func (m *MockIRegionRepository) GetAll(ctx context.Context) ([]region_entity.Region, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAll", ctx)
+	ret0, _ := ret[0].([]region_entity.Region)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAll indicates an expected call of GetAll.
+func (mr *MockIRegionRepositoryMockRecorder) GetAll(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAll", reflect.TypeOf((*MockIRegionRepository)(nil).GetAll), ctx)
+}
+
+// GetByCode mocks base method.
+func (m *MockIRegionRepository) GetByCode(ctx context.Context, code string) (*region_entity.Region, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByCode", ctx, code)
+	ret0, _ := ret[0].(*region_entity.Region)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByCode indicates an expected call of GetByCode.
+func (mr *MockIRegionRepositoryMockRecorder) GetByCode(ctx, code interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByCode", reflect.TypeOf((*MockIRegionRepository)(nil).GetByCode), ctx, code)
+}
+
+// GetByID mocks base method.
+func (m *MockIRegionRepository) GetByID(ctx context.Context, id string) (*region_entity.Region, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByID", ctx, id)
+	ret0, _ := ret[0].(*region_entity.Region)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByID indicates an expected call of GetByID.
+func (mr *MockIRegionRepositoryMockRecorder) GetByID(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByID", reflect.TypeOf((*MockIRegionRepository)(nil).GetByID), ctx, id)
+}
+
+// MockISessionRepository is a mock of ISessionRepository interface.
+type MockISessionRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockISessionRepositoryMockRecorder
+}
+
+// MockISessionRepositoryMockRecorder is the mock recorder for MockISessionRepository.
+type MockISessionRepositoryMockRecorder struct {
+	mock *MockISessionRepository
+}
+
+// NewMockISessionRepository creates a new mock instance.
+func NewMockISessionRepository(ctrl *gomock.Controller) *MockISessionRepository {
+	mock := &MockISessionRepository{ctrl: ctrl}
+	mock.recorder = &MockISessionRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockISessionRepository) EXPECT() *MockISessionRepositoryMockRecorder {
+	return m.recorder
+}
+
+// GetSessionInfo mocks base method.
+func (m *MockISessionRepository) GetSessionInfo(ctx context.Context) (*auth_entity.ActiveSession, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSessionInfo", ctx)
+	ret0, _ := ret[0].(*auth_entity.ActiveSession)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSessionInfo indicates an expected call of GetSessionInfo.
+func (mr *MockISessionRepositoryMockRecorder) GetSessionInfo(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSessionInfo", reflect.TypeOf((*MockISessionRepository)(nil).GetSessionInfo), ctx)
+}
+
+// PutSessionInfo mocks base method.
+func (m *MockISessionRepository) PutSessionInfo(ctx context.Context, sessionInfo *auth_entity.ActiveSession) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PutSessionInfo", ctx, sessionInfo)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// PutSessionInfo indicates an expected call of PutSessionInfo.
+func (mr *MockISessionRepositoryMockRecorder) PutSessionInfo(ctx, sessionInfo interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PutSessionInfo", reflect.TypeOf((*MockISessionRepository)(nil).PutSessionInfo), ctx, sessionInfo)
+}
+
+// MockIUserUsecaseAdapter is a mock of IUserUsecaseAdapter interface.
+type MockIUserUsecaseAdapter struct {
+	ctrl     *gomock.Controller
+	recorder *MockIUserUsecaseAdapterMockRecorder
+}
+
+// MockIUserUsecaseAdapterMockRecorder is the mock recorder for MockIUserUsecaseAdapter.
+type MockIUserUsecaseAdapterMockRecorder struct {
+	mock *MockIUserUsecaseAdapter
+}
+
+// NewMockIUserUsecaseAdapter creates a new mock instance.
+func NewMockIUserUsecaseAdapter(ctrl *gomock.Controller) *MockIUserUsecaseAdapter {
+	mock := &MockIUserUsecaseAdapter{ctrl: ctrl}
+	mock.recorder = &MockIUserUsecaseAdapterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockIUserUsecaseAdapter) EXPECT() *MockIUserUsecaseAdapterMockRecorder {
+	return m.recorder
+}
+
+// GetRegionID mocks base method.
+func (m *MockIUserUsecaseAdapter) GetRegionID(ctx context.Context, userID string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRegionID", ctx, userID)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRegionID indicates an expected call of GetRegionID.
+func (mr *MockIUserUsecaseAdapterMockRecorder) GetRegionID(ctx, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRegionID", reflect.TypeOf((*MockIUserUsecaseAdapter)(nil).GetRegionID), ctx, userID)
+}
+
+// UpdateRegion mocks base method.
+func (m *MockIUserUsecaseAdapter) UpdateRegion(ctx context.Context, userID, regionID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateRegion", ctx, userID, regionID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateRegion indicates an expected call of UpdateRegion.
+func (mr *MockIUserUsecaseAdapterMockRecorder) UpdateRegion(ctx, userID, regionID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateRegion", reflect.TypeOf((*MockIUserUsecaseAdapter)(nil).UpdateRegion), ctx, userID, regionID)
+}
+
+// MockIAddressRegionProvider is a mock of IAddressRegionProvider interface.
+type MockIAddressRegionProvider struct {
+	ctrl     *gomock.Controller
+	recorder *MockIAddressRegionProviderMockRecorder
+}
+
+// MockIAddressRegionProviderMockRecorder is the mock recorder for MockIAddressRegionProvider.
+type MockIAddressRegionProviderMockRecorder struct {
+	mock *MockIAddressRegionProvider
+}
+
+// NewMockIAddressRegionProvider creates a new mock instance.
+func NewMockIAddressRegionProvider(ctrl *gomock.Controller) *MockIAddressRegionProvider {
+	mock := &MockIAddressRegionProvider{ctrl: ctrl}
+	mock.recorder = &MockIAddressRegionProviderMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockIAddressRegionProvider) EXPECT() *MockIAddressRegionProviderMockRecorder {
+	return m.recorder
+}
+
+// GetDefaultRegionID mocks base method.
+func (m *MockIAddressRegionProvider) GetDefaultRegionID(ctx context.Context, userID string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDefaultRegionID", ctx, userID)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDefaultRegionID indicates an expected call of GetDefaultRegionID.
+func (mr *MockIAddressRegionProviderMockRecorder) GetDefaultRegionID(ctx, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDefaultRegionID", reflect.TypeOf((*MockIAddressRegionProvider)(nil).GetDefaultRegionID), ctx, userID)
+}
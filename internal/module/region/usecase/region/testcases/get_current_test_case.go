@@ -0,0 +1,96 @@
+package region_testcases
+
+import (
+	"context"
+	"errors"
+
+	auth_entity "github.com/Fi44er/sdmed/internal/module/auth/entity"
+	region_entity "github.com/Fi44er/sdmed/internal/module/region/entity"
+	"github.com/Fi44er/sdmed/internal/module/region/usecase/region/mock"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+type MockGetCurrent struct {
+	Ctrl            *gomock.Controller
+	Ctx             context.Context
+	RepoMock        *mock.MockIRegionRepository
+	SessionRepoMock *mock.MockISessionRepository
+	UserMock        *mock.MockIUserUsecaseAdapter
+	AddressMock     *mock.MockIAddressRegionProvider
+	T               assert.TestingT
+}
+
+type GetCurrentTestCase struct {
+	Name           string
+	SetupMocks     func(m *MockGetCurrent)
+	Calls          int
+	ExpectedRegion *region_entity.CurrentRegion
+	ExpectedError  error
+}
+
+const DefaultRegionCode = "77"
+
+var (
+	moscow = region_entity.Region{ID: "region-moscow", Code: DefaultRegionCode, Name: "Москва"}
+	kazan  = region_entity.Region{ID: "region-kazan", Code: "16", Name: "Республика Татарстан"}
+)
+
+func GetGetCurrentTestCases() []GetCurrentTestCase {
+	return []GetCurrentTestCase{
+		{
+			Name: "region_from_session",
+			SetupMocks: func(m *MockGetCurrent) {
+				m.SessionRepoMock.EXPECT().GetSessionInfo(m.Ctx).Return(&auth_entity.ActiveSession{RegionID: kazan.ID}, nil)
+				m.RepoMock.EXPECT().GetByID(m.Ctx, kazan.ID).Return(&kazan, nil)
+			},
+			Calls:          1,
+			ExpectedRegion: &region_entity.CurrentRegion{Region: kazan, Source: region_entity.RegionSourceSession},
+		},
+		{
+			Name: "region_from_user_saved_to_session",
+			SetupMocks: func(m *MockGetCurrent) {
+				m.SessionRepoMock.EXPECT().GetSessionInfo(m.Ctx).Return(&auth_entity.ActiveSession{UserID: "user-1"}, nil)
+				m.UserMock.EXPECT().GetRegionID(m.Ctx, "user-1").Return(kazan.ID, nil)
+				m.RepoMock.EXPECT().GetByID(m.Ctx, kazan.ID).Return(&kazan, nil)
+				m.SessionRepoMock.EXPECT().
+					PutSessionInfo(m.Ctx, gomock.Any()).
+					DoAndReturn(func(ctx context.Context, sessionInfo *auth_entity.ActiveSession) error {
+						assert.Equal(m.T, kazan.ID, sessionInfo.RegionID)
+						return nil
+					})
+			},
+			Calls:          1,
+			ExpectedRegion: &region_entity.CurrentRegion{Region: kazan, Source: region_entity.RegionSourceUser},
+		},
+		{
+			Name: "region_from_default_address",
+			SetupMocks: func(m *MockGetCurrent) {
+				m.SessionRepoMock.EXPECT().GetSessionInfo(m.Ctx).Return(&auth_entity.ActiveSession{UserID: "user-1"}, nil)
+				m.UserMock.EXPECT().GetRegionID(m.Ctx, "user-1").Return("", nil)
+				m.AddressMock.EXPECT().GetDefaultRegionID(m.Ctx, "user-1").Return(kazan.ID, nil)
+				m.RepoMock.EXPECT().GetByID(m.Ctx, kazan.ID).Return(&kazan, nil)
+			},
+			Calls:          1,
+			ExpectedRegion: &region_entity.CurrentRegion{Region: kazan, Source: region_entity.RegionSourceAddress},
+		},
+		{
+			Name: "default_region_without_session",
+			SetupMocks: func(m *MockGetCurrent) {
+				m.SessionRepoMock.EXPECT().GetSessionInfo(m.Ctx).Return(nil, errors.New("session not found"))
+				m.RepoMock.EXPECT().GetByCode(m.Ctx, DefaultRegionCode).Return(&moscow, nil)
+			},
+			Calls:          1,
+			ExpectedRegion: &region_entity.CurrentRegion{Region: moscow, Source: region_entity.RegionSourceDefault},
+		},
+		{
+			Name: "resolved_once_per_request",
+			SetupMocks: func(m *MockGetCurrent) {
+				m.SessionRepoMock.EXPECT().GetSessionInfo(m.Ctx).Return(&auth_entity.ActiveSession{RegionID: kazan.ID}, nil).Times(1)
+				m.RepoMock.EXPECT().GetByID(m.Ctx, kazan.ID).Return(&kazan, nil).Times(1)
+			},
+			Calls:          3,
+			ExpectedRegion: &region_entity.CurrentRegion{Region: kazan, Source: region_entity.RegionSourceSession},
+		},
+	}
+}
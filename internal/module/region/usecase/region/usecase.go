@@ -0,0 +1,192 @@
+package region_usecase
+
+import (
+	"context"
+
+	"github.com/Fi44er/sdmed/internal/config"
+	region_entity "github.com/Fi44er/sdmed/internal/module/region/entity"
+	region_constant "github.com/Fi44er/sdmed/internal/module/region/pkg"
+	region_usecase_contracts "github.com/Fi44er/sdmed/internal/module/region/usecase/region/contracts"
+	"github.com/Fi44er/sdmed/pkg/logger"
+)
+
+type IRegionUsecase interface {
+	GetAll(ctx context.Context) ([]region_entity.Region, error)
+	GetByID(ctx context.Context, id string) (*region_entity.Region, error)
+	GetCurrent(ctx context.Context) (*region_entity.CurrentRegion, error)
+	SetCurrent(ctx context.Context, regionID string) (*region_entity.Region, error)
+}
+
+type RegionUsecase struct {
+	repository        region_usecase_contracts.IRegionRepository
+	sessionRepository region_usecase_contracts.ISessionRepository
+	userUsecase       region_usecase_contracts.IUserUsecaseAdapter
+	addressProvider   region_usecase_contracts.IAddressRegionProvider
+	logger            *logger.Logger
+	config            *config.Config
+}
+
+func NewRegionUsecase(
+	repository region_usecase_contracts.IRegionRepository,
+	sessionRepository region_usecase_contracts.ISessionRepository,
+	userUsecase region_usecase_contracts.IUserUsecaseAdapter,
+	logger *logger.Logger,
+	config *config.Config,
+) *RegionUsecase {
+	return &RegionUsecase{
+		repository:        repository,
+		sessionRepository: sessionRepository,
+		userUsecase:       userUsecase,
+		logger:            logger,
+		config:            config,
+	}
+}
+
+// SetAddressRegionProvider подключает источник региона из адреса доставки
+func (u *RegionUsecase) SetAddressRegionProvider(provider region_usecase_contracts.IAddressRegionProvider) {
+	u.addressProvider = provider
+}
+
+func (u *RegionUsecase) GetAll(ctx context.Context) ([]region_entity.Region, error) {
+	return u.repository.GetAll(ctx)
+}
+
+func (u *RegionUsecase) GetByID(ctx context.Context, id string) (*region_entity.Region, error) {
+	region, err := u.repository.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if region == nil {
+		return nil, region_constant.ErrRegionNotFound
+	}
+	return region, nil
+}
+
+// CurrentRegionKey - ключ значения запроса, в котором запоминается регион покупателя
+const CurrentRegionKey = "currentRegion"
+
+// CurrentRegionCache хранит регион покупателя, уже определенный в рамках запроса
+type CurrentRegionCache struct {
+	Region *region_entity.CurrentRegion
+}
+
+// GetCurrent определяет регион покупателя: выбранный в сессии, сохраненный у пользователя,
+// регион адреса доставки, и в последнюю очередь регион по умолчанию из конфигурации.
+// Если в запросе есть CurrentRegionCache, регион определяется один раз за запрос
+func (u *RegionUsecase) GetCurrent(ctx context.Context) (*region_entity.CurrentRegion, error) {
+	cache, _ := ctx.Value(CurrentRegionKey).(*CurrentRegionCache)
+	if cache != nil && cache.Region != nil {
+		return cache.Region, nil
+	}
+
+	region, err := u.resolveCurrent(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if cache != nil {
+		cache.Region = region
+	}
+
+	return region, nil
+}
+
+func (u *RegionUsecase) resolveCurrent(ctx context.Context) (*region_entity.CurrentRegion, error) {
+	sessionInfo, err := u.sessionRepository.GetSessionInfo(ctx)
+	if err != nil {
+		u.logger.Debugf("Session info not available, using default region: %v", err)
+		return u.getDefault(ctx)
+	}
+
+	if sessionInfo.RegionID != "" {
+		if region := u.findRegion(ctx, sessionInfo.RegionID); region != nil {
+			return &region_entity.CurrentRegion{Region: *region, Source: region_entity.RegionSourceSession}, nil
+		}
+	}
+
+	if sessionInfo.UserID == "" {
+		return u.getDefault(ctx)
+	}
+
+	regionID, err := u.userUsecase.GetRegionID(ctx, sessionInfo.UserID)
+	if err != nil {
+		u.logger.Warnf("Failed to get region of user %s: %v", sessionInfo.UserID, err)
+	} else if region := u.findRegion(ctx, regionID); region != nil {
+		sessionInfo.RegionID = region.ID
+		if err := u.sessionRepository.PutSessionInfo(ctx, sessionInfo); err != nil {
+			u.logger.Warnf("Failed to save region to session: %v", err)
+		}
+		return &region_entity.CurrentRegion{Region: *region, Source: region_entity.RegionSourceUser}, nil
+	}
+
+	if u.addressProvider != nil {
+		regionID, err := u.addressProvider.GetDefaultRegionID(ctx, sessionInfo.UserID)
+		if err != nil {
+			u.logger.Warnf("Failed to get address region of user %s: %v", sessionInfo.UserID, err)
+		} else if region := u.findRegion(ctx, regionID); region != nil {
+			return &region_entity.CurrentRegion{Region: *region, Source: region_entity.RegionSourceAddress}, nil
+		}
+	}
+
+	return u.getDefault(ctx)
+}
+
+// SetCurrent сохраняет выбранный регион в сессии и у пользователя (в том числе shadow)
+func (u *RegionUsecase) SetCurrent(ctx context.Context, regionID string) (*region_entity.Region, error) {
+	u.logger.Infof("Setting current region: %s", regionID)
+
+	region, err := u.GetByID(ctx, regionID)
+	if err != nil {
+		return nil, err
+	}
+
+	sessionInfo, err := u.sessionRepository.GetSessionInfo(ctx)
+	if err != nil {
+		u.logger.Errorf("Failed to get session info: %v", err)
+		return nil, err
+	}
+
+	sessionInfo.RegionID = region.ID
+	if err := u.sessionRepository.PutSessionInfo(ctx, sessionInfo); err != nil {
+		u.logger.Errorf("Failed to save region to session: %v", err)
+		return nil, err
+	}
+	if cache, ok := ctx.Value(CurrentRegionKey).(*CurrentRegionCache); ok {
+		cache.Region = nil
+	}
+
+	if sessionInfo.UserID != "" {
+		if err := u.userUsecase.UpdateRegion(ctx, sessionInfo.UserID, region.ID); err != nil {
+			u.logger.Errorf("Failed to save region for user %s: %v", sessionInfo.UserID, err)
+			return nil, err
+		}
+	}
+
+	return region, nil
+}
+
+func (u *RegionUsecase) findRegion(ctx context.Context, id string) *region_entity.Region {
+	if id == "" {
+		return nil
+	}
+
+	region, err := u.repository.GetByID(ctx, id)
+	if err != nil {
+		u.logger.Warnf("Failed to get region %s: %v", id, err)
+		return nil
+	}
+	return region
+}
+
+func (u *RegionUsecase) getDefault(ctx context.Context) (*region_entity.CurrentRegion, error) {
+	region, err := u.repository.GetByCode(ctx, u.config.DefaultRegionCode)
+	if err != nil {
+		return nil, err
+	}
+
+	if region == nil {
+		u.logger.Errorf("Default region %s not found", u.config.DefaultRegionCode)
+		return nil, region_constant.ErrRegionNotFound
+	}
+	return &region_entity.CurrentRegion{Region: *region, Source: region_entity.RegionSourceDefault}, nil
+}
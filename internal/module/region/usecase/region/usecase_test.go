@@ -0,0 +1,67 @@
+package region_usecase_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/Fi44er/sdmed/internal/config"
+	region_usecase "github.com/Fi44er/sdmed/internal/module/region/usecase/region"
+	"github.com/Fi44er/sdmed/internal/module/region/usecase/region/mock"
+	region_testcases "github.com/Fi44er/sdmed/internal/module/region/usecase/region/testcases"
+	"github.com/Fi44er/sdmed/pkg/logger"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+)
+
+type RegionUsecaseTestSuite struct {
+	suite.Suite
+	logger *logger.Logger
+	config *config.Config
+}
+
+func (s *RegionUsecaseTestSuite) SetupSuite() {
+	s.logger = logger.NewLogger()
+	s.config = &config.Config{DefaultRegionCode: region_testcases.DefaultRegionCode}
+}
+
+func TestRegionUsecase(t *testing.T) {
+	suite.Run(t, new(RegionUsecaseTestSuite))
+}
+
+func (s *RegionUsecaseTestSuite) TestGetCurrent() {
+	tests := region_testcases.GetGetCurrentTestCases()
+
+	for _, tc := range tests {
+		s.T().Run(tc.Name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			ctx := context.WithValue(context.Background(), region_usecase.CurrentRegionKey, &region_usecase.CurrentRegionCache{})
+			mockStruct := &region_testcases.MockGetCurrent{
+				Ctrl:            ctrl,
+				Ctx:             ctx,
+				RepoMock:        mock.NewMockIRegionRepository(ctrl),
+				SessionRepoMock: mock.NewMockISessionRepository(ctrl),
+				UserMock:        mock.NewMockIUserUsecaseAdapter(ctrl),
+				AddressMock:     mock.NewMockIAddressRegionProvider(ctrl),
+				T:               t,
+			}
+
+			usecase := region_usecase.NewRegionUsecase(mockStruct.RepoMock, mockStruct.SessionRepoMock, mockStruct.UserMock, s.logger, s.config)
+			usecase.SetAddressRegionProvider(mockStruct.AddressMock)
+
+			tc.SetupMocks(mockStruct)
+
+			for i := 0; i < tc.Calls; i++ {
+				region, err := usecase.GetCurrent(ctx)
+				if tc.ExpectedError != nil {
+					assert.ErrorIs(t, err, tc.ExpectedError)
+					continue
+				}
+				assert.NoError(t, err)
+				assert.Equal(t, tc.ExpectedRegion, region)
+			}
+		})
+	}
+}
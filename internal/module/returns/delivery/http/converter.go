@@ -0,0 +1,122 @@
+package returns_http
+
+import (
+	"fmt"
+	"math"
+	"path"
+
+	"github.com/Fi44er/sdmed/internal/config"
+	returns_dto "github.com/Fi44er/sdmed/internal/module/returns/dto"
+	returns_entity "github.com/Fi44er/sdmed/internal/module/returns/entity"
+	dto_utils "github.com/Fi44er/sdmed/pkg/utils/dto"
+)
+
+type Converter struct {
+	config *config.Config
+}
+
+func NewConverter(config *config.Config) *Converter {
+	return &Converter{
+		config: config,
+	}
+}
+
+func (c *Converter) ToReturn(dto *returns_dto.CreateReturnRequest) *returns_entity.Return {
+	items := make([]returns_entity.Item, len(dto.Items))
+	for i, item := range dto.Items {
+		items[i] = returns_entity.Item{
+			OrderItemID: item.OrderItemID,
+			Quantity:    item.Quantity,
+		}
+	}
+
+	photos := make([]returns_entity.Photo, len(dto.Photos))
+	for i, photoURL := range dto.Photos {
+		photos[i] = returns_entity.Photo{Name: path.Base(photoURL)}
+	}
+
+	return &returns_entity.Return{
+		OrderID: dto.OrderID,
+		Reason:  dto.Reason,
+		Items:   items,
+		Photos:  photos,
+	}
+}
+
+func (c *Converter) ToStepComment(dto *returns_dto.ReturnStepRequest) *string {
+	return &dto.Comment
+}
+
+func (c *Converter) ToRejectComment(dto *returns_dto.RejectReturnRequest) *string {
+	return &dto.Comment
+}
+
+func (c *Converter) ToRefund(dto *returns_dto.RefundReturnRequest) *returns_entity.Return {
+	return &returns_entity.Return{
+		RefundAmount: dto.Amount,
+		Comment:      dto.Comment,
+	}
+}
+
+func (c *Converter) ToFilterEntity(dto *returns_dto.ReturnQueryParams) *returns_entity.ReturnFilter {
+	return &returns_entity.ReturnFilter{
+		Status:  returns_entity.ReturnStatus(dto.Status),
+		OrderID: dto.OrderID,
+		UserID:  dto.UserID,
+	}
+}
+
+func (c *Converter) ToReturnResponse(entity *returns_entity.Return) *returns_dto.ReturnResponse {
+	items := make([]returns_dto.ReturnItemResponse, len(entity.Items))
+	for i, item := range entity.Items {
+		items[i] = returns_dto.ReturnItemResponse{
+			ID:          item.ID,
+			OrderItemID: item.OrderItemID,
+			ProductID:   item.ProductID,
+			Name:        item.Name,
+			Quantity:    item.Quantity,
+			Amount:      item.Amount,
+		}
+	}
+
+	photos := make([]returns_dto.FileResponse, len(entity.Photos))
+	for i, photo := range entity.Photos {
+		photos[i] = returns_dto.FileResponse{
+			ID:  photo.ID,
+			URL: fmt.Sprintf("%s/%s/%s", c.config.ApiUrl, c.config.FileLink, photo.Name),
+		}
+	}
+
+	return &returns_dto.ReturnResponse{
+		ID:           entity.ID,
+		Number:       entity.Number(),
+		OrderID:      entity.OrderID,
+		UserID:       entity.UserID,
+		Status:       string(entity.Status),
+		Reason:       entity.Reason,
+		Comment:      entity.Comment,
+		Items:        items,
+		Photos:       photos,
+		RefundAmount: entity.RefundAmount,
+		PaymentID:    entity.PaymentID,
+		CreatedAt:    entity.CreatedAt,
+		UpdatedAt:    entity.UpdatedAt,
+	}
+}
+
+func (c *Converter) ToReturnListResponse(returns []returns_entity.Return, count int64, page, pageSize int) *dto_utils.ListResponse[returns_dto.ReturnResponse] {
+	data := make([]returns_dto.ReturnResponse, len(returns))
+	for i := range returns {
+		data[i] = *c.ToReturnResponse(&returns[i])
+	}
+
+	return &dto_utils.ListResponse[returns_dto.ReturnResponse]{
+		Data: data,
+		Pagination: dto_utils.PaginationInfo{
+			Total:    count,
+			Page:     page,
+			PageSize: pageSize,
+			Pages:    int(math.Ceil(float64(count) / float64(pageSize))),
+		},
+	}
+}
@@ -0,0 +1,330 @@
+package returns_http
+
+import (
+	"context"
+
+	"github.com/Fi44er/sdmed/internal/config"
+	returns_dto "github.com/Fi44er/sdmed/internal/module/returns/dto"
+	returns_entity "github.com/Fi44er/sdmed/internal/module/returns/entity"
+	"github.com/Fi44er/sdmed/pkg/logger"
+	_ "github.com/Fi44er/sdmed/pkg/response"
+	"github.com/Fi44er/sdmed/pkg/session"
+	"github.com/Fi44er/sdmed/pkg/utils"
+	"github.com/go-playground/validator/v10"
+	"github.com/gofiber/fiber/v2"
+)
+
+type IReturnUsecase interface {
+	Create(ctx context.Context, ret *returns_entity.Return) (*returns_entity.Return, error)
+	GetOwn(ctx context.Context, page, pageSize int) ([]returns_entity.Return, int64, error)
+	GetOwnByID(ctx context.Context, id string) (*returns_entity.Return, error)
+
+	GetByID(ctx context.Context, id string) (*returns_entity.Return, error)
+	GetAll(ctx context.Context, filter *returns_entity.ReturnFilter, page, pageSize int) ([]returns_entity.Return, int64, error)
+	Approve(ctx context.Context, id, comment string) (*returns_entity.Return, error)
+	Reject(ctx context.Context, id, comment string) (*returns_entity.Return, error)
+	Receive(ctx context.Context, id, comment string) (*returns_entity.Return, error)
+	Refund(ctx context.Context, id string, amount float64, comment string) (*returns_entity.Return, error)
+}
+
+type ReturnHandler struct {
+	usecase IReturnUsecase
+
+	validator *validator.Validate
+	logger    *logger.Logger
+	converter *Converter
+}
+
+func NewReturnHandler(
+	usecase IReturnUsecase,
+	validator *validator.Validate,
+	logger *logger.Logger,
+	config *config.Config,
+) *ReturnHandler {
+	return &ReturnHandler{
+		usecase:   usecase,
+		validator: validator,
+		logger:    logger,
+		converter: NewConverter(config),
+	}
+}
+
+// Create godoc
+// @Summary Open a return request
+// @Description Return lines of an own delivered order. Photos are URLs of files uploaded with /files/upload-temporary.
+// @Description A line can be returned only up to the quantity not covered by other active returns
+// @Tags returns
+// @Accept json
+// @Produce json
+// @Param request body returns_dto.CreateReturnRequest true "Return request"
+// @Success 201 {object} response.ResponseData{data=returns_dto.ReturnResponse} "Created"
+// @Failure 400 {object} response.Response "Invalid request or quantity exceeded"
+// @Failure 404 {object} response.Response "Order or order item not found"
+// @Failure 409 {object} response.Response "Order is not delivered"
+// @Router /returns [post]
+func (h *ReturnHandler) Create(ctx *fiber.Ctx) error {
+	dto := new(returns_dto.CreateReturnRequest)
+
+	ret, err := utils.ParseAndValidate(ctx, dto, h.validator, h.converter.ToReturn, h.logger)
+	if err != nil {
+		return ctx.Status(400).JSON(fiber.Map{
+			"status":  "fail",
+			"message": err.Error(),
+		})
+	}
+
+	ret, err = h.usecase.Create(h.getCtxWithSession(ctx), ret)
+	if err != nil {
+		return err
+	}
+
+	return ctx.Status(201).JSON(fiber.Map{
+		"status": "success",
+		"data":   h.converter.ToReturnResponse(ret),
+	})
+}
+
+// GetOwn godoc
+// @Summary Get my returns
+// @Description Return requests of the session user, newest first
+// @Tags returns
+// @Produce json
+// @Param page query int false "Page number (default 1)"
+// @Param page_size query int false "Items per page (default 20)"
+// @Success 200 {object} response.ResponseData{data=[]returns_dto.ReturnResponse} "OK"
+// @Failure 401 {object} response.Response "Unauthorized"
+// @Router /returns [get]
+func (h *ReturnHandler) GetOwn(ctx *fiber.Ctx) error {
+	params := &returns_dto.ReturnQueryParams{
+		Page:     1,
+		PageSize: 20,
+	}
+
+	if err := ctx.QueryParser(params); err != nil {
+		h.logger.Errorf("Failed to parse query params: %v", err)
+		return fiber.NewError(fiber.StatusBadRequest, "Invalid query parameters")
+	}
+
+	returns, count, err := h.usecase.GetOwn(h.getCtxWithSession(ctx), params.Page, params.PageSize)
+	if err != nil {
+		return err
+	}
+
+	return ctx.Status(200).JSON(fiber.Map{
+		"status": "success",
+		"data":   h.converter.ToReturnListResponse(returns, count, params.Page, params.PageSize),
+	})
+}
+
+// GetOwnByID godoc
+// @Summary Get my return
+// @Tags returns
+// @Produce json
+// @Param id path string true "Return ID"
+// @Success 200 {object} response.ResponseData{data=returns_dto.ReturnResponse} "OK"
+// @Failure 404 {object} response.Response "Return not found"
+// @Router /returns/{id} [get]
+func (h *ReturnHandler) GetOwnByID(ctx *fiber.Ctx) error {
+	ret, err := h.usecase.GetOwnByID(h.getCtxWithSession(ctx), ctx.Params("id"))
+	if err != nil {
+		return err
+	}
+
+	return ctx.Status(200).JSON(fiber.Map{
+		"status": "success",
+		"data":   h.converter.ToReturnResponse(ret),
+	})
+}
+
+// GetAll godoc
+// @Summary Get returns
+// @Description Return requests filtered by status, order or customer, newest first
+// @Tags returns-admin
+// @Produce json
+// @Param status query string false "requested, approved, rejected, received, refund_pending or refunded"
+// @Param order_id query string false "Order ID"
+// @Param user_id query string false "Customer ID"
+// @Param page query int false "Page number (default 1)"
+// @Param page_size query int false "Items per page (default 20)"
+// @Success 200 {object} response.ResponseData{data=[]returns_dto.ReturnResponse} "OK"
+// @Failure 400 {object} response.Response "Invalid status"
+// @Router /admin/returns [get]
+func (h *ReturnHandler) GetAll(ctx *fiber.Ctx) error {
+	params := &returns_dto.ReturnQueryParams{
+		Page:     1,
+		PageSize: 20,
+	}
+
+	if err := ctx.QueryParser(params); err != nil {
+		h.logger.Errorf("Failed to parse query params: %v", err)
+		return fiber.NewError(fiber.StatusBadRequest, "Invalid query parameters")
+	}
+
+	returns, count, err := h.usecase.GetAll(ctx.Context(), h.converter.ToFilterEntity(params), params.Page, params.PageSize)
+	if err != nil {
+		return err
+	}
+
+	return ctx.Status(200).JSON(fiber.Map{
+		"status": "success",
+		"data":   h.converter.ToReturnListResponse(returns, count, params.Page, params.PageSize),
+	})
+}
+
+// GetByID godoc
+// @Summary Get return
+// @Tags returns-admin
+// @Produce json
+// @Param id path string true "Return ID"
+// @Success 200 {object} response.ResponseData{data=returns_dto.ReturnResponse} "OK"
+// @Failure 404 {object} response.Response "Return not found"
+// @Router /admin/returns/{id} [get]
+func (h *ReturnHandler) GetByID(ctx *fiber.Ctx) error {
+	ret, err := h.usecase.GetByID(ctx.Context(), ctx.Params("id"))
+	if err != nil {
+		return err
+	}
+
+	return ctx.Status(200).JSON(fiber.Map{
+		"status": "success",
+		"data":   h.converter.ToReturnResponse(ret),
+	})
+}
+
+// Approve godoc
+// @Summary Approve a return
+// @Tags returns-admin
+// @Accept json
+// @Produce json
+// @Param id path string true "Return ID"
+// @Param request body returns_dto.ReturnStepRequest false "Comment for the order history"
+// @Success 200 {object} response.ResponseData{data=returns_dto.ReturnResponse} "OK"
+// @Failure 404 {object} response.Response "Return not found"
+// @Failure 409 {object} response.Response "Return is not requested"
+// @Router /admin/returns/{id}/approve [post]
+func (h *ReturnHandler) Approve(ctx *fiber.Ctx) error {
+	return h.step(ctx, h.usecase.Approve)
+}
+
+// Reject godoc
+// @Summary Reject a return
+// @Description The comment is required and is shown to the customer
+// @Tags returns-admin
+// @Accept json
+// @Produce json
+// @Param id path string true "Return ID"
+// @Param request body returns_dto.RejectReturnRequest true "Reason of the rejection"
+// @Success 200 {object} response.ResponseData{data=returns_dto.ReturnResponse} "OK"
+// @Failure 400 {object} response.Response "Comment is missing"
+// @Failure 404 {object} response.Response "Return not found"
+// @Failure 409 {object} response.Response "Return is not requested"
+// @Router /admin/returns/{id}/reject [post]
+func (h *ReturnHandler) Reject(ctx *fiber.Ctx) error {
+	comment, err := utils.ParseAndValidate(ctx, new(returns_dto.RejectReturnRequest), h.validator, h.converter.ToRejectComment, h.logger)
+	if err != nil {
+		return ctx.Status(400).JSON(fiber.Map{
+			"status":  "fail",
+			"message": err.Error(),
+		})
+	}
+
+	ret, err := h.usecase.Reject(h.getCtxWithSession(ctx), ctx.Params("id"), *comment)
+	if err != nil {
+		return err
+	}
+
+	return ctx.Status(200).JSON(fiber.Map{
+		"status": "success",
+		"data":   h.converter.ToReturnResponse(ret),
+	})
+}
+
+// Receive godoc
+// @Summary Receive returned goods
+// @Description Mark the returned goods as received back to the warehouse
+// @Tags returns-admin
+// @Accept json
+// @Produce json
+// @Param id path string true "Return ID"
+// @Param request body returns_dto.ReturnStepRequest false "Comment for the order history"
+// @Success 200 {object} response.ResponseData{data=returns_dto.ReturnResponse} "OK"
+// @Failure 404 {object} response.Response "Return not found"
+// @Failure 409 {object} response.Response "Return is not approved"
+// @Router /admin/returns/{id}/receive [post]
+func (h *ReturnHandler) Receive(ctx *fiber.Ctx) error {
+	return h.step(ctx, h.usecase.Receive)
+}
+
+// Refund godoc
+// @Summary Refund a return
+// @Description Refund through the payment provider. Zero amount refunds the full amount paid by card for the returned items.
+// @Description The return moves to refund_pending before the provider is called. If the provider fails it stays there and the request can be repeated.
+// @Description When every line of the order is refunded the order becomes returned
+// @Tags returns-admin
+// @Accept json
+// @Produce json
+// @Param id path string true "Return ID"
+// @Param request body returns_dto.RefundReturnRequest false "Refund amount and comment"
+// @Success 200 {object} response.ResponseData{data=returns_dto.ReturnResponse} "OK"
+// @Failure 400 {object} response.Response "Invalid amount"
+// @Failure 404 {object} response.Response "Return not found"
+// @Failure 409 {object} response.Response "Return is not received, refund started with another amount or no payment to refund"
+// @Failure 502 {object} response.Response "Payment provider error"
+// @Router /admin/returns/{id}/refund [post]
+func (h *ReturnHandler) Refund(ctx *fiber.Ctx) error {
+	refund := new(returns_entity.Return)
+	if len(ctx.Body()) > 0 {
+		var err error
+		refund, err = utils.ParseAndValidate(ctx, new(returns_dto.RefundReturnRequest), h.validator, h.converter.ToRefund, h.logger)
+		if err != nil {
+			return ctx.Status(400).JSON(fiber.Map{
+				"status":  "fail",
+				"message": err.Error(),
+			})
+		}
+	}
+
+	ret, err := h.usecase.Refund(h.getCtxWithSession(ctx), ctx.Params("id"), refund.RefundAmount, refund.Comment)
+	if err != nil {
+		return err
+	}
+
+	return ctx.Status(200).JSON(fiber.Map{
+		"status": "success",
+		"data":   h.converter.ToReturnResponse(ret),
+	})
+}
+
+// step выполняет шаг возврата с необязательным комментарием в теле запроса
+func (h *ReturnHandler) step(ctx *fiber.Ctx, apply func(ctx context.Context, id, comment string) (*returns_entity.Return, error)) error {
+	comment := new(string)
+	if len(ctx.Body()) > 0 {
+		var err error
+		comment, err = utils.ParseAndValidate(ctx, new(returns_dto.ReturnStepRequest), h.validator, h.converter.ToStepComment, h.logger)
+		if err != nil {
+			return ctx.Status(400).JSON(fiber.Map{
+				"status":  "fail",
+				"message": err.Error(),
+			})
+		}
+	}
+
+	ret, err := apply(h.getCtxWithSession(ctx), ctx.Params("id"), *comment)
+	if err != nil {
+		return err
+	}
+
+	return ctx.Status(200).JSON(fiber.Map{
+		"status": "success",
+		"data":   h.converter.ToReturnResponse(ret),
+	})
+}
+
+func (h *ReturnHandler) getCtxWithSession(ctx *fiber.Ctx) context.Context {
+	sess := session.FromFiberContext(ctx)
+	if sess == nil {
+		return ctx.Context()
+	}
+
+	return context.WithValue(ctx.Context(), "session", *sess)
+}
@@ -0,0 +1,21 @@
+package returns_http
+
+import (
+	"github.com/Fi44er/sdmed/internal/middlewares"
+	"github.com/gofiber/fiber/v2"
+)
+
+func (h *ReturnHandler) RegisterRoutes(router fiber.Router) {
+	returns := router.Group("/returns", middlewares.RequireAuth())
+	returns.Post("/", h.Create)
+	returns.Get("/", h.GetOwn)
+	returns.Get("/:id", h.GetOwnByID)
+
+	admin := router.Group("/admin/returns", middlewares.Authorize("return", "read"))
+	admin.Get("/", h.GetAll)
+	admin.Get("/:id", h.GetByID)
+	admin.Post("/:id/approve", middlewares.Authorize("return", "update"), h.Approve)
+	admin.Post("/:id/reject", middlewares.Authorize("return", "update"), h.Reject)
+	admin.Post("/:id/receive", middlewares.Authorize("return", "update"), h.Receive)
+	admin.Post("/:id/refund", middlewares.Authorize("return", "refund"), h.Refund)
+}
@@ -0,0 +1,66 @@
+package returns_dto
+
+import "time"
+
+type CreateReturnRequest struct {
+	OrderID string                    `json:"order_id" validate:"required,uuid"`
+	Reason  string                    `json:"reason" validate:"required,max=1000"`
+	Items   []CreateReturnItemRequest `json:"items" validate:"required,min=1,dive"`
+	Photos  []string                  `json:"photos" validate:"omitempty,max=10,dive,url"`
+}
+
+type CreateReturnItemRequest struct {
+	OrderItemID string `json:"order_item_id" validate:"required,uuid"`
+	Quantity    int    `json:"quantity" validate:"min=1"`
+}
+
+type ReturnStepRequest struct {
+	Comment string `json:"comment" validate:"max=1000"`
+}
+
+type RejectReturnRequest struct {
+	Comment string `json:"comment" validate:"required,max=1000"`
+}
+
+type RefundReturnRequest struct {
+	Amount  float64 `json:"amount" validate:"gte=0"`
+	Comment string  `json:"comment" validate:"max=1000"`
+}
+
+type ReturnQueryParams struct {
+	Status   string `query:"status"`
+	OrderID  string `query:"order_id"`
+	UserID   string `query:"user_id"`
+	Page     int    `query:"page"`
+	PageSize int    `query:"page_size"`
+}
+
+type ReturnItemResponse struct {
+	ID          string  `json:"id"`
+	OrderItemID string  `json:"order_item_id"`
+	ProductID   string  `json:"product_id"`
+	Name        string  `json:"name"`
+	Quantity    int     `json:"quantity"`
+	Amount      float64 `json:"amount"`
+}
+
+type FileResponse struct {
+	ID  string `json:"id"`
+	URL string `json:"url"`
+}
+
+type ReturnResponse struct {
+	ID           string               `json:"id"`
+	Number       string               `json:"number"`
+	OrderID      string               `json:"order_id"`
+	UserID       string               `json:"user_id"`
+	Status       string               `json:"status"`
+	Reason       string               `json:"reason"`
+	Comment      string               `json:"comment,omitempty"`
+	Items        []ReturnItemResponse `json:"items"`
+	Photos       []FileResponse       `json:"photos"`
+	RefundAmount float64              `json:"refund_amount"`
+	PaymentID    string               `json:"payment_id,omitempty"`
+	CreatedAt    time.Time            `json:"created_at"`
+	UpdatedAt    time.Time            `json:"updated_at"`
+}
@@ -0,0 +1,176 @@
+package returns_entity
+
+import (
+	"math"
+	"strings"
+	"time"
+)
+
+type ReturnStatus string
+
+const (
+	// ReturnStatusRequested - заявка открыта покупателем и ждет решения
+	ReturnStatusRequested ReturnStatus = "requested"
+	ReturnStatusApproved  ReturnStatus = "approved"
+	ReturnStatusRejected  ReturnStatus = "rejected"
+	// ReturnStatusReceived - товар получен обратно на склад
+	ReturnStatusReceived ReturnStatus = "received"
+	// ReturnStatusRefundPending - сумма возврата зафиксирована, деньги возвращаются через платежный шлюз
+	ReturnStatusRefundPending ReturnStatus = "refund_pending"
+	// ReturnStatusRefunded - деньги возвращены покупателю
+	ReturnStatusRefunded ReturnStatus = "refunded"
+)
+
+// Actor - кто выполняет шаг возврата, записывается в историю заказа
+type Actor string
+
+const (
+	ActorCustomer Actor = "customer"
+	ActorManager  Actor = "manager"
+)
+
+// PhotoOwnerType - тип владельца фотографий возврата в файловом модуле
+const PhotoOwnerType = "return"
+
+// transitions - допустимые шаги возврата
+var transitions = map[ReturnStatus][]ReturnStatus{
+	ReturnStatusRequested:     {ReturnStatusApproved, ReturnStatusRejected},
+	ReturnStatusApproved:      {ReturnStatusReceived},
+	ReturnStatusReceived:      {ReturnStatusRefundPending},
+	ReturnStatusRefundPending: {ReturnStatusRefunded},
+}
+
+// Return - заявка на возврат позиций заказа. RefundAmount - сумма к возврату на карту,
+// PaymentID - платеж, по которому выполнен возврат
+type Return struct {
+	ID           string
+	OrderID      string
+	UserID       string
+	Status       ReturnStatus
+	Reason       string
+	Comment      string
+	Items        []Item
+	Photos       []Photo
+	RefundAmount float64
+	PaymentID    string
+	CreatedAt    time.Time
+	UpdatedAt    time.Time
+}
+
+// Item - возвращаемая позиция заказа. Amount - оплаченная картой часть за возвращаемое количество
+type Item struct {
+	ID          string
+	ReturnID    string
+	OrderItemID string
+	ProductID   string
+	Name        string
+	Quantity    int
+	Amount      float64
+}
+
+type Photo struct {
+	ID   string
+	Name string
+}
+
+// Order - данные заказа, нужные для возврата
+type Order struct {
+	ID         string
+	UserID     string
+	Status     string
+	Returnable bool
+	Items      []OrderItem
+}
+
+// OrderItem - позиция заказа. PaidAmount - часть суммы позиции, оплаченная картой
+type OrderItem struct {
+	ID         string
+	ProductID  string
+	Name       string
+	Quantity   int
+	PaidAmount float64
+}
+
+type ReturnFilter struct {
+	Status  ReturnStatus
+	OrderID string
+	UserID  string
+	Offset  int
+	Limit   int
+}
+
+func (s ReturnStatus) IsValid() bool {
+	switch s {
+	case ReturnStatusRequested, ReturnStatusApproved, ReturnStatusRejected, ReturnStatusReceived,
+		ReturnStatusRefundPending, ReturnStatusRefunded:
+		return true
+	}
+	return false
+}
+
+// CanTransition проверяет, что шаг из статуса from в to допустим
+func CanTransition(from, to ReturnStatus) bool {
+	for _, allowed := range transitions[from] {
+		if allowed == to {
+			return true
+		}
+	}
+	return false
+}
+
+// FindItem возвращает позицию заказа или nil
+func (o *Order) FindItem(id string) *OrderItem {
+	for i := range o.Items {
+		if o.Items[i].ID == id {
+			return &o.Items[i]
+		}
+	}
+	return nil
+}
+
+// Left - сколько единиц позиции еще можно вернуть с учетом прошлых заявок
+func (i *OrderItem) Left(returned map[string]int) int {
+	return i.Quantity - returned[i.ID]
+}
+
+// AmountFor - оплаченная картой часть за quantity единиц позиции, когда returned единиц
+// уже возвращено. Считается как разница накопленных сумм, чтобы частичные возвраты
+// в сумме давали ровно PaidAmount
+func (i *OrderItem) AmountFor(quantity, returned int) float64 {
+	if i.Quantity == 0 {
+		return 0
+	}
+	share := func(units int) float64 {
+		return math.Round(i.PaidAmount*float64(units)/float64(i.Quantity)*100) / 100
+	}
+	return math.Round((share(returned+quantity)-share(returned))*100) / 100
+}
+
+// Number - короткий номер возврата для покупателя и истории заказа
+func (r *Return) Number() string {
+	number := strings.ReplaceAll(r.ID, "-", "")
+	if len(number) > 8 {
+		number = number[:8]
+	}
+	return strings.ToUpper(number)
+}
+
+// CalculateRefund пересчитывает сумму возврата по позициям
+func (r *Return) CalculateRefund() float64 {
+	amount := 0.0
+	for i := range r.Items {
+		amount += r.Items[i].Amount
+	}
+	r.RefundAmount = math.Round(amount*100) / 100
+	return r.RefundAmount
+}
+
+// IsFullReturn - после возврата у заказа не осталось невозвращенных позиций
+func IsFullReturn(order *Order, returned map[string]int) bool {
+	for i := range order.Items {
+		if order.Items[i].Left(returned) > 0 {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,60 @@
+package returns_entity
+
+import "testing"
+
+func TestCanTransition(t *testing.T) {
+	tests := []struct {
+		from, to ReturnStatus
+		want     bool
+	}{
+		{ReturnStatusRequested, ReturnStatusApproved, true},
+		{ReturnStatusRequested, ReturnStatusRejected, true},
+		{ReturnStatusApproved, ReturnStatusReceived, true},
+		{ReturnStatusReceived, ReturnStatusRefundPending, true},
+		{ReturnStatusRefundPending, ReturnStatusRefunded, true},
+		{ReturnStatusReceived, ReturnStatusRefunded, false},
+		{ReturnStatusRequested, ReturnStatusRefunded, false},
+		{ReturnStatusApproved, ReturnStatusRejected, false},
+		{ReturnStatusRejected, ReturnStatusApproved, false},
+		{ReturnStatusRefunded, ReturnStatusReceived, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(string(tt.from)+"->"+string(tt.to), func(t *testing.T) {
+			if got := CanTransition(tt.from, tt.to); got != tt.want {
+				t.Errorf("CanTransition(%s, %s) = %v, want %v", tt.from, tt.to, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestOrderItemAmountFor(t *testing.T) {
+	item := &OrderItem{ID: "item", Quantity: 3, PaidAmount: 100}
+
+	first := item.AmountFor(1, 0)
+	second := item.AmountFor(1, 1)
+	third := item.AmountFor(1, 2)
+	if first != 33.33 || second != 33.34 || third != 33.33 {
+		t.Errorf("AmountFor by one = %.2f, %.2f, %.2f, want 33.33, 33.34, 33.33", first, second, third)
+	}
+	if total := first + second + third; total != item.PaidAmount {
+		t.Errorf("partial returns sum to %.2f, want %.2f", total, item.PaidAmount)
+	}
+	if got := item.AmountFor(3, 0); got != item.PaidAmount {
+		t.Errorf("AmountFor(3, 0) = %.2f, want %.2f", got, item.PaidAmount)
+	}
+}
+
+func TestIsFullReturn(t *testing.T) {
+	order := &Order{Items: []OrderItem{
+		{ID: "a", Quantity: 2},
+		{ID: "b", Quantity: 1},
+	}}
+
+	if IsFullReturn(order, map[string]int{"a": 2}) {
+		t.Error("order with a line left is reported as fully returned")
+	}
+	if !IsFullReturn(order, map[string]int{"a": 2, "b": 1}) {
+		t.Error("order with every line returned is not reported as fully returned")
+	}
+}
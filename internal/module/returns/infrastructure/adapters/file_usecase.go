@@ -0,0 +1,59 @@
+package returns_adapters
+
+import (
+	"context"
+
+	file_entity "github.com/Fi44er/sdmed/internal/module/file/entity"
+	file_usecase "github.com/Fi44er/sdmed/internal/module/file/usecase/file"
+	returns_entity "github.com/Fi44er/sdmed/internal/module/returns/entity"
+)
+
+type IFileUsecaseAdapter interface {
+	MakeFilesPermanent(ctx context.Context, names []string, ownerID string) error
+	GetByOwner(ctx context.Context, ownerID string) ([]returns_entity.Photo, error)
+	GetByOwners(ctx context.Context, ownerIDs []string) (map[string][]returns_entity.Photo, error)
+}
+
+type FileUsecaseAdapter struct {
+	fileUsecase file_usecase.IFileUsecase
+}
+
+func NewFileUsecaseAdapter(fileUsecase file_usecase.IFileUsecase) IFileUsecaseAdapter {
+	return &FileUsecaseAdapter{
+		fileUsecase: fileUsecase,
+	}
+}
+
+// MakeFilesPermanent привязывает временно загруженные фотографии к возврату
+func (a *FileUsecaseAdapter) MakeFilesPermanent(ctx context.Context, names []string, ownerID string) error {
+	return a.fileUsecase.MakeFilesPermanent(ctx, names, ownerID, returns_entity.PhotoOwnerType)
+}
+
+func (a *FileUsecaseAdapter) GetByOwner(ctx context.Context, ownerID string) ([]returns_entity.Photo, error) {
+	files, err := a.fileUsecase.GetByOwner(ctx, ownerID, returns_entity.PhotoOwnerType)
+	if err != nil {
+		return nil, err
+	}
+	return toPhotos(files), nil
+}
+
+func (a *FileUsecaseAdapter) GetByOwners(ctx context.Context, ownerIDs []string) (map[string][]returns_entity.Photo, error) {
+	filesByOwner, err := a.fileUsecase.GetByOwners(ctx, ownerIDs, returns_entity.PhotoOwnerType)
+	if err != nil {
+		return nil, err
+	}
+
+	photos := make(map[string][]returns_entity.Photo, len(filesByOwner))
+	for ownerID, files := range filesByOwner {
+		photos[ownerID] = toPhotos(files)
+	}
+	return photos, nil
+}
+
+func toPhotos(files []file_entity.File) []returns_entity.Photo {
+	photos := make([]returns_entity.Photo, len(files))
+	for i := range files {
+		photos[i] = returns_entity.Photo{ID: files[i].ID, Name: files[i].Name}
+	}
+	return photos
+}
@@ -0,0 +1,85 @@
+package returns_adapters
+
+import (
+	"context"
+	"errors"
+
+	order_entity "github.com/Fi44er/sdmed/internal/module/order/entity"
+	order_constant "github.com/Fi44er/sdmed/internal/module/order/pkg"
+	order_usecase "github.com/Fi44er/sdmed/internal/module/order/usecase/order"
+	returns_entity "github.com/Fi44er/sdmed/internal/module/returns/entity"
+)
+
+type IOrderUsecaseAdapter interface {
+	GetOwn(ctx context.Context, id string) (*returns_entity.Order, error)
+	GetByID(ctx context.Context, id string) (*returns_entity.Order, error)
+	AddHistoryNote(ctx context.Context, id string, actor returns_entity.Actor, comment string) error
+	MarkReturned(ctx context.Context, id, comment string) error
+}
+
+type OrderUsecaseAdapter struct {
+	orderUsecase order_usecase.IOrderUsecase
+}
+
+func NewOrderUsecaseAdapter(orderUsecase order_usecase.IOrderUsecase) IOrderUsecaseAdapter {
+	return &OrderUsecaseAdapter{
+		orderUsecase: orderUsecase,
+	}
+}
+
+// GetOwn возвращает заказ пользователя сессии
+func (a *OrderUsecaseAdapter) GetOwn(ctx context.Context, id string) (*returns_entity.Order, error) {
+	order, err := a.orderUsecase.GetOwnByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	return toReturnOrder(order), nil
+}
+
+func (a *OrderUsecaseAdapter) GetByID(ctx context.Context, id string) (*returns_entity.Order, error) {
+	order, err := a.orderUsecase.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	return toReturnOrder(order), nil
+}
+
+func (a *OrderUsecaseAdapter) AddHistoryNote(ctx context.Context, id string, actor returns_entity.Actor, comment string) error {
+	orderActor := order_entity.ActorManager
+	if actor == returns_entity.ActorCustomer {
+		orderActor = order_entity.ActorCustomer
+	}
+	return a.orderUsecase.AddHistoryNote(ctx, id, orderActor, comment)
+}
+
+// MarkReturned переводит заказ в статус returned. Если переход уже невозможен,
+// например заказ переведен вручную, статус не меняется
+func (a *OrderUsecaseAdapter) MarkReturned(ctx context.Context, id, comment string) error {
+	_, err := a.orderUsecase.ChangeStatus(ctx, id, order_entity.OrderStatusReturned, order_entity.ActorSystem, comment)
+	if errors.Is(err, order_constant.ErrInvalidTransition) || errors.Is(err, order_constant.ErrTransitionForbidden) {
+		return nil
+	}
+	return err
+}
+
+// toReturnOrder - вернуть можно только доставленный заказ
+func toReturnOrder(order *order_entity.Order) *returns_entity.Order {
+	items := make([]returns_entity.OrderItem, len(order.Items))
+	for i := range order.Items {
+		items[i] = returns_entity.OrderItem{
+			ID:         order.Items[i].ID,
+			ProductID:  order.Items[i].ProductID,
+			Name:       order.Items[i].Name,
+			Quantity:   order.Items[i].Quantity,
+			PaidAmount: order.Items[i].CardAmount(),
+		}
+	}
+
+	return &returns_entity.Order{
+		ID:         order.ID,
+		UserID:     order.UserID,
+		Status:     string(order.Status),
+		Returnable: order.Status == order_entity.OrderStatusDelivered,
+		Items:      items,
+	}
+}
@@ -0,0 +1,53 @@
+package returns_adapters
+
+import (
+	"context"
+
+	payment_entity "github.com/Fi44er/sdmed/internal/module/payment/entity"
+	payment_usecase "github.com/Fi44er/sdmed/internal/module/payment/usecase/payment"
+	returns_entity "github.com/Fi44er/sdmed/internal/module/returns/entity"
+	returns_constant "github.com/Fi44er/sdmed/internal/module/returns/pkg"
+)
+
+type IPaymentUsecaseAdapter interface {
+	Refund(ctx context.Context, orderID string, amount float64, items []returns_entity.Item) (string, error)
+}
+
+type PaymentUsecaseAdapter struct {
+	paymentUsecase payment_usecase.IPaymentUsecase
+}
+
+func NewPaymentUsecaseAdapter(paymentUsecase payment_usecase.IPaymentUsecase) IPaymentUsecaseAdapter {
+	return &PaymentUsecaseAdapter{
+		paymentUsecase: paymentUsecase,
+	}
+}
+
+// Refund возвращает amount по оплаченному платежу заказа, на котором достаточно
+// невозвращенных средств. Чек возврата формируется по позициям items. Возвращает идентификатор платежа
+func (a *PaymentUsecaseAdapter) Refund(ctx context.Context, orderID string, amount float64, items []returns_entity.Item) (string, error) {
+	payments, err := a.paymentUsecase.GetByOrderID(ctx, orderID)
+	if err != nil {
+		return "", err
+	}
+
+	refundItems := make([]payment_entity.RefundItem, len(items))
+	for i, item := range items {
+		refundItems[i] = payment_entity.RefundItem{
+			OrderItemID: item.OrderItemID,
+			Quantity:    item.Quantity,
+			Amount:      item.Amount,
+		}
+	}
+
+	for i := range payments {
+		if payments[i].Refundable() >= amount {
+			if _, err := a.paymentUsecase.Refund(ctx, payments[i].ID, amount, refundItems); err != nil {
+				return "", err
+			}
+			return payments[i].ID, nil
+		}
+	}
+
+	return "", returns_constant.ErrPaymentNotFound
+}
@@ -0,0 +1,35 @@
+package returns_adapters
+
+import (
+	"context"
+
+	returns_entity "github.com/Fi44er/sdmed/internal/module/returns/entity"
+	stock_entity "github.com/Fi44er/sdmed/internal/module/stock/entity"
+	stock_usecase "github.com/Fi44er/sdmed/internal/module/stock/usecase/stock"
+)
+
+type IStockUsecaseAdapter interface {
+	Restock(ctx context.Context, items []returns_entity.Item) error
+}
+
+type StockUsecaseAdapter struct {
+	stockUsecase stock_usecase.IStockUsecase
+}
+
+func NewStockUsecaseAdapter(stockUsecase stock_usecase.IStockUsecase) IStockUsecaseAdapter {
+	return &StockUsecaseAdapter{
+		stockUsecase: stockUsecase,
+	}
+}
+
+// Restock возвращает на склад позиции возврата
+func (a *StockUsecaseAdapter) Restock(ctx context.Context, items []returns_entity.Item) error {
+	restock := make([]stock_entity.ReserveItem, len(items))
+	for i := range items {
+		restock[i] = stock_entity.ReserveItem{
+			ProductID: items[i].ProductID,
+			Quantity:  items[i].Quantity,
+		}
+	}
+	return a.stockUsecase.Restock(ctx, restock)
+}
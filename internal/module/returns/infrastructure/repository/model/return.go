@@ -0,0 +1,36 @@
+package returns_model
+
+import "time"
+
+type Return struct {
+	ID      string `gorm:"primaryKey;type:uuid;default:uuid_generate_v4();"`
+	OrderID string `gorm:"type:uuid;not null;index"`
+	UserID  string `gorm:"type:uuid;not null;index"`
+	Status  string `gorm:"type:varchar(20);not null;index"`
+	Reason  string `gorm:"type:text;not null"`
+	Comment string `gorm:"type:text;not null;default:''"`
+	// PaymentID без внешнего ключа: платежи принадлежат модулю оплаты
+	PaymentID    *string      `gorm:"type:uuid"`
+	RefundAmount float64      `gorm:"type:float;not null;default:0"`
+	Items        []ReturnItem `gorm:"foreignKey:ReturnID;constraint:OnDelete:CASCADE"`
+	CreatedAt    time.Time    `gorm:"not null;default:now();index"`
+	UpdatedAt    time.Time    `gorm:"not null;default:now()"`
+}
+
+func (Return) TableName() string {
+	return "returns_module.returns"
+}
+
+type ReturnItem struct {
+	ID          string  `gorm:"primaryKey;type:uuid;default:uuid_generate_v4();"`
+	ReturnID    string  `gorm:"type:uuid;not null;index"`
+	OrderItemID string  `gorm:"type:uuid;not null;index"`
+	ProductID   string  `gorm:"type:uuid;not null"`
+	Name        string  `gorm:"type:varchar(255);not null"`
+	Quantity    int     `gorm:"not null"`
+	Amount      float64 `gorm:"type:float;not null;default:0"`
+}
+
+func (ReturnItem) TableName() string {
+	return "returns_module.return_items"
+}
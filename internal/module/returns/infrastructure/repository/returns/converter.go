@@ -0,0 +1,77 @@
+package returns_repository
+
+import (
+	returns_entity "github.com/Fi44er/sdmed/internal/module/returns/entity"
+	returns_model "github.com/Fi44er/sdmed/internal/module/returns/infrastructure/repository/model"
+)
+
+type Converter struct{}
+
+func (c *Converter) ToModel(entity *returns_entity.Return) *returns_model.Return {
+	items := make([]returns_model.ReturnItem, len(entity.Items))
+	for i := range entity.Items {
+		items[i] = returns_model.ReturnItem{
+			OrderItemID: entity.Items[i].OrderItemID,
+			ProductID:   entity.Items[i].ProductID,
+			Name:        entity.Items[i].Name,
+			Quantity:    entity.Items[i].Quantity,
+			Amount:      entity.Items[i].Amount,
+		}
+	}
+
+	return &returns_model.Return{
+		ID:           entity.ID,
+		OrderID:      entity.OrderID,
+		UserID:       entity.UserID,
+		Status:       string(entity.Status),
+		Reason:       entity.Reason,
+		Comment:      entity.Comment,
+		PaymentID:    optional(entity.PaymentID),
+		RefundAmount: entity.RefundAmount,
+		Items:        items,
+	}
+}
+
+func (c *Converter) ToEntity(model *returns_model.Return) *returns_entity.Return {
+	items := make([]returns_entity.Item, len(model.Items))
+	for i := range model.Items {
+		items[i] = returns_entity.Item{
+			ID:          model.Items[i].ID,
+			ReturnID:    model.Items[i].ReturnID,
+			OrderItemID: model.Items[i].OrderItemID,
+			ProductID:   model.Items[i].ProductID,
+			Name:        model.Items[i].Name,
+			Quantity:    model.Items[i].Quantity,
+			Amount:      model.Items[i].Amount,
+		}
+	}
+
+	return &returns_entity.Return{
+		ID:           model.ID,
+		OrderID:      model.OrderID,
+		UserID:       model.UserID,
+		Status:       returns_entity.ReturnStatus(model.Status),
+		Reason:       model.Reason,
+		Comment:      model.Comment,
+		Items:        items,
+		RefundAmount: model.RefundAmount,
+		PaymentID:    value(model.PaymentID),
+		CreatedAt:    model.CreatedAt,
+		UpdatedAt:    model.UpdatedAt,
+	}
+}
+
+// optional - пустая строка хранится как NULL
+func optional(value string) *string {
+	if value == "" {
+		return nil
+	}
+	return &value
+}
+
+func value(ptr *string) string {
+	if ptr == nil {
+		return ""
+	}
+	return *ptr
+}
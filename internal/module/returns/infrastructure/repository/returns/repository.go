@@ -0,0 +1,181 @@
+package returns_repository
+
+import (
+	"context"
+
+	returns_entity "github.com/Fi44er/sdmed/internal/module/returns/entity"
+	returns_model "github.com/Fi44er/sdmed/internal/module/returns/infrastructure/repository/model"
+	"github.com/Fi44er/sdmed/pkg/logger"
+	"gorm.io/gorm"
+)
+
+type IReturnRepository interface {
+	Create(ctx context.Context, ret *returns_entity.Return) error
+	GetByID(ctx context.Context, id string) (*returns_entity.Return, error)
+	GetAll(ctx context.Context, filter *returns_entity.ReturnFilter) ([]returns_entity.Return, error)
+	Count(ctx context.Context, filter *returns_entity.ReturnFilter) (int64, error)
+	GetReturnedQuantities(ctx context.Context, orderID string, statuses []returns_entity.ReturnStatus) (map[string]int, error)
+	UpdateStatus(ctx context.Context, id string, from, to returns_entity.ReturnStatus, comment string) (bool, error)
+	SetRefund(ctx context.Context, id string, amount float64, paymentID string) error
+}
+
+type ReturnRepository struct {
+	logger    *logger.Logger
+	db        *gorm.DB
+	converter *Converter
+}
+
+func NewReturnRepository(logger *logger.Logger, db *gorm.DB) IReturnRepository {
+	return &ReturnRepository{
+		logger:    logger,
+		db:        db,
+		converter: &Converter{},
+	}
+}
+
+func (r *ReturnRepository) Create(ctx context.Context, ret *returns_entity.Return) error {
+	r.logger.Infof("Creating return for order: %s", ret.OrderID)
+
+	returnModel := r.converter.ToModel(ret)
+	if err := r.db.WithContext(ctx).Create(returnModel).Error; err != nil {
+		r.logger.Errorf("Failed to create return for order %s: %v", ret.OrderID, err)
+		return err
+	}
+
+	created := r.converter.ToEntity(returnModel)
+	ret.ID = created.ID
+	ret.Items = created.Items
+	ret.CreatedAt = created.CreatedAt
+	ret.UpdatedAt = created.UpdatedAt
+
+	return nil
+}
+
+func (r *ReturnRepository) GetByID(ctx context.Context, id string) (*returns_entity.Return, error) {
+	var returnModel returns_model.Return
+	err := r.db.WithContext(ctx).Preload("Items").First(&returnModel, "id = ?", id).Error
+	if err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, nil
+		}
+		r.logger.Errorf("Failed to get return %s: %v", id, err)
+		return nil, err
+	}
+
+	return r.converter.ToEntity(&returnModel), nil
+}
+
+func (r *ReturnRepository) GetAll(ctx context.Context, filter *returns_entity.ReturnFilter) ([]returns_entity.Return, error) {
+	var returnModels []returns_model.Return
+	query := r.applyFilter(r.db.WithContext(ctx).Model(&returns_model.Return{}), filter)
+	err := query.Preload("Items").
+		Order("created_at DESC").
+		Offset(filter.Offset).
+		Limit(filter.Limit).
+		Find(&returnModels).Error
+	if err != nil {
+		r.logger.Errorf("Failed to get returns: %v", err)
+		return nil, err
+	}
+
+	returns := make([]returns_entity.Return, len(returnModels))
+	for i := range returnModels {
+		returns[i] = *r.converter.ToEntity(&returnModels[i])
+	}
+
+	return returns, nil
+}
+
+func (r *ReturnRepository) Count(ctx context.Context, filter *returns_entity.ReturnFilter) (int64, error) {
+	var count int64
+	query := r.applyFilter(r.db.WithContext(ctx).Model(&returns_model.Return{}), filter)
+	if err := query.Count(&count).Error; err != nil {
+		r.logger.Errorf("Failed to count returns: %v", err)
+		return 0, err
+	}
+
+	return count, nil
+}
+
+// GetReturnedQuantities возвращает количество единиц по позициям заказа в возвратах с указанными статусами
+func (r *ReturnRepository) GetReturnedQuantities(ctx context.Context, orderID string, statuses []returns_entity.ReturnStatus) (map[string]int, error) {
+	var rows []struct {
+		OrderItemID string
+		Quantity    int
+	}
+
+	values := make([]string, len(statuses))
+	for i := range statuses {
+		values[i] = string(statuses[i])
+	}
+
+	err := r.db.WithContext(ctx).
+		Model(&returns_model.ReturnItem{}).
+		Select("return_items.order_item_id, SUM(return_items.quantity) AS quantity").
+		Joins("JOIN returns_module.returns ON returns.id = return_items.return_id").
+		Where("returns.order_id = ? AND returns.status IN ?", orderID, values).
+		Group("return_items.order_item_id").
+		Scan(&rows).Error
+	if err != nil {
+		r.logger.Errorf("Failed to get returned quantities of order %s: %v", orderID, err)
+		return nil, err
+	}
+
+	quantities := make(map[string]int, len(rows))
+	for _, row := range rows {
+		quantities[row.OrderItemID] = row.Quantity
+	}
+
+	return quantities, nil
+}
+
+// UpdateStatus меняет статус, только если возврат все еще в статусе from.
+// Возвращает false, если статус успели изменить
+func (r *ReturnRepository) UpdateStatus(ctx context.Context, id string, from, to returns_entity.ReturnStatus, comment string) (bool, error) {
+	r.logger.Infof("Updating return %s status: %s -> %s", id, from, to)
+
+	result := r.db.WithContext(ctx).
+		Model(&returns_model.Return{}).
+		Where("id = ? AND status = ?", id, string(from)).
+		Updates(map[string]any{
+			"status":     string(to),
+			"comment":    comment,
+			"updated_at": gorm.Expr("now()"),
+		})
+	if result.Error != nil {
+		r.logger.Errorf("Failed to update return %s status: %v", id, result.Error)
+		return false, result.Error
+	}
+
+	return result.RowsAffected > 0, nil
+}
+
+func (r *ReturnRepository) SetRefund(ctx context.Context, id string, amount float64, paymentID string) error {
+	err := r.db.WithContext(ctx).
+		Model(&returns_model.Return{}).
+		Where("id = ?", id).
+		Updates(map[string]any{
+			"refund_amount": amount,
+			"payment_id":    optional(paymentID),
+			"updated_at":    gorm.Expr("now()"),
+		}).Error
+	if err != nil {
+		r.logger.Errorf("Failed to save refund of return %s: %v", id, err)
+		return err
+	}
+
+	return nil
+}
+
+func (r *ReturnRepository) applyFilter(query *gorm.DB, filter *returns_entity.ReturnFilter) *gorm.DB {
+	if filter.Status != "" {
+		query = query.Where("status = ?", string(filter.Status))
+	}
+	if filter.OrderID != "" {
+		query = query.Where("order_id = ?", filter.OrderID)
+	}
+	if filter.UserID != "" {
+		query = query.Where("user_id = ?", filter.UserID)
+	}
+	return query
+}
@@ -0,0 +1,90 @@
+package returns_module
+
+import (
+	"github.com/Fi44er/sdmed/internal/config"
+	file_usecase "github.com/Fi44er/sdmed/internal/module/file/usecase/file"
+	order_usecase "github.com/Fi44er/sdmed/internal/module/order/usecase/order"
+	payment_usecase "github.com/Fi44er/sdmed/internal/module/payment/usecase/payment"
+	returns_http "github.com/Fi44er/sdmed/internal/module/returns/delivery/http"
+	returns_adapters "github.com/Fi44er/sdmed/internal/module/returns/infrastructure/adapters"
+	returns_repository "github.com/Fi44er/sdmed/internal/module/returns/infrastructure/repository/returns"
+	returns_usecase "github.com/Fi44er/sdmed/internal/module/returns/usecase/returns"
+	returns_usecase_contracts "github.com/Fi44er/sdmed/internal/module/returns/usecase/returns/contracts"
+	stock_usecase "github.com/Fi44er/sdmed/internal/module/stock/usecase/stock"
+	"github.com/Fi44er/sdmed/pkg/logger"
+	"github.com/Fi44er/sdmed/pkg/postgres/uow"
+	"github.com/go-playground/validator/v10"
+	"github.com/gofiber/fiber/v2"
+	"gorm.io/gorm"
+)
+
+type ReturnsModule struct {
+	returnRepository returns_repository.IReturnRepository
+	returnUsecase    returns_usecase.IReturnUsecase
+	returnHandler    *returns_http.ReturnHandler
+
+	sessionRepository returns_usecase_contracts.ISessionRepository
+	orderUsecase      order_usecase.IOrderUsecase
+	paymentUsecase    payment_usecase.IPaymentUsecase
+	stockUsecase      stock_usecase.IStockUsecase
+	fileUsecase       file_usecase.IFileUsecase
+
+	logger    *logger.Logger
+	validator *validator.Validate
+	db        *gorm.DB
+	uow       uow.Uow
+	config    *config.Config
+}
+
+func NewReturnsModule(
+	logger *logger.Logger,
+	validator *validator.Validate,
+	db *gorm.DB,
+	uow uow.Uow,
+	config *config.Config,
+	sessionRepository returns_usecase_contracts.ISessionRepository,
+	orderUsecase order_usecase.IOrderUsecase,
+	paymentUsecase payment_usecase.IPaymentUsecase,
+	stockUsecase stock_usecase.IStockUsecase,
+	fileUsecase file_usecase.IFileUsecase,
+) *ReturnsModule {
+	return &ReturnsModule{
+		logger:            logger,
+		validator:         validator,
+		db:                db,
+		uow:               uow,
+		config:            config,
+		sessionRepository: sessionRepository,
+		orderUsecase:      orderUsecase,
+		paymentUsecase:    paymentUsecase,
+		stockUsecase:      stockUsecase,
+		fileUsecase:       fileUsecase,
+	}
+}
+
+func (m *ReturnsModule) Init() {
+	m.uow.RegisterRepository("returns", func(tx *gorm.DB) (any, error) {
+		return returns_repository.NewReturnRepository(m.logger, tx), nil
+	})
+
+	m.returnRepository = returns_repository.NewReturnRepository(m.logger, m.db)
+	m.returnUsecase = returns_usecase.NewReturnUsecase(
+		m.returnRepository,
+		m.sessionRepository,
+		returns_adapters.NewOrderUsecaseAdapter(m.orderUsecase),
+		returns_adapters.NewPaymentUsecaseAdapter(m.paymentUsecase),
+		returns_adapters.NewStockUsecaseAdapter(m.stockUsecase),
+		returns_adapters.NewFileUsecaseAdapter(m.fileUsecase),
+		m.uow,
+		m.logger,
+	)
+	m.returnHandler = returns_http.NewReturnHandler(m.returnUsecase, m.validator, m.logger, m.config)
+}
+
+func (m *ReturnsModule) InitDelivery(router fiber.Router) {
+	m.returnHandler.RegisterRoutes(router)
+}
+
+func (m *ReturnsModule) GetReturnUsecase() returns_usecase.IReturnUsecase {
+	return m.returnUsecase
+}
@@ -0,0 +1,18 @@
+package returns_constant
+
+import "github.com/Fi44er/sdmed/pkg/customerr"
+
+var (
+	ErrReturnNotFound      = customerr.NewError(404, "return not found")
+	ErrOrderNotReturnable  = customerr.NewError(409, "order can be returned only after delivery")
+	ErrOrderItemNotFound   = customerr.NewError(404, "order item not found")
+	ErrDuplicateItem       = customerr.NewError(400, "order item is listed more than once")
+	ErrQuantityExceeded    = customerr.NewError(400, "return quantity exceeds the quantity left to return")
+	ErrInvalidStatus       = customerr.NewError(400, "invalid return status")
+	ErrInvalidTransition   = customerr.NewError(409, "return status transition is not allowed")
+	ErrStatusChanged       = customerr.NewError(409, "return status was changed concurrently")
+	ErrRefundAmountInvalid = customerr.NewError(400, "refund amount exceeds the amount paid for the returned items")
+	ErrPaymentNotFound     = customerr.NewError(409, "no card payment to refund")
+	ErrRefundAmountChanged = customerr.NewError(409, "refund is already started with another amount")
+	ErrSessionUserNotFound = customerr.NewError(401, "session user not found")
+)
@@ -0,0 +1,45 @@
+package returns_usecase_contracts
+
+import (
+	"context"
+
+	auth_entity "github.com/Fi44er/sdmed/internal/module/auth/entity"
+	returns_entity "github.com/Fi44er/sdmed/internal/module/returns/entity"
+)
+
+type IReturnRepository interface {
+	Create(ctx context.Context, ret *returns_entity.Return) error
+	GetByID(ctx context.Context, id string) (*returns_entity.Return, error)
+	GetAll(ctx context.Context, filter *returns_entity.ReturnFilter) ([]returns_entity.Return, error)
+	Count(ctx context.Context, filter *returns_entity.ReturnFilter) (int64, error)
+	GetReturnedQuantities(ctx context.Context, orderID string, statuses []returns_entity.ReturnStatus) (map[string]int, error)
+	UpdateStatus(ctx context.Context, id string, from, to returns_entity.ReturnStatus, comment string) (bool, error)
+	SetRefund(ctx context.Context, id string, amount float64, paymentID string) error
+}
+
+type ISessionRepository interface {
+	GetSessionInfo(ctx context.Context) (*auth_entity.ActiveSession, error)
+}
+
+type IOrderUsecaseAdapter interface {
+	GetOwn(ctx context.Context, id string) (*returns_entity.Order, error)
+	GetByID(ctx context.Context, id string) (*returns_entity.Order, error)
+	AddHistoryNote(ctx context.Context, id string, actor returns_entity.Actor, comment string) error
+	MarkReturned(ctx context.Context, id, comment string) error
+}
+
+// IPaymentUsecaseAdapter возвращает деньги через платежный шлюз, которым оплачен заказ
+type IPaymentUsecaseAdapter interface {
+	Refund(ctx context.Context, orderID string, amount float64, items []returns_entity.Item) (string, error)
+}
+
+// IStockUsecaseAdapter возвращает полученный товар в остаток склада
+type IStockUsecaseAdapter interface {
+	Restock(ctx context.Context, items []returns_entity.Item) error
+}
+
+type IFileUsecaseAdapter interface {
+	MakeFilesPermanent(ctx context.Context, names []string, ownerID string) error
+	GetByOwner(ctx context.Context, ownerID string) ([]returns_entity.Photo, error)
+	GetByOwners(ctx context.Context, ownerIDs []string) (map[string][]returns_entity.Photo, error)
+}
@@ -0,0 +1,401 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./returns/contracts/contracts.go
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	context "context"
+	reflect "reflect"
+
+	auth_entity "github.com/Fi44er/sdmed/internal/module/auth/entity"
+	returns_entity "github.com/Fi44er/sdmed/internal/module/returns/entity"
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockIReturnRepository is a mock of IReturnRepository interface.
+type MockIReturnRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockIReturnRepositoryMockRecorder
+}
+
+// MockIReturnRepositoryMockRecorder is the mock recorder for MockIReturnRepository.
+type MockIReturnRepositoryMockRecorder struct {
+	mock *MockIReturnRepository
+}
+
+// NewMockIReturnRepository creates a new mock instance.
+func NewMockIReturnRepository(ctrl *gomock.Controller) *MockIReturnRepository {
+	mock := &MockIReturnRepository{ctrl: ctrl}
+	mock.recorder = &MockIReturnRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockIReturnRepository) EXPECT() *MockIReturnRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Count mocks base method.
+func (m *MockIReturnRepository) Count(ctx context.Context, filter *returns_entity.ReturnFilter) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Count", ctx, filter)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Count indicates an expected call of Count.
+func (mr *MockIReturnRepositoryMockRecorder) Count(ctx, filter interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Count", reflect.TypeOf((*MockIReturnRepository)(nil).Count), ctx, filter)
+}
+
+// Create mocks base method.
+func (m *MockIReturnRepository) Create(ctx context.Context, ret *returns_entity.Return) error {
+	m.ctrl.T.Helper()
+	ret_2 := m.ctrl.Call(m, "Create", ctx, ret)
+	ret0, _ := ret_2[0].(error)
+	return ret0
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockIReturnRepositoryMockRecorder) Create(ctx, ret interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockIReturnRepository)(nil).Create), ctx, ret)
+}
+
+// GetAll mocks base method.
+func (m *MockIReturnRepository) GetAll(ctx context.Context, filter *returns_entity.ReturnFilter) ([]returns_entity.Return, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAll", ctx, filter)
+	ret0, _ := ret[0].([]returns_entity.Return)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAll indicates an expected call of GetAll.
+func (mr *MockIReturnRepositoryMockRecorder) GetAll(ctx, filter interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAll", reflect.TypeOf((*MockIReturnRepository)(nil).GetAll), ctx, filter)
+}
+
+// GetByID mocks base method.
+func (m *MockIReturnRepository) GetByID(ctx context.Context, id string) (*returns_entity.Return, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByID", ctx, id)
+	ret0, _ := ret[0].(*returns_entity.Return)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByID indicates an expected call of GetByID.
+func (mr *MockIReturnRepositoryMockRecorder) GetByID(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByID", reflect.TypeOf((*MockIReturnRepository)(nil).GetByID), ctx, id)
+}
+
+// GetReturnedQuantities mocks base method.
+func (m *MockIReturnRepository) GetReturnedQuantities(ctx context.Context, orderID string, statuses []returns_entity.ReturnStatus) (map[string]int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetReturnedQuantities", ctx, orderID, statuses)
+	ret0, _ := ret[0].(map[string]int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetReturnedQuantities indicates an expected call of GetReturnedQuantities.
+func (mr *MockIReturnRepositoryMockRecorder) GetReturnedQuantities(ctx, orderID, statuses interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetReturnedQuantities", reflect.TypeOf((*MockIReturnRepository)(nil).GetReturnedQuantities), ctx, orderID, statuses)
+}
+
+// SetRefund mocks base method.
+func (m *MockIReturnRepository) SetRefund(ctx context.Context, id string, amount float64, paymentID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetRefund", ctx, id, amount, paymentID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetRefund indicates an expected call of SetRefund.
+func (mr *MockIReturnRepositoryMockRecorder) SetRefund(ctx, id, amount, paymentID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetRefund", reflect.TypeOf((*MockIReturnRepository)(nil).SetRefund), ctx, id, amount, paymentID)
+}
+
+// UpdateStatus mocks base method.
+func (m *MockIReturnRepository) UpdateStatus(ctx context.Context, id string, from, to returns_entity.ReturnStatus, comment string) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateStatus", ctx, id, from, to, comment)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateStatus indicates an expected call of UpdateStatus.
+func (mr *MockIReturnRepositoryMockRecorder) UpdateStatus(ctx, id, from, to, comment interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateStatus", reflect.TypeOf((*MockIReturnRepository)(nil).UpdateStatus), ctx, id, from, to, comment)
+}
+
+// MockISessionRepository is a mock of ISessionRepository interface.
+type MockISessionRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockISessionRepositoryMockRecorder
+}
+
+// MockISessionRepositoryMockRecorder is the mock recorder for MockISessionRepository.
+type MockISessionRepositoryMockRecorder struct {
+	mock *MockISessionRepository
+}
+
+// NewMockISessionRepository creates a new mock instance.
+func NewMockISessionRepository(ctrl *gomock.Controller) *MockISessionRepository {
+	mock := &MockISessionRepository{ctrl: ctrl}
+	mock.recorder = &MockISessionRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockISessionRepository) EXPECT() *MockISessionRepositoryMockRecorder {
+	return m.recorder
+}
+
+// GetSessionInfo mocks base method.
+func (m *MockISessionRepository) GetSessionInfo(ctx context.Context) (*auth_entity.ActiveSession, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSessionInfo", ctx)
+	ret0, _ := ret[0].(*auth_entity.ActiveSession)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSessionInfo indicates an expected call of GetSessionInfo.
+func (mr *MockISessionRepositoryMockRecorder) GetSessionInfo(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSessionInfo", reflect.TypeOf((*MockISessionRepository)(nil).GetSessionInfo), ctx)
+}
+
+// MockIOrderUsecaseAdapter is a mock of IOrderUsecaseAdapter interface.
+type MockIOrderUsecaseAdapter struct {
+	ctrl     *gomock.Controller
+	recorder *MockIOrderUsecaseAdapterMockRecorder
+}
+
+// MockIOrderUsecaseAdapterMockRecorder is the mock recorder for MockIOrderUsecaseAdapter.
+type MockIOrderUsecaseAdapterMockRecorder struct {
+	mock *MockIOrderUsecaseAdapter
+}
+
+// NewMockIOrderUsecaseAdapter creates a new mock instance.
+func NewMockIOrderUsecaseAdapter(ctrl *gomock.Controller) *MockIOrderUsecaseAdapter {
+	mock := &MockIOrderUsecaseAdapter{ctrl: ctrl}
+	mock.recorder = &MockIOrderUsecaseAdapterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockIOrderUsecaseAdapter) EXPECT() *MockIOrderUsecaseAdapterMockRecorder {
+	return m.recorder
+}
+
+// AddHistoryNote mocks base method.
+func (m *MockIOrderUsecaseAdapter) AddHistoryNote(ctx context.Context, id string, actor returns_entity.Actor, comment string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddHistoryNote", ctx, id, actor, comment)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddHistoryNote indicates an expected call of AddHistoryNote.
+func (mr *MockIOrderUsecaseAdapterMockRecorder) AddHistoryNote(ctx, id, actor, comment interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddHistoryNote", reflect.TypeOf((*MockIOrderUsecaseAdapter)(nil).AddHistoryNote), ctx, id, actor, comment)
+}
+
+// GetByID mocks base method.
+func (m *MockIOrderUsecaseAdapter) GetByID(ctx context.Context, id string) (*returns_entity.Order, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByID", ctx, id)
+	ret0, _ := ret[0].(*returns_entity.Order)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByID indicates an expected call of GetByID.
+func (mr *MockIOrderUsecaseAdapterMockRecorder) GetByID(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByID", reflect.TypeOf((*MockIOrderUsecaseAdapter)(nil).GetByID), ctx, id)
+}
+
+// GetOwn mocks base method.
+func (m *MockIOrderUsecaseAdapter) GetOwn(ctx context.Context, id string) (*returns_entity.Order, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetOwn", ctx, id)
+	ret0, _ := ret[0].(*returns_entity.Order)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetOwn indicates an expected call of GetOwn.
+func (mr *MockIOrderUsecaseAdapterMockRecorder) GetOwn(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOwn", reflect.TypeOf((*MockIOrderUsecaseAdapter)(nil).GetOwn), ctx, id)
+}
+
+// MarkReturned mocks base method.
+func (m *MockIOrderUsecaseAdapter) MarkReturned(ctx context.Context, id, comment string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MarkReturned", ctx, id, comment)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// MarkReturned indicates an expected call of MarkReturned.
+func (mr *MockIOrderUsecaseAdapterMockRecorder) MarkReturned(ctx, id, comment interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkReturned", reflect.TypeOf((*MockIOrderUsecaseAdapter)(nil).MarkReturned), ctx, id, comment)
+}
+
+// MockIPaymentUsecaseAdapter is a mock of IPaymentUsecaseAdapter interface.
+type MockIPaymentUsecaseAdapter struct {
+	ctrl     *gomock.Controller
+	recorder *MockIPaymentUsecaseAdapterMockRecorder
+}
+
+// MockIPaymentUsecaseAdapterMockRecorder is the mock recorder for MockIPaymentUsecaseAdapter.
+type MockIPaymentUsecaseAdapterMockRecorder struct {
+	mock *MockIPaymentUsecaseAdapter
+}
+
+// NewMockIPaymentUsecaseAdapter creates a new mock instance.
+func NewMockIPaymentUsecaseAdapter(ctrl *gomock.Controller) *MockIPaymentUsecaseAdapter {
+	mock := &MockIPaymentUsecaseAdapter{ctrl: ctrl}
+	mock.recorder = &MockIPaymentUsecaseAdapterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockIPaymentUsecaseAdapter) EXPECT() *MockIPaymentUsecaseAdapterMockRecorder {
+	return m.recorder
+}
+
+// Refund mocks base method.
+func (m *MockIPaymentUsecaseAdapter) Refund(ctx context.Context, orderID string, amount float64, items []returns_entity.Item) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Refund", ctx, orderID, amount, items)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Refund indicates an expected call of Refund.
+func (mr *MockIPaymentUsecaseAdapterMockRecorder) Refund(ctx, orderID, amount, items interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Refund", reflect.TypeOf((*MockIPaymentUsecaseAdapter)(nil).Refund), ctx, orderID, amount, items)
+}
+
+// MockIStockUsecaseAdapter is a mock of IStockUsecaseAdapter interface.
+type MockIStockUsecaseAdapter struct {
+	ctrl     *gomock.Controller
+	recorder *MockIStockUsecaseAdapterMockRecorder
+}
+
+// MockIStockUsecaseAdapterMockRecorder is the mock recorder for MockIStockUsecaseAdapter.
+type MockIStockUsecaseAdapterMockRecorder struct {
+	mock *MockIStockUsecaseAdapter
+}
+
+// NewMockIStockUsecaseAdapter creates a new mock instance.
+func NewMockIStockUsecaseAdapter(ctrl *gomock.Controller) *MockIStockUsecaseAdapter {
+	mock := &MockIStockUsecaseAdapter{ctrl: ctrl}
+	mock.recorder = &MockIStockUsecaseAdapterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockIStockUsecaseAdapter) EXPECT() *MockIStockUsecaseAdapterMockRecorder {
+	return m.recorder
+}
+
+// Restock mocks base method.
+func (m *MockIStockUsecaseAdapter) Restock(ctx context.Context, items []returns_entity.Item) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Restock", ctx, items)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Restock indicates an expected call of Restock.
+func (mr *MockIStockUsecaseAdapterMockRecorder) Restock(ctx, items interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Restock", reflect.TypeOf((*MockIStockUsecaseAdapter)(nil).Restock), ctx, items)
+}
+
+// MockIFileUsecaseAdapter is a mock of IFileUsecaseAdapter interface.
+type MockIFileUsecaseAdapter struct {
+	ctrl     *gomock.Controller
+	recorder *MockIFileUsecaseAdapterMockRecorder
+}
+
+// MockIFileUsecaseAdapterMockRecorder is the mock recorder for MockIFileUsecaseAdapter.
+type MockIFileUsecaseAdapterMockRecorder struct {
+	mock *MockIFileUsecaseAdapter
+}
+
+// NewMockIFileUsecaseAdapter creates a new mock instance.
+func NewMockIFileUsecaseAdapter(ctrl *gomock.Controller) *MockIFileUsecaseAdapter {
+	mock := &MockIFileUsecaseAdapter{ctrl: ctrl}
+	mock.recorder = &MockIFileUsecaseAdapterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockIFileUsecaseAdapter) EXPECT() *MockIFileUsecaseAdapterMockRecorder {
+	return m.recorder
+}
+
+// GetByOwner mocks base method.
+func (m *MockIFileUsecaseAdapter) GetByOwner(ctx context.Context, ownerID string) ([]returns_entity.Photo, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByOwner", ctx, ownerID)
+	ret0, _ := ret[0].([]returns_entity.Photo)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByOwner indicates an expected call of GetByOwner.
+func (mr *MockIFileUsecaseAdapterMockRecorder) GetByOwner(ctx, ownerID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByOwner", reflect.TypeOf((*MockIFileUsecaseAdapter)(nil).GetByOwner), ctx, ownerID)
+}
+
+// GetByOwners mocks base method.
+func (m *MockIFileUsecaseAdapter) GetByOwners(ctx context.Context, ownerIDs []string) (map[string][]returns_entity.Photo, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByOwners", ctx, ownerIDs)
+	ret0, _ := ret[0].(map[string][]returns_entity.Photo)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByOwners indicates an expected call of GetByOwners.
+func (mr *MockIFileUsecaseAdapterMockRecorder) GetByOwners(ctx, ownerIDs interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByOwners", reflect.TypeOf((*MockIFileUsecaseAdapter)(nil).GetByOwners), ctx, ownerIDs)
+}
+
+// MakeFilesPermanent mocks base method.
+func (m *MockIFileUsecaseAdapter) MakeFilesPermanent(ctx context.Context, names []string, ownerID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MakeFilesPermanent", ctx, names, ownerID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// MakeFilesPermanent indicates an expected call of MakeFilesPermanent.
+func (mr *MockIFileUsecaseAdapterMockRecorder) MakeFilesPermanent(ctx, names, ownerID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MakeFilesPermanent", reflect.TypeOf((*MockIFileUsecaseAdapter)(nil).MakeFilesPermanent), ctx, names, ownerID)
+}
@@ -0,0 +1,171 @@
+package returns_testcases
+
+import (
+	"context"
+	"errors"
+
+	auth_entity "github.com/Fi44er/sdmed/internal/module/auth/entity"
+	returns_entity "github.com/Fi44er/sdmed/internal/module/returns/entity"
+	returns_constant "github.com/Fi44er/sdmed/internal/module/returns/pkg"
+	"github.com/Fi44er/sdmed/internal/module/returns/usecase/returns/mock"
+	uow_mock "github.com/Fi44er/sdmed/pkg/postgres/uow/mock"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+type MockCreate struct {
+	Ctrl            *gomock.Controller
+	Ctx             context.Context
+	RepoMock        *mock.MockIReturnRepository
+	SessionRepoMock *mock.MockISessionRepository
+	OrderMock       *mock.MockIOrderUsecaseAdapter
+	FileMock        *mock.MockIFileUsecaseAdapter
+	UowMock         *uow_mock.MockUow
+	T               assert.TestingT
+}
+
+type CreateTestCase struct {
+	Name           string
+	Return         *returns_entity.Return
+	SetupMocks     func(m *MockCreate)
+	ExpectedReturn *returns_entity.Return
+	ExpectedError  error
+}
+
+const (
+	UserID   = "user"
+	OrderID  = "order-1"
+	ReturnID = "4e1d2c3b-5a69-4788-9c0b-1a2b3c4d5e6f"
+)
+
+var photos = []returns_entity.Photo{{ID: "photo-1", Name: "photo.jpg"}}
+
+// order - доставленный заказ: две трости, оплаченные картой, и ходунки, оплаченные сертификатом
+func order() *returns_entity.Order {
+	return &returns_entity.Order{
+		ID:         OrderID,
+		UserID:     UserID,
+		Returnable: true,
+		Items: []returns_entity.OrderItem{
+			{ID: "item-1", ProductID: "product-1", Name: "Трость опорная", Quantity: 2, PaidAmount: 1980},
+			{ID: "item-2", ProductID: "product-2", Name: "Ходунки", Quantity: 1, PaidAmount: 0},
+		},
+	}
+}
+
+func request(items ...returns_entity.Item) *returns_entity.Return {
+	return &returns_entity.Return{
+		OrderID: OrderID,
+		Reason:  "Брак",
+		Items:   items,
+		Photos:  []returns_entity.Photo{{Name: "photo.jpg"}},
+	}
+}
+
+func expectTx(ctx context.Context, uowMock *uow_mock.MockUow, repo any, repositoryCalls int) {
+	uowMock.EXPECT().
+		Do(ctx, gomock.Any()).
+		DoAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+			return fn(ctx)
+		})
+	uowMock.EXPECT().GetRepository(ctx, "returns").Return(repo, nil).Times(repositoryCalls)
+}
+
+func expectOpening(m *MockCreate, returned map[string]int) {
+	m.SessionRepoMock.EXPECT().GetSessionInfo(m.Ctx).Return(&auth_entity.ActiveSession{UserID: UserID}, nil)
+	m.OrderMock.EXPECT().GetOwn(m.Ctx, OrderID).Return(order(), nil)
+	expectTx(m.Ctx, m.UowMock, m.RepoMock, 1)
+	m.RepoMock.EXPECT().GetReturnedQuantities(m.Ctx, OrderID, gomock.Any()).Return(returned, nil)
+}
+
+func GetCreateTestCases() []CreateTestCase {
+	opened := &returns_entity.Return{
+		ID:           ReturnID,
+		OrderID:      OrderID,
+		UserID:       UserID,
+		Status:       returns_entity.ReturnStatusRequested,
+		Reason:       "Брак",
+		Items:        []returns_entity.Item{{OrderItemID: "item-1", ProductID: "product-1", Name: "Трость опорная", Quantity: 1, Amount: 990}},
+		Photos:       photos,
+		RefundAmount: 990,
+	}
+
+	return []CreateTestCase{
+		{
+			Name:   "return_opened",
+			Return: request(returns_entity.Item{OrderItemID: "item-1", Quantity: 1}),
+			SetupMocks: func(m *MockCreate) {
+				expectOpening(m, map[string]int{})
+				m.RepoMock.EXPECT().
+					Create(m.Ctx, gomock.Any()).
+					DoAndReturn(func(ctx context.Context, ret *returns_entity.Return) error {
+						assert.Equal(m.T, UserID, ret.UserID)
+						assert.Equal(m.T, returns_entity.ReturnStatusRequested, ret.Status)
+						assert.Equal(m.T, "Трость опорная", ret.Items[0].Name)
+						assert.Equal(m.T, 990.0, ret.RefundAmount)
+						ret.ID = ReturnID
+						return nil
+					})
+				m.FileMock.EXPECT().MakeFilesPermanent(m.Ctx, []string{"photo.jpg"}, ReturnID).Return(nil)
+				m.OrderMock.EXPECT().
+					AddHistoryNote(m.Ctx, OrderID, returns_entity.ActorCustomer, gomock.Any()).
+					DoAndReturn(func(ctx context.Context, id string, actor returns_entity.Actor, comment string) error {
+						assert.Contains(m.T, comment, "Открыта заявка на возврат 4E1D2C3B")
+						return nil
+					})
+
+				stored := *opened
+				stored.Photos = nil
+				m.RepoMock.EXPECT().GetByID(m.Ctx, ReturnID).Return(&stored, nil)
+				m.FileMock.EXPECT().GetByOwner(m.Ctx, ReturnID).Return(photos, nil)
+			},
+			ExpectedReturn: opened,
+		},
+		{
+			Name:   "quantity_left_after_active_returns",
+			Return: request(returns_entity.Item{OrderItemID: "item-1", Quantity: 2}),
+			SetupMocks: func(m *MockCreate) {
+				expectOpening(m, map[string]int{"item-1": 1})
+			},
+			ExpectedError: returns_constant.ErrQuantityExceeded,
+		},
+		{
+			Name: "duplicate_item",
+			Return: request(
+				returns_entity.Item{OrderItemID: "item-2", Quantity: 1},
+				returns_entity.Item{OrderItemID: "item-2", Quantity: 1},
+			),
+			SetupMocks: func(m *MockCreate) {
+				expectOpening(m, map[string]int{})
+			},
+			ExpectedError: returns_constant.ErrDuplicateItem,
+		},
+		{
+			Name:   "unknown_item",
+			Return: request(returns_entity.Item{OrderItemID: "item-3", Quantity: 1}),
+			SetupMocks: func(m *MockCreate) {
+				expectOpening(m, map[string]int{})
+			},
+			ExpectedError: returns_constant.ErrOrderItemNotFound,
+		},
+		{
+			Name:   "order_not_delivered",
+			Return: request(returns_entity.Item{OrderItemID: "item-2", Quantity: 1}),
+			SetupMocks: func(m *MockCreate) {
+				notDelivered := order()
+				notDelivered.Returnable = false
+				m.SessionRepoMock.EXPECT().GetSessionInfo(m.Ctx).Return(&auth_entity.ActiveSession{UserID: UserID}, nil)
+				m.OrderMock.EXPECT().GetOwn(m.Ctx, OrderID).Return(notDelivered, nil)
+			},
+			ExpectedError: returns_constant.ErrOrderNotReturnable,
+		},
+		{
+			Name:   "no_session_user",
+			Return: request(returns_entity.Item{OrderItemID: "item-2", Quantity: 1}),
+			SetupMocks: func(m *MockCreate) {
+				m.SessionRepoMock.EXPECT().GetSessionInfo(m.Ctx).Return(nil, errors.New("session not found"))
+			},
+			ExpectedError: returns_constant.ErrSessionUserNotFound,
+		},
+	}
+}
@@ -0,0 +1,115 @@
+package returns_testcases
+
+import (
+	"context"
+	"errors"
+
+	returns_entity "github.com/Fi44er/sdmed/internal/module/returns/entity"
+	returns_constant "github.com/Fi44er/sdmed/internal/module/returns/pkg"
+	"github.com/Fi44er/sdmed/internal/module/returns/usecase/returns/mock"
+	uow_mock "github.com/Fi44er/sdmed/pkg/postgres/uow/mock"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+type MockReceive struct {
+	Ctrl      *gomock.Controller
+	Ctx       context.Context
+	RepoMock  *mock.MockIReturnRepository
+	OrderMock *mock.MockIOrderUsecaseAdapter
+	StockMock *mock.MockIStockUsecaseAdapter
+	FileMock  *mock.MockIFileUsecaseAdapter
+	UowMock   *uow_mock.MockUow
+	T         assert.TestingT
+}
+
+type ReceiveTestCase struct {
+	Name           string
+	Comment        string
+	SetupMocks     func(m *MockReceive)
+	ExpectedStatus returns_entity.ReturnStatus
+	ExpectedError  error
+}
+
+var errStock = errors.New("stock is unavailable")
+
+// stored - заявка на возврат обеих тростей и ходунков в статусе status
+// returnedItems - позиции заявки: трость оплачена картой, ходунки сертификатом
+func returnedItems() []returns_entity.Item {
+	return []returns_entity.Item{
+		{OrderItemID: "item-1", ProductID: "product-1", Name: "Трость опорная", Quantity: 2, Amount: 1980},
+		{OrderItemID: "item-2", ProductID: "product-2", Name: "Ходунки", Quantity: 1},
+	}
+}
+
+func stored(status returns_entity.ReturnStatus, refundAmount float64, paymentID string) *returns_entity.Return {
+	return &returns_entity.Return{
+		ID:           ReturnID,
+		OrderID:      OrderID,
+		UserID:       UserID,
+		Status:       status,
+		Reason:       "Брак",
+		Items:        returnedItems(),
+		RefundAmount: refundAmount,
+		PaymentID:    paymentID,
+	}
+}
+
+func GetReceiveTestCases() []ReceiveTestCase {
+	return []ReceiveTestCase{
+		{
+			Name:    "received_items_restocked",
+			Comment: "Упаковка вскрыта",
+			SetupMocks: func(m *MockReceive) {
+				expectTx(m.Ctx, m.UowMock, m.RepoMock, 1)
+				m.RepoMock.EXPECT().GetByID(m.Ctx, ReturnID).Return(stored(returns_entity.ReturnStatusApproved, 1980, ""), nil)
+				m.RepoMock.EXPECT().
+					UpdateStatus(m.Ctx, ReturnID, returns_entity.ReturnStatusApproved, returns_entity.ReturnStatusReceived, "Упаковка вскрыта").
+					Return(true, nil)
+				m.StockMock.EXPECT().Restock(m.Ctx, stored(returns_entity.ReturnStatusApproved, 0, "").Items).Return(nil)
+				m.OrderMock.EXPECT().
+					AddHistoryNote(m.Ctx, OrderID, returns_entity.ActorManager, "Товар по возврату 4E1D2C3B получен на склад: Упаковка вскрыта").
+					Return(nil)
+
+				m.RepoMock.EXPECT().GetByID(m.Ctx, ReturnID).Return(stored(returns_entity.ReturnStatusReceived, 1980, ""), nil)
+				m.FileMock.EXPECT().GetByOwner(m.Ctx, ReturnID).Return(photos, nil)
+			},
+			ExpectedStatus: returns_entity.ReturnStatusReceived,
+		},
+		{
+			Name: "restock_error_keeps_return_approved",
+			SetupMocks: func(m *MockReceive) {
+				expectTx(m.Ctx, m.UowMock, m.RepoMock, 1)
+				m.RepoMock.EXPECT().GetByID(m.Ctx, ReturnID).Return(stored(returns_entity.ReturnStatusApproved, 1980, ""), nil)
+				m.RepoMock.EXPECT().UpdateStatus(m.Ctx, ReturnID, returns_entity.ReturnStatusApproved, returns_entity.ReturnStatusReceived, "").Return(true, nil)
+				m.StockMock.EXPECT().Restock(m.Ctx, gomock.Any()).Return(errStock)
+			},
+			ExpectedError: errStock,
+		},
+		{
+			Name: "return_not_approved",
+			SetupMocks: func(m *MockReceive) {
+				expectTx(m.Ctx, m.UowMock, m.RepoMock, 1)
+				m.RepoMock.EXPECT().GetByID(m.Ctx, ReturnID).Return(stored(returns_entity.ReturnStatusRequested, 1980, ""), nil)
+			},
+			ExpectedError: returns_constant.ErrInvalidTransition,
+		},
+		{
+			Name: "status_changed_concurrently",
+			SetupMocks: func(m *MockReceive) {
+				expectTx(m.Ctx, m.UowMock, m.RepoMock, 1)
+				m.RepoMock.EXPECT().GetByID(m.Ctx, ReturnID).Return(stored(returns_entity.ReturnStatusApproved, 1980, ""), nil)
+				m.RepoMock.EXPECT().UpdateStatus(m.Ctx, ReturnID, returns_entity.ReturnStatusApproved, returns_entity.ReturnStatusReceived, "").Return(false, nil)
+			},
+			ExpectedError: returns_constant.ErrStatusChanged,
+		},
+		{
+			Name: "return_not_found",
+			SetupMocks: func(m *MockReceive) {
+				expectTx(m.Ctx, m.UowMock, m.RepoMock, 1)
+				m.RepoMock.EXPECT().GetByID(m.Ctx, ReturnID).Return(nil, nil)
+			},
+			ExpectedError: returns_constant.ErrReturnNotFound,
+		},
+	}
+}
@@ -0,0 +1,152 @@
+package returns_testcases
+
+import (
+	"context"
+	"errors"
+
+	returns_entity "github.com/Fi44er/sdmed/internal/module/returns/entity"
+	returns_constant "github.com/Fi44er/sdmed/internal/module/returns/pkg"
+	"github.com/Fi44er/sdmed/internal/module/returns/usecase/returns/mock"
+	uow_mock "github.com/Fi44er/sdmed/pkg/postgres/uow/mock"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+type MockRefund struct {
+	Ctrl        *gomock.Controller
+	Ctx         context.Context
+	RepoMock    *mock.MockIReturnRepository
+	OrderMock   *mock.MockIOrderUsecaseAdapter
+	PaymentMock *mock.MockIPaymentUsecaseAdapter
+	FileMock    *mock.MockIFileUsecaseAdapter
+	UowMock     *uow_mock.MockUow
+	T           assert.TestingT
+}
+
+type RefundTestCase struct {
+	Name              string
+	Amount            float64
+	SetupMocks        func(m *MockRefund)
+	ExpectedStatus    returns_entity.ReturnStatus
+	ExpectedPaymentID string
+	ExpectedError     error
+}
+
+var errProvider = errors.New("provider unavailable")
+
+// expectGetByID ожидает чтение заявки вне транзакции с подгрузкой фотографий
+func expectGetByID(m *MockRefund, ret *returns_entity.Return) *gomock.Call {
+	m.FileMock.EXPECT().GetByOwner(m.Ctx, ReturnID).Return(photos, nil)
+	return m.RepoMock.EXPECT().GetByID(m.Ctx, ReturnID).Return(ret, nil)
+}
+
+// expectStart ожидает фиксацию суммы возврата в refund_pending
+func expectStart(m *MockRefund, amount float64) []*gomock.Call {
+	expectTx(m.Ctx, m.UowMock, m.RepoMock, 2)
+	m.RepoMock.EXPECT().SetRefund(m.Ctx, ReturnID, amount, "").Return(nil)
+	m.OrderMock.EXPECT().AddHistoryNote(m.Ctx, OrderID, returns_entity.ActorManager, gomock.Any()).Return(nil)
+	return []*gomock.Call{
+		m.RepoMock.EXPECT().GetByID(m.Ctx, ReturnID).Return(stored(returns_entity.ReturnStatusReceived, 1980, ""), nil),
+		m.RepoMock.EXPECT().UpdateStatus(m.Ctx, ReturnID, returns_entity.ReturnStatusReceived, returns_entity.ReturnStatusRefundPending, "").Return(true, nil),
+	}
+}
+
+// expectFinish ожидает перевод заявки в refunded; returned - возвращенные по заказу количества
+func expectFinish(m *MockRefund, paymentID string, returned map[string]int) []*gomock.Call {
+	expectTx(m.Ctx, m.UowMock, m.RepoMock, 2)
+	m.RepoMock.EXPECT().GetReturnedQuantities(m.Ctx, OrderID, []returns_entity.ReturnStatus{returns_entity.ReturnStatusRefunded}).Return(returned, nil)
+	m.OrderMock.EXPECT().GetByID(m.Ctx, OrderID).Return(order(), nil)
+	m.OrderMock.EXPECT().
+		AddHistoryNote(m.Ctx, OrderID, returns_entity.ActorManager, gomock.Any()).
+		DoAndReturn(func(ctx context.Context, id string, actor returns_entity.Actor, comment string) error {
+			assert.Contains(m.T, comment, "возвращено")
+			return nil
+		})
+	return []*gomock.Call{
+		m.RepoMock.EXPECT().GetByID(m.Ctx, ReturnID).Return(stored(returns_entity.ReturnStatusRefundPending, 1980, paymentID), nil),
+		m.RepoMock.EXPECT().UpdateStatus(m.Ctx, ReturnID, returns_entity.ReturnStatusRefundPending, returns_entity.ReturnStatusRefunded, "").Return(true, nil),
+		expectGetByID(m, stored(returns_entity.ReturnStatusRefunded, 1980, paymentID)),
+	}
+}
+
+func GetRefundTestCases() []RefundTestCase {
+	return []RefundTestCase{
+		{
+			Name:   "full_refund_completes_order",
+			Amount: 0,
+			SetupMocks: func(m *MockRefund) {
+				calls := []*gomock.Call{expectGetByID(m, stored(returns_entity.ReturnStatusReceived, 1980, ""))}
+				calls = append(calls, expectStart(m, 1980)...)
+				calls = append(calls,
+					expectGetByID(m, stored(returns_entity.ReturnStatusRefundPending, 1980, "")),
+					m.PaymentMock.EXPECT().Refund(m.Ctx, OrderID, 1980.0, returnedItems()).Return("payment-1", nil),
+					m.RepoMock.EXPECT().SetRefund(m.Ctx, ReturnID, 1980.0, "payment-1").Return(nil),
+				)
+				calls = append(calls, expectFinish(m, "payment-1", map[string]int{"item-1": 2, "item-2": 1})...)
+				gomock.InOrder(calls...)
+
+				m.OrderMock.EXPECT().MarkReturned(m.Ctx, OrderID, "Возврат 4E1D2C3B").Return(nil)
+			},
+			ExpectedStatus:    returns_entity.ReturnStatusRefunded,
+			ExpectedPaymentID: "payment-1",
+		},
+		{
+			Name:   "provider_error_keeps_committed_amount",
+			Amount: 500,
+			SetupMocks: func(m *MockRefund) {
+				calls := []*gomock.Call{expectGetByID(m, stored(returns_entity.ReturnStatusReceived, 1980, ""))}
+				calls = append(calls, expectStart(m, 500)...)
+				calls = append(calls,
+					expectGetByID(m, stored(returns_entity.ReturnStatusRefundPending, 500, "")),
+					m.PaymentMock.EXPECT().Refund(m.Ctx, OrderID, 500.0, returnedItems()).Return("", errProvider),
+				)
+				gomock.InOrder(calls...)
+			},
+			ExpectedError: errProvider,
+		},
+		{
+			Name:   "retry_after_provider_error",
+			Amount: 0,
+			SetupMocks: func(m *MockRefund) {
+				calls := []*gomock.Call{
+					expectGetByID(m, stored(returns_entity.ReturnStatusRefundPending, 500, "")),
+					m.PaymentMock.EXPECT().Refund(m.Ctx, OrderID, 500.0, returnedItems()).Return("payment-1", nil),
+					m.RepoMock.EXPECT().SetRefund(m.Ctx, ReturnID, 500.0, "payment-1").Return(nil),
+				}
+				calls = append(calls, expectFinish(m, "payment-1", map[string]int{"item-1": 1})...)
+				gomock.InOrder(calls...)
+			},
+			ExpectedStatus:    returns_entity.ReturnStatusRefunded,
+			ExpectedPaymentID: "payment-1",
+		},
+		{
+			Name:   "retry_with_another_amount",
+			Amount: 300,
+			SetupMocks: func(m *MockRefund) {
+				expectGetByID(m, stored(returns_entity.ReturnStatusRefundPending, 500, ""))
+			},
+			ExpectedError: returns_constant.ErrRefundAmountChanged,
+		},
+		{
+			Name:   "amount_above_paid_for_items",
+			Amount: 2000,
+			SetupMocks: func(m *MockRefund) {
+				expectGetByID(m, stored(returns_entity.ReturnStatusReceived, 1980, ""))
+				expectTx(m.Ctx, m.UowMock, m.RepoMock, 1)
+				m.RepoMock.EXPECT().GetByID(m.Ctx, ReturnID).Return(stored(returns_entity.ReturnStatusReceived, 1980, ""), nil)
+				m.RepoMock.EXPECT().UpdateStatus(m.Ctx, ReturnID, returns_entity.ReturnStatusReceived, returns_entity.ReturnStatusRefundPending, "").Return(true, nil)
+			},
+			ExpectedError: returns_constant.ErrRefundAmountInvalid,
+		},
+		{
+			Name:   "refund_before_receipt",
+			Amount: 0,
+			SetupMocks: func(m *MockRefund) {
+				expectGetByID(m, stored(returns_entity.ReturnStatusApproved, 1980, ""))
+				expectTx(m.Ctx, m.UowMock, m.RepoMock, 1)
+				m.RepoMock.EXPECT().GetByID(m.Ctx, ReturnID).Return(stored(returns_entity.ReturnStatusApproved, 1980, ""), nil)
+			},
+			ExpectedError: returns_constant.ErrInvalidTransition,
+		},
+	}
+}
@@ -0,0 +1,405 @@
+package returns_usecase
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	returns_entity "github.com/Fi44er/sdmed/internal/module/returns/entity"
+	returns_constant "github.com/Fi44er/sdmed/internal/module/returns/pkg"
+	returns_usecase_contracts "github.com/Fi44er/sdmed/internal/module/returns/usecase/returns/contracts"
+	"github.com/Fi44er/sdmed/pkg/logger"
+	"github.com/Fi44er/sdmed/pkg/postgres/uow"
+	"github.com/Fi44er/sdmed/pkg/utils"
+)
+
+// activeStatuses - возвраты, которые занимают количество позиций заказа
+var activeStatuses = []returns_entity.ReturnStatus{
+	returns_entity.ReturnStatusRequested,
+	returns_entity.ReturnStatusApproved,
+	returns_entity.ReturnStatusReceived,
+	returns_entity.ReturnStatusRefundPending,
+	returns_entity.ReturnStatusRefunded,
+}
+
+type IReturnUsecase interface {
+	Create(ctx context.Context, ret *returns_entity.Return) (*returns_entity.Return, error)
+	GetOwn(ctx context.Context, page, pageSize int) ([]returns_entity.Return, int64, error)
+	GetOwnByID(ctx context.Context, id string) (*returns_entity.Return, error)
+
+	GetByID(ctx context.Context, id string) (*returns_entity.Return, error)
+	GetAll(ctx context.Context, filter *returns_entity.ReturnFilter, page, pageSize int) ([]returns_entity.Return, int64, error)
+	Approve(ctx context.Context, id, comment string) (*returns_entity.Return, error)
+	Reject(ctx context.Context, id, comment string) (*returns_entity.Return, error)
+	Receive(ctx context.Context, id, comment string) (*returns_entity.Return, error)
+	Refund(ctx context.Context, id string, amount float64, comment string) (*returns_entity.Return, error)
+}
+
+type ReturnUsecase struct {
+	repository        returns_usecase_contracts.IReturnRepository
+	sessionRepository returns_usecase_contracts.ISessionRepository
+	orderUsecase      returns_usecase_contracts.IOrderUsecaseAdapter
+	paymentUsecase    returns_usecase_contracts.IPaymentUsecaseAdapter
+	stockUsecase      returns_usecase_contracts.IStockUsecaseAdapter
+	fileUsecase       returns_usecase_contracts.IFileUsecaseAdapter
+	uow               uow.Uow
+	logger            *logger.Logger
+}
+
+func NewReturnUsecase(
+	repository returns_usecase_contracts.IReturnRepository,
+	sessionRepository returns_usecase_contracts.ISessionRepository,
+	orderUsecase returns_usecase_contracts.IOrderUsecaseAdapter,
+	paymentUsecase returns_usecase_contracts.IPaymentUsecaseAdapter,
+	stockUsecase returns_usecase_contracts.IStockUsecaseAdapter,
+	fileUsecase returns_usecase_contracts.IFileUsecaseAdapter,
+	uow uow.Uow,
+	logger *logger.Logger,
+) IReturnUsecase {
+	return &ReturnUsecase{
+		repository:        repository,
+		sessionRepository: sessionRepository,
+		orderUsecase:      orderUsecase,
+		paymentUsecase:    paymentUsecase,
+		stockUsecase:      stockUsecase,
+		fileUsecase:       fileUsecase,
+		uow:               uow,
+		logger:            logger,
+	}
+}
+
+// Create открывает заявку на возврат позиций доставленного заказа пользователя сессии.
+// Вернуть можно не больше, чем осталось после прошлых неотклоненных заявок.
+// Фотографии загружаются заранее как временные файлы и привязываются к заявке
+func (u *ReturnUsecase) Create(ctx context.Context, ret *returns_entity.Return) (*returns_entity.Return, error) {
+	userID, err := u.getSessionUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	order, err := u.orderUsecase.GetOwn(ctx, ret.OrderID)
+	if err != nil {
+		return nil, err
+	}
+	if !order.Returnable {
+		return nil, returns_constant.ErrOrderNotReturnable
+	}
+	u.logger.Infof("Opening return for order %s by user %s", order.ID, userID)
+
+	err = u.uow.Do(ctx, func(ctx context.Context) error {
+		repo, err := u.getRepository(ctx)
+		if err != nil {
+			return err
+		}
+
+		returned, err := repo.GetReturnedQuantities(ctx, order.ID, activeStatuses)
+		if err != nil {
+			return err
+		}
+		if err := u.fillItems(ret, order, returned); err != nil {
+			return err
+		}
+
+		ret.UserID = userID
+		ret.Status = returns_entity.ReturnStatusRequested
+		ret.CalculateRefund()
+		if err := repo.Create(ctx, ret); err != nil {
+			return err
+		}
+
+		if len(ret.Photos) > 0 {
+			names := make([]string, len(ret.Photos))
+			for i := range ret.Photos {
+				names[i] = ret.Photos[i].Name
+			}
+			if err := u.fileUsecase.MakeFilesPermanent(ctx, names, ret.ID); err != nil {
+				return err
+			}
+		}
+
+		return u.orderUsecase.AddHistoryNote(ctx, order.ID, returns_entity.ActorCustomer,
+			fmt.Sprintf("Открыта заявка на возврат %s: %s", ret.Number(), ret.Reason))
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return u.GetByID(ctx, ret.ID)
+}
+
+func (u *ReturnUsecase) GetOwn(ctx context.Context, page, pageSize int) ([]returns_entity.Return, int64, error) {
+	userID, err := u.getSessionUserID(ctx)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return u.GetAll(ctx, &returns_entity.ReturnFilter{UserID: userID}, page, pageSize)
+}
+
+// GetOwnByID возвращает заявку, только если она принадлежит пользователю сессии
+func (u *ReturnUsecase) GetOwnByID(ctx context.Context, id string) (*returns_entity.Return, error) {
+	userID, err := u.getSessionUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	ret, err := u.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if ret.UserID != userID {
+		return nil, returns_constant.ErrReturnNotFound
+	}
+
+	return ret, nil
+}
+
+func (u *ReturnUsecase) GetByID(ctx context.Context, id string) (*returns_entity.Return, error) {
+	ret, err := u.repository.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if ret == nil {
+		return nil, returns_constant.ErrReturnNotFound
+	}
+
+	ret.Photos, err = u.fileUsecase.GetByOwner(ctx, ret.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	return ret, nil
+}
+
+func (u *ReturnUsecase) GetAll(ctx context.Context, filter *returns_entity.ReturnFilter, page, pageSize int) ([]returns_entity.Return, int64, error) {
+	if filter.Status != "" && !filter.Status.IsValid() {
+		return nil, 0, returns_constant.ErrInvalidStatus
+	}
+
+	filter.Offset, filter.Limit = utils.SafeCalculateForPostgres(page, pageSize)
+	returns, err := u.repository.GetAll(ctx, filter)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	count, err := u.repository.Count(ctx, filter)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	ids := make([]string, len(returns))
+	for i := range returns {
+		ids[i] = returns[i].ID
+	}
+	photos, err := u.fileUsecase.GetByOwners(ctx, ids)
+	if err != nil {
+		return nil, 0, err
+	}
+	for i := range returns {
+		returns[i].Photos = photos[returns[i].ID]
+	}
+
+	return returns, count, nil
+}
+
+func (u *ReturnUsecase) Approve(ctx context.Context, id, comment string) (*returns_entity.Return, error) {
+	return u.step(ctx, id, returns_entity.ReturnStatusApproved, comment, func(ctx context.Context, ret *returns_entity.Return) (string, error) {
+		return fmt.Sprintf("Возврат %s одобрен", ret.Number()), nil
+	})
+}
+
+func (u *ReturnUsecase) Reject(ctx context.Context, id, comment string) (*returns_entity.Return, error) {
+	return u.step(ctx, id, returns_entity.ReturnStatusRejected, comment, func(ctx context.Context, ret *returns_entity.Return) (string, error) {
+		return fmt.Sprintf("Возврат %s отклонен", ret.Number()), nil
+	})
+}
+
+// Receive фиксирует получение возвращенного товара на склад и возвращает его в остаток
+func (u *ReturnUsecase) Receive(ctx context.Context, id, comment string) (*returns_entity.Return, error) {
+	return u.step(ctx, id, returns_entity.ReturnStatusReceived, comment, func(ctx context.Context, ret *returns_entity.Return) (string, error) {
+		if err := u.stockUsecase.Restock(ctx, ret.Items); err != nil {
+			return "", err
+		}
+		return fmt.Sprintf("Товар по возврату %s получен на склад", ret.Number()), nil
+	})
+}
+
+// Refund возвращает деньги через платежный шлюз, которым оплачен заказ. Нулевая сумма -
+// рассчитанная по позициям сумма возврата, больше нее вернуть нельзя. Позиции, оплаченные
+// сертификатом, на карту не возвращаются. Когда возвращены все позиции, заказ переходит в returned.
+// Сумма фиксируется в статусе refund_pending до обращения к шлюзу, шлюз вызывается вне транзакции:
+// откат не может потерять выполненный возврат. При ошибке шлюза заявка остается в refund_pending
+// и возврат повторяется с той же суммой
+func (u *ReturnUsecase) Refund(ctx context.Context, id string, amount float64, comment string) (*returns_entity.Return, error) {
+	ret, err := u.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if ret.Status == returns_entity.ReturnStatusRefundPending {
+		if amount != 0 && math.Abs(amount-ret.RefundAmount) >= 0.01 {
+			return nil, returns_constant.ErrRefundAmountChanged
+		}
+	} else {
+		ret, err = u.step(ctx, id, returns_entity.ReturnStatusRefundPending, comment, func(ctx context.Context, ret *returns_entity.Return) (string, error) {
+			if amount == 0 {
+				amount = ret.RefundAmount
+			}
+			if amount < 0 || amount > ret.RefundAmount {
+				return "", returns_constant.ErrRefundAmountInvalid
+			}
+
+			repo, err := u.getRepository(ctx)
+			if err != nil {
+				return "", err
+			}
+			if err := repo.SetRefund(ctx, ret.ID, amount, ""); err != nil {
+				return "", err
+			}
+			return fmt.Sprintf("По возврату %s начат возврат %.2f руб.", ret.Number(), amount), nil
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if ret.RefundAmount > 0 && ret.PaymentID == "" {
+		u.logger.Infof("Refunding %.2f for return %s of order %s", ret.RefundAmount, ret.ID, ret.OrderID)
+		paymentID, err := u.paymentUsecase.Refund(ctx, ret.OrderID, ret.RefundAmount, ret.Items)
+		if err != nil {
+			return nil, err
+		}
+		if err := u.repository.SetRefund(ctx, ret.ID, ret.RefundAmount, paymentID); err != nil {
+			u.logger.Errorf("Return %s: refund %.2f by payment %s accepted by provider but not recorded", ret.ID, ret.RefundAmount, paymentID)
+			return nil, err
+		}
+	}
+
+	return u.step(ctx, id, returns_entity.ReturnStatusRefunded, comment, func(ctx context.Context, ret *returns_entity.Return) (string, error) {
+		if err := u.completeOrder(ctx, ret); err != nil {
+			return "", err
+		}
+		return fmt.Sprintf("По возврату %s возвращено %.2f руб.", ret.Number(), ret.RefundAmount), nil
+	})
+}
+
+// step переводит заявку в статус to и записывает шаг в историю заказа.
+// apply выполняется в той же транзакции после смены статуса и возвращает текст записи
+func (u *ReturnUsecase) step(
+	ctx context.Context,
+	id string,
+	to returns_entity.ReturnStatus,
+	comment string,
+	apply func(ctx context.Context, ret *returns_entity.Return) (string, error),
+) (*returns_entity.Return, error) {
+	err := u.uow.Do(ctx, func(ctx context.Context) error {
+		repo, err := u.getRepository(ctx)
+		if err != nil {
+			return err
+		}
+
+		ret, err := repo.GetByID(ctx, id)
+		if err != nil {
+			return err
+		}
+		if ret == nil {
+			return returns_constant.ErrReturnNotFound
+		}
+		if !returns_entity.CanTransition(ret.Status, to) {
+			u.logger.Warnf("Return %s: transition %s -> %s is not allowed", id, ret.Status, to)
+			return returns_constant.ErrInvalidTransition
+		}
+
+		updated, err := repo.UpdateStatus(ctx, id, ret.Status, to, comment)
+		if err != nil {
+			return err
+		}
+		if !updated {
+			return returns_constant.ErrStatusChanged
+		}
+		ret.Status, ret.Comment = to, comment
+
+		note, err := apply(ctx, ret)
+		if err != nil {
+			return err
+		}
+		if comment != "" {
+			note += ": " + comment
+		}
+
+		return u.orderUsecase.AddHistoryNote(ctx, ret.OrderID, returns_entity.ActorManager, note)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return u.GetByID(ctx, id)
+}
+
+// completeOrder переводит заказ в returned, если по нему возвращены все позиции
+func (u *ReturnUsecase) completeOrder(ctx context.Context, ret *returns_entity.Return) error {
+	repo, err := u.getRepository(ctx)
+	if err != nil {
+		return err
+	}
+
+	returned, err := repo.GetReturnedQuantities(ctx, ret.OrderID, []returns_entity.ReturnStatus{returns_entity.ReturnStatusRefunded})
+	if err != nil {
+		return err
+	}
+
+	order, err := u.orderUsecase.GetByID(ctx, ret.OrderID)
+	if err != nil {
+		return err
+	}
+	if !returns_entity.IsFullReturn(order, returned) {
+		return nil
+	}
+
+	u.logger.Infof("All items of order %s are returned", ret.OrderID)
+	return u.orderUsecase.MarkReturned(ctx, ret.OrderID, fmt.Sprintf("Возврат %s", ret.Number()))
+}
+
+// fillItems проверяет запрошенные позиции по заказу и дополняет их данными позиций заказа
+func (u *ReturnUsecase) fillItems(ret *returns_entity.Return, order *returns_entity.Order, returned map[string]int) error {
+	seen := make(map[string]bool, len(ret.Items))
+	for i := range ret.Items {
+		item := &ret.Items[i]
+		if seen[item.OrderItemID] {
+			return returns_constant.ErrDuplicateItem
+		}
+		seen[item.OrderItemID] = true
+
+		orderItem := order.FindItem(item.OrderItemID)
+		if orderItem == nil {
+			return returns_constant.ErrOrderItemNotFound
+		}
+		if item.Quantity > orderItem.Left(returned) {
+			return returns_constant.ErrQuantityExceeded
+		}
+
+		item.ProductID, item.Name = orderItem.ProductID, orderItem.Name
+		item.Amount = orderItem.AmountFor(item.Quantity, returned[orderItem.ID])
+	}
+
+	return nil
+}
+
+func (u *ReturnUsecase) getSessionUserID(ctx context.Context) (string, error) {
+	sessionInfo, err := u.sessionRepository.GetSessionInfo(ctx)
+	if err != nil || sessionInfo.UserID == "" {
+		u.logger.Warnf("Returns requested without session user: %v", err)
+		return "", returns_constant.ErrSessionUserNotFound
+	}
+
+	return sessionInfo.UserID, nil
+}
+
+func (u *ReturnUsecase) getRepository(ctx context.Context) (returns_usecase_contracts.IReturnRepository, error) {
+	repo, err := u.uow.GetRepository(ctx, "returns")
+	if err != nil {
+		u.logger.Errorf("Failed to get repository: %v", err)
+		return nil, err
+	}
+	return repo.(returns_usecase_contracts.IReturnRepository), nil
+}
@@ -0,0 +1,136 @@
+package returns_usecase_test
+
+import (
+	"context"
+	"testing"
+
+	returns_usecase "github.com/Fi44er/sdmed/internal/module/returns/usecase/returns"
+	"github.com/Fi44er/sdmed/internal/module/returns/usecase/returns/mock"
+	returns_testcases "github.com/Fi44er/sdmed/internal/module/returns/usecase/returns/testcases"
+	"github.com/Fi44er/sdmed/pkg/logger"
+	uow_mock "github.com/Fi44er/sdmed/pkg/postgres/uow/mock"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+)
+
+type ReturnUsecaseTestSuite struct {
+	suite.Suite
+	ctx    context.Context
+	logger *logger.Logger
+}
+
+func (s *ReturnUsecaseTestSuite) SetupSuite() {
+	s.ctx = context.Background()
+	s.logger = logger.NewLogger()
+}
+
+func TestReturnUsecase(t *testing.T) {
+	suite.Run(t, new(ReturnUsecaseTestSuite))
+}
+
+func (s *ReturnUsecaseTestSuite) TestCreate() {
+	tests := returns_testcases.GetCreateTestCases()
+
+	for _, tc := range tests {
+		s.T().Run(tc.Name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockStruct := &returns_testcases.MockCreate{
+				Ctrl:            ctrl,
+				Ctx:             s.ctx,
+				RepoMock:        mock.NewMockIReturnRepository(ctrl),
+				SessionRepoMock: mock.NewMockISessionRepository(ctrl),
+				OrderMock:       mock.NewMockIOrderUsecaseAdapter(ctrl),
+				FileMock:        mock.NewMockIFileUsecaseAdapter(ctrl),
+				UowMock:         uow_mock.NewMockUow(ctrl),
+				T:               t,
+			}
+
+			usecase := returns_usecase.NewReturnUsecase(mockStruct.RepoMock, mockStruct.SessionRepoMock, mockStruct.OrderMock, nil, nil, mockStruct.FileMock, mockStruct.UowMock, s.logger)
+
+			tc.SetupMocks(mockStruct)
+			ret, err := usecase.Create(s.ctx, tc.Return)
+
+			if tc.ExpectedError != nil {
+				assert.ErrorIs(t, err, tc.ExpectedError)
+				assert.Nil(t, ret)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tc.ExpectedReturn, ret)
+			}
+		})
+	}
+}
+
+func (s *ReturnUsecaseTestSuite) TestReceive() {
+	tests := returns_testcases.GetReceiveTestCases()
+
+	for _, tc := range tests {
+		s.T().Run(tc.Name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockStruct := &returns_testcases.MockReceive{
+				Ctrl:      ctrl,
+				Ctx:       s.ctx,
+				RepoMock:  mock.NewMockIReturnRepository(ctrl),
+				OrderMock: mock.NewMockIOrderUsecaseAdapter(ctrl),
+				StockMock: mock.NewMockIStockUsecaseAdapter(ctrl),
+				FileMock:  mock.NewMockIFileUsecaseAdapter(ctrl),
+				UowMock:   uow_mock.NewMockUow(ctrl),
+				T:         t,
+			}
+
+			usecase := returns_usecase.NewReturnUsecase(mockStruct.RepoMock, nil, mockStruct.OrderMock, nil, mockStruct.StockMock, mockStruct.FileMock, mockStruct.UowMock, s.logger)
+
+			tc.SetupMocks(mockStruct)
+			ret, err := usecase.Receive(s.ctx, returns_testcases.ReturnID, tc.Comment)
+
+			if tc.ExpectedError != nil {
+				assert.ErrorIs(t, err, tc.ExpectedError)
+				assert.Nil(t, ret)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tc.ExpectedStatus, ret.Status)
+			}
+		})
+	}
+}
+
+func (s *ReturnUsecaseTestSuite) TestRefund() {
+	tests := returns_testcases.GetRefundTestCases()
+
+	for _, tc := range tests {
+		s.T().Run(tc.Name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockStruct := &returns_testcases.MockRefund{
+				Ctrl:        ctrl,
+				Ctx:         s.ctx,
+				RepoMock:    mock.NewMockIReturnRepository(ctrl),
+				OrderMock:   mock.NewMockIOrderUsecaseAdapter(ctrl),
+				PaymentMock: mock.NewMockIPaymentUsecaseAdapter(ctrl),
+				FileMock:    mock.NewMockIFileUsecaseAdapter(ctrl),
+				UowMock:     uow_mock.NewMockUow(ctrl),
+				T:           t,
+			}
+
+			usecase := returns_usecase.NewReturnUsecase(mockStruct.RepoMock, nil, mockStruct.OrderMock, mockStruct.PaymentMock, nil, mockStruct.FileMock, mockStruct.UowMock, s.logger)
+
+			tc.SetupMocks(mockStruct)
+			ret, err := usecase.Refund(s.ctx, returns_testcases.ReturnID, tc.Amount, "")
+
+			if tc.ExpectedError != nil {
+				assert.ErrorIs(t, err, tc.ExpectedError)
+				assert.Nil(t, ret)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tc.ExpectedStatus, ret.Status)
+				assert.Equal(t, tc.ExpectedPaymentID, ret.PaymentID)
+			}
+		})
+	}
+}
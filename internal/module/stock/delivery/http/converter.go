@@ -0,0 +1,59 @@
+package stock_http
+
+import (
+	"math"
+
+	stock_dto "github.com/Fi44er/sdmed/internal/module/stock/dto"
+	stock_entity "github.com/Fi44er/sdmed/internal/module/stock/entity"
+	dto_utils "github.com/Fi44er/sdmed/pkg/utils/dto"
+)
+
+type Converter struct{}
+
+func (c *Converter) ToEntity(dto *stock_dto.SetStockRequest) *stock_entity.Stock {
+	return &stock_entity.Stock{
+		ProductID: dto.ProductID,
+		Quantity:  dto.Quantity,
+	}
+}
+
+func (c *Converter) ToStockResponse(entity *stock_entity.Stock) *stock_dto.StockResponse {
+	reservations := make([]stock_dto.ReservationResponse, len(entity.Reservations))
+	for i, reservation := range entity.Reservations {
+		reservations[i] = stock_dto.ReservationResponse{
+			ID:        reservation.ID,
+			OrderID:   reservation.OrderID,
+			Quantity:  reservation.Quantity,
+			CreatedAt: reservation.CreatedAt,
+		}
+		if !reservation.ExpiresAt.IsZero() {
+			reservations[i].ExpiresAt = &entity.Reservations[i].ExpiresAt
+		}
+	}
+
+	return &stock_dto.StockResponse{
+		ProductID:    entity.ProductID,
+		Quantity:     entity.Quantity,
+		Reserved:     entity.Reserved,
+		Available:    entity.Available(),
+		Reservations: reservations,
+		UpdatedAt:    entity.UpdatedAt,
+	}
+}
+
+func (c *Converter) ToStockListResponse(stocks []stock_entity.Stock, count int64, page, pageSize int) *dto_utils.ListResponse[stock_dto.StockResponse] {
+	data := make([]stock_dto.StockResponse, len(stocks))
+	for i := range stocks {
+		data[i] = *c.ToStockResponse(&stocks[i])
+	}
+
+	return &dto_utils.ListResponse[stock_dto.StockResponse]{
+		Data: data,
+		Pagination: dto_utils.PaginationInfo{
+			Total:    count,
+			Page:     page,
+			PageSize: pageSize,
+			Pages:    int(math.Ceil(float64(count) / float64(pageSize))),
+		},
+	}
+}
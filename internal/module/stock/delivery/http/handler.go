@@ -0,0 +1,133 @@
+package stock_http
+
+import (
+	"context"
+
+	stock_dto "github.com/Fi44er/sdmed/internal/module/stock/dto"
+	stock_entity "github.com/Fi44er/sdmed/internal/module/stock/entity"
+	"github.com/Fi44er/sdmed/pkg/logger"
+	_ "github.com/Fi44er/sdmed/pkg/response"
+	"github.com/Fi44er/sdmed/pkg/utils"
+	"github.com/go-playground/validator/v10"
+	"github.com/gofiber/fiber/v2"
+)
+
+type IStockUsecase interface {
+	GetByProductID(ctx context.Context, productID string) (*stock_entity.Stock, error)
+	GetAll(ctx context.Context, page, pageSize int) ([]stock_entity.Stock, int64, error)
+	SetQuantity(ctx context.Context, productID string, quantity int) (*stock_entity.Stock, error)
+}
+
+type StockHandler struct {
+	usecase IStockUsecase
+
+	validator *validator.Validate
+	logger    *logger.Logger
+	converter *Converter
+}
+
+func NewStockHandler(
+	usecase IStockUsecase,
+	validator *validator.Validate,
+	logger *logger.Logger,
+) *StockHandler {
+	return &StockHandler{
+		usecase:   usecase,
+		validator: validator,
+		logger:    logger,
+		converter: &Converter{},
+	}
+}
+
+// GetAll godoc
+// @Summary Get stock
+// @Description Stock of products with quantity on hand, reserved and available quantity, recently changed first.
+// @Description Products without a stock record are not tracked and are not reserved on checkout
+// @Tags stock-admin
+// @Produce json
+// @Param page query int false "Page number (default 1)"
+// @Param page_size query int false "Items per page (default 20)"
+// @Success 200 {object} response.ResponseData{data=[]stock_dto.StockResponse} "OK"
+// @Failure 500 {object} response.Response "Error"
+// @Router /admin/stock [get]
+func (h *StockHandler) GetAll(ctx *fiber.Ctx) error {
+	params := &stock_dto.StockQueryParams{
+		Page:     1,
+		PageSize: 20,
+	}
+
+	if err := ctx.QueryParser(params); err != nil {
+		h.logger.Errorf("Failed to parse query params: %v", err)
+		return fiber.NewError(fiber.StatusBadRequest, "Invalid query parameters")
+	}
+
+	stocks, count, err := h.usecase.GetAll(ctx.Context(), params.Page, params.PageSize)
+	if err != nil {
+		return err
+	}
+
+	return ctx.Status(200).JSON(fiber.Map{
+		"status": "success",
+		"data":   h.converter.ToStockListResponse(stocks, count, params.Page, params.PageSize),
+	})
+}
+
+// GetByProductID godoc
+// @Summary Get product stock
+// @Description Stock of the product with active order reservations. expires_at is set for unpaid orders
+// @Tags stock-admin
+// @Produce json
+// @Param product_id path string true "Product ID"
+// @Success 200 {object} response.ResponseData{data=stock_dto.StockResponse} "OK"
+// @Failure 404 {object} response.Response "Product not found"
+// @Failure 500 {object} response.Response "Error"
+// @Router /admin/stock/{product_id} [get]
+func (h *StockHandler) GetByProductID(ctx *fiber.Ctx) error {
+	stock, err := h.usecase.GetByProductID(ctx.Context(), ctx.Params("product_id"))
+	if err != nil {
+		return err
+	}
+
+	return ctx.Status(200).JSON(fiber.Map{
+		"status": "success",
+		"data":   h.converter.ToStockResponse(stock),
+	})
+}
+
+// SetQuantity godoc
+// @Summary Set product stock
+// @Description Set the quantity on hand, e.g. after a stocktake or a delivery. Starts tracking the product if it was not tracked.
+// @Description The quantity cannot be less than the quantity reserved for orders
+// @Tags stock-admin
+// @Accept json
+// @Produce json
+// @Param product_id path string true "Product ID"
+// @Param request body stock_dto.SetStockRequest true "Quantity on hand"
+// @Success 200 {object} response.ResponseData{data=stock_dto.StockResponse} "OK"
+// @Failure 400 {object} response.Response "Invalid request"
+// @Failure 404 {object} response.Response "Product not found"
+// @Failure 409 {object} response.Response "Quantity is less than reserved"
+// @Failure 500 {object} response.Response "Error"
+// @Router /admin/stock/{product_id} [put]
+func (h *StockHandler) SetQuantity(ctx *fiber.Ctx) error {
+	dto := new(stock_dto.SetStockRequest)
+	dto.ProductID = ctx.Params("product_id")
+
+	stock, err := utils.ParseAndValidate(ctx, dto, h.validator, h.converter.ToEntity, h.logger)
+	if err != nil {
+		return ctx.Status(400).JSON(fiber.Map{
+			"status":  "fail",
+			"message": err.Error(),
+		})
+	}
+
+	stock, err = h.usecase.SetQuantity(ctx.Context(), stock.ProductID, stock.Quantity)
+	if err != nil {
+		return err
+	}
+
+	return ctx.Status(200).JSON(fiber.Map{
+		"status": "success",
+		"data":   h.converter.ToStockResponse(stock),
+	})
+}
@@ -0,0 +1,13 @@
+package stock_http
+
+import (
+	"github.com/Fi44er/sdmed/internal/middlewares"
+	"github.com/gofiber/fiber/v2"
+)
+
+func (h *StockHandler) RegisterRoutes(router fiber.Router) {
+	admin := router.Group("/admin/stock", middlewares.Authorize("stock", "read"))
+	admin.Get("/", h.GetAll)
+	admin.Get("/:product_id", h.GetByProductID)
+	admin.Put("/:product_id", middlewares.Authorize("stock", "update"), h.SetQuantity)
+}
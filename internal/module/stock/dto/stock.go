@@ -0,0 +1,30 @@
+package stock_dto
+
+import "time"
+
+type SetStockRequest struct {
+	ProductID string `json:"-" validate:"required,uuid"`
+	Quantity  int    `json:"quantity" validate:"min=0,max=1000000"`
+}
+
+type StockQueryParams struct {
+	Page     int `query:"page"`
+	PageSize int `query:"page_size"`
+}
+
+type ReservationResponse struct {
+	ID        string     `json:"id"`
+	OrderID   string     `json:"order_id"`
+	Quantity  int        `json:"quantity"`
+	ExpiresAt *time.Time `json:"expires_at,omitempty"`
+	CreatedAt time.Time  `json:"created_at"`
+}
+
+type StockResponse struct {
+	ProductID    string                `json:"product_id"`
+	Quantity     int                   `json:"quantity"`
+	Reserved     int                   `json:"reserved"`
+	Available    int                   `json:"available"`
+	Reservations []ReservationResponse `json:"reservations,omitempty"`
+	UpdatedAt    time.Time             `json:"updated_at"`
+}
@@ -0,0 +1,66 @@
+package stock_entity
+
+import "time"
+
+// Stock - остаток товара на складе. Reserved - часть остатка под заказы,
+// которые еще не отгружены. Товар без записи об остатке не учитывается и не резервируется
+type Stock struct {
+	ProductID string
+	Quantity  int
+	Reserved  int
+	UpdatedAt time.Time
+
+	// Reservations - действующие резервы, заполняются только при просмотре товара
+	Reservations []Reservation
+}
+
+type ReservationStatus string
+
+const (
+	ReservationStatusActive ReservationStatus = "active"
+	// ReservationStatusReleased - резерв снят: заказ отменен или не оплачен вовремя
+	ReservationStatusReleased ReservationStatus = "released"
+	// ReservationStatusWrittenOff - товар отгружен и списан с остатка
+	ReservationStatusWrittenOff ReservationStatus = "written_off"
+)
+
+// Reservation - резерв товара под заказ. Пустой ExpiresAt - резерв не снимается по таймауту
+type Reservation struct {
+	ID        string
+	OrderID   string
+	ProductID string
+	Quantity  int
+	Status    ReservationStatus
+	ExpiresAt time.Time
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+// ReserveItem - товар и количество для резерва
+type ReserveItem struct {
+	ProductID string
+	Quantity  int
+}
+
+// Available - остаток, который можно зарезервировать
+func (s *Stock) Available() int {
+	return max(s.Quantity-s.Reserved, 0)
+}
+
+// MergeItems объединяет позиции одного товара, чтобы резерв на товар был одной записью
+func MergeItems(items []ReserveItem) []ReserveItem {
+	merged := make([]ReserveItem, 0, len(items))
+	index := make(map[string]int, len(items))
+	for _, item := range items {
+		if item.Quantity <= 0 {
+			continue
+		}
+		if i, ok := index[item.ProductID]; ok {
+			merged[i].Quantity += item.Quantity
+			continue
+		}
+		index[item.ProductID] = len(merged)
+		merged = append(merged, item)
+	}
+	return merged
+}
@@ -0,0 +1,27 @@
+package stock_entity
+
+import "testing"
+
+func TestStockAvailable(t *testing.T) {
+	stock := &Stock{Quantity: 5, Reserved: 3}
+	if got := stock.Available(); got != 2 {
+		t.Errorf("Available() = %d, want 2", got)
+	}
+
+	stock.Reserved = 7
+	if got := stock.Available(); got != 0 {
+		t.Errorf("Available() with over-reservation = %d, want 0", got)
+	}
+}
+
+func TestMergeItems(t *testing.T) {
+	items := MergeItems([]ReserveItem{
+		{ProductID: "cane", Quantity: 1},
+		{ProductID: "walker", Quantity: 0},
+		{ProductID: "cane", Quantity: 2},
+	})
+
+	if len(items) != 1 || items[0].ProductID != "cane" || items[0].Quantity != 3 {
+		t.Errorf("MergeItems() = %+v, want [cane x3]", items)
+	}
+}
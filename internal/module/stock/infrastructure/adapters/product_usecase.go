@@ -0,0 +1,29 @@
+package stock_adapters
+
+import (
+	"context"
+
+	product_usecase "github.com/Fi44er/sdmed/internal/module/product/usecase/product"
+)
+
+type IProductUsecaseAdapter interface {
+	Exists(ctx context.Context, productID string) (bool, error)
+}
+
+type ProductUsecaseAdapter struct {
+	productUsecase product_usecase.IProductUsecase
+}
+
+func NewProductUsecaseAdapter(productUsecase product_usecase.IProductUsecase) IProductUsecaseAdapter {
+	return &ProductUsecaseAdapter{
+		productUsecase: productUsecase,
+	}
+}
+
+func (a *ProductUsecaseAdapter) Exists(ctx context.Context, productID string) (bool, error) {
+	products, err := a.productUsecase.GetByIDs(ctx, []string{productID})
+	if err != nil {
+		return false, err
+	}
+	return len(products) > 0, nil
+}
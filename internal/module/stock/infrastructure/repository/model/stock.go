@@ -0,0 +1,30 @@
+package stock_model
+
+import "time"
+
+// Stock - остаток по товару. ProductID без внешнего ключа: товары принадлежат модулю каталога
+type Stock struct {
+	ProductID string    `gorm:"primaryKey;type:uuid"`
+	Quantity  int       `gorm:"not null;default:0;check:quantity >= 0"`
+	Reserved  int       `gorm:"not null;default:0;check:reserved >= 0"`
+	UpdatedAt time.Time `gorm:"not null;default:now()"`
+}
+
+func (Stock) TableName() string {
+	return "stock_module.stocks"
+}
+
+type Reservation struct {
+	ID        string     `gorm:"primaryKey;type:uuid;default:uuid_generate_v4();"`
+	OrderID   string     `gorm:"type:uuid;not null;index"`
+	ProductID string     `gorm:"type:uuid;not null;index"`
+	Quantity  int        `gorm:"not null"`
+	Status    string     `gorm:"type:varchar(20);not null;index"`
+	ExpiresAt *time.Time `gorm:"index"`
+	CreatedAt time.Time  `gorm:"not null;default:now()"`
+	UpdatedAt time.Time  `gorm:"not null;default:now()"`
+}
+
+func (Reservation) TableName() string {
+	return "stock_module.reservations"
+}
@@ -0,0 +1,51 @@
+package stock_repository
+
+import (
+	"time"
+
+	stock_entity "github.com/Fi44er/sdmed/internal/module/stock/entity"
+	stock_model "github.com/Fi44er/sdmed/internal/module/stock/infrastructure/repository/model"
+)
+
+type Converter struct{}
+
+func (c *Converter) ToEntity(model *stock_model.Stock) *stock_entity.Stock {
+	return &stock_entity.Stock{
+		ProductID: model.ProductID,
+		Quantity:  model.Quantity,
+		Reserved:  model.Reserved,
+		UpdatedAt: model.UpdatedAt,
+	}
+}
+
+func (c *Converter) ToReservationModel(entity *stock_entity.Reservation) *stock_model.Reservation {
+	var expiresAt *time.Time
+	if !entity.ExpiresAt.IsZero() {
+		expiresAt = &entity.ExpiresAt
+	}
+
+	return &stock_model.Reservation{
+		OrderID:   entity.OrderID,
+		ProductID: entity.ProductID,
+		Quantity:  entity.Quantity,
+		Status:    string(entity.Status),
+		ExpiresAt: expiresAt,
+	}
+}
+
+func (c *Converter) ToReservationEntity(model *stock_model.Reservation) *stock_entity.Reservation {
+	reservation := &stock_entity.Reservation{
+		ID:        model.ID,
+		OrderID:   model.OrderID,
+		ProductID: model.ProductID,
+		Quantity:  model.Quantity,
+		Status:    stock_entity.ReservationStatus(model.Status),
+		CreatedAt: model.CreatedAt,
+		UpdatedAt: model.UpdatedAt,
+	}
+	if model.ExpiresAt != nil {
+		reservation.ExpiresAt = *model.ExpiresAt
+	}
+
+	return reservation
+}
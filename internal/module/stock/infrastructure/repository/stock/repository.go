@@ -0,0 +1,272 @@
+package stock_repository
+
+import (
+	"context"
+	"time"
+
+	stock_entity "github.com/Fi44er/sdmed/internal/module/stock/entity"
+	stock_model "github.com/Fi44er/sdmed/internal/module/stock/infrastructure/repository/model"
+	"github.com/Fi44er/sdmed/pkg/logger"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type IStockRepository interface {
+	GetByProductID(ctx context.Context, productID string) (*stock_entity.Stock, error)
+	GetAll(ctx context.Context, offset, limit int) ([]stock_entity.Stock, error)
+	Count(ctx context.Context) (int64, error)
+	SetQuantity(ctx context.Context, productID string, quantity int) (bool, error)
+	Reserve(ctx context.Context, productID string, quantity int) (bool, error)
+	Unreserve(ctx context.Context, productID string, quantity int) error
+	WriteOff(ctx context.Context, productID string, quantity int) error
+	Restock(ctx context.Context, productID string, quantity int) error
+
+	CreateReservation(ctx context.Context, reservation *stock_entity.Reservation) error
+	GetActiveReservations(ctx context.Context, orderID, productID string) ([]stock_entity.Reservation, error)
+	UpdateReservationStatus(ctx context.Context, orderID string, from, to stock_entity.ReservationStatus) error
+	SetExpiry(ctx context.Context, orderID string, expiresAt time.Time) error
+	GetExpiredOrderIDs(ctx context.Context, now time.Time, limit int) ([]string, error)
+}
+
+type StockRepository struct {
+	logger    *logger.Logger
+	db        *gorm.DB
+	converter *Converter
+}
+
+func NewStockRepository(logger *logger.Logger, db *gorm.DB) IStockRepository {
+	return &StockRepository{
+		logger:    logger,
+		db:        db,
+		converter: &Converter{},
+	}
+}
+
+func (r *StockRepository) GetByProductID(ctx context.Context, productID string) (*stock_entity.Stock, error) {
+	var stockModel stock_model.Stock
+	err := r.db.WithContext(ctx).First(&stockModel, "product_id = ?", productID).Error
+	if err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, nil
+		}
+		r.logger.Errorf("Failed to get stock of product %s: %v", productID, err)
+		return nil, err
+	}
+
+	return r.converter.ToEntity(&stockModel), nil
+}
+
+func (r *StockRepository) GetAll(ctx context.Context, offset, limit int) ([]stock_entity.Stock, error) {
+	var stockModels []stock_model.Stock
+	err := r.db.WithContext(ctx).
+		Order("updated_at DESC").
+		Offset(offset).
+		Limit(limit).
+		Find(&stockModels).Error
+	if err != nil {
+		r.logger.Errorf("Failed to get stocks: %v", err)
+		return nil, err
+	}
+
+	stocks := make([]stock_entity.Stock, len(stockModels))
+	for i := range stockModels {
+		stocks[i] = *r.converter.ToEntity(&stockModels[i])
+	}
+
+	return stocks, nil
+}
+
+func (r *StockRepository) Count(ctx context.Context) (int64, error) {
+	var count int64
+	if err := r.db.WithContext(ctx).Model(&stock_model.Stock{}).Count(&count).Error; err != nil {
+		r.logger.Errorf("Failed to count stocks: %v", err)
+		return 0, err
+	}
+
+	return count, nil
+}
+
+// SetQuantity создает или обновляет остаток товара. Возвращает false,
+// если новый остаток меньше уже зарезервированного количества
+func (r *StockRepository) SetQuantity(ctx context.Context, productID string, quantity int) (bool, error) {
+	result := r.db.WithContext(ctx).
+		Clauses(clause.OnConflict{
+			Columns: []clause.Column{{Name: "product_id"}},
+			DoUpdates: clause.Assignments(map[string]any{
+				"quantity":   quantity,
+				"updated_at": gorm.Expr("now()"),
+			}),
+			Where: clause.Where{Exprs: []clause.Expression{
+				clause.Expr{SQL: "stocks.reserved <= ?", Vars: []any{quantity}},
+			}},
+		}).
+		Create(&stock_model.Stock{ProductID: productID, Quantity: quantity})
+	if result.Error != nil {
+		r.logger.Errorf("Failed to set stock of product %s: %v", productID, result.Error)
+		return false, result.Error
+	}
+
+	return result.RowsAffected > 0, nil
+}
+
+// Reserve увеличивает резерв, только если свободного остатка хватает.
+// Проверка и изменение выполняются одним запросом, поэтому последний товар
+// не может достаться двум заказам. Возвращает false, если остатка нет или товар не учитывается
+func (r *StockRepository) Reserve(ctx context.Context, productID string, quantity int) (bool, error) {
+	result := r.db.WithContext(ctx).
+		Model(&stock_model.Stock{}).
+		Where("product_id = ? AND quantity - reserved >= ?", productID, quantity).
+		Updates(map[string]any{
+			"reserved":   gorm.Expr("reserved + ?", quantity),
+			"updated_at": gorm.Expr("now()"),
+		})
+	if result.Error != nil {
+		r.logger.Errorf("Failed to reserve %d of product %s: %v", quantity, productID, result.Error)
+		return false, result.Error
+	}
+
+	return result.RowsAffected > 0, nil
+}
+
+func (r *StockRepository) Unreserve(ctx context.Context, productID string, quantity int) error {
+	err := r.db.WithContext(ctx).
+		Model(&stock_model.Stock{}).
+		Where("product_id = ?", productID).
+		Updates(map[string]any{
+			"reserved":   gorm.Expr("greatest(reserved - ?, 0)", quantity),
+			"updated_at": gorm.Expr("now()"),
+		}).Error
+	if err != nil {
+		r.logger.Errorf("Failed to release %d of product %s: %v", quantity, productID, err)
+		return err
+	}
+
+	return nil
+}
+
+// WriteOff списывает зарезервированный товар с остатка
+func (r *StockRepository) WriteOff(ctx context.Context, productID string, quantity int) error {
+	err := r.db.WithContext(ctx).
+		Model(&stock_model.Stock{}).
+		Where("product_id = ?", productID).
+		Updates(map[string]any{
+			"quantity":   gorm.Expr("greatest(quantity - ?, 0)", quantity),
+			"reserved":   gorm.Expr("greatest(reserved - ?, 0)", quantity),
+			"updated_at": gorm.Expr("now()"),
+		}).Error
+	if err != nil {
+		r.logger.Errorf("Failed to write off %d of product %s: %v", quantity, productID, err)
+		return err
+	}
+
+	return nil
+}
+
+// Restock возвращает товар в остаток. Товар без учета остатков не меняется
+func (r *StockRepository) Restock(ctx context.Context, productID string, quantity int) error {
+	err := r.db.WithContext(ctx).
+		Model(&stock_model.Stock{}).
+		Where("product_id = ?", productID).
+		Updates(map[string]any{
+			"quantity":   gorm.Expr("quantity + ?", quantity),
+			"updated_at": gorm.Expr("now()"),
+		}).Error
+	if err != nil {
+		r.logger.Errorf("Failed to restock %d of product %s: %v", quantity, productID, err)
+		return err
+	}
+
+	return nil
+}
+
+func (r *StockRepository) CreateReservation(ctx context.Context, reservation *stock_entity.Reservation) error {
+	reservationModel := r.converter.ToReservationModel(reservation)
+	if err := r.db.WithContext(ctx).Create(reservationModel).Error; err != nil {
+		r.logger.Errorf("Failed to create reservation of product %s for order %s: %v", reservation.ProductID, reservation.OrderID, err)
+		return err
+	}
+	reservation.ID = reservationModel.ID
+	reservation.CreatedAt = reservationModel.CreatedAt
+	reservation.UpdatedAt = reservationModel.UpdatedAt
+
+	return nil
+}
+
+// GetActiveReservations возвращает действующие резервы заказа или товара, пустой параметр не фильтрует
+func (r *StockRepository) GetActiveReservations(ctx context.Context, orderID, productID string) ([]stock_entity.Reservation, error) {
+	query := r.db.WithContext(ctx).Where("status = ?", string(stock_entity.ReservationStatusActive))
+	if orderID != "" {
+		query = query.Where("order_id = ?", orderID)
+	}
+	if productID != "" {
+		query = query.Where("product_id = ?", productID)
+	}
+
+	var reservationModels []stock_model.Reservation
+	if err := query.Order("created_at ASC").Find(&reservationModels).Error; err != nil {
+		r.logger.Errorf("Failed to get reservations (order %q, product %q): %v", orderID, productID, err)
+		return nil, err
+	}
+
+	reservations := make([]stock_entity.Reservation, len(reservationModels))
+	for i := range reservationModels {
+		reservations[i] = *r.converter.ToReservationEntity(&reservationModels[i])
+	}
+
+	return reservations, nil
+}
+
+func (r *StockRepository) UpdateReservationStatus(ctx context.Context, orderID string, from, to stock_entity.ReservationStatus) error {
+	err := r.db.WithContext(ctx).
+		Model(&stock_model.Reservation{}).
+		Where("order_id = ? AND status = ?", orderID, string(from)).
+		Updates(map[string]any{
+			"status":     string(to),
+			"updated_at": gorm.Expr("now()"),
+		}).Error
+	if err != nil {
+		r.logger.Errorf("Failed to change reservations of order %s to %s: %v", orderID, to, err)
+		return err
+	}
+
+	return nil
+}
+
+// SetExpiry задает срок действующих резервов заказа. Пустой expiresAt снимает таймаут
+func (r *StockRepository) SetExpiry(ctx context.Context, orderID string, expiresAt time.Time) error {
+	var expiry *time.Time
+	if !expiresAt.IsZero() {
+		expiry = &expiresAt
+	}
+
+	err := r.db.WithContext(ctx).
+		Model(&stock_model.Reservation{}).
+		Where("order_id = ? AND status = ?", orderID, string(stock_entity.ReservationStatusActive)).
+		Updates(map[string]any{
+			"expires_at": expiry,
+			"updated_at": gorm.Expr("now()"),
+		}).Error
+	if err != nil {
+		r.logger.Errorf("Failed to set reservation expiry of order %s: %v", orderID, err)
+		return err
+	}
+
+	return nil
+}
+
+// GetExpiredOrderIDs возвращает заказы с действующими резервами, срок которых истек к now
+func (r *StockRepository) GetExpiredOrderIDs(ctx context.Context, now time.Time, limit int) ([]string, error) {
+	var orderIDs []string
+	err := r.db.WithContext(ctx).
+		Model(&stock_model.Reservation{}).
+		Distinct("order_id").
+		Where("status = ? AND expires_at < ?", string(stock_entity.ReservationStatusActive), now).
+		Limit(limit).
+		Pluck("order_id", &orderIDs).Error
+	if err != nil {
+		r.logger.Errorf("Failed to get orders with expired reservations: %v", err)
+		return nil, err
+	}
+
+	return orderIDs, nil
+}
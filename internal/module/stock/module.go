@@ -0,0 +1,66 @@
+package stock_module
+
+import (
+	product_usecase "github.com/Fi44er/sdmed/internal/module/product/usecase/product"
+	stock_http "github.com/Fi44er/sdmed/internal/module/stock/delivery/http"
+	stock_adapters "github.com/Fi44er/sdmed/internal/module/stock/infrastructure/adapters"
+	stock_repository "github.com/Fi44er/sdmed/internal/module/stock/infrastructure/repository/stock"
+	stock_usecase "github.com/Fi44er/sdmed/internal/module/stock/usecase/stock"
+	"github.com/Fi44er/sdmed/pkg/logger"
+	"github.com/Fi44er/sdmed/pkg/postgres/uow"
+	"github.com/go-playground/validator/v10"
+	"github.com/gofiber/fiber/v2"
+	"gorm.io/gorm"
+)
+
+type StockModule struct {
+	stockRepository stock_repository.IStockRepository
+	stockUsecase    stock_usecase.IStockUsecase
+	stockHandler    *stock_http.StockHandler
+
+	productUsecase product_usecase.IProductUsecase
+
+	logger    *logger.Logger
+	validator *validator.Validate
+	db        *gorm.DB
+	uow       uow.Uow
+}
+
+func NewStockModule(
+	logger *logger.Logger,
+	validator *validator.Validate,
+	db *gorm.DB,
+	uow uow.Uow,
+	productUsecase product_usecase.IProductUsecase,
+) *StockModule {
+	return &StockModule{
+		logger:         logger,
+		validator:      validator,
+		db:             db,
+		uow:            uow,
+		productUsecase: productUsecase,
+	}
+}
+
+func (m *StockModule) Init() {
+	m.uow.RegisterRepository("stock", func(tx *gorm.DB) (any, error) {
+		return stock_repository.NewStockRepository(m.logger, tx), nil
+	})
+
+	m.stockRepository = stock_repository.NewStockRepository(m.logger, m.db)
+	m.stockUsecase = stock_usecase.NewStockUsecase(
+		m.stockRepository,
+		stock_adapters.NewProductUsecaseAdapter(m.productUsecase),
+		m.uow,
+		m.logger,
+	)
+	m.stockHandler = stock_http.NewStockHandler(m.stockUsecase, m.validator, m.logger)
+}
+
+func (m *StockModule) InitDelivery(router fiber.Router) {
+	m.stockHandler.RegisterRoutes(router)
+}
+
+func (m *StockModule) GetStockUsecase() stock_usecase.IStockUsecase {
+	return m.stockUsecase
+}
@@ -0,0 +1,9 @@
+package stock_constant
+
+import "github.com/Fi44er/sdmed/pkg/customerr"
+
+var (
+	ErrProductNotFound       = customerr.NewError(404, "product not found")
+	ErrInsufficientStock     = customerr.NewError(409, "not enough stock to reserve the ordered quantity")
+	ErrQuantityBelowReserved = customerr.NewError(409, "stock quantity cannot be less than the reserved quantity")
+)
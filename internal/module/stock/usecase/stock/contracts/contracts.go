@@ -0,0 +1,29 @@
+package stock_usecase_contracts
+
+import (
+	"context"
+	"time"
+
+	stock_entity "github.com/Fi44er/sdmed/internal/module/stock/entity"
+)
+
+type IStockRepository interface {
+	GetByProductID(ctx context.Context, productID string) (*stock_entity.Stock, error)
+	GetAll(ctx context.Context, offset, limit int) ([]stock_entity.Stock, error)
+	Count(ctx context.Context) (int64, error)
+	SetQuantity(ctx context.Context, productID string, quantity int) (bool, error)
+	Reserve(ctx context.Context, productID string, quantity int) (bool, error)
+	Unreserve(ctx context.Context, productID string, quantity int) error
+	WriteOff(ctx context.Context, productID string, quantity int) error
+	Restock(ctx context.Context, productID string, quantity int) error
+
+	CreateReservation(ctx context.Context, reservation *stock_entity.Reservation) error
+	GetActiveReservations(ctx context.Context, orderID, productID string) ([]stock_entity.Reservation, error)
+	UpdateReservationStatus(ctx context.Context, orderID string, from, to stock_entity.ReservationStatus) error
+	SetExpiry(ctx context.Context, orderID string, expiresAt time.Time) error
+	GetExpiredOrderIDs(ctx context.Context, now time.Time, limit int) ([]string, error)
+}
+
+type IProductUsecaseAdapter interface {
+	Exists(ctx context.Context, productID string) (bool, error)
+}
@@ -0,0 +1,264 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./stock/contracts/contracts.go
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	stock_entity "github.com/Fi44er/sdmed/internal/module/stock/entity"
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockIStockRepository is a mock of IStockRepository interface.
+type MockIStockRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockIStockRepositoryMockRecorder
+}
+
+// MockIStockRepositoryMockRecorder is the mock recorder for MockIStockRepository.
+type MockIStockRepositoryMockRecorder struct {
+	mock *MockIStockRepository
+}
+
+// NewMockIStockRepository creates a new mock instance.
+func NewMockIStockRepository(ctrl *gomock.Controller) *MockIStockRepository {
+	mock := &MockIStockRepository{ctrl: ctrl}
+	mock.recorder = &MockIStockRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockIStockRepository) EXPECT() *MockIStockRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Count mocks base method.
+func (m *MockIStockRepository) Count(ctx context.Context) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Count", ctx)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Count indicates an expected call of Count.
+func (mr *MockIStockRepositoryMockRecorder) Count(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Count", reflect.TypeOf((*MockIStockRepository)(nil).Count), ctx)
+}
+
+// CreateReservation mocks base method.
+func (m *MockIStockRepository) CreateReservation(ctx context.Context, reservation *stock_entity.Reservation) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateReservation", ctx, reservation)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateReservation indicates an expected call of CreateReservation.
+func (mr *MockIStockRepositoryMockRecorder) CreateReservation(ctx, reservation interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateReservation", reflect.TypeOf((*MockIStockRepository)(nil).CreateReservation), ctx, reservation)
+}
+
+// GetActiveReservations mocks base method.
+func (m *MockIStockRepository) GetActiveReservations(ctx context.Context, orderID, productID string) ([]stock_entity.Reservation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetActiveReservations", ctx, orderID, productID)
+	ret0, _ := ret[0].([]stock_entity.Reservation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetActiveReservations indicates an expected call of GetActiveReservations.
+func (mr *MockIStockRepositoryMockRecorder) GetActiveReservations(ctx, orderID, productID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetActiveReservations", reflect.TypeOf((*MockIStockRepository)(nil).GetActiveReservations), ctx, orderID, productID)
+}
+
+// GetAll mocks base method.
+func (m *MockIStockRepository) GetAll(ctx context.Context, offset, limit int) ([]stock_entity.Stock, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAll", ctx, offset, limit)
+	ret0, _ := ret[0].([]stock_entity.Stock)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAll indicates an expected call of GetAll.
+func (mr *MockIStockRepositoryMockRecorder) GetAll(ctx, offset, limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAll", reflect.TypeOf((*MockIStockRepository)(nil).GetAll), ctx, offset, limit)
+}
+
+// GetByProductID mocks base method.
+func (m *MockIStockRepository) GetByProductID(ctx context.Context, productID string) (*stock_entity.Stock, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByProductID", ctx, productID)
+	ret0, _ := ret[0].(*stock_entity.Stock)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByProductID indicates an expected call of GetByProductID.
+func (mr *MockIStockRepositoryMockRecorder) GetByProductID(ctx, productID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByProductID", reflect.TypeOf((*MockIStockRepository)(nil).GetByProductID), ctx, productID)
+}
+
+// GetExpiredOrderIDs mocks base method.
+func (m *MockIStockRepository) GetExpiredOrderIDs(ctx context.Context, now time.Time, limit int) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetExpiredOrderIDs", ctx, now, limit)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetExpiredOrderIDs indicates an expected call of GetExpiredOrderIDs.
+func (mr *MockIStockRepositoryMockRecorder) GetExpiredOrderIDs(ctx, now, limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetExpiredOrderIDs", reflect.TypeOf((*MockIStockRepository)(nil).GetExpiredOrderIDs), ctx, now, limit)
+}
+
+// Reserve mocks base method.
+func (m *MockIStockRepository) Reserve(ctx context.Context, productID string, quantity int) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Reserve", ctx, productID, quantity)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Reserve indicates an expected call of Reserve.
+func (mr *MockIStockRepositoryMockRecorder) Reserve(ctx, productID, quantity interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Reserve", reflect.TypeOf((*MockIStockRepository)(nil).Reserve), ctx, productID, quantity)
+}
+
+// Restock mocks base method.
+func (m *MockIStockRepository) Restock(ctx context.Context, productID string, quantity int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Restock", ctx, productID, quantity)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Restock indicates an expected call of Restock.
+func (mr *MockIStockRepositoryMockRecorder) Restock(ctx, productID, quantity interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Restock", reflect.TypeOf((*MockIStockRepository)(nil).Restock), ctx, productID, quantity)
+}
+
+// SetExpiry mocks base method.
+func (m *MockIStockRepository) SetExpiry(ctx context.Context, orderID string, expiresAt time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetExpiry", ctx, orderID, expiresAt)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetExpiry indicates an expected call of SetExpiry.
+func (mr *MockIStockRepositoryMockRecorder) SetExpiry(ctx, orderID, expiresAt interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetExpiry", reflect.TypeOf((*MockIStockRepository)(nil).SetExpiry), ctx, orderID, expiresAt)
+}
+
+// SetQuantity mocks base method.
+func (m *MockIStockRepository) SetQuantity(ctx context.Context, productID string, quantity int) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetQuantity", ctx, productID, quantity)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetQuantity indicates an expected call of SetQuantity.
+func (mr *MockIStockRepositoryMockRecorder) SetQuantity(ctx, productID, quantity interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetQuantity", reflect.TypeOf((*MockIStockRepository)(nil).SetQuantity), ctx, productID, quantity)
+}
+
+// Unreserve mocks base method.
+func (m *MockIStockRepository) Unreserve(ctx context.Context, productID string, quantity int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Unreserve", ctx, productID, quantity)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Unreserve indicates an expected call of Unreserve.
+func (mr *MockIStockRepositoryMockRecorder) Unreserve(ctx, productID, quantity interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Unreserve", reflect.TypeOf((*MockIStockRepository)(nil).Unreserve), ctx, productID, quantity)
+}
+
+// UpdateReservationStatus mocks base method.
+func (m *MockIStockRepository) UpdateReservationStatus(ctx context.Context, orderID string, from, to stock_entity.ReservationStatus) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateReservationStatus", ctx, orderID, from, to)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateReservationStatus indicates an expected call of UpdateReservationStatus.
+func (mr *MockIStockRepositoryMockRecorder) UpdateReservationStatus(ctx, orderID, from, to interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateReservationStatus", reflect.TypeOf((*MockIStockRepository)(nil).UpdateReservationStatus), ctx, orderID, from, to)
+}
+
+// WriteOff mocks base method.
+func (m *MockIStockRepository) WriteOff(ctx context.Context, productID string, quantity int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WriteOff", ctx, productID, quantity)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// WriteOff indicates an expected call of WriteOff.
+func (mr *MockIStockRepositoryMockRecorder) WriteOff(ctx, productID, quantity interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WriteOff", reflect.TypeOf((*MockIStockRepository)(nil).WriteOff), ctx, productID, quantity)
+}
+
+// MockIProductUsecaseAdapter is a mock of IProductUsecaseAdapter interface.
+type MockIProductUsecaseAdapter struct {
+	ctrl     *gomock.Controller
+	recorder *MockIProductUsecaseAdapterMockRecorder
+}
+
+// MockIProductUsecaseAdapterMockRecorder is the mock recorder for MockIProductUsecaseAdapter.
+type MockIProductUsecaseAdapterMockRecorder struct {
+	mock *MockIProductUsecaseAdapter
+}
+
+// NewMockIProductUsecaseAdapter creates a new mock instance.
+func NewMockIProductUsecaseAdapter(ctrl *gomock.Controller) *MockIProductUsecaseAdapter {
+	mock := &MockIProductUsecaseAdapter{ctrl: ctrl}
+	mock.recorder = &MockIProductUsecaseAdapterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockIProductUsecaseAdapter) EXPECT() *MockIProductUsecaseAdapterMockRecorder {
+	return m.recorder
+}
+
+// Exists mocks base method.
+func (m *MockIProductUsecaseAdapter) Exists(ctx context.Context, productID string) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Exists", ctx, productID)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Exists indicates an expected call of Exists.
+func (mr *MockIProductUsecaseAdapterMockRecorder) Exists(ctx, productID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Exists", reflect.TypeOf((*MockIProductUsecaseAdapter)(nil).Exists), ctx, productID)
+}
@@ -0,0 +1,45 @@
+package stock_testcases
+
+import (
+	"context"
+	"time"
+
+	"github.com/Fi44er/sdmed/internal/module/stock/usecase/stock/mock"
+	uow_mock "github.com/Fi44er/sdmed/pkg/postgres/uow/mock"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+type MockConfirm struct {
+	Ctrl     *gomock.Controller
+	Ctx      context.Context
+	RepoMock *mock.MockIStockRepository
+	UowMock  *uow_mock.MockUow
+	T        assert.TestingT
+}
+
+type ConfirmTestCase struct {
+	Name          string
+	SetupMocks    func(m *MockConfirm)
+	ExpectedError error
+}
+
+func GetConfirmTestCases() []ConfirmTestCase {
+	return []ConfirmTestCase{
+		{
+			Name: "deadline_removed",
+			SetupMocks: func(m *MockConfirm) {
+				expectTx(m.Ctx, m.UowMock, m.RepoMock)
+				m.RepoMock.EXPECT().SetExpiry(m.Ctx, OrderID, time.Time{}).Return(nil)
+			},
+		},
+		{
+			Name: "repository_error",
+			SetupMocks: func(m *MockConfirm) {
+				expectTx(m.Ctx, m.UowMock, m.RepoMock)
+				m.RepoMock.EXPECT().SetExpiry(m.Ctx, OrderID, time.Time{}).Return(errRepository)
+			},
+			ExpectedError: errRepository,
+		},
+	}
+}
@@ -0,0 +1,44 @@
+package stock_testcases
+
+import (
+	"context"
+	"time"
+
+	"github.com/Fi44er/sdmed/internal/module/stock/usecase/stock/mock"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+type MockExtend struct {
+	Ctrl     *gomock.Controller
+	Ctx      context.Context
+	RepoMock *mock.MockIStockRepository
+	T        assert.TestingT
+}
+
+type ExtendTestCase struct {
+	Name          string
+	ExpiresAt     time.Time
+	SetupMocks    func(m *MockExtend)
+	ExpectedError error
+}
+
+func GetExtendTestCases() []ExtendTestCase {
+	return []ExtendTestCase{
+		{
+			Name:      "deadline_moved",
+			ExpiresAt: ExpiresAt.Add(time.Hour),
+			SetupMocks: func(m *MockExtend) {
+				m.RepoMock.EXPECT().SetExpiry(m.Ctx, OrderID, ExpiresAt.Add(time.Hour)).Return(nil)
+			},
+		},
+		{
+			Name:      "repository_error",
+			ExpiresAt: ExpiresAt,
+			SetupMocks: func(m *MockExtend) {
+				m.RepoMock.EXPECT().SetExpiry(m.Ctx, OrderID, ExpiresAt).Return(errRepository)
+			},
+			ExpectedError: errRepository,
+		},
+	}
+}
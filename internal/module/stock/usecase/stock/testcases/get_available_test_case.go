@@ -0,0 +1,55 @@
+package stock_testcases
+
+import (
+	"context"
+
+	stock_entity "github.com/Fi44er/sdmed/internal/module/stock/entity"
+	"github.com/Fi44er/sdmed/internal/module/stock/usecase/stock/mock"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+type MockGetAvailable struct {
+	Ctrl     *gomock.Controller
+	Ctx      context.Context
+	RepoMock *mock.MockIStockRepository
+	T        assert.TestingT
+}
+
+type GetAvailableTestCase struct {
+	Name              string
+	ProductID         string
+	SetupMocks        func(m *MockGetAvailable)
+	ExpectedAvailable int
+	ExpectedTracked   bool
+	ExpectedError     error
+}
+
+func GetGetAvailableTestCases() []GetAvailableTestCase {
+	return []GetAvailableTestCase{
+		{
+			Name:      "free_quantity",
+			ProductID: "cane",
+			SetupMocks: func(m *MockGetAvailable) {
+				m.RepoMock.EXPECT().GetByProductID(m.Ctx, "cane").Return(&stock_entity.Stock{ProductID: "cane", Quantity: 3, Reserved: 1}, nil)
+			},
+			ExpectedAvailable: 2,
+			ExpectedTracked:   true,
+		},
+		{
+			Name:      "untracked_product",
+			ProductID: "untracked",
+			SetupMocks: func(m *MockGetAvailable) {
+				m.RepoMock.EXPECT().GetByProductID(m.Ctx, "untracked").Return(nil, nil)
+			},
+		},
+		{
+			Name:      "repository_error",
+			ProductID: "cane",
+			SetupMocks: func(m *MockGetAvailable) {
+				m.RepoMock.EXPECT().GetByProductID(m.Ctx, "cane").Return(nil, errRepository)
+			},
+			ExpectedError: errRepository,
+		},
+	}
+}
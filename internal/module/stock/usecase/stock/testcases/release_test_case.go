@@ -0,0 +1,58 @@
+package stock_testcases
+
+import (
+	"context"
+
+	stock_entity "github.com/Fi44er/sdmed/internal/module/stock/entity"
+	"github.com/Fi44er/sdmed/internal/module/stock/usecase/stock/mock"
+	uow_mock "github.com/Fi44er/sdmed/pkg/postgres/uow/mock"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+type MockRelease struct {
+	Ctrl     *gomock.Controller
+	Ctx      context.Context
+	RepoMock *mock.MockIStockRepository
+	UowMock  *uow_mock.MockUow
+	T        assert.TestingT
+}
+
+type ReleaseTestCase struct {
+	Name          string
+	SetupMocks    func(m *MockRelease)
+	ExpectedError error
+}
+
+func GetReleaseTestCases() []ReleaseTestCase {
+	return []ReleaseTestCase{
+		{
+			Name: "reserved_quantity_returned",
+			SetupMocks: func(m *MockRelease) {
+				expectTx(m.Ctx, m.UowMock, m.RepoMock)
+				gomock.InOrder(
+					m.RepoMock.EXPECT().GetActiveReservations(m.Ctx, OrderID, "").Return(active("cane", 2), nil),
+					m.RepoMock.EXPECT().Unreserve(m.Ctx, "cane", 2).Return(nil),
+					m.RepoMock.EXPECT().UpdateReservationStatus(m.Ctx, OrderID, stock_entity.ReservationStatusActive, stock_entity.ReservationStatusReleased).Return(nil),
+				)
+			},
+		},
+		{
+			Name: "written_off_order_not_returned",
+			SetupMocks: func(m *MockRelease) {
+				expectTx(m.Ctx, m.UowMock, m.RepoMock)
+				m.RepoMock.EXPECT().GetActiveReservations(m.Ctx, OrderID, "").Return(nil, nil)
+				m.RepoMock.EXPECT().UpdateReservationStatus(m.Ctx, OrderID, stock_entity.ReservationStatusActive, stock_entity.ReservationStatusReleased).Return(nil)
+			},
+		},
+		{
+			Name: "unreserve_failed",
+			SetupMocks: func(m *MockRelease) {
+				expectTx(m.Ctx, m.UowMock, m.RepoMock)
+				m.RepoMock.EXPECT().GetActiveReservations(m.Ctx, OrderID, "").Return(active("cane", 2), nil)
+				m.RepoMock.EXPECT().Unreserve(m.Ctx, "cane", 2).Return(errRepository)
+			},
+			ExpectedError: errRepository,
+		},
+	}
+}
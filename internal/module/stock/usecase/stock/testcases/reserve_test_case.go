@@ -0,0 +1,98 @@
+package stock_testcases
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	stock_entity "github.com/Fi44er/sdmed/internal/module/stock/entity"
+	stock_constant "github.com/Fi44er/sdmed/internal/module/stock/pkg"
+	"github.com/Fi44er/sdmed/internal/module/stock/usecase/stock/mock"
+	uow_mock "github.com/Fi44er/sdmed/pkg/postgres/uow/mock"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+const OrderID = "order-1"
+
+var (
+	ExpiresAt = time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+
+	errRepository = errors.New("repository error")
+)
+
+type MockReserve struct {
+	Ctrl     *gomock.Controller
+	Ctx      context.Context
+	RepoMock *mock.MockIStockRepository
+	UowMock  *uow_mock.MockUow
+	T        assert.TestingT
+}
+
+type ReserveTestCase struct {
+	Name          string
+	Items         []stock_entity.ReserveItem
+	SetupMocks    func(m *MockReserve)
+	ExpectedError error
+}
+
+func expectTx(ctx context.Context, uowMock *uow_mock.MockUow, repo any) {
+	uowMock.EXPECT().
+		Do(ctx, gomock.Any()).
+		DoAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+			return fn(ctx)
+		})
+	uowMock.EXPECT().GetRepository(ctx, "stock").Return(repo, nil)
+}
+
+func reservation(productID string, quantity int) *stock_entity.Reservation {
+	return &stock_entity.Reservation{
+		OrderID:   OrderID,
+		ProductID: productID,
+		Quantity:  quantity,
+		Status:    stock_entity.ReservationStatusActive,
+		ExpiresAt: ExpiresAt,
+	}
+}
+
+func GetReserveTestCases() []ReserveTestCase {
+	return []ReserveTestCase{
+		{
+			Name: "items_of_one_product_merged",
+			Items: []stock_entity.ReserveItem{
+				{ProductID: "cane", Quantity: 1},
+				{ProductID: "untracked", Quantity: 5},
+				{ProductID: "cane", Quantity: 1},
+			},
+			SetupMocks: func(m *MockReserve) {
+				expectTx(m.Ctx, m.UowMock, m.RepoMock)
+				gomock.InOrder(
+					m.RepoMock.EXPECT().Reserve(m.Ctx, "cane", 2).Return(true, nil),
+					m.RepoMock.EXPECT().CreateReservation(m.Ctx, reservation("cane", 2)).Return(nil),
+					m.RepoMock.EXPECT().Reserve(m.Ctx, "untracked", 5).Return(false, nil),
+					m.RepoMock.EXPECT().GetByProductID(m.Ctx, "untracked").Return(nil, nil),
+				)
+			},
+		},
+		{
+			Name:  "not_enough_stock",
+			Items: []stock_entity.ReserveItem{{ProductID: "walker", Quantity: 1}},
+			SetupMocks: func(m *MockReserve) {
+				expectTx(m.Ctx, m.UowMock, m.RepoMock)
+				m.RepoMock.EXPECT().Reserve(m.Ctx, "walker", 1).Return(false, nil)
+				m.RepoMock.EXPECT().GetByProductID(m.Ctx, "walker").Return(&stock_entity.Stock{ProductID: "walker", Quantity: 1, Reserved: 1}, nil)
+			},
+			ExpectedError: stock_constant.ErrInsufficientStock,
+		},
+		{
+			Name:  "reservation_not_saved",
+			Items: []stock_entity.ReserveItem{{ProductID: "cane", Quantity: 1}},
+			SetupMocks: func(m *MockReserve) {
+				expectTx(m.Ctx, m.UowMock, m.RepoMock)
+				m.RepoMock.EXPECT().Reserve(m.Ctx, "cane", 1).Return(true, nil)
+				m.RepoMock.EXPECT().CreateReservation(m.Ctx, reservation("cane", 1)).Return(errRepository)
+			},
+			ExpectedError: errRepository,
+		},
+	}
+}
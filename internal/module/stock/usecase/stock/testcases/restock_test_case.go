@@ -0,0 +1,55 @@
+package stock_testcases
+
+import (
+	"context"
+
+	stock_entity "github.com/Fi44er/sdmed/internal/module/stock/entity"
+	"github.com/Fi44er/sdmed/internal/module/stock/usecase/stock/mock"
+	uow_mock "github.com/Fi44er/sdmed/pkg/postgres/uow/mock"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+type MockRestock struct {
+	Ctrl     *gomock.Controller
+	Ctx      context.Context
+	RepoMock *mock.MockIStockRepository
+	UowMock  *uow_mock.MockUow
+	T        assert.TestingT
+}
+
+type RestockTestCase struct {
+	Name          string
+	Items         []stock_entity.ReserveItem
+	SetupMocks    func(m *MockRestock)
+	ExpectedError error
+}
+
+func GetRestockTestCases() []RestockTestCase {
+	return []RestockTestCase{
+		{
+			Name: "items_returned_to_stock",
+			Items: []stock_entity.ReserveItem{
+				{ProductID: "cane", Quantity: 1},
+				{ProductID: "walker", Quantity: 1},
+				{ProductID: "cane", Quantity: 2},
+			},
+			SetupMocks: func(m *MockRestock) {
+				expectTx(m.Ctx, m.UowMock, m.RepoMock)
+				gomock.InOrder(
+					m.RepoMock.EXPECT().Restock(m.Ctx, "cane", 3).Return(nil),
+					m.RepoMock.EXPECT().Restock(m.Ctx, "walker", 1).Return(nil),
+				)
+			},
+		},
+		{
+			Name:  "repository_error",
+			Items: []stock_entity.ReserveItem{{ProductID: "cane", Quantity: 1}},
+			SetupMocks: func(m *MockRestock) {
+				expectTx(m.Ctx, m.UowMock, m.RepoMock)
+				m.RepoMock.EXPECT().Restock(m.Ctx, "cane", 1).Return(errRepository)
+			},
+			ExpectedError: errRepository,
+		},
+	}
+}
@@ -0,0 +1,64 @@
+package stock_testcases
+
+import (
+	"context"
+
+	stock_entity "github.com/Fi44er/sdmed/internal/module/stock/entity"
+	stock_constant "github.com/Fi44er/sdmed/internal/module/stock/pkg"
+	"github.com/Fi44er/sdmed/internal/module/stock/usecase/stock/mock"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+type MockSetQuantity struct {
+	Ctrl        *gomock.Controller
+	Ctx         context.Context
+	RepoMock    *mock.MockIStockRepository
+	ProductMock *mock.MockIProductUsecaseAdapter
+	T           assert.TestingT
+}
+
+type SetQuantityTestCase struct {
+	Name              string
+	ProductID         string
+	Quantity          int
+	SetupMocks        func(m *MockSetQuantity)
+	ExpectedAvailable int
+	ExpectedError     error
+}
+
+func GetSetQuantityTestCases() []SetQuantityTestCase {
+	return []SetQuantityTestCase{
+		{
+			Name:      "quantity_set",
+			ProductID: "cane",
+			Quantity:  10,
+			SetupMocks: func(m *MockSetQuantity) {
+				m.ProductMock.EXPECT().Exists(m.Ctx, "cane").Return(true, nil)
+				m.RepoMock.EXPECT().SetQuantity(m.Ctx, "cane", 10).Return(true, nil)
+				m.RepoMock.EXPECT().GetByProductID(m.Ctx, "cane").Return(&stock_entity.Stock{ProductID: "cane", Quantity: 10, Reserved: 2}, nil)
+				m.RepoMock.EXPECT().GetActiveReservations(m.Ctx, "", "cane").Return(active("cane", 2), nil)
+			},
+			ExpectedAvailable: 8,
+		},
+		{
+			Name:      "below_reserved",
+			ProductID: "cane",
+			Quantity:  1,
+			SetupMocks: func(m *MockSetQuantity) {
+				m.ProductMock.EXPECT().Exists(m.Ctx, "cane").Return(true, nil)
+				m.RepoMock.EXPECT().SetQuantity(m.Ctx, "cane", 1).Return(false, nil)
+			},
+			ExpectedError: stock_constant.ErrQuantityBelowReserved,
+		},
+		{
+			Name:      "product_not_found",
+			ProductID: "missing",
+			Quantity:  1,
+			SetupMocks: func(m *MockSetQuantity) {
+				m.ProductMock.EXPECT().Exists(m.Ctx, "missing").Return(false, nil)
+			},
+			ExpectedError: stock_constant.ErrProductNotFound,
+		},
+	}
+}
@@ -0,0 +1,74 @@
+package stock_testcases
+
+import (
+	"context"
+
+	stock_entity "github.com/Fi44er/sdmed/internal/module/stock/entity"
+	stock_constant "github.com/Fi44er/sdmed/internal/module/stock/pkg"
+	"github.com/Fi44er/sdmed/internal/module/stock/usecase/stock/mock"
+	uow_mock "github.com/Fi44er/sdmed/pkg/postgres/uow/mock"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+type MockUpdate struct {
+	Ctrl     *gomock.Controller
+	Ctx      context.Context
+	RepoMock *mock.MockIStockRepository
+	UowMock  *uow_mock.MockUow
+	T        assert.TestingT
+}
+
+type UpdateTestCase struct {
+	Name          string
+	Items         []stock_entity.ReserveItem
+	SetupMocks    func(m *MockUpdate)
+	ExpectedError error
+}
+
+func active(productID string, quantity int) []stock_entity.Reservation {
+	return []stock_entity.Reservation{*reservation(productID, quantity)}
+}
+
+func GetUpdateTestCases() []UpdateTestCase {
+	return []UpdateTestCase{
+		{
+			Name:  "reservation_rebuilt_with_order_deadline",
+			Items: []stock_entity.ReserveItem{{ProductID: "cane", Quantity: 3}},
+			SetupMocks: func(m *MockUpdate) {
+				expectTx(m.Ctx, m.UowMock, m.RepoMock)
+				gomock.InOrder(
+					m.RepoMock.EXPECT().GetActiveReservations(m.Ctx, OrderID, "").Return(active("walker", 1), nil),
+					m.RepoMock.EXPECT().Unreserve(m.Ctx, "walker", 1).Return(nil),
+					m.RepoMock.EXPECT().UpdateReservationStatus(m.Ctx, OrderID, stock_entity.ReservationStatusActive, stock_entity.ReservationStatusReleased).Return(nil),
+					m.RepoMock.EXPECT().Reserve(m.Ctx, "cane", 3).Return(true, nil),
+					m.RepoMock.EXPECT().CreateReservation(m.Ctx, reservation("cane", 3)).Return(nil),
+				)
+			},
+		},
+		{
+			Name:  "not_enough_stock_for_new_items",
+			Items: []stock_entity.ReserveItem{{ProductID: "cane", Quantity: 4}},
+			SetupMocks: func(m *MockUpdate) {
+				expectTx(m.Ctx, m.UowMock, m.RepoMock)
+				gomock.InOrder(
+					m.RepoMock.EXPECT().GetActiveReservations(m.Ctx, OrderID, "").Return(active("cane", 3), nil),
+					m.RepoMock.EXPECT().Unreserve(m.Ctx, "cane", 3).Return(nil),
+					m.RepoMock.EXPECT().UpdateReservationStatus(m.Ctx, OrderID, stock_entity.ReservationStatusActive, stock_entity.ReservationStatusReleased).Return(nil),
+					m.RepoMock.EXPECT().Reserve(m.Ctx, "cane", 4).Return(false, nil),
+					m.RepoMock.EXPECT().GetByProductID(m.Ctx, "cane").Return(&stock_entity.Stock{ProductID: "cane", Quantity: 3}, nil),
+				)
+			},
+			ExpectedError: stock_constant.ErrInsufficientStock,
+		},
+		{
+			Name:  "reservations_not_loaded",
+			Items: []stock_entity.ReserveItem{{ProductID: "cane", Quantity: 1}},
+			SetupMocks: func(m *MockUpdate) {
+				expectTx(m.Ctx, m.UowMock, m.RepoMock)
+				m.RepoMock.EXPECT().GetActiveReservations(m.Ctx, OrderID, "").Return(nil, errRepository)
+			},
+			ExpectedError: errRepository,
+		},
+	}
+}
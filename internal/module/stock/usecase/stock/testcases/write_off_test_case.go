@@ -0,0 +1,50 @@
+package stock_testcases
+
+import (
+	"context"
+
+	stock_entity "github.com/Fi44er/sdmed/internal/module/stock/entity"
+	"github.com/Fi44er/sdmed/internal/module/stock/usecase/stock/mock"
+	uow_mock "github.com/Fi44er/sdmed/pkg/postgres/uow/mock"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+type MockWriteOff struct {
+	Ctrl     *gomock.Controller
+	Ctx      context.Context
+	RepoMock *mock.MockIStockRepository
+	UowMock  *uow_mock.MockUow
+	T        assert.TestingT
+}
+
+type WriteOffTestCase struct {
+	Name          string
+	SetupMocks    func(m *MockWriteOff)
+	ExpectedError error
+}
+
+func GetWriteOffTestCases() []WriteOffTestCase {
+	return []WriteOffTestCase{
+		{
+			Name: "reserved_quantity_written_off",
+			SetupMocks: func(m *MockWriteOff) {
+				expectTx(m.Ctx, m.UowMock, m.RepoMock)
+				gomock.InOrder(
+					m.RepoMock.EXPECT().GetActiveReservations(m.Ctx, OrderID, "").Return(active("cane", 1), nil),
+					m.RepoMock.EXPECT().WriteOff(m.Ctx, "cane", 1).Return(nil),
+					m.RepoMock.EXPECT().UpdateReservationStatus(m.Ctx, OrderID, stock_entity.ReservationStatusActive, stock_entity.ReservationStatusWrittenOff).Return(nil),
+				)
+			},
+		},
+		{
+			Name: "write_off_failed",
+			SetupMocks: func(m *MockWriteOff) {
+				expectTx(m.Ctx, m.UowMock, m.RepoMock)
+				m.RepoMock.EXPECT().GetActiveReservations(m.Ctx, OrderID, "").Return(active("cane", 1), nil)
+				m.RepoMock.EXPECT().WriteOff(m.Ctx, "cane", 1).Return(errRepository)
+			},
+			ExpectedError: errRepository,
+		},
+	}
+}
@@ -0,0 +1,315 @@
+package stock_usecase
+
+import (
+	"context"
+	"time"
+
+	stock_entity "github.com/Fi44er/sdmed/internal/module/stock/entity"
+	stock_constant "github.com/Fi44er/sdmed/internal/module/stock/pkg"
+	stock_usecase_contracts "github.com/Fi44er/sdmed/internal/module/stock/usecase/stock/contracts"
+	"github.com/Fi44er/sdmed/pkg/logger"
+	"github.com/Fi44er/sdmed/pkg/postgres/uow"
+	"github.com/Fi44er/sdmed/pkg/utils"
+)
+
+type IStockUsecase interface {
+	Reserve(ctx context.Context, orderID string, items []stock_entity.ReserveItem, expiresAt time.Time) error
+	Update(ctx context.Context, orderID string, items []stock_entity.ReserveItem, expiresAt time.Time) error
+	Extend(ctx context.Context, orderID string, expiresAt time.Time) error
+	Confirm(ctx context.Context, orderID string) error
+	Release(ctx context.Context, orderID string) error
+	WriteOff(ctx context.Context, orderID string) error
+	Restock(ctx context.Context, items []stock_entity.ReserveItem) error
+	GetExpiredOrderIDs(ctx context.Context, limit int) ([]string, error)
+	GetAvailable(ctx context.Context, productID string) (int, bool, error)
+
+	GetByProductID(ctx context.Context, productID string) (*stock_entity.Stock, error)
+	GetAll(ctx context.Context, page, pageSize int) ([]stock_entity.Stock, int64, error)
+	SetQuantity(ctx context.Context, productID string, quantity int) (*stock_entity.Stock, error)
+}
+
+type StockUsecase struct {
+	repository     stock_usecase_contracts.IStockRepository
+	productUsecase stock_usecase_contracts.IProductUsecaseAdapter
+	uow            uow.Uow
+	logger         *logger.Logger
+}
+
+func NewStockUsecase(
+	repository stock_usecase_contracts.IStockRepository,
+	productUsecase stock_usecase_contracts.IProductUsecaseAdapter,
+	uow uow.Uow,
+	logger *logger.Logger,
+) IStockUsecase {
+	return &StockUsecase{
+		repository:     repository,
+		productUsecase: productUsecase,
+		uow:            uow,
+		logger:         logger,
+	}
+}
+
+// Reserve резервирует товары под заказ. Выполняется в транзакции вызывающего, если она есть:
+// при нехватке любого товара заказ не оформляется. Товары без учета остатков не резервируются
+func (u *StockUsecase) Reserve(ctx context.Context, orderID string, items []stock_entity.ReserveItem, expiresAt time.Time) error {
+	u.logger.Infof("Reserving stock for order %s", orderID)
+
+	return u.uow.Do(ctx, func(ctx context.Context) error {
+		repo, err := u.getRepository(ctx)
+		if err != nil {
+			return err
+		}
+
+		return u.reserve(ctx, repo, orderID, items, expiresAt)
+	})
+}
+
+// Update пересобирает резерв заказа после изменения состава. Срок резерва передает заказ:
+// он считается от оформления, а не от изменения состава
+func (u *StockUsecase) Update(ctx context.Context, orderID string, items []stock_entity.ReserveItem, expiresAt time.Time) error {
+	u.logger.Infof("Updating stock reservation of order %s", orderID)
+
+	return u.uow.Do(ctx, func(ctx context.Context) error {
+		repo, err := u.getRepository(ctx)
+		if err != nil {
+			return err
+		}
+
+		reservations, err := repo.GetActiveReservations(ctx, orderID, "")
+		if err != nil {
+			return err
+		}
+		if err := u.release(ctx, repo, orderID, reservations); err != nil {
+			return err
+		}
+
+		return u.reserve(ctx, repo, orderID, items, expiresAt)
+	})
+}
+
+// Extend продлевает резерв заказа до expiresAt, например пока по заказу идет оплата
+func (u *StockUsecase) Extend(ctx context.Context, orderID string, expiresAt time.Time) error {
+	u.logger.Infof("Extending stock reservation of order %s until %s", orderID, expiresAt.Format(time.RFC3339))
+	return u.repository.SetExpiry(ctx, orderID, expiresAt)
+}
+
+// Confirm снимает таймаут с резерва оплаченного заказа: товар держится до отгрузки или отмены
+func (u *StockUsecase) Confirm(ctx context.Context, orderID string) error {
+	return u.uow.Do(ctx, func(ctx context.Context) error {
+		repo, err := u.getRepository(ctx)
+		if err != nil {
+			return err
+		}
+
+		return repo.SetExpiry(ctx, orderID, time.Time{})
+	})
+}
+
+// Release возвращает зарезервированный под заказ товар в свободный остаток
+func (u *StockUsecase) Release(ctx context.Context, orderID string) error {
+	u.logger.Infof("Releasing stock reserved for order %s", orderID)
+
+	return u.uow.Do(ctx, func(ctx context.Context) error {
+		repo, err := u.getRepository(ctx)
+		if err != nil {
+			return err
+		}
+
+		reservations, err := repo.GetActiveReservations(ctx, orderID, "")
+		if err != nil {
+			return err
+		}
+
+		return u.release(ctx, repo, orderID, reservations)
+	})
+}
+
+// WriteOff списывает зарезервированный под заказ товар с остатка при отгрузке
+func (u *StockUsecase) WriteOff(ctx context.Context, orderID string) error {
+	u.logger.Infof("Writing off stock reserved for order %s", orderID)
+
+	return u.uow.Do(ctx, func(ctx context.Context) error {
+		repo, err := u.getRepository(ctx)
+		if err != nil {
+			return err
+		}
+
+		reservations, err := repo.GetActiveReservations(ctx, orderID, "")
+		if err != nil {
+			return err
+		}
+		for _, reservation := range reservations {
+			if err := repo.WriteOff(ctx, reservation.ProductID, reservation.Quantity); err != nil {
+				return err
+			}
+		}
+
+		return repo.UpdateReservationStatus(ctx, orderID, stock_entity.ReservationStatusActive, stock_entity.ReservationStatusWrittenOff)
+	})
+}
+
+// Restock возвращает на склад полученный по возврату товар. Выполняется в транзакции
+// вызывающего, если она есть
+func (u *StockUsecase) Restock(ctx context.Context, items []stock_entity.ReserveItem) error {
+	return u.uow.Do(ctx, func(ctx context.Context) error {
+		repo, err := u.getRepository(ctx)
+		if err != nil {
+			return err
+		}
+
+		for _, item := range stock_entity.MergeItems(items) {
+			u.logger.Infof("Restocking %d of product %s", item.Quantity, item.ProductID)
+			if err := repo.Restock(ctx, item.ProductID, item.Quantity); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// GetExpiredOrderIDs возвращает заказы, резерв которых истек
+func (u *StockUsecase) GetExpiredOrderIDs(ctx context.Context, limit int) ([]string, error) {
+	return u.repository.GetExpiredOrderIDs(ctx, time.Now(), limit)
+}
+
+// GetAvailable возвращает свободный остаток товара. false - остаток товара не учитывается,
+// такой товар не ограничен складом
+func (u *StockUsecase) GetAvailable(ctx context.Context, productID string) (int, bool, error) {
+	stock, err := u.repository.GetByProductID(ctx, productID)
+	if err != nil {
+		return 0, false, err
+	}
+	if stock == nil {
+		return 0, false, nil
+	}
+	return stock.Available(), true, nil
+}
+
+// GetByProductID возвращает остаток товара с действующими резервами
+func (u *StockUsecase) GetByProductID(ctx context.Context, productID string) (*stock_entity.Stock, error) {
+	stock, err := u.repository.GetByProductID(ctx, productID)
+	if err != nil {
+		return nil, err
+	}
+	if stock == nil {
+		exists, err := u.productUsecase.Exists(ctx, productID)
+		if err != nil {
+			return nil, err
+		}
+		if !exists {
+			return nil, stock_constant.ErrProductNotFound
+		}
+		return &stock_entity.Stock{ProductID: productID}, nil
+	}
+
+	stock.Reservations, err = u.repository.GetActiveReservations(ctx, "", productID)
+	if err != nil {
+		return nil, err
+	}
+
+	return stock, nil
+}
+
+func (u *StockUsecase) GetAll(ctx context.Context, page, pageSize int) ([]stock_entity.Stock, int64, error) {
+	u.logger.Debugf("Getting stocks (page: %d, pageSize: %d)", page, pageSize)
+
+	offset, limit := utils.SafeCalculateForPostgres(page, pageSize)
+	stocks, err := u.repository.GetAll(ctx, offset, limit)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	count, err := u.repository.Count(ctx)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return stocks, count, nil
+}
+
+// SetQuantity задает остаток товара на складе, например после инвентаризации или поступления.
+// Остаток не может быть меньше уже зарезервированного количества
+func (u *StockUsecase) SetQuantity(ctx context.Context, productID string, quantity int) (*stock_entity.Stock, error) {
+	u.logger.Infof("Setting stock of product %s to %d", productID, quantity)
+
+	exists, err := u.productUsecase.Exists(ctx, productID)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, stock_constant.ErrProductNotFound
+	}
+
+	updated, err := u.repository.SetQuantity(ctx, productID, quantity)
+	if err != nil {
+		return nil, err
+	}
+	if !updated {
+		return nil, stock_constant.ErrQuantityBelowReserved
+	}
+
+	return u.GetByProductID(ctx, productID)
+}
+
+func (u *StockUsecase) reserve(
+	ctx context.Context,
+	repo stock_usecase_contracts.IStockRepository,
+	orderID string,
+	items []stock_entity.ReserveItem,
+	expiresAt time.Time,
+) error {
+	for _, item := range stock_entity.MergeItems(items) {
+		reserved, err := repo.Reserve(ctx, item.ProductID, item.Quantity)
+		if err != nil {
+			return err
+		}
+		if !reserved {
+			stock, err := repo.GetByProductID(ctx, item.ProductID)
+			if err != nil {
+				return err
+			}
+			if stock == nil {
+				continue
+			}
+			u.logger.Warnf("Order %s: not enough stock of product %s (requested %d, available %d)", orderID, item.ProductID, item.Quantity, stock.Available())
+			return stock_constant.ErrInsufficientStock
+		}
+
+		err = repo.CreateReservation(ctx, &stock_entity.Reservation{
+			OrderID:   orderID,
+			ProductID: item.ProductID,
+			Quantity:  item.Quantity,
+			Status:    stock_entity.ReservationStatusActive,
+			ExpiresAt: expiresAt,
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (u *StockUsecase) release(
+	ctx context.Context,
+	repo stock_usecase_contracts.IStockRepository,
+	orderID string,
+	reservations []stock_entity.Reservation,
+) error {
+	for _, reservation := range reservations {
+		if err := repo.Unreserve(ctx, reservation.ProductID, reservation.Quantity); err != nil {
+			return err
+		}
+	}
+
+	return repo.UpdateReservationStatus(ctx, orderID, stock_entity.ReservationStatusActive, stock_entity.ReservationStatusReleased)
+}
+
+func (u *StockUsecase) getRepository(ctx context.Context) (stock_usecase_contracts.IStockRepository, error) {
+	repo, err := u.uow.GetRepository(ctx, "stock")
+	if err != nil {
+		u.logger.Errorf("Failed to get repository: %v", err)
+		return nil, err
+	}
+	return repo.(stock_usecase_contracts.IStockRepository), nil
+}
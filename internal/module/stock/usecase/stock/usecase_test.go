@@ -0,0 +1,303 @@
+package stock_usecase_test
+
+import (
+	"context"
+	"testing"
+
+	stock_usecase "github.com/Fi44er/sdmed/internal/module/stock/usecase/stock"
+	"github.com/Fi44er/sdmed/internal/module/stock/usecase/stock/mock"
+	stock_testcases "github.com/Fi44er/sdmed/internal/module/stock/usecase/stock/testcases"
+	"github.com/Fi44er/sdmed/pkg/logger"
+	uow_mock "github.com/Fi44er/sdmed/pkg/postgres/uow/mock"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+)
+
+type StockUsecaseTestSuite struct {
+	suite.Suite
+	ctx    context.Context
+	logger *logger.Logger
+}
+
+func (s *StockUsecaseTestSuite) SetupSuite() {
+	s.ctx = context.Background()
+	s.logger = logger.NewLogger()
+}
+
+func TestStockUsecase(t *testing.T) {
+	suite.Run(t, new(StockUsecaseTestSuite))
+}
+
+func (s *StockUsecaseTestSuite) TestReserve() {
+	tests := stock_testcases.GetReserveTestCases()
+
+	for _, tc := range tests {
+		s.T().Run(tc.Name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockStruct := &stock_testcases.MockReserve{
+				Ctrl:     ctrl,
+				Ctx:      s.ctx,
+				RepoMock: mock.NewMockIStockRepository(ctrl),
+				UowMock:  uow_mock.NewMockUow(ctrl),
+				T:        t,
+			}
+
+			usecase := stock_usecase.NewStockUsecase(nil, nil, mockStruct.UowMock, s.logger)
+
+			tc.SetupMocks(mockStruct)
+			err := usecase.Reserve(s.ctx, stock_testcases.OrderID, tc.Items, stock_testcases.ExpiresAt)
+
+			if tc.ExpectedError != nil {
+				assert.ErrorIs(t, err, tc.ExpectedError)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func (s *StockUsecaseTestSuite) TestUpdate() {
+	tests := stock_testcases.GetUpdateTestCases()
+
+	for _, tc := range tests {
+		s.T().Run(tc.Name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockStruct := &stock_testcases.MockUpdate{
+				Ctrl:     ctrl,
+				Ctx:      s.ctx,
+				RepoMock: mock.NewMockIStockRepository(ctrl),
+				UowMock:  uow_mock.NewMockUow(ctrl),
+				T:        t,
+			}
+
+			usecase := stock_usecase.NewStockUsecase(nil, nil, mockStruct.UowMock, s.logger)
+
+			tc.SetupMocks(mockStruct)
+			err := usecase.Update(s.ctx, stock_testcases.OrderID, tc.Items, stock_testcases.ExpiresAt)
+
+			if tc.ExpectedError != nil {
+				assert.ErrorIs(t, err, tc.ExpectedError)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func (s *StockUsecaseTestSuite) TestExtend() {
+	tests := stock_testcases.GetExtendTestCases()
+
+	for _, tc := range tests {
+		s.T().Run(tc.Name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockStruct := &stock_testcases.MockExtend{
+				Ctrl:     ctrl,
+				Ctx:      s.ctx,
+				RepoMock: mock.NewMockIStockRepository(ctrl),
+				T:        t,
+			}
+
+			usecase := stock_usecase.NewStockUsecase(mockStruct.RepoMock, nil, nil, s.logger)
+
+			tc.SetupMocks(mockStruct)
+			err := usecase.Extend(s.ctx, stock_testcases.OrderID, tc.ExpiresAt)
+
+			if tc.ExpectedError != nil {
+				assert.ErrorIs(t, err, tc.ExpectedError)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func (s *StockUsecaseTestSuite) TestConfirm() {
+	tests := stock_testcases.GetConfirmTestCases()
+
+	for _, tc := range tests {
+		s.T().Run(tc.Name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockStruct := &stock_testcases.MockConfirm{
+				Ctrl:     ctrl,
+				Ctx:      s.ctx,
+				RepoMock: mock.NewMockIStockRepository(ctrl),
+				UowMock:  uow_mock.NewMockUow(ctrl),
+				T:        t,
+			}
+
+			usecase := stock_usecase.NewStockUsecase(nil, nil, mockStruct.UowMock, s.logger)
+
+			tc.SetupMocks(mockStruct)
+			err := usecase.Confirm(s.ctx, stock_testcases.OrderID)
+
+			if tc.ExpectedError != nil {
+				assert.ErrorIs(t, err, tc.ExpectedError)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func (s *StockUsecaseTestSuite) TestRelease() {
+	tests := stock_testcases.GetReleaseTestCases()
+
+	for _, tc := range tests {
+		s.T().Run(tc.Name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockStruct := &stock_testcases.MockRelease{
+				Ctrl:     ctrl,
+				Ctx:      s.ctx,
+				RepoMock: mock.NewMockIStockRepository(ctrl),
+				UowMock:  uow_mock.NewMockUow(ctrl),
+				T:        t,
+			}
+
+			usecase := stock_usecase.NewStockUsecase(nil, nil, mockStruct.UowMock, s.logger)
+
+			tc.SetupMocks(mockStruct)
+			err := usecase.Release(s.ctx, stock_testcases.OrderID)
+
+			if tc.ExpectedError != nil {
+				assert.ErrorIs(t, err, tc.ExpectedError)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func (s *StockUsecaseTestSuite) TestWriteOff() {
+	tests := stock_testcases.GetWriteOffTestCases()
+
+	for _, tc := range tests {
+		s.T().Run(tc.Name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockStruct := &stock_testcases.MockWriteOff{
+				Ctrl:     ctrl,
+				Ctx:      s.ctx,
+				RepoMock: mock.NewMockIStockRepository(ctrl),
+				UowMock:  uow_mock.NewMockUow(ctrl),
+				T:        t,
+			}
+
+			usecase := stock_usecase.NewStockUsecase(nil, nil, mockStruct.UowMock, s.logger)
+
+			tc.SetupMocks(mockStruct)
+			err := usecase.WriteOff(s.ctx, stock_testcases.OrderID)
+
+			if tc.ExpectedError != nil {
+				assert.ErrorIs(t, err, tc.ExpectedError)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func (s *StockUsecaseTestSuite) TestRestock() {
+	tests := stock_testcases.GetRestockTestCases()
+
+	for _, tc := range tests {
+		s.T().Run(tc.Name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockStruct := &stock_testcases.MockRestock{
+				Ctrl:     ctrl,
+				Ctx:      s.ctx,
+				RepoMock: mock.NewMockIStockRepository(ctrl),
+				UowMock:  uow_mock.NewMockUow(ctrl),
+				T:        t,
+			}
+
+			usecase := stock_usecase.NewStockUsecase(nil, nil, mockStruct.UowMock, s.logger)
+
+			tc.SetupMocks(mockStruct)
+			err := usecase.Restock(s.ctx, tc.Items)
+
+			if tc.ExpectedError != nil {
+				assert.ErrorIs(t, err, tc.ExpectedError)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func (s *StockUsecaseTestSuite) TestGetAvailable() {
+	tests := stock_testcases.GetGetAvailableTestCases()
+
+	for _, tc := range tests {
+		s.T().Run(tc.Name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockStruct := &stock_testcases.MockGetAvailable{
+				Ctrl:     ctrl,
+				Ctx:      s.ctx,
+				RepoMock: mock.NewMockIStockRepository(ctrl),
+				T:        t,
+			}
+
+			usecase := stock_usecase.NewStockUsecase(mockStruct.RepoMock, nil, nil, s.logger)
+
+			tc.SetupMocks(mockStruct)
+			available, tracked, err := usecase.GetAvailable(s.ctx, tc.ProductID)
+
+			if tc.ExpectedError != nil {
+				assert.ErrorIs(t, err, tc.ExpectedError)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tc.ExpectedAvailable, available)
+				assert.Equal(t, tc.ExpectedTracked, tracked)
+			}
+		})
+	}
+}
+
+func (s *StockUsecaseTestSuite) TestSetQuantity() {
+	tests := stock_testcases.GetSetQuantityTestCases()
+
+	for _, tc := range tests {
+		s.T().Run(tc.Name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockStruct := &stock_testcases.MockSetQuantity{
+				Ctrl:        ctrl,
+				Ctx:         s.ctx,
+				RepoMock:    mock.NewMockIStockRepository(ctrl),
+				ProductMock: mock.NewMockIProductUsecaseAdapter(ctrl),
+				T:           t,
+			}
+
+			usecase := stock_usecase.NewStockUsecase(mockStruct.RepoMock, mockStruct.ProductMock, nil, s.logger)
+
+			tc.SetupMocks(mockStruct)
+			stock, err := usecase.SetQuantity(s.ctx, tc.ProductID, tc.Quantity)
+
+			if tc.ExpectedError != nil {
+				assert.ErrorIs(t, err, tc.ExpectedError)
+				assert.Nil(t, stock)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tc.ExpectedAvailable, stock.Available())
+				assert.Len(t, stock.Reservations, 1)
+			}
+		})
+	}
+}
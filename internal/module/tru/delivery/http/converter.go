@@ -0,0 +1,147 @@
+package tru_http
+
+import (
+	"math"
+
+	tru_dto "github.com/Fi44er/sdmed/internal/module/tru/dto"
+	tru_entity "github.com/Fi44er/sdmed/internal/module/tru/entity"
+	dto_utils "github.com/Fi44er/sdmed/pkg/utils/dto"
+)
+
+type Converter struct{}
+
+func (c *Converter) ToEntityFromCreate(dto *tru_dto.CreateTRUCodeRequest) *tru_entity.TRUCode {
+	return &tru_entity.TRUCode{
+		Code:     dto.Code,
+		Name:     dto.Name,
+		IsCustom: dto.IsCustom,
+	}
+}
+
+func (c *Converter) ToEntityFromUpdate(dto *tru_dto.UpdateTRUCodeRequest) *tru_entity.TRUCode {
+	return &tru_entity.TRUCode{
+		ID:   dto.ID,
+		Code: dto.Code,
+		Name: dto.Name,
+	}
+}
+
+func (c *Converter) ToPriceEntity(dto *tru_dto.SetTRUPriceRequest) *tru_entity.TRUCodePrice {
+	return &tru_entity.TRUCodePrice{
+		TRUCodeID: dto.TRUCodeID,
+		RegionID:  dto.RegionID,
+		Price:     dto.Price,
+	}
+}
+
+func (c *Converter) ToEntityFromCreateCustom(dto *tru_dto.CreateCustomTRUCodeRequest) *tru_entity.TRUCode {
+	prices := make([]tru_entity.TRUCodePrice, len(dto.Prices))
+	for i, price := range dto.Prices {
+		prices[i] = tru_entity.TRUCodePrice{
+			RegionID: price.RegionID,
+			Price:    price.Price,
+		}
+	}
+
+	return &tru_entity.TRUCode{
+		Code:     dto.Code,
+		Name:     dto.Name,
+		IsCustom: true,
+		Prices:   prices,
+	}
+}
+
+func (c *Converter) ToFilterEntity(params *tru_dto.TRUCodeQueryParams) *tru_entity.TRUCodeFilter {
+	return &tru_entity.TRUCodeFilter{
+		Search:   params.Search,
+		IsCustom: params.IsCustom,
+		Status:   tru_entity.TRUCodeStatus(params.Status),
+	}
+}
+
+func (c *Converter) ToTRUCodeResponse(code *tru_entity.TRUCode) *tru_dto.TRUCodeResponse {
+	prices := make([]tru_dto.TRUCodePriceResponse, len(code.Prices))
+	for i, price := range code.Prices {
+		prices[i] = tru_dto.TRUCodePriceResponse{
+			RegionID:  price.RegionID,
+			Price:     price.Price,
+			UpdatedAt: price.UpdatedAt,
+		}
+	}
+
+	return &tru_dto.TRUCodeResponse{
+		ID:        code.ID,
+		Code:      code.Code,
+		Name:      code.Name,
+		IsCustom:  code.IsCustom,
+		Status:    string(code.Status),
+		Prices:    prices,
+		CreatedAt: code.CreatedAt,
+		UpdatedAt: code.UpdatedAt,
+	}
+}
+
+func (c *Converter) ToTRUCodeResponses(codes []tru_entity.TRUCode) []tru_dto.TRUCodeResponse {
+	result := make([]tru_dto.TRUCodeResponse, len(codes))
+	for i := range codes {
+		result[i] = *c.ToTRUCodeResponse(&codes[i])
+	}
+	return result
+}
+
+func (c *Converter) ToTRUCodeListResponse(codes []tru_entity.TRUCode, count int64, page, pageSize int) *dto_utils.ListResponse[tru_dto.TRUCodeResponse] {
+	return &dto_utils.ListResponse[tru_dto.TRUCodeResponse]{
+		Data: c.ToTRUCodeResponses(codes),
+		Pagination: dto_utils.PaginationInfo{
+			Total:    count,
+			Page:     page,
+			PageSize: pageSize,
+			Pages:    int(math.Ceil(float64(count) / float64(pageSize))),
+		},
+	}
+}
+
+func (c *Converter) ToReimbursementResponse(reimbursement *tru_entity.ProductReimbursement) *tru_dto.ReimbursementResponse {
+	return &tru_dto.ReimbursementResponse{
+		ProductID: reimbursement.ProductID,
+		RegionID:  reimbursement.RegionID,
+		TRUCodeID: reimbursement.TRUCodeID,
+		Code:      reimbursement.Code,
+		Price:     reimbursement.Price,
+	}
+}
+
+func (c *Converter) ToMergeResponse(result *tru_entity.MergeResult) *tru_dto.MergeTRUCodeResponse {
+	return &tru_dto.MergeTRUCodeResponse{
+		Target:        *c.ToTRUCodeResponse(result.Target),
+		MovedProducts: result.MovedProducts,
+		MovedPrices:   result.MovedPrices,
+	}
+}
+
+func (c *Converter) ToImportPricesResponse(report *tru_entity.PriceImportReport) *tru_dto.ImportPricesResponse {
+	rows := make([]tru_dto.ImportPriceRowResponse, len(report.Rows))
+	for i, row := range report.Rows {
+		rows[i] = tru_dto.ImportPriceRowResponse{
+			Row:       row.Row,
+			Code:      row.Code,
+			TRUCodeID: row.TRUCodeID,
+			Price:     row.Price,
+			OldPrice:  row.OldPrice,
+			Status:    string(row.Status),
+			Message:   row.Message,
+		}
+	}
+
+	return &tru_dto.ImportPricesResponse{
+		RegionID:  report.RegionID,
+		DryRun:    report.DryRun,
+		Total:     report.Total,
+		Created:   report.Created,
+		Updated:   report.Updated,
+		Unchanged: report.Unchanged,
+		NotFound:  report.NotFound,
+		Invalid:   report.Invalid,
+		Rows:      rows,
+	}
+}
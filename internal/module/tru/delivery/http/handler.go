@@ -0,0 +1,503 @@
+package tru_http
+
+import (
+	"context"
+
+	tru_dto "github.com/Fi44er/sdmed/internal/module/tru/dto"
+	tru_entity "github.com/Fi44er/sdmed/internal/module/tru/entity"
+	"github.com/Fi44er/sdmed/pkg/logger"
+	_ "github.com/Fi44er/sdmed/pkg/response"
+	"github.com/Fi44er/sdmed/pkg/utils"
+	"github.com/go-playground/validator/v10"
+	"github.com/gofiber/fiber/v2"
+)
+
+type ITRUUsecase interface {
+	Create(ctx context.Context, code *tru_entity.TRUCode) error
+	GetByID(ctx context.Context, id string) (*tru_entity.TRUCode, error)
+	GetAll(ctx context.Context, filter *tru_entity.TRUCodeFilter, page, pageSize int) ([]tru_entity.TRUCode, int64, error)
+	Update(ctx context.Context, code *tru_entity.TRUCode) error
+	Delete(ctx context.Context, id string) error
+
+	CreateCustom(ctx context.Context, code *tru_entity.TRUCode) error
+	Approve(ctx context.Context, id string) error
+	Merge(ctx context.Context, customID, officialID string) (*tru_entity.MergeResult, error)
+	ImportPrices(ctx context.Context, priceImport *tru_entity.PriceImport) (*tru_entity.PriceImportReport, error)
+
+	SetPrice(ctx context.Context, price *tru_entity.TRUCodePrice) error
+	DeletePrice(ctx context.Context, truCodeID, regionID string) error
+
+	SetProductCodes(ctx context.Context, productID string, truCodeIDs []string) error
+	GetProductCodes(ctx context.Context, productID string) ([]tru_entity.TRUCode, error)
+	GetProductReimbursement(ctx context.Context, productID, regionID string) (*tru_entity.ProductReimbursement, error)
+}
+
+type TRUHandler struct {
+	usecase ITRUUsecase
+
+	validator *validator.Validate
+	logger    *logger.Logger
+	converter *Converter
+}
+
+func NewTRUHandler(
+	usecase ITRUUsecase,
+	validator *validator.Validate,
+	logger *logger.Logger,
+) *TRUHandler {
+	return &TRUHandler{
+		usecase:   usecase,
+		validator: validator,
+		logger:    logger,
+		converter: &Converter{},
+	}
+}
+
+// Create godoc
+// @Summary Create a TRU code
+// @Description Create a KTRU code. Custom codes are marked with is_custom
+// @Tags tru
+// @Accept json
+// @Produce json
+// @Param code body tru_dto.CreateTRUCodeRequest true "TRU code"
+// @Success 201 {object} response.ResponseData{data=tru_dto.TRUCodeResponse} "Created"
+// @Failure 409 {object} response.Response "Already exists"
+// @Failure 500 {object} response.Response "Error"
+// @Router /tru-codes [post]
+func (h *TRUHandler) Create(ctx *fiber.Ctx) error {
+	dto := new(tru_dto.CreateTRUCodeRequest)
+
+	entity, err := utils.ParseAndValidate(ctx, dto, h.validator, h.converter.ToEntityFromCreate, h.logger)
+	if err != nil {
+		return ctx.Status(400).JSON(fiber.Map{
+			"status":  "fail",
+			"message": err.Error(),
+		})
+	}
+
+	if err := h.usecase.Create(ctx.Context(), entity); err != nil {
+		return err
+	}
+
+	return ctx.Status(201).JSON(fiber.Map{
+		"status": "success",
+		"data":   h.converter.ToTRUCodeResponse(entity),
+	})
+}
+
+// GetAll godoc
+// @Summary Get TRU codes
+// @Description Get a list of TRU codes with search by code or name
+// @Tags tru
+// @Accept json
+// @Produce json
+// @Param search query string false "Search by code or name"
+// @Param is_custom query bool false "Filter custom codes"
+// @Param page query int false "Page number (default 1)"
+// @Param page_size query int false "Items per page (default 20)"
+// @Success 200 {object} response.ResponseData{data=[]tru_dto.TRUCodeResponse} "OK"
+// @Failure 500 {object} response.Response "Error"
+// @Router /tru-codes [get]
+func (h *TRUHandler) GetAll(ctx *fiber.Ctx) error {
+	params := &tru_dto.TRUCodeQueryParams{
+		Page:     1,
+		PageSize: 20,
+	}
+
+	if err := ctx.QueryParser(params); err != nil {
+		h.logger.Errorf("Failed to parse query params: %v", err)
+		return fiber.NewError(fiber.StatusBadRequest, "Invalid query parameters")
+	}
+
+	codes, count, err := h.usecase.GetAll(ctx.Context(), h.converter.ToFilterEntity(params), params.Page, params.PageSize)
+	if err != nil {
+		return err
+	}
+
+	return ctx.Status(200).JSON(fiber.Map{
+		"status": "success",
+		"data":   h.converter.ToTRUCodeListResponse(codes, count, params.Page, params.PageSize),
+	})
+}
+
+// GetByID godoc
+// @Summary Get a TRU code
+// @Description Get a TRU code with its regional prices
+// @Tags tru
+// @Accept json
+// @Produce json
+// @Param id path string true "TRU code ID"
+// @Success 200 {object} response.ResponseData{data=tru_dto.TRUCodeResponse} "OK"
+// @Failure 404 {object} response.Response "Not found"
+// @Failure 500 {object} response.Response "Error"
+// @Router /tru-codes/{id} [get]
+func (h *TRUHandler) GetByID(ctx *fiber.Ctx) error {
+	code, err := h.usecase.GetByID(ctx.Context(), ctx.Params("id"))
+	if err != nil {
+		return err
+	}
+
+	return ctx.Status(200).JSON(fiber.Map{
+		"status": "success",
+		"data":   h.converter.ToTRUCodeResponse(code),
+	})
+}
+
+// Update godoc
+// @Summary Update a TRU code
+// @Description Update code and name of a TRU code. Status changes only through approve and merge
+// @Tags tru
+// @Accept json
+// @Produce json
+// @Param id path string true "TRU code ID"
+// @Param code body tru_dto.UpdateTRUCodeRequest true "TRU code"
+// @Success 200 {object} response.Response "OK"
+// @Failure 404 {object} response.Response "Not found"
+// @Failure 409 {object} response.Response "Already exists"
+// @Failure 500 {object} response.Response "Error"
+// @Router /tru-codes/{id} [put]
+func (h *TRUHandler) Update(ctx *fiber.Ctx) error {
+	dto := new(tru_dto.UpdateTRUCodeRequest)
+	dto.ID = ctx.Params("id")
+
+	entity, err := utils.ParseAndValidate(ctx, dto, h.validator, h.converter.ToEntityFromUpdate, h.logger)
+	if err != nil {
+		return ctx.Status(400).JSON(fiber.Map{
+			"status":  "fail",
+			"message": err.Error(),
+		})
+	}
+
+	if err := h.usecase.Update(ctx.Context(), entity); err != nil {
+		return err
+	}
+
+	return ctx.Status(200).JSON(fiber.Map{
+		"status":  "success",
+		"message": "tru code updated successfully",
+	})
+}
+
+// Delete godoc
+// @Summary Delete a TRU code
+// @Description Delete a TRU code together with its prices and product links
+// @Tags tru
+// @Produce json
+// @Param id path string true "TRU code ID"
+// @Success 200 {object} response.Response "OK"
+// @Failure 404 {object} response.Response "Not found"
+// @Failure 500 {object} response.Response "Error"
+// @Router /tru-codes/{id} [delete]
+func (h *TRUHandler) Delete(ctx *fiber.Ctx) error {
+	if err := h.usecase.Delete(ctx.Context(), ctx.Params("id")); err != nil {
+		return err
+	}
+
+	return ctx.Status(200).JSON(fiber.Map{
+		"status":  "success",
+		"message": "tru code deleted successfully",
+	})
+}
+
+// CreateCustom godoc
+// @Summary Create a custom TRU code
+// @Description Create a provisional TRU code with manual regional prices. The code is pending until approved
+// @Tags tru
+// @Accept json
+// @Produce json
+// @Param code body tru_dto.CreateCustomTRUCodeRequest true "Custom TRU code"
+// @Success 201 {object} response.ResponseData{data=tru_dto.TRUCodeResponse} "Created"
+// @Failure 400 {object} response.Response "Invalid request"
+// @Failure 409 {object} response.Response "Already exists"
+// @Failure 500 {object} response.Response "Error"
+// @Router /tru-codes/custom [post]
+func (h *TRUHandler) CreateCustom(ctx *fiber.Ctx) error {
+	dto := new(tru_dto.CreateCustomTRUCodeRequest)
+
+	entity, err := utils.ParseAndValidate(ctx, dto, h.validator, h.converter.ToEntityFromCreateCustom, h.logger)
+	if err != nil {
+		return ctx.Status(400).JSON(fiber.Map{
+			"status":  "fail",
+			"message": err.Error(),
+		})
+	}
+
+	if err := h.usecase.CreateCustom(ctx.Context(), entity); err != nil {
+		return err
+	}
+
+	return ctx.Status(201).JSON(fiber.Map{
+		"status": "success",
+		"data":   h.converter.ToTRUCodeResponse(entity),
+	})
+}
+
+// Approve godoc
+// @Summary Approve a custom TRU code
+// @Description Approved custom codes take part in reimbursement calculation
+// @Tags tru
+// @Produce json
+// @Param id path string true "TRU code ID"
+// @Success 200 {object} response.Response "OK"
+// @Failure 400 {object} response.Response "Not a custom code"
+// @Failure 404 {object} response.Response "Not found"
+// @Failure 409 {object} response.Response "Already approved"
+// @Failure 500 {object} response.Response "Error"
+// @Router /tru-codes/{id}/approve [post]
+func (h *TRUHandler) Approve(ctx *fiber.Ctx) error {
+	if err := h.usecase.Approve(ctx.Context(), ctx.Params("id")); err != nil {
+		return err
+	}
+
+	return ctx.Status(200).JSON(fiber.Map{
+		"status":  "success",
+		"message": "tru code approved successfully",
+	})
+}
+
+// Merge godoc
+// @Summary Merge a custom TRU code into an official one
+// @Description Move product links and missing regional prices of the custom code to the official code and delete the custom code
+// @Tags tru
+// @Accept json
+// @Produce json
+// @Param id path string true "Custom TRU code ID"
+// @Param request body tru_dto.MergeTRUCodeRequest true "Official TRU code"
+// @Success 200 {object} response.ResponseData{data=tru_dto.MergeTRUCodeResponse} "OK"
+// @Failure 400 {object} response.Response "Invalid request"
+// @Failure 404 {object} response.Response "Not found"
+// @Failure 500 {object} response.Response "Error"
+// @Router /tru-codes/{id}/merge [post]
+func (h *TRUHandler) Merge(ctx *fiber.Ctx) error {
+	dto := new(tru_dto.MergeTRUCodeRequest)
+	dto.CustomID = ctx.Params("id")
+
+	if err := ctx.BodyParser(dto); err != nil {
+		return ctx.Status(400).JSON(fiber.Map{
+			"status":  "fail",
+			"message": err.Error(),
+		})
+	}
+	if err := h.validator.Struct(dto); err != nil {
+		return ctx.Status(400).JSON(fiber.Map{
+			"status":  "fail",
+			"message": err.Error(),
+		})
+	}
+
+	result, err := h.usecase.Merge(ctx.Context(), dto.CustomID, dto.OfficialID)
+	if err != nil {
+		return err
+	}
+
+	return ctx.Status(200).JSON(fiber.Map{
+		"status": "success",
+		"data":   h.converter.ToMergeResponse(result),
+	})
+}
+
+// ImportPrices godoc
+// @Summary Import TRU prices from an SFR price list
+// @Description Upload an XLSX price list of a regional SFR branch. Rows are matched to TRU codes by code, prices are validated and saved for the region. With dry_run the report is built without saving
+// @Tags tru
+// @Accept multipart/form-data
+// @Produce json
+// @Param file formData file true "XLSX price list"
+// @Param region_id formData string true "Region ID"
+// @Param dry_run formData bool false "Only build the report"
+// @Success 200 {object} response.ResponseData{data=tru_dto.ImportPricesResponse} "OK"
+// @Failure 400 {object} response.Response "Invalid file"
+// @Failure 404 {object} response.Response "Region not found"
+// @Failure 500 {object} response.Response "Error"
+// @Router /tru-codes/prices/import [post]
+func (h *TRUHandler) ImportPrices(ctx *fiber.Ctx) error {
+	dto := new(tru_dto.ImportPricesRequest)
+	if err := ctx.BodyParser(dto); err != nil {
+		return ctx.Status(400).JSON(fiber.Map{
+			"status":  "fail",
+			"message": err.Error(),
+		})
+	}
+	if err := h.validator.Struct(dto); err != nil {
+		return ctx.Status(400).JSON(fiber.Map{
+			"status":  "fail",
+			"message": err.Error(),
+		})
+	}
+
+	fileHeader, err := ctx.FormFile("file")
+	if err != nil {
+		return ctx.Status(400).JSON(fiber.Map{
+			"status":  "fail",
+			"message": "file is required",
+		})
+	}
+
+	file, err := fileHeader.Open()
+	if err != nil {
+		h.logger.Errorf("Failed to open uploaded price list: %v", err)
+		return err
+	}
+	defer file.Close()
+
+	report, err := h.usecase.ImportPrices(ctx.Context(), &tru_entity.PriceImport{
+		RegionID: dto.RegionID,
+		DryRun:   dto.DryRun,
+		File:     file,
+	})
+	if err != nil {
+		return err
+	}
+
+	return ctx.Status(200).JSON(fiber.Map{
+		"status": "success",
+		"data":   h.converter.ToImportPricesResponse(report),
+	})
+}
+
+// SetPrice godoc
+// @Summary Set TRU code price for a region
+// @Description Create or update the reimbursement price of a TRU code in a region
+// @Tags tru
+// @Accept json
+// @Produce json
+// @Param id path string true "TRU code ID"
+// @Param price body tru_dto.SetTRUPriceRequest true "Price"
+// @Success 200 {object} response.Response "OK"
+// @Failure 404 {object} response.Response "Not found"
+// @Failure 500 {object} response.Response "Error"
+// @Router /tru-codes/{id}/prices [put]
+func (h *TRUHandler) SetPrice(ctx *fiber.Ctx) error {
+	dto := new(tru_dto.SetTRUPriceRequest)
+	dto.TRUCodeID = ctx.Params("id")
+
+	entity, err := utils.ParseAndValidate(ctx, dto, h.validator, h.converter.ToPriceEntity, h.logger)
+	if err != nil {
+		return ctx.Status(400).JSON(fiber.Map{
+			"status":  "fail",
+			"message": err.Error(),
+		})
+	}
+
+	if err := h.usecase.SetPrice(ctx.Context(), entity); err != nil {
+		return err
+	}
+
+	return ctx.Status(200).JSON(fiber.Map{
+		"status":  "success",
+		"message": "tru code price saved successfully",
+	})
+}
+
+// DeletePrice godoc
+// @Summary Delete TRU code price for a region
+// @Tags tru
+// @Produce json
+// @Param id path string true "TRU code ID"
+// @Param region_id path string true "Region ID"
+// @Success 200 {object} response.Response "OK"
+// @Failure 404 {object} response.Response "Not found"
+// @Failure 500 {object} response.Response "Error"
+// @Router /tru-codes/{id}/prices/{region_id} [delete]
+func (h *TRUHandler) DeletePrice(ctx *fiber.Ctx) error {
+	if err := h.usecase.DeletePrice(ctx.Context(), ctx.Params("id"), ctx.Params("region_id")); err != nil {
+		return err
+	}
+
+	return ctx.Status(200).JSON(fiber.Map{
+		"status":  "success",
+		"message": "tru code price deleted successfully",
+	})
+}
+
+// SetProductCodes godoc
+// @Summary Link TRU codes to a product
+// @Description Replaces the set of TRU codes the product is reimbursed by
+// @Tags tru
+// @Accept json
+// @Produce json
+// @Param id path string true "Product ID"
+// @Param codes body tru_dto.SetProductTRUCodesRequest true "TRU code IDs"
+// @Success 200 {object} response.Response "OK"
+// @Failure 404 {object} response.Response "Product or TRU code not found"
+// @Failure 500 {object} response.Response "Error"
+// @Router /products/{id}/tru-codes [put]
+func (h *TRUHandler) SetProductCodes(ctx *fiber.Ctx) error {
+	dto := new(tru_dto.SetProductTRUCodesRequest)
+	if err := ctx.BodyParser(dto); err != nil {
+		h.logger.Warnf("error while parsing body: %s", err)
+		return ctx.Status(400).JSON(fiber.Map{
+			"status":  "fail",
+			"message": err.Error(),
+		})
+	}
+	dto.ProductID = ctx.Params("id")
+
+	if err := h.validator.Struct(dto); err != nil {
+		h.logger.Warnf("error while validating dto: %s", err)
+		return ctx.Status(400).JSON(fiber.Map{
+			"status":  "fail",
+			"message": err.Error(),
+		})
+	}
+
+	if err := h.usecase.SetProductCodes(ctx.Context(), dto.ProductID, dto.TRUCodeIDs); err != nil {
+		return err
+	}
+
+	return ctx.Status(200).JSON(fiber.Map{
+		"status":  "success",
+		"message": "product tru codes saved successfully",
+	})
+}
+
+// GetProductCodes godoc
+// @Summary Get TRU codes of a product
+// @Tags tru
+// @Produce json
+// @Param id path string true "Product ID"
+// @Success 200 {object} response.ResponseData{data=[]tru_dto.TRUCodeResponse} "OK"
+// @Failure 500 {object} response.Response "Error"
+// @Router /products/{id}/tru-codes [get]
+func (h *TRUHandler) GetProductCodes(ctx *fiber.Ctx) error {
+	codes, err := h.usecase.GetProductCodes(ctx.Context(), ctx.Params("id"))
+	if err != nil {
+		return err
+	}
+
+	return ctx.Status(200).JSON(fiber.Map{
+		"status": "success",
+		"data":   h.converter.ToTRUCodeResponses(codes),
+	})
+}
+
+// GetProductReimbursement godoc
+// @Summary Get product reimbursement price for a region
+// @Description Returns the reimbursement price by the product's TRU codes. If the product has several codes, the highest price is used
+// @Tags tru
+// @Produce json
+// @Param id path string true "Product ID"
+// @Param region_id query string true "Region ID"
+// @Success 200 {object} response.ResponseData{data=tru_dto.ReimbursementResponse} "OK"
+// @Failure 404 {object} response.Response "No price for region"
+// @Failure 500 {object} response.Response "Error"
+// @Router /products/{id}/reimbursement [get]
+func (h *TRUHandler) GetProductReimbursement(ctx *fiber.Ctx) error {
+	regionID := ctx.Query("region_id")
+	if err := h.validator.Var(regionID, "required,uuid"); err != nil {
+		return ctx.Status(400).JSON(fiber.Map{
+			"status":  "fail",
+			"message": "region_id must be a valid uuid",
+		})
+	}
+
+	reimbursement, err := h.usecase.GetProductReimbursement(ctx.Context(), ctx.Params("id"), regionID)
+	if err != nil {
+		return err
+	}
+
+	return ctx.Status(200).JSON(fiber.Map{
+		"status": "success",
+		"data":   h.converter.ToReimbursementResponse(reimbursement),
+	})
+}
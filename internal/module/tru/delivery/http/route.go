@@ -0,0 +1,26 @@
+package tru_http
+
+import (
+	"github.com/Fi44er/sdmed/internal/middlewares"
+	"github.com/gofiber/fiber/v2"
+)
+
+func (h *TRUHandler) RegisterRoutes(router fiber.Router) {
+	codes := router.Group("/tru-codes")
+	codes.Get("/", h.GetAll)
+	codes.Get("/:id", h.GetByID)
+	codes.Post("/", middlewares.Authorize("tru", "create"), h.Create)
+	codes.Post("/custom", middlewares.Authorize("tru", "create"), h.CreateCustom)
+	codes.Post("/prices/import", middlewares.Authorize("tru", "update"), h.ImportPrices)
+	codes.Post("/:id/approve", middlewares.Authorize("tru", "approve"), h.Approve)
+	codes.Post("/:id/merge", middlewares.Authorize("tru", "approve"), h.Merge)
+	codes.Put("/:id", middlewares.Authorize("tru", "update"), h.Update)
+	codes.Delete("/:id", middlewares.Authorize("tru", "delete"), h.Delete)
+	codes.Put("/:id/prices", middlewares.Authorize("tru", "update"), h.SetPrice)
+	codes.Delete("/:id/prices/:region_id", middlewares.Authorize("tru", "update"), h.DeletePrice)
+
+	products := router.Group("/products")
+	products.Get("/:id/tru-codes", h.GetProductCodes)
+	products.Put("/:id/tru-codes", middlewares.Authorize("tru", "update"), h.SetProductCodes)
+	products.Get("/:id/reimbursement", h.GetProductReimbursement)
+}
@@ -0,0 +1,108 @@
+package tru_dto
+
+import "time"
+
+type CreateTRUCodeRequest struct {
+	Code     string `json:"code" validate:"required,min=1,max=30"`
+	Name     string `json:"name" validate:"omitempty,max=1000"`
+	IsCustom bool   `json:"is_custom"`
+}
+
+type UpdateTRUCodeRequest struct {
+	ID   string `json:"-" validate:"required"`
+	Code string `json:"code" validate:"required,min=1,max=30"`
+	Name string `json:"name" validate:"omitempty,max=1000"`
+}
+
+type SetTRUPriceRequest struct {
+	TRUCodeID string  `json:"-" validate:"required"`
+	RegionID  string  `json:"region_id" validate:"required,uuid"`
+	Price     float64 `json:"price" validate:"gte=0"`
+}
+
+type CustomTRUPriceRequest struct {
+	RegionID string  `json:"region_id" validate:"required,uuid"`
+	Price    float64 `json:"price" validate:"gte=0"`
+}
+
+type CreateCustomTRUCodeRequest struct {
+	Code   string                  `json:"code" validate:"required,min=1,max=30"`
+	Name   string                  `json:"name" validate:"required,max=1000"`
+	Prices []CustomTRUPriceRequest `json:"prices" validate:"dive"`
+}
+
+type MergeTRUCodeRequest struct {
+	CustomID   string `json:"-" validate:"required"`
+	OfficialID string `json:"official_id" validate:"required,uuid"`
+}
+
+type SetProductTRUCodesRequest struct {
+	ProductID  string   `json:"-" validate:"required"`
+	TRUCodeIDs []string `json:"tru_code_ids" validate:"dive,uuid"`
+}
+
+type TRUCodeQueryParams struct {
+	Search   string `query:"search"`
+	IsCustom *bool  `query:"is_custom"`
+	Status   string `query:"status"`
+	Page     int    `query:"page"`
+	PageSize int    `query:"page_size"`
+}
+
+type TRUCodePriceResponse struct {
+	RegionID  string    `json:"region_id"`
+	Price     float64   `json:"price"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+type TRUCodeResponse struct {
+	ID        string                 `json:"id"`
+	Code      string                 `json:"code"`
+	Name      string                 `json:"name"`
+	IsCustom  bool                   `json:"is_custom"`
+	Status    string                 `json:"status"`
+	Prices    []TRUCodePriceResponse `json:"prices"`
+	CreatedAt time.Time              `json:"created_at"`
+	UpdatedAt time.Time              `json:"updated_at"`
+}
+
+type ReimbursementResponse struct {
+	ProductID string  `json:"product_id"`
+	RegionID  string  `json:"region_id"`
+	TRUCodeID string  `json:"tru_code_id"`
+	Code      string  `json:"code"`
+	Price     float64 `json:"price"`
+}
+
+type MergeTRUCodeResponse struct {
+	Target        TRUCodeResponse `json:"target"`
+	MovedProducts int64           `json:"moved_products"`
+	MovedPrices   int64           `json:"moved_prices"`
+}
+
+type ImportPricesRequest struct {
+	RegionID string `form:"region_id" validate:"required,uuid"`
+	DryRun   bool   `form:"dry_run"`
+}
+
+type ImportPriceRowResponse struct {
+	Row       int      `json:"row"`
+	Code      string   `json:"code"`
+	TRUCodeID string   `json:"tru_code_id,omitempty"`
+	Price     *float64 `json:"price,omitempty"`
+	OldPrice  *float64 `json:"old_price,omitempty"`
+	Status    string   `json:"status"`
+	Message   string   `json:"message,omitempty"`
+}
+
+type ImportPricesResponse struct {
+	RegionID  string                   `json:"region_id"`
+	DryRun    bool                     `json:"dry_run"`
+	Total     int                      `json:"total"`
+	Created   int                      `json:"created"`
+	Updated   int                      `json:"updated"`
+	Unchanged int                      `json:"unchanged"`
+	NotFound  int                      `json:"not_found"`
+	Invalid   int                      `json:"invalid"`
+	Rows      []ImportPriceRowResponse `json:"rows"`
+}
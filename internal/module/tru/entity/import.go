@@ -0,0 +1,66 @@
+package tru_entity
+
+import "io"
+
+type ImportRowStatus string
+
+const (
+	ImportRowCreated   ImportRowStatus = "created"
+	ImportRowUpdated   ImportRowStatus = "updated"
+	ImportRowUnchanged ImportRowStatus = "unchanged"
+	ImportRowNotFound  ImportRowStatus = "not_found"
+	ImportRowInvalid   ImportRowStatus = "invalid"
+)
+
+// PriceImport - загрузка прайс-листа отделения СФР для одного региона
+type PriceImport struct {
+	RegionID string
+	DryRun   bool
+	File     io.Reader
+}
+
+// PriceImportRow - строка прайс-листа как она записана в файле
+type PriceImportRow struct {
+	Row   int
+	Code  string
+	Price string
+}
+
+type PriceImportRowResult struct {
+	Row       int
+	Code      string
+	TRUCodeID string
+	Price     *float64
+	OldPrice  *float64
+	Status    ImportRowStatus
+	Message   string
+}
+
+type PriceImportReport struct {
+	RegionID  string
+	DryRun    bool
+	Total     int
+	Created   int
+	Updated   int
+	Unchanged int
+	NotFound  int
+	Invalid   int
+	Rows      []PriceImportRowResult
+}
+
+// Add добавляет результат строки в отчет и обновляет счетчики
+func (r *PriceImportReport) Add(result PriceImportRowResult) {
+	switch result.Status {
+	case ImportRowCreated:
+		r.Created++
+	case ImportRowUpdated:
+		r.Updated++
+	case ImportRowUnchanged:
+		r.Unchanged++
+	case ImportRowNotFound:
+		r.NotFound++
+	case ImportRowInvalid:
+		r.Invalid++
+	}
+	r.Rows = append(r.Rows, result)
+}
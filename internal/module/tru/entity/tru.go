@@ -0,0 +1,56 @@
+package tru_entity
+
+import "time"
+
+// TRUCodeStatus - статус проверки кода. Официальные коды всегда approved,
+// собственные коды до утверждения не участвуют в расчете возмещения.
+type TRUCodeStatus string
+
+const (
+	TRUCodeStatusPending  TRUCodeStatus = "pending"
+	TRUCodeStatusApproved TRUCodeStatus = "approved"
+)
+
+type TRUCode struct {
+	ID        string
+	Code      string
+	Name      string
+	IsCustom  bool
+	Status    TRUCodeStatus
+	Prices    []TRUCodePrice
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+type TRUCodePrice struct {
+	ID        string
+	TRUCodeID string
+	RegionID  string
+	Price     float64
+	UpdatedAt time.Time
+}
+
+// ProductReimbursement - цена возмещения товара в регионе.
+// Если товар привязан к нескольким кодам, берется код с максимальной ценой.
+type ProductReimbursement struct {
+	ProductID string
+	RegionID  string
+	TRUCodeID string
+	Code      string
+	Price     float64
+}
+
+type TRUCodeFilter struct {
+	Search   string
+	IsCustom *bool
+	Status   TRUCodeStatus
+	Offset   int
+	Limit    int
+}
+
+// MergeResult - итог переноса собственного кода в официальный
+type MergeResult struct {
+	Target        *TRUCode
+	MovedProducts int64
+	MovedPrices   int64
+}
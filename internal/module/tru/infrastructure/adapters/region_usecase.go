@@ -0,0 +1,26 @@
+package tru_adapters
+
+import (
+	"context"
+
+	region_usecase "github.com/Fi44er/sdmed/internal/module/region/usecase/region"
+)
+
+type IRegionUsecaseAdapter interface {
+	CheckExists(ctx context.Context, regionID string) error
+}
+
+type RegionUsecaseAdapter struct {
+	regionUsecase region_usecase.IRegionUsecase
+}
+
+func NewRegionUsecaseAdapter(regionUsecase region_usecase.IRegionUsecase) IRegionUsecaseAdapter {
+	return &RegionUsecaseAdapter{
+		regionUsecase: regionUsecase,
+	}
+}
+
+func (a *RegionUsecaseAdapter) CheckExists(ctx context.Context, regionID string) error {
+	_, err := a.regionUsecase.GetByID(ctx, regionID)
+	return err
+}
@@ -0,0 +1,31 @@
+package tru_adapters
+
+import (
+	"context"
+
+	matcher_entity "github.com/Fi44er/sdmed/internal/module/matcher/entity"
+	matcher_usecase "github.com/Fi44er/sdmed/internal/module/matcher/usecase/matcher"
+	tru_entity "github.com/Fi44er/sdmed/internal/module/tru/entity"
+)
+
+type ISuggestionUsecaseAdapter interface {
+	MoveCode(ctx context.Context, fromCodeID string, to *tru_entity.TRUCode) error
+}
+
+type SuggestionUsecaseAdapter struct {
+	matcherUsecase matcher_usecase.IMatcherUsecase
+}
+
+func NewSuggestionUsecaseAdapter(matcherUsecase matcher_usecase.IMatcherUsecase) ISuggestionUsecaseAdapter {
+	return &SuggestionUsecaseAdapter{
+		matcherUsecase: matcherUsecase,
+	}
+}
+
+func (a *SuggestionUsecaseAdapter) MoveCode(ctx context.Context, fromCodeID string, to *tru_entity.TRUCode) error {
+	return a.matcherUsecase.MoveCode(ctx, fromCodeID, &matcher_entity.MatchCode{
+		ID:   to.ID,
+		Code: to.Code,
+		Name: to.Name,
+	})
+}
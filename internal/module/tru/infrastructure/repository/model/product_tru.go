@@ -0,0 +1,15 @@
+package tru_model
+
+import "time"
+
+// ProductTRUCode - связь товара с кодами КТРУ, по которым он возмещается
+type ProductTRUCode struct {
+	ProductID string    `gorm:"primaryKey;type:uuid"`
+	TRUCodeID string    `gorm:"primaryKey;type:uuid;index"`
+	TRUCode   TRUCode   `gorm:"foreignKey:TRUCodeID;constraint:OnDelete:CASCADE"`
+	CreatedAt time.Time `gorm:"not null;default:now()"`
+}
+
+func (ProductTRUCode) TableName() string {
+	return "tru_module.product_tru_codes"
+}
@@ -0,0 +1,18 @@
+package tru_model
+
+import "time"
+
+type TRUCode struct {
+	ID        string         `gorm:"primaryKey;type:uuid;default:uuid_generate_v4();"`
+	Code      string         `gorm:"type:varchar(30);not null;unique"`
+	Name      string         `gorm:"type:varchar(1000);not null;default:''"`
+	IsCustom  bool           `gorm:"type:bool;not null;default:false"`
+	Status    string         `gorm:"type:varchar(20);not null;default:'approved';index"`
+	Prices    []TRUCodePrice `gorm:"foreignKey:TRUCodeID;constraint:OnDelete:CASCADE"`
+	CreatedAt time.Time      `gorm:"not null;default:now()"`
+	UpdatedAt time.Time      `gorm:"not null;default:now()"`
+}
+
+func (TRUCode) TableName() string {
+	return "tru_module.tru_codes"
+}
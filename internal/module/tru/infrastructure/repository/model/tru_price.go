@@ -0,0 +1,15 @@
+package tru_model
+
+import "time"
+
+type TRUCodePrice struct {
+	ID        string    `gorm:"primaryKey;type:uuid;default:uuid_generate_v4();"`
+	TRUCodeID string    `gorm:"type:uuid;not null;uniqueIndex:idx_tru_code_region"`
+	RegionID  string    `gorm:"type:uuid;not null;uniqueIndex:idx_tru_code_region;index"`
+	Price     float64   `gorm:"type:float;not null"`
+	UpdatedAt time.Time `gorm:"not null;default:now()"`
+}
+
+func (TRUCodePrice) TableName() string {
+	return "tru_module.tru_code_prices"
+}
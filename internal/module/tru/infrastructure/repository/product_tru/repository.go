@@ -0,0 +1,189 @@
+package product_tru_repository
+
+import (
+	"context"
+
+	tru_entity "github.com/Fi44er/sdmed/internal/module/tru/entity"
+	tru_model "github.com/Fi44er/sdmed/internal/module/tru/infrastructure/repository/model"
+	"github.com/Fi44er/sdmed/pkg/logger"
+	"gorm.io/gorm"
+)
+
+type IProductTRURepository interface {
+	SetProductCodes(ctx context.Context, productID string, truCodeIDs []string) error
+	GetCodesByProductID(ctx context.Context, productID string) ([]tru_entity.TRUCode, error)
+	GetReimbursements(ctx context.Context, productIDs []string, regionID string) ([]tru_entity.ProductReimbursement, error)
+	GetEligibleProductIDs(ctx context.Context, regionID string) ([]string, error)
+	GetProductIDsByCodeIDs(ctx context.Context, truCodeIDs []string) (map[string][]string, error)
+	MoveCodeLinks(ctx context.Context, fromCodeID, toCodeID string) (int64, error)
+}
+
+type ProductTRURepository struct {
+	logger *logger.Logger
+	db     *gorm.DB
+}
+
+func NewProductTRURepository(logger *logger.Logger, db *gorm.DB) IProductTRURepository {
+	return &ProductTRURepository{
+		logger: logger,
+		db:     db,
+	}
+}
+
+// SetProductCodes полностью заменяет набор кодов КТРУ товара
+func (r *ProductTRURepository) SetProductCodes(ctx context.Context, productID string, truCodeIDs []string) error {
+	r.logger.Infof("Setting %d tru codes for product %s", len(truCodeIDs), productID)
+
+	if err := r.db.WithContext(ctx).Delete(&tru_model.ProductTRUCode{}, "product_id = ?", productID).Error; err != nil {
+		r.logger.Errorf("Failed to clear tru codes for product %s: %v", productID, err)
+		return err
+	}
+
+	if len(truCodeIDs) == 0 {
+		return nil
+	}
+
+	links := make([]tru_model.ProductTRUCode, len(truCodeIDs))
+	for i, truCodeID := range truCodeIDs {
+		links[i] = tru_model.ProductTRUCode{
+			ProductID: productID,
+			TRUCodeID: truCodeID,
+		}
+	}
+
+	if err := r.db.WithContext(ctx).Omit("TRUCode").Create(&links).Error; err != nil {
+		r.logger.Errorf("Failed to link tru codes to product %s: %v", productID, err)
+		return err
+	}
+
+	return nil
+}
+
+func (r *ProductTRURepository) GetCodesByProductID(ctx context.Context, productID string) ([]tru_entity.TRUCode, error) {
+	r.logger.Debugf("Getting tru codes for product: %s", productID)
+
+	var links []tru_model.ProductTRUCode
+	if err := r.db.WithContext(ctx).Preload("TRUCode.Prices").Where("product_id = ?", productID).Find(&links).Error; err != nil {
+		r.logger.Errorf("Failed to get tru codes for product %s: %v", productID, err)
+		return nil, err
+	}
+
+	codes := make([]tru_entity.TRUCode, len(links))
+	for i, link := range links {
+		prices := make([]tru_entity.TRUCodePrice, len(link.TRUCode.Prices))
+		for j, price := range link.TRUCode.Prices {
+			prices[j] = tru_entity.TRUCodePrice{
+				ID:        price.ID,
+				TRUCodeID: price.TRUCodeID,
+				RegionID:  price.RegionID,
+				Price:     price.Price,
+				UpdatedAt: price.UpdatedAt,
+			}
+		}
+
+		codes[i] = tru_entity.TRUCode{
+			ID:        link.TRUCode.ID,
+			Code:      link.TRUCode.Code,
+			Name:      link.TRUCode.Name,
+			IsCustom:  link.TRUCode.IsCustom,
+			Status:    tru_entity.TRUCodeStatus(link.TRUCode.Status),
+			Prices:    prices,
+			CreatedAt: link.TRUCode.CreatedAt,
+			UpdatedAt: link.TRUCode.UpdatedAt,
+		}
+	}
+
+	return codes, nil
+}
+
+// GetReimbursements возвращает цену возмещения для каждого товара, у которого есть цена в регионе.
+// Неутвержденные собственные коды не учитываются.
+// При нескольких кодах у товара берется максимальная цена.
+func (r *ProductTRURepository) GetReimbursements(ctx context.Context, productIDs []string, regionID string) ([]tru_entity.ProductReimbursement, error) {
+	r.logger.Debugf("Getting reimbursements for %d products in region %s", len(productIDs), regionID)
+
+	reimbursements := make([]tru_entity.ProductReimbursement, 0)
+	if len(productIDs) == 0 {
+		return reimbursements, nil
+	}
+
+	err := r.db.WithContext(ctx).
+		Table("tru_module.product_tru_codes AS l").
+		Select("DISTINCT ON (l.product_id) l.product_id, p.region_id, l.tru_code_id, c.code, p.price").
+		Joins("JOIN tru_module.tru_codes c ON c.id = l.tru_code_id").
+		Joins("JOIN tru_module.tru_code_prices p ON p.tru_code_id = l.tru_code_id").
+		Where("l.product_id IN ? AND p.region_id = ? AND c.status = ?", productIDs, regionID, string(tru_entity.TRUCodeStatusApproved)).
+		Order("l.product_id, p.price DESC").
+		Scan(&reimbursements).Error
+	if err != nil {
+		r.logger.Errorf("Failed to get reimbursements: %v", err)
+		return nil, err
+	}
+
+	return reimbursements, nil
+}
+
+// GetEligibleProductIDs возвращает товары, у которых есть цена возмещения в регионе
+// по утвержденному коду
+func (r *ProductTRURepository) GetEligibleProductIDs(ctx context.Context, regionID string) ([]string, error) {
+	r.logger.Debugf("Getting certificate eligible products in region %s", regionID)
+
+	productIDs := make([]string, 0)
+	err := r.db.WithContext(ctx).
+		Table("tru_module.product_tru_codes AS l").
+		Distinct("l.product_id").
+		Joins("JOIN tru_module.tru_codes c ON c.id = l.tru_code_id").
+		Joins("JOIN tru_module.tru_code_prices p ON p.tru_code_id = l.tru_code_id").
+		Where("p.region_id = ? AND c.status = ?", regionID, string(tru_entity.TRUCodeStatusApproved)).
+		Pluck("l.product_id", &productIDs).Error
+	if err != nil {
+		r.logger.Errorf("Failed to get certificate eligible products: %v", err)
+		return nil, err
+	}
+
+	return productIDs, nil
+}
+
+// GetProductIDsByCodeIDs возвращает товары, привязанные к кодам, сгруппированные по коду
+func (r *ProductTRURepository) GetProductIDsByCodeIDs(ctx context.Context, truCodeIDs []string) (map[string][]string, error) {
+	r.logger.Debugf("Getting products for %d tru codes", len(truCodeIDs))
+
+	result := make(map[string][]string)
+	if len(truCodeIDs) == 0 {
+		return result, nil
+	}
+
+	var links []tru_model.ProductTRUCode
+	if err := r.db.WithContext(ctx).Where("tru_code_id IN ?", truCodeIDs).Order("product_id").Find(&links).Error; err != nil {
+		r.logger.Errorf("Failed to get products for tru codes: %v", err)
+		return nil, err
+	}
+
+	for _, link := range links {
+		result[link.TRUCodeID] = append(result[link.TRUCodeID], link.ProductID)
+	}
+
+	return result, nil
+}
+
+// MoveCodeLinks перепривязывает товары с одного кода на другой.
+// Товары, уже привязанные к целевому коду, не дублируются.
+func (r *ProductTRURepository) MoveCodeLinks(ctx context.Context, fromCodeID, toCodeID string) (int64, error) {
+	r.logger.Infof("Moving product links from tru code %s to %s", fromCodeID, toCodeID)
+
+	result := r.db.WithContext(ctx).Exec(`
+		INSERT INTO tru_module.product_tru_codes (product_id, tru_code_id, created_at)
+		SELECT product_id, ?, created_at FROM tru_module.product_tru_codes WHERE tru_code_id = ?
+		ON CONFLICT DO NOTHING`, toCodeID, fromCodeID)
+	if result.Error != nil {
+		r.logger.Errorf("Failed to move product links to tru code %s: %v", toCodeID, result.Error)
+		return 0, result.Error
+	}
+
+	if err := r.db.WithContext(ctx).Delete(&tru_model.ProductTRUCode{}, "tru_code_id = ?", fromCodeID).Error; err != nil {
+		r.logger.Errorf("Failed to delete product links of tru code %s: %v", fromCodeID, err)
+		return 0, err
+	}
+
+	return result.RowsAffected, nil
+}
@@ -0,0 +1,44 @@
+package tru_code_repository
+
+import (
+	tru_entity "github.com/Fi44er/sdmed/internal/module/tru/entity"
+	tru_model "github.com/Fi44er/sdmed/internal/module/tru/infrastructure/repository/model"
+)
+
+type Converter struct{}
+
+func (c *Converter) ToModel(entity *tru_entity.TRUCode) *tru_model.TRUCode {
+	return &tru_model.TRUCode{
+		ID:        entity.ID,
+		Code:      entity.Code,
+		Name:      entity.Name,
+		IsCustom:  entity.IsCustom,
+		Status:    string(entity.Status),
+		CreatedAt: entity.CreatedAt,
+		UpdatedAt: entity.UpdatedAt,
+	}
+}
+
+func (c *Converter) ToEntity(model *tru_model.TRUCode) *tru_entity.TRUCode {
+	prices := make([]tru_entity.TRUCodePrice, len(model.Prices))
+	for i, price := range model.Prices {
+		prices[i] = tru_entity.TRUCodePrice{
+			ID:        price.ID,
+			TRUCodeID: price.TRUCodeID,
+			RegionID:  price.RegionID,
+			Price:     price.Price,
+			UpdatedAt: price.UpdatedAt,
+		}
+	}
+
+	return &tru_entity.TRUCode{
+		ID:        model.ID,
+		Code:      model.Code,
+		Name:      model.Name,
+		IsCustom:  model.IsCustom,
+		Status:    tru_entity.TRUCodeStatus(model.Status),
+		Prices:    prices,
+		CreatedAt: model.CreatedAt,
+		UpdatedAt: model.UpdatedAt,
+	}
+}
@@ -0,0 +1,177 @@
+package tru_code_repository
+
+import (
+	"context"
+	"time"
+
+	tru_entity "github.com/Fi44er/sdmed/internal/module/tru/entity"
+	tru_model "github.com/Fi44er/sdmed/internal/module/tru/infrastructure/repository/model"
+	"github.com/Fi44er/sdmed/pkg/logger"
+	"gorm.io/gorm"
+)
+
+type ITRUCodeRepository interface {
+	Create(ctx context.Context, code *tru_entity.TRUCode) error
+	GetByID(ctx context.Context, id string) (*tru_entity.TRUCode, error)
+	GetByCode(ctx context.Context, code string) (*tru_entity.TRUCode, error)
+	GetAll(ctx context.Context, filter *tru_entity.TRUCodeFilter) ([]tru_entity.TRUCode, error)
+	Count(ctx context.Context, filter *tru_entity.TRUCodeFilter) (int64, error)
+	Update(ctx context.Context, code *tru_entity.TRUCode) error
+	Delete(ctx context.Context, id string) error
+	GetOfficialUpdatedBefore(ctx context.Context, before time.Time) ([]tru_entity.TRUCode, error)
+}
+
+type TRUCodeRepository struct {
+	logger    *logger.Logger
+	db        *gorm.DB
+	converter *Converter
+}
+
+func NewTRUCodeRepository(logger *logger.Logger, db *gorm.DB) ITRUCodeRepository {
+	return &TRUCodeRepository{
+		logger:    logger,
+		db:        db,
+		converter: &Converter{},
+	}
+}
+
+func (r *TRUCodeRepository) Create(ctx context.Context, code *tru_entity.TRUCode) error {
+	r.logger.Infof("Creating tru code: %s", code.Code)
+
+	codeModel := r.converter.ToModel(code)
+	if err := r.db.WithContext(ctx).Create(codeModel).Error; err != nil {
+		r.logger.Errorf("Failed to create tru code %s: %v", code.Code, err)
+		return err
+	}
+	code.ID = codeModel.ID
+	code.CreatedAt = codeModel.CreatedAt
+	code.UpdatedAt = codeModel.UpdatedAt
+
+	r.logger.Infof("TRU code created successfully: %s (ID: %s)", code.Code, code.ID)
+	return nil
+}
+
+func (r *TRUCodeRepository) GetByID(ctx context.Context, id string) (*tru_entity.TRUCode, error) {
+	r.logger.Debugf("Getting tru code by ID: %s", id)
+
+	var codeModel tru_model.TRUCode
+	if err := r.db.WithContext(ctx).Preload("Prices").First(&codeModel, "id = ?", id).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			r.logger.Debugf("TRU code not found: %s", id)
+			return nil, nil
+		}
+		r.logger.Errorf("Failed to get tru code by ID %s: %v", id, err)
+		return nil, err
+	}
+
+	return r.converter.ToEntity(&codeModel), nil
+}
+
+func (r *TRUCodeRepository) GetByCode(ctx context.Context, code string) (*tru_entity.TRUCode, error) {
+	r.logger.Debugf("Getting tru code by code: %s", code)
+
+	var codeModel tru_model.TRUCode
+	if err := r.db.WithContext(ctx).Preload("Prices").First(&codeModel, "code = ?", code).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			r.logger.Debugf("TRU code not found: %s", code)
+			return nil, nil
+		}
+		r.logger.Errorf("Failed to get tru code %s: %v", code, err)
+		return nil, err
+	}
+
+	return r.converter.ToEntity(&codeModel), nil
+}
+
+func (r *TRUCodeRepository) GetAll(ctx context.Context, filter *tru_entity.TRUCodeFilter) ([]tru_entity.TRUCode, error) {
+	r.logger.Debugf("Getting tru codes (offset: %d, limit: %d)", filter.Offset, filter.Limit)
+
+	var codeModels []tru_model.TRUCode
+	query := r.applyFilter(r.db.WithContext(ctx).Model(&tru_model.TRUCode{}), filter)
+	if err := query.Order("code ASC").Offset(filter.Offset).Limit(filter.Limit).Find(&codeModels).Error; err != nil {
+		r.logger.Errorf("Failed to get tru codes: %v", err)
+		return nil, err
+	}
+
+	codes := make([]tru_entity.TRUCode, len(codeModels))
+	for i := range codeModels {
+		codes[i] = *r.converter.ToEntity(&codeModels[i])
+	}
+
+	r.logger.Debugf("Retrieved %d tru codes", len(codes))
+	return codes, nil
+}
+
+// GetOfficialUpdatedBefore возвращает официальные коды, которые не обновлялись с указанного момента
+func (r *TRUCodeRepository) GetOfficialUpdatedBefore(ctx context.Context, before time.Time) ([]tru_entity.TRUCode, error) {
+	r.logger.Debugf("Getting official tru codes updated before %v", before)
+
+	var codeModels []tru_model.TRUCode
+	if err := r.db.WithContext(ctx).Where("is_custom = ? AND updated_at < ?", false, before).Order("code ASC").Find(&codeModels).Error; err != nil {
+		r.logger.Errorf("Failed to get stale tru codes: %v", err)
+		return nil, err
+	}
+
+	codes := make([]tru_entity.TRUCode, len(codeModels))
+	for i := range codeModels {
+		codes[i] = *r.converter.ToEntity(&codeModels[i])
+	}
+
+	return codes, nil
+}
+
+func (r *TRUCodeRepository) Count(ctx context.Context, filter *tru_entity.TRUCodeFilter) (int64, error) {
+	var count int64
+	query := r.applyFilter(r.db.WithContext(ctx).Model(&tru_model.TRUCode{}), filter)
+	if err := query.Count(&count).Error; err != nil {
+		r.logger.Errorf("Failed to count tru codes: %v", err)
+		return 0, err
+	}
+
+	return count, nil
+}
+
+func (r *TRUCodeRepository) Update(ctx context.Context, code *tru_entity.TRUCode) error {
+	r.logger.Infof("Updating tru code: %s (ID: %s)", code.Code, code.ID)
+
+	updates := map[string]any{
+		"code":       code.Code,
+		"name":       code.Name,
+		"is_custom":  code.IsCustom,
+		"status":     string(code.Status),
+		"updated_at": gorm.Expr("now()"),
+	}
+	if err := r.db.WithContext(ctx).Model(&tru_model.TRUCode{}).Where("id = ?", code.ID).Updates(updates).Error; err != nil {
+		r.logger.Errorf("Failed to update tru code %s: %v", code.ID, err)
+		return err
+	}
+
+	r.logger.Infof("TRU code updated successfully: %s", code.ID)
+	return nil
+}
+
+func (r *TRUCodeRepository) Delete(ctx context.Context, id string) error {
+	r.logger.Infof("Deleting tru code: %s", id)
+
+	if err := r.db.WithContext(ctx).Delete(&tru_model.TRUCode{}, "id = ?", id).Error; err != nil {
+		r.logger.Errorf("Failed to delete tru code %s: %v", id, err)
+		return err
+	}
+
+	r.logger.Infof("TRU code deleted successfully: %s", id)
+	return nil
+}
+
+func (r *TRUCodeRepository) applyFilter(query *gorm.DB, filter *tru_entity.TRUCodeFilter) *gorm.DB {
+	if filter.Search != "" {
+		pattern := "%" + filter.Search + "%"
+		query = query.Where("code ILIKE ? OR name ILIKE ?", pattern, pattern)
+	}
+	if filter.IsCustom != nil {
+		query = query.Where("is_custom = ?", *filter.IsCustom)
+	}
+	if filter.Status != "" {
+		query = query.Where("status = ?", string(filter.Status))
+	}
+	return query
+}
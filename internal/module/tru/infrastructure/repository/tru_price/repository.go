@@ -0,0 +1,123 @@
+package tru_price_repository
+
+import (
+	"context"
+
+	tru_entity "github.com/Fi44er/sdmed/internal/module/tru/entity"
+	tru_model "github.com/Fi44er/sdmed/internal/module/tru/infrastructure/repository/model"
+	"github.com/Fi44er/sdmed/pkg/logger"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type ITRUPriceRepository interface {
+	Upsert(ctx context.Context, price *tru_entity.TRUCodePrice) error
+	GetByCodeID(ctx context.Context, truCodeID string) ([]tru_entity.TRUCodePrice, error)
+	Delete(ctx context.Context, truCodeID, regionID string) (bool, error)
+	DeleteExceptRegions(ctx context.Context, truCodeID string, regionIDs []string) error
+	MoveMissing(ctx context.Context, fromCodeID, toCodeID string) (int64, error)
+}
+
+type TRUPriceRepository struct {
+	logger *logger.Logger
+	db     *gorm.DB
+}
+
+func NewTRUPriceRepository(logger *logger.Logger, db *gorm.DB) ITRUPriceRepository {
+	return &TRUPriceRepository{
+		logger: logger,
+		db:     db,
+	}
+}
+
+// Upsert создает или обновляет цену кода для региона
+func (r *TRUPriceRepository) Upsert(ctx context.Context, price *tru_entity.TRUCodePrice) error {
+	r.logger.Infof("Setting price %.2f for tru code %s in region %s", price.Price, price.TRUCodeID, price.RegionID)
+
+	priceModel := &tru_model.TRUCodePrice{
+		TRUCodeID: price.TRUCodeID,
+		RegionID:  price.RegionID,
+		Price:     price.Price,
+	}
+	err := r.db.WithContext(ctx).Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "tru_code_id"}, {Name: "region_id"}},
+		DoUpdates: clause.Assignments(map[string]any{"price": price.Price, "updated_at": gorm.Expr("now()")}),
+	}).Create(priceModel).Error
+	if err != nil {
+		r.logger.Errorf("Failed to set tru code price: %v", err)
+		return err
+	}
+	price.ID = priceModel.ID
+
+	return nil
+}
+
+func (r *TRUPriceRepository) GetByCodeID(ctx context.Context, truCodeID string) ([]tru_entity.TRUCodePrice, error) {
+	r.logger.Debugf("Getting prices for tru code: %s", truCodeID)
+
+	var priceModels []tru_model.TRUCodePrice
+	if err := r.db.WithContext(ctx).Where("tru_code_id = ?", truCodeID).Find(&priceModels).Error; err != nil {
+		r.logger.Errorf("Failed to get prices for tru code %s: %v", truCodeID, err)
+		return nil, err
+	}
+
+	prices := make([]tru_entity.TRUCodePrice, len(priceModels))
+	for i, price := range priceModels {
+		prices[i] = tru_entity.TRUCodePrice{
+			ID:        price.ID,
+			TRUCodeID: price.TRUCodeID,
+			RegionID:  price.RegionID,
+			Price:     price.Price,
+			UpdatedAt: price.UpdatedAt,
+		}
+	}
+
+	return prices, nil
+}
+
+// Delete удаляет цену кода для региона, возвращает false если цены не было
+func (r *TRUPriceRepository) Delete(ctx context.Context, truCodeID, regionID string) (bool, error) {
+	r.logger.Infof("Deleting price for tru code %s in region %s", truCodeID, regionID)
+
+	result := r.db.WithContext(ctx).Delete(&tru_model.TRUCodePrice{}, "tru_code_id = ? AND region_id = ?", truCodeID, regionID)
+	if result.Error != nil {
+		r.logger.Errorf("Failed to delete tru code price: %v", result.Error)
+		return false, result.Error
+	}
+
+	return result.RowsAffected > 0, nil
+}
+
+// DeleteExceptRegions удаляет цены кода во всех регионах, кроме перечисленных
+func (r *TRUPriceRepository) DeleteExceptRegions(ctx context.Context, truCodeID string, regionIDs []string) error {
+	r.logger.Debugf("Deleting prices of tru code %s outside %d regions", truCodeID, len(regionIDs))
+
+	query := r.db.WithContext(ctx).Where("tru_code_id = ?", truCodeID)
+	if len(regionIDs) > 0 {
+		query = query.Where("region_id NOT IN ?", regionIDs)
+	}
+
+	if err := query.Delete(&tru_model.TRUCodePrice{}).Error; err != nil {
+		r.logger.Errorf("Failed to delete prices of tru code %s: %v", truCodeID, err)
+		return err
+	}
+
+	return nil
+}
+
+// MoveMissing переносит цены на другой код только в тех регионах, где у него еще нет своей цены
+func (r *TRUPriceRepository) MoveMissing(ctx context.Context, fromCodeID, toCodeID string) (int64, error) {
+	r.logger.Infof("Moving prices from tru code %s to %s", fromCodeID, toCodeID)
+
+	existRegions := r.db.Model(&tru_model.TRUCodePrice{}).Select("region_id").Where("tru_code_id = ?", toCodeID)
+	result := r.db.WithContext(ctx).
+		Model(&tru_model.TRUCodePrice{}).
+		Where("tru_code_id = ? AND region_id NOT IN (?)", fromCodeID, existRegions).
+		Updates(map[string]any{"tru_code_id": toCodeID, "updated_at": gorm.Expr("now()")})
+	if result.Error != nil {
+		r.logger.Errorf("Failed to move prices to tru code %s: %v", toCodeID, result.Error)
+		return 0, result.Error
+	}
+
+	return result.RowsAffected, nil
+}
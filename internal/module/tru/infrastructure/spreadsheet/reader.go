@@ -0,0 +1,101 @@
+package tru_spreadsheet
+
+import (
+	"io"
+	"strings"
+
+	tru_entity "github.com/Fi44er/sdmed/internal/module/tru/entity"
+	tru_constant "github.com/Fi44er/sdmed/internal/module/tru/pkg"
+	"github.com/Fi44er/sdmed/pkg/logger"
+	"github.com/xuri/excelize/v2"
+)
+
+// headerSearchRows - в скольких первых строках искать заголовок таблицы.
+// Над таблицей в прайс-листах СФР обычно идут название отделения и дата приказа.
+const headerSearchRows = 20
+
+type PriceListReader struct {
+	logger *logger.Logger
+}
+
+func NewPriceListReader(logger *logger.Logger) *PriceListReader {
+	return &PriceListReader{
+		logger: logger,
+	}
+}
+
+// ReadPriceRows читает первый лист XLSX-файла. Колонки кода и цены определяются по заголовку,
+// пустые строки пропускаются.
+func (r *PriceListReader) ReadPriceRows(file io.Reader) ([]tru_entity.PriceImportRow, error) {
+	workbook, err := excelize.OpenReader(file)
+	if err != nil {
+		r.logger.Warnf("Failed to open price list: %v", err)
+		return nil, tru_constant.ErrImportFileInvalid
+	}
+	defer workbook.Close()
+
+	sheets := workbook.GetSheetList()
+	if len(sheets) == 0 {
+		return nil, tru_constant.ErrImportFileInvalid
+	}
+
+	rows, err := workbook.GetRows(sheets[0])
+	if err != nil {
+		r.logger.Warnf("Failed to read sheet %s: %v", sheets[0], err)
+		return nil, tru_constant.ErrImportFileInvalid
+	}
+
+	headerRow, codeCol, priceCol := findHeader(rows)
+	if headerRow < 0 {
+		return nil, tru_constant.ErrImportHeaderNotFound
+	}
+
+	result := make([]tru_entity.PriceImportRow, 0, len(rows)-headerRow-1)
+	for i := headerRow + 1; i < len(rows); i++ {
+		code, price := cell(rows[i], codeCol), cell(rows[i], priceCol)
+		if code == "" && price == "" {
+			continue
+		}
+
+		result = append(result, tru_entity.PriceImportRow{
+			Row:   i + 1,
+			Code:  code,
+			Price: price,
+		})
+	}
+
+	r.logger.Debugf("Read %d price rows from sheet %s", len(result), sheets[0])
+	return result, nil
+}
+
+// findHeader ищет строку, где есть и колонка кода, и колонка цены.
+// Колонка "Код КТРУ" предпочтительнее других колонок с "код" в названии.
+func findHeader(rows [][]string) (int, int, int) {
+	for i := 0; i < len(rows) && i < headerSearchRows; i++ {
+		codeCol, priceCol := -1, -1
+		for j, value := range rows[i] {
+			header := strings.ToLower(strings.TrimSpace(value))
+			switch {
+			case strings.Contains(header, "ктру"):
+				codeCol = j
+			case codeCol < 0 && strings.Contains(header, "код"):
+				codeCol = j
+			case priceCol < 0 && (strings.Contains(header, "цен") || strings.Contains(header, "стоимост")):
+				priceCol = j
+			}
+		}
+
+		if codeCol >= 0 && priceCol >= 0 {
+			return i, codeCol, priceCol
+		}
+	}
+
+	return -1, -1, -1
+}
+
+func cell(row []string, col int) string {
+	if col >= len(row) {
+		return ""
+	}
+	return strings.TrimSpace(row[col])
+}
@@ -0,0 +1,69 @@
+package tru_spreadsheet
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	tru_entity "github.com/Fi44er/sdmed/internal/module/tru/entity"
+	tru_constant "github.com/Fi44er/sdmed/internal/module/tru/pkg"
+	"github.com/Fi44er/sdmed/pkg/logger"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/xuri/excelize/v2"
+)
+
+func buildWorkbook(t *testing.T, rows [][]any) *bytes.Buffer {
+	t.Helper()
+
+	workbook := excelize.NewFile()
+	defer workbook.Close()
+
+	sheet := workbook.GetSheetName(0)
+	for i, row := range rows {
+		cellName, err := excelize.CoordinatesToCellName(1, i+1)
+		require.NoError(t, err)
+		require.NoError(t, workbook.SetSheetRow(sheet, cellName, &row))
+	}
+
+	buffer, err := workbook.WriteToBuffer()
+	require.NoError(t, err)
+	return buffer
+}
+
+func TestReadPriceRows(t *testing.T) {
+	reader := NewPriceListReader(logger.NewLogger())
+
+	t.Run("header below title and empty rows skipped", func(t *testing.T) {
+		file := buildWorkbook(t, [][]any{
+			{"Отделение СФР по Тюменской области"},
+			{},
+			{"№ п/п", "Код вида ТСР", "Код КТРУ", "Наименование", "Цена, руб."},
+			{1, "7-01", "32.50.22.121-00000001", "Кресло-коляска", "12 345,67"},
+			{},
+			{2, "7-02", "32.50.22.121-00000002", "Кресло-коляска электрическая", 98000},
+		})
+
+		rows, err := reader.ReadPriceRows(file)
+		require.NoError(t, err)
+		assert.Equal(t, []tru_entity.PriceImportRow{
+			{Row: 4, Code: "32.50.22.121-00000001", Price: "12 345,67"},
+			{Row: 6, Code: "32.50.22.121-00000002", Price: "98000"},
+		}, rows)
+	})
+
+	t.Run("missing price column", func(t *testing.T) {
+		file := buildWorkbook(t, [][]any{
+			{"Код КТРУ", "Наименование"},
+			{"32.50.22.121-00000001", "Кресло-коляска"},
+		})
+
+		_, err := reader.ReadPriceRows(file)
+		assert.ErrorIs(t, err, tru_constant.ErrImportHeaderNotFound)
+	})
+
+	t.Run("not an xlsx file", func(t *testing.T) {
+		_, err := reader.ReadPriceRows(strings.NewReader("code;price\n"))
+		assert.ErrorIs(t, err, tru_constant.ErrImportFileInvalid)
+	})
+}
@@ -0,0 +1,93 @@
+package tru_module
+
+import (
+	matcher_usecase "github.com/Fi44er/sdmed/internal/module/matcher/usecase/matcher"
+	product_usecase "github.com/Fi44er/sdmed/internal/module/product/usecase/product"
+	region_usecase "github.com/Fi44er/sdmed/internal/module/region/usecase/region"
+	tru_http "github.com/Fi44er/sdmed/internal/module/tru/delivery/http"
+	tru_adapters "github.com/Fi44er/sdmed/internal/module/tru/infrastructure/adapters"
+	product_tru_repository "github.com/Fi44er/sdmed/internal/module/tru/infrastructure/repository/product_tru"
+	tru_code_repository "github.com/Fi44er/sdmed/internal/module/tru/infrastructure/repository/tru_code"
+	tru_price_repository "github.com/Fi44er/sdmed/internal/module/tru/infrastructure/repository/tru_price"
+	tru_spreadsheet "github.com/Fi44er/sdmed/internal/module/tru/infrastructure/spreadsheet"
+	tru_usecase "github.com/Fi44er/sdmed/internal/module/tru/usecase/tru"
+	"github.com/Fi44er/sdmed/pkg/logger"
+	"github.com/Fi44er/sdmed/pkg/postgres/uow"
+	"github.com/go-playground/validator/v10"
+	"github.com/gofiber/fiber/v2"
+	"gorm.io/gorm"
+)
+
+type TRUModule struct {
+	codeRepository       tru_code_repository.ITRUCodeRepository
+	productTRURepository product_tru_repository.IProductTRURepository
+	truUsecase           *tru_usecase.TRUUsecase
+	truHandler           *tru_http.TRUHandler
+
+	regionUsecase region_usecase.IRegionUsecase
+
+	logger    *logger.Logger
+	validator *validator.Validate
+	db        *gorm.DB
+	uow       uow.Uow
+}
+
+func NewTRUModule(
+	logger *logger.Logger,
+	validator *validator.Validate,
+	db *gorm.DB,
+	uow uow.Uow,
+	regionUsecase region_usecase.IRegionUsecase,
+) *TRUModule {
+	return &TRUModule{
+		logger:        logger,
+		validator:     validator,
+		db:            db,
+		uow:           uow,
+		regionUsecase: regionUsecase,
+	}
+}
+
+func (m *TRUModule) Init() {
+	m.uow.RegisterRepository("tru_code", func(tx *gorm.DB) (any, error) {
+		return tru_code_repository.NewTRUCodeRepository(m.logger, tx), nil
+	})
+
+	m.uow.RegisterRepository("tru_price", func(tx *gorm.DB) (any, error) {
+		return tru_price_repository.NewTRUPriceRepository(m.logger, tx), nil
+	})
+
+	m.uow.RegisterRepository("product_tru", func(tx *gorm.DB) (any, error) {
+		return product_tru_repository.NewProductTRURepository(m.logger, tx), nil
+	})
+
+	m.codeRepository = tru_code_repository.NewTRUCodeRepository(m.logger, m.db)
+	m.productTRURepository = product_tru_repository.NewProductTRURepository(m.logger, m.db)
+	m.truUsecase = tru_usecase.NewTRUUsecase(
+		m.codeRepository,
+		m.productTRURepository,
+		tru_spreadsheet.NewPriceListReader(m.logger),
+		tru_adapters.NewRegionUsecaseAdapter(m.regionUsecase),
+		m.uow,
+		m.logger,
+	)
+	m.truHandler = tru_http.NewTRUHandler(m.truUsecase, m.validator, m.logger)
+}
+
+func (m *TRUModule) InitDelivery(router fiber.Router) {
+	m.truHandler.RegisterRoutes(router)
+}
+
+func (m *TRUModule) GetTRUUsecase() tru_usecase.ITRUUsecase {
+	return m.truUsecase
+}
+
+// SetProductUsecase подключает проверку существования товара при привязке кодов
+func (m *TRUModule) SetProductUsecase(productUsecase product_usecase.IProductUsecase) {
+	m.truUsecase.SetProductUsecase(tru_adapters.NewProductUsecaseAdapter(productUsecase))
+}
+
+// SetMatcherUsecase подключает перенос подсказок подбора при объединении кодов
+func (m *TRUModule) SetMatcherUsecase(matcherUsecase matcher_usecase.IMatcherUsecase) {
+	m.truUsecase.SetSuggestionUsecase(tru_adapters.NewSuggestionUsecaseAdapter(matcherUsecase))
+}
@@ -0,0 +1,19 @@
+package tru_constant
+
+import "github.com/Fi44er/sdmed/pkg/customerr"
+
+var (
+	ErrTRUCodeNotFound       = customerr.NewError(404, "tru code not found")
+	ErrTRUCodeAlreadyExists  = customerr.NewError(409, "tru code already exists")
+	ErrTRUPriceNotFound      = customerr.NewError(404, "tru code price for region not found")
+	ErrReimbursementNotFound = customerr.NewError(404, "product has no reimbursement price for region")
+	ErrProductNotFound       = customerr.NewError(404, "product not found")
+
+	ErrTRUCodeNotCustom       = customerr.NewError(400, "tru code is not custom")
+	ErrTRUCodeAlreadyApproved = customerr.NewError(409, "tru code already approved")
+	ErrMergeTargetNotOfficial = customerr.NewError(400, "merge target must be an official tru code")
+	ErrParsedCodeIsCustom     = customerr.NewError(409, "catalogue code conflicts with custom tru code")
+
+	ErrImportFileInvalid    = customerr.NewError(400, "price list must be a valid xlsx file")
+	ErrImportHeaderNotFound = customerr.NewError(400, "price list header not found: expected code and price columns")
+)
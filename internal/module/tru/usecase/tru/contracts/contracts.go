@@ -0,0 +1,52 @@
+package tru_usecase_contracts
+
+import (
+	"context"
+	"io"
+	"time"
+
+	tru_entity "github.com/Fi44er/sdmed/internal/module/tru/entity"
+)
+
+type ITRUCodeRepository interface {
+	Create(ctx context.Context, code *tru_entity.TRUCode) error
+	GetByID(ctx context.Context, id string) (*tru_entity.TRUCode, error)
+	GetByCode(ctx context.Context, code string) (*tru_entity.TRUCode, error)
+	GetAll(ctx context.Context, filter *tru_entity.TRUCodeFilter) ([]tru_entity.TRUCode, error)
+	Count(ctx context.Context, filter *tru_entity.TRUCodeFilter) (int64, error)
+	Update(ctx context.Context, code *tru_entity.TRUCode) error
+	Delete(ctx context.Context, id string) error
+	GetOfficialUpdatedBefore(ctx context.Context, before time.Time) ([]tru_entity.TRUCode, error)
+}
+
+type ITRUPriceRepository interface {
+	Upsert(ctx context.Context, price *tru_entity.TRUCodePrice) error
+	Delete(ctx context.Context, truCodeID, regionID string) (bool, error)
+	DeleteExceptRegions(ctx context.Context, truCodeID string, regionIDs []string) error
+	MoveMissing(ctx context.Context, fromCodeID, toCodeID string) (int64, error)
+}
+
+type IProductTRURepository interface {
+	SetProductCodes(ctx context.Context, productID string, truCodeIDs []string) error
+	GetCodesByProductID(ctx context.Context, productID string) ([]tru_entity.TRUCode, error)
+	GetReimbursements(ctx context.Context, productIDs []string, regionID string) ([]tru_entity.ProductReimbursement, error)
+	GetEligibleProductIDs(ctx context.Context, regionID string) ([]string, error)
+	GetProductIDsByCodeIDs(ctx context.Context, truCodeIDs []string) (map[string][]string, error)
+	MoveCodeLinks(ctx context.Context, fromCodeID, toCodeID string) (int64, error)
+}
+
+type IPriceListReader interface {
+	ReadPriceRows(file io.Reader) ([]tru_entity.PriceImportRow, error)
+}
+
+type IRegionUsecaseAdapter interface {
+	CheckExists(ctx context.Context, regionID string) error
+}
+
+type IProductUsecaseAdapter interface {
+	Exists(ctx context.Context, productID string) (bool, error)
+}
+
+type ISuggestionUsecaseAdapter interface {
+	MoveCode(ctx context.Context, fromCodeID string, to *tru_entity.TRUCode) error
+}
@@ -0,0 +1,148 @@
+package tru_usecase
+
+import (
+	"context"
+
+	tru_entity "github.com/Fi44er/sdmed/internal/module/tru/entity"
+	tru_constant "github.com/Fi44er/sdmed/internal/module/tru/pkg"
+	tru_usecase_contracts "github.com/Fi44er/sdmed/internal/module/tru/usecase/tru/contracts"
+)
+
+// CreateCustom создает собственный код с ручными региональными ценами.
+// Код создается в статусе pending и не участвует в возмещении до утверждения.
+func (u *TRUUsecase) CreateCustom(ctx context.Context, code *tru_entity.TRUCode) error {
+	u.logger.Infof("Creating custom tru code: %s", code.Code)
+
+	code.IsCustom = true
+	return u.uow.Do(ctx, func(ctx context.Context) error {
+		if err := u.Create(ctx, code); err != nil {
+			return err
+		}
+
+		priceRepo, err := u.getPriceRepository(ctx)
+		if err != nil {
+			return err
+		}
+
+		for i := range code.Prices {
+			code.Prices[i].TRUCodeID = code.ID
+			if err := priceRepo.Upsert(ctx, &code.Prices[i]); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+}
+
+func (u *TRUUsecase) Approve(ctx context.Context, id string) error {
+	u.logger.Infof("Approving custom tru code: %s", id)
+
+	return u.uow.Do(ctx, func(ctx context.Context) error {
+		codeRepo, err := u.getCodeRepository(ctx)
+		if err != nil {
+			return err
+		}
+
+		code, err := codeRepo.GetByID(ctx, id)
+		if err != nil {
+			return err
+		}
+		if code == nil {
+			return tru_constant.ErrTRUCodeNotFound
+		}
+		if !code.IsCustom {
+			return tru_constant.ErrTRUCodeNotCustom
+		}
+		if code.Status == tru_entity.TRUCodeStatusApproved {
+			return tru_constant.ErrTRUCodeAlreadyApproved
+		}
+
+		code.Status = tru_entity.TRUCodeStatusApproved
+		return codeRepo.Update(ctx, code)
+	})
+}
+
+// Merge переносит собственный код в официальный: привязки товаров, подсказки подбора и цены в регионах,
+// где у официального кода цены еще нет, переходят на него, после чего собственный код удаляется.
+func (u *TRUUsecase) Merge(ctx context.Context, customID, officialID string) (*tru_entity.MergeResult, error) {
+	u.logger.Infof("Merging custom tru code %s into %s", customID, officialID)
+
+	result := &tru_entity.MergeResult{}
+	err := u.uow.Do(ctx, func(ctx context.Context) error {
+		codeRepo, err := u.getCodeRepository(ctx)
+		if err != nil {
+			return err
+		}
+
+		custom, err := codeRepo.GetByID(ctx, customID)
+		if err != nil {
+			return err
+		}
+		if custom == nil {
+			return tru_constant.ErrTRUCodeNotFound
+		}
+		if !custom.IsCustom {
+			return tru_constant.ErrTRUCodeNotCustom
+		}
+
+		official, err := codeRepo.GetByID(ctx, officialID)
+		if err != nil {
+			return err
+		}
+		if official == nil {
+			return tru_constant.ErrTRUCodeNotFound.WithContext("merge target " + officialID)
+		}
+		if official.IsCustom {
+			return tru_constant.ErrMergeTargetNotOfficial
+		}
+
+		repo, err := u.uow.GetRepository(ctx, "product_tru")
+		if err != nil {
+			u.logger.Errorf("Failed to get repository: %v", err)
+			return err
+		}
+		productTRURepo := repo.(tru_usecase_contracts.IProductTRURepository)
+
+		if result.MovedProducts, err = productTRURepo.MoveCodeLinks(ctx, customID, officialID); err != nil {
+			return err
+		}
+
+		priceRepo, err := u.getPriceRepository(ctx)
+		if err != nil {
+			return err
+		}
+
+		if result.MovedPrices, err = priceRepo.MoveMissing(ctx, customID, officialID); err != nil {
+			return err
+		}
+
+		if u.suggestionUsecase != nil {
+			if err := u.suggestionUsecase.MoveCode(ctx, customID, official); err != nil {
+				return err
+			}
+		}
+
+		if err := codeRepo.Delete(ctx, customID); err != nil {
+			return err
+		}
+
+		result.Target, err = codeRepo.GetByID(ctx, officialID)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	u.logger.Infof("Merged tru code %s: %d products, %d prices moved", customID, result.MovedProducts, result.MovedPrices)
+	return result, nil
+}
+
+func (u *TRUUsecase) getPriceRepository(ctx context.Context) (tru_usecase_contracts.ITRUPriceRepository, error) {
+	repo, err := u.uow.GetRepository(ctx, "tru_price")
+	if err != nil {
+		u.logger.Errorf("Failed to get repository: %v", err)
+		return nil, err
+	}
+	return repo.(tru_usecase_contracts.ITRUPriceRepository), nil
+}
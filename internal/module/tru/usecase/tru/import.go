@@ -0,0 +1,149 @@
+package tru_usecase
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+
+	tru_entity "github.com/Fi44er/sdmed/internal/module/tru/entity"
+	tru_usecase_contracts "github.com/Fi44er/sdmed/internal/module/tru/usecase/tru/contracts"
+)
+
+// priceEpsilon - цены, отличающиеся меньше чем на полкопейки, считаются равными
+const priceEpsilon = 0.005
+
+// ImportPrices загружает цены из прайс-листа для одного региона.
+// Каждая строка получает статус в отчете; некорректные строки не мешают загрузке остальных.
+// В режиме dry-run отчет строится без записи в базу.
+func (u *TRUUsecase) ImportPrices(ctx context.Context, priceImport *tru_entity.PriceImport) (*tru_entity.PriceImportReport, error) {
+	u.logger.Infof("Importing tru prices for region %s (dry run: %t)", priceImport.RegionID, priceImport.DryRun)
+
+	if err := u.regionUsecase.CheckExists(ctx, priceImport.RegionID); err != nil {
+		return nil, err
+	}
+
+	rows, err := u.priceListReader.ReadPriceRows(priceImport.File)
+	if err != nil {
+		return nil, err
+	}
+
+	report := &tru_entity.PriceImportReport{
+		RegionID: priceImport.RegionID,
+		DryRun:   priceImport.DryRun,
+		Total:    len(rows),
+		Rows:     make([]tru_entity.PriceImportRowResult, 0, len(rows)),
+	}
+
+	err = u.uow.Do(ctx, func(ctx context.Context) error {
+		codeRepo, err := u.getCodeRepository(ctx)
+		if err != nil {
+			return err
+		}
+
+		priceRepo, err := u.getPriceRepository(ctx)
+		if err != nil {
+			return err
+		}
+
+		seen := make(map[string]int, len(rows))
+		for _, row := range rows {
+			result := tru_entity.PriceImportRowResult{Row: row.Row, Code: row.Code}
+
+			price, message := parsePrice(row.Price)
+			switch {
+			case row.Code == "":
+				result.Status, result.Message = tru_entity.ImportRowInvalid, "empty code"
+			case message != "":
+				result.Status, result.Message = tru_entity.ImportRowInvalid, message
+			case seen[row.Code] != 0:
+				result.Status = tru_entity.ImportRowInvalid
+				result.Message = fmt.Sprintf("duplicate code, first seen in row %d", seen[row.Code])
+			}
+
+			if result.Status == "" {
+				seen[row.Code] = row.Row
+				result.Price = &price
+
+				if err := u.importPrice(ctx, codeRepo, priceRepo, priceImport, &result); err != nil {
+					return err
+				}
+			}
+
+			report.Add(result)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	u.logger.Infof("Imported tru prices for region %s: %d created, %d updated, %d unchanged, %d not found, %d invalid",
+		report.RegionID, report.Created, report.Updated, report.Unchanged, report.NotFound, report.Invalid)
+	return report, nil
+}
+
+func (u *TRUUsecase) importPrice(
+	ctx context.Context,
+	codeRepo tru_usecase_contracts.ITRUCodeRepository,
+	priceRepo tru_usecase_contracts.ITRUPriceRepository,
+	priceImport *tru_entity.PriceImport,
+	result *tru_entity.PriceImportRowResult,
+) error {
+	code, err := codeRepo.GetByCode(ctx, result.Code)
+	if err != nil {
+		return err
+	}
+	if code == nil {
+		result.Status, result.Message = tru_entity.ImportRowNotFound, "tru code not found"
+		return nil
+	}
+	result.TRUCodeID = code.ID
+
+	result.Status = tru_entity.ImportRowCreated
+	for _, existPrice := range code.Prices {
+		if existPrice.RegionID != priceImport.RegionID {
+			continue
+		}
+
+		oldPrice := existPrice.Price
+		result.OldPrice = &oldPrice
+		result.Status = tru_entity.ImportRowUpdated
+		if math.Abs(oldPrice-*result.Price) < priceEpsilon {
+			result.Status = tru_entity.ImportRowUnchanged
+		}
+		break
+	}
+
+	if priceImport.DryRun || result.Status == tru_entity.ImportRowUnchanged {
+		return nil
+	}
+
+	return priceRepo.Upsert(ctx, &tru_entity.TRUCodePrice{
+		TRUCodeID: code.ID,
+		RegionID:  priceImport.RegionID,
+		Price:     *result.Price,
+	})
+}
+
+// parsePrice разбирает цену в записи прайс-листа: допускаются пробелы между разрядами
+// и запятая в качестве десятичного разделителя. Возвращает причину, если цена некорректна.
+func parsePrice(value string) (float64, string) {
+	normalized := strings.NewReplacer(" ", "", "\u00a0", "", ",", ".").Replace(value)
+	normalized = strings.TrimSuffix(strings.TrimSuffix(normalized, "руб."), "₽")
+	if normalized == "" {
+		return 0, "empty price"
+	}
+
+	price, err := strconv.ParseFloat(normalized, 64)
+	if err != nil || math.IsNaN(price) || math.IsInf(price, 0) {
+		return 0, fmt.Sprintf("invalid price %q", value)
+	}
+	if price <= 0 {
+		return 0, fmt.Sprintf("price must be positive, got %s", value)
+	}
+
+	return math.Round(price*100) / 100, ""
+}
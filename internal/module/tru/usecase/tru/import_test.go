@@ -0,0 +1,37 @@
+package tru_usecase
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParsePrice(t *testing.T) {
+	tests := []struct {
+		value   string
+		price   float64
+		invalid bool
+	}{
+		{value: "12345.67", price: 12345.67},
+		{value: "12 345,67", price: 12345.67},
+		{value: "12 345,674", price: 12345.67},
+		{value: "980 руб.", price: 980},
+		{value: "", invalid: true},
+		{value: "—", invalid: true},
+		{value: "0", invalid: true},
+		{value: "-15", invalid: true},
+		{value: "NaN", invalid: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.value, func(t *testing.T) {
+			price, message := parsePrice(tt.value)
+			if tt.invalid {
+				assert.NotEmpty(t, message)
+				return
+			}
+			assert.Empty(t, message)
+			assert.Equal(t, tt.price, price)
+		})
+	}
+}
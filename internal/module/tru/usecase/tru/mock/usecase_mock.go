@@ -0,0 +1,498 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./tru/contracts/contracts.go
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	context "context"
+	io "io"
+	reflect "reflect"
+	time "time"
+
+	tru_entity "github.com/Fi44er/sdmed/internal/module/tru/entity"
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockITRUCodeRepository is a mock of ITRUCodeRepository interface.
+type MockITRUCodeRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockITRUCodeRepositoryMockRecorder
+}
+
+// MockITRUCodeRepositoryMockRecorder is the mock recorder for MockITRUCodeRepository.
+type MockITRUCodeRepositoryMockRecorder struct {
+	mock *MockITRUCodeRepository
+}
+
+// NewMockITRUCodeRepository creates a new mock instance.
+func NewMockITRUCodeRepository(ctrl *gomock.Controller) *MockITRUCodeRepository {
+	mock := &MockITRUCodeRepository{ctrl: ctrl}
+	mock.recorder = &MockITRUCodeRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockITRUCodeRepository) EXPECT() *MockITRUCodeRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Count mocks base method.
+func (m *MockITRUCodeRepository) Count(ctx context.Context, filter *tru_entity.TRUCodeFilter) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Count", ctx, filter)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Count indicates an expected call of Count.
+func (mr *MockITRUCodeRepositoryMockRecorder) Count(ctx, filter interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Count", reflect.TypeOf((*MockITRUCodeRepository)(nil).Count), ctx, filter)
+}
+
+// Create mocks base method.
+func (m *MockITRUCodeRepository) Create(ctx context.Context, code *tru_entity.TRUCode) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, code)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockITRUCodeRepositoryMockRecorder) Create(ctx, code interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockITRUCodeRepository)(nil).Create), ctx, code)
+}
+
+// Delete mocks base method.
+func (m *MockITRUCodeRepository) Delete(ctx context.Context, id string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockITRUCodeRepositoryMockRecorder) Delete(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockITRUCodeRepository)(nil).Delete), ctx, id)
+}
+
+// GetAll mocks base method.
+func (m *MockITRUCodeRepository) GetAll(ctx context.Context, filter *tru_entity.TRUCodeFilter) ([]tru_entity.TRUCode, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAll", ctx, filter)
+	ret0, _ := ret[0].([]tru_entity.TRUCode)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAll indicates an expected call of GetAll.
+func (mr *MockITRUCodeRepositoryMockRecorder) GetAll(ctx, filter interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAll", reflect.TypeOf((*MockITRUCodeRepository)(nil).GetAll), ctx, filter)
+}
+
+// GetByCode mocks base method.
+func (m *MockITRUCodeRepository) GetByCode(ctx context.Context, code string) (*tru_entity.TRUCode, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByCode", ctx, code)
+	ret0, _ := ret[0].(*tru_entity.TRUCode)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByCode indicates an expected call of GetByCode.
+func (mr *MockITRUCodeRepositoryMockRecorder) GetByCode(ctx, code interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByCode", reflect.TypeOf((*MockITRUCodeRepository)(nil).GetByCode), ctx, code)
+}
+
+// GetByID mocks base method.
+func (m *MockITRUCodeRepository) GetByID(ctx context.Context, id string) (*tru_entity.TRUCode, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByID", ctx, id)
+	ret0, _ := ret[0].(*tru_entity.TRUCode)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByID indicates an expected call of GetByID.
+func (mr *MockITRUCodeRepositoryMockRecorder) GetByID(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByID", reflect.TypeOf((*MockITRUCodeRepository)(nil).GetByID), ctx, id)
+}
+
+// GetOfficialUpdatedBefore mocks base method.
+func (m *MockITRUCodeRepository) GetOfficialUpdatedBefore(ctx context.Context, before time.Time) ([]tru_entity.TRUCode, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetOfficialUpdatedBefore", ctx, before)
+	ret0, _ := ret[0].([]tru_entity.TRUCode)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetOfficialUpdatedBefore indicates an expected call of GetOfficialUpdatedBefore.
+func (mr *MockITRUCodeRepositoryMockRecorder) GetOfficialUpdatedBefore(ctx, before interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOfficialUpdatedBefore", reflect.TypeOf((*MockITRUCodeRepository)(nil).GetOfficialUpdatedBefore), ctx, before)
+}
+
+// Update mocks base method.
+func (m *MockITRUCodeRepository) Update(ctx context.Context, code *tru_entity.TRUCode) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", ctx, code)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockITRUCodeRepositoryMockRecorder) Update(ctx, code interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockITRUCodeRepository)(nil).Update), ctx, code)
+}
+
+// MockITRUPriceRepository is a mock of ITRUPriceRepository interface.
+type MockITRUPriceRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockITRUPriceRepositoryMockRecorder
+}
+
+// MockITRUPriceRepositoryMockRecorder is the mock recorder for MockITRUPriceRepository.
+type MockITRUPriceRepositoryMockRecorder struct {
+	mock *MockITRUPriceRepository
+}
+
+// NewMockITRUPriceRepository creates a new mock instance.
+func NewMockITRUPriceRepository(ctrl *gomock.Controller) *MockITRUPriceRepository {
+	mock := &MockITRUPriceRepository{ctrl: ctrl}
+	mock.recorder = &MockITRUPriceRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockITRUPriceRepository) EXPECT() *MockITRUPriceRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Delete mocks base method.
+func (m *MockITRUPriceRepository) Delete(ctx context.Context, truCodeID, regionID string) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, truCodeID, regionID)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockITRUPriceRepositoryMockRecorder) Delete(ctx, truCodeID, regionID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockITRUPriceRepository)(nil).Delete), ctx, truCodeID, regionID)
+}
+
+// DeleteExceptRegions mocks base method.
+func (m *MockITRUPriceRepository) DeleteExceptRegions(ctx context.Context, truCodeID string, regionIDs []string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteExceptRegions", ctx, truCodeID, regionIDs)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteExceptRegions indicates an expected call of DeleteExceptRegions.
+func (mr *MockITRUPriceRepositoryMockRecorder) DeleteExceptRegions(ctx, truCodeID, regionIDs interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteExceptRegions", reflect.TypeOf((*MockITRUPriceRepository)(nil).DeleteExceptRegions), ctx, truCodeID, regionIDs)
+}
+
+// MoveMissing mocks base method.
+func (m *MockITRUPriceRepository) MoveMissing(ctx context.Context, fromCodeID, toCodeID string) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MoveMissing", ctx, fromCodeID, toCodeID)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MoveMissing indicates an expected call of MoveMissing.
+func (mr *MockITRUPriceRepositoryMockRecorder) MoveMissing(ctx, fromCodeID, toCodeID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MoveMissing", reflect.TypeOf((*MockITRUPriceRepository)(nil).MoveMissing), ctx, fromCodeID, toCodeID)
+}
+
+// Upsert mocks base method.
+func (m *MockITRUPriceRepository) Upsert(ctx context.Context, price *tru_entity.TRUCodePrice) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Upsert", ctx, price)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Upsert indicates an expected call of Upsert.
+func (mr *MockITRUPriceRepositoryMockRecorder) Upsert(ctx, price interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Upsert", reflect.TypeOf((*MockITRUPriceRepository)(nil).Upsert), ctx, price)
+}
+
+// MockIProductTRURepository is a mock of IProductTRURepository interface.
+type MockIProductTRURepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockIProductTRURepositoryMockRecorder
+}
+
+// MockIProductTRURepositoryMockRecorder is the mock recorder for MockIProductTRURepository.
+type MockIProductTRURepositoryMockRecorder struct {
+	mock *MockIProductTRURepository
+}
+
+// NewMockIProductTRURepository creates a new mock instance.
+func NewMockIProductTRURepository(ctrl *gomock.Controller) *MockIProductTRURepository {
+	mock := &MockIProductTRURepository{ctrl: ctrl}
+	mock.recorder = &MockIProductTRURepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockIProductTRURepository) EXPECT() *MockIProductTRURepositoryMockRecorder {
+	return m.recorder
+}
+
+// GetCodesByProductID mocks base method.
+func (m *MockIProductTRURepository) GetCodesByProductID(ctx context.Context, productID string) ([]tru_entity.TRUCode, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCodesByProductID", ctx, productID)
+	ret0, _ := ret[0].([]tru_entity.TRUCode)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCodesByProductID indicates an expected call of GetCodesByProductID.
+func (mr *MockIProductTRURepositoryMockRecorder) GetCodesByProductID(ctx, productID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCodesByProductID", reflect.TypeOf((*MockIProductTRURepository)(nil).GetCodesByProductID), ctx, productID)
+}
+
+// GetEligibleProductIDs mocks base method.
+func (m *MockIProductTRURepository) GetEligibleProductIDs(ctx context.Context, regionID string) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetEligibleProductIDs", ctx, regionID)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetEligibleProductIDs indicates an expected call of GetEligibleProductIDs.
+func (mr *MockIProductTRURepositoryMockRecorder) GetEligibleProductIDs(ctx, regionID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetEligibleProductIDs", reflect.TypeOf((*MockIProductTRURepository)(nil).GetEligibleProductIDs), ctx, regionID)
+}
+
+// GetProductIDsByCodeIDs mocks base method.
+func (m *MockIProductTRURepository) GetProductIDsByCodeIDs(ctx context.Context, truCodeIDs []string) (map[string][]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetProductIDsByCodeIDs", ctx, truCodeIDs)
+	ret0, _ := ret[0].(map[string][]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetProductIDsByCodeIDs indicates an expected call of GetProductIDsByCodeIDs.
+func (mr *MockIProductTRURepositoryMockRecorder) GetProductIDsByCodeIDs(ctx, truCodeIDs interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetProductIDsByCodeIDs", reflect.TypeOf((*MockIProductTRURepository)(nil).GetProductIDsByCodeIDs), ctx, truCodeIDs)
+}
+
+// GetReimbursements mocks base method.
+func (m *MockIProductTRURepository) GetReimbursements(ctx context.Context, productIDs []string, regionID string) ([]tru_entity.ProductReimbursement, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetReimbursements", ctx, productIDs, regionID)
+	ret0, _ := ret[0].([]tru_entity.ProductReimbursement)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetReimbursements indicates an expected call of GetReimbursements.
+func (mr *MockIProductTRURepositoryMockRecorder) GetReimbursements(ctx, productIDs, regionID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetReimbursements", reflect.TypeOf((*MockIProductTRURepository)(nil).GetReimbursements), ctx, productIDs, regionID)
+}
+
+// MoveCodeLinks mocks base method.
+func (m *MockIProductTRURepository) MoveCodeLinks(ctx context.Context, fromCodeID, toCodeID string) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MoveCodeLinks", ctx, fromCodeID, toCodeID)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MoveCodeLinks indicates an expected call of MoveCodeLinks.
+func (mr *MockIProductTRURepositoryMockRecorder) MoveCodeLinks(ctx, fromCodeID, toCodeID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MoveCodeLinks", reflect.TypeOf((*MockIProductTRURepository)(nil).MoveCodeLinks), ctx, fromCodeID, toCodeID)
+}
+
+// SetProductCodes mocks base method.
+func (m *MockIProductTRURepository) SetProductCodes(ctx context.Context, productID string, truCodeIDs []string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetProductCodes", ctx, productID, truCodeIDs)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetProductCodes indicates an expected call of SetProductCodes.
+func (mr *MockIProductTRURepositoryMockRecorder) SetProductCodes(ctx, productID, truCodeIDs interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetProductCodes", reflect.TypeOf((*MockIProductTRURepository)(nil).SetProductCodes), ctx, productID, truCodeIDs)
+}
+
+// MockIPriceListReader is a mock of IPriceListReader interface.
+type MockIPriceListReader struct {
+	ctrl     *gomock.Controller
+	recorder *MockIPriceListReaderMockRecorder
+}
+
+// MockIPriceListReaderMockRecorder is the mock recorder for MockIPriceListReader.
+type MockIPriceListReaderMockRecorder struct {
+	mock *MockIPriceListReader
+}
+
+// NewMockIPriceListReader creates a new mock instance.
+func NewMockIPriceListReader(ctrl *gomock.Controller) *MockIPriceListReader {
+	mock := &MockIPriceListReader{ctrl: ctrl}
+	mock.recorder = &MockIPriceListReaderMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockIPriceListReader) EXPECT() *MockIPriceListReaderMockRecorder {
+	return m.recorder
+}
+
+// ReadPriceRows mocks base method.
+func (m *MockIPriceListReader) ReadPriceRows(file io.Reader) ([]tru_entity.PriceImportRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReadPriceRows", file)
+	ret0, _ := ret[0].([]tru_entity.PriceImportRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReadPriceRows indicates an expected call of ReadPriceRows.
+func (mr *MockIPriceListReaderMockRecorder) ReadPriceRows(file interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadPriceRows", reflect.TypeOf((*MockIPriceListReader)(nil).ReadPriceRows), file)
+}
+
+// MockIRegionUsecaseAdapter is a mock of IRegionUsecaseAdapter interface.
+type MockIRegionUsecaseAdapter struct {
+	ctrl     *gomock.Controller
+	recorder *MockIRegionUsecaseAdapterMockRecorder
+}
+
+// MockIRegionUsecaseAdapterMockRecorder is the mock recorder for MockIRegionUsecaseAdapter.
+type MockIRegionUsecaseAdapterMockRecorder struct {
+	mock *MockIRegionUsecaseAdapter
+}
+
+// NewMockIRegionUsecaseAdapter creates a new mock instance.
+func NewMockIRegionUsecaseAdapter(ctrl *gomock.Controller) *MockIRegionUsecaseAdapter {
+	mock := &MockIRegionUsecaseAdapter{ctrl: ctrl}
+	mock.recorder = &MockIRegionUsecaseAdapterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockIRegionUsecaseAdapter) EXPECT() *MockIRegionUsecaseAdapterMockRecorder {
+	return m.recorder
+}
+
+// CheckExists mocks base method.
+func (m *MockIRegionUsecaseAdapter) CheckExists(ctx context.Context, regionID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CheckExists", ctx, regionID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CheckExists indicates an expected call of CheckExists.
+func (mr *MockIRegionUsecaseAdapterMockRecorder) CheckExists(ctx, regionID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CheckExists", reflect.TypeOf((*MockIRegionUsecaseAdapter)(nil).CheckExists), ctx, regionID)
+}
+
+// MockIProductUsecaseAdapter is a mock of IProductUsecaseAdapter interface.
+type MockIProductUsecaseAdapter struct {
+	ctrl     *gomock.Controller
+	recorder *MockIProductUsecaseAdapterMockRecorder
+}
+
+// MockIProductUsecaseAdapterMockRecorder is the mock recorder for MockIProductUsecaseAdapter.
+type MockIProductUsecaseAdapterMockRecorder struct {
+	mock *MockIProductUsecaseAdapter
+}
+
+// NewMockIProductUsecaseAdapter creates a new mock instance.
+func NewMockIProductUsecaseAdapter(ctrl *gomock.Controller) *MockIProductUsecaseAdapter {
+	mock := &MockIProductUsecaseAdapter{ctrl: ctrl}
+	mock.recorder = &MockIProductUsecaseAdapterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockIProductUsecaseAdapter) EXPECT() *MockIProductUsecaseAdapterMockRecorder {
+	return m.recorder
+}
+
+// Exists mocks base method.
+func (m *MockIProductUsecaseAdapter) Exists(ctx context.Context, productID string) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Exists", ctx, productID)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Exists indicates an expected call of Exists.
+func (mr *MockIProductUsecaseAdapterMockRecorder) Exists(ctx, productID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Exists", reflect.TypeOf((*MockIProductUsecaseAdapter)(nil).Exists), ctx, productID)
+}
+
+// MockISuggestionUsecaseAdapter is a mock of ISuggestionUsecaseAdapter interface.
+type MockISuggestionUsecaseAdapter struct {
+	ctrl     *gomock.Controller
+	recorder *MockISuggestionUsecaseAdapterMockRecorder
+}
+
+// MockISuggestionUsecaseAdapterMockRecorder is the mock recorder for MockISuggestionUsecaseAdapter.
+type MockISuggestionUsecaseAdapterMockRecorder struct {
+	mock *MockISuggestionUsecaseAdapter
+}
+
+// NewMockISuggestionUsecaseAdapter creates a new mock instance.
+func NewMockISuggestionUsecaseAdapter(ctrl *gomock.Controller) *MockISuggestionUsecaseAdapter {
+	mock := &MockISuggestionUsecaseAdapter{ctrl: ctrl}
+	mock.recorder = &MockISuggestionUsecaseAdapterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockISuggestionUsecaseAdapter) EXPECT() *MockISuggestionUsecaseAdapterMockRecorder {
+	return m.recorder
+}
+
+// MoveCode mocks base method.
+func (m *MockISuggestionUsecaseAdapter) MoveCode(ctx context.Context, fromCodeID string, to *tru_entity.TRUCode) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MoveCode", ctx, fromCodeID, to)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// MoveCode indicates an expected call of MoveCode.
+func (mr *MockISuggestionUsecaseAdapterMockRecorder) MoveCode(ctx, fromCodeID, to interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MoveCode", reflect.TypeOf((*MockISuggestionUsecaseAdapter)(nil).MoveCode), ctx, fromCodeID, to)
+}
@@ -0,0 +1,239 @@
+package tru_testcases
+
+import (
+	"context"
+
+	tru_entity "github.com/Fi44er/sdmed/internal/module/tru/entity"
+	tru_constant "github.com/Fi44er/sdmed/internal/module/tru/pkg"
+	"github.com/Fi44er/sdmed/internal/module/tru/usecase/tru/mock"
+	uow_mock "github.com/Fi44er/sdmed/pkg/postgres/uow/mock"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+type MockCustom struct {
+	Ctrl               *gomock.Controller
+	Ctx                context.Context
+	CodeRepoMock       *mock.MockITRUCodeRepository
+	PriceRepoMock      *mock.MockITRUPriceRepository
+	ProductTRURepoMock *mock.MockIProductTRURepository
+	SuggestionMock     *mock.MockISuggestionUsecaseAdapter
+	UowMock            *uow_mock.MockUow
+	T                  assert.TestingT
+}
+
+type CustomTestCase struct {
+	Name            string
+	InputCode       *tru_entity.TRUCode
+	InputID         string
+	InputOfficialID string
+	SetupMocks      func(m *MockCustom)
+	ExpectedResult  *tru_entity.MergeResult
+	ExpectedError   error
+}
+
+func setupCustomTransaction(m *MockCustom, times int) {
+	m.UowMock.EXPECT().Do(m.Ctx, gomock.Any()).DoAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+		return fn(ctx)
+	}).Times(times)
+	m.UowMock.EXPECT().GetRepository(m.Ctx, "tru_code").Return(m.CodeRepoMock, nil)
+}
+
+func customCode(status tru_entity.TRUCodeStatus) *tru_entity.TRUCode {
+	return &tru_entity.TRUCode{ID: "custom-1", Code: "custom-001", Name: "Трость опорная", IsCustom: true, Status: status}
+}
+
+func officialCode() *tru_entity.TRUCode {
+	return &tru_entity.TRUCode{ID: "official-1", Code: "32.50.22.121-00000001", Name: "Трость", Status: tru_entity.TRUCodeStatusApproved}
+}
+
+func GetCreateCustomTestCases() []CustomTestCase {
+	return []CustomTestCase{
+		{
+			Name: "created_pending_with_prices",
+			InputCode: &tru_entity.TRUCode{
+				Code:   "custom-001",
+				Name:   "Трость опорная",
+				Prices: []tru_entity.TRUCodePrice{{RegionID: "region-1", Price: 1500}},
+			},
+			SetupMocks: func(m *MockCustom) {
+				setupCustomTransaction(m, 2)
+				m.CodeRepoMock.EXPECT().GetByCode(m.Ctx, "custom-001").Return(nil, nil)
+				m.CodeRepoMock.EXPECT().
+					Create(m.Ctx, gomock.Any()).
+					DoAndReturn(func(ctx context.Context, code *tru_entity.TRUCode) error {
+						assert.True(m.T, code.IsCustom)
+						assert.Equal(m.T, tru_entity.TRUCodeStatusPending, code.Status)
+						code.ID = "custom-1"
+						return nil
+					})
+				m.UowMock.EXPECT().GetRepository(m.Ctx, "tru_price").Return(m.PriceRepoMock, nil)
+				m.PriceRepoMock.EXPECT().
+					Upsert(m.Ctx, gomock.Any()).
+					DoAndReturn(func(ctx context.Context, price *tru_entity.TRUCodePrice) error {
+						assert.Equal(m.T, "custom-1", price.TRUCodeID)
+						return nil
+					})
+			},
+		},
+		{
+			Name:      "code_already_exists",
+			InputCode: &tru_entity.TRUCode{Code: "custom-001", Name: "Трость опорная"},
+			SetupMocks: func(m *MockCustom) {
+				setupCustomTransaction(m, 2)
+				m.CodeRepoMock.EXPECT().GetByCode(m.Ctx, "custom-001").Return(customCode(tru_entity.TRUCodeStatusPending), nil)
+			},
+			ExpectedError: tru_constant.ErrTRUCodeAlreadyExists,
+		},
+	}
+}
+
+func GetApproveTestCases() []CustomTestCase {
+	return []CustomTestCase{
+		{
+			Name:    "pending_custom_code_approved",
+			InputID: "custom-1",
+			SetupMocks: func(m *MockCustom) {
+				setupCustomTransaction(m, 1)
+				m.CodeRepoMock.EXPECT().GetByID(m.Ctx, "custom-1").Return(customCode(tru_entity.TRUCodeStatusPending), nil)
+				m.CodeRepoMock.EXPECT().
+					Update(m.Ctx, gomock.Any()).
+					DoAndReturn(func(ctx context.Context, code *tru_entity.TRUCode) error {
+						assert.Equal(m.T, tru_entity.TRUCodeStatusApproved, code.Status)
+						return nil
+					})
+			},
+		},
+		{
+			Name:    "official_code_rejected",
+			InputID: "official-1",
+			SetupMocks: func(m *MockCustom) {
+				setupCustomTransaction(m, 1)
+				m.CodeRepoMock.EXPECT().GetByID(m.Ctx, "official-1").Return(officialCode(), nil)
+			},
+			ExpectedError: tru_constant.ErrTRUCodeNotCustom,
+		},
+		{
+			Name:    "already_approved",
+			InputID: "custom-1",
+			SetupMocks: func(m *MockCustom) {
+				setupCustomTransaction(m, 1)
+				m.CodeRepoMock.EXPECT().GetByID(m.Ctx, "custom-1").Return(customCode(tru_entity.TRUCodeStatusApproved), nil)
+			},
+			ExpectedError: tru_constant.ErrTRUCodeAlreadyApproved,
+		},
+		{
+			Name:    "code_not_found",
+			InputID: "custom-1",
+			SetupMocks: func(m *MockCustom) {
+				setupCustomTransaction(m, 1)
+				m.CodeRepoMock.EXPECT().GetByID(m.Ctx, "custom-1").Return(nil, nil)
+			},
+			ExpectedError: tru_constant.ErrTRUCodeNotFound,
+		},
+	}
+}
+
+func GetMergeTestCases() []CustomTestCase {
+	return []CustomTestCase{
+		{
+			Name:            "links_prices_and_suggestions_moved",
+			InputID:         "custom-1",
+			InputOfficialID: "official-1",
+			SetupMocks: func(m *MockCustom) {
+				setupCustomTransaction(m, 1)
+				m.UowMock.EXPECT().GetRepository(m.Ctx, "product_tru").Return(m.ProductTRURepoMock, nil)
+				m.UowMock.EXPECT().GetRepository(m.Ctx, "tru_price").Return(m.PriceRepoMock, nil)
+
+				official := officialCode()
+				gomock.InOrder(
+					m.CodeRepoMock.EXPECT().GetByID(m.Ctx, "custom-1").Return(customCode(tru_entity.TRUCodeStatusApproved), nil),
+					m.CodeRepoMock.EXPECT().GetByID(m.Ctx, "official-1").Return(official, nil),
+					m.ProductTRURepoMock.EXPECT().MoveCodeLinks(m.Ctx, "custom-1", "official-1").Return(int64(2), nil),
+					m.PriceRepoMock.EXPECT().MoveMissing(m.Ctx, "custom-1", "official-1").Return(int64(1), nil),
+					m.SuggestionMock.EXPECT().MoveCode(m.Ctx, "custom-1", official).Return(nil),
+					m.CodeRepoMock.EXPECT().Delete(m.Ctx, "custom-1").Return(nil),
+					m.CodeRepoMock.EXPECT().GetByID(m.Ctx, "official-1").Return(official, nil),
+				)
+			},
+			ExpectedResult: &tru_entity.MergeResult{Target: officialCode(), MovedProducts: 2, MovedPrices: 1},
+		},
+		{
+			Name:            "target_is_custom",
+			InputID:         "custom-1",
+			InputOfficialID: "custom-2",
+			SetupMocks: func(m *MockCustom) {
+				setupCustomTransaction(m, 1)
+				m.CodeRepoMock.EXPECT().GetByID(m.Ctx, "custom-1").Return(customCode(tru_entity.TRUCodeStatusPending), nil)
+				m.CodeRepoMock.EXPECT().GetByID(m.Ctx, "custom-2").Return(&tru_entity.TRUCode{ID: "custom-2", IsCustom: true}, nil)
+			},
+			ExpectedError: tru_constant.ErrMergeTargetNotOfficial,
+		},
+		{
+			Name:            "source_is_official",
+			InputID:         "official-1",
+			InputOfficialID: "official-2",
+			SetupMocks: func(m *MockCustom) {
+				setupCustomTransaction(m, 1)
+				m.CodeRepoMock.EXPECT().GetByID(m.Ctx, "official-1").Return(officialCode(), nil)
+			},
+			ExpectedError: tru_constant.ErrTRUCodeNotCustom,
+		},
+		{
+			Name:            "target_not_found",
+			InputID:         "custom-1",
+			InputOfficialID: "official-1",
+			SetupMocks: func(m *MockCustom) {
+				setupCustomTransaction(m, 1)
+				m.CodeRepoMock.EXPECT().GetByID(m.Ctx, "custom-1").Return(customCode(tru_entity.TRUCodeStatusPending), nil)
+				m.CodeRepoMock.EXPECT().GetByID(m.Ctx, "official-1").Return(nil, nil)
+			},
+			ExpectedError: tru_constant.ErrTRUCodeNotFound,
+		},
+	}
+}
+
+func GetUpdateTestCases() []CustomTestCase {
+	return []CustomTestCase{
+		{
+			Name:      "pending_custom_code_stays_pending",
+			InputCode: &tru_entity.TRUCode{ID: "custom-1", Code: "custom-001", Name: "Трость опорная с УПС"},
+			SetupMocks: func(m *MockCustom) {
+				setupCustomTransaction(m, 1)
+				m.CodeRepoMock.EXPECT().GetByID(m.Ctx, "custom-1").Return(customCode(tru_entity.TRUCodeStatusPending), nil)
+				m.CodeRepoMock.EXPECT().
+					Update(m.Ctx, gomock.Any()).
+					DoAndReturn(func(ctx context.Context, code *tru_entity.TRUCode) error {
+						assert.True(m.T, code.IsCustom)
+						assert.Equal(m.T, tru_entity.TRUCodeStatusPending, code.Status)
+						return nil
+					})
+			},
+		},
+		{
+			Name:      "official_code_stays_official",
+			InputCode: &tru_entity.TRUCode{ID: "official-1", Code: "32.50.22.121-00000001", Name: "Трость"},
+			SetupMocks: func(m *MockCustom) {
+				setupCustomTransaction(m, 1)
+				m.CodeRepoMock.EXPECT().GetByID(m.Ctx, "official-1").Return(officialCode(), nil)
+				m.CodeRepoMock.EXPECT().
+					Update(m.Ctx, gomock.Any()).
+					DoAndReturn(func(ctx context.Context, code *tru_entity.TRUCode) error {
+						assert.False(m.T, code.IsCustom)
+						assert.Equal(m.T, tru_entity.TRUCodeStatusApproved, code.Status)
+						return nil
+					})
+			},
+		},
+		{
+			Name:      "new_code_already_exists",
+			InputCode: &tru_entity.TRUCode{ID: "custom-1", Code: "32.50.22.121-00000001"},
+			SetupMocks: func(m *MockCustom) {
+				setupCustomTransaction(m, 1)
+				m.CodeRepoMock.EXPECT().GetByID(m.Ctx, "custom-1").Return(customCode(tru_entity.TRUCodeStatusPending), nil)
+				m.CodeRepoMock.EXPECT().GetByCode(m.Ctx, "32.50.22.121-00000001").Return(officialCode(), nil)
+			},
+			ExpectedError: tru_constant.ErrTRUCodeAlreadyExists,
+		},
+	}
+}
@@ -0,0 +1,100 @@
+package tru_testcases
+
+import (
+	"context"
+
+	tru_entity "github.com/Fi44er/sdmed/internal/module/tru/entity"
+	tru_constant "github.com/Fi44er/sdmed/internal/module/tru/pkg"
+	"github.com/Fi44er/sdmed/internal/module/tru/usecase/tru/mock"
+	uow_mock "github.com/Fi44er/sdmed/pkg/postgres/uow/mock"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+type MockSaveParsedCode struct {
+	Ctrl          *gomock.Controller
+	Ctx           context.Context
+	CodeRepoMock  *mock.MockITRUCodeRepository
+	PriceRepoMock *mock.MockITRUPriceRepository
+	UowMock       *uow_mock.MockUow
+	T             assert.TestingT
+}
+
+type SaveParsedCodeTestCase struct {
+	Name          string
+	InputCode     *tru_entity.TRUCode
+	SetupMocks    func(m *MockSaveParsedCode)
+	ExpectedError error
+}
+
+const parsedCode = "32.50.22.121-00000001"
+
+func setupSaveParsedCode(m *MockSaveParsedCode, existCode *tru_entity.TRUCode) {
+	m.UowMock.EXPECT().Do(m.Ctx, gomock.Any()).DoAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+		return fn(ctx)
+	})
+	m.UowMock.EXPECT().GetRepository(m.Ctx, "tru_code").Return(m.CodeRepoMock, nil)
+	m.CodeRepoMock.EXPECT().GetByCode(m.Ctx, parsedCode).Return(existCode, nil)
+}
+
+func setupParsedPrices(m *MockSaveParsedCode, codeID string) {
+	m.UowMock.EXPECT().GetRepository(m.Ctx, "tru_price").Return(m.PriceRepoMock, nil)
+	m.PriceRepoMock.EXPECT().
+		Upsert(m.Ctx, gomock.Any()).
+		DoAndReturn(func(ctx context.Context, price *tru_entity.TRUCodePrice) error {
+			assert.Equal(m.T, codeID, price.TRUCodeID)
+			return nil
+		})
+	m.PriceRepoMock.EXPECT().DeleteExceptRegions(m.Ctx, codeID, []string{"region-1"}).Return(nil)
+}
+
+func parsedTRUCode() *tru_entity.TRUCode {
+	return &tru_entity.TRUCode{
+		Code:   parsedCode,
+		Name:   "Кресло-коляска",
+		Prices: []tru_entity.TRUCodePrice{{RegionID: "region-1", Price: 21500}},
+	}
+}
+
+func GetSaveParsedCodeTestCases() []SaveParsedCodeTestCase {
+	return []SaveParsedCodeTestCase{
+		{
+			Name:      "new_code_created_as_official",
+			InputCode: parsedTRUCode(),
+			SetupMocks: func(m *MockSaveParsedCode) {
+				setupSaveParsedCode(m, nil)
+				m.CodeRepoMock.EXPECT().
+					Create(m.Ctx, gomock.Any()).
+					DoAndReturn(func(ctx context.Context, code *tru_entity.TRUCode) error {
+						assert.False(m.T, code.IsCustom)
+						assert.Equal(m.T, tru_entity.TRUCodeStatusApproved, code.Status)
+						code.ID = "code-1"
+						return nil
+					})
+				setupParsedPrices(m, "code-1")
+			},
+		},
+		{
+			Name:      "existing_official_code_updated",
+			InputCode: parsedTRUCode(),
+			SetupMocks: func(m *MockSaveParsedCode) {
+				setupSaveParsedCode(m, &tru_entity.TRUCode{ID: "code-1", Code: parsedCode, Status: tru_entity.TRUCodeStatusApproved})
+				m.CodeRepoMock.EXPECT().
+					Update(m.Ctx, gomock.Any()).
+					DoAndReturn(func(ctx context.Context, code *tru_entity.TRUCode) error {
+						assert.Equal(m.T, "code-1", code.ID)
+						return nil
+					})
+				setupParsedPrices(m, "code-1")
+			},
+		},
+		{
+			Name:      "custom_code_not_overwritten",
+			InputCode: parsedTRUCode(),
+			SetupMocks: func(m *MockSaveParsedCode) {
+				setupSaveParsedCode(m, &tru_entity.TRUCode{ID: "custom-1", Code: parsedCode, IsCustom: true, Status: tru_entity.TRUCodeStatusPending})
+			},
+			ExpectedError: tru_constant.ErrParsedCodeIsCustom,
+		},
+	}
+}
@@ -0,0 +1,75 @@
+package tru_testcases
+
+import (
+	"context"
+
+	tru_entity "github.com/Fi44er/sdmed/internal/module/tru/entity"
+	tru_constant "github.com/Fi44er/sdmed/internal/module/tru/pkg"
+	"github.com/Fi44er/sdmed/internal/module/tru/usecase/tru/mock"
+	uow_mock "github.com/Fi44er/sdmed/pkg/postgres/uow/mock"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+type MockSetProductCodes struct {
+	Ctrl               *gomock.Controller
+	Ctx                context.Context
+	CodeRepoMock       *mock.MockITRUCodeRepository
+	ProductTRURepoMock *mock.MockIProductTRURepository
+	ProductMock        *mock.MockIProductUsecaseAdapter
+	UowMock            *uow_mock.MockUow
+	T                  assert.TestingT
+}
+
+type SetProductCodesTestCase struct {
+	Name           string
+	InputProductID string
+	InputCodeIDs   []string
+	SetupMocks     func(m *MockSetProductCodes)
+	ExpectedError  error
+}
+
+func GetSetProductCodesTestCases() []SetProductCodesTestCase {
+	return []SetProductCodesTestCase{
+		{
+			Name:           "successful_link_without_duplicates",
+			InputProductID: "product-1",
+			InputCodeIDs:   []string{"code-1", "code-2", "code-1"},
+			SetupMocks: func(m *MockSetProductCodes) {
+				m.ProductMock.EXPECT().Exists(m.Ctx, "product-1").Return(true, nil)
+				m.UowMock.EXPECT().Do(m.Ctx, gomock.Any()).DoAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+					return fn(ctx)
+				})
+				m.UowMock.EXPECT().GetRepository(m.Ctx, "tru_code").Return(m.CodeRepoMock, nil)
+				m.UowMock.EXPECT().GetRepository(m.Ctx, "product_tru").Return(m.ProductTRURepoMock, nil)
+
+				m.CodeRepoMock.EXPECT().GetByID(m.Ctx, "code-1").Return(&tru_entity.TRUCode{ID: "code-1"}, nil)
+				m.CodeRepoMock.EXPECT().GetByID(m.Ctx, "code-2").Return(&tru_entity.TRUCode{ID: "code-2"}, nil)
+				m.ProductTRURepoMock.EXPECT().SetProductCodes(m.Ctx, "product-1", []string{"code-1", "code-2"}).Return(nil)
+			},
+		},
+		{
+			Name:           "product_not_found",
+			InputProductID: "missing",
+			InputCodeIDs:   []string{"code-1"},
+			SetupMocks: func(m *MockSetProductCodes) {
+				m.ProductMock.EXPECT().Exists(m.Ctx, "missing").Return(false, nil)
+			},
+			ExpectedError: tru_constant.ErrProductNotFound,
+		},
+		{
+			Name:           "tru_code_not_found",
+			InputProductID: "product-1",
+			InputCodeIDs:   []string{"code-1"},
+			SetupMocks: func(m *MockSetProductCodes) {
+				m.ProductMock.EXPECT().Exists(m.Ctx, "product-1").Return(true, nil)
+				m.UowMock.EXPECT().Do(m.Ctx, gomock.Any()).DoAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+					return fn(ctx)
+				})
+				m.UowMock.EXPECT().GetRepository(m.Ctx, "tru_code").Return(m.CodeRepoMock, nil)
+				m.CodeRepoMock.EXPECT().GetByID(m.Ctx, "code-1").Return(nil, nil)
+			},
+			ExpectedError: tru_constant.ErrTRUCodeNotFound,
+		},
+	}
+}
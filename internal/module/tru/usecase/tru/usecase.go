@@ -0,0 +1,424 @@
+package tru_usecase
+
+import (
+	"context"
+	"time"
+
+	tru_entity "github.com/Fi44er/sdmed/internal/module/tru/entity"
+	tru_constant "github.com/Fi44er/sdmed/internal/module/tru/pkg"
+	tru_usecase_contracts "github.com/Fi44er/sdmed/internal/module/tru/usecase/tru/contracts"
+	"github.com/Fi44er/sdmed/pkg/logger"
+	"github.com/Fi44er/sdmed/pkg/postgres/uow"
+	"github.com/Fi44er/sdmed/pkg/utils"
+)
+
+type ITRUUsecase interface {
+	Create(ctx context.Context, code *tru_entity.TRUCode) error
+	GetByID(ctx context.Context, id string) (*tru_entity.TRUCode, error)
+	GetByCode(ctx context.Context, code string) (*tru_entity.TRUCode, error)
+	GetAll(ctx context.Context, filter *tru_entity.TRUCodeFilter, page, pageSize int) ([]tru_entity.TRUCode, int64, error)
+	Update(ctx context.Context, code *tru_entity.TRUCode) error
+	Delete(ctx context.Context, id string) error
+	SaveParsedCode(ctx context.Context, code *tru_entity.TRUCode) error
+	GetStaleOfficialCodes(ctx context.Context, since time.Time) ([]tru_entity.TRUCode, error)
+
+	CreateCustom(ctx context.Context, code *tru_entity.TRUCode) error
+	Approve(ctx context.Context, id string) error
+	Merge(ctx context.Context, customID, officialID string) (*tru_entity.MergeResult, error)
+	ImportPrices(ctx context.Context, priceImport *tru_entity.PriceImport) (*tru_entity.PriceImportReport, error)
+
+	SetPrice(ctx context.Context, price *tru_entity.TRUCodePrice) error
+	DeletePrice(ctx context.Context, truCodeID, regionID string) error
+
+	SetProductCodes(ctx context.Context, productID string, truCodeIDs []string) error
+	GetProductCodes(ctx context.Context, productID string) ([]tru_entity.TRUCode, error)
+	GetProductReimbursement(ctx context.Context, productID, regionID string) (*tru_entity.ProductReimbursement, error)
+	GetReimbursements(ctx context.Context, productIDs []string, regionID string) (map[string]tru_entity.ProductReimbursement, error)
+	GetEligibleProductIDs(ctx context.Context, regionID string) ([]string, error)
+	GetProductIDsByCodes(ctx context.Context, truCodeIDs []string) (map[string][]string, error)
+}
+
+type TRUUsecase struct {
+	codeRepository       tru_usecase_contracts.ITRUCodeRepository
+	productTRURepository tru_usecase_contracts.IProductTRURepository
+	priceListReader      tru_usecase_contracts.IPriceListReader
+	regionUsecase        tru_usecase_contracts.IRegionUsecaseAdapter
+	productUsecase       tru_usecase_contracts.IProductUsecaseAdapter
+	suggestionUsecase    tru_usecase_contracts.ISuggestionUsecaseAdapter
+	uow                  uow.Uow
+	logger               *logger.Logger
+}
+
+func NewTRUUsecase(
+	codeRepository tru_usecase_contracts.ITRUCodeRepository,
+	productTRURepository tru_usecase_contracts.IProductTRURepository,
+	priceListReader tru_usecase_contracts.IPriceListReader,
+	regionUsecase tru_usecase_contracts.IRegionUsecaseAdapter,
+	uow uow.Uow,
+	logger *logger.Logger,
+) *TRUUsecase {
+	return &TRUUsecase{
+		codeRepository:       codeRepository,
+		productTRURepository: productTRURepository,
+		priceListReader:      priceListReader,
+		regionUsecase:        regionUsecase,
+		uow:                  uow,
+		logger:               logger,
+	}
+}
+
+// SetProductUsecase подключает проверку товаров. Модуль товаров создается после модуля КТРУ
+func (u *TRUUsecase) SetProductUsecase(productUsecase tru_usecase_contracts.IProductUsecaseAdapter) {
+	u.productUsecase = productUsecase
+}
+
+// SetSuggestionUsecase подключает перенос подсказок подбора при объединении кодов.
+// Модуль подбора создается после модуля КТРУ
+func (u *TRUUsecase) SetSuggestionUsecase(suggestionUsecase tru_usecase_contracts.ISuggestionUsecaseAdapter) {
+	u.suggestionUsecase = suggestionUsecase
+}
+
+func (u *TRUUsecase) Create(ctx context.Context, code *tru_entity.TRUCode) error {
+	u.logger.Infof("Creating tru code: %s", code.Code)
+
+	return u.uow.Do(ctx, func(ctx context.Context) error {
+		codeRepo, err := u.getCodeRepository(ctx)
+		if err != nil {
+			return err
+		}
+
+		existCode, err := codeRepo.GetByCode(ctx, code.Code)
+		if err != nil {
+			return err
+		}
+		if existCode != nil {
+			u.logger.Warnf("TRU code already exists: %s", code.Code)
+			return tru_constant.ErrTRUCodeAlreadyExists
+		}
+
+		code.Status = tru_entity.TRUCodeStatusApproved
+		if code.IsCustom {
+			code.Status = tru_entity.TRUCodeStatusPending
+		}
+
+		return codeRepo.Create(ctx, code)
+	})
+}
+
+func (u *TRUUsecase) GetByID(ctx context.Context, id string) (*tru_entity.TRUCode, error) {
+	u.logger.Debugf("Getting tru code by ID: %s", id)
+
+	code, err := u.codeRepository.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if code == nil {
+		return nil, tru_constant.ErrTRUCodeNotFound
+	}
+
+	return code, nil
+}
+
+func (u *TRUUsecase) GetByCode(ctx context.Context, code string) (*tru_entity.TRUCode, error) {
+	u.logger.Debugf("Getting tru code by code: %s", code)
+
+	truCode, err := u.codeRepository.GetByCode(ctx, code)
+	if err != nil {
+		return nil, err
+	}
+	if truCode == nil {
+		return nil, tru_constant.ErrTRUCodeNotFound
+	}
+
+	return truCode, nil
+}
+
+func (u *TRUUsecase) GetAll(ctx context.Context, filter *tru_entity.TRUCodeFilter, page, pageSize int) ([]tru_entity.TRUCode, int64, error) {
+	u.logger.Debugf("Getting tru codes (page: %d, pageSize: %d)", page, pageSize)
+
+	filter.Offset, filter.Limit = utils.SafeCalculateForPostgres(page, pageSize)
+	codes, err := u.codeRepository.GetAll(ctx, filter)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	count, err := u.codeRepository.Count(ctx, filter)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return codes, count, nil
+}
+
+// Update меняет код и название. Признак собственного кода и статус сохраняются:
+// статус меняют только Approve и Merge.
+func (u *TRUUsecase) Update(ctx context.Context, code *tru_entity.TRUCode) error {
+	u.logger.Infof("Updating tru code: %s", code.ID)
+
+	return u.uow.Do(ctx, func(ctx context.Context) error {
+		codeRepo, err := u.getCodeRepository(ctx)
+		if err != nil {
+			return err
+		}
+
+		existCode, err := codeRepo.GetByID(ctx, code.ID)
+		if err != nil {
+			return err
+		}
+		if existCode == nil {
+			return tru_constant.ErrTRUCodeNotFound
+		}
+
+		if existCode.Code != code.Code {
+			sameCode, err := codeRepo.GetByCode(ctx, code.Code)
+			if err != nil {
+				return err
+			}
+			if sameCode != nil {
+				u.logger.Warnf("TRU code already exists: %s", code.Code)
+				return tru_constant.ErrTRUCodeAlreadyExists
+			}
+		}
+
+		code.IsCustom = existCode.IsCustom
+		code.Status = existCode.Status
+
+		return codeRepo.Update(ctx, code)
+	})
+}
+
+func (u *TRUUsecase) Delete(ctx context.Context, id string) error {
+	u.logger.Infof("Deleting tru code: %s", id)
+
+	return u.uow.Do(ctx, func(ctx context.Context) error {
+		codeRepo, err := u.getCodeRepository(ctx)
+		if err != nil {
+			return err
+		}
+
+		existCode, err := codeRepo.GetByID(ctx, id)
+		if err != nil {
+			return err
+		}
+		if existCode == nil {
+			return tru_constant.ErrTRUCodeNotFound
+		}
+
+		return codeRepo.Delete(ctx, id)
+	})
+}
+
+// SaveParsedCode создает или обновляет официальный код из каталога СФР вместе с региональными ценами.
+// Цены регионов, которых больше нет в каталоге, удаляются.
+// Пользовательский код с тем же номером не перезаписывается: его нужно объединить с официальным через Merge.
+func (u *TRUUsecase) SaveParsedCode(ctx context.Context, code *tru_entity.TRUCode) error {
+	u.logger.Debugf("Saving parsed tru code %s with %d prices", code.Code, len(code.Prices))
+
+	return u.uow.Do(ctx, func(ctx context.Context) error {
+		codeRepo, err := u.getCodeRepository(ctx)
+		if err != nil {
+			return err
+		}
+
+		existCode, err := codeRepo.GetByCode(ctx, code.Code)
+		if err != nil {
+			return err
+		}
+
+		if existCode != nil && existCode.IsCustom {
+			return tru_constant.ErrParsedCodeIsCustom
+		}
+
+		code.IsCustom = false
+		code.Status = tru_entity.TRUCodeStatusApproved
+		if existCode == nil {
+			if err := codeRepo.Create(ctx, code); err != nil {
+				return err
+			}
+		} else {
+			code.ID = existCode.ID
+			if err := codeRepo.Update(ctx, code); err != nil {
+				return err
+			}
+		}
+
+		repo, err := u.uow.GetRepository(ctx, "tru_price")
+		if err != nil {
+			u.logger.Errorf("Failed to get repository: %v", err)
+			return err
+		}
+		priceRepo := repo.(tru_usecase_contracts.ITRUPriceRepository)
+
+		regionIDs := make([]string, len(code.Prices))
+		for i := range code.Prices {
+			code.Prices[i].TRUCodeID = code.ID
+			if err := priceRepo.Upsert(ctx, &code.Prices[i]); err != nil {
+				return err
+			}
+			regionIDs[i] = code.Prices[i].RegionID
+		}
+
+		return priceRepo.DeleteExceptRegions(ctx, code.ID, regionIDs)
+	})
+}
+
+// GetStaleOfficialCodes возвращает официальные коды, которые не встречались в каталоге с момента since
+func (u *TRUUsecase) GetStaleOfficialCodes(ctx context.Context, since time.Time) ([]tru_entity.TRUCode, error) {
+	u.logger.Debugf("Getting official tru codes not updated since %v", since)
+	return u.codeRepository.GetOfficialUpdatedBefore(ctx, since)
+}
+
+func (u *TRUUsecase) SetPrice(ctx context.Context, price *tru_entity.TRUCodePrice) error {
+	u.logger.Infof("Setting price for tru code %s in region %s", price.TRUCodeID, price.RegionID)
+
+	return u.uow.Do(ctx, func(ctx context.Context) error {
+		codeRepo, err := u.getCodeRepository(ctx)
+		if err != nil {
+			return err
+		}
+
+		existCode, err := codeRepo.GetByID(ctx, price.TRUCodeID)
+		if err != nil {
+			return err
+		}
+		if existCode == nil {
+			return tru_constant.ErrTRUCodeNotFound
+		}
+
+		repo, err := u.uow.GetRepository(ctx, "tru_price")
+		if err != nil {
+			u.logger.Errorf("Failed to get repository: %v", err)
+			return err
+		}
+		priceRepo := repo.(tru_usecase_contracts.ITRUPriceRepository)
+
+		return priceRepo.Upsert(ctx, price)
+	})
+}
+
+func (u *TRUUsecase) DeletePrice(ctx context.Context, truCodeID, regionID string) error {
+	u.logger.Infof("Deleting price for tru code %s in region %s", truCodeID, regionID)
+
+	return u.uow.Do(ctx, func(ctx context.Context) error {
+		repo, err := u.uow.GetRepository(ctx, "tru_price")
+		if err != nil {
+			u.logger.Errorf("Failed to get repository: %v", err)
+			return err
+		}
+		priceRepo := repo.(tru_usecase_contracts.ITRUPriceRepository)
+
+		deleted, err := priceRepo.Delete(ctx, truCodeID, regionID)
+		if err != nil {
+			return err
+		}
+		if !deleted {
+			return tru_constant.ErrTRUPriceNotFound
+		}
+
+		return nil
+	})
+}
+
+func (u *TRUUsecase) SetProductCodes(ctx context.Context, productID string, truCodeIDs []string) error {
+	u.logger.Infof("Linking %d tru codes to product %s", len(truCodeIDs), productID)
+
+	if u.productUsecase != nil {
+		exists, err := u.productUsecase.Exists(ctx, productID)
+		if err != nil {
+			return err
+		}
+		if !exists {
+			return tru_constant.ErrProductNotFound
+		}
+	}
+
+	return u.uow.Do(ctx, func(ctx context.Context) error {
+		codeRepo, err := u.getCodeRepository(ctx)
+		if err != nil {
+			return err
+		}
+
+		unique := make([]string, 0, len(truCodeIDs))
+		seen := make(map[string]struct{}, len(truCodeIDs))
+		for _, id := range truCodeIDs {
+			if _, ok := seen[id]; ok {
+				continue
+			}
+			seen[id] = struct{}{}
+
+			code, err := codeRepo.GetByID(ctx, id)
+			if err != nil {
+				return err
+			}
+			if code == nil {
+				return tru_constant.ErrTRUCodeNotFound.WithContext("tru code " + id)
+			}
+			unique = append(unique, id)
+		}
+
+		repo, err := u.uow.GetRepository(ctx, "product_tru")
+		if err != nil {
+			u.logger.Errorf("Failed to get repository: %v", err)
+			return err
+		}
+		productTRURepo := repo.(tru_usecase_contracts.IProductTRURepository)
+
+		return productTRURepo.SetProductCodes(ctx, productID, unique)
+	})
+}
+
+func (u *TRUUsecase) GetProductCodes(ctx context.Context, productID string) ([]tru_entity.TRUCode, error) {
+	u.logger.Debugf("Getting tru codes for product: %s", productID)
+	return u.productTRURepository.GetCodesByProductID(ctx, productID)
+}
+
+func (u *TRUUsecase) GetProductReimbursement(ctx context.Context, productID, regionID string) (*tru_entity.ProductReimbursement, error) {
+	u.logger.Debugf("Getting reimbursement for product %s in region %s", productID, regionID)
+
+	reimbursements, err := u.GetReimbursements(ctx, []string{productID}, regionID)
+	if err != nil {
+		return nil, err
+	}
+
+	reimbursement, ok := reimbursements[productID]
+	if !ok {
+		return nil, tru_constant.ErrReimbursementNotFound
+	}
+
+	return &reimbursement, nil
+}
+
+// GetReimbursements возвращает цены возмещения по товарам, у которых есть цена в регионе
+func (u *TRUUsecase) GetReimbursements(ctx context.Context, productIDs []string, regionID string) (map[string]tru_entity.ProductReimbursement, error) {
+	reimbursements, err := u.productTRURepository.GetReimbursements(ctx, productIDs, regionID)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make(map[string]tru_entity.ProductReimbursement, len(reimbursements))
+	for _, reimbursement := range reimbursements {
+		result[reimbursement.ProductID] = reimbursement
+	}
+
+	return result, nil
+}
+
+// GetEligibleProductIDs возвращает товары, которые можно оплатить сертификатом в регионе
+func (u *TRUUsecase) GetEligibleProductIDs(ctx context.Context, regionID string) ([]string, error) {
+	u.logger.Debugf("Getting certificate eligible products in region %s", regionID)
+	return u.productTRURepository.GetEligibleProductIDs(ctx, regionID)
+}
+
+func (u *TRUUsecase) GetProductIDsByCodes(ctx context.Context, truCodeIDs []string) (map[string][]string, error) {
+	u.logger.Debugf("Getting products linked to %d tru codes", len(truCodeIDs))
+	return u.productTRURepository.GetProductIDsByCodeIDs(ctx, truCodeIDs)
+}
+
+func (u *TRUUsecase) getCodeRepository(ctx context.Context) (tru_usecase_contracts.ITRUCodeRepository, error) {
+	repo, err := u.uow.GetRepository(ctx, "tru_code")
+	if err != nil {
+		u.logger.Errorf("Failed to get repository: %v", err)
+		return nil, err
+	}
+	return repo.(tru_usecase_contracts.ITRUCodeRepository), nil
+}
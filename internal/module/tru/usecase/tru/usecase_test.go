@@ -0,0 +1,208 @@
+package tru_usecase_test
+
+import (
+	"context"
+	"testing"
+
+	tru_usecase "github.com/Fi44er/sdmed/internal/module/tru/usecase/tru"
+	"github.com/Fi44er/sdmed/internal/module/tru/usecase/tru/mock"
+	tru_testcases "github.com/Fi44er/sdmed/internal/module/tru/usecase/tru/testcases"
+	"github.com/Fi44er/sdmed/pkg/logger"
+	uow_mock "github.com/Fi44er/sdmed/pkg/postgres/uow/mock"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+)
+
+type TRUUsecaseTestSuite struct {
+	suite.Suite
+	ctx    context.Context
+	logger *logger.Logger
+}
+
+func (s *TRUUsecaseTestSuite) SetupSuite() {
+	s.ctx = context.Background()
+	s.logger = logger.NewLogger()
+}
+
+func TestTRUUsecase(t *testing.T) {
+	suite.Run(t, new(TRUUsecaseTestSuite))
+}
+
+func (s *TRUUsecaseTestSuite) TestSetProductCodes() {
+	tests := tru_testcases.GetSetProductCodesTestCases()
+
+	for _, tc := range tests {
+		s.T().Run(tc.Name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			codeRepoMock := mock.NewMockITRUCodeRepository(ctrl)
+			productTRURepoMock := mock.NewMockIProductTRURepository(ctrl)
+			productMock := mock.NewMockIProductUsecaseAdapter(ctrl)
+			uowMock := uow_mock.NewMockUow(ctrl)
+
+			usecase := tru_usecase.NewTRUUsecase(codeRepoMock, productTRURepoMock, nil, nil, uowMock, s.logger)
+			usecase.SetProductUsecase(productMock)
+
+			mockStruct := &tru_testcases.MockSetProductCodes{
+				Ctrl:               ctrl,
+				Ctx:                s.ctx,
+				CodeRepoMock:       codeRepoMock,
+				ProductTRURepoMock: productTRURepoMock,
+				ProductMock:        productMock,
+				UowMock:            uowMock,
+				T:                  t,
+			}
+
+			tc.SetupMocks(mockStruct)
+			err := usecase.SetProductCodes(s.ctx, tc.InputProductID, tc.InputCodeIDs)
+
+			if tc.ExpectedError != nil {
+				assert.Error(t, err)
+				assert.Contains(t, err.Error(), tc.ExpectedError.Error())
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func (s *TRUUsecaseTestSuite) TestSaveParsedCode() {
+	tests := tru_testcases.GetSaveParsedCodeTestCases()
+
+	for _, tc := range tests {
+		s.T().Run(tc.Name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockStruct := &tru_testcases.MockSaveParsedCode{
+				Ctrl:          ctrl,
+				Ctx:           s.ctx,
+				CodeRepoMock:  mock.NewMockITRUCodeRepository(ctrl),
+				PriceRepoMock: mock.NewMockITRUPriceRepository(ctrl),
+				UowMock:       uow_mock.NewMockUow(ctrl),
+				T:             t,
+			}
+
+			usecase := tru_usecase.NewTRUUsecase(mockStruct.CodeRepoMock, nil, nil, nil, mockStruct.UowMock, s.logger)
+
+			tc.SetupMocks(mockStruct)
+			err := usecase.SaveParsedCode(s.ctx, tc.InputCode)
+
+			if tc.ExpectedError != nil {
+				assert.ErrorIs(t, err, tc.ExpectedError)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func (s *TRUUsecaseTestSuite) newCustomMocks(t *testing.T, ctrl *gomock.Controller) (*tru_testcases.MockCustom, *tru_usecase.TRUUsecase) {
+	m := &tru_testcases.MockCustom{
+		Ctrl:               ctrl,
+		Ctx:                s.ctx,
+		CodeRepoMock:       mock.NewMockITRUCodeRepository(ctrl),
+		PriceRepoMock:      mock.NewMockITRUPriceRepository(ctrl),
+		ProductTRURepoMock: mock.NewMockIProductTRURepository(ctrl),
+		SuggestionMock:     mock.NewMockISuggestionUsecaseAdapter(ctrl),
+		UowMock:            uow_mock.NewMockUow(ctrl),
+		T:                  t,
+	}
+
+	usecase := tru_usecase.NewTRUUsecase(m.CodeRepoMock, m.ProductTRURepoMock, nil, nil, m.UowMock, s.logger)
+	usecase.SetSuggestionUsecase(m.SuggestionMock)
+	return m, usecase
+}
+
+func (s *TRUUsecaseTestSuite) TestCreateCustom() {
+	tests := tru_testcases.GetCreateCustomTestCases()
+
+	for _, tc := range tests {
+		s.T().Run(tc.Name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockStruct, usecase := s.newCustomMocks(t, ctrl)
+			tc.SetupMocks(mockStruct)
+
+			err := usecase.CreateCustom(s.ctx, tc.InputCode)
+
+			if tc.ExpectedError != nil {
+				assert.ErrorIs(t, err, tc.ExpectedError)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func (s *TRUUsecaseTestSuite) TestApprove() {
+	tests := tru_testcases.GetApproveTestCases()
+
+	for _, tc := range tests {
+		s.T().Run(tc.Name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockStruct, usecase := s.newCustomMocks(t, ctrl)
+			tc.SetupMocks(mockStruct)
+
+			err := usecase.Approve(s.ctx, tc.InputID)
+
+			if tc.ExpectedError != nil {
+				assert.ErrorIs(t, err, tc.ExpectedError)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func (s *TRUUsecaseTestSuite) TestMerge() {
+	tests := tru_testcases.GetMergeTestCases()
+
+	for _, tc := range tests {
+		s.T().Run(tc.Name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockStruct, usecase := s.newCustomMocks(t, ctrl)
+			tc.SetupMocks(mockStruct)
+
+			result, err := usecase.Merge(s.ctx, tc.InputID, tc.InputOfficialID)
+
+			if tc.ExpectedError != nil {
+				assert.Error(t, err)
+				assert.Contains(t, err.Error(), tc.ExpectedError.Error())
+				assert.Nil(t, result)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tc.ExpectedResult, result)
+		})
+	}
+}
+
+func (s *TRUUsecaseTestSuite) TestUpdate() {
+	tests := tru_testcases.GetUpdateTestCases()
+
+	for _, tc := range tests {
+		s.T().Run(tc.Name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockStruct, usecase := s.newCustomMocks(t, ctrl)
+			tc.SetupMocks(mockStruct)
+
+			err := usecase.Update(s.ctx, tc.InputCode)
+
+			if tc.ExpectedError != nil {
+				assert.ErrorIs(t, err, tc.ExpectedError)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
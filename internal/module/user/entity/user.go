@@ -18,6 +18,7 @@ type User struct {
 	PhoneNumber  string
 	IsShadow     bool
 	Roles        []Role
+	RegionID     *string
 
 	ShadowCreatedAt *time.Time
 	ShadowExpiresAt *time.Time
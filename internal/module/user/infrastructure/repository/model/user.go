@@ -13,6 +13,8 @@ type User struct {
 	IsShadow     bool   `gorm:"default:false"`
 	Roles        []Role `gorm:"many2many:user_roles;"`
 
+	RegionID *string `gorm:"type:uuid"`
+
 	ShadowCreatedAt *time.Time `gorm:"index:idx_users_shadow"`
 	ShadowExpiresAt *time.Time `gorm:"index:idx_users_shadow"`
 	CreatedAt       time.Time
@@ -22,6 +22,7 @@ func (c *Converter) ToModel(entity *user_entity.User) *user_model.User {
 		PhoneNumber:     entity.PhoneNumber,
 		Roles:           roles,
 		IsShadow:        entity.IsShadow,
+		RegionID:        entity.RegionID,
 		ShadowCreatedAt: entity.ShadowCreatedAt,
 		ShadowExpiresAt: entity.ShadowExpiresAt,
 		CreatedAt:       entity.CreatedAt,
@@ -44,6 +45,7 @@ func (c *Converter) ToEntity(model *user_model.User) *user_entity.User {
 		PhoneNumber:     model.PhoneNumber,
 		Roles:           roles,
 		IsShadow:        model.IsShadow,
+		RegionID:        model.RegionID,
 		ShadowCreatedAt: model.ShadowCreatedAt,
 		ShadowExpiresAt: model.ShadowExpiresAt,
 		CreatedAt:       model.CreatedAt,
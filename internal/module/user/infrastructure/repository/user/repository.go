@@ -46,6 +46,15 @@ func (r *UserRepository) Update(ctx context.Context, user *user_entity.User) err
 	return nil
 }
 
+func (r *UserRepository) UpdateRegion(ctx context.Context, userID, regionID string) error {
+	r.logger.Infof("Updating region for user %s: %s", userID, regionID)
+	if err := r.db.WithContext(ctx).Model(&user_model.User{}).Where("id = ?", userID).Update("region_id", regionID).Error; err != nil {
+		r.logger.Errorf("Error updating user region: %v", err)
+		return err
+	}
+	return nil
+}
+
 func (r *UserRepository) Delete(ctx context.Context, id string) error {
 	r.logger.Infof("Deleting user: %s", id)
 	if err := r.db.WithContext(ctx).Delete(&user_model.User{}, "id = ?", id).Error; err != nil {
@@ -14,6 +14,7 @@ type IUserRepository interface {
 	GetByEmail(ctx context.Context, email string) (*user_entity.User, error)
 	Create(ctx context.Context, user_entity *user_entity.User) error
 	Update(ctx context.Context, user_entity *user_entity.User) error
+	UpdateRegion(ctx context.Context, userID, regionID string) error
 	Delete(ctx context.Context, id string) error
 }
 
@@ -93,6 +94,11 @@ func (u *UserUsecase) Update(ctx context.Context, user *user_entity.User) error
 	return nil
 }
 
+// UpdateRegion сохраняет выбранный покупателем регион, в том числе для shadow-пользователя
+func (u *UserUsecase) UpdateRegion(ctx context.Context, userID, regionID string) error {
+	return u.repository.UpdateRegion(ctx, userID, regionID)
+}
+
 func (u *UserUsecase) Delete(ctx context.Context, id string) error {
 	if err := u.repository.Delete(ctx, id); err != nil {
 		return err
@@ -1,12 +1,26 @@
 package postgres
 
 import (
+	address_model "github.com/Fi44er/sdmed/internal/module/address/infrastructure/repository/model"
 	auth_models "github.com/Fi44er/sdmed/internal/module/auth/infrastucture/repository/models"
+	cart_model "github.com/Fi44er/sdmed/internal/module/cart/infrastructure/repository/model"
+	delivery_model "github.com/Fi44er/sdmed/internal/module/delivery/infrastructure/repository/model"
+	discount_model "github.com/Fi44er/sdmed/internal/module/discount/infrastructure/repository/model"
 	file_model "github.com/Fi44er/sdmed/internal/module/file/infrastructure/repository/model"
+	matcher_model "github.com/Fi44er/sdmed/internal/module/matcher/infrastructure/repository/model"
+	order_model "github.com/Fi44er/sdmed/internal/module/order/infrastructure/repository/model"
+	parser_model "github.com/Fi44er/sdmed/internal/module/parser/infrastructure/repository/model"
+	payment_model "github.com/Fi44er/sdmed/internal/module/payment/infrastructure/repository/model"
 	product_model "github.com/Fi44er/sdmed/internal/module/product/infrastructure/repository/model"
+	receipt_model "github.com/Fi44er/sdmed/internal/module/receipt/infrastructure/repository/model"
+	region_model "github.com/Fi44er/sdmed/internal/module/region/infrastructure/repository/model"
+	returns_model "github.com/Fi44er/sdmed/internal/module/returns/infrastructure/repository/model"
+	stock_model "github.com/Fi44er/sdmed/internal/module/stock/infrastructure/repository/model"
+	tru_model "github.com/Fi44er/sdmed/internal/module/tru/infrastructure/repository/model"
 	user_model "github.com/Fi44er/sdmed/internal/module/user/infrastructure/repository/model"
 	"github.com/Fi44er/sdmed/pkg/logger"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
 func Migrate(db *gorm.DB, trigger bool, log *logger.Logger) error {
@@ -25,17 +39,75 @@ func Migrate(db *gorm.DB, trigger bool, log *logger.Logger) error {
 			product_model.Characteristic{},
 			product_model.CharacteristicValue{},
 			product_model.CharOption{},
+
+			region_model.Region{},
+
+			tru_model.TRUCode{},
+			tru_model.TRUCodePrice{},
+			tru_model.ProductTRUCode{},
+
+			parser_model.ParserProgress{},
+			parser_model.Changeset{},
+			parser_model.ChangesetItem{},
+			matcher_model.TRUSuggestion{},
+
+			cart_model.Cart{},
+			cart_model.CartItem{},
+
+			address_model.Address{},
+
+			discount_model.PromoCode{},
+			discount_model.Rule{},
+			discount_model.PromoCodeUsage{},
+
+			delivery_model.DeliveryMethod{},
+			delivery_model.DeliveryZone{},
+
+			stock_model.Stock{},
+			stock_model.Reservation{},
+
+			order_model.Order{},
+			order_model.OrderItem{},
+			order_model.OrderStatusHistory{},
+			order_model.OrderDocument{},
+			order_model.OrderNotification{},
+			order_model.OrderComment{},
+
+			payment_model.Payment{},
+
+			receipt_model.Receipt{},
+
+			returns_model.Return{},
+			returns_model.ReturnItem{},
 		}
 
 		log.Info("📦 Creating types...")
 
 		db.Exec("CREATE TYPE file_status AS ENUM ('temporary', 'permanent')")
 		db.Exec("CREATE EXTENSION IF NOT EXISTS \"uuid-ossp\"")
+		db.Exec("CREATE SCHEMA IF NOT EXISTS tru_module")
+		db.Exec("CREATE SCHEMA IF NOT EXISTS parser_module")
+		db.Exec("CREATE SCHEMA IF NOT EXISTS matcher_module")
+		db.Exec("CREATE SCHEMA IF NOT EXISTS cart_module")
+		db.Exec("CREATE SCHEMA IF NOT EXISTS address_module")
+		db.Exec("CREATE SCHEMA IF NOT EXISTS discount_module")
+		db.Exec("CREATE SCHEMA IF NOT EXISTS delivery_module")
+		db.Exec("CREATE SCHEMA IF NOT EXISTS stock_module")
+		db.Exec("CREATE SCHEMA IF NOT EXISTS order_module")
+		db.Exec("CREATE SCHEMA IF NOT EXISTS payment_module")
+		db.Exec("CREATE SCHEMA IF NOT EXISTS receipt_module")
+		db.Exec("CREATE SCHEMA IF NOT EXISTS returns_module")
 
 		if err := db.AutoMigrate(models...); err != nil {
 			log.Errorf("✖ Failed to migrate database: %v", err)
 			return err
 		}
+
+		log.Info("📦 Seeding regions...")
+		if err := db.Clauses(clause.OnConflict{DoNothing: true}).Create(region_model.DefaultRegions()).Error; err != nil {
+			log.Errorf("✖ Failed to seed regions: %v", err)
+			return err
+		}
 	}
 
 	log.Info("✅ Database connection successfully")
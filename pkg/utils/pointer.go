@@ -0,0 +1,18 @@
+package utils
+
+// Deref возвращает значение указателя или нулевое значение типа, если указатель nil
+func Deref[T any](v *T) T {
+	if v == nil {
+		var zero T
+		return zero
+	}
+	return *v
+}
+
+// NilIfEmpty возвращает nil для пустой строки, например для необязательных uuid-колонок
+func NilIfEmpty(s string) *string {
+	if s == "" {
+		return nil
+	}
+	return &s
+}